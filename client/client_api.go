@@ -21,6 +21,7 @@ import (
 
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
 )
 
 // ModelInfo is just retyped models.ModelInfo for backward compatibility purpose
@@ -79,6 +80,10 @@ type GenericClient interface {
 
 	DeleteItems(ctx context.Context, items []UpdateItem) ([]*UpdateResult, error)
 
+	// PlanItems simulates UpdateItems and returns the execution plan
+	// without actually changing anything.
+	PlanItems(ctx context.Context, items []UpdateItem, resync bool) (*kvscheduler.TxnPlan, error)
+
 	// DumpState dumps actual running state.
 	DumpState() ([]*StateItem, error)
 }
@@ -27,9 +27,11 @@ import (
 
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 	"go.ligato.io/vpp-agent/v3/pkg/util"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/contextdecorator"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
 )
 
 // LocalClient is global client for direct local access.
@@ -155,6 +157,31 @@ func (c *client) DeleteItems(ctx context.Context, items []UpdateItem) ([]*Update
 	return nil, nil
 }
 
+func (c *client) PlanItems(ctx context.Context, items []UpdateItem, resync bool) (*kvscheduler.TxnPlan, error) {
+	var kvPairs []orchestrator.KeyVal
+	for _, item := range items {
+		key, err := models.GetKey(item.Message)
+		if err != nil {
+			return nil, err
+		}
+		kvPairs = append(kvPairs, orchestrator.KeyVal{
+			Key: key,
+			Val: item.Message,
+		})
+	}
+	if resync {
+		ctx = kvs.WithResync(ctx, kvs.FullResync, true)
+	}
+	if _, withDataSrc := contextdecorator.DataSrcFromContext(ctx); !withDataSrc {
+		ctx = contextdecorator.DataSrcContext(ctx, "localclient")
+	}
+	txn, err := c.dispatcher.PlanData(ctx, kvPairs)
+	if err != nil {
+		return nil, err
+	}
+	return txn.TxnPlan(), nil
+}
+
 func (c *client) DumpState() ([]*generic.StateItem, error) {
 	// TODO: use dispatcher to dump state
 	return nil, nil
@@ -16,6 +16,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 	"go.ligato.io/vpp-agent/v3/pkg/util"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
 )
 
 type grpcClient struct {
@@ -259,6 +260,29 @@ func (c *grpcClient) DeleteItems(ctx context.Context, items []client.UpdateItem)
 	return updateResults, nil
 }
 
+func (c *grpcClient) PlanItems(ctx context.Context, items []client.UpdateItem, resync bool) (*kvscheduler.TxnPlan, error) {
+	req := &generic.SetConfigRequest{
+		OverwriteAll: resync,
+		DryRun:       true,
+	}
+	for _, ui := range items {
+		var item *generic.Item
+		item, err := models.MarshalItemUsingModelRegistry(ui.Message, c.modelRegistry)
+		if err != nil {
+			return nil, err
+		}
+		req.Updates = append(req.Updates, &generic.UpdateItem{
+			Item:   item,
+			Labels: ui.Labels,
+		})
+	}
+	res, err := c.manager.SetConfig(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return res.GetPlan(), nil
+}
+
 func (c *grpcClient) DumpState() ([]*client.StateItem, error) {
 	ctx := context.Background()
 
@@ -34,6 +34,12 @@ type SchedulerResyncOptions struct {
 	Verbose bool
 }
 
+type SchedulerDriftOptions struct {
+	Detect      bool
+	Heal        bool
+	Descriptors []string
+}
+
 type SchedulerHistoryOptions struct {
 	Count  int
 	SeqNum int
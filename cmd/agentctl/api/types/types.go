@@ -58,3 +58,18 @@ type Model struct {
 	GoType       string `json:",omitempty"`
 	PkgPath      string `json:",omitempty"`
 }
+
+// ConfigConflict contains single item of response of Agent REST API:
+// GET "/configuration/conflicts"
+type ConfigConflict struct {
+	Key     string
+	Winner  string
+	Sources []ConfigConflictSource
+}
+
+// ConfigConflictSource describes value set by one of the conflicting data sources.
+type ConfigConflictSource struct {
+	DataSrc  string
+	Priority int
+	Value    string
+}
@@ -22,12 +22,14 @@ type APIClient interface {
 	InfraAPIClient
 	ModelAPIClient
 	SchedulerAPIClient
+	ConfigAPIClient
 	VppAPIClient
 	MetricsAPIClient
 
 	GenericClient() (client.GenericClient, error)
 	ConfiguratorClient() (configurator.ConfiguratorServiceClient, error)
 	MetaServiceClient() (generic.MetaServiceClient, error)
+	ManagerServiceClient() (generic.ManagerServiceClient, error)
 
 	AgentHost() string
 	Version() string
@@ -58,6 +60,12 @@ type SchedulerAPIClient interface {
 	SchedulerValues(ctx context.Context, opts types.SchedulerValuesOptions) ([]*kvscheduler.BaseValueStatus, error)
 	SchedulerResync(ctx context.Context, opts types.SchedulerResyncOptions) (*api.RecordedTxn, error)
 	SchedulerHistory(ctx context.Context, opts types.SchedulerHistoryOptions) (api.RecordedTxns, error)
+	SchedulerDrift(ctx context.Context, opts types.SchedulerDriftOptions) (*api.DriftReport, error)
+}
+
+// ConfigAPIClient defines API client methods for the config
+type ConfigAPIClient interface {
+	ConfigConflicts(ctx context.Context) ([]types.ConfigConflict, error)
 }
 
 // VppAPIClient defines API client methods for the VPP
@@ -184,6 +184,15 @@ func (c *Client) MetaServiceClient() (generic.MetaServiceClient, error) {
 	return generic.NewMetaServiceClient(conn), nil
 }
 
+// ManagerServiceClient creates new client for using manager service
+func (c *Client) ManagerServiceClient() (generic.ManagerServiceClient, error) {
+	conn, err := c.GRPCConn()
+	if err != nil {
+		return nil, err
+	}
+	return generic.NewManagerServiceClient(conn), nil
+}
+
 // HTTPClient returns configured HTTP client.
 func (c *Client) HTTPClient() *http.Client {
 	if c.httpClient == nil {
@@ -0,0 +1,21 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.ligato.io/vpp-agent/v3/cmd/agentctl/api/types"
+)
+
+func (c *Client) ConfigConflicts(ctx context.Context) ([]types.ConfigConflict, error) {
+	resp, err := c.get(ctx, "/configuration/conflicts", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var conflicts []types.ConfigConflict
+	if err := json.NewDecoder(resp.body).Decode(&conflicts); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return conflicts, nil
+}
@@ -98,3 +98,32 @@ func (c *Client) SchedulerHistory(ctx context.Context, opts types.SchedulerHisto
 
 	return rectxn, nil
 }
+
+func (c *Client) SchedulerDrift(ctx context.Context, opts types.SchedulerDriftOptions) (*api.DriftReport, error) {
+	query := url.Values{}
+	var (
+		resp serverResponse
+		err  error
+	)
+	if opts.Detect || opts.Heal {
+		for _, descriptor := range opts.Descriptors {
+			query.Add("descriptor", descriptor)
+		}
+		if opts.Heal {
+			query.Set("heal", "1")
+		}
+		resp, err = c.post(ctx, "/scheduler/drift", query, nil, nil)
+	} else {
+		resp, err = c.get(ctx, "/scheduler/drift", query, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var report api.DriftReport
+	if err := json.NewDecoder(resp.body).Decode(&report); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+
+	return &report, nil
+}
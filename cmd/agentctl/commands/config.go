@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/user"
 	"sort"
 	"strconv"
 	"strings"
@@ -38,6 +39,7 @@ import (
 	agentcli "go.ligato.io/vpp-agent/v3/cmd/agentctl/cli"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
 )
 
@@ -54,6 +56,10 @@ func NewConfigCommand(cli agentcli.Cli) *cobra.Command {
 		newConfigWatchCommand(cli),
 		newConfigResyncCommand(cli),
 		newConfigHistoryCommand(cli),
+		newConfigRevisionsCommand(cli),
+		newConfigRollbackCommand(cli),
+		newConfigConflictsCommand(cli),
+		newConfigDriftCommand(cli),
 	)
 	return cmd
 }
@@ -143,6 +149,8 @@ func newConfigUpdateCommand(cli agentcli.Cli) *cobra.Command {
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
 	flags.BoolVar(&opts.Replace, "replace", false, "Replaces all existing config")
+	flags.BoolVar(&opts.DryRun, "dry-run", false, "Only show the plan of operations without applying the config")
+	flags.StringVar(&opts.Description, "description", "", "Description of the change stored in the config revision")
 	// TODO implement waitdone also for generic client
 	// flags.BoolVar(&opts.WaitDone, "waitdone", false, "Waits until config update is done")
 	// TODO implement transaction output when verbose is used
@@ -158,8 +166,10 @@ func newConfigUpdateCommand(cli agentcli.Cli) *cobra.Command {
 }
 
 type ConfigUpdateOptions struct {
-	Format  string
-	Replace bool
+	Format      string
+	Replace     bool
+	DryRun      bool
+	Description string
 	// WaitDone bool
 	// Verbose  bool
 	Timeout time.Duration
@@ -226,7 +236,21 @@ func runConfigUpdate(cli agentcli.Cli, opts ConfigUpdateOptions, args []string)
 		labels["io.ligato.from-client"] = "agentctl"
 	}
 
+	// only plan the update/resync of configuration
+	if opts.DryRun {
+		plan, err := c.PlanItems(ctx, createUpdateItems(configMessages, labels), opts.Replace)
+		if err != nil {
+			return fmt.Errorf("dry-run failed: %w", err)
+		}
+		if len(opts.Format) == 0 {
+			printTxnPlan(cli.Out(), plan)
+			return nil
+		}
+		return formatAsTemplate(cli.Out(), opts.Format, plan)
+	}
+
 	// update/resync configuration
+	ctx = withRevisionMetadata(ctx, opts.Description)
 	_, err = c.UpdateItems(ctx, createUpdateItems(configMessages, labels), opts.Replace)
 	if err != nil {
 		return fmt.Errorf("update failed: %w", err)
@@ -553,6 +577,10 @@ Type can be one of:
  - config sync    (NB - upstream resync)
  - status sync    (NB - downstream resync)
  - retry #X for Y (retry of TX)
+
+If the transaction journal is enabled in the KVScheduler config, the history
+also includes transactions from before the agent restart. Transactions that
+were interrupted by the restart are reported with the result "interrupted".
 `,
 		Example: `
 # Show entire history
@@ -633,6 +661,310 @@ func runConfigHistory(cli agentcli.Cli, opts ConfigHistoryOptions) (err error) {
 	return nil
 }
 
+func newConfigRevisionsCommand(cli agentcli.Cli) *cobra.Command {
+	var (
+		opts ConfigRevisionsOptions
+	)
+	cmd := &cobra.Command{
+		Use:   "revisions",
+		Short: "Show config revisions",
+		Long: `Show retained revisions of the desired config
+
+Each config change creates a numbered revision that holds the complete
+desired config. Only the last revisions are retained by the agent.
+Use 'config rollback' to re-apply the config of an older revision.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigRevisions(cli, opts)
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
+	return cmd
+}
+
+type ConfigRevisionsOptions struct {
+	Format string
+}
+
+func runConfigRevisions(cli agentcli.Cli, opts ConfigRevisionsOptions) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, err := cli.Client().ManagerServiceClient()
+	if err != nil {
+		return err
+	}
+	resp, err := c.ListRevisions(ctx, &generic.ListRevisionsRequest{})
+	if err != nil {
+		return err
+	}
+
+	if len(opts.Format) == 0 {
+		printRevisionsTable(cli.Out(), resp.GetRevisions())
+		return nil
+	}
+	return formatAsTemplate(cli.Out(), opts.Format, resp.GetRevisions())
+}
+
+func printRevisionsTable(out io.Writer, revisions []*generic.Revision) {
+	table := tablewriter.NewWriter(out)
+	table.SetHeader([]string{
+		"Rev", "Time", "Source", "Author", "Items", "Txn", "Description",
+	})
+	table.SetAutoWrapText(false)
+	table.SetAutoFormatHeaders(true)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+	table.SetRowSeparator("")
+	table.SetHeaderLine(false)
+	table.SetBorder(false)
+	table.SetTablePadding("\t")
+	for _, rev := range revisions {
+		table.Append([]string{
+			fmt.Sprint(rev.GetNum()),
+			time.Unix(rev.GetTimestamp(), 0).Format(time.RFC3339),
+			rev.GetDataSrc(),
+			rev.GetAuthor(),
+			fmt.Sprint(rev.GetNumItems()),
+			fmt.Sprint(rev.GetTxnSeqNum()),
+			rev.GetDescription(),
+		})
+	}
+	table.Render()
+}
+
+func newConfigRollbackCommand(cli agentcli.Cli) *cobra.Command {
+	var (
+		opts ConfigRollbackOptions
+	)
+	cmd := &cobra.Command{
+		Use:   "rollback REV",
+		Short: "Roll back config to a revision",
+		Long: `Roll back config to an older revision
+
+Re-applies the complete desired config of the given revision as a full resync.
+The rollback itself creates a new revision.
+`,
+		Example: `
+# Show retained revisions
+{{.CommandPath}} config revisions
+
+# Roll back config to revision 3
+{{.CommandPath}} config rollback 3
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigRollback(cli, opts, args)
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
+	flags.StringVar(&opts.Description, "description", "", "Description of the rollback stored in the config revision")
+	flags.DurationVarP(&opts.Timeout, "timeout", "t",
+		5*time.Minute, "Timeout for the rollback")
+	return cmd
+}
+
+type ConfigRollbackOptions struct {
+	Format      string
+	Description string
+	Timeout     time.Duration
+}
+
+func runConfigRollback(cli agentcli.Cli, opts ConfigRollbackOptions, args []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), opts.Timeout)
+	defer cancel()
+
+	revision, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid revision: %q, use number > 0", args[0])
+	}
+
+	c, err := cli.Client().ManagerServiceClient()
+	if err != nil {
+		return err
+	}
+	ctx = withRevisionMetadata(ctx, opts.Description)
+	resp, err := c.Rollback(ctx, &generic.RollbackRequest{Revision: revision})
+	if err != nil {
+		return fmt.Errorf("rollback failed: %w", err)
+	}
+
+	if len(opts.Format) == 0 {
+		fmt.Fprintf(cli.Out(), "Rolled back to revision %d (new revision %d)\n", revision, resp.GetRevision())
+		return nil
+	}
+	return formatAsTemplate(cli.Out(), opts.Format, resp)
+}
+
+func newConfigConflictsCommand(cli agentcli.Cli) *cobra.Command {
+	var (
+		opts ConfigConflictsOptions
+	)
+	cmd := &cobra.Command{
+		Use:   "conflicts",
+		Short: "Show config conflicts between data sources",
+		Long: `Show config items set by multiple data sources
+
+When the same config item is set by multiple NB data sources (e.g. grpc,
+datasync or initfile), the value from the data source with the highest
+priority is applied. The winning data source is marked with asterisk.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigConflicts(cli, opts)
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
+	return cmd
+}
+
+type ConfigConflictsOptions struct {
+	Format string
+}
+
+func runConfigConflicts(cli agentcli.Cli, opts ConfigConflictsOptions) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conflicts, err := cli.Client().ConfigConflicts(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(opts.Format) == 0 {
+		printConflictsTable(cli.Out(), conflicts)
+		return nil
+	}
+	return formatAsTemplate(cli.Out(), opts.Format, conflicts)
+}
+
+func printConflictsTable(out io.Writer, conflicts []types.ConfigConflict) {
+	if len(conflicts) == 0 {
+		fmt.Fprintln(out, "No conflicts.")
+		return
+	}
+	table := tablewriter.NewWriter(out)
+	table.SetHeader([]string{
+		"Key", "Source", "Priority", "Value",
+	})
+	table.SetAutoWrapText(false)
+	table.SetAutoFormatHeaders(true)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+	table.SetRowSeparator("")
+	table.SetHeaderLine(false)
+	table.SetBorder(false)
+	table.SetTablePadding("\t")
+	for _, c := range conflicts {
+		for i, src := range c.Sources {
+			var key string
+			if i == 0 {
+				key = c.Key
+			}
+			dataSrc := src.DataSrc
+			if dataSrc == c.Winner {
+				dataSrc += " *"
+			}
+			table.Append([]string{
+				key,
+				dataSrc,
+				fmt.Sprint(src.Priority),
+				src.Value,
+			})
+		}
+	}
+	table.Render()
+}
+
+func newConfigDriftCommand(cli agentcli.Cli) *cobra.Command {
+	var (
+		opts ConfigDriftOptions
+	)
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Show config drift from the intended state",
+		Long: `Show values that have drifted from the intended state
+
+The actual state of the data plane (as retrieved by descriptors) is compared
+with the intended state and values which are missing, modified or unexpected
+are reported. By default the report from the latest (periodic) drift detection
+is shown. Use --detect to run the detection right away and --heal to also
+resync the drifted values.
+`,
+		Example: `
+# Show the latest drift report
+{{.CommandPath}} config drift
+
+# Check interfaces for drift right now
+{{.CommandPath}} config drift --detect --descriptor vpp-interface
+
+# Detect drift and resync drifted values
+{{.CommandPath}} config drift --heal
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runConfigDrift(cli, opts)
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
+	flags.BoolVar(&opts.Detect, "detect", false, "Run drift detection right away")
+	flags.BoolVar(&opts.Heal, "heal", false, "Run drift detection and resync drifted values (implies --detect)")
+	flags.StringSliceVar(&opts.Descriptors, "descriptor", nil, "Limit drift detection to the given descriptor(s)")
+	return cmd
+}
+
+type ConfigDriftOptions struct {
+	Format      string
+	Detect      bool
+	Heal        bool
+	Descriptors []string
+}
+
+func runConfigDrift(cli agentcli.Cli, opts ConfigDriftOptions) error {
+	if len(opts.Descriptors) > 0 && !opts.Detect && !opts.Heal {
+		return fmt.Errorf("--descriptor can be used only with --detect or --heal")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	report, err := cli.Client().SchedulerDrift(ctx, types.SchedulerDriftOptions{
+		Detect:      opts.Detect,
+		Heal:        opts.Heal,
+		Descriptors: opts.Descriptors,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(opts.Format) == 0 {
+		fmt.Fprint(cli.Out(), report.String())
+		return nil
+	}
+	return formatAsTemplate(cli.Out(), opts.Format, report)
+}
+
+// withRevisionMetadata adds author and description of the config change
+// into the outgoing gRPC metadata (stored in the config revision).
+func withRevisionMetadata(ctx context.Context, description string) context.Context {
+	if u, err := user.Current(); err == nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, "author", u.Username)
+	}
+	if description != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "description", description)
+	}
+	return ctx
+}
+
 func printHistoryTable(out io.Writer, txns kvs.RecordedTxns, withDetails bool) {
 	table := tablewriter.NewWriter(out)
 	header := []string{
@@ -675,7 +1007,13 @@ func printHistoryTable(out io.Writer, txns kvs.RecordedTxns, withDetails bool) {
 			summary = "<none>"
 		}
 		errs := txnErrors(txn)
-		if errs != nil {
+		if txn.Interrupted {
+			result = "interrupted"
+			resClr = tablewriter.FgHiRedColor
+		} else if txn.DryRun {
+			result = "dry-run"
+			resClr = tablewriter.FgCyanColor
+		} else if errs != nil {
 			result = "error"
 			resClr = tablewriter.FgHiRedColor
 			if len(errs) > 1 {
@@ -726,6 +1064,88 @@ func printHistoryTable(out io.Writer, txns kvs.RecordedTxns, withDetails bool) {
 	table.Render()
 }
 
+// printTxnPlan prints the transaction plan with one line per operation
+// prefixed with the symbol of the operation.
+func printTxnPlan(out io.Writer, plan *kvscheduler.TxnPlan) {
+	var created, updated, deleted, pending, invalid int
+	var b strings.Builder
+	for _, op := range plan.GetOperations() {
+		if op.GetIsProperty() {
+			continue
+		}
+		var symbol string
+		switch op.GetOperation() {
+		case kvscheduler.TxnOperation_CREATE:
+			symbol = "+"
+		case kvscheduler.TxnOperation_UPDATE:
+			symbol = "~"
+		case kvscheduler.TxnOperation_DELETE:
+			symbol = "-"
+		default:
+			symbol = "?"
+		}
+		if op.GetIsRecreate() {
+			symbol = "-/+"
+		}
+		var flags []string
+		if op.GetIsDerived() {
+			flags = append(flags, "derived")
+		}
+		switch op.GetNewState() {
+		case kvscheduler.ValueState_PENDING:
+			flags = append(flags, "pending")
+			pending++
+		case kvscheduler.ValueState_INVALID:
+			flags = append(flags, "invalid")
+			invalid++
+		}
+		if !op.GetNoop() && !op.GetIsDerived() {
+			switch op.GetOperation() {
+			case kvscheduler.TxnOperation_CREATE:
+				created++
+			case kvscheduler.TxnOperation_UPDATE:
+				updated++
+			case kvscheduler.TxnOperation_DELETE:
+				deleted++
+			}
+		}
+		fmt.Fprintf(&b, "  %3s %s", symbol, op.GetKey())
+		if len(flags) > 0 {
+			fmt.Fprintf(&b, " (%s)", strings.Join(flags, ", "))
+		}
+		b.WriteString("\n")
+		switch op.GetOperation() {
+		case kvscheduler.TxnOperation_CREATE:
+			fmt.Fprintf(&b, "        value: %s\n", op.GetNewValue())
+		case kvscheduler.TxnOperation_UPDATE:
+			fmt.Fprintf(&b, "        prev-value: %s\n", op.GetPrevValue())
+			fmt.Fprintf(&b, "        new-value: %s\n", op.GetNewValue())
+		case kvscheduler.TxnOperation_DELETE:
+			fmt.Fprintf(&b, "        value: %s\n", op.GetPrevValue())
+		}
+		if deps := op.GetUnmetDependencies(); len(deps) > 0 {
+			fmt.Fprintf(&b, "        unmet dependencies: %s\n", strings.Join(deps, ", "))
+		}
+		if op.GetError() != "" {
+			fmt.Fprintf(&b, "        error: %s\n", op.GetError())
+		}
+	}
+	if b.Len() == 0 {
+		fmt.Fprintf(out, "No changes. Configuration is up-to-date.\n")
+		return
+	}
+	fmt.Fprintf(out, "Planned operations (dry-run #%d):\n\n", plan.GetSeqNum())
+	fmt.Fprint(out, b.String())
+	fmt.Fprintf(out, "\nPlan: %d to create, %d to update, %d to delete", created, updated, deleted)
+	if pending > 0 {
+		fmt.Fprintf(out, ", %d pending", pending)
+	}
+	if invalid > 0 {
+		fmt.Fprintf(out, ", %d invalid", invalid)
+	}
+	fmt.Fprintf(out, ".\n")
+}
+
 func getTxnColor(txn *kvs.RecordedTxn) int {
 	var clr int
 	switch txn.TxnType {
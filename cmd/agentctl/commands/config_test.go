@@ -15,11 +15,14 @@
 package commands
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"google.golang.org/protobuf/proto"
 
 	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
 )
 
@@ -60,3 +63,64 @@ func Test_prepareNotifyFilters(t *testing.T) {
 		})
 	}
 }
+
+func Test_printTxnPlan(t *testing.T) {
+	tests := []struct {
+		name string
+		plan *kvscheduler.TxnPlan
+		want []string
+	}{
+		{
+			name: "no-changes",
+			plan: &kvscheduler.TxnPlan{SeqNum: 3},
+			want: []string{"No changes."},
+		},
+		{
+			name: "create-pending-delete",
+			plan: &kvscheduler.TxnPlan{
+				SeqNum: 5,
+				Operations: []*kvscheduler.PlannedOperation{
+					{
+						Operation: kvscheduler.TxnOperation_CREATE,
+						Key:       "config/vpp/v2/interfaces/loop1",
+						NewValue:  `{ name:"loop1" }`,
+						NewState:  kvscheduler.ValueState_CONFIGURED,
+					},
+					{
+						Operation:         kvscheduler.TxnOperation_CREATE,
+						Key:               "config/vpp/v2/route/vrf/0/dst/10.0.0.0/24/gw/",
+						NewState:          kvscheduler.ValueState_PENDING,
+						UnmetDependencies: []string{"outgoing-interface"},
+						Noop:              true,
+					},
+					{
+						Operation: kvscheduler.TxnOperation_DELETE,
+						Key:       "config/vpp/v2/interfaces/loop0",
+						PrevValue: `{ name:"loop0" }`,
+						NewState:  kvscheduler.ValueState_REMOVED,
+					},
+				},
+			},
+			want: []string{
+				"dry-run #5",
+				"    + config/vpp/v2/interfaces/loop1\n",
+				`value: { name:"loop1" }`,
+				"(pending)",
+				"unmet dependencies: outgoing-interface",
+				"    - config/vpp/v2/interfaces/loop0\n",
+				"Plan: 1 to create, 0 to update, 1 to delete, 1 pending.",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			printTxnPlan(&out, tt.plan)
+			for _, want := range tt.want {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("printTxnPlan() = %q, want to contain %q", out.String(), want)
+				}
+			}
+		})
+	}
+}
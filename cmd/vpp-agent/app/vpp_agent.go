@@ -32,6 +32,7 @@ import (
 	linux_ifplugin "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
 	linux_iptablesplugin "go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin"
 	linux_l3plugin "go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin"
+	linux_nftablesplugin "go.ligato.io/vpp-agent/v3/plugins/linux/nftablesplugin"
 	linux_nsplugin "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator"
@@ -39,15 +40,21 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/watcher"
 	"go.ligato.io/vpp-agent/v3/plugins/restapi"
 	"go.ligato.io/vpp-agent/v3/plugins/telemetry"
+	"go.ligato.io/vpp-agent/v3/plugins/tracing"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/dnsplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipfixplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/srplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/stnplugin"
@@ -60,6 +67,9 @@ import (
 type VPPAgent struct {
 	infra.PluginName
 	LogManager *logmanager.Plugin
+	// Tracing is initialized early to have the tracer provider set up before
+	// the plugins start recording spans.
+	Tracing *tracing.Plugin
 
 	// VPP & Linux (and other plugins with descriptors) are first to ensure that
 	// all their descriptors are registered to KVScheduler
@@ -137,6 +147,7 @@ func New() *VPPAgent {
 	return &VPPAgent{
 		PluginName:     "VPPAgent",
 		LogManager:     &logmanager.DefaultPlugin,
+		Tracing:        &tracing.DefaultPlugin,
 		Orchestrator:   &orchestrator.DefaultPlugin,
 		ETCDDataSync:   etcdDataSync,
 		ConsulDataSync: consulDataSync,
@@ -175,36 +186,46 @@ func (a *VPPAgent) Close() error {
 
 // VPP contains all VPP plugins.
 type VPP struct {
-	ABFPlugin   *abfplugin.ABFPlugin
-	ACLPlugin   *aclplugin.ACLPlugin
-	DNSPlugin   *dnsplugin.DNSPlugin
-	IfPlugin    *ifplugin.IfPlugin
-	IPFIXPlugin *ipfixplugin.IPFIXPlugin
-	IPSecPlugin *ipsecplugin.IPSecPlugin
-	L2Plugin    *l2plugin.L2Plugin
-	L3Plugin    *l3plugin.L3Plugin
-	NATPlugin   *natplugin.NATPlugin
-	PuntPlugin  *puntplugin.PuntPlugin
-	STNPlugin   *stnplugin.STNPlugin
-	SRPlugin    *srplugin.SRPlugin
-	WgPlugin    *wireguardplugin.WgPlugin
+	ABFPlugin        *abfplugin.ABFPlugin
+	ACLPlugin        *aclplugin.ACLPlugin
+	BfdPlugin        *bfdplugin.BfdPlugin
+	ClassifierPlugin *classifierplugin.ClassifierPlugin
+	DNSPlugin        *dnsplugin.DNSPlugin
+	IfPlugin         *ifplugin.IfPlugin
+	IPFIXPlugin      *ipfixplugin.IPFIXPlugin
+	IPSecPlugin      *ipsecplugin.IPSecPlugin
+	L2Plugin         *l2plugin.L2Plugin
+	L3Plugin         *l3plugin.L3Plugin
+	LinuxCpPlugin    *linuxcpplugin.LinuxCpPlugin
+	MplsPlugin       *mplsplugin.MplsPlugin
+	NATPlugin        *natplugin.NATPlugin
+	PolicerPlugin    *policerplugin.PolicerPlugin
+	PuntPlugin       *puntplugin.PuntPlugin
+	STNPlugin        *stnplugin.STNPlugin
+	SRPlugin         *srplugin.SRPlugin
+	WgPlugin         *wireguardplugin.WgPlugin
 }
 
 func DefaultVPP() VPP {
 	return VPP{
-		ABFPlugin:   &abfplugin.DefaultPlugin,
-		ACLPlugin:   &aclplugin.DefaultPlugin,
-		DNSPlugin:   &dnsplugin.DefaultPlugin,
-		IfPlugin:    &ifplugin.DefaultPlugin,
-		IPFIXPlugin: &ipfixplugin.DefaultPlugin,
-		IPSecPlugin: &ipsecplugin.DefaultPlugin,
-		L2Plugin:    &l2plugin.DefaultPlugin,
-		L3Plugin:    &l3plugin.DefaultPlugin,
-		NATPlugin:   &natplugin.DefaultPlugin,
-		PuntPlugin:  &puntplugin.DefaultPlugin,
-		STNPlugin:   &stnplugin.DefaultPlugin,
-		SRPlugin:    &srplugin.DefaultPlugin,
-		WgPlugin:    &wireguardplugin.DefaultPlugin,
+		ABFPlugin:        &abfplugin.DefaultPlugin,
+		ACLPlugin:        &aclplugin.DefaultPlugin,
+		BfdPlugin:        &bfdplugin.DefaultPlugin,
+		ClassifierPlugin: &classifierplugin.DefaultPlugin,
+		DNSPlugin:        &dnsplugin.DefaultPlugin,
+		IfPlugin:         &ifplugin.DefaultPlugin,
+		IPFIXPlugin:      &ipfixplugin.DefaultPlugin,
+		IPSecPlugin:      &ipsecplugin.DefaultPlugin,
+		L2Plugin:         &l2plugin.DefaultPlugin,
+		L3Plugin:         &l3plugin.DefaultPlugin,
+		LinuxCpPlugin:    &linuxcpplugin.DefaultPlugin,
+		MplsPlugin:       &mplsplugin.DefaultPlugin,
+		NATPlugin:        &natplugin.DefaultPlugin,
+		PolicerPlugin:    &policerplugin.DefaultPlugin,
+		PuntPlugin:       &puntplugin.DefaultPlugin,
+		STNPlugin:        &stnplugin.DefaultPlugin,
+		SRPlugin:         &srplugin.DefaultPlugin,
+		WgPlugin:         &wireguardplugin.DefaultPlugin,
 	}
 }
 
@@ -214,6 +235,7 @@ type Linux struct {
 	L3Plugin       *linux_l3plugin.L3Plugin
 	NSPlugin       *linux_nsplugin.NsPlugin
 	IPTablesPlugin *linux_iptablesplugin.IPTablesPlugin
+	NftablesPlugin *linux_nftablesplugin.NftablesPlugin
 }
 
 func DefaultLinux() Linux {
@@ -222,5 +244,6 @@ func DefaultLinux() Linux {
 		L3Plugin:       &linux_l3plugin.DefaultPlugin,
 		NSPlugin:       &linux_nsplugin.DefaultPlugin,
 		IPTablesPlugin: &linux_iptablesplugin.DefaultPlugin,
+		NftablesPlugin: &linux_nftablesplugin.DefaultPlugin,
 	}
 }
@@ -17,6 +17,17 @@ type SyslogSenderKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type SyslogSenderBatchOp struct {
+	Key         string
+	Value       *vpp_syslog.Sender
+	PrevValue   *vpp_syslog.Sender
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type SyslogSenderDescriptor struct {
@@ -33,11 +44,15 @@ type SyslogSenderDescriptor struct {
 	Delete               func(key string, value *vpp_syslog.Sender, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_syslog.Sender, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_syslog.Sender, metadata interface{}) bool
+	CreateBatch          func(ops []*SyslogSenderBatchOp) error
+	UpdateBatch          func(ops []*SyslogSenderBatchOp) error
+	DeleteBatch          func(ops []*SyslogSenderBatchOp) error
 	Retrieve             func(correlate []SyslogSenderKVWithMetadata) ([]SyslogSenderKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_syslog.Sender) []KeyValuePair
 	Dependencies         func(key string, value *vpp_syslog.Sender) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewSyslogSenderDescriptor(typedDescriptor *SyslogSenderDescriptor) *KVDescr
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewSyslogSenderDescriptor(typedDescriptor *SyslogSenderDescriptor) *KVDescr
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *SyslogSenderDescriptorAdapter) UpdateWithRecreate(key string, oldValue
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *SyslogSenderDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castSyslogSenderBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copySyslogSenderBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SyslogSenderDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castSyslogSenderBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copySyslogSenderBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SyslogSenderDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castSyslogSenderBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copySyslogSenderBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *SyslogSenderDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []SyslogSenderKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castSyslogSenderValue(key string, value proto.Message) (*vpp_syslog.Sender,
 	return typedValue, nil
 }
 
+func castSyslogSenderBatchOps(ops []*BatchOp, withPrevValue bool) []*SyslogSenderBatchOp {
+	var typedOps []*SyslogSenderBatchOp
+	for _, op := range ops {
+		typedValue, err := castSyslogSenderValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_syslog.Sender
+		if withPrevValue {
+			typedPrevValue, err = castSyslogSenderValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castSyslogSenderMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &SyslogSenderBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copySyslogSenderBatchResults(ops []*BatchOp, typedOps []*SyslogSenderBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castSyslogSenderMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type BDInterfaceKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type BDInterfaceBatchOp struct {
+	Key         string
+	Value       *mock_l2.BridgeDomain_Interface
+	PrevValue   *mock_l2.BridgeDomain_Interface
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type BDInterfaceDescriptor struct {
@@ -33,11 +44,15 @@ type BDInterfaceDescriptor struct {
 	Delete               func(key string, value *mock_l2.BridgeDomain_Interface, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *mock_l2.BridgeDomain_Interface, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *mock_l2.BridgeDomain_Interface, metadata interface{}) bool
+	CreateBatch          func(ops []*BDInterfaceBatchOp) error
+	UpdateBatch          func(ops []*BDInterfaceBatchOp) error
+	DeleteBatch          func(ops []*BDInterfaceBatchOp) error
 	Retrieve             func(correlate []BDInterfaceKVWithMetadata) ([]BDInterfaceKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *mock_l2.BridgeDomain_Interface) []KeyValuePair
 	Dependencies         func(key string, value *mock_l2.BridgeDomain_Interface) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewBDInterfaceDescriptor(typedDescriptor *BDInterfaceDescriptor) *KVDescrip
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewBDInterfaceDescriptor(typedDescriptor *BDInterfaceDescriptor) *KVDescrip
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *BDInterfaceDescriptorAdapter) UpdateWithRecreate(key string, oldValue,
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *BDInterfaceDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castBDInterfaceBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyBDInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *BDInterfaceDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castBDInterfaceBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyBDInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *BDInterfaceDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castBDInterfaceBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyBDInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *BDInterfaceDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []BDInterfaceKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castBDInterfaceValue(key string, value proto.Message) (*mock_l2.BridgeDomai
 	return typedValue, nil
 }
 
+func castBDInterfaceBatchOps(ops []*BatchOp, withPrevValue bool) []*BDInterfaceBatchOp {
+	var typedOps []*BDInterfaceBatchOp
+	for _, op := range ops {
+		typedValue, err := castBDInterfaceValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *mock_l2.BridgeDomain_Interface
+		if withPrevValue {
+			typedPrevValue, err = castBDInterfaceValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castBDInterfaceMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &BDInterfaceBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyBDInterfaceBatchResults(ops []*BatchOp, typedOps []*BDInterfaceBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castBDInterfaceMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -18,6 +18,17 @@ type BridgeDomainKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type BridgeDomainBatchOp struct {
+	Key         string
+	Value       *mock_l2.BridgeDomain
+	PrevValue   *mock_l2.BridgeDomain
+	Metadata    *idxvpp.OnlyIndex
+	NewMetadata *idxvpp.OnlyIndex
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type BridgeDomainDescriptor struct {
@@ -34,11 +45,15 @@ type BridgeDomainDescriptor struct {
 	Delete               func(key string, value *mock_l2.BridgeDomain, metadata *idxvpp.OnlyIndex) error
 	Update               func(key string, oldValue, newValue *mock_l2.BridgeDomain, oldMetadata *idxvpp.OnlyIndex) (newMetadata *idxvpp.OnlyIndex, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *mock_l2.BridgeDomain, metadata *idxvpp.OnlyIndex) bool
+	CreateBatch          func(ops []*BridgeDomainBatchOp) error
+	UpdateBatch          func(ops []*BridgeDomainBatchOp) error
+	DeleteBatch          func(ops []*BridgeDomainBatchOp) error
 	Retrieve             func(correlate []BridgeDomainKVWithMetadata) ([]BridgeDomainKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *mock_l2.BridgeDomain) []KeyValuePair
 	Dependencies         func(key string, value *mock_l2.BridgeDomain) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -59,6 +74,7 @@ func NewBridgeDomainDescriptor(typedDescriptor *BridgeDomainDescriptor) *KVDescr
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -78,6 +94,15 @@ func NewBridgeDomainDescriptor(typedDescriptor *BridgeDomainDescriptor) *KVDescr
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -159,6 +184,27 @@ func (da *BridgeDomainDescriptorAdapter) UpdateWithRecreate(key string, oldValue
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *BridgeDomainDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castBridgeDomainBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyBridgeDomainBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *BridgeDomainDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castBridgeDomainBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyBridgeDomainBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *BridgeDomainDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castBridgeDomainBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyBridgeDomainBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *BridgeDomainDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []BridgeDomainKVWithMetadata
 	for _, kvpair := range correlate {
@@ -222,6 +268,50 @@ func castBridgeDomainValue(key string, value proto.Message) (*mock_l2.BridgeDoma
 	return typedValue, nil
 }
 
+func castBridgeDomainBatchOps(ops []*BatchOp, withPrevValue bool) []*BridgeDomainBatchOp {
+	var typedOps []*BridgeDomainBatchOp
+	for _, op := range ops {
+		typedValue, err := castBridgeDomainValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *mock_l2.BridgeDomain
+		if withPrevValue {
+			typedPrevValue, err = castBridgeDomainValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castBridgeDomainMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &BridgeDomainBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyBridgeDomainBatchResults(ops []*BatchOp, typedOps []*BridgeDomainBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castBridgeDomainMetadata(key string, metadata Metadata) (*idxvpp.OnlyIndex, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type FIBKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type FIBBatchOp struct {
+	Key         string
+	Value       *mock_l2.FIBEntry
+	PrevValue   *mock_l2.FIBEntry
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type FIBDescriptor struct {
@@ -33,11 +44,15 @@ type FIBDescriptor struct {
 	Delete               func(key string, value *mock_l2.FIBEntry, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *mock_l2.FIBEntry, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *mock_l2.FIBEntry, metadata interface{}) bool
+	CreateBatch          func(ops []*FIBBatchOp) error
+	UpdateBatch          func(ops []*FIBBatchOp) error
+	DeleteBatch          func(ops []*FIBBatchOp) error
 	Retrieve             func(correlate []FIBKVWithMetadata) ([]FIBKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *mock_l2.FIBEntry) []KeyValuePair
 	Dependencies         func(key string, value *mock_l2.FIBEntry) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewFIBDescriptor(typedDescriptor *FIBDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewFIBDescriptor(typedDescriptor *FIBDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *FIBDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newValu
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *FIBDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castFIBBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyFIBBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *FIBDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castFIBBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyFIBBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *FIBDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castFIBBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyFIBBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *FIBDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []FIBKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castFIBValue(key string, value proto.Message) (*mock_l2.FIBEntry, error) {
 	return typedValue, nil
 }
 
+func castFIBBatchOps(ops []*BatchOp, withPrevValue bool) []*FIBBatchOp {
+	var typedOps []*FIBBatchOp
+	for _, op := range ops {
+		typedValue, err := castFIBValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *mock_l2.FIBEntry
+		if withPrevValue {
+			typedPrevValue, err = castFIBValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castFIBMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &FIBBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyFIBBatchResults(ops []*BatchOp, typedOps []*FIBBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castFIBMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type SkeletonKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type SkeletonBatchOp struct {
+	Key         string
+	Value       *model.ValueSkeleton
+	PrevValue   *model.ValueSkeleton
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type SkeletonDescriptor struct {
@@ -33,11 +44,15 @@ type SkeletonDescriptor struct {
 	Delete               func(key string, value *model.ValueSkeleton, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *model.ValueSkeleton, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *model.ValueSkeleton, metadata interface{}) bool
+	CreateBatch          func(ops []*SkeletonBatchOp) error
+	UpdateBatch          func(ops []*SkeletonBatchOp) error
+	DeleteBatch          func(ops []*SkeletonBatchOp) error
 	Retrieve             func(correlate []SkeletonKVWithMetadata) ([]SkeletonKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *model.ValueSkeleton) []KeyValuePair
 	Dependencies         func(key string, value *model.ValueSkeleton) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewSkeletonDescriptor(typedDescriptor *SkeletonDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewSkeletonDescriptor(typedDescriptor *SkeletonDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *SkeletonDescriptorAdapter) UpdateWithRecreate(key string, oldValue, ne
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *SkeletonDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castSkeletonBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copySkeletonBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SkeletonDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castSkeletonBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copySkeletonBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SkeletonDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castSkeletonBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copySkeletonBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *SkeletonDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []SkeletonKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castSkeletonValue(key string, value proto.Message) (*model.ValueSkeleton, e
 	return typedValue, nil
 }
 
+func castSkeletonBatchOps(ops []*BatchOp, withPrevValue bool) []*SkeletonBatchOp {
+	var typedOps []*SkeletonBatchOp
+	for _, op := range ops {
+		typedValue, err := castSkeletonValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *model.ValueSkeleton
+		if withPrevValue {
+			typedPrevValue, err = castSkeletonValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castSkeletonMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &SkeletonBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copySkeletonBatchResults(ops []*BatchOp, typedOps []*SkeletonBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castSkeletonMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type InterfaceKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type InterfaceBatchOp struct {
+	Key         string
+	Value       *model.Interface
+	PrevValue   *model.Interface
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type InterfaceDescriptor struct {
@@ -33,11 +44,15 @@ type InterfaceDescriptor struct {
 	Delete               func(key string, value *model.Interface, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *model.Interface, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *model.Interface, metadata interface{}) bool
+	CreateBatch          func(ops []*InterfaceBatchOp) error
+	UpdateBatch          func(ops []*InterfaceBatchOp) error
+	DeleteBatch          func(ops []*InterfaceBatchOp) error
 	Retrieve             func(correlate []InterfaceKVWithMetadata) ([]InterfaceKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *model.Interface) []KeyValuePair
 	Dependencies         func(key string, value *model.Interface) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewInterfaceDescriptor(typedDescriptor *InterfaceDescriptor) *KVDescriptor
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewInterfaceDescriptor(typedDescriptor *InterfaceDescriptor) *KVDescriptor
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *InterfaceDescriptorAdapter) UpdateWithRecreate(key string, oldValue, n
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *InterfaceDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castInterfaceBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *InterfaceDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castInterfaceBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *InterfaceDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castInterfaceBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *InterfaceDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []InterfaceKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castInterfaceValue(key string, value proto.Message) (*model.Interface, erro
 	return typedValue, nil
 }
 
+func castInterfaceBatchOps(ops []*BatchOp, withPrevValue bool) []*InterfaceBatchOp {
+	var typedOps []*InterfaceBatchOp
+	for _, op := range ops {
+		typedValue, err := castInterfaceValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *model.Interface
+		if withPrevValue {
+			typedPrevValue, err = castInterfaceValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castInterfaceMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &InterfaceBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyInterfaceBatchResults(ops []*BatchOp, typedOps []*InterfaceBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castInterfaceMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
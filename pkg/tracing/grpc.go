@@ -0,0 +1,122 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor records a server span for every unary RPC.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (resp interface{}, err error) {
+	service, method := splitMethodName(info.FullMethod)
+	ctx, span := StartGRPCServer(ctx, service, method)
+	defer func() { End(span, err) }()
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor records a server span for every streaming RPC.
+func StreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) (err error) {
+	service, method := splitMethodName(info.FullMethod)
+	ctx, span := StartGRPCServer(stream.Context(), service, method)
+	defer func() { End(span, err) }()
+	return handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+}
+
+// ServiceRegistrar returns registrar which registers the services to <registrar>
+// with every RPC intercepted by UnaryServerInterceptor or StreamServerInterceptor.
+// The gRPC server of the agent is created by the infra plugin, the interceptors
+// are therefore applied to the services registered by the agent this way:
+//
+//	pb.RegisterServiceServer(tracing.ServiceRegistrar(grpcServer), svc)
+func ServiceRegistrar(registrar grpc.ServiceRegistrar) grpc.ServiceRegistrar {
+	return serviceRegistrar{registrar}
+}
+
+type serviceRegistrar struct {
+	grpc.ServiceRegistrar
+}
+
+func (r serviceRegistrar) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	r.ServiceRegistrar.RegisterService(ServiceDesc(desc), impl)
+}
+
+// ServiceDesc returns a copy of the service description with every RPC
+// of the service intercepted by UnaryServerInterceptor or StreamServerInterceptor.
+// Interceptor of the server (if any) is still called, inside the span.
+func ServiceDesc(desc *grpc.ServiceDesc) *grpc.ServiceDesc {
+	traced := *desc
+	traced.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, m := range desc.Methods {
+		handler := m.Handler
+		traced.Methods[i] = grpc.MethodDesc{
+			MethodName: m.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error,
+				interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				return handler(srv, ctx, dec, chainUnary(UnaryServerInterceptor, interceptor))
+			},
+		}
+	}
+	traced.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for i, s := range desc.Streams {
+		handler := s.Handler
+		info := &grpc.StreamServerInfo{
+			FullMethod:     "/" + desc.ServiceName + "/" + s.StreamName,
+			IsClientStream: s.ClientStreams,
+			IsServerStream: s.ServerStreams,
+		}
+		traced.Streams[i] = s
+		traced.Streams[i].Handler = func(srv interface{}, stream grpc.ServerStream) error {
+			return StreamServerInterceptor(srv, stream, info, handler)
+		}
+	}
+	return &traced
+}
+
+// chainUnary returns interceptor calling <outer> and then <inner> (if not nil).
+func chainUnary(outer, inner grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	if inner == nil {
+		return outer
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		return outer(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return inner(ctx, req, info, handler)
+		})
+	}
+}
+
+// splitMethodName splits full RPC method name ("/service/method").
+func splitMethodName(fullMethod string) (service, method string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+// serverStream overrides context of the stream to carry the span.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
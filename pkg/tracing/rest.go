@@ -0,0 +1,42 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/unrolled/render"
+	"go.ligato.io/cn-infra/v2/rpc/rest"
+)
+
+// HTTPHandlers returns HTTP handlers registry wrapping every handler
+// registered through it with HTTPMiddleware.
+func HTTPHandlers(handlers rest.HTTPHandlers) rest.HTTPHandlers {
+	if handlers == nil {
+		return nil
+	}
+	return &httpHandlers{HTTPHandlers: handlers}
+}
+
+type httpHandlers struct {
+	rest.HTTPHandlers
+}
+
+func (h *httpHandlers) RegisterHTTPHandler(path string, provider rest.HandlerProvider, methods ...string) *mux.Route {
+	return h.HTTPHandlers.RegisterHTTPHandler(path, func(formatter *render.Render) http.HandlerFunc {
+		return HTTPMiddleware(path, provider(formatter)).ServeHTTP
+	}, methods...)
+}
@@ -0,0 +1,181 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func setupRecorder() *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return recorder
+}
+
+func TestStartGRPCServer(t *testing.T) {
+	RegisterTestingT(t)
+	recorder := setupRecorder()
+
+	// span started by the client
+	clientCtx, clientSpan := Start(context.Background(), "client")
+	md := metadata.MD{}
+	otel.GetTextMapPropagator().Inject(clientCtx, metadataCarrier(md))
+	Expect(md.Get("traceparent")).To(HaveLen(1))
+
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	ctx, span := StartGRPCServer(ctx, "ligato.configurator.ConfiguratorService", "Update")
+	_, childSpan := Start(ctx, "child")
+	End(childSpan, errors.New("failure"))
+	End(span, nil)
+	clientSpan.End()
+
+	spans := recorder.Ended()
+	Expect(spans).To(HaveLen(3))
+	child, server := spans[0], spans[1]
+
+	Expect(server.Name()).To(Equal("ligato.configurator.ConfiguratorService/Update"))
+	Expect(server.SpanKind()).To(Equal(trace.SpanKindServer))
+	Expect(server.SpanContext().TraceID()).To(Equal(clientSpan.SpanContext().TraceID()))
+	Expect(server.Parent().SpanID()).To(Equal(clientSpan.SpanContext().SpanID()))
+	Expect(server.Status().Code).To(Equal(codes.Unset))
+
+	Expect(child.Parent().SpanID()).To(Equal(server.SpanContext().SpanID()))
+	Expect(child.Status().Code).To(Equal(codes.Error))
+	Expect(child.Status().Description).To(Equal("failure"))
+	Expect(child.Events()).To(HaveLen(1)) // recorded error
+}
+
+func TestStartHTTPServer(t *testing.T) {
+	RegisterTestingT(t)
+	recorder := setupRecorder()
+
+	clientCtx, clientSpan := Start(context.Background(), "client")
+	req := httptest.NewRequest("PUT", "/configuration?replace", nil)
+	otel.GetTextMapPropagator().Inject(clientCtx, propagation.HeaderCarrier(req.Header))
+
+	_, span := StartHTTPServer(context.Background(), req, "/configuration")
+	End(span, nil)
+	clientSpan.End()
+
+	spans := recorder.Ended()
+	Expect(spans).To(HaveLen(2))
+	server := spans[0]
+	Expect(server.Name()).To(Equal("PUT /configuration"))
+	Expect(server.SpanContext().TraceID()).To(Equal(clientSpan.SpanContext().TraceID()))
+	Expect(server.Parent().SpanID()).To(Equal(clientSpan.SpanContext().SpanID()))
+}
+
+func TestServiceDesc(t *testing.T) {
+	RegisterTestingT(t)
+	recorder := setupRecorder()
+
+	var handlerSpan trace.Span
+	desc := &grpc.ServiceDesc{
+		ServiceName: "ligato.test.TestService",
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Get",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error,
+				interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					handlerSpan = trace.SpanFromContext(ctx)
+					return nil, errors.New("failure")
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/ligato.test.TestService/Get"}
+				return interceptor(ctx, nil, info, handler)
+			},
+		}},
+		Streams: []grpc.StreamDesc{{
+			StreamName:    "Watch",
+			ServerStreams: true,
+			Handler: func(srv interface{}, stream grpc.ServerStream) error {
+				handlerSpan = trace.SpanFromContext(stream.Context())
+				return nil
+			},
+		}},
+	}
+	traced := ServiceDesc(desc)
+	Expect(traced.ServiceName).To(Equal(desc.ServiceName))
+
+	// interceptor of the server is called inside the span
+	var serverIntercepted bool
+	serverInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		serverIntercepted = true
+		Expect(trace.SpanFromContext(ctx).SpanContext().IsValid()).To(BeTrue())
+		return handler(ctx, req)
+	}
+	_, err := traced.Methods[0].Handler(nil, context.Background(), nil, serverInterceptor)
+	Expect(err).To(HaveOccurred())
+	Expect(serverIntercepted).To(BeTrue())
+
+	spans := recorder.Ended()
+	Expect(spans).To(HaveLen(1))
+	Expect(spans[0].Name()).To(Equal("ligato.test.TestService/Get"))
+	Expect(spans[0].SpanContext()).To(Equal(handlerSpan.SpanContext()))
+	Expect(spans[0].Status().Code).To(Equal(codes.Error))
+
+	err = traced.Streams[0].Handler(nil, &testServerStream{ctx: context.Background()})
+	Expect(err).ToNot(HaveOccurred())
+	spans = recorder.Ended()
+	Expect(spans).To(HaveLen(2))
+	Expect(spans[1].Name()).To(Equal("ligato.test.TestService/Watch"))
+	Expect(spans[1].SpanContext()).To(Equal(handlerSpan.SpanContext()))
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	RegisterTestingT(t)
+	recorder := setupRecorder()
+
+	var handlerSpan trace.Span
+	handler := HTTPMiddleware("/items/{id}", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		handlerSpan = trace.SpanFromContext(req.Context())
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/items/1", nil))
+	Expect(w.Code).To(Equal(http.StatusInternalServerError))
+
+	spans := recorder.Ended()
+	Expect(spans).To(HaveLen(1))
+	Expect(spans[0].Name()).To(Equal("GET /items/{id}"))
+	Expect(spans[0].SpanContext()).To(Equal(handlerSpan.SpanContext()))
+	Expect(spans[0].Status().Code).To(Equal(codes.Error))
+	Expect(spans[0].Attributes()).To(ContainElement(semconv.HTTPStatusCodeKey.Int(http.StatusInternalServerError)))
+}
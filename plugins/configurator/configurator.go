@@ -18,7 +18,6 @@ import (
 	"context"
 	"fmt"
 	"reflect"
-	"runtime/trace"
 	"strconv"
 	"time"
 
@@ -81,10 +80,6 @@ func (svc *configuratorServer) Get(context.Context, *pb.GetRequest) (*pb.GetResp
 
 // Update adds configuration data present in data request to the VPP/Linux
 func (svc *configuratorServer) Update(ctx context.Context, req *pb.UpdateRequest) (*pb.UpdateResponse, error) {
-	ctx, task := trace.NewTask(ctx, "grpc.Update")
-	defer task.End()
-	trace.Logf(ctx, "updateData", "%+v", req)
-
 	defer trackOperation("Update")()
 
 	protos := util.ExtractProtos(
@@ -110,8 +105,14 @@ func (svc *configuratorServer) Update(ctx context.Context, req *pb.UpdateRequest
 	}
 
 	if req.FullResync {
+		if req.Atomic {
+			return nil, status.Error(codes.InvalidArgument, "atomic update cannot be combined with full resync")
+		}
 		ctx = kvs.WithResync(ctx, kvs.FullResync, true)
 	}
+	if req.Atomic {
+		ctx = kvs.WithRevert(ctx)
+	}
 
 	md, hasMeta := metadata.FromIncomingContext(ctx)
 	if hasMeta && len(md["datasrc"]) == 1 {
@@ -119,6 +120,22 @@ func (svc *configuratorServer) Update(ctx context.Context, req *pb.UpdateRequest
 	} else {
 		ctx = contextdecorator.DataSrcContext(ctx, "grpc")
 	}
+	if hasMeta && len(md["author"]) == 1 {
+		ctx = contextdecorator.AuthorContext(ctx, md["author"][0])
+	}
+	if hasMeta && len(md["description"]) == 1 {
+		ctx = kvs.WithDescription(ctx, md["description"][0])
+	}
+	ctx = kvs.WithPriority(ctx, kvs.TxnPriorityFromProto(req.Priority))
+	if req.DryRun {
+		txn, err := svc.dispatch.PlanData(ctx, kvPairs)
+		if err != nil {
+			st := status.New(codes.FailedPrecondition, err.Error())
+			return nil, st.Err()
+		}
+		svc.log.Debugf("config update planned with %d operations", len(txn.Planned))
+		return &pb.UpdateResponse{Plan: txn.TxnPlan()}, nil
+	}
 	results, err := svc.dispatch.PushData(ctx, kvPairs, nil)
 
 	header := map[string]string{}
@@ -129,6 +146,13 @@ func (svc *configuratorServer) Update(ctx context.Context, req *pb.UpdateRequest
 		logging.Warnf("sending grpc header failed: %v", err)
 	}
 	if err != nil {
+		if req.Atomic && results != nil {
+			svc.log.Debugf("atomic config update rolled back")
+			return &pb.UpdateResponse{
+				Results:    orchestrator.UpdateResults(results),
+				RolledBack: true,
+			}, nil
+		}
 		st := status.New(codes.FailedPrecondition, err.Error())
 		return nil, st.Err()
 	}
@@ -160,7 +184,11 @@ func (svc *configuratorServer) Update(ctx context.Context, req *pb.UpdateRequest
 
 	svc.log.Debugf("config update finished with %d results", len(results))
 
-	return &pb.UpdateResponse{}, nil
+	resp := &pb.UpdateResponse{}
+	if req.Atomic {
+		resp.Results = orchestrator.UpdateResults(results)
+	}
+	return resp, nil
 }
 
 // Delete removes configuration data present in data request from the VPP/linux
@@ -195,6 +223,22 @@ func (svc *configuratorServer) Delete(ctx context.Context, req *pb.DeleteRequest
 	} else {
 		ctx = contextdecorator.DataSrcContext(ctx, "grpc")
 	}
+	if hasMeta && len(md["author"]) == 1 {
+		ctx = contextdecorator.AuthorContext(ctx, md["author"][0])
+	}
+	if hasMeta && len(md["description"]) == 1 {
+		ctx = kvs.WithDescription(ctx, md["description"][0])
+	}
+	ctx = kvs.WithPriority(ctx, kvs.TxnPriorityFromProto(req.Priority))
+	if req.DryRun {
+		txn, err := svc.dispatch.PlanData(ctx, kvPairs)
+		if err != nil {
+			st := status.New(codes.FailedPrecondition, err.Error())
+			return nil, st.Err()
+		}
+		svc.log.Debugf("config delete planned with %d operations", len(txn.Planned))
+		return &pb.DeleteResponse{Plan: txn.TxnPlan()}, nil
+	}
 	results, err := svc.dispatch.PushData(ctx, kvPairs, nil)
 
 	header := map[string]string{}
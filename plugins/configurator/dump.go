@@ -17,6 +17,7 @@ package configurator
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 
 	"go.ligato.io/cn-infra/v2/logging"
@@ -25,11 +26,16 @@ import (
 	l3linuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin/linuxcalls"
 	abfvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin/vppcalls"
 	aclvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls"
+	bfdvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	classifiervppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls"
 	ifvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
 	ipsecvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
 	l2vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
 	l3vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	linuxcpvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls"
+	mplsvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/vppcalls"
 	natvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	policervppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin/vppcalls"
 	wireguardvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/vppcalls"
 	rpc "go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
@@ -37,11 +43,16 @@ import (
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
 	vpp_abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+	vpp_classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
 	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 	vpp_ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
 	vpp_l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 	vpp_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	vpp_linuxcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/linuxcp"
+	vpp_mpls "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/mpls"
 	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+	vpp_policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
 	vpp_punt "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/punt"
 	vpp_wg "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/wireguard"
 )
@@ -57,11 +68,18 @@ type dumpService struct {
 	l3Handler    l3vppcalls.L3VppAPI
 	ipsecHandler ipsecvppcalls.IPSecVPPRead
 	// plugins
-	aclHandler       aclvppcalls.ACLVppRead
-	abfHandler       abfvppcalls.ABFVppRead
-	natHandler       natvppcalls.NatVppRead
-	puntHandler      vppcalls.PuntVPPRead
-	wireguardHandler wireguardvppcalls.WgVppRead
+	aclHandler        aclvppcalls.ACLVppRead
+	abfHandler        abfvppcalls.ABFVppRead
+	natHandler        natvppcalls.NatVppRead
+	nat64Handler      natvppcalls.Nat64VppRead
+	det44Handler      natvppcalls.Det44VppRead
+	mplsHandler       mplsvppcalls.MplsVppRead
+	policerHandler    policervppcalls.PolicerVppRead
+	bfdHandler        bfdvppcalls.BfdVppRead
+	linuxCpHandler    linuxcpvppcalls.LinuxCpVppRead
+	classifierHandler classifiervppcalls.ClassifierVppRead
+	puntHandler       vppcalls.PuntVPPRead
+	wireguardHandler  wireguardvppcalls.WgVppRead
 
 	// Linux handlers
 	linuxIfHandler iflinuxcalls.NetlinkAPIRead
@@ -114,6 +132,71 @@ func (svc *dumpService) Dump(ctx context.Context, req *rpc.DumpRequest) (*rpc.Du
 		svc.log.Errorf("DumpARPs failed: %v", err)
 		return nil, err
 	}
+	dump.VppConfig.MplsTables, err = svc.DumpMplsTables()
+	if err != nil {
+		svc.log.Errorf("DumpMplsTables failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.MplsLocalLabels, err = svc.DumpMplsLocalLabels()
+	if err != nil {
+		svc.log.Errorf("DumpMplsLocalLabels failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Policers, err = svc.DumpPolicers()
+	if err != nil {
+		svc.log.Errorf("DumpPolicers failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.QosRecords, err = svc.DumpQosRecords()
+	if err != nil {
+		svc.log.Errorf("DumpQosRecords failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.QosEgressMaps, err = svc.DumpQosEgressMaps()
+	if err != nil {
+		svc.log.Errorf("DumpQosEgressMaps failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.QosMarks, err = svc.DumpQosMarks()
+	if err != nil {
+		svc.log.Errorf("DumpQosMarks failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.BfdSessions, err = svc.DumpBfdSessions()
+	if err != nil {
+		svc.log.Errorf("DumpBfdSessions failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.BfdAuthKeys, err = svc.DumpBfdAuthKeys()
+	if err != nil {
+		svc.log.Errorf("DumpBfdAuthKeys failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.LinuxcpGlobal, err = svc.DumpLinuxCpGlobal()
+	if err != nil {
+		svc.log.Errorf("DumpLinuxCpGlobal failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.LinuxcpPairs, err = svc.DumpLinuxCpPairs()
+	if err != nil {
+		svc.log.Errorf("DumpLinuxCpPairs failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.ClassifyTables, err = svc.DumpClassifyTables()
+	if err != nil {
+		svc.log.Errorf("DumpClassifyTables failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.ClassifySessions, err = svc.DumpClassifySessions()
+	if err != nil {
+		svc.log.Errorf("DumpClassifySessions failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.ClassifyInterfaces, err = svc.DumpClassifyInterfaces()
+	if err != nil {
+		svc.log.Errorf("DumpClassifyInterfaces failed: %v", err)
+		return nil, err
+	}
 	dump.VppConfig.IpsecSpds, err = svc.DumpIPSecSPDs()
 	if err != nil {
 		svc.log.Errorf("DumpIPSecSPDs failed: %v", err)
@@ -159,6 +242,41 @@ func (svc *dumpService) Dump(ctx context.Context, req *rpc.DumpRequest) (*rpc.Du
 		svc.log.Errorf("DumpNAT44AddressPools failed: %v", err)
 		return nil, err
 	}
+	dump.VppConfig.Nat64Prefixes, err = svc.DumpNAT64Prefixes()
+	if err != nil {
+		svc.log.Errorf("DumpNAT64Prefixes failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Nat64Pools, err = svc.DumpNAT64AddressPools()
+	if err != nil {
+		svc.log.Errorf("DumpNAT64AddressPools failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Nat64Interfaces, err = svc.DumpNAT64Interfaces()
+	if err != nil {
+		svc.log.Errorf("DumpNAT64Interfaces failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Nat64StaticBibs, err = svc.DumpNAT64StaticBibs()
+	if err != nil {
+		svc.log.Errorf("DumpNAT64StaticBibs failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Det44Mappings, err = svc.DumpDET44Mappings()
+	if err != nil {
+		svc.log.Errorf("DumpDET44Mappings failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Det44Interfaces, err = svc.DumpDET44Interfaces()
+	if err != nil {
+		svc.log.Errorf("DumpDET44Interfaces failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Ip6NdProxies, err = svc.DumpIP6NDProxies(ctx)
+	if err != nil {
+		svc.log.Errorf("DumpIP6NDProxies failed: %v", err)
+		return nil, err
+	}
 	dump.VppConfig.PuntTohosts, err = svc.DumpPunt()
 	if err != nil {
 		svc.log.Errorf("DumpPunt failed: %v", err)
@@ -217,6 +335,38 @@ func (svc *dumpService) DumpInterfaces(ctx context.Context) (ifs []*vpp_interfac
 	return ifs, nil
 }
 
+// DumpIP6NDProxies dumps IPv6 ND proxy entries. Router advertisement
+// configuration cannot be read back from VPP and therefore is not dumped.
+func (svc *dumpService) DumpIP6NDProxies(ctx context.Context) (proxies []*vpp_interfaces.IP6NDProxy, err error) {
+	if svc.ifHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	entries, err := svc.ifHandler.DumpIP6ndProxies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	ifDetails, err := svc.ifHandler.DumpInterfaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		iface, ok := ifDetails[entry.SwIfIndex]
+		if !ok {
+			continue
+		}
+		proxies = append(proxies, &vpp_interfaces.IP6NDProxy{
+			Interface: iface.Interface.Name,
+			Address:   entry.Address,
+		})
+	}
+	return proxies, nil
+}
+
 // DumpIPSecSPDs reads IPSec SPD and returns them as an *IPSecSPDResponse. If reading ends up with error,
 // only error is send back in response
 func (svc *dumpService) DumpIPSecSPDs() (spds []*vpp_ipsec.SecurityPolicyDatabase, err error) {
@@ -445,6 +595,90 @@ func (svc *dumpService) DumpNAT44AddressPools() (natPools []*vpp_nat.Nat44Addres
 	return natPools, nil
 }
 
+// DumpNAT64Prefixes dumps NAT64 prefixes
+func (svc *dumpService) DumpNAT64Prefixes() (prefixes []*vpp_nat.Nat64Prefix, err error) {
+	if svc.nat64Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	prefixes, err = svc.nat64Handler.Nat64PrefixesDump()
+	if err != nil {
+		return nil, err
+	}
+	return prefixes, nil
+}
+
+// DumpNAT64AddressPools dumps NAT64 address pools
+func (svc *dumpService) DumpNAT64AddressPools() (natPools []*vpp_nat.Nat64AddressPool, err error) {
+	if svc.nat64Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	natPools, err = svc.nat64Handler.Nat64AddressPoolsDump()
+	if err != nil {
+		return nil, err
+	}
+	return natPools, nil
+}
+
+// DumpNAT64Interfaces dumps NAT64 interfaces
+func (svc *dumpService) DumpNAT64Interfaces() (natIfs []*vpp_nat.Nat64Interface, err error) {
+	if svc.nat64Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	natIfs, err = svc.nat64Handler.Nat64InterfacesDump()
+	if err != nil {
+		return nil, err
+	}
+	return natIfs, nil
+}
+
+// DumpNAT64StaticBibs dumps static NAT64 BIB entries
+func (svc *dumpService) DumpNAT64StaticBibs() (bibs []*vpp_nat.Nat64StaticBib, err error) {
+	if svc.nat64Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	bibs, err = svc.nat64Handler.Nat64StaticBibsDump()
+	if err != nil {
+		return nil, err
+	}
+	return bibs, nil
+}
+
+// DumpDET44Mappings dumps DET44 mappings
+func (svc *dumpService) DumpDET44Mappings() (mappings []*vpp_nat.Det44Mapping, err error) {
+	if svc.det44Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	mappings, err = svc.det44Handler.Det44MappingsDump()
+	if err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
+
+// DumpDET44Interfaces dumps DET44 interfaces
+func (svc *dumpService) DumpDET44Interfaces() (natIfs []*vpp_nat.Det44Interface, err error) {
+	if svc.det44Handler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	natIfs, err = svc.det44Handler.Det44InterfacesDump()
+	if err != nil {
+		return nil, err
+	}
+	return natIfs, nil
+}
+
 // DumpPunt reads VPP Punt socket registrations and returns them as an *PuntResponse.
 func (svc *dumpService) DumpPunt() (punts []*vpp_punt.ToHost, err error) {
 	if svc.puntHandler == nil {
@@ -478,6 +712,200 @@ func (svc *dumpService) DumpPuntExceptions() (punts []*vpp_punt.Exception, err e
 	return punts, nil
 }
 
+// DumpMplsTables reads MPLS tables and returns them as a list.
+func (svc *dumpService) DumpMplsTables() ([]*vpp_mpls.MplsTable, error) {
+	if svc.mplsHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.mplsHandler.DumpMplsTables()
+}
+
+// DumpMplsLocalLabels reads MPLS local label entries and returns them as a list.
+func (svc *dumpService) DumpMplsLocalLabels() ([]*vpp_mpls.LocalLabel, error) {
+	if svc.mplsHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.mplsHandler.DumpLocalLabels()
+}
+
+// DumpPolicers reads policers and returns them as a list.
+// Interfaces the policers are applied to are not included.
+func (svc *dumpService) DumpPolicers() (policers []*vpp_policer.Policer, err error) {
+	if svc.policerHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	policerDetails, err := svc.policerHandler.DumpPolicers()
+	if err != nil {
+		return nil, err
+	}
+	for _, details := range policerDetails {
+		policers = append(policers, details.Policer)
+	}
+	return policers, nil
+}
+
+// DumpQosRecords reads interfaces with enabled QoS recording and returns them as a list.
+func (svc *dumpService) DumpQosRecords() ([]*vpp_policer.QosRecord, error) {
+	if svc.policerHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.policerHandler.DumpQosRecords()
+}
+
+// DumpQosEgressMaps reads QoS egress maps and returns them as a list.
+func (svc *dumpService) DumpQosEgressMaps() ([]*vpp_policer.QosEgressMap, error) {
+	if svc.policerHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.policerHandler.DumpQosEgressMaps()
+}
+
+// DumpQosMarks reads interfaces with enabled QoS marking and returns them as a list.
+func (svc *dumpService) DumpQosMarks() ([]*vpp_policer.QosMark, error) {
+	if svc.policerHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.policerHandler.DumpQosMarks()
+}
+
+// DumpBfdSessions reads BFD sessions and returns them as a list.
+func (svc *dumpService) DumpBfdSessions() (sessions []*vpp_bfd.BfdSession, err error) {
+	if svc.bfdHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	sessionDetails, err := svc.bfdHandler.DumpBfdSessions()
+	if err != nil {
+		return nil, err
+	}
+	for _, details := range sessionDetails {
+		sessions = append(sessions, details.Session)
+	}
+	return sessions, nil
+}
+
+// DumpBfdAuthKeys reads BFD authentication keys (without secrets) and returns them as a list.
+func (svc *dumpService) DumpBfdAuthKeys() ([]*vpp_bfd.BfdAuthKey, error) {
+	if svc.bfdHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	return svc.bfdHandler.DumpBfdAuthKeys()
+}
+
+// DumpLinuxCpGlobal reads global linux-cp settings.
+func (svc *dumpService) DumpLinuxCpGlobal() (*vpp_linuxcp.LinuxCpGlobal, error) {
+	if svc.linuxCpHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	netns, err := svc.linuxCpHandler.GetDefaultNetns()
+	if err != nil {
+		return nil, err
+	}
+	return &vpp_linuxcp.LinuxCpGlobal{DefaultNetns: netns}, nil
+}
+
+// DumpLinuxCpPairs reads linux-cp interface pairs and returns them as a list.
+func (svc *dumpService) DumpLinuxCpPairs() (pairs []*vpp_linuxcp.LinuxCpPair, err error) {
+	if svc.linuxCpHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	pairDetails, err := svc.linuxCpHandler.DumpLinuxCpPairs()
+	if err != nil {
+		return nil, err
+	}
+	for _, details := range pairDetails {
+		pairs = append(pairs, details.Pair)
+	}
+	return pairs, nil
+}
+
+// DumpClassifyTables reads classify tables and returns them as a list.
+// VPP does not know table names, tables are therefore named by their VPP index.
+func (svc *dumpService) DumpClassifyTables() (tables []*vpp_classifier.ClassifyTable, err error) {
+	if svc.classifierHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	tableDetails, err := svc.classifierHandler.DumpClassifyTables()
+	if err != nil {
+		return nil, err
+	}
+	for _, details := range tableDetails {
+		details.Table.Name = classifyTableName(details.Meta.Index)
+		if details.Meta.NextTableIndex != ^uint32(0) {
+			details.Table.NextTable = classifyTableName(details.Meta.NextTableIndex)
+		}
+		tables = append(tables, details.Table)
+	}
+	return tables, nil
+}
+
+// DumpClassifySessions reads sessions of all classify tables and returns them as a list.
+func (svc *dumpService) DumpClassifySessions() (sessions []*vpp_classifier.ClassifySession, err error) {
+	if svc.classifierHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	tableDetails, err := svc.classifierHandler.DumpClassifyTables()
+	if err != nil {
+		return nil, err
+	}
+	for _, details := range tableDetails {
+		tableSessions, err := svc.classifierHandler.DumpClassifySessions(details.Meta.Index)
+		if err != nil {
+			return nil, err
+		}
+		for _, session := range tableSessions {
+			session.Table = classifyTableName(details.Meta.Index)
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
+
+// DumpClassifyInterfaces reads classify tables attached to interfaces and returns them as a list.
+// Only tables classifying received packets can be dumped.
+func (svc *dumpService) DumpClassifyInterfaces() (ifaces []*vpp_classifier.ClassifyInterface, err error) {
+	if svc.classifierHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+	ifDetails, err := svc.classifierHandler.DumpClassifyInputInterfaces()
+	if err != nil {
+		return nil, err
+	}
+	for _, details := range ifDetails {
+		iface := &vpp_classifier.ClassifyInterface{
+			Interface: details.Interface,
+			Direction: vpp_classifier.ClassifyInterface_INPUT,
+		}
+		if details.IP4TableIndex != ^uint32(0) {
+			iface.Ip4Table = classifyTableName(details.IP4TableIndex)
+		}
+		if details.IP6TableIndex != ^uint32(0) {
+			iface.Ip6Table = classifyTableName(details.IP6TableIndex)
+		}
+		if details.L2TableIndex != ^uint32(0) {
+			iface.L2Table = classifyTableName(details.L2TableIndex)
+		}
+		ifaces = append(ifaces, iface)
+	}
+	return ifaces, nil
+}
+
+func classifyTableName(tableIdx uint32) string {
+	return fmt.Sprintf("table-%d", tableIdx)
+}
+
 func (svc *dumpService) DumpWgPeers() (peers []*vpp_wg.Peer, err error) {
 	if svc.wireguardHandler == nil {
 		// handler is not available
@@ -24,6 +24,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin"
@@ -39,6 +40,7 @@ func NewPlugin(opts ...Option) *Plugin {
 	p.PluginName = "configurator"
 	p.GRPCServer = &grpc.DefaultPlugin
 	p.Dispatch = &orchestrator.DefaultPlugin
+	p.Notifier = &orchestrator.DefaultPlugin
 	p.VPP = &govppmux.DefaultPlugin
 	p.ServiceLabel = &servicelabel.DefaultPlugin
 	p.AddrAlloc = &netalloc.DefaultPlugin
@@ -46,6 +48,7 @@ func NewPlugin(opts ...Option) *Plugin {
 	p.VPPIfPlugin = &ifplugin.DefaultPlugin
 	p.VPPL2Plugin = &l2plugin.DefaultPlugin
 	p.VPPL3Plugin = &l3plugin.DefaultPlugin
+	p.VPPBfdPlugin = &bfdplugin.DefaultPlugin
 	p.LinuxIfPlugin = &linuxifplugin.DefaultPlugin
 	p.NsPlugin = &nsplugin.DefaultPlugin
 
@@ -20,6 +20,7 @@ import (
 	"go.ligato.io/cn-infra/v2/servicelabel"
 	"google.golang.org/protobuf/proto"
 
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
 	iflinuxplugin "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
 	iflinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
@@ -30,6 +31,9 @@ import (
 	abfvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin"
 	aclvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin"
+	bfdvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	classifiervppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
 	ifvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
 	ipsecvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin/vppcalls"
@@ -37,10 +41,14 @@ import (
 	l2vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin"
 	l3vppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	linuxcpvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls"
+	mplsvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/vppcalls"
 	natvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	policervppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
 	puntvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin/vppcalls"
 	wireguardvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin/vppcalls"
 	pb "go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/linux"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
 )
@@ -60,6 +68,7 @@ type Deps struct {
 	infra.PluginDeps
 	GRPCServer    grpc.Server
 	Dispatch      orchestrator.Dispatcher
+	Notifier      orchestrator.Notifier
 	VPP           govppmux.API
 	ServiceLabel  servicelabel.ReaderAPI
 	AddrAlloc     netalloc.AddressAllocator
@@ -67,6 +76,7 @@ type Deps struct {
 	VPPIfPlugin   ifplugin.API
 	VPPL2Plugin   *l2plugin.L2Plugin
 	VPPL3Plugin   l3plugin.API
+	VPPBfdPlugin  bfdplugin.API
 	LinuxIfPlugin iflinuxplugin.API
 	NsPlugin      nsplugin.API
 }
@@ -85,7 +95,7 @@ func (p *Plugin) Init() error {
 
 	grpcServer := p.GRPCServer.GetServer()
 	if grpcServer != nil {
-		pb.RegisterConfiguratorServiceServer(grpcServer, &p.configurator)
+		pb.RegisterConfiguratorServiceServer(tracing.ServiceRegistrar(grpcServer), &p.configurator)
 	}
 
 	if p.VPPIfPlugin != nil {
@@ -93,6 +103,11 @@ func (p *Plugin) Init() error {
 			p.sendNotification(notification)
 		})
 	}
+	if p.VPPBfdPlugin != nil {
+		p.VPPBfdPlugin.SetNotifyService(func(notification *vpp.Notification) {
+			p.sendNotification(notification)
+		})
+	}
 	if p.LinuxIfPlugin != nil {
 		p.LinuxIfPlugin.SetNotifyService(func(notification *linux.Notification) {
 			p.sendNotification(notification)
@@ -110,6 +125,12 @@ func (p *Plugin) sendNotification(notification proto.Message) {
 				VppNotification: n,
 			},
 		})
+		// BFD session state is published also to the generic manager subscribers
+		if bfdNotif := n.GetBfdSession(); bfdNotif != nil && p.Notifier != nil {
+			p.Notifier.PushNotification(bfdNotif.Session, &generic.ItemStatus{
+				Status: bfdNotif.State.String(),
+			})
+		}
 	case *linux.Notification:
 		p.configurator.notifyService.pushNotification(&pb.Notification{
 			Notification: &pb.Notification_LinuxNotification{
@@ -168,10 +189,38 @@ func (p *Plugin) initHandlers() (err error) {
 	if p.configurator.natHandler == nil {
 		p.Log.Info("VPP NAT handler is not available, it will be skipped")
 	}
+	p.configurator.nat64Handler = natvppcalls.CompatibleNat64VppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.nat64Handler == nil {
+		p.Log.Info("VPP NAT64 handler is not available, it will be skipped")
+	}
+	p.configurator.det44Handler = natvppcalls.CompatibleDet44VppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.det44Handler == nil {
+		p.Log.Info("VPP DET44 handler is not available, it will be skipped")
+	}
 	p.configurator.puntHandler = puntvppcalls.CompatiblePuntVppHandler(p.VPP, ifIndexes, p.Log)
 	if p.configurator.puntHandler == nil {
 		p.Log.Info("VPP Punt handler is not available, it will be skipped")
 	}
+	p.configurator.mplsHandler = mplsvppcalls.CompatibleMplsVppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.mplsHandler == nil {
+		p.Log.Info("VPP MPLS handler is not available, it will be skipped")
+	}
+	p.configurator.policerHandler = policervppcalls.CompatiblePolicerVppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.policerHandler == nil {
+		p.Log.Info("VPP Policer handler is not available, it will be skipped")
+	}
+	p.configurator.bfdHandler = bfdvppcalls.CompatibleBfdVppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.bfdHandler == nil {
+		p.Log.Info("VPP BFD handler is not available, it will be skipped")
+	}
+	p.configurator.linuxCpHandler = linuxcpvppcalls.CompatibleLinuxCpVppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.linuxCpHandler == nil {
+		p.Log.Info("VPP linux-cp handler is not available, it will be skipped")
+	}
+	p.configurator.classifierHandler = classifiervppcalls.CompatibleClassifierVppHandler(p.VPP, ifIndexes, p.Log)
+	if p.configurator.classifierHandler == nil {
+		p.Log.Info("VPP classifier handler is not available, it will be skipped")
+	}
 	p.configurator.wireguardHandler = wireguardvppcalls.CompatibleWgVppHandler(p.VPP, ifIndexes, p.Log)
 	if p.configurator.wireguardHandler == nil {
 		p.Log.Info("VPP Wg handler is not available, it will be skipped")
@@ -16,19 +16,27 @@ package govppmux
 
 import (
 	"context"
-	"runtime/trace"
 	"time"
 
 	govppapi "go.fd.io/govpp/api"
 	"go.fd.io/govpp/core"
 	"go.ligato.io/cn-infra/v2/logging"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 )
 
+// attribute key of the binary API message name recorded with the spans
+const msgNameAttrKey = attribute.Key("govpp.message")
+
 func (p *Plugin) NewStream(ctx context.Context, options ...govppapi.StreamOption) (govppapi.Stream, error) {
 	return p.vppConn.NewStream(ctx, options...)
 }
 
-func (p *Plugin) Invoke(ctx context.Context, req govppapi.Message, reply govppapi.Message) error {
+func (p *Plugin) Invoke(ctx context.Context, req govppapi.Message, reply govppapi.Message) (err error) {
+	ctx, span := tracing.Start(ctx, "govpp.Invoke", msgNameAttrKey.String(req.GetMessageName()))
+	defer func() { tracing.End(span, err) }()
 	return p.vppConn.Invoke(ctx, req, reply)
 }
 
@@ -95,8 +103,7 @@ type retryConfig struct {
 
 // govppRequestCtx is custom govpp RequestCtx.
 type govppRequestCtx struct {
-	ctx  context.Context
-	task *trace.Task
+	span trace.Span
 
 	// Original request context
 	requestCtx govppapi.RequestCtx
@@ -111,8 +118,7 @@ type govppRequestCtx struct {
 
 // govppMultirequestCtx is custom govpp MultiRequestCtx.
 type govppMultirequestCtx struct {
-	ctx  context.Context
-	task *trace.Task
+	span trace.Span
 
 	// Original multi request context
 	requestCtx govppapi.MultiRequestCtx
@@ -125,8 +131,10 @@ type govppMultirequestCtx struct {
 // SendRequest sends asynchronous request to the vpp and receives context used to receive reply.
 // Plugin govppmux allows to re-send retry which failed because of disconnected vpp, if enabled.
 func (c *goVppChan) SendRequest(request govppapi.Message) govppapi.RequestCtx {
-	ctx, task := trace.NewTask(context.Background(), "govpp.SendRequest")
-	trace.Log(ctx, "messageName", request.GetMessageName())
+	// the channel API does not carry context, the span is therefore recorded
+	// as a root span (time spent by descriptors in VPP is covered by the spans
+	// of the descriptor callbacks)
+	_, span := tracing.Start(context.Background(), "govpp.SendRequest", msgNameAttrKey.String(request.GetMessageName()))
 
 	start := time.Now()
 	// Send request now and wait for context
@@ -136,8 +144,7 @@ func (c *goVppChan) SendRequest(request govppapi.Message) govppapi.RequestCtx {
 
 	// Return context with value and function which allows to send request again if needed
 	return &govppRequestCtx{
-		ctx:         ctx,
-		task:        task,
+		span:        span,
 		requestCtx:  requestCtx,
 		sendRequest: c.Channel.SendRequest,
 		requestMsg:  request,
@@ -147,8 +154,8 @@ func (c *goVppChan) SendRequest(request govppapi.Message) govppapi.RequestCtx {
 }
 
 // ReceiveReply handles request and returns error if occurred. Also does retry if this option is available.
-func (r *govppRequestCtx) ReceiveReply(reply govppapi.Message) error {
-	defer r.task.End()
+func (r *govppRequestCtx) ReceiveReply(reply govppapi.Message) (err error) {
+	defer func() { tracing.End(r.span, err) }()
 
 	var timeout time.Duration
 	attempts := r.retry.attempts
@@ -156,7 +163,7 @@ func (r *govppRequestCtx) ReceiveReply(reply govppapi.Message) error {
 		timeout = r.retry.timeout
 	}
 	// Receive reply from original send
-	err := r.requestCtx.ReceiveReply(reply)
+	err = r.requestCtx.ReceiveReply(reply)
 	for retry := 1; err == core.ErrNotConnected; retry++ {
 		if retry > attempts {
 			break // max attempts exceeded
@@ -166,7 +173,7 @@ func (r *govppRequestCtx) ReceiveReply(reply govppapi.Message) error {
 		// Wait before next attempt
 		time.Sleep(timeout)
 		// Retry request
-		trace.Logf(r.ctx, "requestRetry", "%d/%d", retry, attempts)
+		r.span.AddEvent("requestRetry", trace.WithAttributes(attribute.Int("govpp.retry", retry)))
 		err = r.sendRequest(r.requestMsg).ReceiveReply(reply)
 	}
 	if err != nil {
@@ -180,8 +187,7 @@ func (r *govppRequestCtx) ReceiveReply(reply govppapi.Message) error {
 
 // SendMultiRequest sends asynchronous request to the vpp and receives context used to receive reply.
 func (c *goVppChan) SendMultiRequest(request govppapi.Message) govppapi.MultiRequestCtx {
-	ctx, task := trace.NewTask(context.Background(), "govpp.SendMultiRequest")
-	trace.Log(ctx, "msgName", request.GetMessageName())
+	_, span := tracing.Start(context.Background(), "govpp.SendMultiRequest", msgNameAttrKey.String(request.GetMessageName()))
 
 	start := time.Now()
 	// Send request now and wait for context
@@ -191,8 +197,7 @@ func (c *goVppChan) SendMultiRequest(request govppapi.Message) govppapi.MultiReq
 
 	// Return context with value and function which allows to send request again if needed
 	return &govppMultirequestCtx{
-		ctx:        ctx,
-		task:       task,
+		span:       span,
 		requestCtx: requestCtx,
 		requestMsg: request,
 		start:      start,
@@ -204,7 +209,7 @@ func (r *govppMultirequestCtx) ReceiveReply(reply govppapi.Message) (bool, error
 	// Receive reply from original send
 	last, err := r.requestCtx.ReceiveReply(reply)
 	if last || err != nil {
-		defer r.task.End()
+		defer tracing.End(r.span, err)
 		if err != nil {
 			reportRequestFailed(r.requestMsg, err)
 		} else {
@@ -20,6 +20,9 @@ import (
 
 	. "github.com/onsi/gomega"
 	"go.fd.io/govpp/core"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
 )
@@ -99,3 +102,28 @@ func TestRequestRetry(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestSpans(t *testing.T) {
+	RegisterTestingT(t)
+
+	// record spans
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(prevProvider)
+
+	ctx := vppmock.SetupTestCtx(t)
+	defer ctx.TeardownTestCtx()
+	ch := newGovppChan(ctx.MockChannel, retryConfig{})
+
+	// the channel API does not carry context, requests are traced as root spans
+	ctx.MockVpp.MockReply(&core.ControlPingReply{})
+	err := ch.SendRequest(&core.ControlPing{}).ReceiveReply(&core.ControlPingReply{})
+	Expect(err).ToNot(HaveOccurred())
+
+	spans := recorder.Ended()
+	Expect(spans).To(HaveLen(1))
+	Expect(spans[0].Name()).To(Equal("govpp.SendRequest"))
+	Expect(spans[0].Parent().IsValid()).To(BeFalse())
+	Expect(spans[0].Attributes()).To(ContainElement(msgNameAttrKey.String("control_ping")))
+}
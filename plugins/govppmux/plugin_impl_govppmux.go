@@ -37,6 +37,7 @@ import (
 	"go.ligato.io/cn-infra/v2/logging"
 	"go.ligato.io/cn-infra/v2/rpc/rest"
 
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi"
@@ -178,7 +179,7 @@ func (p *Plugin) Init() (err error) {
 	}
 
 	// register REST API handlers
-	p.registerHandlers(p.HTTPHandlers)
+	p.registerHandlers(tracing.HTTPHandlers(p.HTTPHandlers))
 
 	return nil
 }
@@ -134,3 +134,77 @@ func TestResyncTypeDecode(t *testing.T) {
 		})
 	}
 }
+
+func TestTxnPriorityEncode(t *testing.T) {
+	tests := []struct {
+		name string
+
+		priority api.TxnPriority
+
+		expectOut string
+	}{
+		{"LowPriority", api.LowPriority, `"low"`},
+		{"NormalPriority", api.NormalPriority, `"normal"`},
+		{"HighPriority", api.HighPriority, `"high"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := json.Marshal(test.priority)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			out := string(b)
+			if out != test.expectOut {
+				t.Fatalf("expected output: %q, got %q", test.expectOut, out)
+			}
+		})
+	}
+}
+
+func TestTxnPriorityDecode(t *testing.T) {
+	tests := []struct {
+		name string
+
+		input string
+
+		expectPriority api.TxnPriority
+		expectErr      bool
+	}{
+		{"low", `"low"`, api.LowPriority, false},
+		{"normal", `"normal"`, api.NormalPriority, false},
+		{"high", `"high"`, api.HighPriority, false},
+		{"-1 (low)", `-1`, api.LowPriority, false},
+		{"1 (high)", `1`, api.HighPriority, false},
+		{"invalid", `"INVALID"`, api.NormalPriority, true},
+		{"out of range", `2`, api.NormalPriority, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var priority api.TxnPriority
+			err := json.Unmarshal([]byte(test.input), &priority)
+			if test.expectErr {
+				if err == nil {
+					t.Fatalf("expected error for %s", test.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if priority != test.expectPriority {
+				t.Fatalf("expected TxnPriority: %v, got %v", test.expectPriority, priority)
+			}
+		})
+	}
+}
+
+func TestParseTxnPriority(t *testing.T) {
+	for _, s := range []string{"low", "Normal", "HIGH"} {
+		if _, err := api.ParseTxnPriority(s); err != nil {
+			t.Fatalf("unexpected error for %q: %v", s, err)
+		}
+	}
+	if _, err := api.ParseTxnPriority("urgent"); err == nil {
+		t.Fatalf("expected error for invalid priority")
+	}
+}
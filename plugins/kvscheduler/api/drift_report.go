@@ -0,0 +1,170 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/utils"
+)
+
+// DriftType classifies the difference between the intended (NB) and the actual
+// (SB) state of a value found by the drift detection.
+type DriftType int
+
+const (
+	// MissingValue is a value configured by NB which is not present in SB.
+	MissingValue DriftType = iota
+
+	// ModifiedValue is a value present in SB which is not equivalent
+	// with the value configured by NB.
+	ModifiedValue
+
+	// UnexpectedValue is a value found in SB which is not configured by NB
+	// and would be therefore removed by resync.
+	UnexpectedValue
+)
+
+// String returns human-readable string representation of the drift type.
+func (t DriftType) String() string {
+	switch t {
+	case MissingValue:
+		return "Missing"
+	case ModifiedValue:
+		return "Modified"
+	case UnexpectedValue:
+		return "Unexpected"
+	}
+	return "UndefinedDriftType"
+}
+
+var driftType_value = map[string]int{
+	"Missing":    int(MissingValue),
+	"Modified":   int(ModifiedValue),
+	"Unexpected": int(UnexpectedValue),
+}
+
+func (t DriftType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *DriftType) UnmarshalJSON(b []byte) error {
+	if b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		if v, ok := driftType_value[s]; ok {
+			*t = DriftType(v)
+		} else {
+			*t = DriftType(-1)
+		}
+	} else {
+		var n int
+		if err := json.Unmarshal(b, &n); err != nil {
+			return err
+		}
+		*t = DriftType(n)
+	}
+	return nil
+}
+
+// DriftedValue describes a single value which has drifted from the intended state.
+type DriftedValue struct {
+	Key        string
+	BaseKey    string `json:",omitempty"` // defined for derived values
+	Descriptor string
+	DriftType  DriftType
+	Expected   *utils.RecordedProtoMessage `json:",omitempty"` // nil for UnexpectedValue
+	Actual     *utils.RecordedProtoMessage `json:",omitempty"` // nil for MissingValue
+}
+
+// DriftReport is a record of a single run of the drift detection, comparing
+// the state of SB (as retrieved by descriptors) with the scheduler's graph.
+type DriftReport struct {
+	// timestamps
+	Start time.Time
+	Stop  time.Time
+
+	// SeqNum is a sequence number of the drift detection run.
+	SeqNum uint64
+
+	// Descriptors lists descriptors with values checked for drift.
+	Descriptors []string
+
+	// Drifted lists values that differ between NB and SB (ordered by key).
+	Drifted []DriftedValue `json:",omitempty"`
+
+	// RetrieveErrors maps descriptor name to the error returned by Retrieve.
+	RetrieveErrors map[string]string `json:",omitempty"`
+
+	// HealScheduled is true if downstream resync of the drifted values was
+	// scheduled to heal the drift.
+	HealScheduled bool `json:",omitempty"`
+}
+
+// HasDrift returns true if any value has drifted from the intended state.
+func (r *DriftReport) HasDrift() bool {
+	return len(r.Drifted) > 0
+}
+
+// DriftedKeys returns keys of all drifted values.
+func (r *DriftReport) DriftedKeys() (keys []string) {
+	for _, value := range r.Drifted {
+		keys = append(keys, value.Key)
+	}
+	return keys
+}
+
+// String returns human-readable string representation of the drift report.
+func (r *DriftReport) String() string {
+	var str strings.Builder
+	str.WriteString(fmt.Sprintf("* drift detection #%d (%s, took %v):\n",
+		r.SeqNum, r.Start.Format(time.RFC3339), r.Stop.Sub(r.Start).Round(time.Millisecond)))
+	str.WriteString(fmt.Sprintf("    - descriptors: %s\n", strings.Join(r.Descriptors, ", ")))
+	if len(r.RetrieveErrors) > 0 {
+		var descriptors []string
+		for descriptor := range r.RetrieveErrors {
+			descriptors = append(descriptors, descriptor)
+		}
+		sort.Strings(descriptors)
+		str.WriteString("    - retrieve errors:\n")
+		for _, descriptor := range descriptors {
+			str.WriteString(fmt.Sprintf("        - %s: %s\n", descriptor, r.RetrieveErrors[descriptor]))
+		}
+	}
+	if !r.HasDrift() {
+		str.WriteString("    - drifted values: NONE\n")
+		return str.String()
+	}
+	str.WriteString("    - drifted values:\n")
+	for _, value := range r.Drifted {
+		str.WriteString(fmt.Sprintf("        - %s [%s] %q\n", value.DriftType, value.Descriptor, value.Key))
+		if value.Expected != nil {
+			str.WriteString(fmt.Sprintf("            expected: %s\n", utils.ProtoToString(value.Expected)))
+		}
+		if value.Actual != nil {
+			str.WriteString(fmt.Sprintf("            actual: %s\n", utils.ProtoToString(value.Actual)))
+		}
+	}
+	if r.HealScheduled {
+		str.WriteString("    - heal: downstream resync of drifted values scheduled\n")
+	}
+	return str.String()
+}
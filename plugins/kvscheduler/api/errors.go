@@ -29,6 +29,9 @@ var (
 	// ErrRevertNotSupportedWithResync is returned when transaction combines resync with revert.
 	ErrRevertNotSupportedWithResync = errors.New("it is not supported to combine resync with revert")
 
+	// ErrDryRunNotSupportedWithDownstreamResync is returned when transaction combines downstream-resync with dry-run.
+	ErrDryRunNotSupportedWithDownstreamResync = errors.New("it is not supported to combine downstream resync with dry-run")
+
 	// ErrClosedScheduler is returned when scheduler is closed during transaction execution.
 	ErrClosedScheduler = errors.New("scheduler was closed")
 
@@ -54,6 +57,14 @@ var (
 	// namespace but forgets to revert the change back before returning from the
 	// operation back to the scheduler.
 	ErrEscapedNetNs = errors.New("operation didn't preserve the original network namespace")
+
+	// ErrDriftBeforeResync is returned when drift detection is requested before
+	// the graph was populated by the startup resync.
+	ErrDriftBeforeResync = errors.New("drift cannot be detected before the startup resync")
+
+	// ErrUnknownDescriptor is returned when an operation refers to a descriptor
+	// which is not registered.
+	ErrUnknownDescriptor = errors.New("descriptor is not registered")
 )
 
 // ErrInvalidValueType is returned to scheduler by auto-generated descriptor adapter
@@ -117,6 +128,17 @@ func (e *TransactionError) GetKVErrors() (kvErrors []KeyWithError) {
 	return e.kvErrors
 }
 
+// RevertFailed returns true if some of the operations reverting the failed
+// transaction failed as well, i.e. the transaction was not cleanly reverted.
+func (e *TransactionError) RevertFailed() bool {
+	for _, kvError := range e.GetKVErrors() {
+		if kvError.IsRevert {
+			return true
+		}
+	}
+	return false
+}
+
 // GetTxnInitError returns error thrown during the transaction initialization.
 // If the transaction initialization fails, the other stages of the transaction
 // processing are not even started, therefore either GetTxnInitError or GetKVErrors
@@ -113,6 +113,32 @@ func (vo ValueOrigin) String() string {
 	}
 }
 
+// BatchOp is a single Create/Update/Delete operation passed to a batch
+// handler of a descriptor (CreateBatch, UpdateBatch, DeleteBatch).
+// The handler should report the result of every operation separately
+// by filling <NewMetadata> and <Err>.
+type BatchOp struct {
+	// Key of the value to create/update/delete.
+	Key string
+
+	// Value is the new value for Create and Update, and the value being
+	// removed for Delete.
+	Value proto.Message
+
+	// PrevValue is the previous value for Update (nil otherwise).
+	PrevValue proto.Message
+
+	// Metadata associated with the value (Update and Delete only).
+	Metadata Metadata
+
+	// NewMetadata should be set by CreateBatch/UpdateBatch to the metadata
+	// to associate with the value (<NewMetadata> can re-use <Metadata>).
+	NewMetadata Metadata
+
+	// Err should be set by the handler if the operation has failed.
+	Err error
+}
+
 // KVDescriptor teaches KVScheduler how to CRUD values under keys matched
 // by KeySelector().
 //
@@ -226,6 +252,20 @@ type KVDescriptor struct {
 	// to go.
 	UpdateWithRecreate func(key string, oldValue, newValue proto.Message, metadata Metadata) bool
 
+	// CreateBatch, UpdateBatch and DeleteBatch can be *optionally* defined
+	// to apply multiple independent operations of this descriptor at once,
+	// e.g. using a single round-trip to SB.
+	// Scheduler uses batch handlers for runs of operations of the same type
+	// without dependencies between them (see ConcurrencySafe for how these
+	// are selected). Results should be reported for each operation separately
+	// (see BatchOp) - if the returned error is non-nil, it is reported
+	// for every operation without an error of its own.
+	// The batch handlers do not replace Create, Update and Delete, which are
+	// still used for all the other operations and must be defined as well.
+	CreateBatch func(ops []*BatchOp) error
+	UpdateBatch func(ops []*BatchOp) error
+	DeleteBatch func(ops []*BatchOp) error
+
 	// Retrieve should return all non-derived values described by this descriptor
 	// that *really* exist in the southbound plane (and not what the current
 	// scheduler's view of SB is). Derived value will get automatically created
@@ -279,4 +319,17 @@ type KVDescriptor struct {
 	// Metadata for values already retrieved are available via GetMetadataMap().
 	// TODO: define dependencies as a slice of models, not descriptors.
 	RetrieveDependencies []string /* descriptor name */
+
+	// ConcurrencySafe tells scheduler that Create, Delete and Update handlers
+	// of this descriptor can be called concurrently for different values.
+	// Operations of concurrency-safe descriptors that are independent of each
+	// other (see Dependencies) may be then executed in parallel to speed up
+	// large transactions (bounded by the scheduler option txn-exec-workers).
+	// The order of recorded operations remains the same as with sequential
+	// execution.
+	// Descriptors sending requests to VPP over a shared govpp API channel are
+	// not concurrency-safe (replies of a channel are received in the order
+	// of the requests), they should rather define batch handlers
+	// (CreateBatch, UpdateBatch, DeleteBatch) to speed up large transactions.
+	ConcurrencySafe bool
 }
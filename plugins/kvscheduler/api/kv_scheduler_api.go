@@ -70,6 +70,7 @@ type KeyWithError struct {
 	Key          string
 	TxnOperation kvscheduler.TxnOperation
 	Error        error
+	IsRevert     bool // the operation was reverting the failed transaction
 }
 
 // KVWithMetadata encapsulates key-value pair with metadata and the origin mark.
@@ -251,6 +252,17 @@ type KVScheduler interface {
 	// by the sequence number.
 	GetRecordedTransaction(SeqNum uint64) (txn *RecordedTxn)
 
+	// DetectDrift compares SB state retrieved by the given descriptors (all
+	// if none are given) with the intended state and returns report of the
+	// values that have drifted. If <heal> is true, resync of drifted values
+	// is scheduled. Drift cannot be detected before the startup resync.
+	// Transactions are processed in-between checks of individual descriptors.
+	DetectDrift(heal bool, descriptors ...string) (*DriftReport, error)
+
+	// GetDriftReports returns recorded reports of past drift detection runs,
+	// ordered from the oldest to the latest.
+	GetDriftReports() []*DriftReport
+
 	// ValidateSemantically validates given proto messages according to semantic validation(KVDescriptor.Validate)
 	// from registered KVDescriptors. If all locally known messages are valid, nil is returned. If some locally known
 	// messages are invalid, kvscheduler.MessageValidationErrors is returned. In any other case, error is returned.
@@ -17,7 +17,11 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
 )
 
 type schedulerCtxKey int
@@ -46,6 +50,14 @@ const (
 	// txnSimulationCtxKey is a key under which option enabling txn simulation
 	// is stored into the context.
 	txnSimulationCtxKey
+
+	// dryRunCtxKey is a key under which *dry-run* txn option is stored into
+	// the context.
+	dryRunCtxKey
+
+	// priorityCtxKey is a key under which *priority* txn option is stored into
+	// the context.
+	priorityCtxKey
 )
 
 // modifiable default parameters for the *retry* txn option
@@ -302,3 +314,160 @@ func IsWithSimulation(ctx context.Context) bool {
 	_, withSimulation := ctx.Value(txnSimulationCtxKey).(*txnSimulationOpt)
 	return withSimulation
 }
+
+/* Dry-Run */
+
+// dryRunOpt represents the *dry-run* transaction option.
+type dryRunOpt struct {
+	record *RecordedTxn
+}
+
+// WithDryRun prepares context for transaction that will be only simulated
+// to obtain the execution plan. None of the Create/Delete/Update operations
+// are executed and the graph is left unchanged. The transaction is neither
+// recorded into the history nor does it consume a sequence number (Commit()
+// returns the number of the next transaction), use WithDryRunRecord to obtain
+// the plan.
+// Resync run as dry-run is planned against the last known (cached) SB state
+// - the graph is not refreshed. Dry-run of the downstream resync is therefore
+// not supported.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunCtxKey, &dryRunOpt{})
+}
+
+// WithDryRunRecord prepares context for dry-run transaction (see WithDryRun),
+// for which the transaction record with the plan is stored into <record>
+// before the blocking Commit() returns.
+func WithDryRunRecord(ctx context.Context, record *RecordedTxn) context.Context {
+	return context.WithValue(ctx, dryRunCtxKey, &dryRunOpt{record: record})
+}
+
+// IsDryRun returns true if transaction context is configured for dry-run.
+func IsDryRun(ctx context.Context) bool {
+	_, dryRun := ctx.Value(dryRunCtxKey).(*dryRunOpt)
+	return dryRun
+}
+
+// IsWithDryRunRecord returns the record passed to WithDryRunRecord
+// (nil if the context is not configured for dry-run with record).
+func IsWithDryRunRecord(ctx context.Context) (record *RecordedTxn, withRecord bool) {
+	dryRun, isDryRun := ctx.Value(dryRunCtxKey).(*dryRunOpt)
+	if !isDryRun || dryRun.record == nil {
+		return nil, false
+	}
+	return dryRun.record, true
+}
+
+/* Priority */
+
+// priorityOpt represents the *priority* transaction option.
+type priorityOpt struct {
+	priority TxnPriority
+}
+
+// TxnPriority is one of: LowPriority, NormalPriority, HighPriority.
+type TxnPriority int
+
+const (
+	// LowPriority is used for background transactions which can wait
+	// (also used for retries of failed operations).
+	LowPriority TxnPriority = iota - 1
+
+	// NormalPriority is the default priority of transactions.
+	NormalPriority
+
+	// HighPriority is used for urgent transactions that should be processed
+	// ahead of all queued transactions with lower priority.
+	HighPriority
+)
+
+func (p TxnPriority) String() string {
+	switch p {
+	case LowPriority:
+		return "low"
+	case NormalPriority:
+		return "normal"
+	case HighPriority:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+var txnPriority_value = map[string]TxnPriority{
+	"low":    LowPriority,
+	"normal": NormalPriority,
+	"high":   HighPriority,
+}
+
+// ParseTxnPriority parses transaction priority from its string representation
+// (case-insensitive).
+func ParseTxnPriority(s string) (TxnPriority, error) {
+	if p, ok := txnPriority_value[strings.ToLower(s)]; ok {
+		return p, nil
+	}
+	return NormalPriority, fmt.Errorf("invalid transaction priority %q (expected one of: low, normal, high)", s)
+}
+
+func (p TxnPriority) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+func (p *TxnPriority) UnmarshalJSON(b []byte) error {
+	if b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		v, err := ParseTxnPriority(s)
+		if err != nil {
+			return err
+		}
+		*p = v
+	} else {
+		var n int
+		if err := json.Unmarshal(b, &n); err != nil {
+			return err
+		}
+		if v := TxnPriority(n); v < LowPriority || v > HighPriority {
+			return fmt.Errorf("invalid transaction priority %d (expected one of: -1, 0, 1)", n)
+		}
+		*p = TxnPriority(n)
+	}
+	return nil
+}
+
+// TxnPriorityFromProto converts transaction priority from its proto representation.
+func TxnPriorityFromProto(priority kvscheduler.TxnQueuePriority) TxnPriority {
+	switch priority {
+	case kvscheduler.TxnQueuePriority_LOW:
+		return LowPriority
+	case kvscheduler.TxnQueuePriority_HIGH:
+		return HighPriority
+	}
+	return NormalPriority
+}
+
+// WithPriority prepares context for transaction that will be queued with the
+// given priority. Queued transactions of higher priority are processed ahead
+// of those with lower priority, transactions of the same priority are processed
+// in the order of arrival. Transactions changing some of the same keys keep
+// the order of arrival regardless of the priority.
+// High-priority NB transaction (without revert and dry-run) also gets ahead
+// of a queued resync and preempts a running resync in-between operations;
+// the preempted resync is then restarted with the values of the transaction
+// merged into its data. Other transactions are never interrupted.
+// By default, transactions are queued with NormalPriority.
+func WithPriority(ctx context.Context, priority TxnPriority) context.Context {
+	return context.WithValue(ctx, priorityCtxKey, &priorityOpt{priority: priority})
+}
+
+// IsWithPriority returns the priority of the transaction if it was set
+// in the transaction context.
+func IsWithPriority(ctx context.Context) (priority TxnPriority, withPriority bool) {
+	priorityOpt, withPriority := ctx.Value(priorityCtxKey).(*priorityOpt)
+	if !withPriority {
+		return NormalPriority, false
+	}
+	return priorityOpt.priority, true
+}
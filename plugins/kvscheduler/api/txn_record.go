@@ -114,6 +114,8 @@ func ResyncTypeToString(t ResyncType) string {
 // RecordedTxn is used to record executed transaction.
 type RecordedTxn struct {
 	PreRecord      bool `json:",omitempty"` // not yet fully recorded, only args + plan + pre-processing errors
+	Interrupted    bool `json:",omitempty"` // pre-recorded, but never finalized due to agent restart
+	DryRun         bool `json:",omitempty"` // only simulated, nothing was executed
 	WithSimulation bool `json:",omitempty"`
 
 	// timestamps
@@ -124,6 +126,7 @@ type RecordedTxn struct {
 	SeqNum       uint64
 	TxnType      TxnType
 	ResyncType   ResyncType       `json:",omitempty"`
+	Priority     TxnPriority      `json:",omitempty"`
 	Description  string           `json:",omitempty"`
 	RetryForTxn  uint64           `json:",omitempty"`
 	RetryAttempt int              `json:",omitempty"`
@@ -150,6 +153,7 @@ type RecordedTxnOp struct {
 	PrevErr    error                       `json:"-"`
 	PrevErrMsg string                      `json:",omitempty"`
 	NOOP       bool                        `json:",omitempty"`
+	UnmetDeps  []string                    `json:",omitempty"` // labels of unmet dependencies if left pending
 
 	// flags
 	IsDerived  bool `json:",omitempty"`
@@ -207,6 +211,9 @@ func (txn *RecordedTxn) StringWithOpts(resultOnly, verbose bool, indent int) str
 				str += indent2 + fmt.Sprintf("- type: %s\n", TxnTypeToString(txn.TxnType))
 			}
 		}
+		if txn.Priority != NormalPriority {
+			str += indent2 + fmt.Sprintf("- priority: %s\n", txn.Priority)
+		}
 		if txn.Description != "" {
 			descriptionLines := strings.Split(txn.Description, "\n")
 			for idx, line := range descriptionLines {
@@ -242,7 +249,13 @@ func (txn *RecordedTxn) StringWithOpts(resultOnly, verbose bool, indent int) str
 		}
 	}
 
-	if !txn.PreRecord {
+	if txn.Interrupted {
+		str += indent1 + "* executed operations: <INTERRUPTED BY AGENT RESTART>\n"
+	}
+
+	if txn.DryRun {
+		str += indent1 + "* executed operations: <DRY-RUN>\n"
+	} else if !txn.PreRecord {
 		if len(txn.Executed) == 0 {
 			str += indent1 + "* executed operations:\n"
 		} else {
@@ -257,6 +270,35 @@ func (txn *RecordedTxn) StringWithOpts(resultOnly, verbose bool, indent int) str
 	return str
 }
 
+// TxnPlan returns the planned operations of the transaction as a proto message.
+func (txn *RecordedTxn) TxnPlan() *kvscheduler.TxnPlan {
+	plan := &kvscheduler.TxnPlan{
+		SeqNum: txn.SeqNum,
+	}
+	for _, op := range txn.Planned {
+		plannedOp := &kvscheduler.PlannedOperation{
+			Operation:         op.Operation,
+			Key:               op.Key,
+			PrevState:         op.PrevState,
+			NewState:          op.NewState,
+			Error:             op.NewErrMsg,
+			UnmetDependencies: op.UnmetDeps,
+			IsDerived:         op.IsDerived,
+			IsProperty:        op.IsProperty,
+			IsRecreate:        op.IsRecreate,
+			Noop:              op.NOOP,
+		}
+		if op.PrevValue != nil {
+			plannedOp.PrevValue = utils.ProtoToString(op.PrevValue)
+		}
+		if op.NewValue != nil {
+			plannedOp.NewValue = utils.ProtoToString(op.NewValue)
+		}
+		plan.Operations = append(plan.Operations, plannedOp)
+	}
+	return plan
+}
+
 // String returns a *multi-line* human-readable string representation of a recorded
 // transaction operation.
 func (op *RecordedTxnOp) String() string {
@@ -386,6 +428,9 @@ func (op *RecordedTxnOp) StringWithOpts(index int, verbose bool, indent int) str
 	if op.NewErr != nil {
 		str += indent2 + fmt.Sprintf("- error: %s\n", utils.ErrorToString(op.NewErr))
 	}
+	if len(op.UnmetDeps) > 0 {
+		str += indent2 + fmt.Sprintf("- unmet-deps: %s\n", strings.Join(op.UnmetDeps, ", "))
+	}
 	if verbose {
 		str += indent2 + fmt.Sprintf("- prev-state: %s \n", op.PrevState.String())
 		str += indent2 + fmt.Sprintf("- new-state: %s \n", op.NewState.String())
@@ -2200,3 +2200,150 @@ func TestFailedRecreateOfDerivedValue(t *testing.T) {
 	err = scheduler.Close()
 	Expect(err).To(BeNil())
 }
+
+func TestDryRunTransaction(t *testing.T) {
+	RegisterTestingT(t)
+
+	// prepare KV Scheduler
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+
+	// prepare mocks
+	mockSB := test.NewMockSouthbound()
+	// -> descriptor1:
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: string(proto.MessageName(test.NewArrayValue())),
+		DerivedValues: test.ArrayValueDerBuilder,
+		WithMetadata:  true,
+	}, mockSB, 0)
+	// -> descriptor2:
+	descriptor2 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor2Name,
+		NBKeyPrefix:   prefixB,
+		KeySelector:   prefixSelector(prefixB),
+		ValueTypeName: string(proto.MessageName(test.NewArrayValue())),
+		DerivedValues: test.ArrayValueDerBuilder,
+		Dependencies: func(key string, value proto.Message) []Dependency {
+			if key == prefixB+baseValue2+"/item1" {
+				depKey := prefixA + baseValue1
+				return []Dependency{
+					{Label: depKey, Key: depKey},
+				}
+			}
+			return nil
+		},
+		WithMetadata:         true,
+		RetrieveDependencies: []string{descriptor1Name},
+	}, mockSB, 0)
+
+	// register both descriptors with the scheduler
+	scheduler.RegisterKVDescriptor(descriptor1)
+	scheduler.RegisterKVDescriptor(descriptor2)
+
+	// run dry-run transaction against empty SB
+	schedulerTxn := scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixB+baseValue2, test.NewArrayValue("item1", "item2"))
+	txn := &RecordedTxn{}
+	seqNum, err := schedulerTxn.Commit(WithDryRunRecord(context.Background(), txn))
+	Expect(seqNum).To(BeEquivalentTo(0))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// nothing was executed
+	Expect(mockSB.GetValues(nil)).To(BeEmpty())
+	Expect(mockSB.PopHistoryOfOps()).To(BeEmpty())
+	status := scheduler.GetValueStatus(prefixB + baseValue2)
+	Expect(status.GetValue().GetState()).To(Equal(ValueState_NONEXISTENT))
+
+	// dry-run is not recorded into the history
+	Expect(scheduler.GetRecordedTransaction(seqNum)).To(BeNil())
+	Expect(scheduler.GetTransactionHistory(time.Time{}, time.Time{})).To(BeEmpty())
+
+	// check the returned plan
+	Expect(txn.SeqNum).To(Equal(seqNum))
+	Expect(txn.DryRun).To(BeTrue())
+	Expect(txn.WithSimulation).To(BeTrue())
+	Expect(txn.Executed).To(BeEmpty())
+	txnOps := RecordedTxnOps{
+		{
+			Operation: TxnOperation_CREATE,
+			Key:       prefixB + baseValue2,
+			NewValue:  utils.RecordProtoMessage(test.NewArrayValue("item1", "item2")),
+			PrevState: ValueState_NONEXISTENT,
+			NewState:  ValueState_CONFIGURED,
+		},
+		{
+			Operation: TxnOperation_CREATE,
+			Key:       prefixB + baseValue2 + "/item1",
+			IsDerived: true,
+			NewValue:  utils.RecordProtoMessage(test.NewStringValue("item1")),
+			PrevState: ValueState_NONEXISTENT,
+			NewState:  ValueState_PENDING,
+			NOOP:      true,
+		},
+		{
+			Operation: TxnOperation_CREATE,
+			Key:       prefixB + baseValue2 + "/item2",
+			IsDerived: true,
+			NewValue:  utils.RecordProtoMessage(test.NewStringValue("item2")),
+			PrevState: ValueState_NONEXISTENT,
+			NewState:  ValueState_CONFIGURED,
+		},
+	}
+	checkTxnOperations(txn.Planned, txnOps)
+	Expect(txn.Planned[1].UnmetDeps).To(ConsistOf(prefixA + baseValue1))
+
+	// dry-run cannot be combined with downstream resync
+	_, err = scheduler.StartNBTransaction().Commit(
+		WithDryRun(WithResync(context.Background(), DownstreamResync, true)))
+	Expect(err).To(HaveOccurred())
+
+	// the next transaction is executed as usual (dry-run did not consume
+	// the sequence number)
+	schedulerTxn = scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixB+baseValue2, test.NewArrayValue("item1", "item2"))
+	seqNum, err = schedulerTxn.Commit(testCtx)
+	Expect(seqNum).To(BeEquivalentTo(0))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(mockSB.GetValue(prefixB + baseValue2)).ToNot(BeNil())
+	Expect(mockSB.GetValue(prefixB + baseValue2 + "/item1")).To(BeNil())
+
+	// with the history enabled, dry-run leaves the history unchanged
+	history := scheduler.GetTransactionHistory(time.Time{}, time.Time{})
+	Expect(history).To(HaveLen(1))
+	recorded := scheduler.GetRecordedTransaction(seqNum)
+	Expect(recorded).ToNot(BeNil())
+	schedulerTxn = scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixB+baseValue2, nil)
+	record := &RecordedTxn{}
+	dryRunSeqNum, err := schedulerTxn.Commit(WithDryRunRecord(context.Background(), record))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(dryRunSeqNum).To(BeEquivalentTo(1))
+	Expect(record.SeqNum).To(Equal(dryRunSeqNum))
+	Expect(record.Planned).ToNot(BeEmpty())
+	Expect(scheduler.GetRecordedTransaction(dryRunSeqNum)).To(BeNil())
+	Expect(scheduler.GetRecordedTransaction(seqNum)).To(BeIdenticalTo(recorded))
+	Expect(scheduler.GetTransactionHistory(time.Time{}, time.Time{})).To(Equal(history))
+
+	// plan is returned to the caller also without the transaction history
+	scheduler.config.RecordTransactionHistory = false
+	schedulerTxn = scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixB+baseValue2, nil)
+	record = &RecordedTxn{}
+	seqNum, err = schedulerTxn.Commit(WithDryRunRecord(context.Background(), record))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(record.SeqNum).To(Equal(seqNum))
+	Expect(record.DryRun).To(BeTrue())
+	Expect(record.Planned).ToNot(BeEmpty())
+	Expect(record.Planned[0].Operation).To(Equal(TxnOperation_DELETE))
+	Expect(mockSB.GetValue(prefixB + baseValue2)).ToNot(BeNil())
+
+	// close scheduler
+	err = scheduler.Close()
+	Expect(err).To(BeNil())
+}
@@ -36,6 +36,17 @@ type {{ .DescriptorName }}KVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type {{ .DescriptorName }}BatchOp struct {
+	Key         string
+	Value       {{ .ValueT }}
+	PrevValue   {{ .ValueT }}
+	Metadata    {{ .MetadataT }}
+	NewMetadata {{ .MetadataT }}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type {{ .DescriptorName }}Descriptor struct {
@@ -52,11 +63,15 @@ type {{ .DescriptorName }}Descriptor struct {
 	Delete               func(key string, value {{ .ValueT }}, metadata {{ .MetadataT }}) error
 	Update               func(key string, oldValue, newValue {{ .ValueT }}, oldMetadata {{ .MetadataT }}) (newMetadata {{ .MetadataT }}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue {{ .ValueT }}, metadata {{ .MetadataT }}) bool
+	CreateBatch          func(ops []*{{ .DescriptorName }}BatchOp) error
+	UpdateBatch          func(ops []*{{ .DescriptorName }}BatchOp) error
+	DeleteBatch          func(ops []*{{ .DescriptorName }}BatchOp) error
 	Retrieve             func(correlate []{{ .DescriptorName }}KVWithMetadata) ([]{{ .DescriptorName }}KVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value {{ .ValueT }}) []KeyValuePair
 	Dependencies         func(key string, value {{ .ValueT }}) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -77,6 +92,7 @@ func New{{ .DescriptorName }}Descriptor(typedDescriptor *{{ .DescriptorName }}De
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -96,6 +112,15 @@ func New{{ .DescriptorName }}Descriptor(typedDescriptor *{{ .DescriptorName }}De
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -177,6 +202,27 @@ func (da *{{ .DescriptorName }}DescriptorAdapter) UpdateWithRecreate(key string,
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *{{ .DescriptorName }}DescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := cast{{ .DescriptorName }}BatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copy{{ .DescriptorName }}BatchResults(ops, typedOps)
+	return err
+}
+
+func (da *{{ .DescriptorName }}DescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := cast{{ .DescriptorName }}BatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copy{{ .DescriptorName }}BatchResults(ops, typedOps)
+	return err
+}
+
+func (da *{{ .DescriptorName }}DescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := cast{{ .DescriptorName }}BatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copy{{ .DescriptorName }}BatchResults(ops, typedOps)
+	return err
+}
+
 func (da *{{ .DescriptorName }}DescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []{{ .DescriptorName }}KVWithMetadata
 	for _, kvpair := range correlate {
@@ -240,6 +286,50 @@ func cast{{ .DescriptorName }}Value(key string, value proto.Message) ({{ .ValueT
 	return typedValue, nil
 }
 
+func cast{{ .DescriptorName }}BatchOps(ops []*BatchOp, withPrevValue bool) []*{{ .DescriptorName }}BatchOp {
+	var typedOps []*{{ .DescriptorName }}BatchOp
+	for _, op := range ops {
+		typedValue, err := cast{{ .DescriptorName }}Value(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue {{ .ValueT }}
+		if withPrevValue {
+			typedPrevValue, err = cast{{ .DescriptorName }}Value(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := cast{{ .DescriptorName }}Metadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &{{ .DescriptorName }}BatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copy{{ .DescriptorName }}BatchResults(ops []*BatchOp, typedOps []*{{ .DescriptorName }}BatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func cast{{ .DescriptorName }}Metadata(key string, metadata Metadata) ({{ .MetadataT }}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -1,13 +1,18 @@
 package kvscheduler
 
 import (
+	"context"
 	"errors"
 	"os"
 
 	"github.com/vishvananda/netns"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
 )
 
 // nonRetryableErrors contains global list of non-retryable errors.
@@ -95,7 +100,7 @@ func (h *descriptorHandler) validate(key string, value proto.Message) error {
 }
 
 // create returns ErrUnimplementedCreate if Create is not provided.
-func (h *descriptorHandler) create(key string, value proto.Message) (metadata kvs.Metadata, err error) {
+func (h *descriptorHandler) create(ctx context.Context, key string, value proto.Message) (metadata kvs.Metadata, err error) {
 	if h.descriptor == nil {
 		return
 	}
@@ -103,6 +108,8 @@ func (h *descriptorHandler) create(key string, value proto.Message) (metadata kv
 		return nil, kvs.ErrUnimplementedCreate
 	}
 	defer trackDescMethod(h.descriptor.Name, "Create")()
+	_, endSpan := h.startSpan(ctx, "Create", attribute.String("kvscheduler.key", key))
+	defer func() { endSpan(err) }()
 	metadata, err = h.descriptor.Create(key, value)
 	if nsErr := checkNetNs(); nsErr != nil {
 		err = nsErr
@@ -112,11 +119,13 @@ func (h *descriptorHandler) create(key string, value proto.Message) (metadata kv
 }
 
 // update is not called if Update is not provided (updateWithRecreate() returns true).
-func (h *descriptorHandler) update(key string, oldValue, newValue proto.Message, oldMetadata kvs.Metadata) (newMetadata kvs.Metadata, err error) {
+func (h *descriptorHandler) update(ctx context.Context, key string, oldValue, newValue proto.Message, oldMetadata kvs.Metadata) (newMetadata kvs.Metadata, err error) {
 	if h.descriptor == nil {
 		return oldMetadata, nil
 	}
 	defer trackDescMethod(h.descriptor.Name, "Update")()
+	_, endSpan := h.startSpan(ctx, "Update", attribute.String("kvscheduler.key", key))
+	defer func() { endSpan(err) }()
 	newMetadata, err = h.descriptor.Update(key, oldValue, newValue, oldMetadata)
 	if nsErr := checkNetNs(); nsErr != nil {
 		err = nsErr
@@ -144,7 +153,7 @@ func (h *descriptorHandler) updateWithRecreate(key string, oldValue, newValue pr
 }
 
 // delete returns ErrUnimplementedDelete if Delete is not provided.
-func (h *descriptorHandler) delete(key string, value proto.Message, metadata kvs.Metadata) error {
+func (h *descriptorHandler) delete(ctx context.Context, key string, value proto.Message, metadata kvs.Metadata) (err error) {
 	if h.descriptor == nil {
 		return nil
 	}
@@ -152,13 +161,64 @@ func (h *descriptorHandler) delete(key string, value proto.Message, metadata kvs
 		return kvs.ErrUnimplementedDelete
 	}
 	defer trackDescMethod(h.descriptor.Name, "Delete")()
-	err := h.descriptor.Delete(key, value, metadata)
+	_, endSpan := h.startSpan(ctx, "Delete", attribute.String("kvscheduler.key", key))
+	defer func() { endSpan(err) }()
+	err = h.descriptor.Delete(key, value, metadata)
 	if nsErr := checkNetNs(); nsErr != nil {
 		err = nsErr
 	}
 	return err
 }
 
+// batchHandler returns batch handler of the descriptor for the given
+// operation, or nil if not defined.
+func (h *descriptorHandler) batchHandler(operation kvscheduler.TxnOperation) (handler func(ops []*kvs.BatchOp) error, name string) {
+	if h.descriptor == nil {
+		return nil, ""
+	}
+	switch operation {
+	case kvscheduler.TxnOperation_CREATE:
+		return h.descriptor.CreateBatch, "CreateBatch"
+	case kvscheduler.TxnOperation_UPDATE:
+		return h.descriptor.UpdateBatch, "UpdateBatch"
+	case kvscheduler.TxnOperation_DELETE:
+		return h.descriptor.DeleteBatch, "DeleteBatch"
+	}
+	return nil, ""
+}
+
+// supportsBatch returns true if the descriptor defines batch handler
+// for the given operation.
+func (h *descriptorHandler) supportsBatch(operation kvscheduler.TxnOperation) bool {
+	handler, _ := h.batchHandler(operation)
+	return handler != nil
+}
+
+// executeBatch executes the given operations using the batch handler
+// of the descriptor. Error returned for the whole batch is reported for every
+// operation without an error of its own.
+func (h *descriptorHandler) executeBatch(ctx context.Context, operation kvscheduler.TxnOperation, ops []*kvs.BatchOp) {
+	handler, name := h.batchHandler(operation)
+	if handler == nil {
+		return
+	}
+	defer trackDescMethod(h.descriptor.Name, name)()
+	_, endSpan := h.startSpan(ctx, name, attribute.Int("kvscheduler.batch_size", len(ops)))
+	err := handler(ops)
+	if nsErr := checkNetNs(); nsErr != nil {
+		err = nsErr
+	}
+	endSpan(err)
+	if err == nil {
+		return
+	}
+	for _, op := range ops {
+		if op.Err == nil {
+			op.Err = err
+		}
+	}
+}
+
 // isRetriableFailure first checks for errors returned by the handler itself.
 // If descriptor does not define IsRetriableFailure, it is assumed any failure
 // can be potentially fixed by retry.
@@ -193,14 +253,26 @@ func (h *descriptorHandler) derivedValues(key string, value proto.Message) (deri
 }
 
 // retrieve returns <ableToRetrieve> as false if descriptor does not implement Retrieve.
-func (h *descriptorHandler) retrieve(correlate []kvs.KVWithMetadata) (values []kvs.KVWithMetadata, ableToRetrieve bool, err error) {
+func (h *descriptorHandler) retrieve(ctx context.Context, correlate []kvs.KVWithMetadata) (values []kvs.KVWithMetadata, ableToRetrieve bool, err error) {
 	if h.descriptor == nil || h.descriptor.Retrieve == nil {
 		return values, false, nil
 	}
 	defer trackDescMethod(h.descriptor.Name, "Retrieve")()
+	span, endSpan := h.startSpan(ctx, "Retrieve")
+	defer func() { endSpan(err) }()
 	values, err = h.descriptor.Retrieve(correlate)
 	if nsErr := checkNetNs(); nsErr != nil {
 		err = nsErr
 	}
+	span.SetAttributes(attribute.Int("kvscheduler.retrieved", len(values)))
 	return values, true, err
 }
+
+// startSpan starts span for a call of the given descriptor method.
+func (h *descriptorHandler) startSpan(ctx context.Context, method string, attrs ...attribute.KeyValue) (span trace.Span, end func(err error)) {
+	attrs = append(attrs, attribute.String("kvscheduler.descriptor", h.descriptor.Name))
+	_, span = tracing.Start(ctx, h.descriptor.Name+"."+method, attrs...)
+	return span, func(err error) {
+		tracing.End(span, err)
+	}
+}
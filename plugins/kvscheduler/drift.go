@@ -0,0 +1,346 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvscheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/graph"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/utils"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+const (
+	// how many of the latest drift reports are kept in memory
+	driftReportHistoryLimit = 100
+)
+
+// DetectDrift compares the actual state of SB, obtained using Retrieve
+// of the given descriptors (all if none are given), with the state in which
+// the scheduler believes SB to be. Nothing is executed, the drift is only
+// recorded and returned as a report. If <heal> is enabled and drift was found,
+// downstream resync limited to the drifted values is scheduled.
+func (s *Scheduler) DetectDrift(heal bool, descriptors ...string) (*kvs.DriftReport, error) {
+	report, err := s.detectDrift(descriptors)
+	if err != nil {
+		return nil, err
+	}
+	if heal && report.HasDrift() {
+		report.HealScheduled = s.healDrift(report)
+	}
+	if report.HasDrift() {
+		s.Log.Warnf("Detected drift of %d value(s) from the intended state: %v",
+			len(report.Drifted), report.DriftedKeys())
+	} else {
+		s.Log.Debugf("No drift detected (descriptors: %v)", report.Descriptors)
+	}
+	s.recordDriftReport(report)
+	reportDrift(report)
+	return report, nil
+}
+
+// GetDriftReports returns all drift reports kept in memory, ordered from
+// the oldest to the latest.
+func (s *Scheduler) GetDriftReports() []*kvs.DriftReport {
+	s.driftLock.Lock()
+	defer s.driftLock.Unlock()
+
+	reports := make([]*kvs.DriftReport, len(s.driftReports))
+	copy(reports, s.driftReports)
+	return reports
+}
+
+// GetLastDriftReport returns the report from the latest run of the drift
+// detection, or nil if the detection has not run yet.
+func (s *Scheduler) GetLastDriftReport() *kvs.DriftReport {
+	s.driftLock.Lock()
+	defer s.driftLock.Unlock()
+
+	if len(s.driftReports) == 0 {
+		return nil
+	}
+	return s.driftReports[len(s.driftReports)-1]
+}
+
+// periodicDriftDetection periodically checks SB for drift from the intended state.
+func (s *Scheduler) periodicDriftDetection() {
+	defer s.wg.Done()
+
+	period := time.Duration(s.config.DriftDetectionPeriod) * time.Second
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(period):
+			_, err := s.DetectDrift(s.config.DriftAutoHeal, s.config.DriftDetectionDescriptors...)
+			if err == kvs.ErrDriftBeforeResync {
+				continue
+			}
+			if err != nil {
+				s.Log.Errorf("Drift detection failed: %v", err)
+			}
+		}
+	}
+}
+
+// detectDrift runs Retrieve of the selected descriptors and compares
+// the retrieved values with the graph.
+// Transaction processing is paused only while a single descriptor is checked,
+// values of different descriptors are therefore compared at different points
+// in time, but each of them against the graph state matching SB.
+func (s *Scheduler) detectDrift(descriptors []string) (*kvs.DriftReport, error) {
+	s.txnLock.Lock()
+	if s.resyncCount == 0 {
+		s.txnLock.Unlock()
+		return nil, kvs.ErrDriftBeforeResync
+	}
+	selected := make(map[string]struct{})
+	for _, descriptor := range descriptors {
+		if s.registry.GetDescriptor(descriptor) == nil {
+			s.txnLock.Unlock()
+			return nil, fmt.Errorf("%w: %s", kvs.ErrUnknownDescriptor, descriptor)
+		}
+		selected[descriptor] = struct{}{}
+	}
+	report := &kvs.DriftReport{
+		Start:  time.Now(),
+		SeqNum: s.driftSeqNumber,
+	}
+	s.driftSeqNumber++
+	allDescriptors := s.registry.GetAllDescriptors()
+	s.txnLock.Unlock()
+
+	ctx, span := tracing.Start(context.Background(), "kvscheduler.detectDrift")
+	defer span.End()
+
+	for _, descriptor := range allDescriptors {
+		if _, isSelected := selected[descriptor.Name]; len(selected) > 0 && !isSelected {
+			continue
+		}
+		s.detectDescriptorDrift(ctx, descriptor, report)
+	}
+
+	sort.Slice(report.Drifted, func(i, j int) bool {
+		return report.Drifted[i].Key < report.Drifted[j].Key
+	})
+	report.Stop = time.Now()
+	return report, nil
+}
+
+// detectDescriptorDrift runs Retrieve of a single descriptor and adds values
+// drifted from the graph into the report. Transaction processing is paused
+// in the meantime, so that Retrieve does not run concurrently with the
+// operations of the descriptor.
+func (s *Scheduler) detectDescriptorDrift(ctx context.Context, descriptor *kvs.KVDescriptor, report *kvs.DriftReport) {
+	s.txnLock.Lock()
+	defer s.txnLock.Unlock()
+
+	graphR := s.graph.Read()
+	defer graphR.Release()
+
+	handler := newDescriptorHandler(descriptor)
+	nodes := graphR.GetNodes(nil, descrValsSelectors(descriptor.Name, true)...)
+	retrieved, ableToRetrieve, err := handler.retrieve(ctx, nodesToKVPairsWithMetadata(nodes))
+	if !ableToRetrieve {
+		return
+	}
+	report.Descriptors = append(report.Descriptors, descriptor.Name)
+	if err != nil {
+		if report.RetrieveErrors == nil {
+			report.RetrieveErrors = make(map[string]string)
+		}
+		report.RetrieveErrors[descriptor.Name] = err.Error()
+		return
+	}
+	report.Drifted = append(report.Drifted, s.compareWithSB(graphR, handler, nodes, retrieved)...)
+}
+
+// compareWithSB compares values of a single descriptor as stored in the graph
+// with the values retrieved from SB.
+func (s *Scheduler) compareWithSB(graphR graph.ReadAccess, handler *descriptorHandler,
+	nodes []graph.Node, retrieved []kvs.KVWithMetadata) (drifted []kvs.DriftedValue) {
+
+	descriptor := handler.descriptor.Name
+	actual := make(map[string]kvs.KVWithMetadata, len(retrieved))
+	for _, kv := range retrieved {
+		actual[kv.Key] = kv
+	}
+
+	for _, node := range nodes {
+		if !isNodeInSyncWithNB(node) {
+			continue
+		}
+		key := node.GetKey()
+		kv, found := actual[key]
+		if !found {
+			drifted = append(drifted, kvs.DriftedValue{
+				Key:        key,
+				Descriptor: descriptor,
+				DriftType:  kvs.MissingValue,
+				Expected:   utils.RecordProtoMessage(node.GetValue()),
+			})
+			continue
+		}
+		if !handler.equivalentValues(key, node.GetValue(), kv.Value) {
+			drifted = append(drifted, kvs.DriftedValue{
+				Key:        key,
+				Descriptor: descriptor,
+				DriftType:  kvs.ModifiedValue,
+				Expected:   utils.RecordProtoMessage(node.GetValue()),
+				Actual:     utils.RecordProtoMessage(kv.Value),
+			})
+			continue
+		}
+		drifted = append(drifted, s.compareDerivedWithSB(node, handler.derivedValues(key, kv.Value))...)
+	}
+
+	// values retrieved as created by NB but unknown to the graph would be
+	// removed by resync
+	for _, kv := range retrieved {
+		if kv.Origin != kvs.FromNB || graphR.GetNode(kv.Key) != nil {
+			continue
+		}
+		drifted = append(drifted, kvs.DriftedValue{
+			Key:        kv.Key,
+			Descriptor: descriptor,
+			DriftType:  kvs.UnexpectedValue,
+			Actual:     utils.RecordProtoMessage(kv.Value),
+		})
+	}
+	return drifted
+}
+
+// compareDerivedWithSB compares values derived from a base value in the graph
+// with values derived from the same base value as retrieved from SB.
+// Properties (derived values without descriptor) are not compared.
+func (s *Scheduler) compareDerivedWithSB(node graph.Node, derivedFromSB []kvs.KeyValuePair) (drifted []kvs.DriftedValue) {
+	baseKey := node.GetKey()
+	actual := make(map[string]proto.Message, len(derivedFromSB))
+	for _, kv := range derivedFromSB {
+		actual[kv.Key] = kv.Value
+	}
+
+	expected := utils.NewMapBasedKeySet()
+	for _, derivedNode := range getDerivedNodes(node) {
+		key := derivedNode.GetKey()
+		descriptor := s.registry.GetDescriptorForKey(key)
+		if descriptor == nil {
+			continue
+		}
+		expected.Add(key)
+		if !isNodeInSyncWithNB(derivedNode) {
+			continue
+		}
+		value, found := actual[key]
+		if !found {
+			drifted = append(drifted, kvs.DriftedValue{
+				Key:        key,
+				BaseKey:    baseKey,
+				Descriptor: descriptor.Name,
+				DriftType:  kvs.MissingValue,
+				Expected:   utils.RecordProtoMessage(derivedNode.GetValue()),
+			})
+			continue
+		}
+		if !newDescriptorHandler(descriptor).equivalentValues(key, derivedNode.GetValue(), value) {
+			drifted = append(drifted, kvs.DriftedValue{
+				Key:        key,
+				BaseKey:    baseKey,
+				Descriptor: descriptor.Name,
+				DriftType:  kvs.ModifiedValue,
+				Expected:   utils.RecordProtoMessage(derivedNode.GetValue()),
+				Actual:     utils.RecordProtoMessage(value),
+			})
+		}
+	}
+
+	for _, kv := range derivedFromSB {
+		if expected.Has(kv.Key) {
+			continue
+		}
+		descriptor := s.registry.GetDescriptorForKey(kv.Key)
+		if descriptor == nil {
+			continue
+		}
+		drifted = append(drifted, kvs.DriftedValue{
+			Key:        kv.Key,
+			BaseKey:    baseKey,
+			Descriptor: descriptor.Name,
+			DriftType:  kvs.UnexpectedValue,
+			Actual:     utils.RecordProtoMessage(kv.Value),
+		})
+	}
+	return drifted
+}
+
+// healDrift schedules downstream resync limited to the drifted values
+// (or their base values for derived values).
+func (s *Scheduler) healDrift(report *kvs.DriftReport) (scheduled bool) {
+	resyncKeys := utils.NewMapBasedKeySet()
+	for _, value := range report.Drifted {
+		if value.BaseKey != "" {
+			resyncKeys.Add(value.BaseKey)
+		} else {
+			resyncKeys.Add(value.Key)
+		}
+	}
+	err := s.enqueueTxn(&transaction{
+		txnType: kvs.NBTransaction,
+		nb: &nbTxn{
+			resyncType:  kvs.DownstreamResync,
+			resyncKeys:  resyncKeys,
+			description: fmt.Sprintf("heal of drift #%d: %s", report.SeqNum, resyncKeys.String()),
+		},
+		created: time.Now(),
+	})
+	if err != nil {
+		s.Log.Errorf("Failed to enqueue resync healing drift #%d: %v", report.SeqNum, err)
+		return false
+	}
+	return true
+}
+
+// recordDriftReport stores the drift report, keeping only a limited number
+// of the latest reports.
+func (s *Scheduler) recordDriftReport(report *kvs.DriftReport) {
+	s.driftLock.Lock()
+	defer s.driftLock.Unlock()
+
+	s.driftReports = append(s.driftReports, report)
+	if overflow := len(s.driftReports) - driftReportHistoryLimit; overflow > 0 {
+		copy(s.driftReports, s.driftReports[overflow:])
+		for i := driftReportHistoryLimit; i < len(s.driftReports); i++ {
+			s.driftReports[i] = nil
+		}
+		s.driftReports = s.driftReports[:driftReportHistoryLimit]
+	}
+}
+
+// isNodeInSyncWithNB returns true if the node represents NB value which
+// is expected to exist in SB.
+func isNodeInSyncWithNB(node graph.Node) bool {
+	if getNodeOrigin(node) != kvs.FromNB {
+		return false
+	}
+	state := getNodeState(node)
+	return state == kvscheduler.ValueState_CONFIGURED || state == kvscheduler.ValueState_DISCOVERED
+}
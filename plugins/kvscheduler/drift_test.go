@@ -0,0 +1,134 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvscheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/protobuf/proto"
+
+	. "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/test"
+)
+
+func TestDriftDetection(t *testing.T) {
+	RegisterTestingT(t)
+
+	// prepare KV Scheduler
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+	defer scheduler.Close()
+
+	// prepare mocks
+	mockSB := test.NewMockSouthbound()
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: string(proto.MessageName(test.NewArrayValue())),
+		DerivedValues: test.ArrayValueDerBuilder,
+		WithMetadata:  true,
+	}, mockSB, 0)
+	scheduler.RegisterKVDescriptor(descriptor1)
+
+	// drift cannot be detected before the startup resync
+	_, err = scheduler.DetectDrift(false)
+	Expect(err).To(Equal(ErrDriftBeforeResync))
+
+	// run startup resync
+	schedulerTxn := scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixA+baseValue1, test.NewArrayValue("item1"))
+	schedulerTxn.SetValue(prefixA+baseValue2, test.NewArrayValue("item1"))
+	_, err = schedulerTxn.Commit(WithResync(testCtx, FullResync, true))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// no drift right after the resync
+	report, err := scheduler.DetectDrift(false)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(report.HasDrift()).To(BeFalse())
+	Expect(report.Descriptors).To(Equal([]string{descriptor1Name}))
+	Expect(report.RetrieveErrors).To(BeEmpty())
+
+	// unknown descriptor
+	_, err = scheduler.DetectDrift(false, "unknown-descriptor")
+	Expect(errors.Is(err, ErrUnknownDescriptor)).To(BeTrue())
+
+	// simulate drift in SB
+	value2 := mockSB.GetValue(prefixA + baseValue2)
+	Expect(value2).ToNot(BeNil())
+	mockSB.SetValue(prefixA+baseValue1, nil, nil, FromNB, false)
+	mockSB.SetValue(prefixA+baseValue2, test.NewArrayValue("item2"), value2.Metadata, FromNB, false)
+	mockSB.SetValue(prefixA+baseValue3, test.NewArrayValue("item1"), nil, FromNB, false)
+	mockSB.PopHistoryOfOps()
+
+	// detect drift without healing
+	report, err = scheduler.DetectDrift(false, descriptor1Name)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(report.HasDrift()).To(BeTrue())
+	Expect(report.HealScheduled).To(BeFalse())
+	Expect(report.Drifted).To(HaveLen(3))
+	Expect(report.Drifted[0].Key).To(Equal(prefixA + baseValue1))
+	Expect(report.Drifted[0].DriftType).To(Equal(MissingValue))
+	Expect(report.Drifted[0].Descriptor).To(Equal(descriptor1Name))
+	Expect(report.Drifted[0].Expected).ToNot(BeNil())
+	Expect(report.Drifted[0].Actual).To(BeNil())
+	Expect(report.Drifted[1].Key).To(Equal(prefixA + baseValue2))
+	Expect(report.Drifted[1].DriftType).To(Equal(ModifiedValue))
+	Expect(report.Drifted[1].Expected).ToNot(BeNil())
+	Expect(report.Drifted[1].Actual).ToNot(BeNil())
+	Expect(report.Drifted[2].Key).To(Equal(prefixA + baseValue3))
+	Expect(report.Drifted[2].DriftType).To(Equal(UnexpectedValue))
+	Expect(report.Drifted[2].Expected).To(BeNil())
+
+	// only Retrieve was called, nothing was executed
+	opHistory := mockSB.PopHistoryOfOps()
+	Expect(opHistory).To(HaveLen(1))
+	Expect(opHistory[0].OpType).To(Equal(test.MockRetrieve))
+	txnCount := len(scheduler.GetTransactionHistory(time.Time{}, time.Time{}))
+
+	// detect drift and heal it
+	report, err = scheduler.DetectDrift(true)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(report.Drifted).To(HaveLen(3))
+	Expect(report.HealScheduled).To(BeTrue())
+	Eventually(func() int {
+		return len(scheduler.GetTransactionHistory(time.Time{}, time.Time{}))
+	}).Should(Equal(txnCount + 1))
+	healTxn := scheduler.GetTransactionHistory(time.Time{}, time.Time{})[txnCount]
+	Expect(healTxn.ResyncType).To(BeEquivalentTo(DownstreamResync))
+	Expect(healTxn.Description).To(ContainSubstring(prefixA + baseValue1))
+
+	// drift has been healed
+	Expect(mockSB.GetValue(prefixA + baseValue1)).ToNot(BeNil())
+	Expect(proto.Equal(mockSB.GetValue(prefixA+baseValue2).Value, test.NewArrayValue("item1"))).To(BeTrue())
+	Expect(mockSB.GetValue(prefixA + baseValue3)).To(BeNil())
+	report, err = scheduler.DetectDrift(false)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(report.HasDrift()).To(BeFalse())
+
+	// reports are recorded
+	reports := scheduler.GetDriftReports()
+	Expect(reports).To(HaveLen(4))
+	for i, report := range reports {
+		Expect(report.SeqNum).To(BeEquivalentTo(i))
+	}
+}
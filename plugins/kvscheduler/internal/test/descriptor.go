@@ -16,6 +16,7 @@ package test
 
 import (
 	"strings"
+	"sync"
 
 	"go.ligato.io/cn-infra/v2/idxmap"
 	"google.golang.org/protobuf/proto"
@@ -39,6 +40,8 @@ const (
 
 // mockDescriptor implements KVDescriptor for UTs.
 type mockDescriptor struct {
+	sync.Mutex // serializes operations executed concurrently
+
 	nextIndex int
 	args      *KVDescriptor
 	sb        *MockSouthbound
@@ -64,6 +67,7 @@ func NewMockDescriptor(args *KVDescriptor, sb *MockSouthbound, firstFreeIndex in
 		UpdateWithRecreate:   args.UpdateWithRecreate,
 		Dependencies:         args.Dependencies,
 		RetrieveDependencies: args.RetrieveDependencies,
+		ConcurrencySafe:      args.ConcurrencySafe,
 	}
 	if args.WithMetadata {
 		descriptor.MetadataMapFactory = func() idxmap.NamedMappingRW {
@@ -76,6 +80,16 @@ func NewMockDescriptor(args *KVDescriptor, sb *MockSouthbound, firstFreeIndex in
 	if args.DerivedValues != nil {
 		descriptor.DerivedValues = mock.DerivedValues
 	}
+	// batch operations are enabled by defining them (as any non-nil function) in args
+	if args.CreateBatch != nil {
+		descriptor.CreateBatch = mock.CreateBatch
+	}
+	if args.UpdateBatch != nil {
+		descriptor.UpdateBatch = mock.UpdateBatch
+	}
+	if args.DeleteBatch != nil {
+		descriptor.DeleteBatch = mock.DeleteBatch
+	}
 
 	// operations that can be left undefined:
 	withoutMap := make(map[WithoutOp]struct{})
@@ -114,8 +128,11 @@ func (md *mockDescriptor) equalValues(key string, v1, v2 proto.Message) bool {
 
 // Create executes create operation in the mock SB.
 func (md *mockDescriptor) Create(key string, value proto.Message) (metadata Metadata, err error) {
+	md.Lock()
+	defer md.Unlock()
+
 	md.validateKey(key, md.args.KeySelector(key))
-	withMeta := md.sb != nil && md.args.WithMetadata && !md.sb.isKeyDerived(key)
+	withMeta := md.sb != nil && md.args.WithMetadata && !md.sb.isDerived(key)
 	if withMeta {
 		metadata = &OnlyInteger{md.nextIndex}
 	}
@@ -131,11 +148,14 @@ func (md *mockDescriptor) Create(key string, value proto.Message) (metadata Meta
 
 // Delete executes del operation in the mock SB.
 func (md *mockDescriptor) Delete(key string, value proto.Message, metadata Metadata) (err error) {
+	md.Lock()
+	defer md.Unlock()
+
 	md.validateKey(key, md.args.KeySelector(key))
 	if md.sb != nil {
 		kv := md.sb.GetValue(key)
 		md.validateKey(key, kv != nil)
-		if md.sb.isKeyDerived(key) {
+		if md.sb.isDerived(key) {
 			// re-generated on refresh
 			md.validateKey(key, md.equalValues(key, kv.Value, value))
 		} else {
@@ -149,12 +169,15 @@ func (md *mockDescriptor) Delete(key string, value proto.Message, metadata Metad
 
 // Update executes update operation in the mock SB.
 func (md *mockDescriptor) Update(key string, oldValue, newValue proto.Message, oldMetadata Metadata) (newMetadata Metadata, err error) {
+	md.Lock()
+	defer md.Unlock()
+
 	md.validateKey(key, md.args.KeySelector(key))
 	newMetadata = oldMetadata
 	if md.sb != nil {
 		kv := md.sb.GetValue(key)
 		md.validateKey(key, kv != nil)
-		if md.sb.isKeyDerived(key) {
+		if md.sb.isDerived(key) {
 			// re-generated on refresh
 			md.validateKey(key, md.equalValues(key, kv.Value, oldValue))
 		} else {
@@ -166,6 +189,30 @@ func (md *mockDescriptor) Update(key string, oldValue, newValue proto.Message, o
 	return newMetadata, err
 }
 
+// CreateBatch executes create operations in the mock SB one by one.
+func (md *mockDescriptor) CreateBatch(ops []*BatchOp) error {
+	for _, op := range ops {
+		op.NewMetadata, op.Err = md.Create(op.Key, op.Value)
+	}
+	return nil
+}
+
+// UpdateBatch executes update operations in the mock SB one by one.
+func (md *mockDescriptor) UpdateBatch(ops []*BatchOp) error {
+	for _, op := range ops {
+		op.NewMetadata, op.Err = md.Update(op.Key, op.PrevValue, op.Value, op.Metadata)
+	}
+	return nil
+}
+
+// DeleteBatch executes delete operations in the mock SB one by one.
+func (md *mockDescriptor) DeleteBatch(ops []*BatchOp) error {
+	for _, op := range ops {
+		op.Err = md.Delete(op.Key, op.Value, op.Metadata)
+	}
+	return nil
+}
+
 // Dependencies uses provided DerValuesBuilder.
 func (md *mockDescriptor) DerivedValues(key string, value proto.Message) []KeyValuePair {
 	md.validateKey(key, md.args.KeySelector(key))
@@ -161,10 +161,20 @@ func (ms *MockSouthbound) isKeyDerived(key string) bool {
 	return isDerived
 }
 
+// isDerived is a variant of isKeyDerived which acquires the lock.
+// Used by MockDescriptor.
+func (ms *MockSouthbound) isDerived(key string) bool {
+	ms.Lock()
+	defer ms.Unlock()
+	return ms.isKeyDerived(key)
+}
+
 // registerKeyWithInvalidData is used to remember that for the given key invalid input
 // data were provided.
 func (ms *MockSouthbound) registerKeyWithInvalidData(key string) {
 	//panic(key)
+	ms.Lock()
+	defer ms.Unlock()
 	ms.invalidKeyData[key] = struct{}{}
 }
 
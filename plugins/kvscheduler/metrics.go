@@ -25,7 +25,9 @@ import (
 // Set of raw Prometheus metrics.
 // Labels
 // * txn_type
+// * priority
 // * slice
+// * descriptor
 // Do not increment directly, use Report* methods.
 var (
 	transactionsProcessed = prometheus.NewCounter(prometheus.CounterOpts{
@@ -44,7 +46,7 @@ var (
 		Namespace: "ligato",
 		Subsystem: "kvscheduler",
 		Name:      "queue_capacity",
-		Help:      "The capacity of the transactions queue.",
+		Help:      "The capacity of the transactions queue (summed over all priorities).",
 	})
 	queueLength = prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: "ligato",
@@ -52,6 +54,14 @@ var (
 		Name:      "queue_length",
 		Help:      "The number of transactions in the queue.",
 	})
+	queuePriorityLength = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ligato",
+		Subsystem: "kvscheduler",
+		Name:      "queue_priority_length",
+		Help:      "The number of transactions in the queue by priority.",
+	},
+		[]string{"priority"},
+	)
 	queueWaitSeconds = prometheus.NewSummaryVec(prometheus.SummaryOpts{
 		Namespace: "ligato",
 		Subsystem: "kvscheduler",
@@ -59,8 +69,14 @@ var (
 		Help:      "Wait time in queue for transactions.",
 		MaxAge:    time.Second * 30,
 	},
-		[]string{"txn_type"},
+		[]string{"txn_type", "priority"},
 	)
+	sbNotifCoalesced = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "ligato",
+		Subsystem: "kvscheduler",
+		Name:      "sb_notif_coalesced",
+		Help:      "The total number of SB notification values merged into already queued notifications.",
+	})
 	txnProcessDurationSeconds = prometheus.NewSummaryVec(prometheus.SummaryOpts{
 		Namespace: "ligato",
 		Subsystem: "kvscheduler",
@@ -78,6 +94,26 @@ var (
 	},
 		[]string{"txn_type"},
 	)
+	driftDetections = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "ligato",
+		Subsystem: "kvscheduler",
+		Name:      "drift_detections",
+		Help:      "The total number of drift detection runs.",
+	})
+	driftedValues = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "ligato",
+		Subsystem: "kvscheduler",
+		Name:      "drifted_values",
+		Help:      "The number of values found drifted by the latest drift detection.",
+	},
+		[]string{"descriptor"},
+	)
+	driftHeals = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "ligato",
+		Subsystem: "kvscheduler",
+		Name:      "drift_heals",
+		Help:      "The total number of resyncs scheduled to heal drift.",
+	})
 )
 
 func init() {
@@ -85,9 +121,14 @@ func init() {
 	prometheus.MustRegister(transactionsDropped)
 	prometheus.MustRegister(queueCapacity)
 	prometheus.MustRegister(queueLength)
+	prometheus.MustRegister(queuePriorityLength)
 	prometheus.MustRegister(queueWaitSeconds)
+	prometheus.MustRegister(sbNotifCoalesced)
 	prometheus.MustRegister(txnProcessDurationSeconds)
 	prometheus.MustRegister(txnDurationSeconds)
+	prometheus.MustRegister(driftDetections)
+	prometheus.MustRegister(driftedValues)
+	prometheus.MustRegister(driftHeals)
 }
 
 func reportTxnProcessed(typ kvs.TxnType, sec float64) {
@@ -103,14 +144,32 @@ func reportQueueCap(c int) {
 	queueCapacity.Set(float64(c))
 }
 
-func reportQueued(n int) {
+func reportQueued(priority kvs.TxnPriority, n int) {
 	queueLength.Add(float64(n))
+	queuePriorityLength.WithLabelValues(priority.String()).Add(float64(n))
+}
+
+func reportQueueWait(typ kvs.TxnType, priority kvs.TxnPriority, sec float64) {
+	queueWaitSeconds.WithLabelValues(typ.String(), priority.String()).Observe(sec)
 }
 
-func reportQueueWait(typ kvs.TxnType, sec float64) {
-	queueWaitSeconds.WithLabelValues(typ.String()).Observe(sec)
+func reportSBNotifCoalesced(n int) {
+	sbNotifCoalesced.Add(float64(n))
 }
 
 func reportTxnProcessDuration(slice string, sec float64) {
 	txnProcessDurationSeconds.WithLabelValues(slice).Observe(sec)
 }
+
+func reportDrift(report *kvs.DriftReport) {
+	driftDetections.Inc()
+	for _, descriptor := range report.Descriptors {
+		driftedValues.WithLabelValues(descriptor).Set(0)
+	}
+	for _, value := range report.Drifted {
+		driftedValues.WithLabelValues(value.Descriptor).Inc()
+	}
+	if report.HealScheduled {
+		driftHeals.Inc()
+	}
+}
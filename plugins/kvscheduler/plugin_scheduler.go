@@ -17,11 +17,11 @@ package kvscheduler
 import (
 	"context"
 	"os"
-	"runtime/trace"
 	"sync"
 	"time"
 
 	"github.com/go-errors/errors"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
 
 	"go.ligato.io/cn-infra/v2/idxmap"
@@ -29,6 +29,7 @@ import (
 	"go.ligato.io/cn-infra/v2/infra"
 	"go.ligato.io/cn-infra/v2/rpc/rest"
 
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/graph"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/registry"
@@ -65,6 +66,29 @@ const (
 	// to stdout
 	defaultPrintTxnSummary = true
 
+	// by default, a single file of the transaction journal is limited to 1MiB
+	defaultTxnJournalMaxFileSize = 1024 // in KiB
+
+	// by default, the transaction journal is rotated over 5 files
+	defaultTxnJournalMaxFiles = 5
+
+	// by default, the periodic drift detection is disabled
+	defaultDriftDetectionPeriod = 0 // in seconds
+
+	// by default, detected drift is only reported, not healed
+	defaultDriftAutoHeal = false
+
+	// by default, up to 8 independent operations of concurrency-safe descriptors
+	// are executed in parallel
+	defaultTxnExecWorkers = 8
+
+	// by default, queued transaction is raised to the next priority class
+	// after every 5 seconds of waiting
+	defaultTxnPriorityAging = 5 // in seconds
+
+	// maximum number of queued transactions of the same priority
+	txnQueueCapacity = 100
+
 	// name of the environment variable used to enable verification after every transaction
 	verifyModeEnv = "KVSCHED_VERIFY_MODE"
 
@@ -101,7 +125,7 @@ type Scheduler struct {
 
 	// TXN processing
 	txnLock      sync.Mutex // can be used to pause transaction processing; always lock before the graph!
-	txnQueue     chan *transaction
+	txnQueue     *txnQueue
 	txnSeqNumber uint64
 	resyncCount  uint
 
@@ -114,6 +138,16 @@ type Scheduler struct {
 	txnHistory  []*kvs.RecordedTxn // ordered from the oldest to the latest
 	startTime   time.Time
 
+	// TXN journal
+	txnJournal           *txnJournal  // nil if disabled
+	interruptedKeys      utils.KeySet // values touched by txns interrupted by restart
+	resyncAllInterrupted bool         // true if the keys of an interrupted txn are not known
+
+	// drift detection
+	driftLock      sync.Mutex
+	driftReports   []*kvs.DriftReport // ordered from the oldest to the latest
+	driftSeqNumber uint64
+
 	// debugging
 	verifyMode   bool
 	logGraphWalk bool
@@ -127,11 +161,19 @@ type Deps struct {
 
 // Config holds the KVScheduler configuration.
 type Config struct {
-	RecordTransactionHistory      bool   `json:"record-transaction-history"`
-	TransactionHistoryAgeLimit    uint32 `json:"transaction-history-age-limit"`    // in minutes
-	PermanentlyRecordedInitPeriod uint32 `json:"permanently-recorded-init-period"` // in minutes
-	EnableTxnSimulation           bool   `json:"enable-txn-simulation"`
-	PrintTxnSummary               bool   `json:"print-txn-summary"`
+	RecordTransactionHistory      bool     `json:"record-transaction-history"`
+	TransactionHistoryAgeLimit    uint32   `json:"transaction-history-age-limit"`    // in minutes
+	PermanentlyRecordedInitPeriod uint32   `json:"permanently-recorded-init-period"` // in minutes
+	EnableTxnSimulation           bool     `json:"enable-txn-simulation"`
+	PrintTxnSummary               bool     `json:"print-txn-summary"`
+	TxnJournalDir                 string   `json:"txn-journal-dir"`           // empty to disable the journal
+	TxnJournalMaxFileSize         uint32   `json:"txn-journal-max-file-size"` // in KiB
+	TxnJournalMaxFiles            uint32   `json:"txn-journal-max-files"`
+	DriftDetectionPeriod          uint32   `json:"drift-detection-period"`      // in seconds, 0 to disable
+	DriftDetectionDescriptors     []string `json:"drift-detection-descriptors"` // empty for all
+	DriftAutoHeal                 bool     `json:"drift-auto-heal"`
+	TxnExecWorkers                uint32   `json:"txn-exec-workers"`   // 0 or 1 for sequential execution
+	TxnPriorityAging              uint32   `json:"txn-priority-aging"` // in seconds, 0 to disable
 }
 
 // SchedulerTxn implements transaction for the KV scheduler.
@@ -156,6 +198,12 @@ func (s *Scheduler) Init() error {
 		PermanentlyRecordedInitPeriod: defaultPermanentlyRecordedInitPeriod,
 		EnableTxnSimulation:           defaultEnableTxnSimulation,
 		PrintTxnSummary:               defaultPrintTxnSummary,
+		TxnJournalMaxFileSize:         defaultTxnJournalMaxFileSize,
+		TxnJournalMaxFiles:            defaultTxnJournalMaxFiles,
+		DriftDetectionPeriod:          defaultDriftDetectionPeriod,
+		DriftAutoHeal:                 defaultDriftAutoHeal,
+		TxnExecWorkers:                defaultTxnExecWorkers,
+		TxnPriorityAging:              defaultTxnPriorityAging,
 	}
 
 	// load configuration
@@ -178,15 +226,22 @@ func (s *Scheduler) Init() error {
 	s.graph = graph.NewGraph(graphOpts)
 	// initialize registry for key->descriptor lookups
 	s.registry = registry.NewRegistry()
-	// prepare channel for serializing transactions
-	s.txnQueue = make(chan *transaction, 100)
-	reportQueueCap(cap(s.txnQueue))
+	// prepare priority queue for serializing transactions
+	s.txnQueue = newTxnQueue(txnQueueCapacity, time.Duration(s.config.TxnPriorityAging)*time.Second)
+	reportQueueCap(3 * txnQueueCapacity) // low, normal and high priority
 	// register REST API handlers
-	s.registerHandlers(s.HTTPHandlers)
+	s.registerHandlers(tracing.HTTPHandlers(s.HTTPHandlers))
 	// initialize key-set used to mark values with updated status
 	s.updatedStates = utils.NewSliceBasedKeySet()
 	// record startup time
 	s.startTime = time.Now()
+	// open transaction journal and restore history from before the restart
+	if s.config.TxnJournalDir != "" {
+		if err = s.openTxnJournal(); err != nil {
+			s.Log.Error(err)
+			return err
+		}
+	}
 
 	// enable or disable debugging mode
 	s.verifyMode = os.Getenv(verifyModeEnv) != ""
@@ -201,6 +256,12 @@ func (s *Scheduler) Init() error {
 		s.wg.Add(1)
 		go s.transactionHistoryTrimming()
 	}
+
+	// go routine periodically checking SB for drift from the intended state
+	if s.config.DriftDetectionPeriod > 0 {
+		s.wg.Add(1)
+		go s.periodicDriftDetection()
+	}
 	return nil
 }
 
@@ -221,6 +282,9 @@ func (s *Scheduler) loadConfig(config *Config) error {
 func (s *Scheduler) Close() error {
 	s.cancel()
 	s.wg.Wait()
+	if s.txnJournal != nil {
+		return s.txnJournal.close()
+	}
 	return nil
 }
 
@@ -433,8 +497,8 @@ func (txn *SchedulerTxn) SetValue(key string, value proto.Message) kvs.Txn {
 // Operations with unmet dependencies will get postponed and possibly
 // executed later.
 func (txn *SchedulerTxn) Commit(ctx context.Context) (txnSeqNum uint64, err error) {
-	ctx, task := trace.NewTask(ctx, "scheduler.Commit")
-	defer task.End()
+	ctx, span := tracing.Start(ctx, "kvscheduler.Commit", attribute.Int("kvscheduler.values", len(txn.values)))
+	defer func() { tracing.End(span, err) }()
 
 	txnSeqNum = ^uint64(0)
 
@@ -461,7 +525,11 @@ func (txn *SchedulerTxn) Commit(ctx context.Context) (txnSeqNum uint64, err erro
 	txnData.nb.retryArgs, txnData.nb.retryEnabled = kvs.IsWithRetry(ctx)
 	txnData.nb.revertOnFailure = kvs.IsWithRevert(ctx)
 	txnData.nb.description, _ = kvs.IsWithDescription(ctx)
-	txnData.nb.withSimulation = txn.scheduler.config.EnableTxnSimulation || kvs.IsWithSimulation(ctx)
+	txnData.priority, _ = kvs.IsWithPriority(ctx)
+	txnData.nb.dryRun = kvs.IsDryRun(ctx)
+	txnData.nb.dryRunRecord, _ = kvs.IsWithDryRunRecord(ctx)
+	txnData.nb.withSimulation = txn.scheduler.config.EnableTxnSimulation || kvs.IsWithSimulation(ctx) ||
+		txnData.nb.dryRun
 
 	// validate transaction options
 	if txnData.nb.resyncType == kvs.DownstreamResync && len(txnData.values) > 0 {
@@ -470,6 +538,9 @@ func (txn *SchedulerTxn) Commit(ctx context.Context) (txnSeqNum uint64, err erro
 	if txnData.nb.revertOnFailure && txnData.nb.resyncType != kvs.NotResync {
 		return txnSeqNum, kvs.NewTransactionError(kvs.ErrRevertNotSupportedWithResync, nil)
 	}
+	if txnData.nb.dryRun && txnData.nb.resyncType == kvs.DownstreamResync {
+		return txnSeqNum, kvs.NewTransactionError(kvs.ErrDryRunNotSupportedWithDownstreamResync, nil)
+	}
 
 	// enqueue txn and for blocking Commit wait for the errors
 	if txnData.nb.isBlocking {
@@ -488,7 +559,7 @@ func (txn *SchedulerTxn) Commit(ctx context.Context) (txnSeqNum uint64, err erro
 			return txnSeqNum, kvs.NewTransactionError(kvs.ErrTxnWaitCanceled, nil)
 		case txnResult := <-txnData.nb.resultChan:
 			close(txnData.nb.resultChan)
-			trace.Logf(ctx, "txnSeqNum", "%d", txnResult.txnSeqNum)
+			span.SetAttributes(attribute.Int64("kvscheduler.txn_seq_num", int64(txnResult.txnSeqNum)))
 			return txnResult.txnSeqNum, txnResult.err
 		}
 	}
@@ -15,6 +15,7 @@
 package kvscheduler
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -22,6 +23,7 @@ import (
 	"go.ligato.io/cn-infra/v2/logging"
 	"google.golang.org/protobuf/encoding/prototext"
 
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/graph"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/utils"
@@ -43,9 +45,12 @@ type resyncData struct {
 
 // refreshGraph updates all/some values in the graph to their *real* state
 // using the Retrieve methods from descriptors.
-func (s *Scheduler) refreshGraph(graphW graph.RWAccess,
+func (s *Scheduler) refreshGraph(ctx context.Context, graphW graph.RWAccess,
 	keys utils.KeySet, resyncData *resyncData, verbose bool,
 ) {
+	ctx, span := tracing.Start(ctx, "kvscheduler.refreshGraph")
+	defer span.End()
+
 	if s.logGraphWalk {
 		keysToRefresh := "<ALL>"
 		if keys != nil && keys.Length() > 0 {
@@ -114,7 +119,7 @@ func (s *Scheduler) refreshGraph(graphW graph.RWAccess,
 		}
 
 		// execute Retrieve operation
-		retrieved, ableToRetrieve, err := handler.retrieve(correlate)
+		retrieved, ableToRetrieve, err := handler.retrieve(ctx, correlate)
 
 		// mark un-retrievable as refreshed
 		if !ableToRetrieve || err != nil {
@@ -118,6 +118,18 @@ const (
 	// statusURL is URL used to print the state of values under the given
 	// descriptor / key-prefix or all of them.
 	statusURL = urlPrefix + "status"
+
+	// driftURL is URL used to obtain the latest drift report (GET)
+	// or to run the drift detection right away (POST).
+	driftURL = urlPrefix + "drift"
+
+	// historyArg is the name of the argument used for "drift" GET API to return
+	// all recorded drift reports instead of just the latest one.
+	historyArg = "history"
+
+	// healArg is the name of the argument used for "drift" POST API to tell
+	// whether to schedule resync of drifted values.
+	healArg = "heal"
 )
 
 // errorString wraps string representation of an error that, unlike the original
@@ -162,6 +174,8 @@ func (s *Scheduler) registerHandlers(http rest.HTTPHandlers) {
 	http.RegisterHTTPHandler(downstreamResyncURL, s.downstreamResyncPostHandler, "POST")
 	http.RegisterHTTPHandler(dumpURL, s.dumpGetHandler, "GET")
 	http.RegisterHTTPHandler(statusURL, s.statusGetHandler, "GET")
+	http.RegisterHTTPHandler(driftURL, s.driftGetHandler, "GET")
+	http.RegisterHTTPHandler(driftURL, s.driftPostHandler, "POST")
 	http.RegisterHTTPHandler(urlPrefix+"graph", s.graphHandler, "GET")
 	http.RegisterHTTPHandler(urlPrefix+"stats", s.statsHandler, "GET")
 }
@@ -337,6 +351,110 @@ func (s *Scheduler) flagStatsGetHandler(formatter *render.Render) http.HandlerFu
 	}
 }
 
+// driftGetHandler is the GET handler for "drift" API.
+func (s *Scheduler) driftGetHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		args := req.URL.Query()
+
+		// parse optional *format* argument (default = JSON)
+		format, err := parseFormatArg(args)
+		if err != nil {
+			s.logError(formatter.JSON(w, http.StatusInternalServerError, errorString{err.Error()}))
+			return
+		}
+
+		// parse optional *history* argument
+		history := false
+		if historyStr, withHistory := args[historyArg]; withHistory && len(historyStr) == 1 {
+			historyVal := historyStr[0]
+			if historyVal == "true" || historyVal == "1" {
+				history = true
+			}
+		}
+
+		var reports []*kvs.DriftReport
+		if history {
+			reports = s.GetDriftReports()
+		} else if report := s.GetLastDriftReport(); report != nil {
+			reports = append(reports, report)
+		}
+		if len(reports) == 0 {
+			err := errors.New("drift detection has not run yet")
+			s.logError(formatter.JSON(w, http.StatusNotFound, errorString{err.Error()}))
+			return
+		}
+		s.writeDriftReports(formatter, w, format, history, reports)
+	}
+}
+
+// driftPostHandler is the POST handler for "drift" API.
+func (s *Scheduler) driftPostHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		args := req.URL.Query()
+
+		// parse optional *format* argument (default = JSON)
+		format, err := parseFormatArg(args)
+		if err != nil {
+			s.logError(formatter.JSON(w, http.StatusInternalServerError, errorString{err.Error()}))
+			return
+		}
+
+		// parse optional *heal* argument
+		heal := false
+		if healStr, withHeal := args[healArg]; withHeal && len(healStr) == 1 {
+			healVal := healStr[0]
+			if healVal == "true" || healVal == "1" {
+				heal = true
+			}
+		}
+
+		// optional *descriptor* argument(s) - if not defined, all descriptors are checked
+		report, err := s.DetectDrift(heal, args[descriptorArg]...)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, kvs.ErrUnknownDescriptor) {
+				status = http.StatusBadRequest
+			} else if errors.Is(err, kvs.ErrDriftBeforeResync) {
+				status = http.StatusServiceUnavailable
+			}
+			s.logError(formatter.JSON(w, status, errorString{err.Error()}))
+			return
+		}
+		s.writeDriftReports(formatter, w, format, false, []*kvs.DriftReport{report})
+	}
+}
+
+// writeDriftReports writes drift report(s) in the requested format.
+func (s *Scheduler) writeDriftReports(formatter *render.Render, w http.ResponseWriter,
+	format string, history bool, reports []*kvs.DriftReport) {
+
+	if format == formatText {
+		var str strings.Builder
+		for _, report := range reports {
+			str.WriteString(report.String())
+		}
+		s.logError(formatter.Text(w, http.StatusOK, str.String()))
+		return
+	}
+	if history {
+		s.logError(formatter.JSON(w, http.StatusOK, reports))
+		return
+	}
+	s.logError(formatter.JSON(w, http.StatusOK, reports[0]))
+}
+
+// parseFormatArg parses optional *format* argument (default = JSON).
+func parseFormatArg(args url.Values) (string, error) {
+	if formatStr, withFormat := args[formatArg]; withFormat && len(formatStr) == 1 {
+		format := formatStr[0]
+		if format != formatJSON && format != formatText {
+			return "", errors.New("unrecognized output format")
+		}
+		return format, nil
+	}
+	return formatJSON, nil
+}
+
 // downstreamResyncPostHandler is the POST handler for "downstream-resync" API.
 func (s *Scheduler) downstreamResyncPostHandler(formatter *render.Render) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
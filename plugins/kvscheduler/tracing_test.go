@@ -0,0 +1,98 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvscheduler
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"google.golang.org/protobuf/proto"
+
+	. "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/test"
+)
+
+func TestTransactionSpans(t *testing.T) {
+	RegisterTestingT(t)
+
+	// record spans
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(prevProvider)
+
+	// prepare KV Scheduler
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+	defer scheduler.Close()
+
+	// prepare mocks
+	mockSB := test.NewMockSouthbound()
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: string(proto.MessageName(test.NewStringValue(""))),
+		WithMetadata:  true,
+	}, mockSB, 0)
+	scheduler.RegisterKVDescriptor(descriptor1)
+
+	// run startup resync
+	schedulerTxn := scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixA+baseValue1, test.NewStringValue("value1"))
+	schedulerTxn.SetValue(prefixA+baseValue2, test.NewStringValue("value2"))
+	_, err = schedulerTxn.Commit(WithResync(testCtx, FullResync, true))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// transaction span is ended only after the result is returned to Commit
+	spans := make(map[string][]sdktrace.ReadOnlySpan)
+	Eventually(func() map[string][]sdktrace.ReadOnlySpan {
+		spans = make(map[string][]sdktrace.ReadOnlySpan)
+		for _, span := range recorder.Ended() {
+			spans[span.Name()] = append(spans[span.Name()], span)
+		}
+		return spans
+	}).Should(HaveKey("kvscheduler.processTransaction"))
+	for _, name := range []string{
+		"kvscheduler.Commit", "kvscheduler.queue", "kvscheduler.processTransaction",
+		"kvscheduler.preProcessTransaction", "kvscheduler.refreshGraph", "kvscheduler.order",
+		"kvscheduler.execute", "kvscheduler.recordTransaction", "kvscheduler.postProcessTransaction",
+		descriptor1Name + ".Retrieve",
+	} {
+		Expect(spans).To(HaveKeyWithValue(name, HaveLen(1)), name)
+	}
+	Expect(spans).To(HaveKeyWithValue(descriptor1Name+".Create", HaveLen(2)))
+
+	// check the hierarchy of spans
+	commit := spans["kvscheduler.Commit"][0]
+	process := spans["kvscheduler.processTransaction"][0]
+	execute := spans["kvscheduler.execute"][0]
+	refresh := spans["kvscheduler.refreshGraph"][0]
+	Expect(spans["kvscheduler.queue"][0].Parent().SpanID()).To(Equal(commit.SpanContext().SpanID()))
+	Expect(process.Parent().SpanID()).To(Equal(commit.SpanContext().SpanID()))
+	Expect(execute.Parent().SpanID()).To(Equal(process.SpanContext().SpanID()))
+	Expect(spans[descriptor1Name+".Retrieve"][0].Parent().SpanID()).To(Equal(refresh.SpanContext().SpanID()))
+	for _, create := range spans[descriptor1Name+".Create"] {
+		Expect(create.Parent().SpanID()).To(Equal(execute.SpanContext().SpanID()))
+		Expect(create.SpanContext().TraceID()).To(Equal(commit.SpanContext().TraceID()))
+	}
+}
@@ -15,15 +15,17 @@
 package kvscheduler
 
 import (
+	"context"
 	"fmt"
-	"runtime/trace"
 	"sort"
 	"strings"
 
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
 
 	"go.ligato.io/cn-infra/v2/logging"
 
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/graph"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/utils"
@@ -32,6 +34,7 @@ import (
 
 // applyValueArgs collects all arguments to applyValue method.
 type applyValueArgs struct {
+	ctx     context.Context // carries span of the transaction phase
 	graphW  graph.RWAccess
 	txn     *transaction
 	kv      kvForTxn
@@ -43,6 +46,10 @@ type applyValueArgs struct {
 	isRetry bool
 	dryRun  bool
 
+	// operations already executed (in parallel or in batches) within the current
+	// execution wave
+	prepared map[string]*preparedOp
+
 	// set inside of the recursive chain of applyValue-s
 	isDepUpdate bool
 	isDerived   bool
@@ -57,10 +64,16 @@ type applyValueArgs struct {
 // and the graph will be returned to its original state at the end.
 func (s *Scheduler) executeTransaction(txn *transaction, graphW graph.RWAccess, dryRun bool) (executed kvs.RecordedTxnOps) {
 	op := "execute transaction"
+	spanName := "kvscheduler.execute"
 	if dryRun {
 		op = "simulate transaction"
+		spanName = "kvscheduler.simulate"
 	}
-	defer trace.StartRegion(txn.ctx, op).End()
+	ctx, span := tracing.Start(txn.ctx, spanName, attribute.Int("kvscheduler.values", len(txn.values)))
+	defer func() {
+		span.SetAttributes(attribute.Int("kvscheduler.operations", len(executed)))
+		span.End()
+	}()
 	if dryRun {
 		defer trackTransactionMethod("simulateTransaction")()
 	} else {
@@ -78,21 +91,50 @@ func (s *Scheduler) executeTransaction(txn *transaction, graphW graph.RWAccess,
 
 	prevValues := make([]kvs.KeyValuePair, 0, len(txn.values))
 
+	// the plan (from the simulation) is used to execute independent operations
+	// in waves, which are used only until the execution diverges from the plan
+	inWaves := !dryRun && len(txn.plan) == len(txn.values) && s.canExecInWaves(txn)
+	var (
+		prepared map[string]*preparedOp
+		waveEnd  int
+		diverged bool
+	)
+	if dryRun {
+		txn.plan = nil
+	}
+
 	// execute transaction either in best-effort mode or with revert on the first failure
 	var revert bool
-	for _, kv := range txn.values {
+	for i, kv := range txn.values {
+		if !dryRun && i > 0 && (!inWaves || i >= waveEnd) && s.preemptResync(txn) {
+			// the remaining operations are executed by the restarted resync
+			break
+		}
+		if inWaves && i >= waveEnd {
+			prepared = nil
+			if !diverged {
+				prepared, waveEnd = s.executeWave(ctx, txn, graphW, i)
+			}
+		}
 		applied.Add(kv.key)
 		ops, prevValue, err := s.applyValue(&applyValueArgs{
-			graphW:  graphW,
-			txn:     txn,
-			kv:      kv,
-			baseKey: kv.key,
-			applied: applied,
-			dryRun:  dryRun,
-			isRetry: txn.txnType == kvs.RetryFailedOps,
-			branch:  branch,
+			ctx:      ctx,
+			graphW:   graphW,
+			txn:      txn,
+			kv:       kv,
+			baseKey:  kv.key,
+			applied:  applied,
+			dryRun:   dryRun,
+			isRetry:  txn.txnType == kvs.RetryFailedOps,
+			branch:   branch,
+			prepared: prepared,
 		})
 		executed = append(executed, ops...)
+		if dryRun {
+			txn.plan = append(txn.plan, ops)
+		} else if inWaves && !diverged {
+			diverged = hasFailedOp(ops)
+		}
 		prevValues = append(prevValues, kvs.KeyValuePair{})
 		copy(prevValues[1:], prevValues)
 		prevValues[0] = prevValue
@@ -101,7 +143,7 @@ func (s *Scheduler) executeTransaction(txn *transaction, graphW graph.RWAccess,
 				// refresh failed value and trigger reverting
 				// (not dry-run)
 				failedKey := utils.NewSingletonKeySet(kv.key)
-				s.refreshGraph(graphW, failedKey, nil, true)
+				s.refreshGraph(ctx, graphW, failedKey, nil, true)
 				revert = true
 				break
 			}
@@ -116,6 +158,7 @@ func (s *Scheduler) executeTransaction(txn *transaction, graphW graph.RWAccess,
 		// revert back to previous values
 		for _, kvPair := range prevValues {
 			ops, _, _ := s.applyValue(&applyValueArgs{
+				ctx:    ctx,
 				graphW: graphW,
 				txn:    txn,
 				kv: kvForTxn{
@@ -135,6 +178,16 @@ func (s *Scheduler) executeTransaction(txn *transaction, graphW graph.RWAccess,
 
 	// get rid of uninteresting intermediate pending Create/Delete operations
 	executed = s.compressTxnOps(executed)
+
+	// record unmet dependencies of values left pending
+	for _, txnOp := range executed {
+		if txnOp.NewState != kvscheduler.ValueState_PENDING {
+			continue
+		}
+		if node := graphW.GetNode(txnOp.Key); node != nil {
+			txnOp.UnmetDeps = getValueDetails(node)
+		}
+	}
 	return executed
 }
 
@@ -357,7 +410,11 @@ func (s *Scheduler) applyDelete(node graph.NodeRW, txnOp *kvs.RecordedTxnOp, arg
 	handler := newDescriptorHandler(descriptor)
 	if !args.dryRun && descriptor != nil {
 		if args.kv.origin != kvs.FromSB {
-			err = handler.delete(node.GetKey(), node.GetValue(), node.GetMetadata())
+			if op := s.takePreparedOp(args, kvscheduler.TxnOperation_DELETE); op != nil {
+				err = op.err
+			} else {
+				err = handler.delete(args.ctx, node.GetKey(), node.GetValue(), node.GetMetadata())
+			}
 		}
 		if err != nil {
 			retriableErr = handler.isRetriableFailure(err)
@@ -404,8 +461,9 @@ func (s *Scheduler) applyCreate(node graph.NodeRW, txnOp *kvs.RecordedTxnOp, arg
 		node.SetFlags(&DerivedFlag{baseKey: args.baseKey})
 	}
 
-	// validate value
-	if !args.dryRun && args.kv.origin == kvs.FromNB {
+	// validate value (validation has no side effects, therefore it is included
+	// in the plan of a dry-run transaction)
+	if (!args.dryRun || isDryRun(args.txn)) && args.kv.origin == kvs.FromNB {
 		err = handler.validate(node.GetKey(), node.GetValue())
 		if err != nil {
 			node.SetFlags(&UnavailValueFlag{})
@@ -447,7 +505,11 @@ func (s *Scheduler) applyCreate(node graph.NodeRW, txnOp *kvs.RecordedTxnOp, arg
 		var metadata interface{}
 
 		if args.kv.origin != kvs.FromSB {
-			metadata, err = handler.create(node.GetKey(), node.GetValue())
+			if op := s.takePreparedOp(args, kvscheduler.TxnOperation_CREATE); op != nil {
+				metadata, err = op.newMetadata, op.err
+			} else {
+				metadata, err = handler.create(args.ctx, node.GetKey(), node.GetValue())
+			}
 		} else {
 			// already created in SB
 			metadata = args.kv.metadata
@@ -522,7 +584,7 @@ func (s *Scheduler) applyUpdate(node graph.NodeRW, txnOp *kvs.RecordedTxnOp, arg
 	// validate new value
 	descriptor := s.registry.GetDescriptorForKey(args.kv.key)
 	handler := newDescriptorHandler(descriptor)
-	if !args.dryRun && args.kv.origin == kvs.FromNB {
+	if (!args.dryRun || isDryRun(args.txn)) && args.kv.origin == kvs.FromNB {
 		err = handler.validate(node.GetKey(), args.kv.value)
 		if err != nil {
 			node.SetValue(args.kv.value) // save the invalid value
@@ -606,7 +668,11 @@ func (s *Scheduler) applyUpdate(node graph.NodeRW, txnOp *kvs.RecordedTxnOp, arg
 
 		// call Update handler
 		if args.kv.origin != kvs.FromSB {
-			newMetadata, err = handler.update(node.GetKey(), prevValue, node.GetValue(), node.GetMetadata())
+			if op := s.takePreparedOp(args, kvscheduler.TxnOperation_UPDATE); op != nil {
+				newMetadata, err = op.newMetadata, op.err
+			} else {
+				newMetadata, err = handler.update(args.ctx, node.GetKey(), prevValue, node.GetValue(), node.GetMetadata())
+			}
 		} else {
 			// already modified in SB
 			newMetadata = args.kv.metadata
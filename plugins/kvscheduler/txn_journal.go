@@ -0,0 +1,385 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvscheduler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/utils"
+)
+
+const (
+	// journal files are named <journalFilePrefix><index><journalFileSuffix>,
+	// where index is increased with every rotation
+	journalFilePrefix = "txn-journal-"
+	journalFileSuffix = ".log"
+)
+
+// txnJournal is an on-disk write-ahead log of transaction records, bounded
+// in size by rotating over a limited number of files.
+// Every transaction is journaled twice - pre-recorded before the execution
+// and finalized once all the operations have been executed. A pre-record
+// without the matching final record therefore identifies transaction that was
+// interrupted by a crash of the agent.
+type txnJournal struct {
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+
+	file     *os.File
+	fileIdx  uint64
+	fileSize int64
+}
+
+// journalContent is the content of the journal loaded on startup.
+type journalContent struct {
+	// finalized (and previously interrupted) transactions ordered by seqNum
+	history kvs.RecordedTxns
+
+	// pre-recorded transactions without the final record
+	interrupted kvs.RecordedTxns
+
+	// sequence number of the last journaled transaction
+	lastSeqNum uint64
+
+	// false if the journal contains no (valid) records
+	nonEmpty bool
+
+	// number of records that could not be decoded
+	corrupted int
+}
+
+// newTxnJournal opens transaction journal stored in the given directory.
+// New records are always appended into a new file.
+func newTxnJournal(dir string, maxFileSize int64, maxFiles int) (*txnJournal, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory for txn journal: %w", err)
+	}
+	if maxFiles < 1 {
+		maxFiles = 1
+	}
+	journal := &txnJournal{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+	}
+	indexes, err := journal.listFiles()
+	if err != nil {
+		return nil, err
+	}
+	if len(indexes) > 0 {
+		journal.fileIdx = indexes[len(indexes)-1]
+	}
+	return journal, nil
+}
+
+// load reads all the records stored in the journal.
+func (j *txnJournal) load() (*journalContent, error) {
+	indexes, err := j.listFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	content := &journalContent{}
+	preRecorded := make(map[uint64]*kvs.RecordedTxn)
+	finalized := make(map[uint64]*kvs.RecordedTxn)
+	for _, idx := range indexes {
+		records, corrupted, err := j.readFile(j.filePath(idx))
+		if err != nil {
+			return nil, err
+		}
+		content.corrupted += corrupted
+		for _, record := range records {
+			content.nonEmpty = true
+			if record.SeqNum > content.lastSeqNum {
+				content.lastSeqNum = record.SeqNum
+			}
+			if record.PreRecord && !record.Interrupted {
+				preRecorded[record.SeqNum] = record
+				continue
+			}
+			finalized[record.SeqNum] = record
+		}
+	}
+
+	for seqNum, record := range preRecorded {
+		if _, isFinalized := finalized[seqNum]; !isFinalized {
+			content.interrupted = append(content.interrupted, record)
+		}
+	}
+	for _, record := range finalized {
+		content.history = append(content.history, record)
+	}
+	sort.Slice(content.interrupted, func(i, j int) bool {
+		return content.interrupted[i].SeqNum < content.interrupted[j].SeqNum
+	})
+	sort.Slice(content.history, func(i, j int) bool {
+		return content.history[i].SeqNum < content.history[j].SeqNum
+	})
+	return content, nil
+}
+
+// write appends transaction record into the journal and syncs it to the disk.
+func (j *txnJournal) write(record *kvs.RecordedTxn) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal txn record: %w", err)
+	}
+	data = append(data, '\n')
+
+	if j.file == nil || (j.fileSize > 0 && j.fileSize+int64(len(data)) > j.maxFileSize) {
+		if err = j.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := j.file.Write(data)
+	j.fileSize += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write txn record into the journal: %w", err)
+	}
+	return j.file.Sync()
+}
+
+// close closes the currently opened journal file.
+func (j *txnJournal) close() error {
+	if j.file == nil {
+		return nil
+	}
+	err := j.file.Close()
+	j.file = nil
+	return err
+}
+
+// rotate opens the next journal file and removes files over the limit.
+func (j *txnJournal) rotate() error {
+	if err := j.close(); err != nil {
+		return fmt.Errorf("failed to close txn journal file: %w", err)
+	}
+	j.fileIdx++
+	file, err := os.OpenFile(j.filePath(j.fileIdx), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open txn journal file: %w", err)
+	}
+	j.file = file
+	j.fileSize = 0
+
+	indexes, err := j.listFiles()
+	if err != nil {
+		return err
+	}
+	for len(indexes) > j.maxFiles {
+		if err := os.Remove(j.filePath(indexes[0])); err != nil {
+			return fmt.Errorf("failed to remove old txn journal file: %w", err)
+		}
+		indexes = indexes[1:]
+	}
+	return nil
+}
+
+// listFiles returns indexes of existing journal files in the ascending order.
+func (j *txnJournal) listFiles() ([]uint64, error) {
+	entries, err := os.ReadDir(j.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list txn journal files: %w", err)
+	}
+	var indexes []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, journalFilePrefix) ||
+			!strings.HasSuffix(name, journalFileSuffix) {
+			continue
+		}
+		idxStr := strings.TrimSuffix(strings.TrimPrefix(name, journalFilePrefix), journalFileSuffix)
+		idx, err := strconv.ParseUint(idxStr, 10, 64)
+		if err != nil {
+			continue
+		}
+		indexes = append(indexes, idx)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i] < indexes[j]
+	})
+	return indexes, nil
+}
+
+// readFile reads all records from a single journal file.
+// Records that cannot be decoded (e.g. partially written due to a crash)
+// are skipped and counted.
+func (j *txnJournal) readFile(path string) (records kvs.RecordedTxns, corrupted int, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open txn journal file: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			record := &kvs.RecordedTxn{}
+			if err := json.Unmarshal(line, record); err != nil {
+				corrupted++
+			} else {
+				records = append(records, record)
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return nil, 0, fmt.Errorf("failed to read txn journal file: %w", readErr)
+		}
+	}
+	return records, corrupted, nil
+}
+
+func (j *txnJournal) filePath(idx uint64) string {
+	return filepath.Join(j.dir, fmt.Sprintf("%s%06d%s", journalFilePrefix, idx, journalFileSuffix))
+}
+
+// openTxnJournal opens the transaction journal, restores the history
+// of transactions from before the restart and detects transactions interrupted
+// by a crash.
+func (s *Scheduler) openTxnJournal() error {
+	if s.config.TxnJournalMaxFileSize == 0 {
+		return errors.New("txn-journal-max-file-size must be greater than 0")
+	}
+	journal, err := newTxnJournal(s.config.TxnJournalDir,
+		int64(s.config.TxnJournalMaxFileSize)*1024, int(s.config.TxnJournalMaxFiles))
+	if err != nil {
+		return err
+	}
+	content, err := journal.load()
+	if err != nil {
+		return err
+	}
+	s.txnJournal = journal
+
+	if !content.nonEmpty {
+		return nil
+	}
+	if content.corrupted > 0 {
+		s.Log.Warnf("Skipped %d corrupted records in the transaction journal", content.corrupted)
+	}
+	// continue with the sequence numbering from before the restart
+	s.txnSeqNumber = content.lastSeqNum + 1
+
+	for _, txn := range content.interrupted {
+		txn.Interrupted = true
+		s.Log.Warnf("Transaction #%d was interrupted by agent restart, operations in-flight:\n%s",
+			txn.SeqNum, txn.StringWithOpts(false, false, 2))
+		if s.interruptedKeys == nil {
+			s.interruptedKeys = utils.NewMapBasedKeySet()
+		}
+		keys := interruptedTxnKeys(txn)
+		if len(keys) == 0 {
+			// keys touched by the transaction are not known - resync everything
+			s.resyncAllInterrupted = true
+		}
+		for _, key := range keys {
+			s.interruptedKeys.Add(key)
+		}
+		// mark the transaction as interrupted to not report it again after
+		// the next restart
+		if err := journal.write(txn); err != nil {
+			return err
+		}
+		content.history = append(content.history, txn)
+	}
+
+	if s.config.RecordTransactionHistory {
+		// restore transactions not too old to keep (the journal itself is bounded,
+		// therefore restored records are not trimmed afterwards)
+		ageLimit := time.Duration(s.config.TransactionHistoryAgeLimit) * time.Minute
+		sort.Slice(content.history, func(i, j int) bool {
+			return content.history[i].SeqNum < content.history[j].SeqNum
+		})
+		for _, txn := range content.history {
+			if s.startTime.Sub(txn.Start) <= ageLimit {
+				s.txnHistory = append(s.txnHistory, txn)
+			}
+		}
+	}
+	return nil
+}
+
+// journalTransaction writes (pre-)record of a transaction into the journal.
+// Dry-run transactions do not change anything and therefore are not journaled.
+func (s *Scheduler) journalTransaction(record *kvs.RecordedTxn) {
+	if s.txnJournal == nil || record.DryRun {
+		return
+	}
+	if err := s.txnJournal.write(record); err != nil {
+		s.Log.WithField("txnSeq", record.SeqNum).
+			Errorf("Failed to journal transaction: %v", err)
+	}
+}
+
+// resyncInterruptedTxns schedules downstream resync for values that were
+// being changed by transactions interrupted by agent restart.
+func (s *Scheduler) resyncInterruptedTxns() {
+	if s.interruptedKeys == nil {
+		return
+	}
+	var resyncKeys utils.KeySet
+	description := "resync of values touched by interrupted transaction(s)"
+	if !s.resyncAllInterrupted {
+		resyncKeys = s.interruptedKeys
+		description += ": " + resyncKeys.String()
+	}
+	s.interruptedKeys = nil
+	s.resyncAllInterrupted = false
+
+	err := s.enqueueTxn(&transaction{
+		txnType: kvs.NBTransaction,
+		nb: &nbTxn{
+			resyncType:  kvs.DownstreamResync,
+			resyncKeys:  resyncKeys,
+			description: description,
+		},
+		created: time.Now(),
+	})
+	if err != nil {
+		s.Log.Errorf("Failed to enqueue resync of interrupted transaction(s): %v", err)
+	}
+}
+
+// interruptedTxnKeys returns keys of values that the given transaction was
+// (possibly) changing.
+func interruptedTxnKeys(txn *kvs.RecordedTxn) (keys []string) {
+	if txn.WithSimulation {
+		for _, op := range txn.Planned {
+			keys = append(keys, op.Key)
+		}
+		return keys
+	}
+	for _, kv := range txn.Values {
+		keys = append(keys, kv.Key)
+	}
+	return keys
+}
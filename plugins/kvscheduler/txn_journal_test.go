@@ -0,0 +1,195 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvscheduler
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+
+	. "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/test"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/utils"
+)
+
+func TestTxnJournalInterruptedTxn(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir := t.TempDir()
+	journal, err := newTxnJournal(dir, 1024*1024, 3)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// transaction #1 is finalized, #2 gets interrupted
+	txn1 := &RecordedTxn{
+		PreRecord: true,
+		SeqNum:    1,
+		TxnType:   NBTransaction,
+		Values: []RecordedKVPair{
+			{Key: prefixA + baseValue1, Value: utils.RecordProtoMessage(test.NewArrayValue("item1")), Origin: FromNB},
+		},
+	}
+	Expect(journal.write(txn1)).To(Succeed())
+	txn1.PreRecord = false
+	Expect(journal.write(txn1)).To(Succeed())
+	txn2 := &RecordedTxn{
+		PreRecord: true,
+		SeqNum:    2,
+		TxnType:   NBTransaction,
+		Values: []RecordedKVPair{
+			{Key: prefixA + baseValue2, Value: utils.RecordProtoMessage(test.NewArrayValue("item1")), Origin: FromNB},
+		},
+	}
+	Expect(journal.write(txn2)).To(Succeed())
+	Expect(journal.close()).To(Succeed())
+
+	// simulate partially written record
+	file, err := os.OpenFile(journal.filePath(journal.fileIdx), os.O_APPEND|os.O_WRONLY, 0644)
+	Expect(err).ShouldNot(HaveOccurred())
+	_, err = file.WriteString(`{"PreRecord":true,"SeqNum":`)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(file.Close()).To(Succeed())
+
+	// re-open and load the journal
+	journal, err = newTxnJournal(dir, 1024*1024, 3)
+	Expect(err).ShouldNot(HaveOccurred())
+	content, err := journal.load()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(content.nonEmpty).To(BeTrue())
+	Expect(content.corrupted).To(Equal(1))
+	Expect(content.lastSeqNum).To(BeEquivalentTo(2))
+	Expect(content.history).To(HaveLen(1))
+	Expect(content.history[0].SeqNum).To(BeEquivalentTo(1))
+	Expect(content.history[0].PreRecord).To(BeFalse())
+	Expect(content.history[0].Values).To(HaveLen(1))
+	Expect(proto.Equal(content.history[0].Values[0].Value, test.NewArrayValue("item1"))).To(BeTrue())
+	Expect(content.interrupted).To(HaveLen(1))
+	Expect(content.interrupted[0].SeqNum).To(BeEquivalentTo(2))
+	Expect(interruptedTxnKeys(content.interrupted[0])).To(ConsistOf(prefixA + baseValue2))
+
+	// once marked as interrupted, the transaction is no longer reported
+	content.interrupted[0].Interrupted = true
+	Expect(journal.write(content.interrupted[0])).To(Succeed())
+	Expect(journal.close()).To(Succeed())
+	content, err = journal.load()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(content.interrupted).To(BeEmpty())
+	Expect(content.history).To(HaveLen(2))
+	Expect(content.history[1].Interrupted).To(BeTrue())
+}
+
+func TestTxnJournalRotation(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir := t.TempDir()
+	journal, err := newTxnJournal(dir, 256, 2)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	for seqNum := uint64(0); seqNum < 10; seqNum++ {
+		Expect(journal.write(&RecordedTxn{SeqNum: seqNum, TxnType: SBNotification})).To(Succeed())
+	}
+	Expect(journal.close()).To(Succeed())
+
+	indexes, err := journal.listFiles()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(indexes).To(HaveLen(2))
+
+	content, err := journal.load()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(content.lastSeqNum).To(BeEquivalentTo(9))
+	Expect(len(content.history)).To(BeNumerically("<", 10))
+	Expect(content.history[len(content.history)-1].SeqNum).To(BeEquivalentTo(9))
+}
+
+func TestTxnJournalZeroFileSize(t *testing.T) {
+	RegisterTestingT(t)
+
+	// journal with unlimited file size would grow without bounds
+	s := &Scheduler{config: &Config{
+		TxnJournalDir:         t.TempDir(),
+		TxnJournalMaxFileSize: 0,
+		TxnJournalMaxFiles:    defaultTxnJournalMaxFiles,
+	}}
+	Expect(s.openTxnJournal()).ToNot(Succeed())
+	Expect(s.txnJournal).To(BeNil())
+}
+
+func TestResyncOfInterruptedTxn(t *testing.T) {
+	RegisterTestingT(t)
+
+	// prepare journal with interrupted transaction
+	dir := t.TempDir()
+	journal, err := newTxnJournal(dir, 1024*1024, 3)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(journal.write(&RecordedTxn{
+		PreRecord: true,
+		Start:     time.Now(),
+		SeqNum:    5,
+		TxnType:   NBTransaction,
+		Values: []RecordedKVPair{
+			{Key: prefixA + baseValue2, Value: utils.RecordProtoMessage(test.NewArrayValue("item1")), Origin: FromNB},
+		},
+	})).To(Succeed())
+	Expect(journal.close()).To(Succeed())
+
+	// prepare KV Scheduler
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err = scheduler.Init()
+	Expect(err).To(BeNil())
+	scheduler.config.TxnJournalDir = dir
+	Expect(scheduler.openTxnJournal()).To(Succeed())
+	defer scheduler.Close()
+
+	// interrupted transaction is restored into the history
+	txnHistory := scheduler.GetTransactionHistory(time.Time{}, time.Time{})
+	Expect(txnHistory).To(HaveLen(1))
+	Expect(txnHistory[0].SeqNum).To(BeEquivalentTo(5))
+	Expect(txnHistory[0].Interrupted).To(BeTrue())
+
+	// prepare mocks
+	mockSB := test.NewMockSouthbound()
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: string(proto.MessageName(test.NewArrayValue())),
+		DerivedValues: test.ArrayValueDerBuilder,
+		WithMetadata:  true,
+	}, mockSB, 0)
+	scheduler.RegisterKVDescriptor(descriptor1)
+
+	// run startup resync
+	schedulerTxn := scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixA+baseValue1, test.NewArrayValue("item1"))
+	schedulerTxn.SetValue(prefixA+baseValue2, test.NewArrayValue("item1"))
+	seqNum, err := schedulerTxn.Commit(WithResync(testCtx, FullResync, true))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(seqNum).To(BeEquivalentTo(6))
+
+	// resync limited to the values touched by the interrupted txn should follow
+	Eventually(func() RecordedTxns {
+		return scheduler.GetTransactionHistory(time.Time{}, time.Time{})
+	}).Should(HaveLen(3))
+	resyncTxn := scheduler.GetRecordedTransaction(7)
+	Expect(resyncTxn).ToNot(BeNil())
+	Expect(resyncTxn.TxnType).To(BeEquivalentTo(NBTransaction))
+	Expect(resyncTxn.ResyncType).To(BeEquivalentTo(DownstreamResync))
+	Expect(resyncTxn.Description).To(ContainSubstring(prefixA + baseValue2))
+	Expect(resyncTxn.Executed).To(BeEmpty())
+}
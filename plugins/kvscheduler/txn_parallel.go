@@ -0,0 +1,387 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvscheduler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/graph"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+const (
+	// maximum number of operations executed in a single wave
+	// (limits the cost of checking the dependencies between wave members)
+	maxExecWaveSize = 1000
+)
+
+// preparedOp is a Create/Update/Delete operation executed ahead of the graph
+// walk as a member of an execution wave (in parallel with other operations
+// or as part of a batch). The graph walk (which remains
+// sequential) then uses the result instead of calling the descriptor.
+type preparedOp struct {
+	operation kvscheduler.TxnOperation
+	key       string
+	handler   *descriptorHandler
+
+	// arguments
+	value     proto.Message // new value for Create/Update, removed value for Delete
+	prevValue proto.Message // Update only
+	metadata  kvs.Metadata  // Update and Delete only
+
+	// relations to other wave members
+	deps     []kvs.Dependency
+	provides []string // key + derived keys
+
+	// results
+	newMetadata kvs.Metadata
+	err         error
+}
+
+// execute calls the descriptor to execute the operation.
+func (op *preparedOp) execute(ctx context.Context) {
+	switch op.operation {
+	case kvscheduler.TxnOperation_CREATE:
+		op.newMetadata, op.err = op.handler.create(ctx, op.key, op.value)
+	case kvscheduler.TxnOperation_UPDATE:
+		op.newMetadata, op.err = op.handler.update(ctx, op.key, op.prevValue, op.value, op.metadata)
+	case kvscheduler.TxnOperation_DELETE:
+		op.err = op.handler.delete(ctx, op.key, op.value, op.metadata)
+	}
+}
+
+// waveTask is a unit of work within an execution wave - either a single
+// operation or a batch of operations of the same descriptor and type.
+type waveTask struct {
+	ops        []*preparedOp
+	batch      bool
+	concurrent bool // can run in parallel with other tasks
+}
+
+// execute executes the operation(s) of the task.
+func (t *waveTask) execute(ctx context.Context) {
+	if !t.batch {
+		t.ops[0].execute(ctx)
+		return
+	}
+	batch := make([]*kvs.BatchOp, len(t.ops))
+	for i, op := range t.ops {
+		batch[i] = &kvs.BatchOp{
+			Key:       op.key,
+			Value:     op.value,
+			PrevValue: op.prevValue,
+			Metadata:  op.metadata,
+		}
+	}
+	t.ops[0].handler.executeBatch(ctx, t.ops[0].operation, batch)
+	for i, op := range t.ops {
+		op.newMetadata = batch[i].NewMetadata
+		op.err = batch[i].Err
+	}
+}
+
+// canExecInWaves returns true if the transaction may have some operations
+// executed in waves (in parallel or in batches).
+func (s *Scheduler) canExecInWaves(txn *transaction) bool {
+	if len(txn.values) < 2 || isDryRun(txn) {
+		return false
+	}
+	if txn.txnType == kvs.NBTransaction && txn.nb.revertOnFailure {
+		// revert stops the execution at the first failure, which is only
+		// well-defined for sequential execution
+		return false
+	}
+	for _, descriptor := range s.registry.GetAllDescriptors() {
+		if s.canExecInParallel(descriptor) || hasBatchHandler(descriptor) {
+			return true
+		}
+	}
+	return false
+}
+
+// canExecInParallel returns true if operations of the given descriptor
+// can be executed in parallel.
+func (s *Scheduler) canExecInParallel(descriptor *kvs.KVDescriptor) bool {
+	return descriptor.ConcurrencySafe && s.config.TxnExecWorkers > 1
+}
+
+// executeWave executes operations of the wave of transaction values starting
+// at the index <from>. Operations of the same descriptor and type are executed
+// using the batch handler of the descriptor, if defined. Other operations
+// of concurrency-safe descriptors are executed in parallel, using a bounded
+// pool of workers. Returned are results of the executed operations, keyed
+// by value keys, and the index where the wave ends. Wave of a single value
+// is not executed (nil map is returned) and the value is left for the graph walk.
+func (s *Scheduler) executeWave(ctx context.Context, txn *transaction, graphR graph.ReadAccess, from int) (prepared map[string]*preparedOp, end int) {
+	wave := s.buildExecWave(txn, graphR, from)
+	if len(wave) < 2 {
+		return nil, from + 1
+	}
+	ctx, span := tracing.Start(ctx, "kvscheduler.executeWave", attribute.Int("kvscheduler.wave_size", len(wave)))
+	defer span.End()
+	defer trackTransactionMethod("executeWave")()
+
+	prepared = make(map[string]*preparedOp, len(wave))
+	for _, op := range wave {
+		prepared[op.key] = op
+	}
+	var concurrent []*waveTask
+	for _, task := range s.groupWaveTasks(wave) {
+		if task.concurrent && s.config.TxnExecWorkers > 1 {
+			concurrent = append(concurrent, task)
+			continue
+		}
+		// descriptor not safe for concurrent use - executed before any
+		// parallel task is started
+		task.execute(ctx)
+	}
+	if len(concurrent) == 0 {
+		return prepared, from + len(wave)
+	}
+
+	workers := int(s.config.TxnExecWorkers)
+	if workers > len(concurrent) {
+		workers = len(concurrent)
+	}
+	queue := make(chan *waveTask)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range queue {
+				task.execute(ctx)
+			}
+		}()
+	}
+	for _, task := range concurrent {
+		queue <- task
+	}
+	close(queue)
+	wg.Wait()
+	return prepared, from + len(wave)
+}
+
+// groupWaveTasks groups operations of the wave into tasks. Operations
+// of the same descriptor and type are grouped into a single batch if the
+// descriptor defines the corresponding batch handler.
+func (s *Scheduler) groupWaveTasks(wave []*preparedOp) (tasks []*waveTask) {
+	type batchID struct {
+		descriptor string
+		operation  kvscheduler.TxnOperation
+	}
+	batches := make(map[batchID]*waveTask)
+	for _, op := range wave {
+		if !op.handler.supportsBatch(op.operation) {
+			tasks = append(tasks, &waveTask{
+				ops:        []*preparedOp{op},
+				concurrent: true, // only concurrency-safe descriptors are left without batch
+			})
+			continue
+		}
+		id := batchID{descriptor: op.handler.descriptor.Name, operation: op.operation}
+		task, exists := batches[id]
+		if !exists {
+			task = &waveTask{
+				batch:      true,
+				concurrent: op.handler.descriptor.ConcurrencySafe,
+			}
+			batches[id] = task
+			tasks = append(tasks, task)
+		}
+		task.ops = append(task.ops, op)
+	}
+	return tasks
+}
+
+// buildExecWave collects operations of consecutive transaction values, starting
+// at the index <from>, that can be executed in parallel or in batches. A value
+// can join the wave if according to the simulation it results in exactly one
+// Create/Update/Delete operation of a concurrency-safe descriptor (or
+// of a descriptor with batch handler for the operation) and it does not depend
+// on, nor is a dependency of, another member of the wave.
+func (s *Scheduler) buildExecWave(txn *transaction, graphR graph.ReadAccess, from int) (wave []*preparedOp) {
+	provided := make(map[string]struct{})
+	var providedKeys []string
+	depKeys := make(map[string]struct{})
+	var anyOfDeps []kvs.Dependency
+
+	for i := from; i < len(txn.values) && len(wave) < maxExecWaveSize; i++ {
+		op := s.prepareOp(txn, graphR, i)
+		if op == nil {
+			break
+		}
+		// check dependencies between the operation and the wave
+		for _, dep := range op.deps {
+			if dep.Key != "" {
+				if _, conflict := provided[dep.Key]; conflict {
+					return wave
+				}
+				continue
+			}
+			for _, key := range providedKeys {
+				if isDependencyTarget(dep, key) {
+					return wave
+				}
+			}
+		}
+		for _, key := range op.provides {
+			if _, conflict := depKeys[key]; conflict {
+				return wave
+			}
+			for _, dep := range anyOfDeps {
+				if isDependencyTarget(dep, key) {
+					return wave
+				}
+			}
+		}
+		// join the wave
+		wave = append(wave, op)
+		for _, key := range op.provides {
+			provided[key] = struct{}{}
+			providedKeys = append(providedKeys, key)
+		}
+		for _, dep := range op.deps {
+			if dep.Key != "" {
+				depKeys[dep.Key] = struct{}{}
+			} else {
+				anyOfDeps = append(anyOfDeps, dep)
+			}
+		}
+	}
+	return wave
+}
+
+// prepareOp prepares operation for the value of the transaction at the given
+// index. Returns nil if the value cannot be a member of an execution wave.
+func (s *Scheduler) prepareOp(txn *transaction, graphR graph.ReadAccess, idx int) *preparedOp {
+	kv := txn.values[idx]
+	plan := txn.plan[idx]
+	if kv.origin == kvs.FromSB || len(plan) != 1 {
+		return nil
+	}
+	planned := plan[0]
+	if planned.Key != kv.key || planned.NOOP || planned.IsRecreate {
+		return nil
+	}
+	descriptor := s.registry.GetDescriptorForKey(kv.key)
+	if descriptor == nil {
+		return nil
+	}
+	op := &preparedOp{
+		operation: planned.Operation,
+		key:       kv.key,
+		handler:   newDescriptorHandler(descriptor),
+	}
+	if !s.canExecInParallel(descriptor) && !op.handler.supportsBatch(op.operation) {
+		return nil
+	}
+	node := graphR.GetNode(kv.key)
+	if planned.Operation != kvscheduler.TxnOperation_DELETE {
+		// validation is not part of the simulation, invalid value would not
+		// get executed by the graph walk
+		if err := op.handler.validate(kv.key, kv.value); err != nil {
+			return nil
+		}
+	}
+	switch planned.Operation {
+	case kvscheduler.TxnOperation_CREATE:
+		op.value = kv.value
+	case kvscheduler.TxnOperation_UPDATE:
+		if node == nil {
+			return nil
+		}
+		op.prevValue = node.GetValue()
+		op.value = kv.value
+		op.metadata = node.GetMetadata()
+	case kvscheduler.TxnOperation_DELETE:
+		if node == nil {
+			return nil
+		}
+		op.value = node.GetValue()
+		op.metadata = node.GetMetadata()
+	default:
+		return nil
+	}
+	op.deps = op.handler.dependencies(kv.key, op.value)
+	op.provides = append(op.provides, kv.key)
+	for _, derived := range op.handler.derivedValues(kv.key, op.value) {
+		op.provides = append(op.provides, derived.Key)
+	}
+	return op
+}
+
+// takePreparedOp returns the operation already executed for the value
+// within the current execution wave. Every prepared operation is used only once.
+func (s *Scheduler) takePreparedOp(args *applyValueArgs, operation kvscheduler.TxnOperation) *preparedOp {
+	op, isPrepared := args.prepared[args.kv.key]
+	if !isPrepared {
+		return nil
+	}
+	delete(args.prepared, args.kv.key)
+	if op.operation != operation {
+		// should not happen - the graph walk follows the plan while no operation fails;
+		// the prepared operation was already executed and the handler must not be
+		// called again, therefore the operation is reported as failed
+		err := fmt.Errorf("operation %v prepared for value %s does not match the executed operation %v",
+			op.operation, op.key, operation)
+		s.Log.Warn(err)
+		return &preparedOp{operation: operation, key: op.key, handler: op.handler, err: err}
+	}
+	return op
+}
+
+// hasBatchHandler returns true if the descriptor defines at least one batch handler.
+func hasBatchHandler(descriptor *kvs.KVDescriptor) bool {
+	return descriptor.CreateBatch != nil || descriptor.UpdateBatch != nil || descriptor.DeleteBatch != nil
+}
+
+// isDependencyTarget returns true if the given key may satisfy the dependency.
+func isDependencyTarget(dep kvs.Dependency, key string) bool {
+	if dep.Key != "" {
+		return dep.Key == key
+	}
+	if len(dep.AnyOf.KeyPrefixes) > 0 {
+		var hasPrefix bool
+		for _, prefix := range dep.AnyOf.KeyPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				hasPrefix = true
+				break
+			}
+		}
+		if !hasPrefix {
+			return false
+		}
+	}
+	return dep.AnyOf.KeySelector == nil || dep.AnyOf.KeySelector(key)
+}
+
+// hasFailedOp returns true if any of the operations has failed.
+func hasFailedOp(ops kvs.RecordedTxnOps) bool {
+	for _, op := range ops {
+		if op.NewErr != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvscheduler
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/protobuf/proto"
+
+	. "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/test"
+	. "go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+const baseValue5 = "base-value5"
+
+func TestParallelExecution(t *testing.T) {
+	RegisterTestingT(t)
+
+	// prepare KV Scheduler
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+	Expect(scheduler.config.TxnExecWorkers).To(BeEquivalentTo(defaultTxnExecWorkers))
+	defer scheduler.Close()
+
+	// prepare mocks
+	mockSB := test.NewMockSouthbound()
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: string(proto.MessageName(test.NewStringValue(""))),
+		Dependencies: func(key string, value proto.Message) []Dependency {
+			if key == prefixA+baseValue3 {
+				return []Dependency{{Label: baseValue1, Key: prefixA + baseValue1}}
+			}
+			return nil
+		},
+		WithMetadata:    true,
+		ConcurrencySafe: true,
+	}, mockSB, 0)
+	descriptor2 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor2Name,
+		NBKeyPrefix:   prefixB,
+		KeySelector:   prefixSelector(prefixB),
+		ValueTypeName: string(proto.MessageName(test.NewStringValue(""))),
+	}, mockSB, 0)
+	scheduler.RegisterKVDescriptor(descriptor1)
+	scheduler.RegisterKVDescriptor(descriptor2)
+
+	// run startup resync
+	// - values are ordered alphabetically: A1 + A2 form the 1st wave, A3 depends
+	//   on A1 and starts the 2nd wave with A4, B1 is executed sequentially
+	schedulerTxn := scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixA+baseValue1, test.NewStringValue("value1"))
+	schedulerTxn.SetValue(prefixA+baseValue2, test.NewStringValue("value2"))
+	schedulerTxn.SetValue(prefixA+baseValue3, test.NewStringValue("value3"))
+	schedulerTxn.SetValue(prefixA+baseValue4, test.NewStringValue("value4"))
+	schedulerTxn.SetValue(prefixB+baseValue1, test.NewStringValue("value1"))
+	seqNum, err := schedulerTxn.Commit(WithSimulation(WithResync(testCtx, FullResync, true)))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// check the state of SB
+	Expect(mockSB.GetKeysWithInvalidData()).To(BeEmpty())
+	Expect(mockSB.GetValues(nil)).To(HaveLen(5))
+	opIndex := make(map[string]int)
+	for i, op := range mockSB.PopHistoryOfOps() {
+		if op.OpType == test.MockCreate {
+			opIndex[op.Key] = i
+		}
+	}
+	Expect(opIndex).To(HaveLen(5))
+	Expect(opIndex[prefixA+baseValue3]).To(BeNumerically(">", opIndex[prefixA+baseValue1]))
+	Expect(opIndex[prefixA+baseValue3]).To(BeNumerically(">", opIndex[prefixA+baseValue2]))
+	Expect(opIndex[prefixB+baseValue1]).To(BeNumerically(">", opIndex[prefixA+baseValue4]))
+
+	// check metadata
+	metadataMap := scheduler.GetMetadataMap(descriptor1.Name)
+	Expect(metadataMap.ListAllNames()).To(HaveLen(4))
+
+	// operations are recorded in the planned order
+	txn := scheduler.GetRecordedTransaction(seqNum)
+	Expect(txn.Executed).To(HaveLen(5))
+	checkOpsFollowPlan(txn.Executed, txn.Planned)
+	for _, op := range txn.Executed {
+		Expect(op.NewState).To(Equal(ValueState_CONFIGURED))
+	}
+
+	// independent operations were executed in waves
+	statsMu.RLock()
+	Expect(stats.TxnStats.Methods).To(HaveKey("executeWave"))
+	statsMu.RUnlock()
+
+	// run 2nd transaction with a failure inside a wave
+	mockSB.PlanError(prefixA+baseValue4, errors.New("failed to update value"), nil)
+	schedulerTxn2 := scheduler.StartNBTransaction()
+	schedulerTxn2.SetValue(prefixA+baseValue2, nil)
+	schedulerTxn2.SetValue(prefixA+baseValue4, test.NewStringValue("value4-rev2"))
+	schedulerTxn2.SetValue(prefixA+baseValue5, test.NewStringValue("value5"))
+	seqNum, err = schedulerTxn2.Commit(WithSimulation(testCtx))
+	Expect(err).ToNot(BeNil())
+	txnErr := err.(*TransactionError)
+	kvErrors := txnErr.GetKVErrors()
+	Expect(kvErrors).To(HaveLen(1))
+	Expect(kvErrors[0].Key).To(BeEquivalentTo(prefixA + baseValue4))
+	Expect(kvErrors[0].TxnOperation).To(BeEquivalentTo(TxnOperation_UPDATE))
+
+	// check the state of SB
+	Expect(mockSB.GetKeysWithInvalidData()).To(BeEmpty())
+	Expect(mockSB.GetValue(prefixA + baseValue2)).To(BeNil())
+	Expect(mockSB.GetValue(prefixA + baseValue5)).ToNot(BeNil())
+	Expect(proto.Equal(mockSB.GetValue(prefixA+baseValue4).Value, test.NewStringValue("value4"))).To(BeTrue())
+
+	// operations are recorded in the planned order, including the failure
+	txn = scheduler.GetRecordedTransaction(seqNum)
+	Expect(txn.Executed).To(HaveLen(3))
+	checkOpsFollowPlan(txn.Executed, txn.Planned)
+	Expect(txn.Executed[0].Key).To(Equal(prefixA + baseValue2))
+	Expect(txn.Executed[0].NewState).To(Equal(ValueState_REMOVED))
+	Expect(txn.Executed[1].Key).To(Equal(prefixA + baseValue5))
+	Expect(txn.Executed[1].NewState).To(Equal(ValueState_CONFIGURED))
+	Expect(txn.Executed[2].Key).To(Equal(prefixA + baseValue4))
+	Expect(txn.Executed[2].NewState).To(Equal(ValueState_FAILED))
+	Expect(txn.Executed[2].NewErrMsg).To(Equal("failed to update value"))
+}
+
+// checkOpsFollowPlan checks that operations were executed in the planned order.
+func checkOpsFollowPlan(executed, planned RecordedTxnOps) {
+	Expect(executed).To(HaveLen(len(planned)))
+	for i := range executed {
+		Expect(executed[i].Key).To(Equal(planned[i].Key))
+		Expect(executed[i].Operation).To(Equal(planned[i].Operation))
+	}
+}
+
+func TestBatchExecution(t *testing.T) {
+	RegisterTestingT(t)
+
+	// prepare KV Scheduler (batches are used even with sequential execution)
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+	scheduler.config.TxnExecWorkers = 1
+	defer scheduler.Close()
+
+	// prepare mocks
+	mockSB := test.NewMockSouthbound()
+	enableBatch := func(ops []*BatchOp) error { return nil }
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: string(proto.MessageName(test.NewStringValue(""))),
+		Dependencies: func(key string, value proto.Message) []Dependency {
+			if key == prefixA+baseValue3 {
+				return []Dependency{{Label: baseValue1, Key: prefixA + baseValue1}}
+			}
+			return nil
+		},
+		WithMetadata: true,
+		CreateBatch:  enableBatch,
+		DeleteBatch:  enableBatch,
+	}, mockSB, 0)
+	scheduler.RegisterKVDescriptor(descriptor1)
+
+	// run startup resync
+	// - A1 + A2 are created by the 1st batch, A3 depends on A1 and starts
+	//   the 2nd batch with A4
+	schedulerTxn := scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixA+baseValue1, test.NewStringValue("value1"))
+	schedulerTxn.SetValue(prefixA+baseValue2, test.NewStringValue("value2"))
+	schedulerTxn.SetValue(prefixA+baseValue3, test.NewStringValue("value3"))
+	schedulerTxn.SetValue(prefixA+baseValue4, test.NewStringValue("value4"))
+	seqNum, err := schedulerTxn.Commit(WithResync(testCtx, FullResync, true))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// check the state of SB
+	Expect(mockSB.GetKeysWithInvalidData()).To(BeEmpty())
+	Expect(mockSB.GetValues(nil)).To(HaveLen(4))
+
+	// check metadata
+	metadataMap := scheduler.GetMetadataMap(descriptor1.Name)
+	Expect(metadataMap.ListAllNames()).To(HaveLen(4))
+
+	// check that batch handler was used instead of Create
+	statsMu.RLock()
+	Expect(stats.Descriptors[descriptor1Name].Methods).To(HaveKey("CreateBatch"))
+	Expect(stats.Descriptors[descriptor1Name].Methods["CreateBatch"].Count).To(BeEquivalentTo(2))
+	Expect(stats.Descriptors[descriptor1Name].Methods).ToNot(HaveKey("Create"))
+	statsMu.RUnlock()
+
+	// operations are recorded in the planned order
+	txn := scheduler.GetRecordedTransaction(seqNum)
+	Expect(txn.Executed).To(HaveLen(4))
+	for _, op := range txn.Executed {
+		Expect(op.NewState).To(Equal(ValueState_CONFIGURED))
+	}
+
+	// run 2nd transaction with a failure inside a batch
+	mockSB.PlanError(prefixA+baseValue4, errors.New("failed to delete value"), nil)
+	schedulerTxn2 := scheduler.StartNBTransaction()
+	schedulerTxn2.SetValue(prefixA+baseValue2, nil)
+	schedulerTxn2.SetValue(prefixA+baseValue4, nil)
+	seqNum, err = schedulerTxn2.Commit(WithSimulation(testCtx))
+	Expect(err).ToNot(BeNil())
+	txnErr := err.(*TransactionError)
+	kvErrors := txnErr.GetKVErrors()
+	Expect(kvErrors).To(HaveLen(1))
+	Expect(kvErrors[0].Key).To(BeEquivalentTo(prefixA + baseValue4))
+	Expect(kvErrors[0].TxnOperation).To(BeEquivalentTo(TxnOperation_DELETE))
+
+	// check the state of SB
+	Expect(mockSB.GetKeysWithInvalidData()).To(BeEmpty())
+	Expect(mockSB.GetValue(prefixA + baseValue2)).To(BeNil())
+	Expect(mockSB.GetValue(prefixA + baseValue4)).ToNot(BeNil())
+
+	// per-item errors are mapped back to the keys
+	txn = scheduler.GetRecordedTransaction(seqNum)
+	Expect(txn.Executed).To(HaveLen(2))
+	checkOpsFollowPlan(txn.Executed, txn.Planned)
+	Expect(txn.Executed[0].Key).To(Equal(prefixA + baseValue2))
+	Expect(txn.Executed[0].NewState).To(Equal(ValueState_REMOVED))
+	Expect(txn.Executed[1].Key).To(Equal(prefixA + baseValue4))
+	Expect(txn.Executed[1].NewState).To(Equal(ValueState_FAILED))
+	Expect(txn.Executed[1].NewErrMsg).To(Equal("failed to delete value"))
+	statsMu.RLock()
+	Expect(stats.Descriptors[descriptor1Name].Methods["DeleteBatch"].Count).To(BeEquivalentTo(1))
+	statsMu.RUnlock()
+}
+
+func TestTakePreparedOp(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+	defer scheduler.Close()
+
+	args := &applyValueArgs{
+		kv: kvForTxn{key: prefixA + baseValue1},
+		prepared: map[string]*preparedOp{
+			prefixA + baseValue1: {operation: TxnOperation_CREATE, key: prefixA + baseValue1},
+			prefixA + baseValue2: {operation: TxnOperation_UPDATE, key: prefixA + baseValue2},
+		},
+	}
+
+	// not prepared value
+	args.kv.key = prefixA + baseValue3
+	Expect(scheduler.takePreparedOp(args, TxnOperation_CREATE)).To(BeNil())
+
+	// prepared operation is used only once
+	args.kv.key = prefixA + baseValue1
+	op := scheduler.takePreparedOp(args, TxnOperation_CREATE)
+	Expect(op).ToNot(BeNil())
+	Expect(op.err).To(BeNil())
+	Expect(scheduler.takePreparedOp(args, TxnOperation_CREATE)).To(BeNil())
+
+	// mismatching operation fails instead of executing the value again
+	args.kv.key = prefixA + baseValue2
+	op = scheduler.takePreparedOp(args, TxnOperation_DELETE)
+	Expect(op).ToNot(BeNil())
+	Expect(op.err).ToNot(BeNil())
+	Expect(args.prepared).To(BeEmpty())
+}
@@ -16,13 +16,15 @@ package kvscheduler
 
 import (
 	"context"
-	"runtime/trace"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 
 	"go.ligato.io/cn-infra/v2/logging"
 
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/graph"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/utils"
@@ -33,13 +35,18 @@ import (
 // Once finalized, it is recorded as instance of RecordedTxn and these data
 // are thrown away.
 type transaction struct {
-	ctx     context.Context
-	seqNum  uint64
-	txnType kvs.TxnType
-	values  []kvForTxn
-	nb      *nbTxn    // defined for NB transactions
-	retry   *retryTxn // defined for retry of failed operations
-	created time.Time
+	ctx      context.Context
+	seqNum   uint64
+	txnType  kvs.TxnType
+	values   []kvForTxn
+	nb       *nbTxn    // defined for NB transactions
+	retry    *retryTxn // defined for retry of failed operations
+	created  time.Time
+	priority kvs.TxnPriority
+
+	// simulated operations of each value (index matches values),
+	// used to plan parallel execution
+	plan []kvs.RecordedTxnOps
 }
 
 // kvForTxn represents a new value for a given key to be applied in a transaction.
@@ -62,8 +69,20 @@ type nbTxn struct {
 
 	revertOnFailure bool
 	withSimulation  bool
+	dryRun          bool
+	dryRunRecord    *kvs.RecordedTxn // filled for the caller of dry-run
 	description     string
 	resultChan      chan txnResult
+
+	// resyncKeys limits DownstreamResync to the given set of values
+	// (nil = resync all values)
+	resyncKeys utils.KeySet
+
+	// NB data of resync as received, used to restart resync preempted
+	// by a high-priority transaction
+	resyncValues []kvForTxn
+	preempted    bool
+	preemptions  int
 }
 
 // retryTxn encapsulates data for retry of failed operations.
@@ -93,14 +112,14 @@ func (s *Scheduler) consumeTransactions() {
 		if canceled {
 			return
 		}
-		reportQueueWait(txn.txnType, time.Since(txn.created).Seconds())
+		reportQueueWait(txn.txnType, txn.priority, time.Since(txn.created).Seconds())
 		s.processTransaction(txn)
 		reportTxnProcessed(txn.txnType, time.Since(txn.created).Seconds())
 	}
 }
 
 // processTransaction processes transaction in 6 steps:
-//	1. Pre-processing: transaction parameters are initialized, retry operations
+//  1. Pre-processing: transaction parameters are initialized, retry operations
 //     are filtered from the obsolete ones and for the resync the graph is refreshed
 //  2. Ordering: pre-order operations using a heuristic to get the shortest graph
 //     walk in average
@@ -108,7 +127,9 @@ func (s *Scheduler) consumeTransactions() {
 //     Create/Delete/Update operations in order to obtain the "execution plan"
 //  4. Pre-recording: logging transaction arguments + plan before execution to
 //     persist some information in case there is a crash during execution
-//  5. Execution: executing the transaction, collecting errors
+//  5. Execution: executing the transaction, collecting errors (independent
+//     operations of concurrency-safe descriptors are executed in parallel,
+//     or in batches if the descriptor defines batch handlers)
 //  6. Recording: recording the finalized transaction (log + in-memory)
 //  7. Post-processing: scheduling retry for failed operations, propagating value
 //     state updates to the subscribers and returning error/nil to the caller
@@ -121,29 +142,53 @@ func (s *Scheduler) processTransaction(txn *transaction) {
 
 	startTime := time.Now()
 
+	// time spent in the queue is recorded as a separate span preceding the processing
+	_, queueSpan := tracing.Tracer().Start(txn.ctx, "kvscheduler.queue", trace.WithTimestamp(txn.created))
+	queueSpan.End(trace.WithTimestamp(startTime))
+
+	parentCtx := txn.ctx
+	ctx, span := tracing.Start(txn.ctx, "kvscheduler.processTransaction",
+		attribute.String("kvscheduler.txn_type", txn.txnType.String()),
+		attribute.String("kvscheduler.txn_priority", txn.priority.String()))
+	defer span.End()
+	txn.ctx = ctx
+
 	// 1. Pre-processing:
 	skipExec, skipSimulation, record := s.preProcessTransaction(txn)
+	span.SetAttributes(attribute.Int64("kvscheduler.txn_seq_num", int64(txn.seqNum)))
 
 	// 2. Ordering:
 	if !skipExec {
+		_, orderSpan := tracing.Start(txn.ctx, "kvscheduler.order")
 		txn.values = s.orderValuesByOp(txn.values)
+		orderSpan.End()
 	}
 
 	// 3. Simulation:
+	// (the plan is also needed to execute independent operations in waves)
 	var simulatedOps kvs.RecordedTxnOps
-	if !skipSimulation {
+	simulate := !skipSimulation || (!skipExec && s.canExecInWaves(txn))
+	if simulate {
 		graphW := s.graph.Write(false, record)
 		simulatedOps = s.executeTransaction(txn, graphW, true)
-		if len(simulatedOps) == 0 {
+		if len(simulatedOps) == 0 && !isDryRun(txn) {
 			// nothing to execute
 			graphW.Save()
 			skipExec = true
 		}
 		graphW.Release()
 	}
+	if isDryRun(txn) {
+		// only the plan was requested
+		skipExec = true
+	}
 
 	// 4. Pre-recording
-	preTxnRecord := s.preRecordTransaction(txn, simulatedOps, skipSimulation)
+	var plannedOps kvs.RecordedTxnOps
+	if !skipSimulation {
+		plannedOps = simulatedOps
+	}
+	preTxnRecord := s.preRecordTransaction(txn, plannedOps, skipSimulation, startTime)
 
 	// 5. Execution:
 	var executedOps kvs.RecordedTxnOps
@@ -158,6 +203,14 @@ func (s *Scheduler) processTransaction(txn *transaction) {
 	// 6. Recording:
 	s.recordTransaction(txn, preTxnRecord, executedOps, startTime, stopTime)
 
+	if txn.txnType == kvs.NBTransaction && txn.nb.preempted {
+		// post-processing is done once the resync is completed
+		txn.ctx = parentCtx
+		s.requeuePreemptedResync(txn)
+		updateTransactionStats(executedOps)
+		return
+	}
+
 	// 7. Post-processing:
 	s.postProcessTransaction(txn, executedOps)
 
@@ -168,12 +221,16 @@ func (s *Scheduler) processTransaction(txn *transaction) {
 // preProcessTransaction initializes transaction parameters, filters obsolete retry
 // operations and refreshes the graph for resync.
 func (s *Scheduler) preProcessTransaction(txn *transaction) (skipExec, skipSimulation, record bool) {
-	defer trace.StartRegion(txn.ctx, "preProcessTransaction").End()
+	ctx, span := tracing.Start(txn.ctx, "kvscheduler.preProcessTransaction")
+	defer span.End()
 	defer trackTransactionMethod("preProcessTransaction")()
 
 	// allocate new transaction sequence number
+	// (dry-run only borrows the number of the next transaction)
 	txn.seqNum = s.txnSeqNumber
-	s.txnSeqNumber++
+	if !isDryRun(txn) {
+		s.txnSeqNumber++
+	}
 
 	switch txn.txnType {
 	case kvs.SBNotification:
@@ -181,7 +238,7 @@ func (s *Scheduler) preProcessTransaction(txn *transaction) (skipExec, skipSimul
 		skipSimulation = !s.config.EnableTxnSimulation
 		record = true
 	case kvs.NBTransaction:
-		skipExec = s.preProcessNBTransaction(txn)
+		skipExec = s.preProcessNBTransaction(ctx, txn)
 		skipSimulation = skipExec || !txn.nb.withSimulation
 		record = txn.nb.resyncType != kvs.DownstreamResync
 	case kvs.RetryFailedOps:
@@ -204,21 +261,45 @@ func (s *Scheduler) preProcessNotification(txn *transaction) (skip bool) {
 }
 
 // preProcessNBTransaction refreshes the graph for resync.
-func (s *Scheduler) preProcessNBTransaction(txn *transaction) (skip bool) {
+func (s *Scheduler) preProcessNBTransaction(ctx context.Context, txn *transaction) (skip bool) {
 	if txn.nb.resyncType == kvs.NotResync {
 		// nothing to do in the pre-processing stage
 		return false
 	}
 
 	// for resync refresh the graph + collect deletes
-	graphW := s.graph.Write(true, false)
+	// (dry-run is planned against the cached SB state and must not change the graph)
+	graphW := s.graph.Write(!txn.nb.dryRun, false)
 	defer graphW.Release()
-	s.resyncCount++
+	if !txn.nb.dryRun && txn.nb.preemptions == 0 {
+		s.resyncCount++
+	}
+	if canPreemptResync(txn) {
+		txn.nb.resyncValues = append([]kvForTxn(nil), txn.values...)
+	}
+
+	// for targeted downstream resync replace derived keys with their base keys
+	resyncKeys := txn.nb.resyncKeys
+	if resyncKeys != nil {
+		resyncKeys = utils.NewMapBasedKeySet()
+		for _, key := range txn.nb.resyncKeys.Iterate() {
+			if node := graphW.GetNode(key); node != nil {
+				key = getNodeBaseKey(node)
+			}
+			resyncKeys.Add(key)
+		}
+	}
+	skipKey := func(key string) bool {
+		return resyncKeys != nil && !resyncKeys.Has(key)
+	}
 
 	if txn.nb.resyncType == kvs.DownstreamResync {
 		// for downstream resync it is assumed that scheduler is in-sync with NB
 		currentNodes := graphW.GetNodes(nil, nbBaseValsSelectors()...)
 		for _, node := range currentNodes {
+			if skipKey(node.GetKey()) {
+				continue
+			}
 			lastUpdate := getNodeLastUpdate(node)
 			txn.values = append(txn.values,
 				kvForTxn{
@@ -238,8 +319,8 @@ func (s *Scheduler) preProcessNBTransaction(txn *transaction) (skip bool) {
 
 	// unless this is only UpstreamResync, refresh the graph with the current
 	// state of SB
-	if txn.nb.resyncType != kvs.UpstreamResync {
-		s.refreshGraph(graphW, nil, &resyncData{
+	if txn.nb.resyncType != kvs.UpstreamResync && !txn.nb.dryRun {
+		s.refreshGraph(ctx, graphW, resyncKeys, &resyncData{
 			first:  s.resyncCount == 1,
 			values: txn.values,
 		}, txn.nb.verboseRefresh)
@@ -248,7 +329,7 @@ func (s *Scheduler) preProcessNBTransaction(txn *transaction) (skip bool) {
 	// collect deletes for obsolete values
 	currentNodes := graphW.GetNodes(nil, nbBaseValsSelectors()...)
 	for _, node := range currentNodes {
-		if nbKey := nbKeys.Has(node.GetKey()); nbKey {
+		if nbKey := nbKeys.Has(node.GetKey()); nbKey || skipKey(node.GetKey()) {
 			continue
 		}
 		txn.values = append(txn.values,
@@ -262,7 +343,7 @@ func (s *Scheduler) preProcessNBTransaction(txn *transaction) (skip bool) {
 	// update (record) SB values
 	sbNodes := graphW.GetNodes(nil, sbBaseValsSelectors()...)
 	for _, node := range sbNodes {
-		if nbKey := nbKeys.Has(node.GetKey()); nbKey {
+		if nbKey := nbKeys.Has(node.GetKey()); nbKey || skipKey(node.GetKey()) {
 			continue
 		}
 		txn.values = append(txn.values,
@@ -308,7 +389,8 @@ func (s *Scheduler) preProcessRetryTxn(txn *transaction) (skip bool) {
 // value state updates to the subscribers and error/nil to the caller of a blocking
 // commit.
 func (s *Scheduler) postProcessTransaction(txn *transaction, executed kvs.RecordedTxnOps) {
-	defer trace.StartRegion(txn.ctx, "postProcessTransaction").End()
+	ctx, span := tracing.Start(txn.ctx, "kvscheduler.postProcessTransaction")
+	defer span.End()
 	defer trackTransactionMethod("postProcessTransaction")()
 
 	// collect new failures (combining derived with base)
@@ -349,7 +431,7 @@ func (s *Scheduler) postProcessTransaction(txn *transaction, executed kvs.Record
 		// changes brought by refresh triggered solely for the verification are
 		// not saved into the graph
 		graphW := s.graph.Write(afterErrRefresh, false)
-		s.refreshGraph(graphW, toRefresh, nil, afterErrRefresh)
+		s.refreshGraph(ctx, graphW, toRefresh, nil, afterErrRefresh)
 		s.scheduleRetries(txn, graphW, toRetry)
 
 		// if enabled, verify transaction effects
@@ -386,6 +468,7 @@ func (s *Scheduler) postProcessTransaction(txn *transaction, executed kvs.Record
 				Key:          txnOp.Key,
 				TxnOperation: txnOp.Operation,
 				Error:        txnOp.NewErr,
+				IsRevert:     txnOp.IsRevert,
 			})
 	}
 	if len(kvErrors) > 0 {
@@ -430,6 +513,18 @@ func (s *Scheduler) postProcessTransaction(txn *transaction, executed kvs.Record
 		}
 		graphW.Release()
 	}
+
+	// once the graph is populated by the startup resync, re-synchronize values
+	// touched by transactions that were interrupted by agent restart
+	if txn.txnType == kvs.NBTransaction && txn.nb.resyncType != kvs.NotResync && !txn.nb.dryRun &&
+		s.resyncCount == 1 {
+		s.resyncInterruptedTxns()
+	}
+}
+
+// isDryRun returns true if the transaction should be only simulated.
+func isDryRun(txn *transaction) bool {
+	return txn.txnType == kvs.NBTransaction && txn.nb.dryRun
 }
 
 // scheduleRetries schedules a series of re-try transactions for failed values
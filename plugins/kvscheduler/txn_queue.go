@@ -16,6 +16,7 @@ package kvscheduler
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"go.ligato.io/cn-infra/v2/logging"
@@ -23,43 +24,367 @@ import (
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 )
 
-// enqueueTxn adds transaction into the FIFO queue (channel) for execution.
+// maxResyncPreemptions is the maximum number of times a single resync can be
+// preempted by high-priority transactions.
+const maxResyncPreemptions = 3
+
+// txnQueue is a priority queue of transactions waiting to be processed.
+// Transactions are ordered by priority and, within the same priority, by the
+// order of arrival. Transactions changing the same keys are always processed
+// in the order of arrival. Resync is overtaken only by high-priority NB
+// transactions, which are then merged into the resync data so that the resync
+// does not revert them. Every priority class has its own capacity, therefore
+// transactions of one class can never fill the queue for the others.
+// To prevent starvation, the priority of a queued transaction is raised
+// by one class for every <agingPeriod> spent waiting in the queue.
+type txnQueue struct {
+	sync.Mutex
+
+	capacity    int           // per priority class
+	agingPeriod time.Duration // 0 to disable aging
+
+	queued []*transaction // in the order of arrival
+	length map[kvs.TxnPriority]int
+
+	// closed and replaced to wake up waiting consumer/producers
+	pushed chan struct{}
+	popped chan struct{}
+}
+
+// newTxnQueue creates a new instance of the transaction queue.
+func newTxnQueue(capacity int, agingPeriod time.Duration) *txnQueue {
+	return &txnQueue{
+		capacity:    capacity,
+		agingPeriod: agingPeriod,
+		length:      make(map[kvs.TxnPriority]int),
+		pushed:      make(chan struct{}),
+		popped:      make(chan struct{}),
+	}
+}
+
+// push adds transaction into the queue. If the queue is full for the priority
+// of the transaction, push either waits until there is space (<block> is true)
+// or returns ErrTxnQueueFull.
+// Values of SB notification are merged into SB notifications already queued
+// for the same keys (only the latest value matters). Returns <coalesced> as true
+// if all the values were merged and the transaction was therefore not queued.
+func (q *txnQueue) push(ctx context.Context, txn *transaction, block bool) (coalesced bool, err error) {
+	for {
+		q.Lock()
+		if txn.txnType == kvs.SBNotification && q.coalesce(txn) {
+			q.Unlock()
+			return true, nil
+		}
+		if q.length[txn.priority] < q.capacity {
+			q.queued = append(q.queued, txn)
+			q.length[txn.priority]++
+			close(q.pushed)
+			q.pushed = make(chan struct{})
+			q.Unlock()
+			return false, nil
+		}
+		popped := q.popped
+		q.Unlock()
+
+		if !block {
+			return false, kvs.ErrTxnQueueFull
+		}
+		select {
+		case <-ctx.Done():
+			return false, kvs.ErrClosedScheduler
+		case <-popped:
+		}
+	}
+}
+
+// pop removes and returns the queued transaction of the highest (effective)
+// priority, waiting for one if the queue is empty.
+func (q *txnQueue) pop(ctx context.Context) (txn *transaction, canceled bool) {
+	for {
+		q.Lock()
+		if len(q.queued) > 0 {
+			idx := q.selectNext(q.queued, time.Now())
+			txn = q.queued[idx]
+			for _, older := range q.queued[:idx] {
+				if isResyncTxn(older) {
+					mergeIntoResync(older, txn)
+				}
+			}
+			q.queued = append(q.queued[:idx], q.queued[idx+1:]...)
+			q.length[txn.priority]--
+			close(q.popped)
+			q.popped = make(chan struct{})
+			q.Unlock()
+			return txn, false
+		}
+		pushed := q.pushed
+		q.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, true
+		case <-pushed:
+		}
+	}
+}
+
+// requeue returns preempted transaction back to the head of the queue.
+// The transaction has been already counted in, therefore the capacity is not
+// checked.
+func (q *txnQueue) requeue(txn *transaction) {
+	q.Lock()
+	defer q.Unlock()
+	q.queued = append([]*transaction{txn}, q.queued...)
+	q.length[txn.priority]++
+	close(q.pushed)
+	q.pushed = make(chan struct{})
+}
+
+// preempts returns true if some of the queued transactions should be processed
+// before the remaining operations of the given resync transaction.
+func (q *txnQueue) preempts(resync *transaction) bool {
+	q.Lock()
+	defer q.Unlock()
+	var candidate bool
+	for _, txn := range q.queued {
+		if canOvertakeResync(txn) {
+			candidate = true
+			break
+		}
+	}
+	if !candidate {
+		return false
+	}
+	queued := append([]*transaction{resync}, q.queued...)
+	return q.selectNext(queued, time.Now()) != 0
+}
+
+// selectNext returns index of the transaction from <queued> to process next.
+// Transaction may get ahead of older transactions only if it does not touch
+// any of their keys, otherwise an older value could be applied last. Resync
+// never overtakes another transaction and is overtaken only by transactions
+// allowed by canOvertakeResync.
+func (q *txnQueue) selectNext(queued []*transaction, now time.Time) (next int) {
+	nextPriority := q.effectivePriority(queued[0], now)
+	if nextPriority == kvs.HighPriority && !isResyncTxn(queued[0]) {
+		return 0
+	}
+	var resyncAhead bool
+	olderKeys := make(map[string]struct{}) // keys of the older transactions (except resync)
+	for i := 1; i < len(queued); i++ {
+		if older := queued[i-1]; isResyncTxn(older) {
+			resyncAhead = true
+		} else {
+			txnKeys(older, olderKeys)
+		}
+		txn := queued[i]
+		if isResyncTxn(txn) || touchesKeys(txn, olderKeys) {
+			continue
+		}
+		if resyncAhead && !canOvertakeResync(txn) {
+			continue
+		}
+		// for the same priority the oldest wins
+		priority := q.effectivePriority(txn, now)
+		if priority <= nextPriority && !isResyncTxn(queued[next]) {
+			continue
+		}
+		next, nextPriority = i, priority
+		if priority == kvs.HighPriority {
+			break
+		}
+	}
+	return next
+}
+
+// isResyncTxn returns true for NB transaction with resync.
+func isResyncTxn(txn *transaction) bool {
+	return txn.txnType == kvs.NBTransaction && txn.nb != nil && txn.nb.resyncType != kvs.NotResync
+}
+
+// canOvertakeResync returns true for transaction which is allowed to get ahead
+// of a resync, i.e. high-priority NB transaction (not resync) which can be
+// merged into the resync data (not reverted on failure, not only dry-run).
+func canOvertakeResync(txn *transaction) bool {
+	return txn.txnType == kvs.NBTransaction && txn.nb != nil && txn.nb.resyncType == kvs.NotResync &&
+		txn.priority == kvs.HighPriority && !txn.nb.revertOnFailure && !txn.nb.dryRun
+}
+
+// mergeIntoResync updates NB data of the resync with the values of transaction
+// that got ahead of it, so that the resync does not revert them.
+// Downstream resync re-applies values from the graph and needs no merging.
+func mergeIntoResync(resync, txn *transaction) {
+	if resync.nb.resyncType == kvs.DownstreamResync {
+		return
+	}
+	newValues := make(map[string]kvForTxn)
+	for _, kv := range txn.values {
+		newValues[kv.key] = kv
+	}
+	merged := make([]kvForTxn, 0, len(resync.values)+len(newValues))
+	for _, kv := range resync.values {
+		if newKv, updated := newValues[kv.key]; updated {
+			delete(newValues, kv.key)
+			if newKv.value == nil {
+				// removed by the transaction
+				continue
+			}
+			kv = newKv
+		}
+		merged = append(merged, kv)
+	}
+	for _, kv := range txn.values {
+		if newKv, added := newValues[kv.key]; added {
+			delete(newValues, kv.key)
+			if newKv.value != nil {
+				merged = append(merged, newKv)
+			}
+		}
+	}
+	resync.values = merged
+}
+
+// txnKeys adds keys of the values changed by the transaction into <keys>.
+func txnKeys(txn *transaction, keys map[string]struct{}) {
+	for _, kv := range txn.values {
+		keys[kv.key] = struct{}{}
+	}
+	if txn.retry != nil {
+		for key := range txn.retry.keys {
+			keys[key] = struct{}{}
+		}
+	}
+}
+
+// touchesKeys returns true if the transaction changes any of the given keys.
+func touchesKeys(txn *transaction, keys map[string]struct{}) bool {
+	for _, kv := range txn.values {
+		if _, ok := keys[kv.key]; ok {
+			return true
+		}
+	}
+	if txn.retry != nil {
+		for key := range txn.retry.keys {
+			if _, ok := keys[key]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// effectivePriority returns priority of the transaction raised by aging.
+func (q *txnQueue) effectivePriority(txn *transaction, now time.Time) kvs.TxnPriority {
+	priority := txn.priority
+	if q.agingPeriod > 0 && priority < kvs.HighPriority {
+		priority += kvs.TxnPriority(now.Sub(txn.created) / q.agingPeriod)
+		if priority > kvs.HighPriority {
+			priority = kvs.HighPriority
+		}
+	}
+	return priority
+}
+
+// coalesce merges values of the SB notification into SB notifications already
+// queued. A value is merged only if the latest queued transaction with the same
+// key is SB notification. Returns true if all the values were merged.
+func (q *txnQueue) coalesce(txn *transaction) bool {
+	var remaining []kvForTxn
+	for _, kv := range txn.values {
+		if !q.coalesceValue(kv) {
+			remaining = append(remaining, kv)
+		}
+	}
+	if merged := len(txn.values) - len(remaining); merged > 0 {
+		reportSBNotifCoalesced(merged)
+	}
+	txn.values = remaining
+	return len(remaining) == 0
+}
+
+// coalesceValue tries to merge a single value of SB notification.
+func (q *txnQueue) coalesceValue(kv kvForTxn) bool {
+	for i := len(q.queued) - 1; i >= 0; i-- {
+		queued := q.queued[i]
+		for j := range queued.values {
+			if queued.values[j].key != kv.key {
+				continue
+			}
+			if queued.txnType != kvs.SBNotification {
+				return false
+			}
+			queued.values[j] = kv
+			return true
+		}
+	}
+	return false
+}
+
+// enqueueTxn adds transaction into the priority queue for execution.
 func (s *Scheduler) enqueueTxn(txn *transaction) error {
 	if txn.ctx == nil {
 		txn.ctx = context.TODO()
 	}
-	//trace.Log(txn.ctx, "txn", "enqueue")
-	if txn.txnType == kvs.NBTransaction && txn.nb.isBlocking {
-		select {
-		case <-s.ctx.Done():
-			return kvs.ErrClosedScheduler
-		case s.txnQueue <- txn:
-			reportQueued(1)
-			return nil
-		}
+	if txn.txnType == kvs.RetryFailedOps {
+		// retries should not starve fresh requests
+		txn.priority = kvs.LowPriority
 	}
-	select {
-	case <-s.ctx.Done():
-		return kvs.ErrClosedScheduler
-	case s.txnQueue <- txn:
-		reportQueued(1)
-		return nil
-	default:
+	blocking := txn.txnType == kvs.NBTransaction && txn.nb.isBlocking
+	coalesced, err := s.txnQueue.push(s.ctx, txn, blocking)
+	if err == kvs.ErrTxnQueueFull {
 		reportTxnDropped()
-		return kvs.ErrTxnQueueFull
 	}
+	if err != nil || coalesced {
+		return err
+	}
+	reportQueued(txn.priority, 1)
+	return nil
 }
 
-// dequeueTxn pulls the oldest queued transaction.
+// dequeueTxn pulls the queued transaction of the highest priority.
 func (s *Scheduler) dequeueTxn() (txn *transaction, canceled bool) {
-	select {
-	case <-s.ctx.Done():
+	txn, canceled = s.txnQueue.pop(s.ctx)
+	if canceled {
 		return nil, true
-	case txn = <-s.txnQueue:
-		reportQueued(-1)
-		//trace.Log(txn.ctx, "txn", "dequeue")
-		return txn, false
 	}
+	reportQueued(txn.priority, -1)
+	return txn, false
+}
+
+// preemptResync checks in-between operations of resync if there is a queued
+// transaction that should be processed before the rest of the resync.
+// Returns true if the resync was preempted and should stop the execution.
+func (s *Scheduler) preemptResync(txn *transaction) bool {
+	if !canPreemptResync(txn) || !s.txnQueue.preempts(txn) {
+		return false
+	}
+	txn.nb.preempted = true
+	return true
+}
+
+// canPreemptResync returns true if the given transaction is resync which can be
+// interrupted and restarted later. Resync with revert cannot be split and resync
+// is preempted at most <maxResyncPreemptions> times to guarantee its completion.
+func canPreemptResync(txn *transaction) bool {
+	return isResyncTxn(txn) && !txn.nb.revertOnFailure && !txn.nb.dryRun &&
+		txn.nb.preemptions < maxResyncPreemptions
+}
+
+// requeuePreemptedResync returns preempted resync back to the head of the queue.
+// Operations already executed are recorded as a separate transaction, the
+// restarted resync then refreshes the graph again and executes the remaining
+// operations (including those of the preempting transactions merged into
+// the resync data).
+func (s *Scheduler) requeuePreemptedResync(txn *transaction) {
+	s.Log.WithField("txnSeq", txn.seqNum).
+		Info("Resync preempted by a high-priority transaction")
+	txn.values = txn.nb.resyncValues
+	txn.plan = nil
+	txn.nb.resyncValues = nil
+	txn.nb.preempted = false
+	txn.nb.preemptions++
+	s.txnQueue.requeue(txn)
+	reportQueued(txn.priority, 1)
 }
 
 // enqueueRetry schedules retry for failed operations.
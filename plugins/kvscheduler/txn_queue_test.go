@@ -0,0 +1,336 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvscheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+
+	. "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/test"
+)
+
+func TestTxnQueuePriority(t *testing.T) {
+	RegisterTestingT(t)
+	ctx := context.Background()
+	queue := newTxnQueue(2, 0)
+
+	created := time.Now()
+	low := &transaction{txnType: RetryFailedOps, priority: LowPriority, created: created}
+	normal1 := &transaction{txnType: NBTransaction, priority: NormalPriority, created: created}
+	normal2 := &transaction{txnType: NBTransaction, priority: NormalPriority, created: created}
+	high := &transaction{txnType: NBTransaction, priority: HighPriority, created: created}
+
+	for _, txn := range []*transaction{low, normal1, normal2, high} {
+		coalesced, err := queue.push(ctx, txn, false)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(coalesced).To(BeFalse())
+	}
+
+	// capacity is per priority class
+	_, err := queue.push(ctx, &transaction{txnType: NBTransaction, priority: NormalPriority}, false)
+	Expect(err).To(Equal(ErrTxnQueueFull))
+	_, err = queue.push(ctx, &transaction{txnType: NBTransaction, priority: HighPriority}, false)
+	Expect(err).ShouldNot(HaveOccurred())
+	txn, _ := queue.pop(ctx)
+	Expect(txn).To(BeIdenticalTo(high))
+
+	// highest priority first, FIFO within the same priority
+	for _, expected := range []*transaction{normal1, normal2, low} {
+		txn, canceled := queue.pop(ctx)
+		Expect(canceled).To(BeFalse())
+		Expect(txn).To(BeIdenticalTo(expected))
+	}
+
+	// pop waits for a transaction until canceled
+	cancelCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	txn, canceled := queue.pop(cancelCtx)
+	Expect(canceled).To(BeTrue())
+	Expect(txn).To(BeNil())
+}
+
+func TestTxnQueueAging(t *testing.T) {
+	RegisterTestingT(t)
+	ctx := context.Background()
+	queue := newTxnQueue(10, time.Second)
+
+	now := time.Now()
+	old := &transaction{txnType: RetryFailedOps, priority: LowPriority, created: now.Add(-2 * time.Second)}
+	fresh := &transaction{txnType: NBTransaction, priority: NormalPriority, created: now}
+
+	Expect(queue.effectivePriority(old, now)).To(Equal(HighPriority))
+	Expect(queue.effectivePriority(fresh, now)).To(Equal(NormalPriority))
+
+	_, err := queue.push(ctx, fresh, false)
+	Expect(err).ShouldNot(HaveOccurred())
+	_, err = queue.push(ctx, old, false)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	// retry waiting for long enough gets ahead of the fresh request
+	txn, _ := queue.pop(ctx)
+	Expect(txn).To(BeIdenticalTo(old))
+	txn, _ = queue.pop(ctx)
+	Expect(txn).To(BeIdenticalTo(fresh))
+}
+
+func TestTxnQueueCoalesceSBNotifications(t *testing.T) {
+	RegisterTestingT(t)
+	ctx := context.Background()
+	queue := newTxnQueue(10, 0)
+
+	sbNotif := func(key, value string) *transaction {
+		return &transaction{
+			txnType: SBNotification,
+			created: time.Now(),
+			values: []kvForTxn{{
+				key:    key,
+				value:  test.NewStringValue(value),
+				origin: FromSB,
+			}},
+		}
+	}
+
+	// first notification is queued
+	first := sbNotif(prefixA+baseValue1, "v1")
+	coalesced, err := queue.push(ctx, first, false)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(coalesced).To(BeFalse())
+
+	// second notification for the same key is merged
+	coalesced, err = queue.push(ctx, sbNotif(prefixA+baseValue1, "v2"), false)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(coalesced).To(BeTrue())
+	Expect(first.values).To(HaveLen(1))
+	Expect(proto.Equal(first.values[0].value, test.NewStringValue("v2"))).To(BeTrue())
+
+	// NB transaction for the same key prevents merging of the following notification
+	nbTxn := &transaction{
+		txnType: NBTransaction,
+		created: time.Now(),
+		values: []kvForTxn{{
+			key:    prefixA + baseValue1,
+			value:  test.NewStringValue("nb"),
+			origin: FromNB,
+		}},
+	}
+	_, err = queue.push(ctx, nbTxn, false)
+	Expect(err).ShouldNot(HaveOccurred())
+	coalesced, err = queue.push(ctx, sbNotif(prefixA+baseValue1, "v3"), false)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(coalesced).To(BeFalse())
+
+	for _, expected := range []string{"v2", "nb", "v3"} {
+		txn, _ := queue.pop(ctx)
+		Expect(proto.Equal(txn.values[0].value, test.NewStringValue(expected))).To(BeTrue())
+	}
+}
+
+func TestTxnQueueKeepsOrderOfSameKeys(t *testing.T) {
+	RegisterTestingT(t)
+	ctx := context.Background()
+	queue := newTxnQueue(10, 0)
+
+	nbTxn := func(priority TxnPriority, resync ResyncType, keys ...string) *transaction {
+		txn := &transaction{
+			txnType:  NBTransaction,
+			priority: priority,
+			created:  time.Now(),
+			nb:       &nbTxn{resyncType: resync},
+		}
+		for _, key := range keys {
+			txn.values = append(txn.values, kvForTxn{
+				key:    key,
+				value:  test.NewStringValue(priority.String()),
+				origin: FromNB,
+			})
+		}
+		return txn
+	}
+
+	normal := nbTxn(NormalPriority, NotResync, prefixA+baseValue1)
+	highSameKey := nbTxn(HighPriority, NotResync, prefixA+baseValue2, prefixA+baseValue1)
+	highOtherKey := nbTxn(HighPriority, NotResync, prefixA+baseValue3)
+	for _, txn := range []*transaction{normal, highSameKey, highOtherKey} {
+		_, err := queue.push(ctx, txn, false)
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	// high priority txn with the same key waits for the older txn,
+	// the one with other keys gets ahead
+	for _, expected := range []*transaction{highOtherKey, normal, highSameKey} {
+		txn, _ := queue.pop(ctx)
+		Expect(txn).To(BeIdenticalTo(expected))
+	}
+
+	// resync never overtakes
+	low := nbTxn(LowPriority, NotResync, prefixA+baseValue1)
+	resync := nbTxn(HighPriority, FullResync, prefixB+baseValue1)
+	for _, txn := range []*transaction{low, resync} {
+		_, err := queue.push(ctx, txn, false)
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+	for _, expected := range []*transaction{low, resync} {
+		txn, _ := queue.pop(ctx)
+		Expect(txn).To(BeIdenticalTo(expected))
+	}
+}
+
+func TestTxnQueueHighPriorityOvertakesResync(t *testing.T) {
+	RegisterTestingT(t)
+	ctx := context.Background()
+	queue := newTxnQueue(10, 0)
+
+	nbTxn := func(priority TxnPriority, resync ResyncType, value string, keys ...string) *transaction {
+		txn := &transaction{
+			txnType:  NBTransaction,
+			priority: priority,
+			created:  time.Now(),
+			nb:       &nbTxn{resyncType: resync},
+		}
+		for _, key := range keys {
+			kv := kvForTxn{key: key, origin: FromNB}
+			if value != "" {
+				kv.value = test.NewStringValue(value)
+			}
+			txn.values = append(txn.values, kv)
+		}
+		return txn
+	}
+
+	resync := nbTxn(NormalPriority, FullResync, "resync", prefixA+baseValue1, prefixA+baseValue2)
+	normal := nbTxn(NormalPriority, NotResync, "normal", prefixA+baseValue3)
+	withRevert := nbTxn(HighPriority, NotResync, "revert", prefixA+baseValue3)
+	withRevert.nb.revertOnFailure = true
+	high := nbTxn(HighPriority, NotResync, "high", prefixA+baseValue1, prefixA+baseValue4)
+	highDelete := nbTxn(HighPriority, NotResync, "", prefixA+baseValue2)
+	for _, txn := range []*transaction{resync, normal, withRevert, high, highDelete} {
+		_, err := queue.push(ctx, txn, false)
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	// running resync is preempted by the queued high-priority transactions
+	running := nbTxn(NormalPriority, DownstreamResync, "")
+	Expect(queue.preempts(running)).To(BeTrue())
+
+	// high-priority transactions without revert get ahead of the queued resync,
+	// other transactions wait
+	for _, expected := range []*transaction{high, highDelete, resync, normal, withRevert} {
+		txn, _ := queue.pop(ctx)
+		Expect(txn).To(BeIdenticalTo(expected))
+	}
+	Expect(queue.preempts(running)).To(BeFalse())
+
+	// values of the transactions that got ahead are merged into the resync
+	Expect(resync.values).To(HaveLen(2))
+	Expect(resync.values[0].key).To(Equal(prefixA + baseValue1))
+	Expect(resync.values[1].key).To(Equal(prefixA + baseValue4))
+	for _, kv := range resync.values {
+		Expect(proto.Equal(kv.value, test.NewStringValue("high"))).To(BeTrue())
+	}
+
+	// high-priority transaction with keys of an older transaction waits for it
+	normal = nbTxn(NormalPriority, NotResync, "normal", prefixA+baseValue1)
+	high = nbTxn(HighPriority, NotResync, "high", prefixA+baseValue1)
+	for _, txn := range []*transaction{normal, high} {
+		_, err := queue.push(ctx, txn, false)
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+	Expect(queue.preempts(running)).To(BeFalse())
+}
+
+func TestResyncPreemptedByHighPriorityTxn(t *testing.T) {
+	RegisterTestingT(t)
+
+	// prepare KV Scheduler
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+
+	// prepare mocks
+	mockSB := test.NewMockSouthbound()
+	descriptor1 := test.NewMockDescriptor(&KVDescriptor{
+		Name:          descriptor1Name,
+		NBKeyPrefix:   prefixA,
+		KeySelector:   prefixSelector(prefixA),
+		ValueTypeName: string(proto.MessageName(test.NewStringValue(""))),
+		WithMetadata:  true,
+	}, mockSB, 0)
+
+	// high-priority transaction arrives during the first Create of the resync
+	create := descriptor1.Create
+	var highSent bool
+	descriptor1.Create = func(key string, value proto.Message) (Metadata, error) {
+		if !highSent {
+			highSent = true
+			highTxn := scheduler.StartNBTransaction()
+			highTxn.SetValue(prefixA+baseValue2, test.NewStringValue("high"))
+			highTxn.SetValue(prefixA+baseValue4, test.NewStringValue("high"))
+			_, err := highTxn.Commit(WithPriority(WithoutBlocking(testCtx), HighPriority))
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		return create(key, value)
+	}
+
+	// register descriptor with the scheduler
+	scheduler.RegisterKVDescriptor(descriptor1)
+
+	// run resync transaction
+	schedulerTxn := scheduler.StartNBTransaction()
+	schedulerTxn.SetValue(prefixA+baseValue1, test.NewStringValue("resync"))
+	schedulerTxn.SetValue(prefixA+baseValue2, test.NewStringValue("resync"))
+	schedulerTxn.SetValue(prefixA+baseValue3, test.NewStringValue("resync"))
+	seqNum, err := schedulerTxn.Commit(WithResync(testCtx, FullResync, true))
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(highSent).To(BeTrue())
+
+	// resync was completed after the high-priority transaction
+	Expect(seqNum).To(BeEquivalentTo(2))
+
+	// values of the high-priority transaction were not reverted by the resync
+	Expect(mockSB.GetKeysWithInvalidData()).To(BeEmpty())
+	for key, expected := range map[string]string{
+		prefixA + baseValue1: "resync",
+		prefixA + baseValue2: "high",
+		prefixA + baseValue3: "resync",
+		prefixA + baseValue4: "high",
+	} {
+		value := mockSB.GetValue(key)
+		Expect(value).ToNot(BeNil())
+		Expect(proto.Equal(value.Value, test.NewStringValue(expected))).To(BeTrue())
+	}
+
+	// preempted resync, high-priority transaction, restarted resync
+	txnHistory := scheduler.GetTransactionHistory(time.Time{}, time.Time{})
+	Expect(txnHistory).To(HaveLen(3))
+	Expect(txnHistory[0].ResyncType).To(Equal(FullResync))
+	Expect(txnHistory[0].Executed).To(HaveLen(1))
+	Expect(txnHistory[0].Executed[0].Key).To(Equal(prefixA + baseValue1))
+	Expect(txnHistory[1].ResyncType).To(Equal(NotResync))
+	Expect(txnHistory[1].Executed).To(HaveLen(2))
+	Expect(txnHistory[2].ResyncType).To(Equal(FullResync))
+	Expect(txnHistory[2].Executed).To(HaveLen(1))
+	Expect(txnHistory[2].Executed[0].Key).To(Equal(prefixA + baseValue3))
+
+	// close scheduler
+	err = scheduler.Close()
+	Expect(err).To(BeNil())
+}
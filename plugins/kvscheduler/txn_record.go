@@ -16,12 +16,12 @@ package kvscheduler
 
 import (
 	"fmt"
-	"runtime/trace"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/graph"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/utils"
@@ -104,21 +104,25 @@ func (s *Scheduler) preRecordTxnOp(args *applyValueArgs, node graph.Node) *kvs.R
 // preRecordTransaction logs transaction arguments + plan before execution to
 // persist some information in case there is a crash during execution.
 func (s *Scheduler) preRecordTransaction(txn *transaction, planned kvs.RecordedTxnOps,
-	skippedSimulation bool) *kvs.RecordedTxn {
-	defer trace.StartRegion(txn.ctx, "preRecordTransaction").End()
+	skippedSimulation bool, start time.Time) *kvs.RecordedTxn {
+	_, span := tracing.Start(txn.ctx, "kvscheduler.preRecordTransaction")
+	defer span.End()
 	defer trackTransactionMethod("preRecordTransaction")()
 
 	// allocate new transaction record
 	record := &kvs.RecordedTxn{
 		PreRecord:      true,
 		WithSimulation: !skippedSimulation,
+		Start:          start,
 		SeqNum:         txn.seqNum,
 		TxnType:        txn.txnType,
+		Priority:       txn.priority,
 		Planned:        planned,
 	}
 	if txn.txnType == kvs.NBTransaction {
 		record.ResyncType = txn.nb.resyncType
 		record.Description = txn.nb.description
+		record.DryRun = txn.nb.dryRun
 	}
 	if txn.txnType == kvs.RetryFailedOps {
 		record.RetryForTxn = txn.retry.txnSeqNum
@@ -158,12 +162,16 @@ func (s *Scheduler) preRecordTransaction(txn *transaction, planned kvs.RecordedT
 		fmt.Println(buf.String())
 	}
 
+	// persist the pre-record before the execution starts
+	s.journalTransaction(record)
+
 	return record
 }
 
-// recordTransaction records the finalized transaction (log + in-memory).
+// recordTransaction records the finalized transaction (log + journal + in-memory).
 func (s *Scheduler) recordTransaction(txn *transaction, txnRecord *kvs.RecordedTxn, executed kvs.RecordedTxnOps, start, stop time.Time) {
-	defer trace.StartRegion(txn.ctx, "recordTransaction").End()
+	_, span := tracing.Start(txn.ctx, "kvscheduler.recordTransaction")
+	defer span.End()
 	defer trackTransactionMethod("recordTransaction")()
 
 	txnRecord.PreRecord = false
@@ -186,6 +194,17 @@ func (s *Scheduler) recordTransaction(txn *transaction, txnRecord *kvs.RecordedT
 		fmt.Println(buf.String())
 	}
 
+	s.journalTransaction(txnRecord)
+
+	// return the plan to the caller of dry-run, which is not recorded
+	// into the history
+	if isDryRun(txn) {
+		if txn.nb.dryRunRecord != nil {
+			*txn.nb.dryRunRecord = *txnRecord
+		}
+		return
+	}
+
 	// add transaction record into the history
 	if s.config.RecordTransactionHistory {
 		s.historyLock.Lock()
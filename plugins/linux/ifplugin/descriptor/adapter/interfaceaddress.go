@@ -17,6 +17,17 @@ type InterfaceAddressKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type InterfaceAddressBatchOp struct {
+	Key         string
+	Value       *linux_interfaces.Interface
+	PrevValue   *linux_interfaces.Interface
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type InterfaceAddressDescriptor struct {
@@ -33,11 +44,15 @@ type InterfaceAddressDescriptor struct {
 	Delete               func(key string, value *linux_interfaces.Interface, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *linux_interfaces.Interface, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *linux_interfaces.Interface, metadata interface{}) bool
+	CreateBatch          func(ops []*InterfaceAddressBatchOp) error
+	UpdateBatch          func(ops []*InterfaceAddressBatchOp) error
+	DeleteBatch          func(ops []*InterfaceAddressBatchOp) error
 	Retrieve             func(correlate []InterfaceAddressKVWithMetadata) ([]InterfaceAddressKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *linux_interfaces.Interface) []KeyValuePair
 	Dependencies         func(key string, value *linux_interfaces.Interface) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewInterfaceAddressDescriptor(typedDescriptor *InterfaceAddressDescriptor)
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewInterfaceAddressDescriptor(typedDescriptor *InterfaceAddressDescriptor)
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *InterfaceAddressDescriptorAdapter) UpdateWithRecreate(key string, oldV
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *InterfaceAddressDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castInterfaceAddressBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyInterfaceAddressBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *InterfaceAddressDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castInterfaceAddressBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyInterfaceAddressBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *InterfaceAddressDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castInterfaceAddressBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyInterfaceAddressBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *InterfaceAddressDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []InterfaceAddressKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castInterfaceAddressValue(key string, value proto.Message) (*linux_interfac
 	return typedValue, nil
 }
 
+func castInterfaceAddressBatchOps(ops []*BatchOp, withPrevValue bool) []*InterfaceAddressBatchOp {
+	var typedOps []*InterfaceAddressBatchOp
+	for _, op := range ops {
+		typedValue, err := castInterfaceAddressValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *linux_interfaces.Interface
+		if withPrevValue {
+			typedPrevValue, err = castInterfaceAddressValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castInterfaceAddressMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &InterfaceAddressBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyInterfaceAddressBatchResults(ops []*BatchOp, typedOps []*InterfaceAddressBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castInterfaceAddressMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type InterfaceVrfKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type InterfaceVrfBatchOp struct {
+	Key         string
+	Value       *linux_interfaces.Interface
+	PrevValue   *linux_interfaces.Interface
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type InterfaceVrfDescriptor struct {
@@ -33,11 +44,15 @@ type InterfaceVrfDescriptor struct {
 	Delete               func(key string, value *linux_interfaces.Interface, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *linux_interfaces.Interface, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *linux_interfaces.Interface, metadata interface{}) bool
+	CreateBatch          func(ops []*InterfaceVrfBatchOp) error
+	UpdateBatch          func(ops []*InterfaceVrfBatchOp) error
+	DeleteBatch          func(ops []*InterfaceVrfBatchOp) error
 	Retrieve             func(correlate []InterfaceVrfKVWithMetadata) ([]InterfaceVrfKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *linux_interfaces.Interface) []KeyValuePair
 	Dependencies         func(key string, value *linux_interfaces.Interface) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewInterfaceVrfDescriptor(typedDescriptor *InterfaceVrfDescriptor) *KVDescr
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewInterfaceVrfDescriptor(typedDescriptor *InterfaceVrfDescriptor) *KVDescr
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *InterfaceVrfDescriptorAdapter) UpdateWithRecreate(key string, oldValue
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *InterfaceVrfDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castInterfaceVrfBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyInterfaceVrfBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *InterfaceVrfDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castInterfaceVrfBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyInterfaceVrfBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *InterfaceVrfDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castInterfaceVrfBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyInterfaceVrfBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *InterfaceVrfDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []InterfaceVrfKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castInterfaceVrfValue(key string, value proto.Message) (*linux_interfaces.I
 	return typedValue, nil
 }
 
+func castInterfaceVrfBatchOps(ops []*BatchOp, withPrevValue bool) []*InterfaceVrfBatchOp {
+	var typedOps []*InterfaceVrfBatchOp
+	for _, op := range ops {
+		typedValue, err := castInterfaceVrfValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *linux_interfaces.Interface
+		if withPrevValue {
+			typedPrevValue, err = castInterfaceVrfValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castInterfaceVrfMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &InterfaceVrfBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyInterfaceVrfBatchResults(ops []*BatchOp, typedOps []*InterfaceVrfBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castInterfaceVrfMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
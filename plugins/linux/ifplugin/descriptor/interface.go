@@ -70,6 +70,7 @@ const (
 	existingHostInterfaceDep = "host-interface-exists"
 	tapInterfaceDep          = "vpp-tap-interface-exists"
 	vethPeerDep              = "veth-peer-exists"
+	parentIfDep              = "parent-interface-exists"
 	microserviceDep          = "microservice-available"
 
 	// suffix attached to logical names of duplicate VETH interfaces
@@ -106,6 +107,10 @@ var (
 	// is not loaded.
 	ErrTAPRequiresVPPIfPlugin = errors.New("TAP_TO_VPP interface requires VPP interface plugin to be loaded")
 
+	// ErrLinuxCpWithoutVPPReference is returned when LINUX_CP interface is missing reference
+	// to the paired VPP interface.
+	ErrLinuxCpWithoutVPPReference = errors.New("LINUX_CP interface defined without reference to VPP interface")
+
 	// ErrNamespaceWithoutReference is returned when namespace is missing reference.
 	ErrNamespaceWithoutReference = errors.New("namespace defined without name")
 
@@ -131,6 +136,29 @@ var (
 
 	// ErrVRFDevInsideVrf is returned when VRF device is configured to be inside another VRF.
 	ErrVRFDevInsideVrf = errors.New("VRF device cannot be inside another VRF")
+
+	// ErrInterfaceWithoutParent is returned when VLAN, MACVLAN or IPVLAN interface
+	// is missing reference to the parent interface.
+	ErrInterfaceWithoutParent = errors.New("interface defined without reference to parent interface")
+
+	// ErrInterfaceIsOwnParent is returned when interface references itself as the parent.
+	ErrInterfaceIsOwnParent = errors.New("interface cannot be its own parent")
+
+	// ErrVLANInvalidID is returned when VLAN ID is out of the range 1-4094.
+	ErrVLANInvalidID = errors.New("VLAN ID must be in the range 1-4094")
+
+	// ErrVXLANInvalidVNI is returned when VXLAN network identifier is undefined or does not fit into 24 bits.
+	ErrVXLANInvalidVNI = errors.New("VXLAN VNI must be in the range 1-16777215")
+
+	// ErrVXLANInvalidAddress is returned when VXLAN source or destination IP address is not valid.
+	ErrVXLANInvalidAddress = errors.New("VXLAN IP address is not valid")
+
+	// ErrVXLANMulticastWithoutParent is returned when multicast VXLAN is defined without
+	// the parent (underlay) interface.
+	ErrVXLANMulticastWithoutParent = errors.New("multicast VXLAN requires parent interface")
+
+	// ErrBridgePortIsBridge is returned when bridge is configured to be its own port.
+	ErrBridgePortIsBridge = errors.New("bridge cannot be its own port")
 )
 
 // InterfaceDescriptor teaches KVScheduler how to configure Linux interfaces.
@@ -231,10 +259,19 @@ func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf
 		if oldIntf.GetTap().GetVppTapIfName() != newIntf.GetTap().GetVppTapIfName() {
 			return false
 		}
+	case interfaces.Interface_LINUX_CP:
+		if oldIntf.GetLinuxCp().GetVppIfName() != newIntf.GetLinuxCp().GetVppIfName() {
+			return false
+		}
 	case interfaces.Interface_VRF_DEVICE:
 		if oldIntf.GetVrfDev().GetRoutingTable() != newIntf.GetVrfDev().GetRoutingTable() {
 			return false
 		}
+	case interfaces.Interface_BRIDGE, interfaces.Interface_VLAN, interfaces.Interface_MACVLAN,
+		interfaces.Interface_IPVLAN, interfaces.Interface_VXLAN:
+		if !equivalentLinks(oldIntf, newIntf) {
+			return false
+		}
 	}
 
 	if !proto.Equal(oldIntf.Namespace, newIntf.Namespace) {
@@ -267,8 +304,27 @@ func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf
 		return false
 	}
 
-	// IP addresses and VRFs are derived out and therefore not compared here
+	// IP addresses, VRFs and bridge ports are derived out and therefore not compared here
+
+	return true
+}
 
+// equivalentLinks compares type-specific attributes of interfaces with parent
+// interface and of bridges.
+func equivalentLinks(oldIntf, newIntf *interfaces.Interface) bool {
+	switch oldIntf.Type {
+	case interfaces.Interface_BRIDGE:
+		// ports are derived out
+		return oldIntf.GetBridge().GetVlanFiltering() == newIntf.GetBridge().GetVlanFiltering()
+	case interfaces.Interface_VLAN:
+		return proto.Equal(oldIntf.GetVlan(), newIntf.GetVlan())
+	case interfaces.Interface_MACVLAN:
+		return proto.Equal(oldIntf.GetMacvlan(), newIntf.GetMacvlan())
+	case interfaces.Interface_IPVLAN:
+		return proto.Equal(oldIntf.GetIpvlan(), newIntf.GetIpvlan())
+	case interfaces.Interface_VXLAN:
+		return equivalentVxlanLinks(oldIntf.GetVxlan(), newIntf.GetVxlan())
+	}
 	return true
 }
 
@@ -319,6 +375,10 @@ func (d *InterfaceDescriptor) Validate(key string, linuxIf *interfaces.Interface
 		if d.vppIfPlugin == nil {
 			return ErrTAPRequiresVPPIfPlugin
 		}
+	case interfaces.Interface_LINUX_CP:
+		if linuxIf.GetLinuxCp().GetVppIfName() == "" {
+			return kvs.NewInvalidValueError(ErrLinuxCpWithoutVPPReference, "vpp_if_name")
+		}
 	case interfaces.Interface_VRF_DEVICE:
 		if linuxIf.GetPhysAddress() != "" {
 			return kvs.NewInvalidValueError(ErrVRFDevWithMACAddr, "type", "phys_address")
@@ -326,6 +386,18 @@ func (d *InterfaceDescriptor) Validate(key string, linuxIf *interfaces.Interface
 		if linuxIf.GetVrfMasterInterface() != "" {
 			return kvs.NewInvalidValueError(ErrVRFDevInsideVrf, "type", "vrf")
 		}
+	case interfaces.Interface_VLAN, interfaces.Interface_MACVLAN, interfaces.Interface_IPVLAN:
+		parent := getParentIfName(linuxIf)
+		if parent == "" {
+			return kvs.NewInvalidValueError(ErrInterfaceWithoutParent, "parent_if_name")
+		}
+		if parent == linuxIf.GetName() {
+			return kvs.NewInvalidValueError(ErrInterfaceIsOwnParent, "parent_if_name")
+		}
+	case interfaces.Interface_VXLAN:
+		if linuxIf.GetVxlan().GetParentIfName() == linuxIf.GetName() {
+			return kvs.NewInvalidValueError(ErrInterfaceIsOwnParent, "parent_if_name")
+		}
 	case interfaces.Interface_UNDEFINED:
 		return kvs.NewInvalidValueError(ErrInterfaceWithoutType, "type")
 	}
@@ -339,6 +411,10 @@ func (d *InterfaceDescriptor) Validate(key string, linuxIf *interfaces.Interface
 		if linuxIf.GetTap().GetVppTapIfName() == "" {
 			return kvs.NewInvalidValueError(ErrTAPWithoutVPPReference, "vpp_tap_if_name")
 		}
+	case *interfaces.Interface_LinuxCp:
+		if linuxIf.GetType() != interfaces.Interface_LINUX_CP {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
 	case *interfaces.Interface_Veth:
 		if linuxIf.GetType() != interfaces.Interface_VETH {
 			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
@@ -346,6 +422,42 @@ func (d *InterfaceDescriptor) Validate(key string, linuxIf *interfaces.Interface
 		if linuxIf.GetVeth().GetPeerIfName() == "" {
 			return kvs.NewInvalidValueError(ErrVETHWithoutPeer, "peer_if_name")
 		}
+	case *interfaces.Interface_Bridge:
+		if linuxIf.GetType() != interfaces.Interface_BRIDGE {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+		for _, port := range linuxIf.GetBridge().GetPorts() {
+			if port == linuxIf.GetName() {
+				return kvs.NewInvalidValueError(ErrBridgePortIsBridge, "ports")
+			}
+		}
+	case *interfaces.Interface_Vlan:
+		if linuxIf.GetType() != interfaces.Interface_VLAN {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+		if vlanID := linuxIf.GetVlan().GetVlanId(); vlanID < 1 || vlanID > 4094 {
+			return kvs.NewInvalidValueError(ErrVLANInvalidID, "vlan_id")
+		}
+	case *interfaces.Interface_Macvlan:
+		if linuxIf.GetType() != interfaces.Interface_MACVLAN {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+	case *interfaces.Interface_Ipvlan:
+		if linuxIf.GetType() != interfaces.Interface_IPVLAN {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+	case *interfaces.Interface_Vxlan:
+		if linuxIf.GetType() != interfaces.Interface_VXLAN {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+	}
+
+	// validate VLAN ID and VXLAN attributes also when the link is missing
+	if linuxIf.GetType() == interfaces.Interface_VLAN && linuxIf.GetVlan() == nil {
+		return kvs.NewInvalidValueError(ErrVLANInvalidID, "vlan_id")
+	}
+	if linuxIf.GetType() == interfaces.Interface_VXLAN {
+		return validateVxlanLink(linuxIf.GetVxlan())
 	}
 
 	return nil
@@ -368,6 +480,8 @@ func (d *InterfaceDescriptor) Create(key string, linuxIf *interfaces.Interface)
 		metadata, err = d.createVETH(nsCtx, key, linuxIf)
 	case interfaces.Interface_TAP_TO_VPP:
 		metadata, err = d.createTAPToVPP(nsCtx, key, linuxIf)
+	case interfaces.Interface_LINUX_CP:
+		metadata, err = d.createLinuxCp(nsCtx, linuxIf)
 	case interfaces.Interface_LOOPBACK:
 		metadata, err = d.createLoopback(nsCtx, linuxIf)
 	case interfaces.Interface_EXISTING:
@@ -392,6 +506,16 @@ func (d *InterfaceDescriptor) Create(key string, linuxIf *interfaces.Interface)
 		metadata, err = d.createVRF(nsCtx, linuxIf)
 	case interfaces.Interface_DUMMY:
 		metadata, err = d.createDummyIf(nsCtx, linuxIf)
+	case interfaces.Interface_BRIDGE:
+		metadata, err = d.createBridge(nsCtx, linuxIf)
+	case interfaces.Interface_VLAN:
+		metadata, err = d.createVLAN(nsCtx, linuxIf)
+	case interfaces.Interface_MACVLAN:
+		metadata, err = d.createMacvlan(nsCtx, linuxIf)
+	case interfaces.Interface_IPVLAN:
+		metadata, err = d.createIPvlan(nsCtx, linuxIf)
+	case interfaces.Interface_VXLAN:
+		metadata, err = d.createVXLAN(nsCtx, linuxIf)
 	default:
 		return nil, ErrUnsupportedLinuxInterfaceType
 	}
@@ -482,6 +606,8 @@ func (d *InterfaceDescriptor) Delete(key string, linuxIf *interfaces.Interface,
 		return d.deleteVETH(nsCtx, key, linuxIf, metadata)
 	case interfaces.Interface_TAP_TO_VPP:
 		return d.deleteAutoTAP(nsCtx, key, linuxIf, metadata)
+	case interfaces.Interface_LINUX_CP:
+		return d.deleteLinuxCp(linuxIf)
 	case interfaces.Interface_LOOPBACK:
 		return d.deleteLoopback(nsCtx, linuxIf)
 	case interfaces.Interface_EXISTING:
@@ -492,6 +618,11 @@ func (d *InterfaceDescriptor) Delete(key string, linuxIf *interfaces.Interface,
 		return d.deleteVRF(linuxIf)
 	case interfaces.Interface_DUMMY:
 		return d.deleteDummyIf(linuxIf)
+	case interfaces.Interface_BRIDGE:
+		return d.deleteBridge(linuxIf)
+	case interfaces.Interface_VLAN, interfaces.Interface_MACVLAN,
+		interfaces.Interface_IPVLAN, interfaces.Interface_VXLAN:
+		return d.deleteLinkWithParent(linuxIf)
 	}
 
 	err = ErrUnsupportedLinuxInterfaceType
@@ -616,8 +747,15 @@ func (d *InterfaceDescriptor) UpdateWithRecreate(key string, oldLinuxIf, newLinu
 		return oldLinuxIf.GetVeth().GetPeerIfName() != newLinuxIf.GetVeth().GetPeerIfName()
 	case interfaces.Interface_TAP_TO_VPP:
 		return oldLinuxIf.GetTap().GetVppTapIfName() != newLinuxIf.GetTap().GetVppTapIfName()
+	case interfaces.Interface_LINUX_CP:
+		// host interface cannot be renamed under the linux-cp pair
+		return oldLinuxIf.GetLinuxCp().GetVppIfName() != newLinuxIf.GetLinuxCp().GetVppIfName() ||
+			getHostIfName(oldLinuxIf) != getHostIfName(newLinuxIf)
 	case interfaces.Interface_VRF_DEVICE:
 		return oldLinuxIf.GetVrfDev().GetRoutingTable() != newLinuxIf.GetVrfDev().GetRoutingTable()
+	case interfaces.Interface_BRIDGE, interfaces.Interface_VLAN, interfaces.Interface_MACVLAN,
+		interfaces.Interface_IPVLAN, interfaces.Interface_VXLAN:
+		return !equivalentLinks(oldLinuxIf, newLinuxIf)
 	}
 	return false
 }
@@ -652,6 +790,14 @@ func (d *InterfaceDescriptor) Dependencies(key string, linuxIf *interfaces.Inter
 		}
 	}
 
+	// VLAN, MACVLAN, IPVLAN and VXLAN depend on the parent interface
+	if parentName := getParentIfName(linuxIf); parentName != "" {
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: parentIfDep,
+			Key:   interfaces.InterfaceKey(parentName),
+		})
+	}
+
 	if linuxIf.GetNamespace().GetType() == namespace.NetNamespace_MICROSERVICE {
 		dependencies = append(dependencies, kvs.Dependency{
 			Label: microserviceDep,
@@ -665,6 +811,7 @@ func (d *InterfaceDescriptor) Dependencies(key string, linuxIf *interfaces.Inter
 // DerivedValues derives:
 //   - one empty value to represent interface state
 //   - one empty value to represent assignment of the interface to a (non-default) VRF
+//   - one empty value for every port enslaved to a bridge
 //   - one empty value for every IP address assigned to the interface.
 func (d *InterfaceDescriptor) DerivedValues(key string, linuxIf *interfaces.Interface) (derValues []kvs.KeyValuePair) {
 	// interface state
@@ -685,6 +832,12 @@ func (d *InterfaceDescriptor) DerivedValues(key string, linuxIf *interfaces.Inte
 			},
 		})
 	}
+	for _, port := range linuxIf.GetBridge().GetPorts() {
+		derValues = append(derValues, kvs.KeyValuePair{
+			Key:   interfaces.InterfaceBridgePortKey(port, linuxIf.Name),
+			Value: &emptypb.Empty{},
+		})
+	}
 	if !linuxIf.GetLinkOnly() || linuxIf.GetType() == interfaces.Interface_EXISTING {
 		var ipSource netalloc_api.IPAddressSource
 		if linuxIf.GetLinkOnly() { // interface type = EXISTING
@@ -745,6 +898,28 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 		}
 	}
 
+	// host sides of VPP linux-cp pairs are derived from the pairs (i.e. not given
+	// for correlation) and can be found in any named namespace
+	linuxCpOnlyNs := make(map[string]bool) // named namespaces listed only for LINUX_CP interfaces
+	namedNsList, err := d.nsPlugin.ListNamedNamespaces()
+	if err != nil {
+		d.log.Warnf("failed to list named namespaces: %v", err)
+	}
+	for _, nsName := range namedNsList {
+		ns := &namespace.NetNamespace{Type: namespace.NetNamespace_NSID, Reference: nsName}
+		nsListed := false
+		for _, ns2 := range nsList {
+			if proto.Equal(ns, ns2) {
+				nsListed = true
+				break
+			}
+		}
+		if !nsListed {
+			nsList = append(nsList, ns)
+			linuxCpOnlyNs[nsName] = true
+		}
+	}
+
 	// retrieve EXISTING interfaces first
 	existingIfaces, err := d.retrieveExistingInterfaces(expExisting)
 	if err != nil {
@@ -765,6 +940,10 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 	indexes := make(map[int]struct{})
 
 	for _, ifDetail := range ifDetails {
+		if ifNs := ifDetail.Interface.GetNamespace(); ifNs.GetType() == namespace.NetNamespace_NSID &&
+			linuxCpOnlyNs[ifNs.GetReference()] && ifDetail.Interface.Type != interfaces.Interface_LINUX_CP {
+			continue
+		}
 		// Transform linux interface details to the type-safe value with metadata
 		var vrfDevRT uint32
 		if ifDetail.Interface.Type == interfaces.Interface_VRF_DEVICE {
@@ -1105,6 +1284,8 @@ func getInterfaceMTU(linuxIntf *interfaces.Interface) int {
 			return defaultLoopbackMTU
 		case interfaces.Interface_VRF_DEVICE:
 			return DefaultVrfDevMTU
+		case interfaces.Interface_VXLAN:
+			return defaultVxlanMTU
 		}
 		return defaultEthernetMTU
 	}
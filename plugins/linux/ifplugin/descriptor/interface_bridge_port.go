@@ -0,0 +1,179 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	iflinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+const (
+	// InterfaceBridgePortDescriptorName is the name of the descriptor for enslaving
+	// Linux interfaces to a bridge.
+	InterfaceBridgePortDescriptorName = "linux-interface-bridge-port"
+
+	// dependency labels
+	bridgePortDep = "bridge-port-exists"
+)
+
+// InterfaceBridgePortDescriptor (un)sets Linux interface as a port of a Linux bridge.
+// Bridge ports are derived from the bridge configuration.
+type InterfaceBridgePortDescriptor struct {
+	log       logging.Logger
+	ifHandler iflinuxcalls.NetlinkAPI
+	nsPlugin  nsplugin.API
+	intfIndex ifaceidx.LinuxIfMetadataIndex
+}
+
+// NewInterfaceBridgePortDescriptor creates a new instance of InterfaceBridgePortDescriptor.
+func NewInterfaceBridgePortDescriptor(nsPlugin nsplugin.API,
+	ifHandler iflinuxcalls.NetlinkAPI, log logging.PluginLogger) (descr *kvs.KVDescriptor, ctx *InterfaceBridgePortDescriptor) {
+
+	ctx = &InterfaceBridgePortDescriptor{
+		ifHandler: ifHandler,
+		nsPlugin:  nsPlugin,
+		log:       log.NewLogger("interface-bridge-port-descriptor"),
+	}
+	descr = &kvs.KVDescriptor{
+		Name:         InterfaceBridgePortDescriptorName,
+		KeySelector:  ctx.IsInterfaceBridgePortKey,
+		Validate:     ctx.Validate,
+		Create:       ctx.Create,
+		Delete:       ctx.Delete,
+		Dependencies: ctx.Dependencies,
+	}
+	return
+}
+
+// SetInterfaceIndex should be used to provide interface index immediately after
+// the descriptor registration.
+func (d *InterfaceBridgePortDescriptor) SetInterfaceIndex(intfIndex ifaceidx.LinuxIfMetadataIndex) {
+	d.intfIndex = intfIndex
+}
+
+// IsInterfaceBridgePortKey returns true if the key represents Linux interface enslaved to a bridge.
+func (d *InterfaceBridgePortDescriptor) IsInterfaceBridgePortKey(key string) bool {
+	_, _, _, isBridgePortKey := interfaces.ParseInterfaceBridgePortKey(key)
+	return isBridgePortKey
+}
+
+// Validate validates derived key.
+func (d *InterfaceBridgePortDescriptor) Validate(key string, emptyVal proto.Message) (err error) {
+	iface, bridge, invalidKey, _ := interfaces.ParseInterfaceBridgePortKey(key)
+	if invalidKey {
+		return errors.New("invalid key")
+	}
+	if iface == bridge {
+		return kvs.NewInvalidValueError(ErrBridgePortIsBridge, "bridge.ports")
+	}
+	return nil
+}
+
+// Create enslaves interface to the bridge.
+func (d *InterfaceBridgePortDescriptor) Create(key string, emptyVal proto.Message) (metadata kvs.Metadata, err error) {
+	iface, bridge, _, _ := interfaces.ParseInterfaceBridgePortKey(key)
+	ifMeta, bridgeMeta, err := d.lookupPort(iface, bridge)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	if !proto.Equal(ifMeta.Namespace, bridgeMeta.Namespace) {
+		err = errors.Errorf("interface %s is not in the same namespace as bridge %s", iface, bridge)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	// switch to the namespace with the bridge
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	revert, err := d.nsPlugin.SwitchToNamespace(nsCtx, bridgeMeta.Namespace)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	defer revert()
+
+	err = d.ifHandler.PutInterfaceIntoBridge(ifMeta.HostIfName, bridgeMeta.HostIfName)
+	if err != nil {
+		err = errors.WithMessagef(err, "failed to put interface '%s' into bridge '%s'",
+			ifMeta.HostIfName, bridgeMeta.HostIfName)
+	}
+	return nil, err
+}
+
+// Delete releases interface from the bridge.
+func (d *InterfaceBridgePortDescriptor) Delete(key string, emptyVal proto.Message, metadata kvs.Metadata) (err error) {
+	iface, bridge, _, _ := interfaces.ParseInterfaceBridgePortKey(key)
+	ifMeta, bridgeMeta, err := d.lookupPort(iface, bridge)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+
+	// switch to the namespace with the interface
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	revert, err := d.nsPlugin.SwitchToNamespace(nsCtx, ifMeta.Namespace)
+	if err != nil {
+		if _, ok := err.(*nsplugin.UnavailableMicroserviceErr); ok {
+			// Assume that the delete was called by scheduler because the namespace
+			// was removed. Do not return error in this case.
+			d.log.Debugf("Interface %s assumed to be released from bridge %s, required namespace %+v does not exist",
+				iface, bridge, ifMeta.Namespace)
+			return nil
+		}
+		d.log.Error(err)
+		return err
+	}
+	defer revert()
+
+	err = d.ifHandler.RemoveInterfaceFromBridge(ifMeta.HostIfName, bridgeMeta.HostIfName)
+	if err != nil {
+		err = errors.WithMessagef(err, "failed to remove interface '%s' from bridge '%s'",
+			ifMeta.HostIfName, bridgeMeta.HostIfName)
+	}
+	return err
+}
+
+// Dependencies lists the enslaved interface as the only dependency
+// (the bridge exists since the port is derived from it).
+func (d *InterfaceBridgePortDescriptor) Dependencies(key string, emptyVal proto.Message) (deps []kvs.Dependency) {
+	iface, _, _, _ := interfaces.ParseInterfaceBridgePortKey(key)
+	return []kvs.Dependency{
+		{
+			Label: bridgePortDep,
+			Key:   interfaces.InterfaceKey(iface),
+		},
+	}
+}
+
+// lookupPort returns metadata of the enslaved interface and the bridge.
+func (d *InterfaceBridgePortDescriptor) lookupPort(iface, bridge string) (ifMeta, bridgeMeta *ifaceidx.LinuxIfMetadata, err error) {
+	ifMeta, found := d.intfIndex.LookupByName(iface)
+	if !found {
+		return nil, nil, errors.Errorf("failed to find interface %s", iface)
+	}
+	bridgeMeta, found = d.intfIndex.LookupByName(bridge)
+	if !found {
+		return nil, nil, errors.Errorf("failed to find bridge %s", bridge)
+	}
+	return ifMeta, bridgeMeta, nil
+}
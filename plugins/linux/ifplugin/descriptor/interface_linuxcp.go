@@ -0,0 +1,75 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+// createLinuxCp looks up the host side of VPP linux-cp pair (created by VPP
+// in the destination namespace) and marks it as managed by the agent.
+func (d *InterfaceDescriptor) createLinuxCp(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, linuxIf *interfaces.Interface,
+) (md *ifaceidx.LinuxIfMetadata, err error) {
+	hostName := getHostIfName(linuxIf)
+	agentPrefix := d.serviceLabel.GetAgentPrefix()
+
+	// move to the namespace with the interface
+	revert, err := d.nsPlugin.SwitchToNamespace(nsCtx, linuxIf.Namespace)
+	if err != nil {
+		d.log.Error("switch to namespace failed:", err)
+		return nil, err
+	}
+	defer revert()
+
+	link, err := d.ifHandler.GetLinkByName(hostName)
+	if err != nil {
+		return nil, errors.WithMessagef(err,
+			"failed to find host side of linux-cp pair for VPP interface %s",
+			linuxIf.GetLinuxCp().GetVppIfName())
+	}
+
+	// add alias to associate the interface with the logical name and the VPP interface
+	err = d.ifHandler.SetInterfaceAlias(hostName, agentPrefix+linuxcalls.GetLinuxCpAlias(linuxIf))
+	if err != nil {
+		return nil, errors.WithMessagef(err,
+			"error setting alias for linux-cp interface %s", hostName)
+	}
+
+	return &ifaceidx.LinuxIfMetadata{
+		Namespace:    linuxIf.Namespace,
+		LinuxIfIndex: link.Attrs().Index,
+		HostIfName:   hostName,
+	}, nil
+}
+
+// deleteLinuxCp only un-marks the host side of VPP linux-cp pair - the interface
+// itself is removed by VPP together with the pair.
+func (d *InterfaceDescriptor) deleteLinuxCp(linuxIf *interfaces.Interface) error {
+	hostName := getHostIfName(linuxIf)
+	// vishvananda/netlink does not support alias removal, so we just change
+	// it to a string which is not prefixed with agent label
+	err := d.ifHandler.SetInterfaceAlias(hostName, "unconfigured")
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+// createMacvlan creates a new MACVLAN interface on top of the parent interface.
+func (d *InterfaceDescriptor) createMacvlan(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, linuxIf *interfaces.Interface,
+) (md *ifaceidx.LinuxIfMetadata, err error) {
+	macvlan := linuxIf.GetMacvlan()
+	return d.createLinkWithParent(nsCtx, linuxIf, macvlan.GetParentIfName(),
+		func(hostName, parentHostName string) error {
+			return d.ifHandler.AddMacvlanInterface(hostName, parentHostName, macvlan)
+		})
+}
+
+// createIPvlan creates a new IPVLAN interface on top of the parent interface.
+func (d *InterfaceDescriptor) createIPvlan(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, linuxIf *interfaces.Interface,
+) (md *ifaceidx.LinuxIfMetadata, err error) {
+	ipvlan := linuxIf.GetIpvlan()
+	return d.createLinkWithParent(nsCtx, linuxIf, ipvlan.GetParentIfName(),
+		func(hostName, parentHostName string) error {
+			return d.ifHandler.AddIPvlanInterface(hostName, parentHostName, ipvlan)
+		})
+}
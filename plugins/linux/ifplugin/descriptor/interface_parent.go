@@ -0,0 +1,147 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+// addLinkFn creates a new link with the given host name on top of the parent
+// interface (referenced by its host name).
+type addLinkFn func(hostName, parentHostName string) error
+
+// createLinkWithParent creates a new link (VLAN, MACVLAN, IPVLAN, VXLAN) on top
+// of the parent interface. The link is always created in the namespace of the parent
+// and then, if needed, moved into the namespace of the interface under a temporary
+// host name. Without parent the link is created directly in the namespace of the interface.
+func (d *InterfaceDescriptor) createLinkWithParent(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, linuxIf *interfaces.Interface, parentName string, addLink addLinkFn,
+) (md *ifaceidx.LinuxIfMetadata, err error) {
+	hostName := getHostIfName(linuxIf)
+	agentPrefix := d.serviceLabel.GetAgentPrefix()
+
+	// determine namespace and host name of the parent
+	parentNs := linuxIf.Namespace
+	var parentHostName string
+	if parentName != "" {
+		parentMeta, found := d.intfIndex.LookupByName(parentName)
+		if !found {
+			return nil, errors.Errorf("failed to find parent interface %s", parentName)
+		}
+		parentNs = parentMeta.Namespace
+		parentHostName = parentMeta.HostIfName
+	}
+	sameNs := proto.Equal(parentNs, linuxIf.Namespace)
+	createName := hostName
+	if !sameNs {
+		createName = getTemporaryHostName(linuxIf.Name)
+	}
+
+	// move to the namespace with the parent
+	revert, err := d.nsPlugin.SwitchToNamespace(nsCtx, parentNs)
+	if err != nil {
+		d.log.Error("switch to namespace failed:", err)
+		return nil, err
+	}
+	defer revert()
+
+	if !sameNs {
+		// delete obsolete/invalid unfinished link (ignore errors)
+		_ = d.ifHandler.DeleteInterface(createName)
+	}
+
+	// create the link
+	if err = addLink(createName, parentHostName); err != nil {
+		return nil, errors.WithMessagef(err, "failed to create %v interface %s", linuxIf.Type, createName)
+	}
+
+	// add alias
+	err = d.ifHandler.SetInterfaceAlias(createName, agentPrefix+linuxcalls.GetParentIfAlias(linuxIf.Name, parentName))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error setting alias for interface %s", createName)
+	}
+
+	if !sameNs {
+		// move the link to the right namespace
+		err = d.setInterfaceNamespace(nsCtx, createName, linuxIf.Namespace)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error setting interface %s to namespace %v", createName, linuxIf.Namespace)
+		}
+
+		// move to the namespace with the interface
+		revert2, err := d.nsPlugin.SwitchToNamespace(nsCtx, linuxIf.Namespace)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error switching to namespace %v", linuxIf.Namespace)
+		}
+		defer revert2()
+
+		// rename from the temporary host name to the requested host name
+		if err = d.ifHandler.RenameInterface(createName, hostName); err != nil {
+			return nil, errors.WithMessagef(err, "error renaming %s to %s", createName, hostName)
+		}
+	}
+
+	// build metadata
+	link, err := d.ifHandler.GetLinkByName(hostName)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error getting link %s", hostName)
+	}
+
+	return &ifaceidx.LinuxIfMetadata{
+		Namespace:    linuxIf.Namespace,
+		LinuxIfIndex: link.Attrs().Index,
+		HostIfName:   hostName,
+	}, nil
+}
+
+// deleteLinkWithParent removes link created on top of a parent interface.
+func (d *InterfaceDescriptor) deleteLinkWithParent(linuxIf *interfaces.Interface) error {
+	hostName := getHostIfName(linuxIf)
+	err := d.ifHandler.DeleteInterface(hostName)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// getParentIfName returns logical name of the parent interface (empty if there is none).
+func getParentIfName(linuxIf *interfaces.Interface) string {
+	switch linuxIf.Type {
+	case interfaces.Interface_VLAN:
+		return linuxIf.GetVlan().GetParentIfName()
+	case interfaces.Interface_MACVLAN:
+		return linuxIf.GetMacvlan().GetParentIfName()
+	case interfaces.Interface_IPVLAN:
+		return linuxIf.GetIpvlan().GetParentIfName()
+	case interfaces.Interface_VXLAN:
+		return linuxIf.GetVxlan().GetParentIfName()
+	}
+	return ""
+}
+
+// getTemporaryHostName (deterministically) generates a temporary host name
+// for a link created outside of its target namespace.
+func getTemporaryHostName(ifName string) string {
+	return fmt.Sprintf("link-%d", fnvHash(ifName))
+}
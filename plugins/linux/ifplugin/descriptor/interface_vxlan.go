@@ -0,0 +1,94 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+const (
+	// default MTU of VXLAN interfaces - leaves space for the VXLAN encapsulation
+	// inside the default ethernet MTU.
+	defaultVxlanMTU = 1450
+
+	// maximum VXLAN network identifier (24 bits)
+	maxVxlanVNI = 1<<24 - 1
+)
+
+// createVXLAN creates a new VXLAN interface. The parent interface is optional
+// and is used as the underlay device for the VXLAN tunnel.
+func (d *InterfaceDescriptor) createVXLAN(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, linuxIf *interfaces.Interface,
+) (md *ifaceidx.LinuxIfMetadata, err error) {
+	vxlan := linuxIf.GetVxlan()
+	return d.createLinkWithParent(nsCtx, linuxIf, vxlan.GetParentIfName(),
+		func(hostName, parentHostName string) error {
+			return d.ifHandler.AddVXLANInterface(hostName, parentHostName, vxlan)
+		})
+}
+
+// equivalentVxlanLinks compares VXLAN links with the default port and IP addresses
+// normalized.
+func equivalentVxlanLinks(oldLink, newLink *interfaces.VxlanLink) bool {
+	return oldLink.GetVni() == newLink.GetVni() &&
+		oldLink.GetParentIfName() == newLink.GetParentIfName() &&
+		oldLink.GetTtl() == newLink.GetTtl() &&
+		oldLink.GetLearning() == newLink.GetLearning() &&
+		getVxlanPort(oldLink) == getVxlanPort(newLink) &&
+		equivalentIPs(oldLink.GetSrcAddress(), newLink.GetSrcAddress()) &&
+		equivalentIPs(oldLink.GetDstAddress(), newLink.GetDstAddress())
+}
+
+// getVxlanPort returns the destination UDP port of the VXLAN link.
+func getVxlanPort(vxlan *interfaces.VxlanLink) uint32 {
+	if vxlan.GetDstPort() == 0 {
+		return linuxcalls.DefaultVxlanPort
+	}
+	return vxlan.GetDstPort()
+}
+
+// equivalentIPs compares IP addresses (empty address is equal only to another empty address).
+func equivalentIPs(ip1, ip2 string) bool {
+	if ip1 == "" || ip2 == "" {
+		return ip1 == ip2
+	}
+	return net.ParseIP(ip1).Equal(net.ParseIP(ip2))
+}
+
+// validateVxlanLink validates VXLAN-specific attributes.
+func validateVxlanLink(vxlan *interfaces.VxlanLink) error {
+	if vni := vxlan.GetVni(); vni < 1 || vni > maxVxlanVNI {
+		return kvs.NewInvalidValueError(ErrVXLANInvalidVNI, "vni")
+	}
+	if srcAddr := vxlan.GetSrcAddress(); srcAddr != "" && net.ParseIP(srcAddr) == nil {
+		return kvs.NewInvalidValueError(ErrVXLANInvalidAddress, "src_address")
+	}
+	if dstAddr := vxlan.GetDstAddress(); dstAddr != "" {
+		ip := net.ParseIP(dstAddr)
+		if ip == nil {
+			return kvs.NewInvalidValueError(ErrVXLANInvalidAddress, "dst_address")
+		}
+		if ip.IsMulticast() && vxlan.GetParentIfName() == "" {
+			return kvs.NewInvalidValueError(ErrVXLANMulticastWithoutParent, "dst_address", "parent_if_name")
+		}
+	}
+	return nil
+}
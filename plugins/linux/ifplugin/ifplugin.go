@@ -40,7 +40,8 @@ const (
 	defaultGoRoutinesCnt = 10
 )
 
-// IfPlugin configures Linux VETH and TAP interfaces using Netlink API.
+// IfPlugin configures Linux VETH, TAP, bridge, VLAN, MACVLAN, IPVLAN and VXLAN
+// interfaces using Netlink API.
 type IfPlugin struct {
 	Deps
 
@@ -51,10 +52,11 @@ type IfPlugin struct {
 	ifHandler linuxcalls.NetlinkAPI
 
 	// descriptors
-	ifDescriptor     *descriptor.InterfaceDescriptor
-	ifWatcher        *descriptor.InterfaceWatcher
-	ifAddrDescriptor *descriptor.InterfaceAddressDescriptor
-	ifVrfDescriptor  *descriptor.InterfaceVrfDescriptor
+	ifDescriptor       *descriptor.InterfaceDescriptor
+	ifWatcher          *descriptor.InterfaceWatcher
+	ifAddrDescriptor   *descriptor.InterfaceAddressDescriptor
+	ifVrfDescriptor    *descriptor.InterfaceVrfDescriptor
+	ifBrPortDescriptor *descriptor.InterfaceBridgePortDescriptor
 
 	// index map
 	ifIndex ifaceidx.LinuxIfMetadataIndex
@@ -115,11 +117,12 @@ func (p *IfPlugin) Init() error {
 		config.GoRoutinesCnt, p.Log)
 	p.ifDescriptor.SetInterfaceHandler(p.ifHandler)
 
-	var addrDescriptor, vrfDescriptor *kvs.KVDescriptor
+	var addrDescriptor, vrfDescriptor, brPortDescriptor *kvs.KVDescriptor
 	addrDescriptor, p.ifAddrDescriptor = descriptor.NewInterfaceAddressDescriptor(p.NsPlugin,
 		p.AddrAlloc, p.ifHandler, p.Log)
 	vrfDescriptor, p.ifVrfDescriptor = descriptor.NewInterfaceVrfDescriptor(p.NsPlugin, p.ifHandler, p.Log)
-	err = p.Deps.KVScheduler.RegisterKVDescriptor(addrDescriptor, vrfDescriptor)
+	brPortDescriptor, p.ifBrPortDescriptor = descriptor.NewInterfaceBridgePortDescriptor(p.NsPlugin, p.ifHandler, p.Log)
+	err = p.Deps.KVScheduler.RegisterKVDescriptor(addrDescriptor, vrfDescriptor, brPortDescriptor)
 	if err != nil {
 		return err
 	}
@@ -141,6 +144,7 @@ func (p *IfPlugin) Init() error {
 	p.ifDescriptor.SetInterfaceIndex(p.ifIndex)
 	p.ifAddrDescriptor.SetInterfaceIndex(p.ifIndex)
 	p.ifVrfDescriptor.SetInterfaceIndex(p.ifIndex)
+	p.ifBrPortDescriptor.SetInterfaceIndex(p.ifIndex)
 
 	// start interface watching
 	if err = p.ifWatcher.StartWatching(); err != nil {
@@ -38,6 +38,10 @@ const (
 	// minimum number of namespaces to be given to a single Go routine for processing
 	// in the Retrieve operation
 	minWorkForGoRoutine = 3
+
+	// prefix of the alias used to distinguish host side of VPP linux-cp pair
+	// from TAP_TO_VPP (both are tuntap links)
+	linuxCpAliasPrefix = "linuxcp:"
 )
 
 // retrievedIfaces is used as the return value sent via channel by retrieveInterfaces().
@@ -198,6 +202,27 @@ func ParseTapAlias(alias string) (linuxTapName, vppTapName, origHostIfName strin
 	return
 }
 
+// GetLinuxCpAlias returns alias for the host side of VPP linux-cp pair
+// configured by the agent. The alias stores the LINUX_CP logical name together
+// with the logical name of the paired VPP interface.
+func GetLinuxCpAlias(linuxIf *interfaces.Interface) string {
+	return linuxCpAliasPrefix + linuxIf.Name + "/" + linuxIf.GetLinuxCp().GetVppIfName()
+}
+
+// ParseLinuxCpAlias parses out LINUX_CP logical name together with the name
+// of the paired VPP interface (which may contain slashes).
+func ParseLinuxCpAlias(alias string) (linuxIfName, vppIfName string, isLinuxCpAlias bool) {
+	if !strings.HasPrefix(alias, linuxCpAliasPrefix) {
+		return "", "", false
+	}
+	aliasParts := strings.SplitN(strings.TrimPrefix(alias, linuxCpAliasPrefix), "/", 2)
+	linuxIfName = aliasParts[0]
+	if len(aliasParts) > 1 {
+		vppIfName = aliasParts[1]
+	}
+	return linuxIfName, vppIfName, true
+}
+
 // GetDummyIfAlias returns alias for Linux Dummy interface managed by the agent.
 func GetDummyIfAlias(linuxIf *interfaces.Interface) string {
 	return linuxIf.Name
@@ -220,6 +245,39 @@ func ParseVRFAlias(alias string) (vrfName string) {
 	return alias
 }
 
+// GetBridgeAlias returns alias for Linux bridge managed by the agent.
+func GetBridgeAlias(linuxIf *interfaces.Interface) string {
+	return linuxIf.Name
+}
+
+// ParseBridgeAlias parses out logical name of a bridge from the alias.
+// Currently there are no other logical information stored in the alias so it is very straightforward.
+func ParseBridgeAlias(alias string) (bridgeName string) {
+	return alias
+}
+
+// GetParentIfAlias returns alias for Linux interface created on top of a parent
+// interface (VLAN, MACVLAN, IPVLAN, VXLAN) and managed by the agent.
+// The alias stores the logical name together with the parent (logical) name.
+// The parent may be in a different namespace, therefore it cannot be learned
+// from the link attributes.
+func GetParentIfAlias(ifName, parentName string) string {
+	if parentName == "" {
+		return ifName
+	}
+	return ifName + "/" + parentName
+}
+
+// ParseParentIfAlias parses out logical name together with the parent name from the alias.
+func ParseParentIfAlias(alias string) (ifName, parentName string) {
+	aliasParts := strings.Split(alias, "/")
+	ifName = aliasParts[0]
+	if len(aliasParts) > 1 {
+		parentName = aliasParts[1]
+	}
+	return
+}
+
 // retrieveInterfaces is run by a separate go routine to retrieve all interfaces
 // present in every <goRoutineIdx>-th network namespace from the list.
 func (h *NetLinkHandler) retrieveInterfaces(nsList []*namespaces.NetNamespace, goRoutineIdx, goRoutinesCnt int, ch chan<- retrievedInterfaces) {
@@ -247,7 +305,8 @@ func (h *NetLinkHandler) retrieveInterfaces(nsList []*namespaces.NetNamespace, g
 
 		// retrieve every interface managed by this agent
 		var ifaces []*InterfaceDetails
-		vrfDevs := make(map[int]string) // vrf index -> vrf name
+		vrfDevs := make(map[int]string)                 // vrf index -> vrf name
+		bridges := make(map[int]*interfaces.BridgeLink) // bridge index -> bridge
 		for _, link := range links {
 			iface := &interfaces.Interface{
 				Namespace:   nsRef,
@@ -276,6 +335,14 @@ func (h *NetLinkHandler) retrieveInterfaces(nsList []*namespaces.NetNamespace, g
 			} else if link.Type() == "dummy" {
 				iface.Type = interfaces.Interface_DUMMY
 				iface.Name = ParseDummyIfAlias(alias)
+			} else if linuxIfName, vppIfName, isLinuxCp := ParseLinuxCpAlias(alias); isLinuxCp {
+				iface.Type = interfaces.Interface_LINUX_CP
+				iface.Name = linuxIfName
+				iface.Link = &interfaces.Interface_LinuxCp{
+					LinuxCp: &interfaces.LinuxCpLink{
+						VppIfName: vppIfName,
+					},
+				}
 			} else if link.Type() == "tuntap" || link.Type() == "tun" /* not defined in vishvananda */ {
 				iface.Type = interfaces.Interface_TAP_TO_VPP
 				var vppTapIfName string
@@ -302,6 +369,97 @@ func (h *NetLinkHandler) retrieveInterfaces(nsList []*namespaces.NetNamespace, g
 					},
 				}
 				vrfDevs[link.Attrs().Index] = iface.Name
+			} else if link.Type() == "bridge" {
+				bridge := &interfaces.BridgeLink{}
+				if bridgeDev, isBridge := link.(*netlink.Bridge); isBridge && bridgeDev.VlanFiltering != nil {
+					bridge.VlanFiltering = *bridgeDev.VlanFiltering
+				}
+				iface.Type = interfaces.Interface_BRIDGE
+				iface.Name = ParseBridgeAlias(alias)
+				iface.Link = &interfaces.Interface_Bridge{Bridge: bridge}
+				bridges[link.Attrs().Index] = bridge
+			} else if link.Type() == "vlan" {
+				vlanDev, isVlan := link.(*netlink.Vlan)
+				if !isVlan {
+					h.log.WithFields(logging.Fields{
+						"if-host-name": link.Attrs().Name,
+						"namespace":    nsRef,
+					}).Warnf("Unable to retrieve VLAN-specific attributes")
+					continue
+				}
+				var parentIfName string
+				iface.Type = interfaces.Interface_VLAN
+				iface.Name, parentIfName = ParseParentIfAlias(alias)
+				iface.Link = &interfaces.Interface_Vlan{
+					Vlan: &interfaces.VlanLink{
+						ParentIfName: parentIfName,
+						VlanId:       uint32(vlanDev.VlanId),
+						Protocol:     fromNetlinkVlanProtocol(vlanDev.VlanProtocol),
+					},
+				}
+			} else if link.Type() == "macvlan" {
+				macvlanDev, isMacvlan := link.(*netlink.Macvlan)
+				if !isMacvlan {
+					h.log.WithFields(logging.Fields{
+						"if-host-name": link.Attrs().Name,
+						"namespace":    nsRef,
+					}).Warnf("Unable to retrieve MACVLAN-specific attributes")
+					continue
+				}
+				var parentIfName string
+				iface.Type = interfaces.Interface_MACVLAN
+				iface.Name, parentIfName = ParseParentIfAlias(alias)
+				iface.Link = &interfaces.Interface_Macvlan{
+					Macvlan: &interfaces.MacvlanLink{
+						ParentIfName: parentIfName,
+						Mode:         fromNetlinkMacvlanMode(macvlanDev.Mode),
+					},
+				}
+			} else if link.Type() == "ipvlan" {
+				ipvlanDev, isIPvlan := link.(*netlink.IPVlan)
+				if !isIPvlan {
+					h.log.WithFields(logging.Fields{
+						"if-host-name": link.Attrs().Name,
+						"namespace":    nsRef,
+					}).Warnf("Unable to retrieve IPVLAN-specific attributes")
+					continue
+				}
+				var parentIfName string
+				iface.Type = interfaces.Interface_IPVLAN
+				iface.Name, parentIfName = ParseParentIfAlias(alias)
+				iface.Link = &interfaces.Interface_Ipvlan{
+					Ipvlan: &interfaces.IpvlanLink{
+						ParentIfName: parentIfName,
+						Mode:         interfaces.IpvlanLink_Mode(ipvlanDev.Mode),
+						Flag:         interfaces.IpvlanLink_Flag(ipvlanDev.Flag),
+					},
+				}
+			} else if link.Type() == "vxlan" {
+				vxlanDev, isVxlan := link.(*netlink.Vxlan)
+				if !isVxlan {
+					h.log.WithFields(logging.Fields{
+						"if-host-name": link.Attrs().Name,
+						"namespace":    nsRef,
+					}).Warnf("Unable to retrieve VXLAN-specific attributes")
+					continue
+				}
+				var parentIfName string
+				iface.Type = interfaces.Interface_VXLAN
+				iface.Name, parentIfName = ParseParentIfAlias(alias)
+				vxlan := &interfaces.VxlanLink{
+					Vni:          uint32(vxlanDev.VxlanId),
+					ParentIfName: parentIfName,
+					DstPort:      uint32(vxlanDev.Port),
+					Ttl:          uint32(vxlanDev.TTL),
+					Learning:     vxlanDev.Learning,
+				}
+				if vxlanDev.SrcAddr != nil {
+					vxlan.SrcAddress = vxlanDev.SrcAddr.String()
+				}
+				if vxlanDev.Group != nil {
+					vxlan.DstAddress = vxlanDev.Group.String()
+				}
+				iface.Link = &interfaces.Interface_Vxlan{Vxlan: vxlan}
 			} else if link.Attrs().Name == DefaultLoopbackName {
 				iface.Type = interfaces.Interface_LOOPBACK
 				iface.Name = alias
@@ -354,11 +512,14 @@ func (h *NetLinkHandler) retrieveInterfaces(nsList []*namespaces.NetNamespace, g
 			})
 		}
 
-		// fill VRF names
+		// fill VRF names and bridge ports
 		for _, iface := range ifaces {
 			if vrfDev, inVrf := vrfDevs[iface.Meta.MasterIndex]; inVrf {
 				iface.Interface.VrfMasterInterface = vrfDev
 			}
+			if bridge, inBridge := bridges[iface.Meta.MasterIndex]; inBridge {
+				bridge.Ports = append(bridge.Ports, iface.Interface.Name)
+			}
 		}
 		retrieved.interfaces = append(retrieved.interfaces, ifaces...)
 
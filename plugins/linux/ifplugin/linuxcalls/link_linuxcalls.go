@@ -22,8 +22,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/vishvananda/netlink"
 	"github.com/vishvananda/netns"
+
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 )
 
+// DefaultVxlanPort is the IANA-assigned UDP port used by VXLAN interfaces
+// if the port is not specified.
+const DefaultVxlanPort = 4789
+
 // GetLinkByName calls netlink API to get Link type from interface name
 func (h *NetLinkHandler) GetLinkByName(ifName string) (netlink.Link, error) {
 	link, err := h.LinkByName(ifName)
@@ -299,6 +305,178 @@ func (h *NetLinkHandler) RemoveInterfaceFromVRF(ifName, vrfDevName string) error
 	return nil
 }
 
+// AddBridge configures new Linux bridge.
+func (h *NetLinkHandler) AddBridge(bridgeName string, bridge *interfaces.BridgeLink) error {
+	vlanFiltering := bridge.GetVlanFiltering()
+	link := &netlink.Bridge{
+		LinkAttrs:     newLinkAttrs(bridgeName),
+		VlanFiltering: &vlanFiltering,
+	}
+	if err := h.LinkAdd(link); err != nil {
+		return errors.Wrapf(err, "LinkAdd (bridge=%s)", bridgeName)
+	}
+	return nil
+}
+
+// PutInterfaceIntoBridge enslaves Linux interface to a given bridge.
+func (h *NetLinkHandler) PutInterfaceIntoBridge(ifName, bridgeName string) error {
+	ifLink, err := h.GetLinkByName(ifName)
+	if err != nil {
+		return err
+	}
+	bridgeLink, err := h.GetLinkByName(bridgeName)
+	if err != nil {
+		return err
+	}
+	if err := h.LinkSetMasterByIndex(ifLink, bridgeLink.Attrs().Index); err != nil {
+		return errors.Wrapf(err, "LinkSetMasterByIndex (interface=%s, bridge=%s, bridge-index=%d)",
+			ifName, bridgeName, bridgeLink.Attrs().Index)
+	}
+	return nil
+}
+
+// RemoveInterfaceFromBridge releases Linux interface from a given bridge.
+func (h *NetLinkHandler) RemoveInterfaceFromBridge(ifName, bridgeName string) error {
+	ifLink, err := h.GetLinkByName(ifName)
+	if err != nil {
+		return err
+	}
+	if err := h.LinkSetNoMaster(ifLink); err != nil {
+		return errors.Wrapf(err, "LinkSetNoMaster (interface=%s, bridge=%s)",
+			ifName, bridgeName)
+	}
+	return nil
+}
+
+// AddVLANInterface configures VLAN sub-interface on top of the parent interface.
+func (h *NetLinkHandler) AddVLANInterface(ifName, parentIfName string, vlan *interfaces.VlanLink) error {
+	attrs, err := h.newChildLinkAttrs(ifName, parentIfName)
+	if err != nil {
+		return err
+	}
+	link := &netlink.Vlan{
+		LinkAttrs:    attrs,
+		VlanId:       int(vlan.GetVlanId()),
+		VlanProtocol: toNetlinkVlanProtocol(vlan.GetProtocol()),
+	}
+	if err := h.LinkAdd(link); err != nil {
+		return errors.Wrapf(err, "LinkAdd (vlan=%s, parent=%s, vlan-id=%d)",
+			ifName, parentIfName, vlan.GetVlanId())
+	}
+	return nil
+}
+
+// AddMacvlanInterface configures MACVLAN interface on top of the parent interface.
+func (h *NetLinkHandler) AddMacvlanInterface(ifName, parentIfName string, macvlan *interfaces.MacvlanLink) error {
+	attrs, err := h.newChildLinkAttrs(ifName, parentIfName)
+	if err != nil {
+		return err
+	}
+	link := &netlink.Macvlan{
+		LinkAttrs: attrs,
+		Mode:      toNetlinkMacvlanMode(macvlan.GetMode()),
+	}
+	if err := h.LinkAdd(link); err != nil {
+		return errors.Wrapf(err, "LinkAdd (macvlan=%s, parent=%s, mode=%v)",
+			ifName, parentIfName, macvlan.GetMode())
+	}
+	return nil
+}
+
+// AddIPvlanInterface configures IPVLAN interface on top of the parent interface.
+func (h *NetLinkHandler) AddIPvlanInterface(ifName, parentIfName string, ipvlan *interfaces.IpvlanLink) error {
+	attrs, err := h.newChildLinkAttrs(ifName, parentIfName)
+	if err != nil {
+		return err
+	}
+	link := &netlink.IPVlan{
+		LinkAttrs: attrs,
+		Mode:      netlink.IPVlanMode(ipvlan.GetMode()),
+		Flag:      netlink.IPVlanFlag(ipvlan.GetFlag()),
+	}
+	if err := h.LinkAdd(link); err != nil {
+		return errors.Wrapf(err, "LinkAdd (ipvlan=%s, parent=%s, mode=%v, flag=%v)",
+			ifName, parentIfName, ipvlan.GetMode(), ipvlan.GetFlag())
+	}
+	return nil
+}
+
+// AddVXLANInterface configures VXLAN interface, <parentIfName> is optional.
+func (h *NetLinkHandler) AddVXLANInterface(ifName, parentIfName string, vxlan *interfaces.VxlanLink) error {
+	link := &netlink.Vxlan{
+		LinkAttrs: newLinkAttrs(ifName),
+		VxlanId:   int(vxlan.GetVni()),
+		SrcAddr:   net.ParseIP(vxlan.GetSrcAddress()),
+		Group:     net.ParseIP(vxlan.GetDstAddress()),
+		TTL:       int(vxlan.GetTtl()),
+		Learning:  vxlan.GetLearning(),
+		Port:      int(vxlan.GetDstPort()),
+	}
+	if link.Port == 0 {
+		link.Port = DefaultVxlanPort
+	}
+	if parentIfName != "" {
+		parent, err := h.GetLinkByName(parentIfName)
+		if err != nil {
+			return err
+		}
+		link.VtepDevIndex = parent.Attrs().Index
+	}
+	if err := h.LinkAdd(link); err != nil {
+		return errors.Wrapf(err, "LinkAdd (vxlan=%s, vni=%d)", ifName, vxlan.GetVni())
+	}
+	return nil
+}
+
+// newChildLinkAttrs returns attributes for a new link on top of the parent interface.
+func (h *NetLinkHandler) newChildLinkAttrs(ifName, parentIfName string) (netlink.LinkAttrs, error) {
+	attrs := newLinkAttrs(ifName)
+	parent, err := h.GetLinkByName(parentIfName)
+	if err != nil {
+		return attrs, err
+	}
+	attrs.ParentIndex = parent.Attrs().Index
+	return attrs, nil
+}
+
+func toNetlinkVlanProtocol(protocol interfaces.VlanLink_Protocol) netlink.VlanProtocol {
+	if protocol == interfaces.VlanLink_DOT1AD {
+		return netlink.VLAN_PROTOCOL_8021AD
+	}
+	return netlink.VLAN_PROTOCOL_8021Q
+}
+
+func fromNetlinkVlanProtocol(protocol netlink.VlanProtocol) interfaces.VlanLink_Protocol {
+	if protocol == netlink.VLAN_PROTOCOL_8021AD {
+		return interfaces.VlanLink_DOT1AD
+	}
+	return interfaces.VlanLink_DOT1Q
+}
+
+func toNetlinkMacvlanMode(mode interfaces.MacvlanLink_Mode) netlink.MacvlanMode {
+	switch mode {
+	case interfaces.MacvlanLink_PRIVATE:
+		return netlink.MACVLAN_MODE_PRIVATE
+	case interfaces.MacvlanLink_VEPA:
+		return netlink.MACVLAN_MODE_VEPA
+	case interfaces.MacvlanLink_PASSTHRU:
+		return netlink.MACVLAN_MODE_PASSTHRU
+	}
+	return netlink.MACVLAN_MODE_BRIDGE
+}
+
+func fromNetlinkMacvlanMode(mode netlink.MacvlanMode) interfaces.MacvlanLink_Mode {
+	switch mode {
+	case netlink.MACVLAN_MODE_PRIVATE:
+		return interfaces.MacvlanLink_PRIVATE
+	case netlink.MACVLAN_MODE_VEPA:
+		return interfaces.MacvlanLink_VEPA
+	case netlink.MACVLAN_MODE_PASSTHRU:
+		return interfaces.MacvlanLink_PASSTHRU
+	}
+	return interfaces.MacvlanLink_BRIDGE
+}
+
 func isLinkUp(link netlink.Link) bool {
 	return (link.Attrs().Flags & net.FlagUp) == net.FlagUp
 }
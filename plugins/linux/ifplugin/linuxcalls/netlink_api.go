@@ -80,6 +80,20 @@ type NetlinkAPI interface {
 	PutInterfaceIntoVRF(ifName, vrfDevName string) error
 	// RemoveInterfaceFromVRF un-assigns Linux interface from a given VRF.
 	RemoveInterfaceFromVRF(ifName, vrfDevName string) error
+	// AddBridge configures new Linux bridge.
+	AddBridge(bridgeName string, bridge *interfaces.BridgeLink) error
+	// PutInterfaceIntoBridge enslaves Linux interface to a given bridge.
+	PutInterfaceIntoBridge(ifName, bridgeName string) error
+	// RemoveInterfaceFromBridge releases Linux interface from a given bridge.
+	RemoveInterfaceFromBridge(ifName, bridgeName string) error
+	// AddVLANInterface configures VLAN sub-interface on top of the parent interface.
+	AddVLANInterface(ifName, parentIfName string, vlan *interfaces.VlanLink) error
+	// AddMacvlanInterface configures MACVLAN interface on top of the parent interface.
+	AddMacvlanInterface(ifName, parentIfName string, macvlan *interfaces.MacvlanLink) error
+	// AddIPvlanInterface configures IPVLAN interface on top of the parent interface.
+	AddIPvlanInterface(ifName, parentIfName string, ipvlan *interfaces.IpvlanLink) error
+	// AddVXLANInterface configures VXLAN interface, <parentIfName> is optional.
+	AddVXLANInterface(ifName, parentIfName string, vxlan *interfaces.VxlanLink) error
 	// DeleteInterface removes the given interface.
 	DeleteInterface(ifName string) error
 	// SetInterfaceUp sets interface state to 'up'
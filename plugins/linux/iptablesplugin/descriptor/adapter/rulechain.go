@@ -17,6 +17,17 @@ type RuleChainKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type RuleChainBatchOp struct {
+	Key         string
+	Value       *linux_iptables.RuleChain
+	PrevValue   *linux_iptables.RuleChain
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type RuleChainDescriptor struct {
@@ -33,11 +44,15 @@ type RuleChainDescriptor struct {
 	Delete               func(key string, value *linux_iptables.RuleChain, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *linux_iptables.RuleChain, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *linux_iptables.RuleChain, metadata interface{}) bool
+	CreateBatch          func(ops []*RuleChainBatchOp) error
+	UpdateBatch          func(ops []*RuleChainBatchOp) error
+	DeleteBatch          func(ops []*RuleChainBatchOp) error
 	Retrieve             func(correlate []RuleChainKVWithMetadata) ([]RuleChainKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *linux_iptables.RuleChain) []KeyValuePair
 	Dependencies         func(key string, value *linux_iptables.RuleChain) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewRuleChainDescriptor(typedDescriptor *RuleChainDescriptor) *KVDescriptor
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewRuleChainDescriptor(typedDescriptor *RuleChainDescriptor) *KVDescriptor
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *RuleChainDescriptorAdapter) UpdateWithRecreate(key string, oldValue, n
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *RuleChainDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castRuleChainBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyRuleChainBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *RuleChainDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castRuleChainBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyRuleChainBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *RuleChainDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castRuleChainBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyRuleChainBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *RuleChainDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []RuleChainKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castRuleChainValue(key string, value proto.Message) (*linux_iptables.RuleCh
 	return typedValue, nil
 }
 
+func castRuleChainBatchOps(ops []*BatchOp, withPrevValue bool) []*RuleChainBatchOp {
+	var typedOps []*RuleChainBatchOp
+	for _, op := range ops {
+		typedValue, err := castRuleChainValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *linux_iptables.RuleChain
+		if withPrevValue {
+			typedPrevValue, err = castRuleChainValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castRuleChainMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &RuleChainBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyRuleChainBatchResults(ops []*BatchOp, typedOps []*RuleChainBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castRuleChainMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -0,0 +1,303 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
+)
+
+// A list of non-retriable errors for structured rules:
+var (
+	// ErrAddressNotMatchingProtocol is returned when an IP address does not match the protocol of the rule chain.
+	ErrAddressNotMatchingProtocol = errors.New("IP address does not match protocol of the rule chain")
+
+	// ErrPortsWithoutProtocol is returned when ports are used without TCP, UDP or SCTP protocol.
+	ErrPortsWithoutProtocol = errors.New("ports can be used only with TCP, UDP or SCTP protocol")
+
+	// ErrICMPNotMatchingProtocol is returned when ICMP version does not match the protocol of the rule chain.
+	ErrICMPNotMatchingProtocol = errors.New("ICMP version does not match protocol of the rule chain")
+
+	// ErrInvalidPortRange is returned when the upper port of a range is lower than the lower port.
+	ErrInvalidPortRange = errors.New("upper port is lower than lower port")
+
+	// ErrInvalidText is returned when a comment or a target parameter contains forbidden characters.
+	ErrInvalidText = errors.New("text cannot contain double quotes or line breaks")
+
+	// ErrJumpWithoutChain is returned when the chain is not defined for the JUMP action.
+	ErrJumpWithoutChain = errors.New("chain must be defined for JUMP action")
+
+	// ErrNATActionOutsideNATTable is returned when a NAT action is used outside of the NAT table.
+	ErrNATActionOutsideNATTable = errors.New("NAT actions can be used only in NAT table")
+
+	// ErrNATWithoutAddress is returned when the translated address is not defined for SNAT or DNAT action.
+	ErrNATWithoutAddress = errors.New("translated address must be defined for SNAT and DNAT actions")
+)
+
+// conntrack states in the order used by iptables-save
+var connStateOrder = []linux_iptables.Rule_ConnState{
+	linux_iptables.Rule_INVALID,
+	linux_iptables.Rule_NEW,
+	linux_iptables.Rule_RELATED,
+	linux_iptables.Rule_ESTABLISHED,
+	linux_iptables.Rule_UNTRACKED,
+}
+
+// validateRule validates the structured rule of the rule chain.
+func validateRule(rch *linux_iptables.RuleChain, rule *linux_iptables.Rule) error {
+	for _, addr := range []struct {
+		value string
+		field string
+	}{{rule.Source, "structured_rules.source"}, {rule.Destination, "structured_rules.destination"}} {
+		if addr.value == "" {
+			continue
+		}
+		prefix, err := parseNetwork(addr.value)
+		if err != nil {
+			return kvs.NewInvalidValueError(err, addr.field)
+		}
+		if prefix.Addr().Is4() != (rch.Protocol == linux_iptables.RuleChain_IPV4) {
+			return kvs.NewInvalidValueError(ErrAddressNotMatchingProtocol, addr.field)
+		}
+	}
+
+	switch rule.Protocol {
+	case linux_iptables.Rule_ICMP:
+		if rch.Protocol != linux_iptables.RuleChain_IPV4 {
+			return kvs.NewInvalidValueError(ErrICMPNotMatchingProtocol, "structured_rules.protocol")
+		}
+	case linux_iptables.Rule_ICMPV6:
+		if rch.Protocol != linux_iptables.RuleChain_IPV6 {
+			return kvs.NewInvalidValueError(ErrICMPNotMatchingProtocol, "structured_rules.protocol")
+		}
+	}
+
+	withPorts := hasPorts(rule.Protocol)
+	for _, ports := range []struct {
+		value *linux_iptables.Rule_PortRange
+		field string
+	}{
+		{rule.SourcePorts, "structured_rules.source_ports"},
+		{rule.DestinationPorts, "structured_rules.destination_ports"},
+		{rule.GetTarget().GetToPorts(), "structured_rules.target.to_ports"},
+	} {
+		if ports.value == nil {
+			continue
+		}
+		if !withPorts {
+			return kvs.NewInvalidValueError(ErrPortsWithoutProtocol, ports.field)
+		}
+		if ports.value.UpperPort != 0 && ports.value.UpperPort < ports.value.LowerPort {
+			return kvs.NewInvalidValueError(ErrInvalidPortRange, ports.field)
+		}
+	}
+
+	if !isValidText(rule.Comment) {
+		return kvs.NewInvalidValueError(ErrInvalidText, "structured_rules.comment")
+	}
+
+	target := rule.Target
+	if target == nil {
+		return nil
+	}
+	if !isValidText(target.LogPrefix) {
+		return kvs.NewInvalidValueError(ErrInvalidText, "structured_rules.target.log_prefix")
+	}
+	if strings.ContainsAny(target.RejectWith, " \t") {
+		return kvs.NewInvalidValueError(ErrInvalidText, "structured_rules.target.reject_with")
+	}
+	switch target.Action {
+	case linux_iptables.Rule_Target_JUMP:
+		if target.Chain == "" {
+			return kvs.NewInvalidValueError(ErrJumpWithoutChain, "structured_rules.target.chain")
+		}
+	case linux_iptables.Rule_Target_MASQUERADE, linux_iptables.Rule_Target_REDIRECT,
+		linux_iptables.Rule_Target_SNAT, linux_iptables.Rule_Target_DNAT:
+		if rch.Table != linux_iptables.RuleChain_NAT {
+			return kvs.NewInvalidValueError(ErrNATActionOutsideNATTable, "structured_rules.target.action")
+		}
+		if target.Action != linux_iptables.Rule_Target_SNAT && target.Action != linux_iptables.Rule_Target_DNAT {
+			break
+		}
+		if target.ToAddress == "" {
+			return kvs.NewInvalidValueError(ErrNATWithoutAddress, "structured_rules.target.to_address")
+		}
+		addr, err := netip.ParseAddr(target.ToAddress)
+		if err != nil {
+			return kvs.NewInvalidValueError(err, "structured_rules.target.to_address")
+		}
+		if addr.Is4() != (rch.Protocol == linux_iptables.RuleChain_IPV4) {
+			return kvs.NewInvalidValueError(ErrAddressNotMatchingProtocol, "structured_rules.target.to_address")
+		}
+	}
+	return nil
+}
+
+// renderRule renders the structured rule in the format used by iptables-save.
+// Interfaces are referred by host names returned by <hostIfName>.
+func renderRule(rule *linux_iptables.Rule, hostIfName func(ifName string) string) string {
+	var args []string
+
+	if rule.Source != "" {
+		args = append(args, "-s", renderNetwork(rule.Source))
+	}
+	if rule.Destination != "" {
+		args = append(args, "-d", renderNetwork(rule.Destination))
+	}
+	if rule.InInterface != "" {
+		args = append(args, "-i", hostIfName(rule.InInterface))
+	}
+	if rule.OutInterface != "" {
+		args = append(args, "-o", hostIfName(rule.OutInterface))
+	}
+	if rule.Protocol != linux_iptables.Rule_ANY {
+		args = append(args, "-p", protocolName(rule.Protocol))
+	}
+	if rule.SourcePorts != nil || rule.DestinationPorts != nil {
+		args = append(args, "-m", protocolName(rule.Protocol))
+		if rule.SourcePorts != nil {
+			args = append(args, "--sport", renderPorts(rule.SourcePorts, ":"))
+		}
+		if rule.DestinationPorts != nil {
+			args = append(args, "--dport", renderPorts(rule.DestinationPorts, ":"))
+		}
+	}
+	if len(rule.ConnStates) > 0 {
+		var states []string
+		for _, state := range connStateOrder {
+			for _, s := range rule.ConnStates {
+				if s == state {
+					states = append(states, state.String())
+					break
+				}
+			}
+		}
+		args = append(args, "-m", "conntrack", "--ctstate", strings.Join(states, ","))
+	}
+	if rule.Comment != "" {
+		args = append(args, "-m", "comment", "--comment", quote(rule.Comment))
+	}
+	args = append(args, renderTarget(rule.Target)...)
+
+	return strings.Join(args, " ")
+}
+
+// renderTarget renders the target part of the rule.
+func renderTarget(target *linux_iptables.Rule_Target) []string {
+	if target == nil {
+		return nil
+	}
+	switch target.Action {
+	case linux_iptables.Rule_Target_JUMP:
+		return []string{"-j", target.Chain}
+	case linux_iptables.Rule_Target_REJECT:
+		args := []string{"-j", "REJECT"}
+		if target.RejectWith != "" {
+			args = append(args, "--reject-with", target.RejectWith)
+		}
+		return args
+	case linux_iptables.Rule_Target_LOG:
+		args := []string{"-j", "LOG"}
+		if target.LogPrefix != "" {
+			args = append(args, "--log-prefix", quote(target.LogPrefix))
+		}
+		return args
+	case linux_iptables.Rule_Target_MASQUERADE, linux_iptables.Rule_Target_REDIRECT:
+		args := []string{"-j", target.Action.String()}
+		if target.ToPorts != nil {
+			args = append(args, "--to-ports", renderPorts(target.ToPorts, "-"))
+		}
+		return args
+	case linux_iptables.Rule_Target_SNAT, linux_iptables.Rule_Target_DNAT:
+		opt := "--to-source"
+		if target.Action == linux_iptables.Rule_Target_DNAT {
+			opt = "--to-destination"
+		}
+		to := target.ToAddress
+		if target.ToPorts != nil {
+			if strings.Contains(to, ":") {
+				to = "[" + to + "]"
+			}
+			to += ":" + renderPorts(target.ToPorts, "-")
+		}
+		return []string{"-j", target.Action.String(), opt, to}
+	default:
+		return []string{"-j", target.Action.String()}
+	}
+}
+
+// renderNetwork renders IP address or network in CIDR notation with the prefix length.
+func renderNetwork(network string) string {
+	prefix, err := parseNetwork(network)
+	if err != nil {
+		return network
+	}
+	return prefix.String()
+}
+
+// renderPorts renders the port range with the given separator.
+func renderPorts(ports *linux_iptables.Rule_PortRange, sep string) string {
+	if ports.UpperPort == 0 || ports.UpperPort == ports.LowerPort {
+		return fmt.Sprint(ports.LowerPort)
+	}
+	return fmt.Sprintf("%d%s%d", ports.LowerPort, sep, ports.UpperPort)
+}
+
+// parseNetwork parses IP address or network in CIDR notation.
+func parseNetwork(network string) (netip.Prefix, error) {
+	if !strings.Contains(network, "/") {
+		addr, err := netip.ParseAddr(network)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(network)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
+
+// protocolName returns the protocol name as printed by iptables-save.
+func protocolName(protocol linux_iptables.Rule_Protocol) string {
+	if protocol == linux_iptables.Rule_ICMPV6 {
+		return "ipv6-icmp"
+	}
+	return strings.ToLower(protocol.String())
+}
+
+// hasPorts returns true for protocols with ports.
+func hasPorts(protocol linux_iptables.Rule_Protocol) bool {
+	return protocol == linux_iptables.Rule_TCP ||
+		protocol == linux_iptables.Rule_UDP ||
+		protocol == linux_iptables.Rule_SCTP
+}
+
+// quote encloses the text in double quotes.
+func quote(text string) string {
+	return "\"" + text + "\""
+}
+
+// isValidText checks that text can be quoted in the rule.
+func isValidText(text string) bool {
+	return !strings.ContainsAny(text, "\"\n\r")
+}
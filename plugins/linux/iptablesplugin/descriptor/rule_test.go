@@ -0,0 +1,267 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
+)
+
+func TestRenderRule(t *testing.T) {
+	hostIfName := func(ifName string) string {
+		return "host-" + ifName
+	}
+
+	tests := []struct {
+		name     string
+		rule     *linux_iptables.Rule
+		expected string
+	}{
+		{
+			name:     "empty rule",
+			rule:     &linux_iptables.Rule{},
+			expected: "",
+		},
+		{
+			name: "addresses and interfaces",
+			rule: &linux_iptables.Rule{
+				Source:       "10.0.0.1",
+				Destination:  "192.168.1.5/24",
+				InInterface:  "if1",
+				OutInterface: "if2",
+				Target:       &linux_iptables.Rule_Target{Action: linux_iptables.Rule_Target_DROP},
+			},
+			expected: "-s 10.0.0.1/32 -d 192.168.1.0/24 -i host-if1 -o host-if2 -j DROP",
+		},
+		{
+			name: "ports and conntrack states",
+			rule: &linux_iptables.Rule{
+				Protocol:         linux_iptables.Rule_TCP,
+				SourcePorts:      &linux_iptables.Rule_PortRange{LowerPort: 1024, UpperPort: 2048},
+				DestinationPorts: &linux_iptables.Rule_PortRange{LowerPort: 80, UpperPort: 80},
+				ConnStates:       []linux_iptables.Rule_ConnState{linux_iptables.Rule_ESTABLISHED, linux_iptables.Rule_NEW},
+				Target:           &linux_iptables.Rule_Target{},
+			},
+			expected: "-p tcp -m tcp --sport 1024:2048 --dport 80 -m conntrack --ctstate NEW,ESTABLISHED -j ACCEPT",
+		},
+		{
+			name: "comment",
+			rule: &linux_iptables.Rule{
+				Protocol: linux_iptables.Rule_ICMPV6,
+				Comment:  "allow ping",
+				Target:   &linux_iptables.Rule_Target{Action: linux_iptables.Rule_Target_JUMP, Chain: "PING"},
+			},
+			expected: `-p ipv6-icmp -m comment --comment "allow ping" -j PING`,
+		},
+		{
+			name: "reject",
+			rule: &linux_iptables.Rule{
+				Target: &linux_iptables.Rule_Target{
+					Action:     linux_iptables.Rule_Target_REJECT,
+					RejectWith: "icmp-port-unreachable",
+				},
+			},
+			expected: "-j REJECT --reject-with icmp-port-unreachable",
+		},
+		{
+			name: "log",
+			rule: &linux_iptables.Rule{
+				Target: &linux_iptables.Rule_Target{Action: linux_iptables.Rule_Target_LOG, LogPrefix: "dropped: "},
+			},
+			expected: `-j LOG --log-prefix "dropped: "`,
+		},
+		{
+			name: "redirect",
+			rule: &linux_iptables.Rule{
+				Protocol: linux_iptables.Rule_UDP,
+				Target: &linux_iptables.Rule_Target{
+					Action:  linux_iptables.Rule_Target_REDIRECT,
+					ToPorts: &linux_iptables.Rule_PortRange{LowerPort: 5000, UpperPort: 5010},
+				},
+			},
+			expected: "-p udp -j REDIRECT --to-ports 5000-5010",
+		},
+		{
+			name: "IPv6 DNAT with port",
+			rule: &linux_iptables.Rule{
+				Protocol: linux_iptables.Rule_TCP,
+				Target: &linux_iptables.Rule_Target{
+					Action:    linux_iptables.Rule_Target_DNAT,
+					ToAddress: "fd00::1",
+					ToPorts:   &linux_iptables.Rule_PortRange{LowerPort: 8080},
+				},
+			},
+			expected: "-p tcp -j DNAT --to-destination [fd00::1]:8080",
+		},
+		{
+			name: "SNAT",
+			rule: &linux_iptables.Rule{
+				Target: &linux_iptables.Rule_Target{Action: linux_iptables.Rule_Target_SNAT, ToAddress: "10.0.0.1"},
+			},
+			expected: "-j SNAT --to-source 10.0.0.1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(renderRule(test.rule, hostIfName)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestValidateRule(t *testing.T) {
+	ipv4Filter := &linux_iptables.RuleChain{Protocol: linux_iptables.RuleChain_IPV4}
+	ipv6Filter := &linux_iptables.RuleChain{Protocol: linux_iptables.RuleChain_IPV6}
+	ipv4NAT := &linux_iptables.RuleChain{Protocol: linux_iptables.RuleChain_IPV4, Table: linux_iptables.RuleChain_NAT}
+
+	tests := []struct {
+		name        string
+		rch         *linux_iptables.RuleChain
+		rule        *linux_iptables.Rule
+		expectedErr error // nil if valid
+		invalid     bool  // for errors other than the predefined ones
+	}{
+		{
+			name: "valid rule",
+			rch:  ipv4Filter,
+			rule: &linux_iptables.Rule{
+				Source:           "10.0.0.0/8",
+				Protocol:         linux_iptables.Rule_TCP,
+				DestinationPorts: &linux_iptables.Rule_PortRange{LowerPort: 80, UpperPort: 90},
+				Comment:          "allow web",
+				Target:           &linux_iptables.Rule_Target{Action: linux_iptables.Rule_Target_ACCEPT},
+			},
+		},
+		{
+			name: "valid NAT rule",
+			rch:  ipv4NAT,
+			rule: &linux_iptables.Rule{
+				Protocol: linux_iptables.Rule_UDP,
+				Target: &linux_iptables.Rule_Target{
+					Action:    linux_iptables.Rule_Target_DNAT,
+					ToAddress: "10.0.0.1",
+					ToPorts:   &linux_iptables.Rule_PortRange{LowerPort: 53},
+				},
+			},
+		},
+		{
+			name:    "invalid address",
+			rch:     ipv4Filter,
+			rule:    &linux_iptables.Rule{Source: "10.0.0.300"},
+			invalid: true,
+		},
+		{
+			name:        "IPv6 address in IPv4 chain",
+			rch:         ipv4Filter,
+			rule:        &linux_iptables.Rule{Destination: "fd00::/64"},
+			expectedErr: ErrAddressNotMatchingProtocol,
+		},
+		{
+			name:        "ICMP in IPv6 chain",
+			rch:         ipv6Filter,
+			rule:        &linux_iptables.Rule{Protocol: linux_iptables.Rule_ICMP},
+			expectedErr: ErrICMPNotMatchingProtocol,
+		},
+		{
+			name:        "ICMPv6 in IPv4 chain",
+			rch:         ipv4Filter,
+			rule:        &linux_iptables.Rule{Protocol: linux_iptables.Rule_ICMPV6},
+			expectedErr: ErrICMPNotMatchingProtocol,
+		},
+		{
+			name:        "ports without protocol",
+			rch:         ipv4Filter,
+			rule:        &linux_iptables.Rule{SourcePorts: &linux_iptables.Rule_PortRange{LowerPort: 80}},
+			expectedErr: ErrPortsWithoutProtocol,
+		},
+		{
+			name: "invalid port range",
+			rch:  ipv4Filter,
+			rule: &linux_iptables.Rule{
+				Protocol:         linux_iptables.Rule_SCTP,
+				DestinationPorts: &linux_iptables.Rule_PortRange{LowerPort: 90, UpperPort: 80},
+			},
+			expectedErr: ErrInvalidPortRange,
+		},
+		{
+			name:        "comment with quotes",
+			rch:         ipv4Filter,
+			rule:        &linux_iptables.Rule{Comment: `say "hello"`},
+			expectedErr: ErrInvalidText,
+		},
+		{
+			name: "log prefix with line break",
+			rch:  ipv4Filter,
+			rule: &linux_iptables.Rule{
+				Target: &linux_iptables.Rule_Target{Action: linux_iptables.Rule_Target_LOG, LogPrefix: "a\nb"},
+			},
+			expectedErr: ErrInvalidText,
+		},
+		{
+			name: "jump without chain",
+			rch:  ipv4Filter,
+			rule: &linux_iptables.Rule{
+				Target: &linux_iptables.Rule_Target{Action: linux_iptables.Rule_Target_JUMP},
+			},
+			expectedErr: ErrJumpWithoutChain,
+		},
+		{
+			name: "NAT action in filter table",
+			rch:  ipv4Filter,
+			rule: &linux_iptables.Rule{
+				Target: &linux_iptables.Rule_Target{Action: linux_iptables.Rule_Target_MASQUERADE},
+			},
+			expectedErr: ErrNATActionOutsideNATTable,
+		},
+		{
+			name: "SNAT without address",
+			rch:  ipv4NAT,
+			rule: &linux_iptables.Rule{
+				Target: &linux_iptables.Rule_Target{Action: linux_iptables.Rule_Target_SNAT},
+			},
+			expectedErr: ErrNATWithoutAddress,
+		},
+		{
+			name: "SNAT with IPv6 address in IPv4 chain",
+			rch:  ipv4NAT,
+			rule: &linux_iptables.Rule{
+				Target: &linux_iptables.Rule_Target{Action: linux_iptables.Rule_Target_SNAT, ToAddress: "fd00::1"},
+			},
+			expectedErr: ErrAddressNotMatchingProtocol,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			err := validateRule(test.rch, test.rule)
+			if test.expectedErr == nil && !test.invalid {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			Expect(err).To(BeAssignableToTypeOf(&kvs.InvalidValueError{}))
+			if test.expectedErr != nil {
+				Expect(errors.Is(err.(*kvs.InvalidValueError).GetValidationError(), test.expectedErr)).To(BeTrue())
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
 	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin/descriptor/adapter"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin/linuxcalls"
@@ -64,6 +65,7 @@ var (
 type RuleChainDescriptor struct {
 	log             logging.Logger
 	nsPlugin        nsplugin.API
+	ifPlugin        ifplugin.API
 	scheduler       kvs.KVScheduler
 	ipTablesHandler linuxcalls.IPTablesAPI
 
@@ -76,12 +78,13 @@ type RuleChainDescriptor struct {
 // NewRuleChainDescriptor creates a new instance of the iptables RuleChain descriptor.
 func NewRuleChainDescriptor(
 	scheduler kvs.KVScheduler, ipTablesHandler linuxcalls.IPTablesAPI, nsPlugin nsplugin.API,
-	log logging.PluginLogger, goRoutinesCnt int, minRuleCountForPerfRuleAddition int) *kvs.KVDescriptor {
+	ifPlugin ifplugin.API, log logging.PluginLogger, goRoutinesCnt int, minRuleCountForPerfRuleAddition int) *kvs.KVDescriptor {
 
 	descrCtx := &RuleChainDescriptor{
 		scheduler:                       scheduler,
 		ipTablesHandler:                 ipTablesHandler,
 		nsPlugin:                        nsPlugin,
+		ifPlugin:                        ifPlugin,
 		goRoutinesCnt:                   goRoutinesCnt,
 		minRuleCountForPerfRuleAddition: minRuleCountForPerfRuleAddition,
 		log:                             log.NewLogger("ipt-rulechain-descriptor"),
@@ -108,14 +111,17 @@ func NewRuleChainDescriptor(
 func (d *RuleChainDescriptor) EquivalentRuleChains(key string, oldRCh, newRch *linux_iptables.RuleChain) bool {
 
 	// first, compare everything except the rules
-	oldRules := oldRCh.Rules
-	newRules := newRch.Rules
+	oldRules := d.allRules(oldRCh)
+	newRules := d.allRules(newRch)
 
-	oldRCh.Rules = nil
-	newRch.Rules = nil
+	oldStringRules, oldStructuredRules := oldRCh.Rules, oldRCh.StructuredRules
+	newStringRules, newStructuredRules := newRch.Rules, newRch.StructuredRules
+
+	oldRCh.Rules, oldRCh.StructuredRules = nil, nil
+	newRch.Rules, newRch.StructuredRules = nil, nil
 	defer func() {
-		oldRCh.Rules = oldRules
-		newRch.Rules = newRules
+		oldRCh.Rules, oldRCh.StructuredRules = oldStringRules, oldStructuredRules
+		newRch.Rules, newRch.StructuredRules = newStringRules, newStructuredRules
 	}()
 
 	if !proto.Equal(oldRCh, newRch) {
@@ -141,8 +147,9 @@ func (d *RuleChainDescriptor) EquivalentRuleChains(key string, oldRCh, newRch *l
 			return false
 		}
 		// check if each token exists in the matching rule
+		// (quotes are optional in the rules dumped by iptables)
 		for j := range oldTokens {
-			if !sliceContains(newTokens, oldTokens[j]) {
+			if !sliceContains(newTokens, strings.Trim(oldTokens[j], `"`)) {
 				return false
 			}
 		}
@@ -165,6 +172,11 @@ func (d *RuleChainDescriptor) Validate(key string, rch *linux_iptables.RuleChain
 	if rch.ChainType == linux_iptables.RuleChain_CUSTOM && rch.DefaultPolicy != linux_iptables.RuleChain_NONE {
 		return kvs.NewInvalidValueError(ErrDefaultPolicyOnCustomChain, "default_policy")
 	}
+	for _, rule := range rch.StructuredRules {
+		if err := validateRule(rch, rule); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -215,6 +227,11 @@ func (d *RuleChainDescriptor) Create(key string, rch *linux_iptables.RuleChain)
 	if err != nil {
 		return nil, errors.Errorf("Error by adding rules: %v", err)
 	}
+	err = d.ipTablesHandler.AppendStructuredRules(protocolType(rch), tableNameStr(rch), chainNameStr(rch),
+		d.renderRules(rch)...)
+	if err != nil {
+		return nil, errors.Errorf("Error by adding structured rules: %v", err)
+	}
 
 	return nil, err
 }
@@ -260,13 +277,11 @@ func (d *RuleChainDescriptor) Dependencies(key string, rch *linux_iptables.RuleC
 	var deps []kvs.Dependency
 
 	// the associated interfaces must exist
-	if len(rch.Interfaces) > 0 {
-		for _, i := range rch.Interfaces {
-			deps = append(deps, kvs.Dependency{
-				Label: ruleChainInterfaceDep + "-" + i,
-				Key:   ifmodel.InterfaceKey(i),
-			})
-		}
+	for _, i := range ruleChainInterfaces(rch) {
+		deps = append(deps, kvs.Dependency{
+			Label: ruleChainInterfaceDep + "-" + i,
+			Key:   ifmodel.InterfaceKey(i),
+		})
 	}
 
 	// microservice must be available
@@ -360,6 +375,11 @@ func (d *RuleChainDescriptor) retrieveRuleChains(
 		// build key-value pair for the retrieved rules
 		val := proto.Clone(corrrelRule).(*linux_iptables.RuleChain)
 		val.Rules = rules
+		val.StructuredRules = nil
+		if len(corrrelRule.StructuredRules) > 0 && d.EquivalentRuleChains(correlate[i].Key, val, corrrelRule) {
+			// rendered structured rules are in place, keep them in the structured form
+			val = proto.Clone(corrrelRule).(*linux_iptables.RuleChain)
+		}
 		retrieved.chains = append(retrieved.chains, adapter.RuleChainKVWithMetadata{
 			Key:    linux_iptables.RuleChainKey(val.Name),
 			Value:  val,
@@ -370,10 +390,49 @@ func (d *RuleChainDescriptor) retrieveRuleChains(
 	ch <- retrieved
 }
 
+// allRules returns the rule strings of the rule chain followed by the rendered structured rules.
+func (d *RuleChainDescriptor) allRules(rch *linux_iptables.RuleChain) []string {
+	if len(rch.StructuredRules) == 0 {
+		return rch.Rules
+	}
+	return append(append([]string(nil), rch.Rules...), d.renderRules(rch)...)
+}
+
+// renderRules returns the rendered structured rules of the rule chain.
+func (d *RuleChainDescriptor) renderRules(rch *linux_iptables.RuleChain) []string {
+	var rules []string
+	for _, rule := range rch.StructuredRules {
+		rules = append(rules, renderRule(rule, d.hostIfName))
+	}
+	return rules
+}
+
+// hostIfName returns host name of the linux interface with the given logical name.
+func (d *RuleChainDescriptor) hostIfName(ifName string) string {
+	if ifMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(ifName); found && ifMeta != nil {
+		return ifMeta.HostIfName
+	}
+	return ifName
+}
+
+// ruleChainInterfaces returns interfaces referred by the rule chain,
+// including interfaces referred by the structured rules.
+func ruleChainInterfaces(rch *linux_iptables.RuleChain) []string {
+	ifNames := append([]string(nil), rch.Interfaces...)
+	for _, rule := range rch.StructuredRules {
+		for _, ifName := range []string{rule.InInterface, rule.OutInterface} {
+			if ifName != "" && !sliceContains(ifNames, ifName) {
+				ifNames = append(ifNames, ifName)
+			}
+		}
+	}
+	return ifNames
+}
+
 // sliceContains returns true if provided slice contains provided value, false otherwise.
 func sliceContains(slice []string, value string) bool {
 	for _, i := range slice {
-		if i == value {
+		if strings.Trim(i, `"`) == value {
 			return true
 		}
 	}
@@ -21,6 +21,7 @@ import (
 
 	"go.ligato.io/cn-infra/v2/infra"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin/descriptor"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin/linuxcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
@@ -56,6 +57,7 @@ type Deps struct {
 	infra.PluginDeps
 	KVScheduler kvs.KVScheduler
 	NsPlugin    nsplugin.API
+	IfPlugin    ifplugin.API
 }
 
 // Config holds the plugin configuration.
@@ -91,7 +93,7 @@ func (p *IPTablesPlugin) Init() error {
 
 	// init & register the descriptor
 	ruleChainDescriptor := descriptor.NewRuleChainDescriptor(
-		p.KVScheduler, p.iptHandler, p.NsPlugin, p.Log, config.GoRoutinesCnt, config.MinRuleCountForPerfRuleAddition)
+		p.KVScheduler, p.iptHandler, p.NsPlugin, p.IfPlugin, p.Log, config.GoRoutinesCnt, config.MinRuleCountForPerfRuleAddition)
 
 	err = p.Deps.KVScheduler.RegisterKVDescriptor(ruleChainDescriptor)
 	if err != nil {
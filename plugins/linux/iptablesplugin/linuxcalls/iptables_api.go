@@ -50,6 +50,11 @@ type IPTablesAPIWrite interface {
 	// AppendRules appends rules into the specified chain.
 	AppendRules(protocol L3Protocol, table, chain string, rules ...string) error
 
+	// AppendStructuredRules appends rules rendered from the structured rules
+	// into the specified chain. Unlike AppendRules, double-quoted text
+	// (e.g. a comment) in the rules is passed as a single argument.
+	AppendStructuredRules(protocol L3Protocol, table, chain string, rules ...string) error
+
 	// DeleteRule deletes a rule from the specified chain.
 	DeleteRule(protocol L3Protocol, table, chain string, rule string) error
 
@@ -106,13 +106,34 @@ func (h *IPTablesHandler) AppendRule(protocol L3Protocol, table, chain string, r
 
 // AppendRules appends rules into the specified chain.
 func (h *IPTablesHandler) AppendRules(protocol L3Protocol, table, chain string, rules ...string) error {
+	return h.appendRules(protocol, table, chain, rules, h.AppendRule)
+}
+
+// AppendStructuredRules appends rules rendered from the structured rules into the specified chain.
+func (h *IPTablesHandler) AppendStructuredRules(protocol L3Protocol, table, chain string, rules ...string) error {
+	return h.appendRules(protocol, table, chain, rules, h.appendStructuredRule)
+}
+
+// appendStructuredRule appends a rule rendered from the structured rule into the specified chain.
+func (h *IPTablesHandler) appendStructuredRule(protocol L3Protocol, table, chain string, rule string) error {
+	handler, err := h.getHandler(protocol)
+	if err != nil {
+		return err
+	}
+	return handler.Append(table, chain, splitRule(rule)...)
+}
+
+// appendRules appends rules into the specified chain, one by one using <appendRule>
+// or all at once with iptables-restore if there are many of them.
+func (h *IPTablesHandler) appendRules(protocol L3Protocol, table, chain string, rules []string,
+	appendRule func(protocol L3Protocol, table, chain string, rule string) error) error {
 	if len(rules) == 0 {
 		return nil // nothing to do
 	}
 
 	if len(rules) < h.minRuleCountForPerfRuleAddition { // use normal method of addition
 		for _, rule := range rules {
-			err := h.AppendRule(protocol, table, chain, rule)
+			err := appendRule(protocol, table, chain, rule)
 			if err != nil {
 				return errors.Errorf("Error by appending iptables rule: %v", err)
 			}
@@ -259,3 +280,32 @@ func (h *IPTablesHandler) getHandler(protocol L3Protocol) (*iptables.IPTables, e
 	}
 	return handler, nil
 }
+
+// splitRule splits the rule rendered from the structured rule into arguments
+// separated by spaces. Double-quoted text (e.g. a comment) is kept in a single
+// argument without the quotes.
+func splitRule(rule string) []string {
+	var args []string
+	var arg strings.Builder
+	var quoted, inArg bool
+	for _, r := range rule {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inArg = true
+		case r == ' ' && !quoted:
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSplitRule(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+		args []string
+	}{
+		{
+			name: "no quotes",
+			rule: "-p tcp -m tcp --dport 80 -j ACCEPT",
+			args: []string{"-p", "tcp", "-m", "tcp", "--dport", "80", "-j", "ACCEPT"},
+		},
+		{
+			name: "quoted comment",
+			rule: `-m comment --comment "allow web traffic" -j ACCEPT`,
+			args: []string{"-m", "comment", "--comment", "allow web traffic", "-j", "ACCEPT"},
+		},
+		{
+			name: "quoted text inside argument",
+			rule: `-j LOG --log-prefix "dropped: "`,
+			args: []string{"-j", "LOG", "--log-prefix", "dropped: "},
+		},
+		{
+			name: "empty quoted text",
+			rule: `--comment "" -j DROP`,
+			args: []string{"--comment", "", "-j", "DROP"},
+		},
+		{
+			name: "repeated spaces",
+			rule: "  -j   DROP ",
+			args: []string{"-j", "DROP"},
+		},
+		{
+			name: "empty rule",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(splitRule(test.rule)).To(Equal(test.args))
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"go.ligato.io/cn-infra/v2/logging"
 
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
 )
 
@@ -32,6 +33,7 @@ func NewPlugin(opts ...Option) *IPTablesPlugin {
 	p.PluginName = "linux-iptablesplugin"
 	p.KVScheduler = &kvscheduler.DefaultPlugin
 	p.NsPlugin = &nsplugin.DefaultPlugin
+	p.IfPlugin = &ifplugin.DefaultPlugin
 
 	for _, o := range opts {
 		o(p)
@@ -17,6 +17,17 @@ type ARPKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type ARPBatchOp struct {
+	Key         string
+	Value       *linux_l3.ARPEntry
+	PrevValue   *linux_l3.ARPEntry
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type ARPDescriptor struct {
@@ -33,11 +44,15 @@ type ARPDescriptor struct {
 	Delete               func(key string, value *linux_l3.ARPEntry, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *linux_l3.ARPEntry, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *linux_l3.ARPEntry, metadata interface{}) bool
+	CreateBatch          func(ops []*ARPBatchOp) error
+	UpdateBatch          func(ops []*ARPBatchOp) error
+	DeleteBatch          func(ops []*ARPBatchOp) error
 	Retrieve             func(correlate []ARPKVWithMetadata) ([]ARPKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *linux_l3.ARPEntry) []KeyValuePair
 	Dependencies         func(key string, value *linux_l3.ARPEntry) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewARPDescriptor(typedDescriptor *ARPDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewARPDescriptor(typedDescriptor *ARPDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *ARPDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newValu
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *ARPDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castARPBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyARPBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *ARPDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castARPBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyARPBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *ARPDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castARPBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyARPBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *ARPDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []ARPKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castARPValue(key string, value proto.Message) (*linux_l3.ARPEntry, error) {
 	return typedValue, nil
 }
 
+func castARPBatchOps(ops []*BatchOp, withPrevValue bool) []*ARPBatchOp {
+	var typedOps []*ARPBatchOp
+	for _, op := range ops {
+		typedValue, err := castARPValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *linux_l3.ARPEntry
+		if withPrevValue {
+			typedPrevValue, err = castARPValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castARPMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &ARPBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyARPBatchResults(ops []*BatchOp, typedOps []*ARPBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castARPMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
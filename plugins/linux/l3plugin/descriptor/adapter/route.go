@@ -17,6 +17,17 @@ type RouteKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type RouteBatchOp struct {
+	Key         string
+	Value       *linux_l3.Route
+	PrevValue   *linux_l3.Route
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type RouteDescriptor struct {
@@ -33,11 +44,15 @@ type RouteDescriptor struct {
 	Delete               func(key string, value *linux_l3.Route, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *linux_l3.Route, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *linux_l3.Route, metadata interface{}) bool
+	CreateBatch          func(ops []*RouteBatchOp) error
+	UpdateBatch          func(ops []*RouteBatchOp) error
+	DeleteBatch          func(ops []*RouteBatchOp) error
 	Retrieve             func(correlate []RouteKVWithMetadata) ([]RouteKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *linux_l3.Route) []KeyValuePair
 	Dependencies         func(key string, value *linux_l3.Route) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewRouteDescriptor(typedDescriptor *RouteDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewRouteDescriptor(typedDescriptor *RouteDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *RouteDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newVa
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *RouteDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castRouteBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyRouteBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *RouteDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castRouteBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyRouteBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *RouteDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castRouteBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyRouteBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *RouteDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []RouteKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castRouteValue(key string, value proto.Message) (*linux_l3.Route, error) {
 	return typedValue, nil
 }
 
+func castRouteBatchOps(ops []*BatchOp, withPrevValue bool) []*RouteBatchOp {
+	var typedOps []*RouteBatchOp
+	for _, op := range ops {
+		typedValue, err := castRouteValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *linux_l3.Route
+		if withPrevValue {
+			typedPrevValue, err = castRouteValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castRouteMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &RouteBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyRouteBatchResults(ops []*BatchOp, typedOps []*RouteBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castRouteMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -120,7 +120,7 @@ func NewRouteDescriptor(
 // EquivalentRoutes is case-insensitive comparison function for l3.LinuxRoute.
 func (d *RouteDescriptor) EquivalentRoutes(key string, oldRoute, newRoute *linux_l3.Route) bool {
 	// attributes compared as usually:
-	if oldRoute.OutgoingInterface != newRoute.OutgoingInterface {
+	if oldRoute.OutgoingInterface != newRoute.OutgoingInterface || oldRoute.Table != newRoute.Table {
 		return false
 	}
 	// compare scopes for IPv4 routes
@@ -197,7 +197,9 @@ func (d *RouteDescriptor) updateRoute(route *linux_l3.Route, actionName string,
 	netlinkRoute.LinkIndex = ifMeta.LinuxIfIndex
 
 	// set routing table
-	if ifMeta.VrfMasterIf != "" {
+	if route.Table != 0 {
+		netlinkRoute.Table = int(route.Table)
+	} else if ifMeta.VrfMasterIf != "" {
 		// - route depends on interface having an IP address
 		// - IP address depends on the interface already being in the VRF
 		// - VRF assignment depends on the VRF device being configured
@@ -410,19 +412,29 @@ func (d *RouteDescriptor) Retrieve(correlate []adapter.RouteKVWithMetadata) ([]a
 				continue
 			}
 		}
+		table := routeDetails.Route.Table
+		key := linux_l3.RouteTableKey(routeDetails.Route.DstNetwork, routeDetails.Route.OutgoingInterface, table)
+		if _, hasExpCfg := expCfg[key]; !hasExpCfg && table == 0 {
+			// the table implied by the interface may be also set explicitly
+			tableKey := linux_l3.RouteTableKey(routeDetails.Route.DstNetwork,
+				routeDetails.Route.OutgoingInterface, routeDetails.Meta.Table)
+			if _, hasExpCfg := expCfg[tableKey]; hasExpCfg {
+				key, table = tableKey, routeDetails.Meta.Table
+			}
+		}
 		route := adapter.RouteKVWithMetadata{
-			Key: linux_l3.RouteKey(routeDetails.Route.DstNetwork, routeDetails.Route.OutgoingInterface),
+			Key: key,
 			Value: &linux_l3.Route{
 				OutgoingInterface: routeDetails.Route.OutgoingInterface,
 				Scope:             scope,
 				DstNetwork:        routeDetails.Route.DstNetwork,
 				GwAddr:            routeDetails.Route.GwAddr,
 				Metric:            routeDetails.Route.Metric,
+				Table:             table,
 			},
 			Origin: kvs.UnknownOrigin, // let the scheduler to determine the origin
 		}
 
-		key := linux_l3.RouteKey(routeDetails.Route.DstNetwork, routeDetails.Route.OutgoingInterface)
 		if expCfg, hasExpCfg := expCfg[key]; hasExpCfg {
 			if d.EquivalentRoutes(key, route.Value, expCfg) {
 				route.Value = nbCfg[key]
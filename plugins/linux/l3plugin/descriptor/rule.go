@@ -0,0 +1,391 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin/descriptor/adapter"
+	l3linuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+const (
+	// RuleDescriptorName is the name of the descriptor for Linux policy routing rules.
+	RuleDescriptorName = "linux-rule"
+
+	// dependency labels
+	ruleInInterfaceDep  = "in-interface-exists"
+	ruleOutInterfaceDep = "out-interface-exists"
+	ruleVrfDep          = "vrf-device-exists"
+	ruleMicroserviceDep = "microservice-available"
+
+	// firewall mark mask used by the kernel if the mask is not defined
+	defaultFwMask = 0xffffffff
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrRuleWithoutTable is returned when Linux Rule is configured without
+	// routing table or VRF.
+	ErrRuleWithoutTable = errors.New("Linux Rule defined without routing table or VRF")
+
+	// ErrRuleWithTableAndVrf is returned when Linux Rule is configured with both
+	// routing table and VRF.
+	ErrRuleWithTableAndVrf = errors.New("Linux Rule defined with both routing table and VRF")
+
+	// ErrRuleNetworkFamily is returned when network of Linux Rule does not match
+	// the address family of the rule.
+	ErrRuleNetworkFamily = errors.New("Linux Rule network does not match the address family of the rule")
+
+	// ErrRuleFwMaskWithoutMark is returned when Linux Rule is configured with
+	// firewall mark mask but without the firewall mark.
+	ErrRuleFwMaskWithoutMark = errors.New("Linux Rule defined with fwmask but without fwmark")
+)
+
+// RuleDescriptor teaches KVScheduler how to configure Linux policy routing rules.
+type RuleDescriptor struct {
+	log       logging.Logger
+	l3Handler l3linuxcalls.NetlinkAPI
+	ifPlugin  ifplugin.API
+	nsPlugin  nsplugin.API
+	scheduler kvs.KVScheduler
+}
+
+// NewRuleDescriptor creates a new instance of the Rule descriptor.
+func NewRuleDescriptor(
+	scheduler kvs.KVScheduler, ifPlugin ifplugin.API, nsPlugin nsplugin.API,
+	l3Handler l3linuxcalls.NetlinkAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &RuleDescriptor{
+		scheduler: scheduler,
+		l3Handler: l3Handler,
+		ifPlugin:  ifPlugin,
+		nsPlugin:  nsPlugin,
+		log:       log.NewLogger("rule-descriptor"),
+	}
+	typedDescr := &adapter.RuleDescriptor{
+		Name:                 RuleDescriptorName,
+		NBKeyPrefix:          linux_l3.ModelRule.KeyPrefix(),
+		ValueTypeName:        linux_l3.ModelRule.ProtoName(),
+		KeySelector:          linux_l3.ModelRule.IsKeyValid,
+		KeyLabel:             linux_l3.ModelRule.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentRules,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewRuleDescriptor(typedDescr)
+}
+
+// EquivalentRules compares rules with networks converted to net.IPNet
+// and with the default firewall mark mask filled in.
+func (d *RuleDescriptor) EquivalentRules(key string, oldRule, newRule *linux_l3.Rule) bool {
+	if oldRule.Family != newRule.Family ||
+		oldRule.Priority != newRule.Priority ||
+		oldRule.Fwmark != newRule.Fwmark ||
+		fwMask(oldRule) != fwMask(newRule) ||
+		oldRule.InInterface != newRule.InInterface ||
+		oldRule.OutInterface != newRule.OutInterface ||
+		oldRule.Table != newRule.Table ||
+		oldRule.Vrf != newRule.Vrf {
+		return false
+	}
+	if !proto.Equal(oldRule.Namespace, newRule.Namespace) {
+		return false
+	}
+	return equalNetworks(oldRule.From, newRule.From) && equalNetworks(oldRule.To, newRule.To)
+}
+
+// Validate validates policy routing rule configuration.
+func (d *RuleDescriptor) Validate(key string, rule *linux_l3.Rule) error {
+	if rule.Table == 0 && rule.Vrf == "" {
+		return kvs.NewInvalidValueError(ErrRuleWithoutTable, "table", "vrf")
+	}
+	if rule.Table != 0 && rule.Vrf != "" {
+		return kvs.NewInvalidValueError(ErrRuleWithTableAndVrf, "table", "vrf")
+	}
+	if rule.Fwmask != 0 && rule.Fwmark == 0 {
+		return kvs.NewInvalidValueError(ErrRuleFwMaskWithoutMark, "fwmask")
+	}
+	for _, network := range []struct {
+		value string
+		field string
+	}{{rule.From, "from"}, {rule.To, "to"}} {
+		if network.value == "" {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(network.value)
+		if err != nil {
+			return kvs.NewInvalidValueError(err, network.field)
+		}
+		if (ipNet.IP.To4() != nil) != (rule.Family == linux_l3.Rule_IPV4) {
+			return kvs.NewInvalidValueError(ErrRuleNetworkFamily, network.field, "family")
+		}
+	}
+	return nil
+}
+
+// Create adds Linux policy routing rule.
+func (d *RuleDescriptor) Create(key string, rule *linux_l3.Rule) (metadata interface{}, err error) {
+	err = d.updateRule(rule, "add", d.l3Handler.AddRule)
+	return nil, err
+}
+
+// Delete removes Linux policy routing rule.
+func (d *RuleDescriptor) Delete(key string, rule *linux_l3.Rule, metadata interface{}) error {
+	return d.updateRule(rule, "delete", d.l3Handler.DelRule)
+}
+
+// updateRule adds or deletes a Linux policy routing rule.
+func (d *RuleDescriptor) updateRule(rule *linux_l3.Rule, actionName string, actionClb func(rule *netlink.Rule) error) error {
+	netlinkRule, err := d.toNetlinkRule(rule)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+
+	// move to the namespace of the rule
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	revertNs, err := d.nsPlugin.SwitchToNamespace(nsCtx, rule.Namespace)
+	if err != nil {
+		err = errors.Errorf("failed to switch namespace: %v", err)
+		d.log.Error(err)
+		return err
+	}
+	defer revertNs()
+
+	err = actionClb(netlinkRule)
+	if err != nil {
+		err = errors.Errorf("failed to %s linux rule: %v", actionName, err)
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Dependencies lists dependencies for a Linux policy routing rule.
+func (d *RuleDescriptor) Dependencies(key string, rule *linux_l3.Rule) []kvs.Dependency {
+	var dependencies []kvs.Dependency
+	// referenced interfaces must exist
+	if rule.InInterface != "" {
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: ruleInInterfaceDep,
+			Key:   ifmodel.InterfaceKey(rule.InInterface),
+		})
+	}
+	if rule.OutInterface != "" {
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: ruleOutInterfaceDep,
+			Key:   ifmodel.InterfaceKey(rule.OutInterface),
+		})
+	}
+	// VRF device must exist to know its routing table
+	if rule.Vrf != "" {
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: ruleVrfDep,
+			Key:   ifmodel.InterfaceKey(rule.Vrf),
+		})
+	}
+	// microservice must be available
+	if rule.Namespace != nil && rule.Namespace.Type == linux_namespace.NetNamespace_MICROSERVICE {
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: ruleMicroserviceDep,
+			Key:   linux_namespace.MicroserviceKey(rule.Namespace.Reference),
+		})
+	}
+	return dependencies
+}
+
+// Retrieve returns all policy routing rules which are expected to be configured
+// by this agent.
+func (d *RuleDescriptor) Retrieve(correlate []adapter.RuleKVWithMetadata) ([]adapter.RuleKVWithMetadata, error) {
+	var values []adapter.RuleKVWithMetadata
+
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	for _, kv := range correlate {
+		expRule := kv.Value
+
+		// switch to the namespace of the rule
+		revertNs, err := d.nsPlugin.SwitchToNamespace(nsCtx, expRule.Namespace)
+		if err != nil {
+			// namespace and all the rules it had contained no longer exist
+			d.log.WithFields(logging.Fields{
+				"err":       err,
+				"namespace": expRule.Namespace,
+			}).Warn("Failed to retrieve rules from the namespace")
+			continue
+		}
+		rules, err := d.l3Handler.GetRules(netlinkFamily(expRule.Family))
+		revertNs()
+		if err != nil {
+			return nil, errors.Errorf("failed to retrieve linux rules: %v", err)
+		}
+
+		// find rule with the expected priority, preferably equivalent to the expected one
+		var retrieved *linux_l3.Rule
+		for i := range rules {
+			if rules[i].Priority != int(expRule.Priority) {
+				continue
+			}
+			rule := d.fromNetlinkRule(&rules[i], expRule)
+			if retrieved == nil || d.EquivalentRules(kv.Key, rule, expRule) {
+				retrieved = rule
+			}
+		}
+		if retrieved == nil {
+			continue
+		}
+		if d.EquivalentRules(kv.Key, retrieved, expRule) {
+			retrieved = expRule
+		}
+		values = append(values, adapter.RuleKVWithMetadata{
+			Key:    linux_l3.RuleKey(retrieved.Family, retrieved.Priority, retrieved.Namespace),
+			Value:  retrieved,
+			Origin: kvs.FromNB,
+		})
+	}
+
+	return values, nil
+}
+
+// toNetlinkRule converts the rule to its Netlink representation.
+func (d *RuleDescriptor) toNetlinkRule(rule *linux_l3.Rule) (*netlink.Rule, error) {
+	netlinkRule := netlink.NewRule()
+	netlinkRule.Family = netlinkFamily(rule.Family)
+	netlinkRule.Priority = int(rule.Priority)
+
+	// set selectors
+	var err error
+	if rule.From != "" {
+		if _, netlinkRule.Src, err = net.ParseCIDR(rule.From); err != nil {
+			return nil, err
+		}
+	}
+	if rule.To != "" {
+		if _, netlinkRule.Dst, err = net.ParseCIDR(rule.To); err != nil {
+			return nil, err
+		}
+	}
+	if rule.Fwmark != 0 {
+		netlinkRule.Mark = int(rule.Fwmark)
+		netlinkRule.Mask = int(fwMask(rule))
+	}
+	if rule.InInterface != "" {
+		if netlinkRule.IifName, err = d.hostIfName(rule.InInterface); err != nil {
+			return nil, err
+		}
+	}
+	if rule.OutInterface != "" {
+		if netlinkRule.OifName, err = d.hostIfName(rule.OutInterface); err != nil {
+			return nil, err
+		}
+	}
+
+	// set routing table
+	netlinkRule.Table = int(rule.Table)
+	if rule.Vrf != "" {
+		vrfMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(rule.Vrf)
+		if !found || vrfMeta == nil {
+			return nil, errors.Errorf("failed to obtain metadata for VRF device %s", rule.Vrf)
+		}
+		netlinkRule.Table = int(vrfMeta.VrfDevRT)
+	}
+	return netlinkRule, nil
+}
+
+// fromNetlinkRule converts the rule from its Netlink representation.
+// Interfaces and VRF are resolved with respect to the expected rule.
+func (d *RuleDescriptor) fromNetlinkRule(netlinkRule *netlink.Rule, expRule *linux_l3.Rule) *linux_l3.Rule {
+	ifIndex := d.ifPlugin.GetInterfaceIndex()
+	rule := &linux_l3.Rule{
+		Family:    expRule.Family,
+		Priority:  uint32(netlinkRule.Priority),
+		Table:     uint32(netlinkRule.Table),
+		Namespace: expRule.Namespace,
+	}
+	if netlinkRule.Src != nil {
+		rule.From = netlinkRule.Src.String()
+	}
+	if netlinkRule.Dst != nil {
+		rule.To = netlinkRule.Dst.String()
+	}
+	if netlinkRule.Mark > 0 {
+		rule.Fwmark = uint32(netlinkRule.Mark)
+		if netlinkRule.Mask >= 0 {
+			rule.Fwmask = uint32(netlinkRule.Mask)
+		}
+	}
+	if netlinkRule.IifName != "" {
+		rule.InInterface = netlinkRule.IifName
+		if ifName, _, found := ifIndex.LookupByHostName(netlinkRule.IifName, expRule.Namespace); found {
+			rule.InInterface = ifName
+		}
+	}
+	if netlinkRule.OifName != "" {
+		rule.OutInterface = netlinkRule.OifName
+		if ifName, _, found := ifIndex.LookupByHostName(netlinkRule.OifName, expRule.Namespace); found {
+			rule.OutInterface = ifName
+		}
+	}
+	if expRule.Vrf != "" {
+		vrfMeta, found := ifIndex.LookupByName(expRule.Vrf)
+		if found && vrfMeta != nil && vrfMeta.VrfDevRT == rule.Table {
+			rule.Vrf = expRule.Vrf
+			rule.Table = 0
+		}
+	}
+	return rule
+}
+
+// hostIfName returns the host name of the interface with the given logical name.
+func (d *RuleDescriptor) hostIfName(ifName string) (string, error) {
+	ifMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(ifName)
+	if !found || ifMeta == nil {
+		return "", errors.Errorf("failed to obtain metadata for interface %s", ifName)
+	}
+	return ifMeta.HostIfName, nil
+}
+
+// netlinkFamily returns Netlink constant of the rule address family.
+func netlinkFamily(family linux_l3.Rule_Family) int {
+	if family == linux_l3.Rule_IPV6 {
+		return netlink.FAMILY_V6
+	}
+	return netlink.FAMILY_V4
+}
+
+// fwMask returns the firewall mark mask of the rule as applied by the kernel.
+func fwMask(rule *linux_l3.Rule) uint32 {
+	if rule.Fwmark != 0 && rule.Fwmask == 0 {
+		return defaultFwMask
+	}
+	return rule.Fwmask
+}
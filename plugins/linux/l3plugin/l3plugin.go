@@ -14,6 +14,7 @@
 
 //go:generate descriptor-adapter --descriptor-name ARP --value-type *linux_l3.ARPEntry --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name Route --value-type *linux_l3.Route --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name Rule --value-type *linux_l3.Rule --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3" --output-dir "descriptor"
 
 package l3plugin
 
@@ -35,7 +36,7 @@ const (
 	defaultGoRoutinesCnt = 10
 )
 
-// L3Plugin configures Linux routes and ARP entries using Netlink API.
+// L3Plugin configures Linux routes, policy routing rules and ARP entries using Netlink API.
 type L3Plugin struct {
 	Deps
 
@@ -61,7 +62,7 @@ type Config struct {
 	GoRoutinesCnt int  `json:"go-routines-count"`
 }
 
-// Init initializes and registers descriptors for Linux ARPs and Routes.
+// Init initializes and registers descriptors for Linux ARPs, Routes and Rules.
 func (p *L3Plugin) Init() error {
 	// parse configuration file
 	config, err := p.retrieveConfig()
@@ -85,6 +86,9 @@ func (p *L3Plugin) Init() error {
 	routeDescriptor := descriptor.NewRouteDescriptor(
 		p.KVScheduler, p.IfPlugin, p.NsPlugin, p.AddrAlloc, p.l3Handler, p.Log, config.GoRoutinesCnt)
 
+	ruleDescriptor := descriptor.NewRuleDescriptor(
+		p.KVScheduler, p.IfPlugin, p.NsPlugin, p.l3Handler, p.Log)
+
 	err = p.Deps.KVScheduler.RegisterKVDescriptor(arpDescriptor)
 	if err != nil {
 		return err
@@ -93,6 +97,10 @@ func (p *L3Plugin) Init() error {
 	if err != nil {
 		return err
 	}
+	err = p.Deps.KVScheduler.RegisterKVDescriptor(ruleDescriptor)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
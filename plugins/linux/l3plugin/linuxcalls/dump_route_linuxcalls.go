@@ -20,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/vishvananda/netlink"
 	"go.ligato.io/cn-infra/v2/logging"
+	"golang.org/x/sys/unix"
 
 	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
@@ -30,6 +31,10 @@ const (
 	IPv4AddrAny = "0.0.0.0"
 	IPv6AddrAny = "::"
 
+	// AllTables can be passed to GetRoutes as the table to read routes
+	// from all the routing tables.
+	AllTables = -1
+
 	// minimum number of interfaces to be given to a single Go routine for processing
 	// in the Retrieve operation
 	minWorkForGoRoutine = 3
@@ -45,6 +50,8 @@ type retrievedRoutes struct {
 // interface.
 // <interfaceIdx> works as filter, if set to zero, all routes in the namespace
 // are returned.
+// Zero <table> represents the main routing table, AllTables selects routes
+// from all the routing tables.
 func (h *NetLinkHandler) GetRoutes(interfaceIdx, table int) (v4Routes, v6Routes []netlink.Route, err error) {
 	var routeFilter *netlink.Route
 	var filterMask uint64
@@ -56,7 +63,11 @@ func (h *NetLinkHandler) GetRoutes(interfaceIdx, table int) (v4Routes, v6Routes
 		if interfaceIdx != 0 {
 			filterMask |= netlink.RT_FILTER_OIF
 		}
-		if table != 0 {
+		if table == AllTables {
+			// unspecified table in the filter matches all the tables
+			routeFilter.Table = unix.RT_TABLE_UNSPEC
+			filterMask |= netlink.RT_FILTER_TABLE
+		} else if table != 0 {
 			filterMask |= netlink.RT_FILTER_TABLE
 		}
 	}
@@ -120,12 +131,12 @@ func (h *NetLinkHandler) retrieveRoutes(interfaces []string, goRoutineIdx, goRou
 			break
 		}
 
-		// obtain the associated routing table
-		var table int
+		// obtain the routing table associated with the interface
+		ifTable := unix.RT_TABLE_MAIN
 		if ifMeta.VrfMasterIf != "" {
 			vrfMeta, found := h.ifIndexes.LookupByName(ifMeta.VrfMasterIf)
 			if found {
-				table = int(vrfMeta.VrfDevRT)
+				ifTable = int(vrfMeta.VrfDevRT)
 			}
 		}
 
@@ -140,8 +151,8 @@ func (h *NetLinkHandler) retrieveRoutes(interfaces []string, goRoutineIdx, goRou
 			continue
 		}
 
-		// get routes assigned to this interface
-		v4Routes, v6Routes, err := h.GetRoutes(ifMeta.LinuxIfIndex, table)
+		// get routes assigned to this interface (from any routing table)
+		v4Routes, v6Routes, err := h.GetRoutes(ifMeta.LinuxIfIndex, AllTables)
 		revertNs()
 		if err != nil {
 			retrieved.err = err
@@ -151,6 +162,10 @@ func (h *NetLinkHandler) retrieveRoutes(interfaces []string, goRoutineIdx, goRou
 
 		// convert each route from Netlink representation to the NB representation
 		for idx, route := range append(v4Routes, v6Routes...) {
+			if route.Table == unix.RT_TABLE_LOCAL {
+				// skip routes to local addresses maintained by the kernel
+				continue
+			}
 			var dstNet, gwAddr string
 			if route.Dst == nil {
 				if idx < len(v4Routes) {
@@ -168,12 +183,18 @@ func (h *NetLinkHandler) retrieveRoutes(interfaces []string, goRoutineIdx, goRou
 			if len(route.Gw) != 0 {
 				gwAddr = route.Gw.String()
 			}
+			// table is left undefined if it is implied by the interface
+			var table uint32
+			if route.Table != ifTable {
+				table = uint32(route.Table)
+			}
 			retrieved.routes = append(retrieved.routes, &RouteDetails{
 				Route: &linux_l3.Route{
 					OutgoingInterface: ifName,
 					DstNetwork:        dstNet,
 					GwAddr:            gwAddr,
 					Metric:            uint32(route.Priority),
+					Table:             table,
 				},
 				Meta: &RouteMeta{
 					InterfaceIndex: uint32(route.LinkIndex),
@@ -76,6 +76,12 @@ type NetlinkAPIWrite interface {
 	ReplaceRoute(route *netlink.Route) error
 	// DelRoute removes linux static route.
 	DelRoute(route *netlink.Route) error
+
+	/* Rules */
+	// AddRule adds new linux policy routing rule.
+	AddRule(rule *netlink.Rule) error
+	// DelRule removes linux policy routing rule.
+	DelRule(rule *netlink.Rule) error
 }
 
 // NetlinkAPIRead interface covers read methods inside linux calls package
@@ -94,12 +100,17 @@ type NetlinkAPIRead interface {
 	// and with the given outgoing interface.
 	// <interfaceIdx> works as filter, if set to zero, all routes in the namespace
 	// are returned.
-	// Zero <table> represents the main routing table.
+	// Zero <table> represents the main routing table, use AllTables to read
+	// routes from all the routing tables.
 	GetRoutes(interfaceIdx, table int) (v4Routes, v6Routes []netlink.Route, err error)
 
 	// DumpRoutes reads all route entries and returns them as details
 	// with proto-modeled route data and additional metadata
 	DumpRoutes() ([]*RouteDetails, error)
+
+	// GetRules reads all policy routing rules of the given address family
+	// (FAMILY_V4 or FAMILY_V6) in the current namespace.
+	GetRules(family int) ([]netlink.Rule, error)
 }
 
 // NetLinkHandler is accessor for Netlink methods.
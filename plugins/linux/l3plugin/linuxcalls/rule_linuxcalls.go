@@ -0,0 +1,36 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+//go:build !windows && !darwin
+
+package linuxcalls
+
+import (
+	"github.com/vishvananda/netlink"
+)
+
+// AddRule creates the new policy routing rule
+func (h *NetLinkHandler) AddRule(rule *netlink.Rule) error {
+	return netlink.RuleAdd(rule)
+}
+
+// DelRule removes the policy routing rule
+func (h *NetLinkHandler) DelRule(rule *netlink.Rule) error {
+	return netlink.RuleDel(rule)
+}
+
+// GetRules reads all policy routing rules of the given address family.
+func (h *NetLinkHandler) GetRules(family int) ([]netlink.Rule, error) {
+	return netlink.RuleList(family)
+}
@@ -0,0 +1,536 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nftablesplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nftablesplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+)
+
+const (
+	// TableDescriptorName is the name of the descriptor for Linux nftables tables.
+	TableDescriptorName = "linux-nftables-table-descriptor"
+
+	// dependency labels
+	tableInterfaceDep = "interface-exists"
+	microserviceDep   = "microservice-available"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrTableWithoutName is returned when the table name is not provided.
+	ErrTableWithoutName = errors.New("nftables table defined without name")
+
+	// ErrChainWithoutName is returned when the chain name is not provided.
+	ErrChainWithoutName = errors.New("nftables chain defined without name")
+
+	// ErrSetWithoutName is returned when the set or map name is not provided.
+	ErrSetWithoutName = errors.New("nftables set or map defined without name")
+
+	// ErrDuplicateName is returned when a chain, set or map name is used more than once in the table.
+	ErrDuplicateName = errors.New("name is already used in the table")
+
+	// ErrBaseChainAttrsOnRegularChain is returned when type or policy is defined for a chain without hook.
+	ErrBaseChainAttrsOnRegularChain = errors.New("type and policy can be defined only for base chains (with hook)")
+
+	// ErrUndefinedChain is returned when a verdict refers to a chain which is not defined in the table.
+	ErrUndefinedChain = errors.New("target chain is not defined in the table")
+
+	// ErrUndefinedSet is returned when a rule refers to a set or map which is not defined in the table.
+	ErrUndefinedSet = errors.New("set or map is not defined in the table")
+
+	// ErrFieldNotMatchingSet is returned when a packet field does not match the type of the set elements,
+	// or the elements of such type cannot be matched in the table family.
+	ErrFieldNotMatchingSet = errors.New("packet field does not match type of the set elements")
+
+	// ErrPortsWithoutProtocol is returned when ports are matched without TCP, UDP or SCTP protocol.
+	ErrPortsWithoutProtocol = errors.New("ports can be matched only with TCP, UDP or SCTP protocol")
+
+	// ErrNetworkNotMatchingFamily is returned when a network does not match the table family.
+	ErrNetworkNotMatchingFamily = errors.New("network does not match the table family")
+
+	// ErrVerdictWithVerdictMap is returned when both verdict and verdict map are defined for a rule.
+	ErrVerdictWithVerdictMap = errors.New("rule cannot have both verdict and verdict map")
+)
+
+// TableDescriptor teaches KVScheduler how to configure Linux nftables tables.
+type TableDescriptor struct {
+	log        logging.Logger
+	nsPlugin   nsplugin.API
+	ifPlugin   ifplugin.API
+	nftHandler linuxcalls.NftablesAPI
+}
+
+// NewTableDescriptor creates a new instance of the nftables Table descriptor.
+func NewTableDescriptor(nftHandler linuxcalls.NftablesAPI, ifPlugin ifplugin.API, nsPlugin nsplugin.API,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	descrCtx := &TableDescriptor{
+		nftHandler: nftHandler,
+		ifPlugin:   ifPlugin,
+		nsPlugin:   nsPlugin,
+		log:        log.NewLogger("nft-table-descriptor"),
+	}
+
+	typedDescr := &adapter.TableDescriptor{
+		Name:                 TableDescriptorName,
+		NBKeyPrefix:          linux_nftables.ModelTable.KeyPrefix(),
+		ValueTypeName:        linux_nftables.ModelTable.ProtoName(),
+		KeySelector:          linux_nftables.ModelTable.IsKeyValid,
+		KeyLabel:             linux_nftables.ModelTable.StripKeyPrefix,
+		ValueComparator:      descrCtx.EquivalentTables,
+		Validate:             descrCtx.Validate,
+		Create:               descrCtx.Create,
+		Delete:               descrCtx.Delete,
+		Update:               descrCtx.Update,
+		Retrieve:             descrCtx.Retrieve,
+		Dependencies:         descrCtx.Dependencies,
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewTableDescriptor(typedDescr)
+}
+
+// EquivalentTables is a comparison function for two Table entries.
+func (d *TableDescriptor) EquivalentTables(key string, oldTable, newTable *linux_nftables.Table) bool {
+	return proto.Equal(normalizeTable(oldTable), normalizeTable(newTable))
+}
+
+// Validate validates nftables table.
+func (d *TableDescriptor) Validate(key string, table *linux_nftables.Table) error {
+	if table.Name == "" {
+		return kvs.NewInvalidValueError(ErrTableWithoutName, "name")
+	}
+
+	chains := make(map[string]bool)
+	for _, chain := range table.Chains {
+		if chain.Name == "" {
+			return kvs.NewInvalidValueError(ErrChainWithoutName, "chains.name")
+		}
+		if chains[chain.Name] {
+			return kvs.NewInvalidValueError(errors.Wrapf(ErrDuplicateName, "chain %q", chain.Name), "chains.name")
+		}
+		chains[chain.Name] = true
+		if chain.Hook == linux_nftables.Chain_NONE &&
+			(chain.Type != linux_nftables.Chain_FILTER || chain.Policy != linux_nftables.Chain_ACCEPT) {
+			return kvs.NewInvalidValueError(errors.Wrapf(ErrBaseChainAttrsOnRegularChain, "chain %q", chain.Name),
+				"chains.type", "chains.policy")
+		}
+	}
+
+	sets := make(map[string]linux_nftables.ElementType)
+	for _, set := range table.Sets {
+		if set.Name == "" {
+			return kvs.NewInvalidValueError(ErrSetWithoutName, "sets.name")
+		}
+		if _, duplicate := sets[set.Name]; duplicate || chains[set.Name] {
+			return kvs.NewInvalidValueError(errors.Wrapf(ErrDuplicateName, "set %q", set.Name), "sets.name")
+		}
+		sets[set.Name] = set.Type
+		for _, elem := range set.Elements {
+			if _, _, err := linuxcalls.ParseElement(set.Type, set.Interval, elem); err != nil {
+				return kvs.NewInvalidValueError(errors.Wrapf(err, "set %q", set.Name), "sets.elements")
+			}
+		}
+	}
+	maps := make(map[string]linux_nftables.ElementType)
+	for _, vmap := range table.Maps {
+		if vmap.Name == "" {
+			return kvs.NewInvalidValueError(ErrSetWithoutName, "maps.name")
+		}
+		if _, duplicate := sets[vmap.Name]; duplicate || chains[vmap.Name] {
+			return kvs.NewInvalidValueError(errors.Wrapf(ErrDuplicateName, "map %q", vmap.Name), "maps.name")
+		}
+		if _, duplicate := maps[vmap.Name]; duplicate {
+			return kvs.NewInvalidValueError(errors.Wrapf(ErrDuplicateName, "map %q", vmap.Name), "maps.name")
+		}
+		maps[vmap.Name] = vmap.KeyType
+		for _, elem := range vmap.Elements {
+			if _, _, err := linuxcalls.ParseElement(vmap.KeyType, vmap.Interval, elem.Key); err != nil {
+				return kvs.NewInvalidValueError(errors.Wrapf(err, "map %q", vmap.Name), "maps.elements.key")
+			}
+			if isChainVerdict(elem.Verdict) && !chains[elem.TargetChain] {
+				return kvs.NewInvalidValueError(errors.Wrapf(ErrUndefinedChain, "map %q", vmap.Name),
+					"maps.elements.target_chain")
+			}
+		}
+	}
+
+	for _, chain := range table.Chains {
+		for _, rule := range chain.Rules {
+			if err := validateRule(table.Family, rule, chains, sets, maps); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateRule validates single rule of the table.
+func validateRule(family linux_nftables.Table_Family, rule *linux_nftables.Rule,
+	chains map[string]bool, sets, maps map[string]linux_nftables.ElementType) error {
+
+	for _, network := range []struct {
+		prefix string
+		field  string
+	}{{rule.SrcNetwork, "rules.src_network"}, {rule.DstNetwork, "rules.dst_network"}} {
+		if network.prefix == "" {
+			continue
+		}
+		prefix, err := linuxcalls.ParseNetwork(network.prefix)
+		if err != nil {
+			return kvs.NewInvalidValueError(err, network.field)
+		}
+		if (family == linux_nftables.Table_IPV4 && !prefix.Addr().Is4()) ||
+			(family == linux_nftables.Table_IPV6 && prefix.Addr().Is4()) ||
+			family == linux_nftables.Table_ARP {
+			return kvs.NewInvalidValueError(ErrNetworkNotMatchingFamily, network.field)
+		}
+	}
+
+	withPorts := rule.Protocol == linux_nftables.Rule_TCP ||
+		rule.Protocol == linux_nftables.Rule_UDP ||
+		rule.Protocol == linux_nftables.Rule_SCTP
+	if !withPorts && (rule.SrcPorts != nil || rule.DstPorts != nil) {
+		return kvs.NewInvalidValueError(ErrPortsWithoutProtocol, "rules.protocol")
+	}
+
+	for _, setMatch := range rule.SetMatches {
+		elemType, ok := sets[setMatch.Set]
+		if !ok {
+			return kvs.NewInvalidValueError(errors.Wrapf(ErrUndefinedSet, "set %q", setMatch.Set),
+				"rules.set_matches.set")
+		}
+		if !fieldMatchesType(family, setMatch.Field, elemType) {
+			return kvs.NewInvalidValueError(ErrFieldNotMatchingSet, "rules.set_matches.field")
+		}
+		if isPortField(setMatch.Field) && !withPorts {
+			return kvs.NewInvalidValueError(ErrPortsWithoutProtocol, "rules.protocol")
+		}
+	}
+
+	if vmap := rule.VerdictMap; vmap != nil {
+		elemType, ok := maps[vmap.Map]
+		if !ok {
+			return kvs.NewInvalidValueError(errors.Wrapf(ErrUndefinedSet, "map %q", vmap.Map),
+				"rules.verdict_map.map")
+		}
+		if !fieldMatchesType(family, vmap.Field, elemType) {
+			return kvs.NewInvalidValueError(ErrFieldNotMatchingSet, "rules.verdict_map.field")
+		}
+		if isPortField(vmap.Field) && !withPorts {
+			return kvs.NewInvalidValueError(ErrPortsWithoutProtocol, "rules.protocol")
+		}
+		if rule.Verdict != linux_nftables.Verdict_CONTINUE {
+			return kvs.NewInvalidValueError(ErrVerdictWithVerdictMap, "rules.verdict", "rules.verdict_map")
+		}
+	}
+
+	if isChainVerdict(rule.Verdict) && !chains[rule.TargetChain] {
+		return kvs.NewInvalidValueError(errors.Wrapf(ErrUndefinedChain, "chain %q", rule.TargetChain),
+			"rules.target_chain")
+	}
+	return nil
+}
+
+// Create creates nftables table.
+func (d *TableDescriptor) Create(key string, table *linux_nftables.Table) (metadata interface{}, err error) {
+	return nil, d.setTable(table)
+}
+
+// Update replaces the content of the nftables table. The change is applied atomically.
+func (d *TableDescriptor) Update(key string, oldTable, newTable *linux_nftables.Table, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	return nil, d.setTable(newTable)
+}
+
+// Delete removes nftables table.
+func (d *TableDescriptor) Delete(key string, table *linux_nftables.Table, metadata interface{}) error {
+	d.log.Debugf("DELETE nftables table %s: %v", key, table)
+
+	// switch network namespace
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	nsRevert, err := d.nsPlugin.SwitchToNamespace(nsCtx, table.Namespace)
+	if err != nil {
+		d.log.WithFields(logging.Fields{
+			"err":       err,
+			"namespace": table.Namespace,
+		}).Warn("Failed to switch the namespace")
+		return err
+	}
+	// revert network namespace after returning
+	defer nsRevert()
+
+	if err = d.nftHandler.DeleteTable(table.Family, table.Name); err != nil {
+		return errors.Errorf("Error by deleting nftables table: %v", err)
+	}
+	return nil
+}
+
+// setTable (re)creates the table in its namespace.
+func (d *TableDescriptor) setTable(table *linux_nftables.Table) error {
+	d.log.Debugf("SET nftables table %s: %v", table.Name, table)
+
+	// interfaces referenced in rules are matched by their host names
+	hostIfNames := make(map[string]string)
+	for _, ifName := range tableInterfaces(table) {
+		ifMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(ifName)
+		if !found || ifMeta == nil {
+			return errors.Errorf("failed to find linux interface %s", ifName)
+		}
+		hostIfNames[ifName] = ifMeta.HostIfName
+	}
+
+	// switch network namespace
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	nsRevert, err := d.nsPlugin.SwitchToNamespace(nsCtx, table.Namespace)
+	if err != nil {
+		d.log.WithFields(logging.Fields{
+			"err":       err,
+			"namespace": table.Namespace,
+		}).Warn("Failed to switch the namespace")
+		return err
+	}
+	// revert network namespace after returning
+	defer nsRevert()
+
+	if err = d.nftHandler.SetTable(table, hostIfNames); err != nil {
+		return errors.Errorf("Error by configuring nftables table: %v", err)
+	}
+	return nil
+}
+
+// Dependencies lists dependencies for a nftables table.
+func (d *TableDescriptor) Dependencies(key string, table *linux_nftables.Table) []kvs.Dependency {
+	var deps []kvs.Dependency
+
+	// interfaces referenced in rules must exist
+	for _, ifName := range tableInterfaces(table) {
+		deps = append(deps, kvs.Dependency{
+			Label: tableInterfaceDep + "-" + ifName,
+			Key:   ifmodel.InterfaceKey(ifName),
+		})
+	}
+
+	// microservice must be available
+	if table.Namespace != nil && table.Namespace.Type == linux_namespace.NetNamespace_MICROSERVICE {
+		deps = append(deps, kvs.Dependency{
+			Label: microserviceDep + "-" + table.Namespace.Reference,
+			Key:   linux_namespace.MicroserviceKey(table.Namespace.Reference),
+		})
+	}
+
+	return deps
+}
+
+// Retrieve returns all nftables tables managed by this agent.
+func (d *TableDescriptor) Retrieve(correlate []adapter.TableKVWithMetadata) ([]adapter.TableKVWithMetadata, error) {
+	var values []adapter.TableKVWithMetadata
+
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	for _, item := range correlate {
+		table := item.Value
+		ifNames := d.logicalIfNames(table.Namespace)
+
+		// switch to the namespace
+		nsRevert, err := d.nsPlugin.SwitchToNamespace(nsCtx, table.Namespace)
+		if err != nil {
+			d.log.WithFields(logging.Fields{
+				"err":       err,
+				"namespace": table.Namespace,
+			}).Warn("Failed to switch the namespace")
+			continue // continue with the item
+		}
+
+		retrieved, err := d.nftHandler.GetTable(table.Family, table.Name, ifNames)
+
+		// switch back to the default namespace
+		nsRevert()
+
+		if err != nil {
+			d.log.Warnf("Error by retrieving nftables table %s: %v", table.Name, err)
+			continue // continue with the item
+		}
+		if retrieved == nil {
+			continue
+		}
+		retrieved.Namespace = table.Namespace
+		values = append(values, adapter.TableKVWithMetadata{
+			Key:    linux_nftables.TableKey(retrieved.Name, retrieved.Family, retrieved.Namespace),
+			Value:  retrieved,
+			Origin: kvs.FromNB,
+		})
+	}
+
+	return values, nil
+}
+
+// logicalIfNames returns mapping of host interface names to logical names
+// for interfaces inside the given namespace.
+func (d *TableDescriptor) logicalIfNames(ns *linux_namespace.NetNamespace) map[string]string {
+	ifNames := make(map[string]string)
+	ifIndex := d.ifPlugin.GetInterfaceIndex()
+	for _, name := range ifIndex.ListAllInterfaces() {
+		ifMeta, found := ifIndex.LookupByName(name)
+		if !found || ifMeta == nil {
+			continue
+		}
+		if ifName, _, found := ifIndex.LookupByHostName(ifMeta.HostIfName, ns); found {
+			ifNames[ifMeta.HostIfName] = ifName
+		}
+	}
+	return ifNames
+}
+
+// tableInterfaces returns logical names of interfaces referenced in rules of the table.
+func tableInterfaces(table *linux_nftables.Table) []string {
+	var ifNames []string
+	seen := make(map[string]bool)
+	for _, chain := range table.Chains {
+		for _, rule := range chain.Rules {
+			for _, ifName := range []string{rule.InInterface, rule.OutInterface} {
+				if ifName != "" && !seen[ifName] {
+					seen[ifName] = true
+					ifNames = append(ifNames, ifName)
+				}
+			}
+		}
+	}
+	return ifNames
+}
+
+// normalizeTable returns copy of the table in the form it has after retrieval.
+func normalizeTable(table *linux_nftables.Table) *linux_nftables.Table {
+	table = proto.Clone(table).(*linux_nftables.Table)
+
+	for _, chain := range table.Chains {
+		if chain.Hook == linux_nftables.Chain_NONE {
+			chain.Priority = 0
+		}
+		for _, rule := range chain.Rules {
+			if rule.SrcNetwork != "" {
+				if prefix, err := linuxcalls.ParseNetwork(rule.SrcNetwork); err == nil {
+					rule.SrcNetwork = prefix.String()
+				}
+			}
+			if rule.DstNetwork != "" {
+				if prefix, err := linuxcalls.ParseNetwork(rule.DstNetwork); err == nil {
+					rule.DstNetwork = prefix.String()
+				}
+			}
+			for _, ports := range []*linux_nftables.Rule_PortRange{rule.SrcPorts, rule.DstPorts} {
+				if ports != nil && ports.UpperPort <= ports.LowerPort {
+					ports.UpperPort = 0
+				}
+			}
+			rule.ConnStates = normalizeConnStates(rule.ConnStates)
+			if !isChainVerdict(rule.Verdict) {
+				rule.TargetChain = ""
+			}
+		}
+	}
+	sort.Slice(table.Chains, func(i, j int) bool {
+		return table.Chains[i].Name < table.Chains[j].Name
+	})
+
+	for _, set := range table.Sets {
+		for i, elem := range set.Elements {
+			set.Elements[i] = linuxcalls.NormalizeElement(set.Type, set.Interval, elem)
+		}
+		sort.Strings(set.Elements)
+	}
+	sort.Slice(table.Sets, func(i, j int) bool {
+		return table.Sets[i].Name < table.Sets[j].Name
+	})
+
+	for _, vmap := range table.Maps {
+		for _, elem := range vmap.Elements {
+			elem.Key = linuxcalls.NormalizeElement(vmap.KeyType, vmap.Interval, elem.Key)
+			if !isChainVerdict(elem.Verdict) {
+				elem.TargetChain = ""
+			}
+		}
+		sort.Slice(vmap.Elements, func(i, j int) bool {
+			return vmap.Elements[i].Key < vmap.Elements[j].Key
+		})
+	}
+	sort.Slice(table.Maps, func(i, j int) bool {
+		return table.Maps[i].Name < table.Maps[j].Name
+	})
+
+	return table
+}
+
+// normalizeConnStates returns sorted connection states without duplicates.
+func normalizeConnStates(states []linux_nftables.Rule_ConnState) []linux_nftables.Rule_ConnState {
+	var normalized []linux_nftables.Rule_ConnState
+	seen := make(map[linux_nftables.Rule_ConnState]bool)
+	for _, state := range states {
+		if !seen[state] {
+			seen[state] = true
+			normalized = append(normalized, state)
+		}
+	}
+	sort.Slice(normalized, func(i, j int) bool {
+		return normalized[i] < normalized[j]
+	})
+	return normalized
+}
+
+// isChainVerdict returns true for verdicts with target chain.
+func isChainVerdict(verdict linux_nftables.Verdict) bool {
+	return verdict == linux_nftables.Verdict_JUMP || verdict == linux_nftables.Verdict_GOTO
+}
+
+// isPortField returns true if the field is an L4 port.
+func isPortField(field linux_nftables.MatchField) bool {
+	return field == linux_nftables.MatchField_SRC_PORT || field == linux_nftables.MatchField_DST_PORT
+}
+
+// fieldMatchesType returns true if the packet field can be looked up in a set
+// with the given element type in a table of the given family.
+func fieldMatchesType(family linux_nftables.Table_Family, field linux_nftables.MatchField,
+	elemType linux_nftables.ElementType) bool {
+	if family == linux_nftables.Table_ARP {
+		// ARP packets have neither IP nor L4 header
+		return false
+	}
+	if isPortField(field) {
+		return elemType == linux_nftables.ElementType_INET_SERVICE
+	}
+	switch elemType {
+	case linux_nftables.ElementType_IPV4_ADDR:
+		return family != linux_nftables.Table_IPV6
+	case linux_nftables.ElementType_IPV6_ADDR:
+		return family != linux_nftables.Table_IPV4
+	case linux_nftables.ElementType_ETHER_ADDR:
+		// ethernet header is guaranteed only for packets of bridge tables
+		return family == linux_nftables.Table_BRIDGE
+	}
+	return false
+}
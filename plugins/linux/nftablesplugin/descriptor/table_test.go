@@ -0,0 +1,305 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+)
+
+func TestTableValidate(t *testing.T) {
+	chain := func(rules ...*linux_nftables.Rule) []*linux_nftables.Chain {
+		return []*linux_nftables.Chain{
+			{Name: "input", Hook: linux_nftables.Chain_INPUT, Rules: rules},
+			{Name: "allowed"},
+		}
+	}
+	sets := []*linux_nftables.Set{
+		{Name: "v4", Type: linux_nftables.ElementType_IPV4_ADDR, Elements: []string{"10.0.0.1"}},
+		{Name: "v6", Type: linux_nftables.ElementType_IPV6_ADDR, Interval: true, Elements: []string{"fd00::/64"}},
+		{Name: "macs", Type: linux_nftables.ElementType_ETHER_ADDR, Elements: []string{"aa:bb:cc:dd:ee:ff"}},
+		{Name: "ports", Type: linux_nftables.ElementType_INET_SERVICE, Elements: []string{"80"}},
+	}
+	setMatch := func(set string, field linux_nftables.MatchField) *linux_nftables.Rule {
+		return &linux_nftables.Rule{
+			Protocol:   linux_nftables.Rule_TCP,
+			SetMatches: []*linux_nftables.Rule_SetMatch{{Set: set, Field: field}},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		table       *linux_nftables.Table
+		expectedErr error
+	}{
+		{
+			name: "valid table",
+			table: &linux_nftables.Table{
+				Name: "filter",
+				Chains: chain(
+					setMatch("v4", linux_nftables.MatchField_SRC_ADDR),
+					setMatch("v6", linux_nftables.MatchField_DST_ADDR),
+					setMatch("ports", linux_nftables.MatchField_DST_PORT),
+					&linux_nftables.Rule{
+						SrcNetwork:  "10.0.0.0/8",
+						Verdict:     linux_nftables.Verdict_JUMP,
+						TargetChain: "allowed",
+					},
+				),
+				Sets: sets,
+				Maps: []*linux_nftables.Map{{
+					Name:    "verdicts",
+					KeyType: linux_nftables.ElementType_INET_SERVICE,
+					Elements: []*linux_nftables.Map_Element{
+						{Key: "22", Verdict: linux_nftables.Verdict_GOTO, TargetChain: "allowed"},
+					},
+				}},
+			},
+		},
+		{
+			name:        "missing table name",
+			table:       &linux_nftables.Table{},
+			expectedErr: ErrTableWithoutName,
+		},
+		{
+			name: "missing chain name",
+			table: &linux_nftables.Table{
+				Name:   "filter",
+				Chains: []*linux_nftables.Chain{{}},
+			},
+			expectedErr: ErrChainWithoutName,
+		},
+		{
+			name: "missing set name",
+			table: &linux_nftables.Table{
+				Name: "filter",
+				Sets: []*linux_nftables.Set{{Type: linux_nftables.ElementType_INET_SERVICE}},
+			},
+			expectedErr: ErrSetWithoutName,
+		},
+		{
+			name: "missing map name",
+			table: &linux_nftables.Table{
+				Name: "filter",
+				Maps: []*linux_nftables.Map{{KeyType: linux_nftables.ElementType_INET_SERVICE}},
+			},
+			expectedErr: ErrSetWithoutName,
+		},
+		{
+			name: "set named as chain",
+			table: &linux_nftables.Table{
+				Name:   "filter",
+				Chains: chain(),
+				Sets:   []*linux_nftables.Set{{Name: "allowed"}},
+			},
+			expectedErr: ErrDuplicateName,
+		},
+		{
+			name: "map named as set",
+			table: &linux_nftables.Table{
+				Name: "filter",
+				Sets: sets,
+				Maps: []*linux_nftables.Map{{Name: "ports"}},
+			},
+			expectedErr: ErrDuplicateName,
+		},
+		{
+			name: "policy of regular chain",
+			table: &linux_nftables.Table{
+				Name:   "filter",
+				Chains: []*linux_nftables.Chain{{Name: "regular", Policy: linux_nftables.Chain_DROP}},
+			},
+			expectedErr: ErrBaseChainAttrsOnRegularChain,
+		},
+		{
+			name: "undefined map target chain",
+			table: &linux_nftables.Table{
+				Name: "filter",
+				Maps: []*linux_nftables.Map{{
+					Name:    "verdicts",
+					KeyType: linux_nftables.ElementType_INET_SERVICE,
+					Elements: []*linux_nftables.Map_Element{
+						{Key: "22", Verdict: linux_nftables.Verdict_JUMP, TargetChain: "undefined"},
+					},
+				}},
+			},
+			expectedErr: ErrUndefinedChain,
+		},
+		{
+			name: "undefined set",
+			table: &linux_nftables.Table{
+				Name:   "filter",
+				Chains: chain(setMatch("undefined", linux_nftables.MatchField_SRC_ADDR)),
+			},
+			expectedErr: ErrUndefinedSet,
+		},
+		{
+			name: "address matched against port set",
+			table: &linux_nftables.Table{
+				Name:   "filter",
+				Chains: chain(setMatch("ports", linux_nftables.MatchField_SRC_ADDR)),
+				Sets:   sets,
+			},
+			expectedErr: ErrFieldNotMatchingSet,
+		},
+		{
+			name: "port matched against address set",
+			table: &linux_nftables.Table{
+				Name:   "filter",
+				Chains: chain(setMatch("v4", linux_nftables.MatchField_DST_PORT)),
+				Sets:   sets,
+			},
+			expectedErr: ErrFieldNotMatchingSet,
+		},
+		{
+			name: "ethernet address set in inet table",
+			table: &linux_nftables.Table{
+				Name:   "filter",
+				Chains: chain(setMatch("macs", linux_nftables.MatchField_SRC_ADDR)),
+				Sets:   sets,
+			},
+			expectedErr: ErrFieldNotMatchingSet,
+		},
+		{
+			name: "ethernet address set in bridge table",
+			table: &linux_nftables.Table{
+				Name:   "filter",
+				Family: linux_nftables.Table_BRIDGE,
+				Chains: chain(setMatch("macs", linux_nftables.MatchField_SRC_ADDR)),
+				Sets:   sets,
+			},
+		},
+		{
+			name: "IPv6 set in IPv4 table",
+			table: &linux_nftables.Table{
+				Name:   "filter",
+				Family: linux_nftables.Table_IPV4,
+				Chains: chain(setMatch("v6", linux_nftables.MatchField_SRC_ADDR)),
+				Sets:   sets,
+			},
+			expectedErr: ErrFieldNotMatchingSet,
+		},
+		{
+			name: "IPv4 set in IPv6 table",
+			table: &linux_nftables.Table{
+				Name:   "filter",
+				Family: linux_nftables.Table_IPV6,
+				Chains: chain(setMatch("v4", linux_nftables.MatchField_DST_ADDR)),
+				Sets:   sets,
+			},
+			expectedErr: ErrFieldNotMatchingSet,
+		},
+		{
+			name: "port set in ARP table",
+			table: &linux_nftables.Table{
+				Name:   "filter",
+				Family: linux_nftables.Table_ARP,
+				Chains: chain(setMatch("ports", linux_nftables.MatchField_DST_PORT)),
+				Sets:   sets,
+			},
+			expectedErr: ErrFieldNotMatchingSet,
+		},
+		{
+			name: "verdict map with mismatching field",
+			table: &linux_nftables.Table{
+				Name: "filter",
+				Chains: chain(&linux_nftables.Rule{
+					VerdictMap: &linux_nftables.Rule_VerdictMapLookup{
+						Map: "verdicts", Field: linux_nftables.MatchField_SRC_ADDR,
+					},
+				}),
+				Maps: []*linux_nftables.Map{{Name: "verdicts", KeyType: linux_nftables.ElementType_ETHER_ADDR}},
+			},
+			expectedErr: ErrFieldNotMatchingSet,
+		},
+		{
+			name: "verdict with verdict map",
+			table: &linux_nftables.Table{
+				Name: "filter",
+				Chains: chain(&linux_nftables.Rule{
+					Protocol: linux_nftables.Rule_UDP,
+					VerdictMap: &linux_nftables.Rule_VerdictMapLookup{
+						Map: "verdicts", Field: linux_nftables.MatchField_DST_PORT,
+					},
+					Verdict: linux_nftables.Verdict_DROP,
+				}),
+				Maps: []*linux_nftables.Map{{Name: "verdicts", KeyType: linux_nftables.ElementType_INET_SERVICE}},
+			},
+			expectedErr: ErrVerdictWithVerdictMap,
+		},
+		{
+			name: "ports without protocol",
+			table: &linux_nftables.Table{
+				Name: "filter",
+				Chains: chain(&linux_nftables.Rule{
+					DstPorts: &linux_nftables.Rule_PortRange{LowerPort: 80},
+				}),
+			},
+			expectedErr: ErrPortsWithoutProtocol,
+		},
+		{
+			name: "IPv6 network in IPv4 table",
+			table: &linux_nftables.Table{
+				Name:   "filter",
+				Family: linux_nftables.Table_IPV4,
+				Chains: chain(&linux_nftables.Rule{DstNetwork: "fd00::/64"}),
+			},
+			expectedErr: ErrNetworkNotMatchingFamily,
+		},
+		{
+			name: "undefined target chain",
+			table: &linux_nftables.Table{
+				Name: "filter",
+				Chains: chain(&linux_nftables.Rule{
+					Verdict:     linux_nftables.Verdict_GOTO,
+					TargetChain: "undefined",
+				}),
+			},
+			expectedErr: ErrUndefinedChain,
+		},
+	}
+
+	d := &TableDescriptor{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			err := d.Validate("", test.table)
+			if test.expectedErr == nil {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			Expect(err).To(BeAssignableToTypeOf(&kvs.InvalidValueError{}))
+			Expect(errors.Is(err.(*kvs.InvalidValueError).GetValidationError(), test.expectedErr)).To(BeTrue())
+		})
+	}
+}
+
+func TestTableKey(t *testing.T) {
+	RegisterTestingT(t)
+
+	ns := &linux_namespace.NetNamespace{Type: linux_namespace.NetNamespace_NSID, Reference: "ns1"}
+	key := linux_nftables.TableKey("filter", linux_nftables.Table_IPV4, nil)
+	Expect(linux_nftables.ModelTable.IsKeyValid(key)).To(BeTrue())
+	Expect(key).ToNot(Equal(linux_nftables.TableKey("filter", linux_nftables.Table_IPV6, nil)))
+	Expect(key).ToNot(Equal(linux_nftables.TableKey("filter", linux_nftables.Table_IPV4, ns)))
+	Expect(linux_nftables.TableKey("filter", linux_nftables.Table_IPV4, &linux_namespace.NetNamespace{})).To(Equal(key))
+}
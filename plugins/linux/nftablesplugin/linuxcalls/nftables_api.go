@@ -0,0 +1,53 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+)
+
+// NftablesAPI interface covers all methods inside linux calls package needed
+// to manage linux nftables tables. The methods operate in the network namespace
+// of the calling OS thread.
+type NftablesAPI interface {
+	NftablesAPIWrite
+	NftablesAPIRead
+}
+
+// NftablesAPIWrite interface covers write methods inside linux calls package
+// needed to manage linux nftables tables.
+type NftablesAPIWrite interface {
+	// SetTable (re)creates the table with all its chains, sets, maps and rules
+	// in a single netlink batch, i.e. atomically. Logical names of interfaces
+	// referenced in rules are translated to host names using <hostIfNames>.
+	SetTable(table *linux_nftables.Table, hostIfNames map[string]string) error
+
+	// DeleteTable removes the table with all its content.
+	DeleteTable(family linux_nftables.Table_Family, name string) error
+}
+
+// NftablesAPIRead interface covers read methods inside linux calls package
+// needed to manage linux nftables tables.
+type NftablesAPIRead interface {
+	// GetTable dumps the table with all its content. Nil is returned if the table
+	// does not exist. Host names of interfaces referenced in rules are translated
+	// to logical names using <ifNames>.
+	GetTable(family linux_nftables.Table_Family, name string, ifNames map[string]string) (*linux_nftables.Table, error)
+}
+
+// NewNftablesHandler creates new instance of nftables handler.
+func NewNftablesHandler() *NftablesHandler {
+	return &NftablesHandler{}
+}
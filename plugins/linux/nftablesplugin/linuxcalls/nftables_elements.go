@@ -0,0 +1,264 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+)
+
+// ParseElement parses set element (or map key) of the given type into its
+// netlink representation. For interval sets, the returned <end> is the first
+// value beyond the interval (nil if the interval reaches the maximum value),
+// for other sets <end> is always nil.
+func ParseElement(typ linux_nftables.ElementType, interval bool, elem string) (start, end []byte, err error) {
+	var last []byte
+	switch typ {
+	case linux_nftables.ElementType_IPV4_ADDR, linux_nftables.ElementType_IPV6_ADDR:
+		start, last, err = parseAddrInterval(typ, elem)
+	case linux_nftables.ElementType_ETHER_ADDR:
+		var mac net.HardwareAddr
+		mac, err = net.ParseMAC(elem)
+		if err == nil && len(mac) != 6 {
+			err = fmt.Errorf("invalid MAC address %q", elem)
+		}
+		start, last = mac, mac
+	case linux_nftables.ElementType_INET_SERVICE:
+		start, last, err = parsePortInterval(elem)
+	default:
+		err = fmt.Errorf("unsupported element type %v", typ)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !interval {
+		if !bytes.Equal(start, last) {
+			return nil, nil, fmt.Errorf("element %q is an interval, but the set is not", elem)
+		}
+		return start, nil, nil
+	}
+	return start, nextValue(last), nil
+}
+
+// FormatElement renders element of the given type from its netlink
+// representation, <first> and <last> are the (inclusive) bounds
+// of the interval and they are equal for single values.
+func FormatElement(typ linux_nftables.ElementType, first, last []byte) string {
+	single := bytes.Equal(first, last)
+
+	switch typ {
+	case linux_nftables.ElementType_IPV4_ADDR, linux_nftables.ElementType_IPV6_ADDR:
+		from, _ := netip.AddrFromSlice(first)
+		if single {
+			return from.String()
+		}
+		if prefixLen, ok := intervalPrefix(first, last); ok {
+			return netip.PrefixFrom(from, prefixLen).String()
+		}
+		to, _ := netip.AddrFromSlice(last)
+		return from.String() + "-" + to.String()
+	case linux_nftables.ElementType_ETHER_ADDR:
+		return net.HardwareAddr(first).String()
+	case linux_nftables.ElementType_INET_SERVICE:
+		from := strconv.Itoa(int(binary.BigEndian.Uint16(first)))
+		if single {
+			return from
+		}
+		return from + "-" + strconv.Itoa(int(binary.BigEndian.Uint16(last)))
+	}
+	return fmt.Sprintf("%x", first)
+}
+
+// NormalizeElement returns the element in the canonical format
+// (i.e. as the element is rendered when it is retrieved).
+func NormalizeElement(typ linux_nftables.ElementType, interval bool, elem string) string {
+	start, end, err := ParseElement(typ, interval, elem)
+	if err != nil {
+		return elem
+	}
+	return FormatElement(typ, start, intervalLast(start, end, interval))
+}
+
+// intervalLast returns the last value of the interval starting at <start>
+// and ending before <end>.
+func intervalLast(start, end []byte, interval bool) []byte {
+	switch {
+	case !interval:
+		return start
+	case end == nil:
+		return bytes.Repeat([]byte{0xff}, len(start))
+	default:
+		return prevValue(end)
+	}
+}
+
+// ElementTypeOf determines the element type from the length of the element
+// in the netlink representation.
+func ElementTypeOf(key []byte) (linux_nftables.ElementType, bool) {
+	switch len(key) {
+	case net.IPv4len:
+		return linux_nftables.ElementType_IPV4_ADDR, true
+	case net.IPv6len:
+		return linux_nftables.ElementType_IPV6_ADDR, true
+	case 6:
+		return linux_nftables.ElementType_ETHER_ADDR, true
+	case 2:
+		return linux_nftables.ElementType_INET_SERVICE, true
+	}
+	return 0, false
+}
+
+// parseAddrInterval parses IP address, network prefix or range of addresses.
+func parseAddrInterval(typ linux_nftables.ElementType, elem string) (first, last []byte, err error) {
+	parseAddr := func(s string) (netip.Addr, error) {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return addr, err
+		}
+		if addr.Is4() != (typ == linux_nftables.ElementType_IPV4_ADDR) {
+			return addr, fmt.Errorf("address %q does not match element type %v", s, typ)
+		}
+		return addr, nil
+	}
+	switch {
+	case strings.Contains(elem, "-"):
+		parts := strings.SplitN(elem, "-", 2)
+		from, err := parseAddr(strings.TrimSpace(parts[0]))
+		if err != nil {
+			return nil, nil, err
+		}
+		to, err := parseAddr(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, nil, err
+		}
+		if to.Less(from) {
+			return nil, nil, fmt.Errorf("invalid address range %q", elem)
+		}
+		return from.AsSlice(), to.AsSlice(), nil
+	case strings.Contains(elem, "/"):
+		prefix, err := netip.ParsePrefix(elem)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err = parseAddr(prefix.Addr().String()); err != nil {
+			return nil, nil, err
+		}
+		first = prefix.Masked().Addr().AsSlice()
+		last = make([]byte, len(first))
+		for i := range first {
+			netBits := prefix.Bits() - 8*i
+			switch {
+			case netBits >= 8:
+				last[i] = first[i]
+			case netBits <= 0:
+				last[i] = 0xff
+			default:
+				last[i] = first[i] | byte(0xff>>netBits)
+			}
+		}
+		return first, last, nil
+	default:
+		addr, err := parseAddr(elem)
+		if err != nil {
+			return nil, nil, err
+		}
+		return addr.AsSlice(), addr.AsSlice(), nil
+	}
+}
+
+// parsePortInterval parses L4 port or range of ports.
+func parsePortInterval(elem string) (first, last []byte, err error) {
+	parts := strings.SplitN(elem, "-", 2)
+	ports := make([]uint16, len(parts))
+	for i, part := range parts {
+		port, err := strconv.ParseUint(strings.TrimSpace(part), 10, 16)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid port %q: %w", part, err)
+		}
+		ports[i] = uint16(port)
+	}
+	first = make([]byte, 2)
+	binary.BigEndian.PutUint16(first, ports[0])
+	last = first
+	if len(ports) > 1 {
+		if ports[1] < ports[0] {
+			return nil, nil, fmt.Errorf("invalid port range %q", elem)
+		}
+		last = make([]byte, 2)
+		binary.BigEndian.PutUint16(last, ports[1])
+	}
+	return first, last, nil
+}
+
+// intervalPrefix checks if the interval <first, last> is a network prefix.
+func intervalPrefix(first, last []byte) (int, bool) {
+	prefixLen := 0
+	for i := range first {
+		diff := first[i] ^ last[i]
+		if diff == 0 {
+			prefixLen += 8
+			continue
+		}
+		lead := bits.LeadingZeros8(diff)
+		hostMask := byte(0xff >> lead)
+		if diff != hostMask || first[i]&hostMask != 0 {
+			return 0, false
+		}
+		for _, b := range first[i+1:] {
+			if b != 0 {
+				return 0, false
+			}
+		}
+		for _, b := range last[i+1:] {
+			if b != 0xff {
+				return 0, false
+			}
+		}
+		return prefixLen + lead, true
+	}
+	return prefixLen, true
+}
+
+// nextValue returns value incremented by one or nil on overflow.
+func nextValue(val []byte) []byte {
+	next := append([]byte(nil), val...)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			return next
+		}
+	}
+	return nil
+}
+
+// prevValue returns value decremented by one.
+func prevValue(val []byte) []byte {
+	prev := append([]byte(nil), val...)
+	for i := len(prev) - 1; i >= 0; i-- {
+		prev[i]--
+		if prev[i] != 0xff {
+			break
+		}
+	}
+	return prev
+}
@@ -0,0 +1,417 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+	"github.com/mdlayher/netlink"
+	"golang.org/x/sys/unix"
+
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+)
+
+var (
+	tableFamilies = map[linux_nftables.Table_Family]nftables.TableFamily{
+		linux_nftables.Table_INET:   nftables.TableFamilyINet,
+		linux_nftables.Table_IPV4:   nftables.TableFamilyIPv4,
+		linux_nftables.Table_IPV6:   nftables.TableFamilyIPv6,
+		linux_nftables.Table_ARP:    nftables.TableFamilyARP,
+		linux_nftables.Table_BRIDGE: nftables.TableFamilyBridge,
+	}
+
+	chainTypes = map[linux_nftables.Chain_Type]nftables.ChainType{
+		linux_nftables.Chain_FILTER: nftables.ChainTypeFilter,
+		linux_nftables.Chain_NAT:    nftables.ChainTypeNAT,
+		linux_nftables.Chain_ROUTE:  nftables.ChainTypeRoute,
+	}
+
+	chainHooks = map[linux_nftables.Chain_Hook]nftables.ChainHook{
+		linux_nftables.Chain_PREROUTING:  unix.NF_INET_PRE_ROUTING,
+		linux_nftables.Chain_INPUT:       unix.NF_INET_LOCAL_IN,
+		linux_nftables.Chain_FORWARD:     unix.NF_INET_FORWARD,
+		linux_nftables.Chain_OUTPUT:      unix.NF_INET_LOCAL_OUT,
+		linux_nftables.Chain_POSTROUTING: unix.NF_INET_POST_ROUTING,
+	}
+
+	elementTypes = map[linux_nftables.ElementType]nftables.SetDatatype{
+		linux_nftables.ElementType_IPV4_ADDR:    nftables.TypeIPAddr,
+		linux_nftables.ElementType_IPV6_ADDR:    nftables.TypeIP6Addr,
+		linux_nftables.ElementType_ETHER_ADDR:   nftables.TypeEtherAddr,
+		linux_nftables.ElementType_INET_SERVICE: nftables.TypeInetService,
+	}
+)
+
+// NftablesHandler is a handler for all operations on Linux nftables.
+type NftablesHandler struct{}
+
+// SetTable (re)creates the table with all its chains, sets, maps and rules
+// in a single netlink batch.
+func (h *NftablesHandler) SetTable(table *linux_nftables.Table, hostIfNames map[string]string) error {
+	conn, err := nftables.New()
+	if err != nil {
+		return err
+	}
+	family := tableFamilies[table.Family]
+
+	existing, err := lookupTable(conn, family, table.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		conn.DelTable(existing)
+	}
+	t := conn.AddTable(&nftables.Table{Name: table.Name, Family: family})
+
+	// chains go first, they can be referenced by elements of the verdict maps
+	chains := make(map[string]*nftables.Chain)
+	for _, chain := range table.Chains {
+		chains[chain.Name] = conn.AddChain(nftChain(t, chain))
+	}
+
+	builder := &ruleBuilder{
+		family:      table.Family,
+		sets:        make(map[string]*nftables.Set),
+		elemTypes:   make(map[string]linux_nftables.ElementType),
+		hostIfNames: hostIfNames,
+	}
+	for _, set := range table.Sets {
+		s := &nftables.Set{
+			Table:    t,
+			Name:     set.Name,
+			Interval: set.Interval,
+			KeyType:  elementTypes[set.Type],
+		}
+		var elems []nftables.SetElement
+		for _, elem := range set.Elements {
+			start, end, err := ParseElement(set.Type, set.Interval, elem)
+			if err != nil {
+				return fmt.Errorf("invalid element of set %q: %w", set.Name, err)
+			}
+			elems = append(elems, nftables.SetElement{Key: start})
+			if end != nil {
+				elems = append(elems, nftables.SetElement{Key: end, IntervalEnd: true})
+			}
+		}
+		if err = conn.AddSet(s, elems); err != nil {
+			return fmt.Errorf("failed to add set %q: %w", set.Name, err)
+		}
+		builder.sets[set.Name] = s
+		builder.elemTypes[set.Name] = set.Type
+	}
+	for _, vmap := range table.Maps {
+		s := &nftables.Set{
+			Table:    t,
+			Name:     vmap.Name,
+			Interval: vmap.Interval,
+			IsMap:    true,
+			KeyType:  elementTypes[vmap.KeyType],
+			DataType: nftables.TypeVerdict,
+		}
+		var elems []nftables.SetElement
+		for _, elem := range vmap.Elements {
+			start, end, err := ParseElement(vmap.KeyType, vmap.Interval, elem.Key)
+			if err != nil {
+				return fmt.Errorf("invalid element of map %q: %w", vmap.Name, err)
+			}
+			elems = append(elems, nftables.SetElement{
+				Key:         start,
+				VerdictData: ruleVerdict(elem.Verdict, elem.TargetChain),
+			})
+			if end != nil {
+				elems = append(elems, nftables.SetElement{Key: end, IntervalEnd: true})
+			}
+		}
+		if err = conn.AddSet(s, elems); err != nil {
+			return fmt.Errorf("failed to add map %q: %w", vmap.Name, err)
+		}
+		builder.sets[vmap.Name] = s
+		builder.elemTypes[vmap.Name] = vmap.KeyType
+	}
+
+	for _, chain := range table.Chains {
+		for i, rule := range chain.Rules {
+			exprs, err := builder.build(rule)
+			if err != nil {
+				return fmt.Errorf("invalid rule #%d of chain %q: %w", i, chain.Name, err)
+			}
+			conn.AddRule(&nftables.Rule{
+				Table:    t,
+				Chain:    chains[chain.Name],
+				Exprs:    exprs,
+				UserData: ruleUserData(rule.Comment),
+			})
+		}
+	}
+	return conn.Flush()
+}
+
+// DeleteTable removes the table with all its content.
+func (h *NftablesHandler) DeleteTable(family linux_nftables.Table_Family, name string) error {
+	conn, err := nftables.New()
+	if err != nil {
+		return err
+	}
+	t, err := lookupTable(conn, tableFamilies[family], name)
+	if err != nil || t == nil {
+		return err
+	}
+	conn.DelTable(t)
+	return conn.Flush()
+}
+
+// GetTable dumps the table with all its content.
+func (h *NftablesHandler) GetTable(family linux_nftables.Table_Family, name string, ifNames map[string]string) (*linux_nftables.Table, error) {
+	conn, err := nftables.New()
+	if err != nil {
+		return nil, err
+	}
+	t, err := lookupTable(conn, tableFamilies[family], name)
+	if err != nil || t == nil {
+		return nil, err
+	}
+	table := &linux_nftables.Table{
+		Name:   name,
+		Family: family,
+	}
+
+	sets, err := conn.GetSets(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sets: %w", err)
+	}
+	for _, s := range sets {
+		if s.Anonymous {
+			continue
+		}
+		elems, err := conn.GetSetElements(s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list elements of set %q: %w", s.Name, err)
+		}
+		if s.IsMap {
+			vmap, err := fromNftMap(s, elems)
+			if err != nil {
+				return nil, err
+			}
+			table.Maps = append(table.Maps, vmap)
+		} else {
+			table.Sets = append(table.Sets, fromNftSet(s, elems))
+		}
+	}
+
+	chains, err := conn.ListChainsOfTableFamily(t.Family)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chains: %w", err)
+	}
+	parser := &ruleParser{ifNames: ifNames}
+	for _, c := range chains {
+		if c.Table.Name != name {
+			continue
+		}
+		chain := fromNftChain(c)
+		rules, err := conn.GetRules(t, c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list rules of chain %q: %w", c.Name, err)
+		}
+		for _, r := range rules {
+			rule, err := parser.parse(r.Exprs, r.UserData)
+			if err != nil {
+				// rule not created by the agent, keep it as unknown
+				rule = &linux_nftables.Rule{Comment: fmt.Sprintf("unsupported rule: %v", err)}
+			}
+			chain.Rules = append(chain.Rules, rule)
+		}
+		table.Chains = append(table.Chains, chain)
+	}
+	return table, nil
+}
+
+// lookupTable returns the table or nil if it does not exist.
+func lookupTable(conn *nftables.Conn, family nftables.TableFamily, name string) (*nftables.Table, error) {
+	tables, err := conn.ListTablesOfFamily(family)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	for _, t := range tables {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+// nftChain converts chain to its nftables representation.
+func nftChain(t *nftables.Table, chain *linux_nftables.Chain) *nftables.Chain {
+	c := &nftables.Chain{
+		Name:  chain.Name,
+		Table: t,
+	}
+	if chain.Hook == linux_nftables.Chain_NONE {
+		return c
+	}
+	hook := chainHooks[chain.Hook]
+	priority := nftables.ChainPriority(chain.Priority)
+	policy := nftables.ChainPolicyAccept
+	if chain.Policy == linux_nftables.Chain_DROP {
+		policy = nftables.ChainPolicyDrop
+	}
+	c.Type = chainTypes[chain.Type]
+	c.Hooknum = &hook
+	c.Priority = &priority
+	c.Policy = &policy
+	return c
+}
+
+// fromNftChain converts chain from its nftables representation (without rules).
+func fromNftChain(c *nftables.Chain) *linux_nftables.Chain {
+	chain := &linux_nftables.Chain{Name: c.Name}
+	if c.Hooknum == nil {
+		return chain
+	}
+	for hook, num := range chainHooks {
+		if num == *c.Hooknum {
+			chain.Hook = hook
+		}
+	}
+	for typ, name := range chainTypes {
+		if name == c.Type {
+			chain.Type = typ
+		}
+	}
+	if c.Priority != nil {
+		chain.Priority = int32(*c.Priority)
+	}
+	if c.Policy != nil && *c.Policy == nftables.ChainPolicyDrop {
+		chain.Policy = linux_nftables.Chain_DROP
+	}
+	return chain
+}
+
+// setInterval is an interval (or a single value) of set elements
+// with the verdict for maps.
+type setInterval struct {
+	first, last []byte
+	verdict     *expr.Verdict
+}
+
+// setIntervals groups the dumped set elements into intervals.
+func setIntervals(interval bool, elems []nftables.SetElement) []setInterval {
+	sort.Slice(elems, func(i, j int) bool {
+		if c := bytes.Compare(elems[i].Key, elems[j].Key); c != 0 {
+			return c < 0
+		}
+		// end of the previous interval goes before the start of the next one
+		return elems[i].IntervalEnd && !elems[j].IntervalEnd
+	})
+	var intervals []setInterval
+	for i, elem := range elems {
+		if elem.IntervalEnd {
+			continue
+		}
+		first := elem.Key
+		last := first
+		if interval {
+			var end []byte
+			if i+1 < len(elems) && elems[i+1].IntervalEnd {
+				end = elems[i+1].Key
+			}
+			last = intervalLast(first, end, true)
+		}
+		intervals = append(intervals, setInterval{
+			first:   first,
+			last:    last,
+			verdict: decodeVerdict(elem.Val),
+		})
+	}
+	return intervals
+}
+
+// fromNftSet converts set from its nftables representation.
+func fromNftSet(s *nftables.Set, elems []nftables.SetElement) *linux_nftables.Set {
+	set := &linux_nftables.Set{
+		Name:     s.Name,
+		Type:     fromNftElementType(s.KeyType, elems),
+		Interval: s.Interval,
+	}
+	for _, in := range setIntervals(s.Interval, elems) {
+		set.Elements = append(set.Elements, FormatElement(set.Type, in.first, in.last))
+	}
+	return set
+}
+
+// fromNftMap converts verdict map from its nftables representation.
+func fromNftMap(s *nftables.Set, elems []nftables.SetElement) (*linux_nftables.Map, error) {
+	vmap := &linux_nftables.Map{
+		Name:     s.Name,
+		KeyType:  fromNftElementType(s.KeyType, elems),
+		Interval: s.Interval,
+	}
+	for _, in := range setIntervals(s.Interval, elems) {
+		if in.verdict == nil {
+			return nil, fmt.Errorf("map %q has element without verdict", s.Name)
+		}
+		verdict, targetChain, _ := fromVerdict(in.verdict)
+		vmap.Elements = append(vmap.Elements, &linux_nftables.Map_Element{
+			Key:         FormatElement(vmap.KeyType, in.first, in.last),
+			Verdict:     verdict,
+			TargetChain: targetChain,
+		})
+	}
+	return vmap, nil
+}
+
+// fromNftElementType determines type of the set elements. The dumped key type
+// is not reliable for verdict maps, hence the elements are checked first.
+func fromNftElementType(keyType nftables.SetDatatype, elems []nftables.SetElement) linux_nftables.ElementType {
+	if len(elems) > 0 {
+		if typ, ok := ElementTypeOf(elems[0].Key); ok {
+			return typ
+		}
+	}
+	for typ, datatype := range elementTypes {
+		if datatype.Name == keyType.Name {
+			return typ
+		}
+	}
+	return 0
+}
+
+// decodeVerdict decodes verdict data of a map element.
+func decodeVerdict(data []byte) *expr.Verdict {
+	if len(data) == 0 {
+		return nil
+	}
+	ad, err := netlink.NewAttributeDecoder(data)
+	if err != nil {
+		return nil
+	}
+	ad.ByteOrder = binary.BigEndian
+	var verdict *expr.Verdict
+	for ad.Next() {
+		switch ad.Type() {
+		case unix.NFTA_VERDICT_CODE:
+			verdict = &expr.Verdict{Kind: expr.VerdictKind(int32(ad.Uint32()))}
+		case unix.NFTA_VERDICT_CHAIN:
+			if verdict != nil {
+				verdict.Chain = ad.String()
+			}
+		}
+	}
+	return verdict
+}
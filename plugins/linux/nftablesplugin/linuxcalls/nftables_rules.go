@@ -0,0 +1,597 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"golang.org/x/sys/unix"
+
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+)
+
+const (
+	// the register used by all the matches of a rule
+	matchReg = 1
+	// the register for the verdict returned by a map lookup
+	verdictReg = 0
+
+	// interface name size used by the kernel
+	ifNameSize = 16
+
+	// type of the rule comment in the rule user data (as used by the nft tool)
+	udataComment = 0
+)
+
+// offsets of the matched header fields
+const (
+	ipv4SrcOffset   = 12
+	ipv4DstOffset   = 16
+	ipv6SrcOffset   = 8
+	ipv6DstOffset   = 24
+	etherSrcOffset  = 6
+	etherDstOffset  = 0
+	l4SrcPortOffset = 0
+	l4DstPortOffset = 2
+)
+
+// ethertypes matched in the bridge tables
+const (
+	ethTypeIPv4 = 0x0800
+	ethTypeIPv6 = 0x86dd
+)
+
+var (
+	l4Protocols = map[linux_nftables.Rule_Protocol]byte{
+		linux_nftables.Rule_TCP:    unix.IPPROTO_TCP,
+		linux_nftables.Rule_UDP:    unix.IPPROTO_UDP,
+		linux_nftables.Rule_SCTP:   unix.IPPROTO_SCTP,
+		linux_nftables.Rule_ICMP:   unix.IPPROTO_ICMP,
+		linux_nftables.Rule_ICMPV6: unix.IPPROTO_ICMPV6,
+	}
+
+	connStates = map[linux_nftables.Rule_ConnState]uint32{
+		linux_nftables.Rule_NEW:         expr.CtStateBitNEW,
+		linux_nftables.Rule_ESTABLISHED: expr.CtStateBitESTABLISHED,
+		linux_nftables.Rule_RELATED:     expr.CtStateBitRELATED,
+		linux_nftables.Rule_INVALID:     expr.CtStateBitINVALID,
+		linux_nftables.Rule_UNTRACKED:   expr.CtStateBitUNTRACKED,
+	}
+
+	verdictKinds = map[linux_nftables.Verdict]expr.VerdictKind{
+		linux_nftables.Verdict_ACCEPT: expr.VerdictAccept,
+		linux_nftables.Verdict_DROP:   expr.VerdictDrop,
+		linux_nftables.Verdict_RETURN: expr.VerdictReturn,
+		linux_nftables.Verdict_JUMP:   expr.VerdictJump,
+		linux_nftables.Verdict_GOTO:   expr.VerdictGoto,
+	}
+)
+
+// ruleBuilder translates rules of the table into nftables expressions.
+type ruleBuilder struct {
+	family      linux_nftables.Table_Family
+	sets        map[string]*nftables.Set
+	elemTypes   map[string]linux_nftables.ElementType
+	hostIfNames map[string]string
+}
+
+// build returns expressions of the rule.
+func (b *ruleBuilder) build(rule *linux_nftables.Rule) ([]expr.Any, error) {
+	var exprs []expr.Any
+
+	if rule.InInterface != "" {
+		exprs = append(exprs, b.matchIfName(expr.MetaKeyIIFNAME, rule.InInterface)...)
+	}
+	if rule.OutInterface != "" {
+		exprs = append(exprs, b.matchIfName(expr.MetaKeyOIFNAME, rule.OutInterface)...)
+	}
+	if rule.Protocol != linux_nftables.Rule_ANY {
+		exprs = append(exprs,
+			&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: matchReg},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: matchReg, Data: []byte{l4Protocols[rule.Protocol]}},
+		)
+	}
+	for _, network := range []struct {
+		prefix string
+		src    bool
+	}{{rule.SrcNetwork, true}, {rule.DstNetwork, false}} {
+		if network.prefix == "" {
+			continue
+		}
+		match, err := b.matchNetwork(network.prefix, network.src)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, match...)
+	}
+	if rule.SrcPorts != nil {
+		exprs = append(exprs, matchPorts(rule.SrcPorts, l4SrcPortOffset)...)
+	}
+	if rule.DstPorts != nil {
+		exprs = append(exprs, matchPorts(rule.DstPorts, l4DstPortOffset)...)
+	}
+	for _, setMatch := range rule.SetMatches {
+		set, ok := b.sets[setMatch.Set]
+		if !ok {
+			return nil, fmt.Errorf("undefined set %q", setMatch.Set)
+		}
+		exprs = append(exprs, b.loadField(b.elemTypes[setMatch.Set], setMatch.Field)...)
+		exprs = append(exprs, &expr.Lookup{
+			SourceRegister: matchReg,
+			SetID:          set.ID,
+			SetName:        set.Name,
+			Invert:         setMatch.Invert,
+		})
+	}
+	if len(rule.ConnStates) > 0 {
+		var mask uint32
+		for _, state := range rule.ConnStates {
+			mask |= connStates[state]
+		}
+		exprs = append(exprs,
+			&expr.Ct{Register: matchReg, Key: expr.CtKeySTATE},
+			&expr.Bitwise{
+				SourceRegister: matchReg,
+				DestRegister:   matchReg,
+				Len:            4,
+				Mask:           binaryutil.NativeEndian.PutUint32(mask),
+				Xor:            binaryutil.NativeEndian.PutUint32(0),
+			},
+			&expr.Cmp{Op: expr.CmpOpNeq, Register: matchReg, Data: binaryutil.NativeEndian.PutUint32(0)},
+		)
+	}
+	if rule.Counter {
+		exprs = append(exprs, &expr.Counter{})
+	}
+	if vmap := rule.VerdictMap; vmap != nil {
+		set, ok := b.sets[vmap.Map]
+		if !ok {
+			return nil, fmt.Errorf("undefined map %q", vmap.Map)
+		}
+		exprs = append(exprs, b.loadField(b.elemTypes[vmap.Map], vmap.Field)...)
+		exprs = append(exprs, &expr.Lookup{
+			SourceRegister: matchReg,
+			DestRegister:   verdictReg,
+			IsDestRegSet:   true,
+			SetID:          set.ID,
+			SetName:        set.Name,
+		})
+	}
+	if verdict := ruleVerdict(rule.Verdict, rule.TargetChain); verdict != nil {
+		exprs = append(exprs, verdict)
+	}
+	return exprs, nil
+}
+
+// matchIfName matches name of the input/output interface.
+func (b *ruleBuilder) matchIfName(key expr.MetaKey, ifName string) []expr.Any {
+	hostIfName, ok := b.hostIfNames[ifName]
+	if !ok {
+		hostIfName = ifName
+	}
+	return []expr.Any{
+		&expr.Meta{Key: key, Register: matchReg},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: matchReg, Data: ifNameData(hostIfName)},
+	}
+}
+
+// matchNetwork matches source or destination IP address against the network.
+func (b *ruleBuilder) matchNetwork(network string, src bool) ([]expr.Any, error) {
+	prefix, err := ParseNetwork(network)
+	if err != nil {
+		return nil, err
+	}
+	elemType := linux_nftables.ElementType_IPV4_ADDR
+	if !prefix.Addr().Is4() {
+		elemType = linux_nftables.ElementType_IPV6_ADDR
+	}
+	field := linux_nftables.MatchField_DST_ADDR
+	if src {
+		field = linux_nftables.MatchField_SRC_ADDR
+	}
+	exprs := b.loadField(elemType, field)
+	addr := prefix.Addr().AsSlice()
+	if prefix.Bits() < len(addr)*8 {
+		mask := make([]byte, len(addr))
+		for i := 0; i < prefix.Bits(); i++ {
+			mask[i/8] |= 0x80 >> (i % 8)
+		}
+		exprs = append(exprs, &expr.Bitwise{
+			SourceRegister: matchReg,
+			DestRegister:   matchReg,
+			Len:            uint32(len(addr)),
+			Mask:           mask,
+			Xor:            make([]byte, len(addr)),
+		})
+	}
+	return append(exprs, &expr.Cmp{Op: expr.CmpOpEq, Register: matchReg, Data: addr}), nil
+}
+
+// loadField loads the packet field into the match register.
+func (b *ruleBuilder) loadField(elemType linux_nftables.ElementType, field linux_nftables.MatchField) []expr.Any {
+	src := field == linux_nftables.MatchField_SRC_ADDR || field == linux_nftables.MatchField_SRC_PORT
+
+	switch elemType {
+	case linux_nftables.ElementType_IPV4_ADDR:
+		offset := uint32(ipv4DstOffset)
+		if src {
+			offset = ipv4SrcOffset
+		}
+		return append(b.matchL3Proto(false), &expr.Payload{
+			DestRegister: matchReg,
+			Base:         expr.PayloadBaseNetworkHeader,
+			Offset:       offset,
+			Len:          4,
+		})
+	case linux_nftables.ElementType_IPV6_ADDR:
+		offset := uint32(ipv6DstOffset)
+		if src {
+			offset = ipv6SrcOffset
+		}
+		return append(b.matchL3Proto(true), &expr.Payload{
+			DestRegister: matchReg,
+			Base:         expr.PayloadBaseNetworkHeader,
+			Offset:       offset,
+			Len:          16,
+		})
+	case linux_nftables.ElementType_ETHER_ADDR:
+		offset := uint32(etherDstOffset)
+		if src {
+			offset = etherSrcOffset
+		}
+		return []expr.Any{&expr.Payload{
+			DestRegister: matchReg,
+			Base:         expr.PayloadBaseLLHeader,
+			Offset:       offset,
+			Len:          6,
+		}}
+	default:
+		offset := uint32(l4DstPortOffset)
+		if src {
+			offset = l4SrcPortOffset
+		}
+		return []expr.Any{&expr.Payload{
+			DestRegister: matchReg,
+			Base:         expr.PayloadBaseTransportHeader,
+			Offset:       offset,
+			Len:          2,
+		}}
+	}
+}
+
+// matchL3Proto matches the L3 protocol in tables of families
+// which are not restricted to a single IP version.
+func (b *ruleBuilder) matchL3Proto(ipv6 bool) []expr.Any {
+	switch b.family {
+	case linux_nftables.Table_INET:
+		proto := byte(unix.NFPROTO_IPV4)
+		if ipv6 {
+			proto = unix.NFPROTO_IPV6
+		}
+		return []expr.Any{
+			&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: matchReg},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: matchReg, Data: []byte{proto}},
+		}
+	case linux_nftables.Table_BRIDGE:
+		ethType := uint16(ethTypeIPv4)
+		if ipv6 {
+			ethType = ethTypeIPv6
+		}
+		return []expr.Any{
+			&expr.Meta{Key: expr.MetaKeyPROTOCOL, Register: matchReg},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: matchReg, Data: binaryutil.BigEndian.PutUint16(ethType)},
+		}
+	}
+	return nil
+}
+
+// matchPorts matches L4 port against the range.
+func matchPorts(ports *linux_nftables.Rule_PortRange, offset uint32) []expr.Any {
+	exprs := []expr.Any{&expr.Payload{
+		DestRegister: matchReg,
+		Base:         expr.PayloadBaseTransportHeader,
+		Offset:       offset,
+		Len:          2,
+	}}
+	lower := binaryutil.BigEndian.PutUint16(uint16(ports.LowerPort))
+	if ports.UpperPort <= ports.LowerPort {
+		return append(exprs, &expr.Cmp{Op: expr.CmpOpEq, Register: matchReg, Data: lower})
+	}
+	return append(exprs, &expr.Range{
+		Op:       expr.CmpOpEq,
+		Register: matchReg,
+		FromData: lower,
+		ToData:   binaryutil.BigEndian.PutUint16(uint16(ports.UpperPort)),
+	})
+}
+
+// ruleVerdict returns verdict expression or nil for CONTINUE.
+func ruleVerdict(verdict linux_nftables.Verdict, targetChain string) *expr.Verdict {
+	kind, ok := verdictKinds[verdict]
+	if !ok {
+		return nil
+	}
+	v := &expr.Verdict{Kind: kind}
+	if verdict == linux_nftables.Verdict_JUMP || verdict == linux_nftables.Verdict_GOTO {
+		v.Chain = targetChain
+	}
+	return v
+}
+
+// fromVerdict converts verdict expression to the NB verdict.
+func fromVerdict(v *expr.Verdict) (verdict linux_nftables.Verdict, targetChain string, ok bool) {
+	for verdict, kind := range verdictKinds {
+		if kind == v.Kind {
+			return verdict, v.Chain, true
+		}
+	}
+	return 0, "", v.Kind == expr.VerdictContinue
+}
+
+// ruleUserData encodes the comment of the rule in the format used by the nft tool.
+func ruleUserData(comment string) []byte {
+	if comment == "" {
+		return nil
+	}
+	data := append([]byte(comment), 0)
+	return append([]byte{udataComment, byte(len(data))}, data...)
+}
+
+// ruleComment decodes the comment from the rule user data.
+func ruleComment(udata []byte) string {
+	for len(udata) >= 2 {
+		typ, size := udata[0], int(udata[1])
+		if len(udata) < 2+size {
+			break
+		}
+		if typ == udataComment {
+			return strings.TrimRight(string(udata[2:2+size]), "\x00")
+		}
+		udata = udata[2+size:]
+	}
+	return ""
+}
+
+// ruleParser translates nftables expressions back into rules.
+type ruleParser struct {
+	ifNames map[string]string // host -> logical
+
+	// content of the match register
+	meta    *expr.Meta
+	payload *expr.Payload
+	ct      *expr.Ct
+	mask    []byte
+}
+
+// parse returns rule defined by the expressions. Error is returned
+// for expressions that were not created by the rule builder.
+func (p *ruleParser) parse(exprs []expr.Any, udata []byte) (*linux_nftables.Rule, error) {
+	rule := &linux_nftables.Rule{
+		Comment: ruleComment(udata),
+	}
+	p.load()
+	for _, e := range exprs {
+		switch e := e.(type) {
+		case *expr.Meta:
+			p.load()
+			p.meta = e
+		case *expr.Payload:
+			p.load()
+			p.payload = e
+		case *expr.Ct:
+			p.load()
+			p.ct = e
+		case *expr.Bitwise:
+			p.mask = e.Mask
+		case *expr.Cmp:
+			if err := p.parseCmp(rule, e); err != nil {
+				return nil, err
+			}
+		case *expr.Range:
+			field, ok := p.payloadField()
+			if !ok || p.payload.Base != expr.PayloadBaseTransportHeader {
+				return nil, fmt.Errorf("unsupported range match")
+			}
+			ports := &linux_nftables.Rule_PortRange{
+				LowerPort: uint32(binaryutil.BigEndian.Uint16(e.FromData)),
+				UpperPort: uint32(binaryutil.BigEndian.Uint16(e.ToData)),
+			}
+			if field == linux_nftables.MatchField_SRC_PORT {
+				rule.SrcPorts = ports
+			} else {
+				rule.DstPorts = ports
+			}
+		case *expr.Lookup:
+			field, ok := p.payloadField()
+			if !ok {
+				return nil, fmt.Errorf("unsupported lookup in set %q", e.SetName)
+			}
+			if e.IsDestRegSet {
+				rule.VerdictMap = &linux_nftables.Rule_VerdictMapLookup{Map: e.SetName, Field: field}
+			} else {
+				rule.SetMatches = append(rule.SetMatches, &linux_nftables.Rule_SetMatch{
+					Set:    e.SetName,
+					Field:  field,
+					Invert: e.Invert,
+				})
+			}
+		case *expr.Counter:
+			rule.Counter = true
+		case *expr.Verdict:
+			verdict, targetChain, ok := fromVerdict(e)
+			if !ok {
+				return nil, fmt.Errorf("unsupported verdict %v", e.Kind)
+			}
+			rule.Verdict = verdict
+			rule.TargetChain = targetChain
+		default:
+			return nil, fmt.Errorf("unsupported expression %T", e)
+		}
+	}
+	return rule, nil
+}
+
+// load resets the content of the match register.
+func (p *ruleParser) load() {
+	p.meta, p.payload, p.ct, p.mask = nil, nil, nil, nil
+}
+
+// parseCmp parses comparison with the content of the match register.
+func (p *ruleParser) parseCmp(rule *linux_nftables.Rule, cmp *expr.Cmp) error {
+	switch {
+	case p.meta != nil:
+		switch p.meta.Key {
+		case expr.MetaKeyIIFNAME:
+			rule.InInterface = p.ifName(cmp.Data)
+		case expr.MetaKeyOIFNAME:
+			rule.OutInterface = p.ifName(cmp.Data)
+		case expr.MetaKeyL4PROTO:
+			for protocol, num := range l4Protocols {
+				if len(cmp.Data) == 1 && cmp.Data[0] == num {
+					rule.Protocol = protocol
+					return nil
+				}
+			}
+			return fmt.Errorf("unsupported L4 protocol %v", cmp.Data)
+		case expr.MetaKeyNFPROTO, expr.MetaKeyPROTOCOL:
+			// implied by the matched addresses
+		default:
+			return fmt.Errorf("unsupported meta match %v", p.meta.Key)
+		}
+	case p.ct != nil:
+		if p.ct.Key != expr.CtKeySTATE || cmp.Op != expr.CmpOpNeq || len(p.mask) != 4 {
+			return fmt.Errorf("unsupported conntrack match")
+		}
+		mask := binaryutil.NativeEndian.Uint32(p.mask)
+		for state, bit := range connStates {
+			if mask&bit != 0 {
+				rule.ConnStates = append(rule.ConnStates, state)
+			}
+		}
+		sort.Slice(rule.ConnStates, func(i, j int) bool {
+			return rule.ConnStates[i] < rule.ConnStates[j]
+		})
+	case p.payload != nil:
+		field, ok := p.payloadField()
+		if !ok || cmp.Op != expr.CmpOpEq {
+			return fmt.Errorf("unsupported payload match")
+		}
+		switch field {
+		case linux_nftables.MatchField_SRC_ADDR, linux_nftables.MatchField_DST_ADDR:
+			addr, ok := netip.AddrFromSlice(cmp.Data)
+			if !ok {
+				return fmt.Errorf("unsupported address match")
+			}
+			prefixLen := addr.BitLen()
+			if p.mask != nil {
+				prefixLen = 0
+				for _, b := range p.mask {
+					prefixLen += bits.OnesCount8(b)
+				}
+			}
+			network := netip.PrefixFrom(addr, prefixLen).String()
+			if field == linux_nftables.MatchField_SRC_ADDR {
+				rule.SrcNetwork = network
+			} else {
+				rule.DstNetwork = network
+			}
+		default:
+			ports := &linux_nftables.Rule_PortRange{
+				LowerPort: uint32(binaryutil.BigEndian.Uint16(cmp.Data)),
+			}
+			if field == linux_nftables.MatchField_SRC_PORT {
+				rule.SrcPorts = ports
+			} else {
+				rule.DstPorts = ports
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported comparison")
+	}
+	return nil
+}
+
+// payloadField determines the packet field loaded into the match register.
+func (p *ruleParser) payloadField() (linux_nftables.MatchField, bool) {
+	if p.payload == nil {
+		return 0, false
+	}
+	switch p.payload.Base {
+	case expr.PayloadBaseNetworkHeader:
+		switch {
+		case p.payload.Len == 4 && p.payload.Offset == ipv4SrcOffset,
+			p.payload.Len == 16 && p.payload.Offset == ipv6SrcOffset:
+			return linux_nftables.MatchField_SRC_ADDR, true
+		case p.payload.Len == 4 && p.payload.Offset == ipv4DstOffset,
+			p.payload.Len == 16 && p.payload.Offset == ipv6DstOffset:
+			return linux_nftables.MatchField_DST_ADDR, true
+		}
+	case expr.PayloadBaseLLHeader:
+		switch {
+		case p.payload.Len == 6 && p.payload.Offset == etherSrcOffset:
+			return linux_nftables.MatchField_SRC_ADDR, true
+		case p.payload.Len == 6 && p.payload.Offset == etherDstOffset:
+			return linux_nftables.MatchField_DST_ADDR, true
+		}
+	case expr.PayloadBaseTransportHeader:
+		switch {
+		case p.payload.Len == 2 && p.payload.Offset == l4SrcPortOffset:
+			return linux_nftables.MatchField_SRC_PORT, true
+		case p.payload.Len == 2 && p.payload.Offset == l4DstPortOffset:
+			return linux_nftables.MatchField_DST_PORT, true
+		}
+	}
+	return 0, false
+}
+
+// ifName translates host interface name into the logical name.
+func (p *ruleParser) ifName(data []byte) string {
+	hostIfName := string(bytes.TrimRight(data, "\x00"))
+	if ifName, ok := p.ifNames[hostIfName]; ok {
+		return ifName
+	}
+	return hostIfName
+}
+
+// ParseNetwork parses IP address or network in CIDR notation.
+func ParseNetwork(network string) (netip.Prefix, error) {
+	if !strings.Contains(network, "/") {
+		addr, err := netip.ParseAddr(network)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(network)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
+
+// ifNameData returns interface name padded to the size used by the kernel.
+func ifNameData(name string) []byte {
+	data := make([]byte, ifNameSize)
+	copy(data, name+"\x00")
+	return data
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"testing"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+)
+
+func TestRuleBuildAndParse(t *testing.T) {
+	sets := map[string]*nftables.Set{
+		"ports": {Name: "ports", ID: 1},
+		"macs":  {Name: "macs", ID: 2},
+		"addrs": {Name: "addrs", ID: 3},
+	}
+	elemTypes := map[string]linux_nftables.ElementType{
+		"ports": linux_nftables.ElementType_INET_SERVICE,
+		"macs":  linux_nftables.ElementType_ETHER_ADDR,
+		"addrs": linux_nftables.ElementType_IPV4_ADDR,
+	}
+
+	tests := []struct {
+		name     string
+		family   linux_nftables.Table_Family
+		rule     *linux_nftables.Rule
+		expected *linux_nftables.Rule // nil if equal to the rule
+	}{
+		{
+			name:   "interfaces, networks and ports",
+			family: linux_nftables.Table_IPV4,
+			rule: &linux_nftables.Rule{
+				InInterface:  "if1",
+				OutInterface: "eth1",
+				Protocol:     linux_nftables.Rule_TCP,
+				SrcNetwork:   "10.0.0.0/8",
+				DstNetwork:   "192.168.1.1",
+				SrcPorts:     &linux_nftables.Rule_PortRange{LowerPort: 1024, UpperPort: 2048},
+				DstPorts:     &linux_nftables.Rule_PortRange{LowerPort: 80},
+				Counter:      true,
+				Verdict:      linux_nftables.Verdict_ACCEPT,
+				Comment:      "allow web",
+			},
+			expected: &linux_nftables.Rule{
+				InInterface:  "if1",
+				OutInterface: "eth1",
+				Protocol:     linux_nftables.Rule_TCP,
+				SrcNetwork:   "10.0.0.0/8",
+				DstNetwork:   "192.168.1.1/32",
+				SrcPorts:     &linux_nftables.Rule_PortRange{LowerPort: 1024, UpperPort: 2048},
+				DstPorts:     &linux_nftables.Rule_PortRange{LowerPort: 80},
+				Counter:      true,
+				Verdict:      linux_nftables.Verdict_ACCEPT,
+				Comment:      "allow web",
+			},
+		},
+		{
+			name:   "IPv6 network in inet table",
+			family: linux_nftables.Table_INET,
+			rule: &linux_nftables.Rule{
+				DstNetwork:  "fd00::/64",
+				ConnStates:  []linux_nftables.Rule_ConnState{linux_nftables.Rule_NEW, linux_nftables.Rule_ESTABLISHED},
+				Verdict:     linux_nftables.Verdict_JUMP,
+				TargetChain: "allowed",
+			},
+		},
+		{
+			name:   "set matches",
+			family: linux_nftables.Table_INET,
+			rule: &linux_nftables.Rule{
+				Protocol: linux_nftables.Rule_UDP,
+				SetMatches: []*linux_nftables.Rule_SetMatch{
+					{Set: "addrs", Field: linux_nftables.MatchField_SRC_ADDR},
+					{Set: "ports", Field: linux_nftables.MatchField_DST_PORT, Invert: true},
+				},
+				Verdict: linux_nftables.Verdict_DROP,
+			},
+		},
+		{
+			name:   "verdict map in bridge table",
+			family: linux_nftables.Table_BRIDGE,
+			rule: &linux_nftables.Rule{
+				SetMatches: []*linux_nftables.Rule_SetMatch{
+					{Set: "macs", Field: linux_nftables.MatchField_SRC_ADDR},
+				},
+				VerdictMap: &linux_nftables.Rule_VerdictMapLookup{
+					Map: "addrs", Field: linux_nftables.MatchField_DST_ADDR,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			b := &ruleBuilder{
+				family:      test.family,
+				sets:        sets,
+				elemTypes:   elemTypes,
+				hostIfNames: map[string]string{"if1": "eth0"},
+			}
+			exprs, err := b.build(test.rule)
+			Expect(err).ToNot(HaveOccurred())
+
+			p := &ruleParser{ifNames: map[string]string{"eth0": "if1"}}
+			rule, err := p.parse(exprs, ruleUserData(test.rule.Comment))
+			Expect(err).ToNot(HaveOccurred())
+
+			expected := test.expected
+			if expected == nil {
+				expected = test.rule
+			}
+			Expect(proto.Equal(rule, expected)).To(BeTrue(), "parsed rule: %v", rule)
+		})
+	}
+}
+
+func TestRuleBuildUndefinedSet(t *testing.T) {
+	RegisterTestingT(t)
+
+	b := &ruleBuilder{}
+	_, err := b.build(&linux_nftables.Rule{
+		SetMatches: []*linux_nftables.Rule_SetMatch{{Set: "undefined"}},
+	})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestRuleParseUnsupported(t *testing.T) {
+	tests := []struct {
+		name  string
+		exprs []expr.Any
+	}{
+		{
+			name:  "unsupported expression",
+			exprs: []expr.Any{&expr.Log{}},
+		},
+		{
+			name: "unsupported meta match",
+			exprs: []expr.Any{
+				&expr.Meta{Key: expr.MetaKeyMARK, Register: matchReg},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: matchReg, Data: []byte{1, 0, 0, 0}},
+			},
+		},
+		{
+			name: "unsupported payload",
+			exprs: []expr.Any{
+				&expr.Payload{DestRegister: matchReg, Base: expr.PayloadBaseNetworkHeader, Offset: 9, Len: 1},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: matchReg, Data: []byte{6}},
+			},
+		},
+		{
+			name:  "comparison without load",
+			exprs: []expr.Any{&expr.Cmp{Op: expr.CmpOpEq, Register: matchReg, Data: []byte{6}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			_, err := (&ruleParser{}).parse(test.exprs, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+func TestRuleComment(t *testing.T) {
+	tests := []struct {
+		name    string
+		udata   []byte
+		comment string
+	}{
+		{name: "no user data"},
+		{name: "comment", udata: ruleUserData("my rule"), comment: "my rule"},
+		{name: "comment after other data", udata: append([]byte{5, 1, 0}, ruleUserData("c")...), comment: "c"},
+		{name: "truncated", udata: ruleUserData("my rule")[:4]},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(ruleComment(test.udata)).To(Equal(test.comment))
+		})
+	}
+}
+
+func TestParseNetwork(t *testing.T) {
+	tests := []struct {
+		network   string
+		expected  string
+		expectErr bool
+	}{
+		{network: "10.0.0.1", expected: "10.0.0.1/32"},
+		{network: "10.0.0.1/24", expected: "10.0.0.0/24"},
+		{network: "fd00::1", expected: "fd00::1/128"},
+		{network: "fd00::1/64", expected: "fd00::/64"},
+		{network: "10.0.0.1/33", expectErr: true},
+		{network: "invalid", expectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.network, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			prefix, err := ParseNetwork(test.network)
+			if test.expectErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(prefix.String()).To(Equal(test.expected))
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name Table --value-type *linux_nftables.Table --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables" --output-dir "descriptor"
+
+package nftablesplugin
+
+import (
+	"go.ligato.io/cn-infra/v2/infra"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nftablesplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nftablesplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+)
+
+// NftablesPlugin configures Linux nftables tables.
+type NftablesPlugin struct {
+	Deps
+
+	// From configuration file
+	disabled bool
+
+	// system handlers
+	nftHandler linuxcalls.NftablesAPI
+}
+
+// Deps lists dependencies of the plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	NsPlugin    nsplugin.API
+	IfPlugin    ifplugin.API
+}
+
+// Config holds the plugin configuration.
+type Config struct {
+	Disabled bool `json:"disabled"`
+}
+
+// Init initializes and registers descriptors and handlers for Linux nftables tables.
+func (p *NftablesPlugin) Init() error {
+	// parse configuration file
+	config, err := p.retrieveConfig()
+	if err != nil {
+		return err
+	}
+	p.Log.Debugf("Linux nftables config: %+v", config)
+	if config.Disabled {
+		p.disabled = true
+		p.Log.Infof("Disabling nftables plugin")
+		return nil
+	}
+
+	// init nftables handler
+	p.nftHandler = linuxcalls.NewNftablesHandler()
+
+	// init & register the descriptor
+	tableDescriptor := descriptor.NewTableDescriptor(p.nftHandler, p.IfPlugin, p.NsPlugin, p.Log)
+
+	err = p.Deps.KVScheduler.RegisterKVDescriptor(tableDescriptor)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Close does nothing here.
+func (p *NftablesPlugin) Close() error {
+	return nil
+}
+
+// retrieveConfig loads plugin configuration file.
+func (p *NftablesPlugin) retrieveConfig() (*Config, error) {
+	config := &Config{}
+	found, err := p.Cfg.LoadValue(config)
+	if !found {
+		p.Log.Debug("Linux NftablesPlugin config not found")
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return config, err
+}
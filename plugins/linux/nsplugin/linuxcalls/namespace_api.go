@@ -34,6 +34,9 @@ type NamedNetNsAPI interface {
 	DeleteNamedNetNs(nsName string) error
 	// NamedNetNsExists checks whether named namespace exists.
 	NamedNetNsExists(nsName string) (bool, error)
+	// ListNamedNetNs returns names of all existing named namespaces.
+	// It does exactly the same thing as the command "ip netns list".
+	ListNamedNetNs() ([]string, error)
 }
 
 // NamespaceMgmtCtx represents context of an ongoing management of Linux namespaces.
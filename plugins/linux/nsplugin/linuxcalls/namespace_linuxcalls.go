@@ -144,3 +144,22 @@ func (nh *namedNetNsHandler) NamedNetNsExists(nsName string) (bool, error) {
 	netnsMountFile := path.Join(netNsMountDir, nsName)
 	return nh.sysHandler.FileExists(netnsMountFile)
 }
+
+// ListNamedNetNs returns names of all existing named namespaces.
+// It does exactly the same thing as the command "ip netns list".
+func (nh *namedNetNsHandler) ListNamedNetNs() ([]string, error) {
+	entries, err := os.ReadDir(netNsMountDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Errorf("failed to list named namespaces: %v", err)
+	}
+	var nsNames []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			nsNames = append(nsNames, entry.Name())
+		}
+	}
+	return nsNames, nil
+}
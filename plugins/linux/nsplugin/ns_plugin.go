@@ -149,6 +149,14 @@ func (p *NsPlugin) GetNamespaceHandle(ctx nsLinuxcalls.NamespaceMgmtCtx, namespa
 	return ns, nil
 }
 
+// ListNamedNamespaces returns names of all existing named namespaces.
+func (p *NsPlugin) ListNamedNamespaces() ([]string, error) {
+	if p.disabled {
+		return nil, errors.New("NsPlugin is disabled")
+	}
+	return p.namedNsHandler.ListNamedNetNs()
+}
+
 // SwitchToNamespace switches the network namespace of the current thread.
 // Caller should eventually call the returned "revert" function in order to get back to the original
 // network namespace (for example using "defer revert()").
@@ -32,4 +32,7 @@ type API interface {
 	// to be used with Netlink API. Do not forget to eventually close the handle using
 	// the netns.NsHandle.Close() method.
 	GetNamespaceHandle(ctx linuxcalls.NamespaceMgmtCtx, ns *linux_namespace.NetNamespace) (handle netns.NsHandle, err error)
+
+	// ListNamedNamespaces returns names of all existing named namespaces.
+	ListNamedNamespaces() ([]string, error)
 }
@@ -17,6 +17,17 @@ type IPAllocKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type IPAllocBatchOp struct {
+	Key         string
+	Value       *netalloc.IPAllocation
+	PrevValue   *netalloc.IPAllocation
+	Metadata    *netalloc.IPAllocMetadata
+	NewMetadata *netalloc.IPAllocMetadata
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type IPAllocDescriptor struct {
@@ -33,11 +44,15 @@ type IPAllocDescriptor struct {
 	Delete               func(key string, value *netalloc.IPAllocation, metadata *netalloc.IPAllocMetadata) error
 	Update               func(key string, oldValue, newValue *netalloc.IPAllocation, oldMetadata *netalloc.IPAllocMetadata) (newMetadata *netalloc.IPAllocMetadata, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *netalloc.IPAllocation, metadata *netalloc.IPAllocMetadata) bool
+	CreateBatch          func(ops []*IPAllocBatchOp) error
+	UpdateBatch          func(ops []*IPAllocBatchOp) error
+	DeleteBatch          func(ops []*IPAllocBatchOp) error
 	Retrieve             func(correlate []IPAllocKVWithMetadata) ([]IPAllocKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *netalloc.IPAllocation) []KeyValuePair
 	Dependencies         func(key string, value *netalloc.IPAllocation) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewIPAllocDescriptor(typedDescriptor *IPAllocDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewIPAllocDescriptor(typedDescriptor *IPAllocDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *IPAllocDescriptorAdapter) UpdateWithRecreate(key string, oldValue, new
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *IPAllocDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castIPAllocBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyIPAllocBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *IPAllocDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castIPAllocBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyIPAllocBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *IPAllocDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castIPAllocBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyIPAllocBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *IPAllocDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []IPAllocKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castIPAllocValue(key string, value proto.Message) (*netalloc.IPAllocation,
 	return typedValue, nil
 }
 
+func castIPAllocBatchOps(ops []*BatchOp, withPrevValue bool) []*IPAllocBatchOp {
+	var typedOps []*IPAllocBatchOp
+	for _, op := range ops {
+		typedValue, err := castIPAllocValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *netalloc.IPAllocation
+		if withPrevValue {
+			typedPrevValue, err = castIPAllocValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castIPAllocMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &IPAllocBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyIPAllocBatchResults(ops []*BatchOp, typedOps []*IPAllocBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castIPAllocMetadata(key string, metadata Metadata) (*netalloc.IPAllocMetadata, error) {
 	if metadata == nil {
 		return nil, nil
@@ -0,0 +1,159 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrKeyOwnedByOtherDataSource is returned in the strict mode when data
+// source pushes a key that is already set by another data source.
+var ErrKeyOwnedByOtherDataSource = errors.New("key is owned by other data source")
+
+// Conflict describes key with value set by multiple data sources.
+type Conflict struct {
+	Key string
+	// Winner is the data source whose value is applied.
+	Winner string
+	// Sources are the data sources which set the key ordered from the winner.
+	Sources []ConflictSource
+}
+
+// ConflictSource describes value set by one of the conflicting data sources.
+type ConflictSource struct {
+	DataSrc  string
+	Priority int
+	Value    proto.Message
+}
+
+// ListConflicts returns keys which are set by multiple data sources.
+func (p *dispatcher) ListConflicts() []Conflict {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	dataSrcs := p.orderedDataSources()
+	owners := make(map[string][]ConflictSource)
+	for i := len(dataSrcs) - 1; i >= 0; i-- {
+		dataSrc := dataSrcs[i]
+		for key, val := range p.db.List(dataSrc) {
+			owners[key] = append(owners[key], ConflictSource{
+				DataSrc:  dataSrc,
+				Priority: p.priorities[dataSrc],
+				Value:    val,
+			})
+		}
+	}
+	var conflicts []Conflict
+	for key, sources := range owners {
+		if len(sources) < 2 {
+			continue
+		}
+		conflicts = append(conflicts, Conflict{
+			Key:     key,
+			Winner:  sources[0].DataSrc,
+			Sources: sources,
+		})
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Key < conflicts[j].Key
+	})
+	return conflicts
+}
+
+// orderedDataSources returns data sources ordered by their priority
+// from the lowest. Data sources with the same priority are ordered by name.
+func (p *dispatcher) orderedDataSources() []string {
+	return p.sortDataSources(p.db.ListDataSources())
+}
+
+// sortDataSources sorts the data sources by their priority from the lowest
+// and then by name.
+func (p *dispatcher) sortDataSources(dataSrcs []string) []string {
+	sort.Strings(dataSrcs)
+	sort.SliceStable(dataSrcs, func(i, j int) bool {
+		return p.priorities[dataSrcs[i]] < p.priorities[dataSrcs[j]]
+	})
+	return dataSrcs
+}
+
+// listAll merges data of all the data sources, the value of data source
+// with higher priority wins.
+func (p *dispatcher) listAll() KVPairs {
+	pairs := make(KVPairs)
+	for _, dataSrc := range p.orderedDataSources() {
+		for k, v := range p.db.List(dataSrc) {
+			pairs[k] = v
+		}
+	}
+	return pairs
+}
+
+// listAllWith merges data of all the data sources like listAll, but with
+// the data of <dataSrc> replaced by the given pairs.
+func (p *dispatcher) listAllWith(dataSrc string, pairs KVPairs) KVPairs {
+	dataSrcs := p.db.ListDataSources()
+	var found bool
+	for _, src := range dataSrcs {
+		found = found || src == dataSrc
+	}
+	if !found {
+		dataSrcs = append(dataSrcs, dataSrc)
+	}
+	allPairs := make(KVPairs)
+	for _, src := range p.sortDataSources(dataSrcs) {
+		srcPairs := pairs
+		if src != dataSrc {
+			srcPairs = p.db.List(src)
+		}
+		for k, v := range srcPairs {
+			allPairs[k] = v
+		}
+	}
+	return allPairs
+}
+
+// checkOwnership checks keys pushed by the data source against data of the
+// other data sources. In the strict mode, an error is returned for keys set
+// only by another data source, otherwise the conflicts are only logged.
+// Keys already set by the pushing data source can be always changed.
+func (p *dispatcher) checkOwnership(dataSrc string, kvPairs []KeyVal) error {
+	owned := p.db.List(dataSrc)
+	for _, other := range p.db.ListDataSources() {
+		if other == dataSrc {
+			continue
+		}
+		data := p.db.List(other)
+		for _, kv := range kvPairs {
+			if _, ok := data[kv.Key]; !ok {
+				continue
+			}
+			if _, ok := owned[kv.Key]; ok && p.strict {
+				continue
+			}
+			if p.strict {
+				return fmt.Errorf("%w: %q is set by data source %q", ErrKeyOwnedByOtherDataSource, kv.Key, other)
+			}
+			if kv.Val != nil {
+				p.log.Warnf("Key %q from data source %q conflicts with value set by data source %q",
+					kv.Key, dataSrc, other)
+			}
+		}
+	}
+	return nil
+}
@@ -29,3 +29,16 @@ func DataSrcFromContext(ctx context.Context) (dataSrc string, ok bool) {
 	dataSrc, ok = ctx.Value(dataSrcKey).(string)
 	return
 }
+
+type authorKeyT string
+
+var authorKey = authorKeyT("author")
+
+func AuthorContext(ctx context.Context, author string) context.Context {
+	return context.WithValue(ctx, authorKey, author)
+}
+
+func AuthorFromContext(ctx context.Context) (author string, ok bool) {
+	author, ok = ctx.Value(authorKey).(string)
+	return
+}
@@ -17,15 +17,16 @@ package orchestrator
 import (
 	"context"
 	"fmt"
-	"runtime/trace"
 	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"go.ligato.io/cn-infra/v2/logging"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
 
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/contextdecorator"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
@@ -59,16 +60,26 @@ type Result struct {
 type Dispatcher interface {
 	ListData() KVPairs
 	PushData(context.Context, []KeyVal, map[string]Labels) ([]Result, error)
+	PlanData(context.Context, []KeyVal) (*kvs.RecordedTxn, error)
 	GetStatus(key string) (*Status, error)
 	ListState() (KVPairs, error)
 	ListLabels(key string) Labels
+	ListRevisions() []*Revision
+	Rollback(ctx context.Context, revision uint64) ([]Result, error)
+	ListConflicts() []Conflict
 }
 
 type dispatcher struct {
-	log logging.Logger
-	kvs kvs.KVScheduler
-	mu  sync.Mutex
-	db  Store
+	log  logging.Logger
+	kvs  kvs.KVScheduler
+	mu   sync.Mutex
+	db   Store
+	revs *revisionHistory
+
+	// priorities of data sources (higher wins in conflicts)
+	priorities map[string]int
+	// reject changes to keys set by another data source
+	strict bool
 }
 
 // ListData retrieves actual data.
@@ -76,7 +87,7 @@ func (p *dispatcher) ListData() KVPairs {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	return p.db.ListAll()
+	return p.listAll()
 }
 
 func (p *dispatcher) GetStatus(key string) (*Status, error) {
@@ -89,41 +100,60 @@ func (p *dispatcher) GetStatus(key string) (*Status, error) {
 }
 
 // PushData updates actual data.
+// With revert, results are returned along with the error only if the failed
+// transaction was cleanly reverted, otherwise only the error is returned.
 func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal, keyLabels map[string]Labels) (results []Result, err error) {
-	trace.Logf(ctx, "pushData", "%d KV pairs", len(kvPairs))
+	ctx, span := tracing.Start(ctx, "orchestrator.PushData", attribute.Int("orchestrator.kv_pairs", len(kvPairs)))
+	defer func() { tracing.End(span, err) }()
 
-	// check key-value pairs for uniqness and validate key
-	uniq := make(map[string]proto.Message)
-	for _, kv := range kvPairs {
-		if kv.Val != nil {
-			// check if given key matches the key generated from value
-			if k := models.Key(kv.Val); k != kv.Key {
-				return nil, errors.Errorf("given key %q does not match with key generated from value: %q (value: %#v)", kv.Key, k, kv.Val)
-			}
-		}
-		// check if key is unique
-		if oldVal, ok := uniq[kv.Key]; ok {
-			return nil, errors.Errorf("found multiple key-value pairs with same key: %q (value 1: %#v, value 2: %#v)", kv.Key, kv.Val, oldVal)
-		}
-		uniq[kv.Key] = kv.Val
+	if kvs.IsDryRun(ctx) {
+		return nil, errors.New("dry-run is not supported by PushData, use PlanData instead")
+	}
+	withRevert := kvs.IsWithRevert(ctx)
+	if typ, _ := kvs.IsResync(ctx); withRevert && typ != kvs.NotResync {
+		return nil, kvs.ErrRevertNotSupportedWithResync
+	}
+	uniq, err := checkKVPairs(kvPairs)
+	if err != nil {
+		return nil, err
 	}
 
 	p.mu.Lock()
 	defer p.mu.Unlock()
+	defer p.checkPersisted(&err)
 
-	pr := trace.StartRegion(ctx, "prepare kv data")
+	_, prepareSpan := tracing.Start(ctx, "orchestrator.prepareData")
 
 	dataSrc, ok := contextdecorator.DataSrcFromContext(ctx)
 	if !ok {
 		dataSrc = "global"
 	}
+	span.SetAttributes(attribute.String("orchestrator.data_source", dataSrc))
 
 	p.log.Debugf("Push data with %d KV pairs (source: %s)", len(kvPairs), dataSrc)
 
+	if err := p.checkOwnership(dataSrc, kvPairs); err != nil {
+		tracing.End(prepareSpan, err)
+		return nil, err
+	}
+
+	// with revert, remember the data to restore if the transaction fails
+	var (
+		prevData   KVPairs
+		prevLabels map[string]Labels
+	)
+	if withRevert {
+		prevData = p.db.List(dataSrc)
+		prevLabels = make(map[string]Labels)
+		for _, kv := range kvPairs {
+			prevLabels[kv.Key] = p.db.ListLabels(kv.Key)
+		}
+	}
+
 	txn := p.kvs.StartNBTransaction()
 
 	if typ, _ := kvs.IsResync(ctx); typ == kvs.FullResync {
-		trace.Log(ctx, "resyncType", typ.String())
+		span.SetAttributes(attribute.String("orchestrator.resync_type", typ.String()))
 		p.db.Reset(dataSrc)
 		for _, kv := range kvPairs {
 			if kv.Val == nil {
@@ -137,7 +167,7 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal, keyLabels m
 				p.db.AddLabel(kv.Key, lkey, lval)
 			}
 		}
-		allPairs := p.db.ListAll()
+		allPairs := p.listAll()
 		p.log.Debugf("will resync %d pairs", len(allPairs))
 		for k, v := range allPairs {
 			txn.SetValue(k, v)
@@ -146,14 +176,12 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal, keyLabels m
 		for _, kv := range kvPairs {
 			if kv.Val == nil {
 				p.log.Debugf(" - DELETE: %q", kv.Key)
-				txn.SetValue(kv.Key, nil)
 				p.db.Delete(dataSrc, kv.Key)
 				for lkey := range keyLabels[kv.Key] {
 					p.db.DeleteLabel(kv.Key, lkey)
 				}
 			} else {
 				p.log.Debugf(" - UPDATE: %q ", kv.Key)
-				txn.SetValue(kv.Key, kv.Val)
 				p.db.Update(dataSrc, kv.Key, kv.Val)
 				p.db.ResetLabels(kv.Key)
 				for lkey, lval := range keyLabels[kv.Key] {
@@ -161,14 +189,21 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal, keyLabels m
 				}
 			}
 		}
+		// apply the value of the data source with the highest priority,
+		// the key is removed only if no data source sets it anymore
+		allPairs := p.listAll()
+		for key := range uniq {
+			txn.SetValue(key, allPairs[key])
+		}
 	}
 
-	pr.End()
+	prepareSpan.End()
 
 	t := time.Now()
 
 	seqID, err := txn.Commit(ctx)
 	p.kvs.TransactionBarrier()
+	span.SetAttributes(attribute.Int64("kvscheduler.txn_seq_num", int64(seqID)))
 	results = append(results, Result{
 		Key: "seqnum",
 		Status: &Status{
@@ -182,7 +217,14 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal, keyLabels m
 			Status: s.GetValue(),
 		})
 	}
+	if isTxnExecuted(err) && (err == nil || !withRevert) {
+		p.addRevision(ctx, dataSrc, seqID)
+	}
 	if err != nil {
+		if withRevert {
+			// nothing has been applied, the data must reflect that
+			p.restoreData(dataSrc, kvPairs, prevData, prevLabels)
+		}
 		if txErr, ok := err.(*kvs.TransactionError); ok && len(txErr.GetKVErrors()) > 0 {
 			kvErrs := txErr.GetKVErrors()
 			var errInfo = ""
@@ -190,6 +232,14 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal, keyLabels m
 				errInfo += fmt.Sprintf(" - %3d. error (%s) %s - %v\n", i+1, kvErr.TxnOperation, kvErr.Key, kvErr.Error)
 			}
 			p.log.Errorf("Transaction #%d finished with %d errors\n%s", seqID, len(kvErrs), errInfo)
+			if withRevert {
+				if txErr.RevertFailed() {
+					p.log.Errorf("Transaction #%d failed to get reverted", seqID)
+					return nil, errors.Wrap(err, "revert failed")
+				}
+				p.log.Warnf("Transaction #%d was reverted", seqID)
+				results = addKVErrors(results, kvErrs)
+			}
 		} else {
 			p.log.Errorf("Transaction failed: %v", err)
 			return nil, err
@@ -203,6 +253,283 @@ func (p *dispatcher) PushData(ctx context.Context, kvPairs []KeyVal, keyLabels m
 	return results, nil
 }
 
+// ListRevisions returns retained revisions of the desired configuration
+// ordered from the oldest.
+func (p *dispatcher) ListRevisions() []*Revision {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.revs.list()
+}
+
+// Rollback re-applies the data of the given revision as a full resync.
+// Only the data sources owned by the agent are rolled back, the data
+// of external sources (etcd, init file) are kept as they are.
+// The rollback itself produces a new revision.
+func (p *dispatcher) Rollback(ctx context.Context, revision uint64) (results []Result, err error) {
+	ctx, span := tracing.Start(ctx, "orchestrator.Rollback", attribute.Int64("orchestrator.revision", int64(revision)))
+	defer func() { tracing.End(span, err) }()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	defer p.checkPersisted(&err)
+
+	rev := p.revs.get(revision)
+	if rev == nil {
+		return nil, errors.Wrapf(ErrRevisionNotFound, "revision %d", revision)
+	}
+
+	p.log.Debugf("Rollback to revision %d with %d items", rev.Num, rev.NumItems())
+
+	// replace the data and labels of the sources owned by the agent,
+	// the data of external sources are kept as they are
+	prevData, prevLabels := p.snapshotData()
+	p.replaceData(rev.Data, rev.Labels)
+
+	txn := p.kvs.StartNBTransaction()
+	allPairs := p.listAll()
+	for k, v := range allPairs {
+		txn.SetValue(k, v)
+	}
+
+	if _, withDescription := kvs.IsWithDescription(ctx); !withDescription {
+		ctx = kvs.WithDescription(ctx, fmt.Sprintf("rollback to revision %d", rev.Num))
+	}
+	ctx = kvs.WithResync(ctx, kvs.FullResync, true)
+
+	seqID, err := txn.Commit(ctx)
+	p.kvs.TransactionBarrier()
+	if !isTxnExecuted(err) {
+		// nothing has been applied, the data must reflect that
+		p.replaceData(prevData, prevLabels)
+		p.log.Errorf("Rollback to revision %d failed: %v", rev.Num, err)
+		return nil, err
+	}
+	p.addRevision(ctx, rev.DataSrc, seqID)
+
+	results = append(results, Result{
+		Key: "seqnum",
+		Status: &Status{
+			Details: []string{fmt.Sprint(seqID)},
+		},
+	})
+	for key := range allPairs {
+		s := p.kvs.GetValueStatus(key)
+		results = append(results, Result{
+			Key:    key,
+			Status: s.GetValue(),
+		})
+	}
+	if err != nil {
+		p.log.Errorf("Rollback to revision %d (transaction #%d) finished with %d errors",
+			rev.Num, seqID, len(err.(*kvs.TransactionError).GetKVErrors()))
+		return results, err
+	}
+	p.log.Infof("Rollback to revision %d successful (transaction #%d)", rev.Num, seqID)
+
+	return results, nil
+}
+
+// checkPersisted sets the error if the changes of data were not persisted
+// (and no other error has occurred).
+func (p *dispatcher) checkPersisted(err *error) {
+	store, persistent := p.db.(PersistentStore)
+	if !persistent {
+		return
+	}
+	if persistErr := store.Err(); persistErr != nil {
+		p.log.Errorf("Failed to persist data: %v", persistErr)
+		if *err == nil {
+			*err = errors.Wrap(persistErr, "failed to persist data")
+		}
+	}
+}
+
+// snapshotData returns copy of the data and labels of the sources owned by the agent.
+func (p *dispatcher) snapshotData() (map[string]KVPairs, map[string]Labels) {
+	data := make(map[string]KVPairs)
+	labels := make(map[string]Labels)
+	for _, dataSrc := range p.db.ListDataSources() {
+		if isExternalDataSource(dataSrc) {
+			continue
+		}
+		data[dataSrc] = p.db.List(dataSrc)
+		for key := range data[dataSrc] {
+			if keyLabels := p.db.ListLabels(key); len(keyLabels) > 0 {
+				labels[key] = keyLabels
+			}
+		}
+	}
+	return data, labels
+}
+
+// replaceData replaces the data and labels of the sources owned by the agent.
+// Data of the external sources are skipped.
+func (p *dispatcher) replaceData(data map[string]KVPairs, labels map[string]Labels) {
+	for _, dataSrc := range p.db.ListDataSources() {
+		if isExternalDataSource(dataSrc) {
+			continue
+		}
+		for key := range p.db.List(dataSrc) {
+			p.db.ResetLabels(key)
+		}
+		p.db.Reset(dataSrc)
+	}
+	for dataSrc, pairs := range data {
+		if isExternalDataSource(dataSrc) {
+			continue
+		}
+		for key, val := range pairs {
+			p.db.Update(dataSrc, key, val)
+			for lkey, lval := range labels[key] {
+				p.db.AddLabel(key, lkey, lval)
+			}
+		}
+	}
+}
+
+// isTxnExecuted returns true if the transaction was executed,
+// even if some of its operations have failed.
+func isTxnExecuted(err error) bool {
+	if err == nil {
+		return true
+	}
+	txErr, ok := err.(*kvs.TransactionError)
+	return ok && len(txErr.GetKVErrors()) > 0
+}
+
+// addRevision records snapshot of the actual data as a new revision.
+func (p *dispatcher) addRevision(ctx context.Context, dataSrc string, seqID uint64) {
+	rev := &Revision{
+		Time:      time.Now(),
+		DataSrc:   dataSrc,
+		TxnSeqNum: seqID,
+		Data:      make(map[string]KVPairs),
+		Labels:    make(map[string]Labels),
+	}
+	rev.Author, _ = contextdecorator.AuthorFromContext(ctx)
+	rev.Description, _ = kvs.IsWithDescription(ctx)
+	for _, src := range p.db.ListDataSources() {
+		pairs := p.db.List(src)
+		for key := range pairs {
+			if labels := p.db.ListLabels(key); len(labels) > 0 {
+				rev.Labels[key] = labels
+			}
+		}
+		rev.Data[src] = pairs
+	}
+	p.revs.add(rev)
+	p.log.Debugf("Added revision %d (transaction #%d, %d items)", rev.Num, seqID, rev.NumItems())
+}
+
+// restoreData restores data and labels of the given keys to the previous state.
+func (p *dispatcher) restoreData(dataSrc string, kvPairs []KeyVal, prevData KVPairs, prevLabels map[string]Labels) {
+	for _, kv := range kvPairs {
+		if prevVal, ok := prevData[kv.Key]; ok {
+			p.db.Update(dataSrc, kv.Key, prevVal)
+		} else {
+			p.db.Delete(dataSrc, kv.Key)
+		}
+		p.db.ResetLabels(kv.Key)
+		for lkey, lval := range prevLabels[kv.Key] {
+			p.db.AddLabel(kv.Key, lkey, lval)
+		}
+	}
+}
+
+// addKVErrors adds errors which caused the transaction revert into the results.
+// After the revert, the value status alone no longer reflects the failure.
+func addKVErrors(results []Result, kvErrs []kvs.KeyWithError) []Result {
+	for _, kvErr := range kvErrs {
+		var found bool
+		for _, res := range results {
+			if res.Key == kvErr.Key && res.Status != nil {
+				if res.Status.Error == "" {
+					res.Status.Error = kvErr.Error.Error()
+				}
+				found = true
+			}
+		}
+		if !found {
+			results = append(results, Result{
+				Key: kvErr.Key,
+				Status: &Status{
+					Key:           kvErr.Key,
+					State:         kvscheduler.ValueState_FAILED,
+					Error:         kvErr.Error.Error(),
+					LastOperation: kvErr.TxnOperation,
+				},
+			})
+		}
+	}
+	return results
+}
+
+// PlanData simulates push of the data and returns the recorded transaction
+// with the execution plan. Neither the actual data nor the running state
+// are changed.
+func (p *dispatcher) PlanData(ctx context.Context, kvPairs []KeyVal) (record *kvs.RecordedTxn, err error) {
+	ctx, span := tracing.Start(ctx, "orchestrator.PlanData", attribute.Int("orchestrator.kv_pairs", len(kvPairs)))
+	defer func() { tracing.End(span, err) }()
+
+	if _, err := checkKVPairs(kvPairs); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	dataSrc, ok := contextdecorator.DataSrcFromContext(ctx)
+	if !ok {
+		dataSrc = "global"
+	}
+
+	p.log.Debugf("Plan data with %d KV pairs (source: %s)", len(kvPairs), dataSrc)
+
+	if err := p.checkOwnership(dataSrc, kvPairs); err != nil {
+		return nil, err
+	}
+
+	// the changes are applied only to a copy of the source data,
+	// the store is left unchanged
+	resync := false
+	pairs := make(KVPairs)
+	if typ, _ := kvs.IsResync(ctx); typ == kvs.FullResync {
+		resync = true
+	} else {
+		pairs = p.db.List(dataSrc)
+	}
+	for _, kv := range kvPairs {
+		if kv.Val != nil {
+			pairs[kv.Key] = kv.Val
+		} else {
+			delete(pairs, kv.Key)
+		}
+	}
+	allPairs := p.listAllWith(dataSrc, pairs)
+
+	txn := p.kvs.StartNBTransaction()
+	if resync {
+		for k, v := range allPairs {
+			txn.SetValue(k, v)
+		}
+	} else {
+		for _, kv := range kvPairs {
+			txn.SetValue(kv.Key, allPairs[kv.Key])
+		}
+	}
+
+	record = &kvs.RecordedTxn{}
+	seqID, err := txn.Commit(kvs.WithDryRunRecord(ctx, record))
+	if err != nil {
+		p.log.Errorf("Transaction plan failed: %v", err)
+		return nil, err
+	}
+	p.log.Infof("Transaction #%d planned with %d operations", seqID, len(record.Planned))
+
+	return record, nil
+}
+
 // ListState retrieves running state.
 func (p *dispatcher) ListState() (KVPairs, error) {
 	p.mu.Lock()
@@ -229,3 +556,22 @@ func (p *dispatcher) ListLabels(key string) Labels {
 
 	return p.db.ListLabels(key)
 }
+
+// checkKVPairs checks key-value pairs for uniqueness and validates keys.
+func checkKVPairs(kvPairs []KeyVal) (map[string]proto.Message, error) {
+	uniq := make(map[string]proto.Message)
+	for _, kv := range kvPairs {
+		if kv.Val != nil {
+			// check if given key matches the key generated from value
+			if k := models.Key(kv.Val); k != kv.Key {
+				return nil, errors.Errorf("given key %q does not match with key generated from value: %q (value: %#v)", kv.Key, k, kv.Val)
+			}
+		}
+		// check if key is unique
+		if oldVal, ok := uniq[kv.Key]; ok {
+			return nil, errors.Errorf("found multiple key-value pairs with same key: %q (value 1: %#v, value 2: %#v)", kv.Key, kv.Val, oldVal)
+		}
+		uniq[kv.Key] = kv.Val
+	}
+	return uniq, nil
+}
@@ -0,0 +1,523 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"google.golang.org/protobuf/proto"
+
+	kvsplugin "go.ligato.io/vpp-agent/v3/plugins/kvscheduler"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/contextdecorator"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// mockScheduler is a minimal KVScheduler that applies the committed values
+// into a map. Methods not used by the dispatcher are not implemented.
+type mockScheduler struct {
+	kvs.KVScheduler
+
+	seqNum uint64
+	values KVPairs
+	txns   []KVPairs // values of the committed transactions
+
+	// errors returned for keys by the next transactions
+	failKeys map[string]error
+	// error returned by the next Commit before the transaction is executed
+	commitErr error
+	// revert of the next failed transaction fails as well
+	revertErr error
+}
+
+func newMockScheduler() *mockScheduler {
+	return &mockScheduler{
+		values:   make(KVPairs),
+		failKeys: make(map[string]error),
+	}
+}
+
+func (s *mockScheduler) StartNBTransaction() kvs.Txn {
+	return &mockTxn{scheduler: s, values: make(KVPairs)}
+}
+
+func (s *mockScheduler) TransactionBarrier() {}
+
+func (s *mockScheduler) GetValueStatus(key string) *kvscheduler.BaseValueStatus {
+	state := kvscheduler.ValueState_CONFIGURED
+	if _, failed := s.failKeys[key]; failed {
+		state = kvscheduler.ValueState_FAILED
+	}
+	return &kvscheduler.BaseValueStatus{
+		Value: &kvscheduler.ValueStatus{Key: key, State: state},
+	}
+}
+
+type mockTxn struct {
+	scheduler *mockScheduler
+	values    KVPairs
+}
+
+func (t *mockTxn) SetValue(key string, value proto.Message) kvs.Txn {
+	t.values[key] = value
+	return t
+}
+
+func (t *mockTxn) Commit(ctx context.Context) (uint64, error) {
+	s := t.scheduler
+	if s.commitErr != nil {
+		return ^uint64(0), kvs.NewTransactionError(s.commitErr, nil)
+	}
+	seqNum := s.seqNum
+	s.seqNum++
+	if record, withRecord := kvs.IsWithDryRunRecord(ctx); withRecord {
+		// plan is the list of values, nothing is applied
+		*record = kvs.RecordedTxn{SeqNum: seqNum, DryRun: true}
+		for key, val := range t.values {
+			op := kvscheduler.TxnOperation_CREATE
+			if val == nil {
+				op = kvscheduler.TxnOperation_DELETE
+			}
+			record.Planned = append(record.Planned, &kvs.RecordedTxnOp{Operation: op, Key: key})
+		}
+		return seqNum, nil
+	}
+	s.txns = append(s.txns, t.values)
+
+	var kvErrs []kvs.KeyWithError
+	for key := range t.values {
+		if err, failed := s.failKeys[key]; failed {
+			kvErrs = append(kvErrs, kvs.KeyWithError{
+				Key:          key,
+				TxnOperation: kvscheduler.TxnOperation_CREATE,
+				Error:        err,
+			})
+		}
+	}
+	if len(kvErrs) > 0 && kvs.IsWithRevert(ctx) {
+		if s.revertErr != nil {
+			kvErrs = append(kvErrs, kvs.KeyWithError{
+				Key:          kvErrs[0].Key,
+				TxnOperation: kvscheduler.TxnOperation_DELETE,
+				Error:        s.revertErr,
+				IsRevert:     true,
+			})
+		}
+		return seqNum, kvs.NewTransactionError(nil, kvErrs)
+	}
+	if typ, _ := kvs.IsResync(ctx); typ == kvs.FullResync {
+		s.values = make(KVPairs)
+	}
+	for key, val := range t.values {
+		if _, failed := s.failKeys[key]; failed {
+			continue
+		}
+		if val == nil {
+			delete(s.values, key)
+		} else {
+			s.values[key] = val
+		}
+	}
+	if len(kvErrs) > 0 {
+		return seqNum, kvs.NewTransactionError(nil, kvErrs)
+	}
+	return seqNum, nil
+}
+
+func newTestDispatcher(scheduler *mockScheduler) *dispatcher {
+	return &dispatcher{
+		log:  logrus.NewLogger("dispatcher"),
+		kvs:  scheduler,
+		db:   newMemStore(),
+		revs: newRevisionHistory(0),
+	}
+}
+
+func testInterface(name string, mtu uint32) KeyVal {
+	val := &interfaces.Interface{
+		Name: name,
+		Type: interfaces.Interface_SOFTWARE_LOOPBACK,
+		Mtu:  mtu,
+	}
+	return KeyVal{Key: interfaces.InterfaceKey(name), Val: val}
+}
+
+func deleteInterface(name string) KeyVal {
+	return KeyVal{Key: interfaces.InterfaceKey(name)}
+}
+
+func dataSrcCtx(dataSrc string) context.Context {
+	return contextdecorator.DataSrcContext(context.Background(), dataSrc)
+}
+
+func TestPushDataPriorityOverride(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := newMockScheduler()
+	d := newTestDispatcher(scheduler)
+	d.priorities = map[string]int{"grpc": 10}
+
+	high := testInterface("if1", 1500)
+	low := testInterface("if1", 9000)
+
+	_, err := d.PushData(dataSrcCtx("grpc"), []KeyVal{high}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(proto.Equal(scheduler.values[high.Key], high.Val)).To(BeTrue())
+
+	// lower priority data source does not override the applied value
+	_, err = d.PushData(dataSrcCtx("datasync"), []KeyVal{low}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(proto.Equal(scheduler.values[high.Key], high.Val)).To(BeTrue())
+
+	conflicts := d.ListConflicts()
+	Expect(conflicts).To(HaveLen(1))
+	Expect(conflicts[0].Winner).To(Equal("grpc"))
+	Expect(proto.Equal(conflicts[0].Sources[0].Value, scheduler.values[high.Key])).To(BeTrue())
+
+	// higher priority data source overrides the value
+	high = testInterface("if1", 1400)
+	_, err = d.PushData(dataSrcCtx("grpc"), []KeyVal{high}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(proto.Equal(scheduler.values[high.Key], high.Val)).To(BeTrue())
+}
+
+func TestPushDataDeleteFallback(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := newMockScheduler()
+	d := newTestDispatcher(scheduler)
+	d.priorities = map[string]int{"grpc": 10}
+
+	high := testInterface("if1", 1500)
+	low := testInterface("if1", 9000)
+
+	_, err := d.PushData(dataSrcCtx("datasync"), []KeyVal{low}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = d.PushData(dataSrcCtx("grpc"), []KeyVal{high}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(proto.Equal(scheduler.values[high.Key], high.Val)).To(BeTrue())
+
+	// value of the other data source is applied after delete
+	_, err = d.PushData(dataSrcCtx("grpc"), []KeyVal{deleteInterface("if1")}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(proto.Equal(scheduler.values[low.Key], low.Val)).To(BeTrue())
+	Expect(d.ListConflicts()).To(BeEmpty())
+
+	// the value is removed once no data source sets it
+	_, err = d.PushData(dataSrcCtx("datasync"), []KeyVal{deleteInterface("if1")}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(scheduler.values).ToNot(HaveKey(low.Key))
+	Expect(d.ListData()).To(BeEmpty())
+}
+
+func TestStrictDataSources(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := newMockScheduler()
+	d := newTestDispatcher(scheduler)
+
+	// keys set by both data sources before the strict mode was enabled
+	_, err := d.PushData(dataSrcCtx("grpc"), []KeyVal{testInterface("if1", 1500), testInterface("if2", 1500)}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = d.PushData(dataSrcCtx("datasync"), []KeyVal{testInterface("if1", 9000)}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	d.strict = true
+
+	// key owned only by other data source is rejected
+	_, err = d.PushData(dataSrcCtx("datasync"), []KeyVal{testInterface("if2", 9000)}, nil)
+	Expect(errors.Is(err, ErrKeyOwnedByOtherDataSource)).To(BeTrue())
+	_, err = d.PushData(dataSrcCtx("datasync"), []KeyVal{deleteInterface("if2")}, nil)
+	Expect(errors.Is(err, ErrKeyOwnedByOtherDataSource)).To(BeTrue())
+	Expect(d.db.List("datasync")).ToNot(HaveKey(interfaces.InterfaceKey("if2")))
+
+	// co-owned key can be changed by both data sources
+	_, err = d.PushData(dataSrcCtx("datasync"), []KeyVal{testInterface("if1", 8000)}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = d.PushData(dataSrcCtx("grpc"), []KeyVal{deleteInterface("if1")}, nil)
+	Expect(err).ToNot(HaveOccurred())
+
+	// new key is accepted
+	_, err = d.PushData(dataSrcCtx("datasync"), []KeyVal{testInterface("if3", 9000)}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(d.ListData()).To(HaveLen(3))
+}
+
+func TestPlanData(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := newMockScheduler()
+	d := newTestDispatcher(scheduler)
+	d.priorities = map[string]int{"grpc": 10}
+
+	_, err := d.PushData(dataSrcCtx("grpc"), []KeyVal{testInterface("if1", 1500)}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	_, err = d.PushData(dataSrcCtx("datasync"), []KeyVal{testInterface("if2", 1500)}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	data := d.ListData()
+	numTxns := len(scheduler.txns)
+
+	planned := func(record *kvs.RecordedTxn) map[string]kvscheduler.TxnOperation {
+		ops := make(map[string]kvscheduler.TxnOperation)
+		for _, op := range record.Planned {
+			ops[op.Key] = op.Operation
+		}
+		return ops
+	}
+
+	// resync of one data source keeps the data of the other sources
+	ctx := kvs.WithResync(dataSrcCtx("grpc"), kvs.FullResync, true)
+	record, err := d.PlanData(ctx, []KeyVal{testInterface("if3", 1500)})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(record.DryRun).To(BeTrue())
+	Expect(planned(record)).To(Equal(map[string]kvscheduler.TxnOperation{
+		interfaces.InterfaceKey("if2"): kvscheduler.TxnOperation_CREATE,
+		interfaces.InterfaceKey("if3"): kvscheduler.TxnOperation_CREATE,
+	}))
+	Expect(d.ListData()).To(Equal(data))
+	Expect(scheduler.txns).To(HaveLen(numTxns))
+
+	// delete of the key set also by other source falls back to its value
+	_, err = d.PushData(dataSrcCtx("datasync"), []KeyVal{testInterface("if1", 9000)}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	data = d.ListData()
+	numTxns = len(scheduler.txns)
+	record, err = d.PlanData(dataSrcCtx("grpc"), []KeyVal{deleteInterface("if1")})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(planned(record)).To(Equal(map[string]kvscheduler.TxnOperation{
+		interfaces.InterfaceKey("if1"): kvscheduler.TxnOperation_CREATE,
+	}))
+	Expect(d.ListData()).To(Equal(data))
+	Expect(scheduler.txns).To(HaveLen(numTxns))
+}
+
+func TestListRevisions(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := newMockScheduler()
+	d := newTestDispatcher(scheduler)
+
+	ctx := contextdecorator.AuthorContext(dataSrcCtx("grpc"), "admin")
+	ctx = kvs.WithDescription(ctx, "add loopback")
+	_, err := d.PushData(ctx, []KeyVal{testInterface("if1", 1500)},
+		map[string]Labels{interfaces.InterfaceKey("if1"): {"owner": "admin"}})
+	Expect(err).ToNot(HaveOccurred())
+
+	revs := d.ListRevisions()
+	Expect(revs).To(HaveLen(1))
+	Expect(revs[0].Num).To(BeEquivalentTo(1))
+	Expect(revs[0].DataSrc).To(Equal("grpc"))
+	Expect(revs[0].Author).To(Equal("admin"))
+	Expect(revs[0].Description).To(Equal("add loopback"))
+	Expect(revs[0].NumItems()).To(Equal(1))
+	Expect(revs[0].Labels).To(Equal(map[string]Labels{interfaces.InterfaceKey("if1"): {"owner": "admin"}}))
+
+	// failed transaction that was not executed does not produce revision
+	scheduler.commitErr = errors.New("transaction rejected")
+	_, err = d.PushData(dataSrcCtx("grpc"), []KeyVal{testInterface("if2", 1500)}, nil)
+	Expect(err).To(HaveOccurred())
+	Expect(d.ListRevisions()).To(HaveLen(1))
+	scheduler.commitErr = nil
+
+	// reverted transaction does not produce revision
+	scheduler.failKeys[interfaces.InterfaceKey("if3")] = errors.New("failed")
+	_, err = d.PushData(kvs.WithRevert(dataSrcCtx("grpc")), []KeyVal{testInterface("if3", 1500)}, nil)
+	Expect(err).To(HaveOccurred())
+	Expect(d.ListRevisions()).To(HaveLen(1))
+
+	// transaction with failed values is recorded without revert
+	_, err = d.PushData(dataSrcCtx("grpc"), []KeyVal{testInterface("if3", 1500)}, nil)
+	Expect(err).To(HaveOccurred())
+	Expect(d.ListRevisions()).To(HaveLen(2))
+}
+
+func TestRollback(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := newMockScheduler()
+	d := newTestDispatcher(scheduler)
+
+	if1 := testInterface("if1", 1500)
+	if2 := testInterface("if2", 1500)
+	if3 := testInterface("if3", 1500)
+
+	_, err := d.PushData(dataSrcCtx("grpc"), []KeyVal{if1}, nil) // revision 1
+	Expect(err).ToNot(HaveOccurred())
+	_, err = d.PushData(dataSrcCtx("datasync"), []KeyVal{if2}, nil) // revision 2
+	Expect(err).ToNot(HaveOccurred())
+	_, err = d.PushData(dataSrcCtx("grpc"), []KeyVal{testInterface("if1", 9000)},
+		map[string]Labels{if1.Key: {"owner": "admin"}}) // revision 3
+	Expect(err).ToNot(HaveOccurred())
+	_, err = d.PushData(dataSrcCtx("datasync"), []KeyVal{if3}, nil) // revision 4
+	Expect(err).ToNot(HaveOccurred())
+
+	// unknown revision
+	_, err = d.Rollback(context.Background(), 100)
+	Expect(errors.Is(err, ErrRevisionNotFound)).To(BeTrue())
+
+	// data of the agent are rolled back, data of external sources are kept
+	results, err := d.Rollback(context.Background(), 1)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(results).ToNot(BeEmpty())
+	Expect(proto.Equal(scheduler.values[if1.Key], if1.Val)).To(BeTrue())
+	Expect(scheduler.values).To(HaveKey(if2.Key))
+	Expect(scheduler.values).To(HaveKey(if3.Key))
+	Expect(d.ListData()).To(HaveLen(3))
+	Expect(d.ListLabels(if1.Key)).To(BeEmpty())
+
+	revs := d.ListRevisions()
+	Expect(revs).To(HaveLen(5))
+	Expect(revs[4].Description).To(Equal("rollback to revision 1"))
+
+	// store is restored if the transaction was not executed
+	data := d.ListData()
+	scheduler.commitErr = errors.New("transaction rejected")
+	results, err = d.Rollback(context.Background(), 3)
+	Expect(err).To(HaveOccurred())
+	Expect(results).To(BeNil())
+	Expect(d.ListData()).To(Equal(data))
+	Expect(d.ListLabels(if1.Key)).To(BeEmpty())
+	Expect(d.ListRevisions()).To(HaveLen(5))
+	scheduler.commitErr = nil
+
+	// rollback with failed values is recorded
+	scheduler.failKeys[if1.Key] = errors.New("failed")
+	results, err = d.Rollback(context.Background(), 3)
+	Expect(err).To(HaveOccurred())
+	Expect(results).ToNot(BeEmpty())
+	Expect(d.ListLabels(if1.Key)).To(Equal(Labels{"owner": "admin"}))
+	Expect(d.ListRevisions()).To(HaveLen(6))
+}
+
+// newFailingDescriptor returns descriptor for VPP interfaces, which fails
+// to create interfaces with the MTU <failMTU>.
+func newFailingDescriptor(failMTU uint32) *kvs.KVDescriptor {
+	return &kvs.KVDescriptor{
+		Name:          "failing-interface",
+		NBKeyPrefix:   interfaces.ModelInterface.KeyPrefix(),
+		KeySelector:   interfaces.ModelInterface.IsKeyValid,
+		ValueTypeName: interfaces.ModelInterface.ProtoName(),
+		Create: func(key string, value proto.Message) (kvs.Metadata, error) {
+			if value.(*interfaces.Interface).Mtu == failMTU {
+				return nil, errors.New("invalid MTU")
+			}
+			return nil, nil
+		},
+		Delete: func(key string, value proto.Message, metadata kvs.Metadata) error {
+			return nil
+		},
+	}
+}
+
+func TestPushDataWithRevert(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := kvsplugin.NewPlugin(kvsplugin.UseDeps(func(deps *kvsplugin.Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	Expect(scheduler.Init()).To(Succeed())
+	defer func() {
+		Expect(scheduler.Close()).To(Succeed())
+	}()
+	Expect(scheduler.RegisterKVDescriptor(newFailingDescriptor(9999))).To(Succeed())
+
+	d := &dispatcher{
+		log:  logrus.NewLogger("dispatcher"),
+		kvs:  scheduler,
+		db:   newMemStore(),
+		revs: newRevisionHistory(0),
+	}
+
+	if1 := testInterface("if1", 1500)
+	_, err := d.PushData(dataSrcCtx("grpc"), []KeyVal{if1},
+		map[string]Labels{if1.Key: {"owner": "admin"}})
+	Expect(err).ToNot(HaveOccurred())
+
+	// the whole transaction is reverted
+	if2 := testInterface("if2", 9999)
+	results, err := d.PushData(kvs.WithRevert(dataSrcCtx("grpc")),
+		[]KeyVal{testInterface("if1", 9000), if2, testInterface("if3", 1500)},
+		map[string]Labels{if1.Key: {"owner": "operator"}})
+	Expect(err).To(HaveOccurred())
+
+	// results contain the error of the failed value
+	var if2Result *Status
+	for _, res := range results {
+		if res.Key == if2.Key {
+			if2Result = res.Status
+		}
+	}
+	Expect(if2Result).ToNot(BeNil())
+	Expect(if2Result.Error).To(ContainSubstring("invalid MTU"))
+
+	// the data are restored to reflect the state before the transaction
+	data := d.ListData()
+	Expect(data).To(HaveLen(1))
+	Expect(proto.Equal(data[if1.Key], if1.Val)).To(BeTrue())
+	Expect(d.ListLabels(if1.Key)).To(Equal(Labels{"owner": "admin"}))
+	Expect(scheduler.GetValueStatus(if1.Key).GetValue().GetState()).To(Equal(kvscheduler.ValueState_CONFIGURED))
+
+	// the restored data are used by the next transaction
+	_, err = d.PushData(dataSrcCtx("grpc"), []KeyVal{testInterface("if3", 1500)}, nil)
+	Expect(err).ToNot(HaveOccurred())
+	data = d.ListData()
+	Expect(data).To(HaveLen(2))
+	Expect(proto.Equal(data[if1.Key], if1.Val)).To(BeTrue())
+	Expect(d.ListRevisions()).To(HaveLen(2))
+}
+
+func TestPushDataWithRevertNotExecuted(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := newMockScheduler()
+	d := newTestDispatcher(scheduler)
+
+	if1 := testInterface("if1", 1500)
+	_, err := d.PushData(dataSrcCtx("grpc"), []KeyVal{if1}, nil)
+	Expect(err).ToNot(HaveOccurred())
+
+	// transaction which is not executed returns only the error
+	scheduler.commitErr = errors.New("transaction rejected")
+	results, err := d.PushData(kvs.WithRevert(dataSrcCtx("grpc")), []KeyVal{deleteInterface("if1")}, nil)
+	Expect(err).To(HaveOccurred())
+	Expect(results).To(BeNil())
+	Expect(d.ListData()).To(HaveKey(if1.Key))
+}
+
+func TestPushDataWithFailedRevert(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := newMockScheduler()
+	d := newTestDispatcher(scheduler)
+
+	if1 := testInterface("if1", 1500)
+	_, err := d.PushData(dataSrcCtx("grpc"), []KeyVal{if1}, nil)
+	Expect(err).ToNot(HaveOccurred())
+
+	// transaction which failed to get reverted returns only the error
+	if2 := testInterface("if2", 1500)
+	scheduler.failKeys[if2.Key] = errors.New("create failed")
+	scheduler.revertErr = errors.New("delete failed")
+	results, err := d.PushData(kvs.WithRevert(dataSrcCtx("grpc")), []KeyVal{if2}, nil)
+	Expect(err).To(HaveOccurred())
+	Expect(err.Error()).To(ContainSubstring("revert failed"))
+	Expect(results).To(BeNil())
+	Expect(d.ListData()).To(HaveLen(1))
+	Expect(d.ListData()).To(HaveKey(if1.Key))
+}
@@ -0,0 +1,354 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+)
+
+const (
+	// minimal number of records in the store file before it gets compacted
+	minCompactRecords = 1000
+	// max. size of a single record in the store file
+	maxRecordSize = 16 * 1024 * 1024
+)
+
+// operations recorded in the store file
+const (
+	recUpdate      = "update"
+	recDelete      = "delete"
+	recReset       = "reset"
+	recAddLabel    = "add-label"
+	recDeleteLabel = "delete-label"
+	recResetLabels = "reset-labels"
+)
+
+// fileStoreRecord is a single change recorded in the store file.
+type fileStoreRecord struct {
+	Op      string `json:"op"`
+	DataSrc string `json:"src,omitempty"`
+	Key     string `json:"key,omitempty"`
+	Item    []byte `json:"item,omitempty"`
+	LKey    string `json:"lkey,omitempty"`
+	LVal    string `json:"lval,omitempty"`
+}
+
+// fileStore is Store implementation that keeps the data in memory and
+// persists every change into append-only file, so that the data survive
+// restart of the agent. The file is compacted into a snapshot of the actual
+// data and labels (on open and whenever it grows too much).
+// Data of the external sources (etcd, init file) are not persisted,
+// they are received again with the resync after the restart.
+type fileStore struct {
+	*memStore
+
+	log  logging.Logger
+	path string
+
+	file       *os.File
+	numRecords int
+	compactAt  int
+
+	// error of persisting the changes, returned by the next call to Err
+	err error
+	// the file has to be rewritten after failed write
+	broken bool
+}
+
+// newFileStore opens the store file and restores the data and labels from it.
+func newFileStore(path string, log logging.Logger) (*fileStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory for store file: %w", err)
+	}
+	s := &fileStore{
+		memStore: newMemStore(),
+		log:      log,
+		path:     path,
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// load replays the records from the store file into memory.
+func (s *fileStore) load() error {
+	file, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to open store file: %w", err)
+	}
+	defer file.Close()
+
+	var corrupted int
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxRecordSize)
+	for scanner.Scan() {
+		var rec fileStoreRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			// most likely the last record was not completely written
+			corrupted++
+			continue
+		}
+		if err := s.replay(&rec); err != nil {
+			s.log.Warnf("skipping record from store file (key %q): %v", rec.Key, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read store file: %w", err)
+	}
+	if corrupted > 0 {
+		s.log.Warnf("store file %s contains %d corrupted records", s.path, corrupted)
+	}
+	s.log.Infof("restored %d items from store file %s", len(s.ListAll()), s.path)
+	return nil
+}
+
+// replay applies the record to the in-memory data.
+func (s *fileStore) replay(rec *fileStoreRecord) error {
+	switch rec.Op {
+	case recUpdate:
+		item := &generic.Item{}
+		if err := proto.Unmarshal(rec.Item, item); err != nil {
+			return err
+		}
+		val, err := models.UnmarshalItem(item)
+		if err != nil {
+			return err
+		}
+		s.memStore.Update(rec.DataSrc, rec.Key, val)
+	case recDelete:
+		s.memStore.Delete(rec.DataSrc, rec.Key)
+	case recReset:
+		s.memStore.Reset(rec.DataSrc)
+	case recAddLabel:
+		s.memStore.AddLabel(rec.Key, rec.LKey, rec.LVal)
+	case recDeleteLabel:
+		s.memStore.DeleteLabel(rec.Key, rec.LKey)
+	case recResetLabels:
+		s.memStore.ResetLabels(rec.Key)
+	default:
+		return fmt.Errorf("unknown operation %q", rec.Op)
+	}
+	return nil
+}
+
+// compact replaces the store file with a snapshot of the actual data.
+func (s *fileStore) compact() error {
+	tmpPath := s.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create store file: %w", err)
+	}
+	w := bufio.NewWriter(tmp)
+	var numRecords int
+	write := func(rec *fileStoreRecord) error {
+		b, err := json.Marshal(rec)
+		if err != nil {
+			return err
+		}
+		numRecords++
+		_, err = w.Write(append(b, '\n'))
+		return err
+	}
+	for _, dataSrc := range s.ListDataSources() {
+		if isExternalDataSource(dataSrc) {
+			continue
+		}
+		for key, val := range s.List(dataSrc) {
+			rec, err := updateRecord(dataSrc, key, val)
+			if err != nil {
+				tmp.Close()
+				return fmt.Errorf("failed to encode value for key %q: %w", key, err)
+			}
+			if err = write(rec); err != nil {
+				tmp.Close()
+				return fmt.Errorf("failed to write store file: %w", err)
+			}
+		}
+	}
+	for key, labels := range s.ldb {
+		for lkey, lval := range labels {
+			err := write(&fileStoreRecord{Op: recAddLabel, Key: key, LKey: lkey, LVal: lval})
+			if err != nil {
+				tmp.Close()
+				return fmt.Errorf("failed to write store file: %w", err)
+			}
+		}
+	}
+	if err = w.Flush(); err == nil {
+		err = tmp.Sync()
+	}
+	if err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write store file: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close store file: %w", err)
+	}
+	if s.file != nil {
+		s.file.Close()
+		s.file = nil
+	}
+	if err = os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("failed to replace store file: %w", err)
+	}
+	s.file, err = os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open store file: %w", err)
+	}
+	s.numRecords = numRecords
+	s.compactAt = 2*numRecords + minCompactRecords
+	s.broken = false
+	return nil
+}
+
+// append writes the record at the end of the store file and syncs the file.
+// Failure is remembered and returned by the next call to Err.
+func (s *fileStore) append(rec *fileStoreRecord) {
+	if err := s.write(rec); err != nil {
+		s.log.Errorf("failed to persist change of key %q: %v", rec.Key, err)
+		s.fail(err)
+	}
+}
+
+// write writes the record at the end of the store file. After a failed write,
+// the file is instead rewritten with a snapshot of the actual data (which
+// already include the change of the record).
+func (s *fileStore) write(rec *fileStoreRecord) error {
+	if s.file == nil {
+		return fmt.Errorf("store file is closed")
+	}
+	if s.broken {
+		return s.compact()
+	}
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode record: %w", err)
+	}
+	if _, err = s.file.Write(append(b, '\n')); err != nil {
+		s.broken = true
+		return fmt.Errorf("failed to write store file: %w", err)
+	}
+	if err = s.file.Sync(); err != nil {
+		s.broken = true
+		return fmt.Errorf("failed to sync store file: %w", err)
+	}
+	s.numRecords++
+	if s.numRecords >= s.compactAt {
+		if err = s.compact(); err != nil {
+			return fmt.Errorf("failed to compact store file: %w", err)
+		}
+	}
+	return nil
+}
+
+// fail remembers the first error of persisting the changes.
+func (s *fileStore) fail(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// Err returns the first error of persisting the changes since the last call.
+func (s *fileStore) Err() error {
+	err := s.err
+	s.err = nil
+	return err
+}
+
+// Update updates value stored under key with given value.
+func (s *fileStore) Update(dataSrc, key string, val proto.Message) {
+	s.memStore.Update(dataSrc, key, val)
+	if isExternalDataSource(dataSrc) {
+		return
+	}
+	rec, err := updateRecord(dataSrc, key, val)
+	if err != nil {
+		s.log.Errorf("value for key %q cannot be persisted: %v", key, err)
+		s.fail(err)
+		return
+	}
+	s.append(rec)
+}
+
+// Delete deletes value stored under given key.
+func (s *fileStore) Delete(dataSrc, key string) {
+	s.memStore.Delete(dataSrc, key)
+	if isExternalDataSource(dataSrc) {
+		return
+	}
+	s.append(&fileStoreRecord{Op: recDelete, DataSrc: dataSrc, Key: key})
+}
+
+// Reset clears all key-value data.
+func (s *fileStore) Reset(dataSrc string) {
+	s.memStore.Reset(dataSrc)
+	if isExternalDataSource(dataSrc) {
+		return
+	}
+	s.append(&fileStoreRecord{Op: recReset, DataSrc: dataSrc})
+}
+
+func (s *fileStore) AddLabel(key, lkey, lval string) {
+	s.memStore.AddLabel(key, lkey, lval)
+	s.append(&fileStoreRecord{Op: recAddLabel, Key: key, LKey: lkey, LVal: lval})
+}
+
+func (s *fileStore) DeleteLabel(key, lkey string) {
+	s.memStore.DeleteLabel(key, lkey)
+	s.append(&fileStoreRecord{Op: recDeleteLabel, Key: key, LKey: lkey})
+}
+
+func (s *fileStore) ResetLabels(key string) {
+	s.memStore.ResetLabels(key)
+	s.append(&fileStoreRecord{Op: recResetLabels, Key: key})
+}
+
+// Close closes the store file.
+func (s *fileStore) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+func updateRecord(dataSrc, key string, val proto.Message) (*fileStoreRecord, error) {
+	item, err := models.MarshalItem(val)
+	if err != nil {
+		return nil, err
+	}
+	b, err := proto.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	return &fileStoreRecord{Op: recUpdate, DataSrc: dataSrc, Key: key, Item: b}, nil
+}
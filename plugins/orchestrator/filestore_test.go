@@ -0,0 +1,148 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+func openTestFileStore(path string) *fileStore {
+	store, err := newFileStore(path, logrus.NewLogger("filestore"))
+	Expect(err).ToNot(HaveOccurred())
+	return store
+}
+
+func countRecords(path string) int {
+	data, err := os.ReadFile(path)
+	Expect(err).ToNot(HaveOccurred())
+	return bytes.Count(data, []byte{'\n'})
+}
+
+func TestFileStoreReload(t *testing.T) {
+	RegisterTestingT(t)
+
+	path := filepath.Join(t.TempDir(), "store", "data")
+	if1 := testInterface("if1", 1500)
+	if2 := testInterface("if2", 1500)
+	if3 := testInterface("if3", 1500)
+
+	store := openTestFileStore(path)
+	store.Update("grpc", if1.Key, if1.Val)
+	store.AddLabel(if1.Key, "owner", "admin")
+	store.Update("grpc", if2.Key, if2.Val)
+	store.Delete("grpc", if2.Key)
+	store.Update("localclient", if3.Key, if3.Val)
+	// data of the external sources are not persisted
+	store.Update("datasync", if2.Key, if2.Val)
+	Expect(store.Err()).ToNot(HaveOccurred())
+	Expect(store.Close()).To(Succeed())
+
+	store = openTestFileStore(path)
+	Expect(store.ListDataSources()).To(Equal([]string{"grpc", "localclient"}))
+	Expect(store.List("grpc")).To(HaveLen(1))
+	Expect(proto.Equal(store.List("grpc")[if1.Key], if1.Val)).To(BeTrue())
+	Expect(proto.Equal(store.List("localclient")[if3.Key], if3.Val)).To(BeTrue())
+	Expect(store.ListLabels(if1.Key)).To(Equal(Labels{"owner": "admin"}))
+
+	// reset of data source is persisted
+	store.Reset("localclient")
+	Expect(store.Close()).To(Succeed())
+	store = openTestFileStore(path)
+	Expect(store.ListDataSources()).To(Equal([]string{"grpc"}))
+	Expect(store.Close()).To(Succeed())
+}
+
+func TestFileStoreTruncatedRecord(t *testing.T) {
+	RegisterTestingT(t)
+
+	path := filepath.Join(t.TempDir(), "data")
+	if1 := testInterface("if1", 1500)
+	if2 := testInterface("if2", 1500)
+
+	store := openTestFileStore(path)
+	store.Update("grpc", if1.Key, if1.Val)
+	store.Update("grpc", if2.Key, if2.Val)
+	Expect(store.Close()).To(Succeed())
+
+	// the last record was not completely written
+	data, err := os.ReadFile(path)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(os.WriteFile(path, data[:len(data)-10], 0644)).To(Succeed())
+
+	store = openTestFileStore(path)
+	Expect(store.List("grpc")).To(HaveLen(1))
+	Expect(proto.Equal(store.List("grpc")[if1.Key], if1.Val)).To(BeTrue())
+
+	// the file is compacted on open, new records are not appended to the corrupted one
+	Expect(countRecords(path)).To(Equal(1))
+	store.Update("grpc", if2.Key, if2.Val)
+	Expect(store.Close()).To(Succeed())
+	store = openTestFileStore(path)
+	Expect(store.List("grpc")).To(HaveLen(2))
+	Expect(store.Close()).To(Succeed())
+}
+
+func TestFileStoreCompaction(t *testing.T) {
+	RegisterTestingT(t)
+
+	path := filepath.Join(t.TempDir(), "data")
+	store := openTestFileStore(path)
+	Expect(store.compactAt).To(Equal(minCompactRecords))
+
+	for i := 0; i < minCompactRecords+10; i++ {
+		store.Update("grpc", testInterface("if1", 0).Key, testInterface("if1", uint32(i)).Val)
+	}
+	store.Update("grpc", testInterface("if2", 0).Key, testInterface("if2", 1500).Val)
+	Expect(store.Err()).ToNot(HaveOccurred())
+
+	// the file was compacted into a single record and the later records were appended
+	Expect(store.numRecords).To(Equal(12))
+	Expect(countRecords(path)).To(Equal(store.numRecords))
+	Expect(store.compactAt).To(Equal(2 + minCompactRecords))
+	Expect(store.Close()).To(Succeed())
+
+	store = openTestFileStore(path)
+	last := testInterface("if1", minCompactRecords+9)
+	Expect(proto.Equal(store.List("grpc")[last.Key], last.Val)).To(BeTrue())
+	Expect(store.List("grpc")).To(HaveLen(2))
+	Expect(countRecords(path)).To(Equal(2))
+	Expect(store.Close()).To(Succeed())
+}
+
+func TestFileStoreError(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := newMockScheduler()
+	d := newTestDispatcher(scheduler)
+	store := openTestFileStore(filepath.Join(t.TempDir(), "data"))
+	d.db = store
+
+	_, err := d.PushData(dataSrcCtx("grpc"), []KeyVal{testInterface("if1", 1500)}, nil)
+	Expect(err).ToNot(HaveOccurred())
+
+	// failure to persist the data is reported
+	Expect(store.file.Close()).To(Succeed())
+	_, err = d.PushData(dataSrcCtx("grpc"), []KeyVal{testInterface("if2", 1500)}, nil)
+	Expect(err).To(HaveOccurred())
+	Expect(store.Err()).ToNot(HaveOccurred())
+	Expect(d.ListData()).To(HaveLen(2))
+}
@@ -16,6 +16,7 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -41,6 +42,7 @@ type genericService struct {
 
 	log      logging.Logger
 	dispatch Dispatcher
+	notifier *notifier
 }
 
 func (s *genericService) KnownModels(ctx context.Context, req *generic.KnownModelsRequest) (*generic.KnownModelsResponse, error) {
@@ -128,16 +130,49 @@ func (s *genericService) SetConfig(ctx context.Context, req *generic.SetConfigRe
 	} else {
 		ctx = contextdecorator.DataSrcContext(ctx, "grpc")
 	}
+	if hasMeta && len(md["author"]) == 1 {
+		ctx = contextdecorator.AuthorContext(ctx, md["author"][0])
+	}
+	if hasMeta && len(md["description"]) == 1 {
+		ctx = kvs.WithDescription(ctx, md["description"][0])
+	}
 	if req.OverwriteAll {
+		if req.Atomic {
+			return nil, status.Error(codes.InvalidArgument, "atomic update cannot be combined with overwrite all")
+		}
 		ctx = kvs.WithResync(ctx, kvs.FullResync, true)
 	}
+	if req.Atomic {
+		ctx = kvs.WithRevert(ctx)
+	}
+	ctx = kvs.WithPriority(ctx, kvs.TxnPriorityFromProto(req.Priority))
+	if req.DryRun {
+		txn, err := s.dispatch.PlanData(ctx, kvPairs)
+		if err != nil {
+			st := status.New(codes.FailedPrecondition, err.Error())
+			return nil, st.Err()
+		}
+		return &generic.SetConfigResponse{Plan: txn.TxnPlan()}, nil
+	}
 	ctx = kvs.WithRetryDefault(ctx)
 	results, err := s.dispatch.PushData(ctx, kvPairs, keyLabels)
 	if err != nil {
+		if req.Atomic && results != nil {
+			// all the updates were reverted
+			return &generic.SetConfigResponse{
+				Results:    UpdateResults(results),
+				RolledBack: true,
+			}, nil
+		}
 		st := status.New(codes.FailedPrecondition, err.Error())
 		return nil, st.Err()
 	}
 
+	return &generic.SetConfigResponse{Results: UpdateResults(results)}, nil
+}
+
+// UpdateResults converts dispatcher results into update results.
+func UpdateResults(results []Result) []*generic.UpdateResult {
 	updateResults := []*generic.UpdateResult{}
 	for _, res := range results {
 		var msg string
@@ -155,22 +190,7 @@ func (s *genericService) SetConfig(ctx context.Context, req *generic.SetConfigRe
 			// Op: res.Status.LastOperation.String(),
 		})
 	}
-
-	/*
-		// commit the transaction
-		if err := txn.Commit(); err != nil {
-			st := status.New(codes.FailedPrecondition, err.Error())
-			return nil, st.Err()
-			// TODO: use the WithDetails to return extra info to clients.
-			//ds, err := st.WithDetails(&rpc.DebugInfo{Detail: "Local transaction failed!"})
-			//if err != nil {
-			//	return nil, st.Err()
-			//}
-			//return nil, ds.Err()
-		}
-	*/
-
-	return &generic.SetConfigResponse{Results: updateResults}, nil
+	return updateResults
 }
 
 func (s *genericService) GetConfig(ctx context.Context, req *generic.GetConfigRequest) (*generic.GetConfigResponse, error) {
@@ -248,8 +268,65 @@ func (s *genericService) DumpState(context.Context, *generic.DumpStateRequest) (
 	return &generic.DumpStateResponse{Items: states}, nil
 }
 
+func (s *genericService) ListRevisions(context.Context, *generic.ListRevisionsRequest) (*generic.ListRevisionsResponse, error) {
+	var revisions []*generic.Revision
+	for _, rev := range s.dispatch.ListRevisions() {
+		revisions = append(revisions, &generic.Revision{
+			Num:         rev.Num,
+			Timestamp:   rev.Time.Unix(),
+			DataSrc:     rev.DataSrc,
+			Author:      rev.Author,
+			Description: rev.Description,
+			TxnSeqNum:   rev.TxnSeqNum,
+			NumItems:    uint32(rev.NumItems()),
+		})
+	}
+	return &generic.ListRevisionsResponse{Revisions: revisions}, nil
+}
+
+func (s *genericService) Rollback(ctx context.Context, req *generic.RollbackRequest) (*generic.RollbackResponse, error) {
+	s.log.Debugf("=> GenericMgr.Rollback: revision %d", req.Revision)
+
+	md, hasMeta := metadata.FromIncomingContext(ctx)
+	if hasMeta && len(md["author"]) == 1 {
+		ctx = contextdecorator.AuthorContext(ctx, md["author"][0])
+	}
+	if hasMeta && len(md["description"]) == 1 {
+		ctx = kvs.WithDescription(ctx, md["description"][0])
+	}
+	ctx = kvs.WithRetryDefault(ctx)
+	results, err := s.dispatch.Rollback(ctx, req.Revision)
+	if errors.Is(err, ErrRevisionNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil && results == nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	resp := &generic.RollbackResponse{Results: UpdateResults(results)}
+	if revs := s.dispatch.ListRevisions(); len(revs) > 0 {
+		resp.Revision = revs[len(revs)-1].Num
+	}
+	return resp, nil
+}
+
 func (s *genericService) Subscribe(req *generic.SubscribeRequest, server generic.ManagerService_SubscribeServer) error {
-	return status.Error(codes.Unimplemented, "Not implemented yet")
+	s.log.Debugf("=> GenericMgr.Subscribe: %d subscriptions", len(req.Subscriptions))
+
+	sub := s.notifier.subscribe(req.Subscriptions)
+	defer s.notifier.unsubscribe(sub)
+
+	for {
+		select {
+		case notif := <-sub.notifs:
+			if err := server.Send(&generic.SubscribeResponse{
+				Notifications: []*generic.Notification{notif},
+			}); err != nil {
+				return err
+			}
+		case <-server.Context().Done():
+			return nil
+		}
+	}
 }
 
 // toImportSet performs convenient format conversion to descriptor.FileDescriptorSet
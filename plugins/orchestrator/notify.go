@@ -0,0 +1,131 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"sync"
+
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
+)
+
+// subscriberBufferSize is the number of notifications buffered for each subscriber.
+const subscriberBufferSize = 100
+
+// Notifier publishes notifications about the state of configuration items
+// to the subscribers of the generic manager service.
+type Notifier interface {
+	// PushNotification publishes notification about the given item.
+	PushNotification(item proto.Message, status *generic.ItemStatus)
+}
+
+// PushNotification publishes notification about the given item
+// to the subscribers of the generic manager service.
+func (p *Plugin) PushNotification(item proto.Message, status *generic.ItemStatus) {
+	if p.notifier == nil {
+		return
+	}
+	p.notifier.push(item, status)
+}
+
+// notifier distributes notifications among subscribers.
+type notifier struct {
+	log  logging.Logger
+	mu   sync.Mutex
+	subs map[*subscriber]struct{}
+}
+
+// subscriber receives notifications about items matching its subscriptions.
+type subscriber struct {
+	subscriptions []*generic.Subscription
+	notifs        chan *generic.Notification
+}
+
+func newNotifier(log logging.Logger) *notifier {
+	return &notifier{
+		log:  log,
+		subs: make(map[*subscriber]struct{}),
+	}
+}
+
+// subscribe registers new subscriber. Subscriber without subscriptions
+// receives all notifications.
+func (n *notifier) subscribe(subscriptions []*generic.Subscription) *subscriber {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	sub := &subscriber{
+		subscriptions: subscriptions,
+		notifs:        make(chan *generic.Notification, subscriberBufferSize),
+	}
+	n.subs[sub] = struct{}{}
+	return sub
+}
+
+// unsubscribe removes the subscriber.
+func (n *notifier) unsubscribe(sub *subscriber) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.subs, sub)
+}
+
+// push sends notification to all subscribers interested in the item.
+func (n *notifier) push(item proto.Message, status *generic.ItemStatus) {
+	genericItem, err := models.MarshalItem(item)
+	if err != nil {
+		n.log.Warnf("failed to marshal notification item: %v", err)
+		return
+	}
+	notif := &generic.Notification{
+		Item:   genericItem,
+		Status: status,
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for sub := range n.subs {
+		if !sub.isSubscribed(genericItem.Id) {
+			continue
+		}
+		select {
+		case sub.notifs <- notif:
+		default:
+			n.log.Warnf("subscriber is not keeping up, dropping notification for %v", genericItem.Id)
+		}
+	}
+}
+
+// isSubscribed returns true if the subscriber is interested in the item.
+// Empty model or name in the subscription matches any value.
+func (s *subscriber) isSubscribed(id *generic.Item_ID) bool {
+	if len(s.subscriptions) == 0 {
+		return true
+	}
+	for _, subscription := range s.subscriptions {
+		if model := subscription.GetId().GetModel(); model != "" && model != id.GetModel() {
+			continue
+		}
+		if name := subscription.GetId().GetName(); name != "" && name != id.GetName() {
+			continue
+		}
+		return true
+	}
+	return false
+}
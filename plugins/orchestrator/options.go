@@ -34,6 +34,7 @@ func NewPlugin(opts ...Option) *Plugin {
 	p.KVScheduler = &kvscheduler.DefaultPlugin
 	p.Watcher = local.DefaultRegistry
 	p.reflection = true
+	p.revisionsLimit = DefaultRevisionsLimit
 
 	for _, o := range opts {
 		o(p)
@@ -52,6 +53,44 @@ func UseReflection(enabled bool) Option {
 	}
 }
 
+// WithRevisionsLimit sets the number of retained revisions of the desired configuration.
+func WithRevisionsLimit(limit int) Option {
+	return func(p *Plugin) {
+		p.revisionsLimit = limit
+	}
+}
+
+// UseStore sets the store used for the desired configuration.
+func UseStore(store Store) Option {
+	return func(p *Plugin) {
+		p.store = store
+	}
+}
+
+// UseFileStore sets the file used to persist the desired configuration
+// across restarts. It can be overridden in the plugin config file.
+func UseFileStore(path string) Option {
+	return func(p *Plugin) {
+		p.storeFile = path
+	}
+}
+
+// WithDataSourcePriorities sets priorities of NB data sources used to resolve
+// conflicts when the same key is set by multiple data sources (higher wins).
+func WithDataSourcePriorities(priorities map[string]int) Option {
+	return func(p *Plugin) {
+		p.dataSrcPriorities = priorities
+	}
+}
+
+// WithStrictDataSources enables rejecting of changes to keys that are already
+// set by another data source.
+func WithStrictDataSources(enabled bool) Option {
+	return func(p *Plugin) {
+		p.strictDataSrcs = enabled
+	}
+}
+
 func EnabledGrpcMetrics() {
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	grpc.UsePromMetrics(grpc_prometheus.DefaultServerMetrics)(&grpc.DefaultPlugin)
@@ -16,6 +16,7 @@ package orchestrator
 
 import (
 	"context"
+	"io"
 	"os"
 	"strings"
 	"sync"
@@ -30,6 +31,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/contextdecorator"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
@@ -48,9 +50,16 @@ type Plugin struct {
 	Deps
 
 	*dispatcher
-	manager *genericService
+	manager  *genericService
+	notifier *notifier
 
-	reflection bool
+	reflection     bool
+	revisionsLimit int
+	store          Store
+	storeFile      string
+
+	dataSrcPriorities map[string]int
+	strictDataSrcs    bool
 
 	// datasync channels
 	changeChan   chan datasync.ChangeEvent
@@ -71,26 +80,71 @@ type Deps struct {
 	StatusPublisher datasync.KeyProtoValWriter
 }
 
+// Config holds the orchestrator configuration.
+type Config struct {
+	// StoreFile is a path to the file used to persist the desired configuration
+	// across restarts (empty to keep the configuration only in memory).
+	// It is ignored if the store is selected with plugin options.
+	StoreFile string `json:"store-file"`
+
+	// DataSourcePriorities defines priorities of NB data sources (e.g. grpc,
+	// datasync or initfile) used when the same key is set by multiple data
+	// sources - the value from data source with higher priority is applied.
+	// Data sources without priority have priority 0 and the conflicts between
+	// data sources with the same priority are resolved by their names.
+	DataSourcePriorities map[string]int `json:"datasource-priorities"`
+
+	// StrictDataSources enables rejecting of changes to keys that are already
+	// set by another data source.
+	StrictDataSources bool `json:"strict-datasources"`
+}
+
 // Init registers the service to GRPC server.
 func (p *Plugin) Init() (err error) {
 	p.quit = make(chan struct{})
 
+	config := &Config{
+		StoreFile:            p.storeFile,
+		DataSourcePriorities: p.dataSrcPriorities,
+		StrictDataSources:    p.strictDataSrcs,
+	}
+	if _, err := p.Cfg.LoadValue(config); err != nil {
+		return err
+	}
+	if p.store == nil {
+		if config.StoreFile != "" {
+			p.store, err = newFileStore(config.StoreFile, p.Log)
+			if err != nil {
+				return errors.Errorf("failed to open orchestrator store: %v", err)
+			}
+		} else {
+			p.store = newMemStore()
+		}
+	}
+
 	p.dispatcher = &dispatcher{
-		log: logging.DefaultRegistry.NewLogger("dispatcher"),
-		db:  newMemStore(),
-		kvs: p.KVScheduler,
+		log:  logging.DefaultRegistry.NewLogger("dispatcher"),
+		db:   p.store,
+		kvs:  p.KVScheduler,
+		revs: newRevisionHistory(p.revisionsLimit),
+
+		priorities: config.DataSourcePriorities,
+		strict:     config.StrictDataSources,
 	}
 
+	p.notifier = newNotifier(logging.DefaultRegistry.NewLogger("notifier"))
+
 	// register grpc service
 	p.manager = &genericService{
 		log:      p.log,
 		dispatch: p.dispatcher,
+		notifier: p.notifier,
 	}
 
 	if grpcServer := p.GRPC.GetServer(); grpcServer != nil {
 		p.Log.Debugf("registering generic manager and meta service")
-		generic.RegisterManagerServiceServer(grpcServer, p.manager)
-		generic.RegisterMetaServiceServer(grpcServer, p.manager)
+		generic.RegisterManagerServiceServer(tracing.ServiceRegistrar(grpcServer), p.manager)
+		generic.RegisterMetaServiceServer(tracing.ServiceRegistrar(grpcServer), p.manager)
 
 		// register grpc services for reflection
 		if p.reflection {
@@ -149,6 +203,9 @@ func (p *Plugin) AfterInit() (err error) {
 func (p *Plugin) Close() (err error) {
 	close(p.quit)
 	p.wg.Wait()
+	if closer, ok := p.store.(io.Closer); ok {
+		return closer.Close()
+	}
 	return nil
 }
 
@@ -158,6 +215,16 @@ func (p *Plugin) InitialSync() error {
 	p.Log.Debugf("starting initial SB sync")
 	txn := p.KVScheduler.StartNBTransaction()
 	ctx := kvs.WithResync(context.Background(), kvs.DownstreamResync, true)
+	if restored := p.ListData(); len(restored) > 0 {
+		// the configuration restored from persistent store is applied
+		// together with the SB resync (the NB resync below keeps it,
+		// since it replaces only data of the resynced data sources)
+		p.Log.Infof("applying %d items restored from orchestrator store", len(restored))
+		for key, val := range restored {
+			txn.SetValue(key, val)
+		}
+		ctx = kvs.WithResync(context.Background(), kvs.FullResync, true)
+	}
 	if _, err := txn.Commit(ctx); err != nil {
 		return errors.Errorf("initial SB sync failed: %v", err)
 	}
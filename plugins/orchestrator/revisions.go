@@ -0,0 +1,96 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// DefaultRevisionsLimit is the default number of retained revisions.
+const DefaultRevisionsLimit = 10
+
+// ErrRevisionNotFound is returned when the requested revision does not exist
+// or it is no longer retained.
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// Revision is a numbered snapshot of the desired configuration
+// created after each push of data.
+type Revision struct {
+	Num         uint64
+	Time        time.Time
+	DataSrc     string
+	Author      string
+	Description string
+	// TxnSeqNum is the sequence number of the transaction
+	// which produced the revision.
+	TxnSeqNum uint64
+	// Data contains the key-value pairs of all data sources.
+	Data map[string]KVPairs
+	// Labels contains labels of all the keys.
+	Labels map[string]Labels
+}
+
+// NumItems returns number of configuration items in the revision.
+func (r *Revision) NumItems() int {
+	var n int
+	for _, pairs := range r.Data {
+		n += len(pairs)
+	}
+	return n
+}
+
+// revisionHistory retains the last revisions of the desired configuration.
+type revisionHistory struct {
+	limit int
+	last  uint64
+	revs  []*Revision
+}
+
+func newRevisionHistory(limit int) *revisionHistory {
+	if limit <= 0 {
+		limit = DefaultRevisionsLimit
+	}
+	return &revisionHistory{limit: limit}
+}
+
+// add numbers the revision and appends it to the history,
+// the oldest revisions beyond the limit are dropped.
+func (h *revisionHistory) add(rev *Revision) *Revision {
+	h.last++
+	rev.Num = h.last
+	h.revs = append(h.revs, rev)
+	if over := len(h.revs) - h.limit; over > 0 {
+		h.revs = append([]*Revision(nil), h.revs[over:]...)
+	}
+	return rev
+}
+
+// get returns the revision with the given number or nil if not retained.
+func (h *revisionHistory) get(num uint64) *Revision {
+	i := sort.Search(len(h.revs), func(i int) bool {
+		return h.revs[i].Num >= num
+	})
+	if i < len(h.revs) && h.revs[i].Num == num {
+		return h.revs[i]
+	}
+	return nil
+}
+
+// list returns retained revisions ordered from the oldest.
+func (h *revisionHistory) list() []*Revision {
+	return append([]*Revision(nil), h.revs...)
+}
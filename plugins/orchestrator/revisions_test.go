@@ -0,0 +1,62 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package orchestrator
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRevisionHistory(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(newRevisionHistory(0).limit).To(Equal(DefaultRevisionsLimit))
+
+	h := newRevisionHistory(3)
+	Expect(h.list()).To(BeEmpty())
+	Expect(h.get(1)).To(BeNil())
+
+	for i := 0; i < 5; i++ {
+		rev := h.add(&Revision{DataSrc: "grpc"})
+		Expect(rev.Num).To(BeEquivalentTo(i + 1))
+	}
+
+	// only the last revisions are retained
+	var nums []uint64
+	for _, rev := range h.list() {
+		nums = append(nums, rev.Num)
+	}
+	Expect(nums).To(Equal([]uint64{3, 4, 5}))
+	Expect(h.get(2)).To(BeNil())
+	Expect(h.get(4).Num).To(BeEquivalentTo(4))
+	Expect(h.get(6)).To(BeNil())
+
+	// returned list is not affected by later revisions
+	list := h.list()
+	h.add(&Revision{})
+	Expect(list).To(HaveLen(3))
+	Expect(list[0].Num).To(BeEquivalentTo(3))
+}
+
+func TestRevisionNumItems(t *testing.T) {
+	RegisterTestingT(t)
+
+	rev := &Revision{Data: map[string]KVPairs{
+		"grpc":     {"a": nil, "b": nil},
+		"datasync": {"c": nil},
+	}}
+	Expect(rev.NumItems()).To(Equal(3))
+}
@@ -20,8 +20,22 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// externalDataSources are data sources of the configuration kept outside
+// of the agent (in etcd or in the init file), which send the whole data
+// again with each resync. Their data are neither persisted nor rolled back.
+var externalDataSources = map[string]bool{
+	"datasync": true,
+	"initfile": true,
+}
+
+// isExternalDataSource returns true if the data source is not owned by the agent.
+func isExternalDataSource(dataSrc string) bool {
+	return externalDataSources[dataSrc]
+}
+
 // KVStore describes an interface for key-value store used by dispatcher.
 type KVStore interface {
+	ListDataSources() []string
 	ListAll() KVPairs
 	List(dataSrc string) KVPairs
 	Update(dataSrc, key string, val proto.Message)
@@ -43,6 +57,15 @@ type Store interface {
 	KVStore
 }
 
+// PersistentStore is a Store which persists the data. Changes which failed
+// to be persisted are kept in memory and the failure is reported by Err.
+type PersistentStore interface {
+	Store
+
+	// Err returns the first error of persisting the changes since the last call.
+	Err() error
+}
+
 // memStore is KStore implementation that stores data in memory.
 type memStore struct {
 	db  map[string]KVPairs
@@ -56,15 +79,20 @@ func newMemStore() *memStore {
 	}
 }
 
-// List lists all key-value pairs.
-func (s *memStore) ListAll() KVPairs {
+// ListDataSources lists data sources with stored data.
+func (s *memStore) ListDataSources() []string {
 	var dataSrcs []string
 	for dataSrc := range s.db {
 		dataSrcs = append(dataSrcs, dataSrc)
 	}
 	sort.Strings(dataSrcs)
+	return dataSrcs
+}
+
+// List lists all key-value pairs.
+func (s *memStore) ListAll() KVPairs {
 	pairs := make(KVPairs)
-	for _, dataSrc := range dataSrcs {
+	for _, dataSrc := range s.ListDataSources() {
 		for k, v := range s.List(dataSrc) {
 			pairs[k] = v
 		}
@@ -25,7 +25,9 @@ import (
 	"net/http"
 	"net/url"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 
 	yaml2 "github.com/ghodss/yaml"
 	"github.com/goccy/go-yaml"
@@ -44,6 +46,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/client"
 	"go.ligato.io/vpp-agent/v3/cmd/agentctl/api/types"
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	"go.ligato.io/vpp-agent/v3/pkg/version"
 	"go.ligato.io/vpp-agent/v3/plugins/configurator"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
@@ -72,12 +75,64 @@ const (
 	// <VPP-Agent IP address>:9191/configuration?replace=true
 	URLReplaceParamName = "replace"
 
+	// URLDryRunParamName is URL parameter name for modifying NB configuration PUT/POST behaviour to only
+	// simulate the configuration change and return the execution plan (recorded transaction) without
+	// actually changing anything. It has the same effect as dry-run parameter for agentctl config update.
+	// Examples how to use dry-run:
+	// <VPP-Agent IP address>:9191/configuration?dryrun
+	// <VPP-Agent IP address>:9191/configuration?dryrun=true
+	URLDryRunParamName = "dryrun"
+
+	// URLAtomicParamName is URL parameter name for modifying NB configuration PUT behaviour to apply either
+	// the whole configuration or nothing. If any configuration item fails, all the already applied changes
+	// are reverted. The response then contains per-item results and whether the configuration was rolled back.
+	// Atomic update can't be combined with replace parameter.
+	// Examples how to use atomic update:
+	// <VPP-Agent IP address>:9191/configuration?atomic
+	// <VPP-Agent IP address>:9191/configuration?atomic=true
+	URLAtomicParamName = "atomic"
+
+	// URLPriorityParamName is URL parameter name for setting the priority (low, normal or high) with which
+	// the NB configuration PUT is queued for processing. Pending changes of higher priority are applied first.
+	// Example how to use priority:
+	// <VPP-Agent IP address>:9191/configuration?priority=high
+	URLPriorityParamName = "priority"
+
+	// URLRevisionParamName is URL parameter name for selecting the NB configuration revision
+	// to roll back to.
+	// Example how to use rollback:
+	// <VPP-Agent IP address>:9191/configuration/rollback?revision=3
+	URLRevisionParamName = "revision"
+
 	// YamlContentType is http header content type for YAML content
 	YamlContentType = "application/yaml"
 
 	internalErrorLogPrefix = "500 Internal server error: "
 )
 
+// ConfigurationUpdateResult is the response of the atomic NB configuration update.
+type ConfigurationUpdateResult struct {
+	Results    []orchestrator.Result `json:"results"`
+	RolledBack bool                  `json:"rolledBack"`
+}
+
+// ConfigurationRevision describes a retained revision of the NB configuration.
+type ConfigurationRevision struct {
+	Num         uint64    `json:"num"`
+	Time        time.Time `json:"time"`
+	DataSrc     string    `json:"dataSrc"`
+	Author      string    `json:"author,omitempty"`
+	Description string    `json:"description,omitempty"`
+	TxnSeqNum   uint64    `json:"txnSeqNum"`
+	NumItems    int       `json:"numItems"`
+}
+
+// ConfigurationRollbackResult is the response of the NB configuration rollback.
+type ConfigurationRollbackResult struct {
+	Results  []orchestrator.Result `json:"results"`
+	Revision uint64                `json:"revision"`
+}
+
 var (
 	// ErrHandlerUnavailable represents error returned when particular
 	// handler is not available
@@ -85,14 +140,18 @@ var (
 )
 
 func (p *Plugin) registerInfoHandlers() {
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.Version, p.versionHandler, GET)
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.JSONSchema, p.jsonSchemaHandler, GET)
+	p.httpHandlers.RegisterHTTPHandler(resturl.Version, p.versionHandler, GET)
+	p.httpHandlers.RegisterHTTPHandler(resturl.JSONSchema, p.jsonSchemaHandler, GET)
 }
 
 func (p *Plugin) registerNBConfigurationHandlers() {
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.Validate, p.validationHandler, POST)
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.Configuration, p.configurationGetHandler, GET)
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.Configuration, p.configurationUpdateHandler, PUT)
+	p.httpHandlers.RegisterHTTPHandler(resturl.Validate, p.validationHandler, POST)
+	p.httpHandlers.RegisterHTTPHandler(resturl.Configuration, p.configurationGetHandler, GET)
+	p.httpHandlers.RegisterHTTPHandler(resturl.Configuration, p.configurationUpdateHandler, PUT)
+	p.httpHandlers.RegisterHTTPHandler(resturl.Configuration, p.configurationUpdateHandler, POST)
+	p.httpHandlers.RegisterHTTPHandler(resturl.Revisions, p.configurationRevisionsHandler, GET)
+	p.httpHandlers.RegisterHTTPHandler(resturl.Rollback, p.configurationRollbackHandler, POST)
+	p.httpHandlers.RegisterHTTPHandler(resturl.Conflicts, p.configurationConflictsHandler, GET)
 }
 
 // Registers ABF REST handler
@@ -320,14 +379,14 @@ func (p *Plugin) registerLinuxL3Handlers() {
 
 // Registers Telemetry handler
 func (p *Plugin) registerTelemetryHandlers() {
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.Telemetry, p.telemetryHandler, GET)
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.TMemory, p.telemetryMemoryHandler, GET)
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.TRuntime, p.telemetryRuntimeHandler, GET)
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.TNodeCount, p.telemetryNodeCountHandler, GET)
+	p.httpHandlers.RegisterHTTPHandler(resturl.Telemetry, p.telemetryHandler, GET)
+	p.httpHandlers.RegisterHTTPHandler(resturl.TMemory, p.telemetryMemoryHandler, GET)
+	p.httpHandlers.RegisterHTTPHandler(resturl.TRuntime, p.telemetryRuntimeHandler, GET)
+	p.httpHandlers.RegisterHTTPHandler(resturl.TNodeCount, p.telemetryNodeCountHandler, GET)
 }
 
 func (p *Plugin) registerStatsHandler() {
-	p.HTTPHandlers.RegisterHTTPHandler(resturl.ConfiguratorStats, p.configuratorStatsHandler, GET)
+	p.httpHandlers.RegisterHTTPHandler(resturl.ConfiguratorStats, p.configuratorStatsHandler, GET)
 }
 
 // Registers index page
@@ -344,7 +403,7 @@ func (p *Plugin) registerIndexHandlers() {
 			p.logError(r.HTML(w, http.StatusOK, "index", p.index))
 		}
 	}
-	p.HTTPHandlers.RegisterHTTPHandler("/", handlerFunc, GET)
+	p.httpHandlers.RegisterHTTPHandler("/", handlerFunc, GET)
 }
 
 // registerHTTPHandler is common register method for all handlers
@@ -365,7 +424,7 @@ func (p *Plugin) registerHTTPHandler(key, method string, f func() (interface{},
 			p.logError(formatter.JSON(w, http.StatusOK, res))
 		}
 	}
-	p.HTTPHandlers.RegisterHTTPHandler(key, handlerFunc, method)
+	p.httpHandlers.RegisterHTTPHandler(key, handlerFunc, method)
 }
 
 // jsonSchemaHandler returns JSON schema of VPP-Agent configuration.
@@ -910,27 +969,155 @@ func (p *Plugin) configurationUpdateHandler(formatter *render.Render) http.Handl
 		}
 
 		// create context for data push
-		ctx := context.Background()
+		ctx := tracing.Detach(req.Context())
 		// // FullResync
-		if _, found := req.URL.Query()[URLReplaceParamName]; found {
+		_, replace := req.URL.Query()[URLReplaceParamName]
+		if replace {
 			ctx = kvs.WithResync(ctx, kvs.FullResync, true)
 		}
+		// // Atomic
+		atomic := isURLParamEnabled(req, URLAtomicParamName)
+		if atomic {
+			if replace {
+				errMsg := "atomic update can't be combined with replace\n"
+				p.Log.Error(errMsg)
+				p.logError(formatter.JSON(w, http.StatusBadRequest, errMsg))
+				return
+			}
+			ctx = kvs.WithRevert(ctx)
+		}
+		// // Priority
+		if priorityParam := req.URL.Query().Get(URLPriorityParamName); priorityParam != "" {
+			priority, err := kvs.ParseTxnPriority(priorityParam)
+			if err != nil {
+				errMsg := fmt.Sprintf("%v\n", err)
+				p.Log.Error(errMsg)
+				p.logError(formatter.JSON(w, http.StatusBadRequest, errMsg))
+				return
+			}
+			ctx = kvs.WithPriority(ctx, priority)
+		}
 		// // Note: using "grpc" data source so that 'agentctl update --replace' can also work with this data
 		// // ('agentctl update' can change data also from non-grpc data sources, but
 		// // 'agentctl update --replace' (=resync) can't)
 		ctx = contextdecorator.DataSrcContext(ctx, "grpc")
 
+		// DryRun
+		if isURLParamEnabled(req, URLDryRunParamName) {
+			txn, err := p.Dispatcher.PlanData(ctx, configKVPairs)
+			if err != nil {
+				p.internalError("can't plan data push into vpp-agent", err, w, formatter)
+				return
+			}
+			p.logError(formatter.JSON(w, http.StatusOK, txn))
+			return
+		}
+
 		// config data pushed into VPP-Agent
-		_, err = p.Dispatcher.PushData(ctx, configKVPairs, nil)
+		results, err := p.Dispatcher.PushData(ctx, configKVPairs, nil)
 		if err != nil {
+			if atomic && results != nil {
+				// all the changes were reverted
+				p.logError(formatter.JSON(w, http.StatusOK, ConfigurationUpdateResult{
+					Results:    results,
+					RolledBack: true,
+				}))
+				return
+			}
 			p.internalError("can't push data into vpp-agent", err, w, formatter)
 			return
 		}
 
+		if atomic {
+			p.logError(formatter.JSON(w, http.StatusOK, ConfigurationUpdateResult{Results: results}))
+			return
+		}
 		p.logError(formatter.JSON(w, http.StatusOK, struct{}{}))
 	}
 }
 
+// configurationRevisionsHandler lists retained revisions of NB configuration of VPP-Agent.
+func (p *Plugin) configurationRevisionsHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		revisions := []ConfigurationRevision{}
+		for _, rev := range p.Dispatcher.ListRevisions() {
+			revisions = append(revisions, ConfigurationRevision{
+				Num:         rev.Num,
+				Time:        rev.Time,
+				DataSrc:     rev.DataSrc,
+				Author:      rev.Author,
+				Description: rev.Description,
+				TxnSeqNum:   rev.TxnSeqNum,
+				NumItems:    rev.NumItems(),
+			})
+		}
+		p.logError(formatter.JSON(w, http.StatusOK, revisions))
+	}
+}
+
+// configurationRollbackHandler re-applies NB configuration of an older revision (as a full resync).
+func (p *Plugin) configurationRollbackHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		revision, err := strconv.ParseUint(req.URL.Query().Get(URLRevisionParamName), 10, 64)
+		if err != nil {
+			errMsg := fmt.Sprintf("invalid or missing %q parameter: %v\n", URLRevisionParamName, err)
+			p.Log.Error(errMsg)
+			p.logError(formatter.JSON(w, http.StatusBadRequest, errMsg))
+			return
+		}
+
+		ctx := kvs.WithRetryDefault(tracing.Detach(req.Context()))
+		results, err := p.Dispatcher.Rollback(ctx, revision)
+		if errors.Is(err, orchestrator.ErrRevisionNotFound) {
+			errMsg := fmt.Sprintf("%v\n", err)
+			p.Log.Error(errMsg)
+			p.logError(formatter.JSON(w, http.StatusNotFound, errMsg))
+			return
+		} else if err != nil && results == nil {
+			p.internalError("can't roll back configuration", err, w, formatter)
+			return
+		}
+
+		result := ConfigurationRollbackResult{Results: results}
+		if revs := p.Dispatcher.ListRevisions(); len(revs) > 0 {
+			result.Revision = revs[len(revs)-1].Num
+		}
+		p.logError(formatter.JSON(w, http.StatusOK, result))
+	}
+}
+
+// configurationConflictsHandler lists NB configuration items of VPP-Agent set by multiple data sources.
+func (p *Plugin) configurationConflictsHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		conflicts := []types.ConfigConflict{}
+		for _, c := range p.Dispatcher.ListConflicts() {
+			conflict := types.ConfigConflict{
+				Key:    c.Key,
+				Winner: c.Winner,
+			}
+			for _, src := range c.Sources {
+				conflict.Sources = append(conflict.Sources, types.ConfigConflictSource{
+					DataSrc:  src.DataSrc,
+					Priority: src.Priority,
+					Value:    prototext.MarshalOptions{}.Format(src.Value),
+				})
+			}
+			conflicts = append(conflicts, conflict)
+		}
+		p.logError(formatter.JSON(w, http.StatusOK, conflicts))
+	}
+}
+
+// isURLParamEnabled returns true if the given boolean URL parameter is present
+// without a value or with the value "true" or "1".
+func isURLParamEnabled(req *http.Request, name string) bool {
+	values, found := req.URL.Query()[name]
+	if !found {
+		return false
+	}
+	return len(values) == 0 || values[0] == "" || values[0] == "true" || values[0] == "1"
+}
+
 // telemetryHandler - returns various telemetry data
 func (p *Plugin) telemetryHandler(formatter *render.Render) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
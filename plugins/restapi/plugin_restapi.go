@@ -23,6 +23,7 @@ import (
 	"go.ligato.io/cn-infra/v2/rpc/rest"
 	access "go.ligato.io/cn-infra/v2/rpc/rest/security/model/access-security"
 	"go.ligato.io/cn-infra/v2/servicelabel"
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
 	vpevppcalls "go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls"
 	kvscheduler "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
@@ -66,6 +67,9 @@ type Plugin struct {
 	// Index page
 	index *index
 
+	// HTTP handlers registry tracing the requests
+	httpHandlers rest.HTTPHandlers
+
 	// Handlers
 	vpeHandler  vpevppcalls.VppCoreAPI
 	teleHandler telemetryvppcalls.TelemetryVppAPI
@@ -189,6 +193,7 @@ func (p *Plugin) Init() (err error) {
 
 	// Register permission groups, used if REST security is enabled
 	p.HTTPHandlers.RegisterPermissionGroup(getPermissionsGroups()...)
+	p.httpHandlers = tracing.HTTPHandlers(p.HTTPHandlers)
 
 	return nil
 }
@@ -26,12 +26,21 @@ const (
 
 // Configuration
 const (
-	// Configuration is a path for handling(GET,PUT) all VPP-Agent NB configuration
+	// Configuration is a path for handling(GET,PUT,POST) all VPP-Agent NB configuration
 	Configuration = "/configuration"
 
 	// Validate is a path for validating NB yaml configuration for VPP-Agent (the same all-in-one dynamically
 	// created yaml configuration as used in agentctl configuration get/update)
 	Validate = "/configuration/validate"
+
+	// Revisions is a path for listing retained revisions of VPP-Agent NB configuration
+	Revisions = "/configuration/revisions"
+
+	// Rollback is a path for re-applying NB configuration of an older revision
+	Rollback = "/configuration/rollback"
+
+	// Conflicts is a path for listing NB configuration items set by multiple data sources
+	Conflicts = "/configuration/conflicts"
 )
 
 // Linux Dumps
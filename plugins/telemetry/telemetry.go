@@ -34,6 +34,7 @@ import (
 
 	"go.ligato.io/vpp-agent/v3/pkg/metrics"
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
 	"go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
@@ -138,7 +139,7 @@ func (p *Plugin) Init() error {
 	}
 
 	if p.HTTPHandlers != nil {
-		p.HTTPHandlers.RegisterHTTPHandler("/metrics/{metric}", metricsHandler, "GET")
+		tracing.HTTPHandlers(p.HTTPHandlers).RegisterHTTPHandler("/metrics/{metric}", metricsHandler, "GET")
 	}
 
 	return nil
@@ -166,7 +167,7 @@ func (p *Plugin) setupStatsPoller() error {
 	p.statsPollerServer.ifIndex = p.IfPlugin.GetInterfaceIndex()
 
 	if p.GRPC != nil && p.GRPC.GetServer() != nil {
-		configurator.RegisterStatsPollerServiceServer(p.GRPC.GetServer(), &p.statsPollerServer)
+		configurator.RegisterStatsPollerServiceServer(tracing.ServiceRegistrar(p.GRPC.GetServer()), &p.statsPollerServer)
 	}
 	return nil
 }
@@ -0,0 +1,73 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package tracing
+
+import "time"
+
+const (
+	// default address of the OTLP/gRPC collector
+	defaultEndpoint = "localhost:4317"
+	// default service name reported with the spans
+	defaultServiceName = "vpp-agent"
+	// default timeout for export of a batch of spans
+	defaultExportTimeout = time.Second * 10
+)
+
+// Config file representation for tracing plugin
+type Config struct {
+	// Allows to disable plugin (tracing is disabled by default)
+	Disabled bool `json:"disabled"`
+	// Address (host:port) of the OTLP/gRPC collector the spans are exported to
+	Endpoint string `json:"endpoint"`
+	// Connect to the collector without TLS
+	Insecure bool `json:"insecure"`
+	// Additional headers sent with every export request (e.g. for authentication)
+	Headers map[string]string `json:"headers"`
+	// Timeout for export of a batch of spans, default value is 10s
+	ExportTimeout time.Duration `json:"export-timeout"`
+	// Ratio of traces to sample (0-1), traces continued from clients follow
+	// the sampling decision of the client, default value is 1 (all traces)
+	SampleRatio *float64 `json:"sample-ratio"`
+	// Service name reported with the spans, default value is "vpp-agent"
+	ServiceName string `json:"service-name"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Disabled:      true,
+		Endpoint:      defaultEndpoint,
+		Insecure:      true,
+		ExportTimeout: defaultExportTimeout,
+		ServiceName:   defaultServiceName,
+	}
+}
+
+// loadConfig returns tracing plugin file configuration if exists
+func (p *Plugin) loadConfig() (*Config, error) {
+	cfg := defaultConfig()
+
+	found, err := p.Cfg.LoadValue(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		p.Log.Debugf("Tracing config not found. Using default config: %+v", cfg)
+	} else {
+		p.Log.Debugf("Tracing config found: %+v", cfg)
+	}
+
+	return cfg, nil
+}
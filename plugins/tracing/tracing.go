@@ -0,0 +1,131 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package tracing implements the tracing plugin, which exports spans recorded
+// by the agent (see pkg/tracing) to an OpenTelemetry collector using OTLP/gRPC.
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/infra"
+	"go.ligato.io/cn-infra/v2/servicelabel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+
+	"go.ligato.io/vpp-agent/v3/pkg/version"
+)
+
+// shutdownTimeout limits the time spent exporting the remaining spans on Close.
+const shutdownTimeout = time.Second * 5
+
+// Plugin sets up the global OpenTelemetry tracer provider with the OTLP exporter.
+type Plugin struct {
+	Deps
+
+	tracerProvider *sdktrace.TracerProvider
+}
+
+// Deps represents dependencies of Tracing Plugin
+type Deps struct {
+	infra.PluginDeps
+	ServiceLabel servicelabel.ReaderAPI
+}
+
+// Init initializes Tracing Plugin
+func (p *Plugin) Init() error {
+	config, err := p.loadConfig()
+	if err != nil {
+		return err
+	}
+	if config.Disabled {
+		p.Log.Info("Tracing plugin is disabled")
+		return nil
+	}
+
+	var instanceID string
+	if p.ServiceLabel != nil {
+		instanceID = p.ServiceLabel.GetAgentLabel()
+	}
+	p.tracerProvider, err = newTracerProvider(context.Background(), config, instanceID)
+	if err != nil {
+		return errors.WithMessage(err, "setting up tracer provider failed")
+	}
+
+	otel.SetTracerProvider(p.tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	))
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		p.Log.Warnf("tracing error: %v", err)
+	}))
+
+	p.Log.Infof("Exporting traces to %s", config.Endpoint)
+	return nil
+}
+
+// Close flushes spans that were not yet exported and stops the exporter.
+func (p *Plugin) Close() error {
+	if p.tracerProvider == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return p.tracerProvider.Shutdown(ctx)
+}
+
+// newTracerProvider creates tracer provider exporting spans in batches
+// to the OTLP/gRPC collector.
+func newTracerProvider(ctx context.Context, config *Config, instanceID string) (*sdktrace.TracerProvider, error) {
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(config.Endpoint),
+		otlptracegrpc.WithTimeout(config.ExportTimeout),
+	}
+	if config.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	if len(config.Headers) > 0 {
+		opts = append(opts, otlptracegrpc.WithHeaders(config.Headers))
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := []attribute.KeyValue{
+		semconv.ServiceNameKey.String(config.ServiceName),
+		semconv.ServiceVersionKey.String(version.Version()),
+	}
+	if instanceID != "" {
+		attrs = append(attrs, semconv.ServiceInstanceIDKey.String(instanceID))
+	}
+
+	sampler := sdktrace.AlwaysSample()
+	if config.SampleRatio != nil {
+		sampler = sdktrace.TraceIDRatioBased(*config.SampleRatio)
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, attrs...)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+	), nil
+}
@@ -0,0 +1,99 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+)
+
+// collector is a stand-in for the OTLP/gRPC collector.
+type collector struct {
+	collectortrace.UnimplementedTraceServiceServer
+
+	sync.Mutex
+	spans []*tracepb.ResourceSpans
+}
+
+func (c *collector) Export(_ context.Context, req *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	c.Lock()
+	defer c.Unlock()
+	c.spans = append(c.spans, req.ResourceSpans...)
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func startCollector(t *testing.T) (*collector, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+	c := &collector{}
+	srv := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(srv, c)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return c, lis.Addr().String()
+}
+
+func TestExportToCollector(t *testing.T) {
+	RegisterTestingT(t)
+	c, endpoint := startCollector(t)
+
+	config := defaultConfig()
+	config.Disabled = false
+	config.Endpoint = endpoint
+
+	ctx := context.Background()
+	tp, err := newTracerProvider(ctx, config, "agent1")
+	Expect(err).ToNot(HaveOccurred())
+
+	tracer := tp.Tracer("test")
+	ctx, parent := tracer.Start(ctx, "parent")
+	_, child := tracer.Start(ctx, "child")
+	child.End()
+	parent.End()
+
+	// shutdown flushes the remaining spans
+	Expect(tp.Shutdown(context.Background())).To(Succeed())
+
+	c.Lock()
+	defer c.Unlock()
+	Expect(c.spans).ToNot(BeEmpty())
+
+	resourceAttrs := make(map[string]string)
+	for _, attr := range c.spans[0].GetResource().GetAttributes() {
+		resourceAttrs[attr.GetKey()] = attr.GetValue().GetStringValue()
+	}
+	Expect(resourceAttrs).To(HaveKeyWithValue("service.name", "vpp-agent"))
+	Expect(resourceAttrs).To(HaveKeyWithValue("service.instance.id", "agent1"))
+
+	spans := make(map[string]*tracepb.Span)
+	for _, rs := range c.spans {
+		for _, ss := range rs.GetScopeSpans() {
+			for _, span := range ss.GetSpans() {
+				spans[span.GetName()] = span
+			}
+		}
+	}
+	Expect(spans).To(HaveKey("parent"))
+	Expect(spans).To(HaveKey("child"))
+	Expect(spans["child"].GetTraceId()).To(Equal(spans["parent"].GetTraceId()))
+	Expect(spans["child"].GetParentSpanId()).To(Equal(spans["parent"].GetSpanId()))
+}
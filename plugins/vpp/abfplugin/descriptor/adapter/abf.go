@@ -18,6 +18,17 @@ type ABFKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type ABFBatchOp struct {
+	Key         string
+	Value       *vpp_abf.ABF
+	PrevValue   *vpp_abf.ABF
+	Metadata    *abfidx.ABFMetadata
+	NewMetadata *abfidx.ABFMetadata
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type ABFDescriptor struct {
@@ -34,11 +45,15 @@ type ABFDescriptor struct {
 	Delete               func(key string, value *vpp_abf.ABF, metadata *abfidx.ABFMetadata) error
 	Update               func(key string, oldValue, newValue *vpp_abf.ABF, oldMetadata *abfidx.ABFMetadata) (newMetadata *abfidx.ABFMetadata, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_abf.ABF, metadata *abfidx.ABFMetadata) bool
+	CreateBatch          func(ops []*ABFBatchOp) error
+	UpdateBatch          func(ops []*ABFBatchOp) error
+	DeleteBatch          func(ops []*ABFBatchOp) error
 	Retrieve             func(correlate []ABFKVWithMetadata) ([]ABFKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_abf.ABF) []KeyValuePair
 	Dependencies         func(key string, value *vpp_abf.ABF) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -59,6 +74,7 @@ func NewABFDescriptor(typedDescriptor *ABFDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -78,6 +94,15 @@ func NewABFDescriptor(typedDescriptor *ABFDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -159,6 +184,27 @@ func (da *ABFDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newValu
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *ABFDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castABFBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyABFBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *ABFDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castABFBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyABFBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *ABFDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castABFBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyABFBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *ABFDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []ABFKVWithMetadata
 	for _, kvpair := range correlate {
@@ -222,6 +268,50 @@ func castABFValue(key string, value proto.Message) (*vpp_abf.ABF, error) {
 	return typedValue, nil
 }
 
+func castABFBatchOps(ops []*BatchOp, withPrevValue bool) []*ABFBatchOp {
+	var typedOps []*ABFBatchOp
+	for _, op := range ops {
+		typedValue, err := castABFValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_abf.ABF
+		if withPrevValue {
+			typedPrevValue, err = castABFValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castABFMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &ABFBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyABFBatchResults(ops []*BatchOp, typedOps []*ABFBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castABFMetadata(key string, metadata Metadata) (*abfidx.ABFMetadata, error) {
 	if metadata == nil {
 		return nil, nil
@@ -18,6 +18,17 @@ type ACLKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type ACLBatchOp struct {
+	Key         string
+	Value       *vpp_acl.ACL
+	PrevValue   *vpp_acl.ACL
+	Metadata    *aclidx.ACLMetadata
+	NewMetadata *aclidx.ACLMetadata
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type ACLDescriptor struct {
@@ -34,11 +45,15 @@ type ACLDescriptor struct {
 	Delete               func(key string, value *vpp_acl.ACL, metadata *aclidx.ACLMetadata) error
 	Update               func(key string, oldValue, newValue *vpp_acl.ACL, oldMetadata *aclidx.ACLMetadata) (newMetadata *aclidx.ACLMetadata, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_acl.ACL, metadata *aclidx.ACLMetadata) bool
+	CreateBatch          func(ops []*ACLBatchOp) error
+	UpdateBatch          func(ops []*ACLBatchOp) error
+	DeleteBatch          func(ops []*ACLBatchOp) error
 	Retrieve             func(correlate []ACLKVWithMetadata) ([]ACLKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_acl.ACL) []KeyValuePair
 	Dependencies         func(key string, value *vpp_acl.ACL) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -59,6 +74,7 @@ func NewACLDescriptor(typedDescriptor *ACLDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -78,6 +94,15 @@ func NewACLDescriptor(typedDescriptor *ACLDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -159,6 +184,27 @@ func (da *ACLDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newValu
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *ACLDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castACLBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyACLBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *ACLDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castACLBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyACLBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *ACLDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castACLBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyACLBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *ACLDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []ACLKVWithMetadata
 	for _, kvpair := range correlate {
@@ -222,6 +268,50 @@ func castACLValue(key string, value proto.Message) (*vpp_acl.ACL, error) {
 	return typedValue, nil
 }
 
+func castACLBatchOps(ops []*BatchOp, withPrevValue bool) []*ACLBatchOp {
+	var typedOps []*ACLBatchOp
+	for _, op := range ops {
+		typedValue, err := castACLValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_acl.ACL
+		if withPrevValue {
+			typedPrevValue, err = castACLValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castACLMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &ACLBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyACLBatchResults(ops []*BatchOp, typedOps []*ACLBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castACLMetadata(key string, metadata Metadata) (*aclidx.ACLMetadata, error) {
 	if metadata == nil {
 		return nil, nil
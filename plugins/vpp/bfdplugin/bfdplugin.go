@@ -0,0 +1,170 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name BfdSession --value-type *vpp_bfd.BfdSession --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name BfdAuthKey --value-type *vpp_bfd.BfdAuthKey --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd" --output-dir "descriptor"
+
+package bfdplugin
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls/vpp2101"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls/vpp2106"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls/vpp2210"
+)
+
+// BfdPlugin configures VPP BFD sessions using GoVPP and notifies about
+// changes of their state.
+type BfdPlugin struct {
+	Deps
+
+	// handlers
+	bfdHandler vppcalls.BfdVppAPI
+
+	// descriptors
+	sessionStateDescriptor *descriptor.BfdSessionStateDescriptor
+
+	// BFD events
+	bfdEvents       chan *vppcalls.BfdSessionDetails
+	cancelBfdEvents context.CancelFunc
+
+	// go routine management
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Deps lists dependencies of the BFD plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler      kvs.KVScheduler
+	VPP              govppmux.API
+	IfPlugin         ifplugin.API
+	StatusCheck      statuscheck.PluginStatusWriter // optional
+	PushNotification func(notification *vpp.Notification)
+}
+
+// Init registers BFD-related descriptors.
+func (p *BfdPlugin) Init() (err error) {
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+
+	// init handlers
+	p.bfdHandler = vppcalls.CompatibleBfdVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.bfdHandler == nil {
+		return errors.New("BFD VPP handler is not available")
+	}
+
+	// init and register descriptors
+	var sessionStateDescriptor *kvs.KVDescriptor
+	sessionStateDescriptor, p.sessionStateDescriptor = descriptor.NewBfdSessionStateDescriptor(
+		p.KVScheduler, p.bfdHandler, p.Log)
+	authKeyDescriptor := descriptor.NewBfdAuthKeyDescriptor(p.bfdHandler, p.Log)
+	sessionDescriptor := descriptor.NewBfdSessionDescriptor(p.bfdHandler, p.sessionStateDescriptor, p.Log)
+
+	err = p.KVScheduler.RegisterKVDescriptor(
+		authKeyDescriptor,
+		sessionDescriptor,
+		sessionStateDescriptor,
+	)
+	if err != nil {
+		return err
+	}
+
+	// start processing of BFD events
+	p.bfdEvents = make(chan *vppcalls.BfdSessionDetails, 100)
+	p.wg.Add(1)
+	go p.processBfdEvents()
+
+	return nil
+}
+
+// AfterInit subscribes for BFD events from VPP.
+func (p *BfdPlugin) AfterInit() error {
+	if err := p.watchBfdEvents(); err != nil {
+		return err
+	}
+	p.VPP.OnReconnect(func() {
+		p.cancelBfdEvents()
+		if err := p.watchBfdEvents(); err != nil {
+			p.Log.Warnf("WatchBfdEvents failed: %v", err)
+		}
+	})
+
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
+
+// Close stops watching for BFD events.
+func (p *BfdPlugin) Close() error {
+	p.cancel()
+	p.wg.Wait()
+	return nil
+}
+
+// SetNotifyService sets notification callback for processing VPP notifications.
+func (p *BfdPlugin) SetNotifyService(notify func(notification *vpp.Notification)) {
+	p.PushNotification = notify
+}
+
+// watchBfdEvents subscribes for BFD events from VPP.
+func (p *BfdPlugin) watchBfdEvents() error {
+	var ctx context.Context
+	ctx, p.cancelBfdEvents = context.WithCancel(p.ctx)
+	return p.bfdHandler.WatchBfdEvents(ctx, p.bfdEvents)
+}
+
+// processBfdEvents updates the state of BFD sessions in the KVScheduler
+// and publishes BFD notifications.
+func (p *BfdPlugin) processBfdEvents() {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case event := <-p.bfdEvents:
+			// if the event is a result of a configuration change,
+			// make sure the associated transaction has already finalized
+			p.KVScheduler.TransactionBarrier()
+
+			p.sessionStateDescriptor.UpdateSessionState(event)
+			if p.PushNotification != nil {
+				p.PushNotification(&vpp.Notification{
+					BfdSession: &bfd.BfdSessionNotification{
+						Session: event.Session,
+						State:   event.State,
+					},
+				})
+			}
+
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
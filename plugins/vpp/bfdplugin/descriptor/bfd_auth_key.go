@@ -0,0 +1,130 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+const (
+	// BfdAuthKeyDescriptorName is the name of the descriptor for BFD authentication keys.
+	BfdAuthKeyDescriptorName = "vpp-bfd-auth-key"
+
+	// maximum length of the key secret
+	maxAuthKeyLen = 20
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrBfdAuthKeyWithoutSecret is returned when BFD authentication key has no secret.
+	ErrBfdAuthKeyWithoutSecret = errors.New("BFD authentication key defined without secret")
+
+	// ErrBfdAuthKeyTooLong is returned when the secret exceeds 20 bytes.
+	ErrBfdAuthKeyTooLong = errors.Errorf("BFD authentication key secret cannot be longer than %d bytes", maxAuthKeyLen)
+)
+
+// BfdAuthKeyDescriptor teaches KVScheduler how to configure VPP BFD authentication keys.
+type BfdAuthKeyDescriptor struct {
+	log        logging.Logger
+	bfdHandler vppcalls.BfdVppAPI
+}
+
+// NewBfdAuthKeyDescriptor creates a new instance of the BfdAuthKey descriptor.
+func NewBfdAuthKeyDescriptor(bfdHandler vppcalls.BfdVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &BfdAuthKeyDescriptor{
+		bfdHandler: bfdHandler,
+		log:        log.NewLogger("bfd-auth-key-descriptor"),
+	}
+	typedDescr := &adapter.BfdAuthKeyDescriptor{
+		Name:               BfdAuthKeyDescriptorName,
+		NBKeyPrefix:        bfd.ModelBfdAuthKey.KeyPrefix(),
+		ValueTypeName:      bfd.ModelBfdAuthKey.ProtoName(),
+		KeySelector:        bfd.ModelBfdAuthKey.IsKeyValid,
+		KeyLabel:           bfd.ModelBfdAuthKey.StripKeyPrefix,
+		Validate:           ctx.Validate,
+		Create:             ctx.Create,
+		Delete:             ctx.Delete,
+		UpdateWithRecreate: ctx.UpdateWithRecreate,
+		Retrieve:           ctx.Retrieve,
+	}
+	return adapter.NewBfdAuthKeyDescriptor(typedDescr)
+}
+
+// Validate validates BFD authentication key configuration.
+func (d *BfdAuthKeyDescriptor) Validate(key string, authKey *bfd.BfdAuthKey) error {
+	if authKey.Secret == "" {
+		return kvs.NewInvalidValueError(ErrBfdAuthKeyWithoutSecret, "secret")
+	}
+	if len(authKey.Secret) > maxAuthKeyLen {
+		return kvs.NewInvalidValueError(ErrBfdAuthKeyTooLong, "secret")
+	}
+	return nil
+}
+
+// Create adds new BFD authentication key.
+func (d *BfdAuthKeyDescriptor) Create(key string, authKey *bfd.BfdAuthKey) (interface{}, error) {
+	if err := d.bfdHandler.SetBfdAuthKey(authKey); err != nil {
+		return nil, errors.Errorf("failed to add BFD authentication key %d: %v", authKey.Id, err)
+	}
+	return nil, nil
+}
+
+// Delete removes BFD authentication key.
+func (d *BfdAuthKeyDescriptor) Delete(key string, authKey *bfd.BfdAuthKey, metadata interface{}) error {
+	if err := d.bfdHandler.DeleteBfdAuthKey(authKey.Id); err != nil {
+		return errors.Errorf("failed to delete BFD authentication key %d: %v", authKey.Id, err)
+	}
+	return nil
+}
+
+// UpdateWithRecreate always returns true - VPP does not allow to change key
+// which is used by BFD sessions, therefore the sessions are removed
+// together with the key and re-created with the new revision.
+func (d *BfdAuthKeyDescriptor) UpdateWithRecreate(key string, oldKey, newKey *bfd.BfdAuthKey, metadata interface{}) bool {
+	return true
+}
+
+// Retrieve returns all BFD authentication keys configured in VPP.
+func (d *BfdAuthKeyDescriptor) Retrieve(correlate []adapter.BfdAuthKeyKVWithMetadata) (
+	retrieved []adapter.BfdAuthKeyKVWithMetadata, err error,
+) {
+	// secrets cannot be dumped from VPP
+	nbKeys := make(map[uint32]*bfd.BfdAuthKey)
+	for _, kv := range correlate {
+		nbKeys[kv.Value.Id] = kv.Value
+	}
+
+	authKeys, err := d.bfdHandler.DumpBfdAuthKeys()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump BFD authentication keys: %v", err)
+	}
+	for _, authKey := range authKeys {
+		if nbKey, hasNbKey := nbKeys[authKey.Id]; hasNbKey && nbKey.AuthType == authKey.AuthType {
+			authKey.Secret = nbKey.Secret
+		}
+		retrieved = append(retrieved, adapter.BfdAuthKeyKVWithMetadata{
+			Key:    bfd.AuthKeyKey(authKey.Id),
+			Value:  authKey,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
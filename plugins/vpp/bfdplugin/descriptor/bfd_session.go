@@ -0,0 +1,202 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// BfdSessionDescriptorName is the name of the descriptor for BFD sessions.
+	BfdSessionDescriptorName = "vpp-bfd-session"
+
+	// dependency labels
+	interfaceDep = "interface-exists"
+	authKeyDep   = "bfd-auth-key-exists"
+
+	// maximum value of detect multiplier and BFD key ID (carried in a single byte)
+	maxUint8 = 255
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrBfdSessionWithoutInterface is returned when single-hop BFD session has no interface.
+	ErrBfdSessionWithoutInterface = errors.New("single-hop BFD session defined without interface")
+
+	// ErrBfdMultihopSessionWithInterface is returned when multi-hop BFD session has interface.
+	ErrBfdMultihopSessionWithInterface = errors.New("multi-hop BFD session cannot be bound to interface")
+
+	// ErrBfdSessionInvalidAddr is returned when local or peer IP address is not valid.
+	ErrBfdSessionInvalidAddr = errors.New("invalid IP address")
+
+	// ErrBfdSessionAddrFamilyMismatch is returned when local and peer IP address
+	// are from different families.
+	ErrBfdSessionAddrFamilyMismatch = errors.New("local and peer IP addresses are from different families")
+
+	// ErrBfdSessionInvalidMultiplier is returned when detect multiplier is zero or above 255.
+	ErrBfdSessionInvalidMultiplier = errors.New("detect multiplier must be in the range 1-255")
+
+	// ErrBfdSessionInvalidInterval is returned when desired minimum TX interval is zero.
+	ErrBfdSessionInvalidInterval = errors.New("desired minimum TX interval must be non-zero")
+
+	// ErrBfdSessionInvalidKeyID is returned when BFD key ID is above 255.
+	ErrBfdSessionInvalidKeyID = errors.New("BFD key ID must be in the range 0-255")
+)
+
+// BfdSessionDescriptor teaches KVScheduler how to configure VPP BFD sessions.
+type BfdSessionDescriptor struct {
+	log          logging.Logger
+	bfdHandler   vppcalls.BfdVppAPI
+	sessionState *BfdSessionStateDescriptor
+}
+
+// NewBfdSessionDescriptor creates a new instance of the BfdSession descriptor.
+func NewBfdSessionDescriptor(bfdHandler vppcalls.BfdVppAPI, sessionState *BfdSessionStateDescriptor,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &BfdSessionDescriptor{
+		bfdHandler:   bfdHandler,
+		sessionState: sessionState,
+		log:          log.NewLogger("bfd-session-descriptor"),
+	}
+	typedDescr := &adapter.BfdSessionDescriptor{
+		Name:               BfdSessionDescriptorName,
+		NBKeyPrefix:        bfd.ModelBfdSession.KeyPrefix(),
+		ValueTypeName:      bfd.ModelBfdSession.ProtoName(),
+		KeySelector:        bfd.ModelBfdSession.IsKeyValid,
+		KeyLabel:           bfd.ModelBfdSession.StripKeyPrefix,
+		Validate:           ctx.Validate,
+		Create:             ctx.Create,
+		Update:             ctx.Update,
+		Delete:             ctx.Delete,
+		UpdateWithRecreate: ctx.UpdateWithRecreate,
+		Retrieve:           ctx.Retrieve,
+		Dependencies:       ctx.Dependencies,
+		RetrieveDependencies: []string{
+			ifdescriptor.InterfaceDescriptorName,
+			BfdAuthKeyDescriptorName,
+		},
+	}
+	return adapter.NewBfdSessionDescriptor(typedDescr)
+}
+
+// Validate validates BFD session configuration.
+func (d *BfdSessionDescriptor) Validate(key string, session *bfd.BfdSession) error {
+	if session.Multihop && session.Interface != "" {
+		return kvs.NewInvalidValueError(ErrBfdMultihopSessionWithInterface, "interface")
+	}
+	if !session.Multihop && session.Interface == "" {
+		return kvs.NewInvalidValueError(ErrBfdSessionWithoutInterface, "interface")
+	}
+	localIP := net.ParseIP(session.LocalIp)
+	if localIP == nil {
+		return kvs.NewInvalidValueError(ErrBfdSessionInvalidAddr, "local_ip")
+	}
+	peerIP := net.ParseIP(session.PeerIp)
+	if peerIP == nil {
+		return kvs.NewInvalidValueError(ErrBfdSessionInvalidAddr, "peer_ip")
+	}
+	if (localIP.To4() == nil) != (peerIP.To4() == nil) {
+		return kvs.NewInvalidValueError(ErrBfdSessionAddrFamilyMismatch, "local_ip", "peer_ip")
+	}
+	if session.DetectMultiplier == 0 || session.DetectMultiplier > maxUint8 {
+		return kvs.NewInvalidValueError(ErrBfdSessionInvalidMultiplier, "detect_multiplier")
+	}
+	if session.DesiredMinTxInterval == 0 {
+		return kvs.NewInvalidValueError(ErrBfdSessionInvalidInterval, "desired_min_tx_interval")
+	}
+	if session.Authentication.GetBfdKeyId() > maxUint8 {
+		return kvs.NewInvalidValueError(ErrBfdSessionInvalidKeyID, "authentication.bfd_key_id")
+	}
+	return nil
+}
+
+// Create adds new BFD session.
+func (d *BfdSessionDescriptor) Create(key string, session *bfd.BfdSession) (interface{}, error) {
+	if err := d.bfdHandler.AddBfdSession(session); err != nil {
+		return nil, errors.Errorf("failed to add BFD session %s: %v", key, err)
+	}
+	return nil, nil
+}
+
+// Update modifies timers of the BFD session.
+func (d *BfdSessionDescriptor) Update(key string, oldSession, newSession *bfd.BfdSession, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	if err := d.bfdHandler.ModifyBfdSession(newSession); err != nil {
+		return nil, errors.Errorf("failed to modify BFD session %s: %v", key, err)
+	}
+	return nil, nil
+}
+
+// Delete removes BFD session.
+func (d *BfdSessionDescriptor) Delete(key string, session *bfd.BfdSession, metadata interface{}) error {
+	if err := d.bfdHandler.DeleteBfdSession(session); err != nil {
+		return errors.Errorf("failed to delete BFD session %s: %v", key, err)
+	}
+	// VPP does not send any event for removed session
+	d.sessionState.RemoveSessionState(session)
+	return nil
+}
+
+// UpdateWithRecreate returns true if the authentication of the session has changed.
+func (d *BfdSessionDescriptor) UpdateWithRecreate(key string, oldSession, newSession *bfd.BfdSession, metadata interface{}) bool {
+	return !proto.Equal(oldSession.Authentication, newSession.Authentication)
+}
+
+// Retrieve returns all BFD sessions configured in VPP.
+func (d *BfdSessionDescriptor) Retrieve(correlate []adapter.BfdSessionKVWithMetadata) (
+	retrieved []adapter.BfdSessionKVWithMetadata, err error,
+) {
+	sessions, err := d.bfdHandler.DumpBfdSessions()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump BFD sessions: %v", err)
+	}
+	for _, session := range sessions {
+		retrieved = append(retrieved, adapter.BfdSessionKVWithMetadata{
+			Key:    bfd.SessionKey(session.Session.Interface, session.Session.LocalIp, session.Session.PeerIp, session.Session.Multihop),
+			Value:  session.Session,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface and the authentication key as dependencies.
+func (d *BfdSessionDescriptor) Dependencies(key string, session *bfd.BfdSession) (deps []kvs.Dependency) {
+	if !session.Multihop {
+		deps = append(deps, kvs.Dependency{
+			Label: interfaceDep,
+			Key:   interfaces.InterfaceKey(session.Interface),
+		})
+	}
+	if session.Authentication != nil {
+		deps = append(deps, kvs.Dependency{
+			Label: authKeyDep,
+			Key:   bfd.AuthKeyKey(session.Authentication.KeyId),
+		})
+	}
+	return deps
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"sync"
+
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+const (
+	// BfdSessionStateDescriptorName is the name of the descriptor notifying
+	// about the state changes of BFD sessions.
+	BfdSessionStateDescriptorName = "vpp-bfd-session-state"
+)
+
+// BfdSessionStateDescriptor notifies kvscheduler about the state changes
+// (up/down) of BFD sessions.
+type BfdSessionStateDescriptor struct {
+	// input arguments
+	log         logging.Logger
+	kvscheduler kvs.KVScheduler
+	bfdHandler  vppcalls.BfdVppAPI
+
+	sessionStatesMx sync.Mutex
+	sessionStates   map[string]bool // session name -> session is up
+}
+
+// NewBfdSessionStateDescriptor creates a new instance of the BfdSessionState descriptor.
+func NewBfdSessionStateDescriptor(kvscheduler kvs.KVScheduler, bfdHandler vppcalls.BfdVppAPI,
+	log logging.PluginLogger) (descr *kvs.KVDescriptor, ctx *BfdSessionStateDescriptor) {
+
+	descrCtx := &BfdSessionStateDescriptor{
+		log:           log.NewLogger("bfd-session-state"),
+		kvscheduler:   kvscheduler,
+		bfdHandler:    bfdHandler,
+		sessionStates: make(map[string]bool),
+	}
+	return &kvs.KVDescriptor{
+		Name:        BfdSessionStateDescriptorName,
+		KeySelector: descrCtx.IsBfdSessionStateKey,
+		Retrieve:    descrCtx.Retrieve,
+		// Retrieve depends on the interface descriptor: interface index is used
+		// to convert sw_if_index to logical interface name
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+	}, descrCtx
+}
+
+// IsBfdSessionStateKey returns <true> for keys representing state of BFD sessions.
+func (w *BfdSessionStateDescriptor) IsBfdSessionStateKey(key string) bool {
+	_, _, isSessionStateKey := bfd.ParseSessionStateKey(key)
+	return isSessionStateKey
+}
+
+// Retrieve returns key for every BFD session describing whether the session
+// is up or down (value is empty).
+func (w *BfdSessionStateDescriptor) Retrieve(correlate []kvs.KVWithMetadata) (values []kvs.KVWithMetadata, err error) {
+	sessions, err := w.bfdHandler.DumpBfdSessions()
+	if err != nil {
+		w.log.Error(err)
+		return nil, err
+	}
+
+	w.sessionStatesMx.Lock()
+	defer w.sessionStatesMx.Unlock()
+	w.sessionStates = make(map[string]bool) // clear the map
+
+	for _, session := range sessions {
+		isUp := session.State == bfd.BfdSessionNotification_UP
+		w.sessionStates[models.Name(session.Session)] = isUp
+		values = append(values, kvs.KVWithMetadata{
+			Key:    bfd.SessionStateKey(session.Session, isUp),
+			Value:  &emptypb.Empty{},
+			Origin: kvs.FromSB,
+		})
+	}
+
+	return values, nil
+}
+
+// UpdateSessionState notifies scheduler about a change in the state of a BFD session.
+func (w *BfdSessionStateDescriptor) UpdateSessionState(event *vppcalls.BfdSessionDetails) {
+	w.sessionStatesMx.Lock()
+	defer w.sessionStatesMx.Unlock()
+
+	var notifs []kvs.KVWithMetadata
+
+	name := models.Name(event.Session)
+	wasUp, hadState := w.sessionStates[name]
+	isUp := event.State == bfd.BfdSessionNotification_UP
+
+	if hadState && isUp != wasUp {
+		// remove now obsolete key-value pair
+		notifs = append(notifs, kvs.KVWithMetadata{
+			Key:      bfd.SessionStateKey(event.Session, wasUp),
+			Value:    nil,
+			Metadata: nil,
+		})
+	}
+
+	if !hadState || isUp != wasUp {
+		// push new key-value pair
+		notifs = append(notifs, kvs.KVWithMetadata{
+			Key:      bfd.SessionStateKey(event.Session, isUp),
+			Value:    &emptypb.Empty{},
+			Metadata: nil,
+		})
+		w.sessionStates[name] = isUp
+	}
+
+	w.pushNotifications(notifs)
+}
+
+// RemoveSessionState notifies scheduler that the state of a removed BFD session
+// is no longer known.
+func (w *BfdSessionStateDescriptor) RemoveSessionState(session *bfd.BfdSession) {
+	w.sessionStatesMx.Lock()
+	defer w.sessionStatesMx.Unlock()
+
+	name := models.Name(session)
+	wasUp, hadState := w.sessionStates[name]
+	if !hadState {
+		return
+	}
+	delete(w.sessionStates, name)
+	w.pushNotifications([]kvs.KVWithMetadata{{
+		Key:      bfd.SessionStateKey(session, wasUp),
+		Value:    nil,
+		Metadata: nil,
+	}})
+}
+
+func (w *BfdSessionStateDescriptor) pushNotifications(notifs []kvs.KVWithMetadata) {
+	if len(notifs) != 0 {
+		err := w.kvscheduler.PushSBNotification(notifs...)
+		if err != nil {
+			w.log.Errorf("failed to send notifications to KVScheduler: %v", err)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	"context"
+
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+// BfdSessionDetails contains BFD session configuration together with its state.
+type BfdSessionDetails struct {
+	Session *bfd.BfdSession
+	State   bfd.BfdSessionNotification_State
+}
+
+// BfdVppAPI provides methods for managing VPP BFD sessions and authentication keys.
+type BfdVppAPI interface {
+	BfdVppRead
+
+	// AddBfdSession creates new BFD session.
+	AddBfdSession(session *bfd.BfdSession) error
+	// ModifyBfdSession updates timers of existing BFD session.
+	ModifyBfdSession(session *bfd.BfdSession) error
+	// DeleteBfdSession removes existing BFD session.
+	DeleteBfdSession(session *bfd.BfdSession) error
+	// SetBfdAuthKey creates (or replaces unused) BFD authentication key.
+	SetBfdAuthKey(key *bfd.BfdAuthKey) error
+	// DeleteBfdAuthKey removes BFD authentication key.
+	DeleteBfdAuthKey(id uint32) error
+	// WatchBfdEvents starts watching for BFD session state changes.
+	WatchBfdEvents(ctx context.Context, eventsCh chan<- *BfdSessionDetails) error
+}
+
+// BfdVppRead provides read methods for VPP BFD sessions and authentication keys.
+type BfdVppRead interface {
+	// DumpBfdSessions dumps all BFD sessions configured in VPP together
+	// with their current state.
+	DumpBfdSessions() ([]*BfdSessionDetails, error)
+	// DumpBfdAuthKeys dumps all BFD authentication keys (secrets are not
+	// returned by VPP).
+	DumpBfdAuthKeys() ([]*bfd.BfdAuthKey, error)
+}
+
+var handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "bfd",
+	HandlerAPI: (*BfdVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) BfdVppAPI
+
+func AddBfdHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleBfdVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) BfdVppAPI {
+	if v := handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(BfdVppAPI)
+	}
+	return nil
+}
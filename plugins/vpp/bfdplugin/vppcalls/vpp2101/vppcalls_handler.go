@@ -0,0 +1,48 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2101
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	vpp2101 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/bfd"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+)
+
+func init() {
+	msgs := vpp_bfd.AllMessages()
+	vppcalls.AddBfdHandlerVersion(vpp2101.Version, msgs, NewBfdVppHandler)
+}
+
+// BfdVppHandler is accessor for BFD-related vppcalls methods
+type BfdVppHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+// NewBfdVppHandler creates new instance of BFD vppcalls handler
+func NewBfdVppHandler(
+	callsChan govppapi.Channel, ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger,
+) vppcalls.BfdVppAPI {
+	return &BfdVppHandler{
+		callsChannel: callsChan,
+		ifIndexes:    ifIndexes,
+		log:          log,
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2106
+
+import (
+	"github.com/pkg/errors"
+
+	vpp_bfd "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/bfd"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip_types"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+// maxAuthKeyLen is the maximum length of BFD authentication key secret.
+const maxAuthKeyLen = 20
+
+// AddBfdSession implements BFD handler.
+func (h *BfdVppHandler) AddBfdSession(session *bfd.BfdSession) error {
+	swIfIndex, localAddr, peerAddr, err := h.sessionID(session)
+	if err != nil {
+		return err
+	}
+	req := &vpp_bfd.BfdUDPAdd{
+		SwIfIndex:     swIfIndex,
+		DesiredMinTx:  session.DesiredMinTxInterval,
+		RequiredMinRx: session.RequiredMinRxInterval,
+		LocalAddr:     localAddr,
+		PeerAddr:      peerAddr,
+		DetectMult:    uint8(session.DetectMultiplier),
+	}
+	if auth := session.Authentication; auth != nil {
+		req.IsAuthenticated = true
+		req.BfdKeyID = uint8(auth.BfdKeyId)
+		req.ConfKeyID = auth.KeyId
+	}
+	reply := &vpp_bfd.BfdUDPAddReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ModifyBfdSession implements BFD handler.
+func (h *BfdVppHandler) ModifyBfdSession(session *bfd.BfdSession) error {
+	swIfIndex, localAddr, peerAddr, err := h.sessionID(session)
+	if err != nil {
+		return err
+	}
+	req := &vpp_bfd.BfdUDPMod{
+		SwIfIndex:     swIfIndex,
+		DesiredMinTx:  session.DesiredMinTxInterval,
+		RequiredMinRx: session.RequiredMinRxInterval,
+		LocalAddr:     localAddr,
+		PeerAddr:      peerAddr,
+		DetectMult:    uint8(session.DetectMultiplier),
+	}
+	reply := &vpp_bfd.BfdUDPModReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteBfdSession implements BFD handler.
+func (h *BfdVppHandler) DeleteBfdSession(session *bfd.BfdSession) error {
+	swIfIndex, localAddr, peerAddr, err := h.sessionID(session)
+	if err != nil {
+		return err
+	}
+	req := &vpp_bfd.BfdUDPDel{
+		SwIfIndex: swIfIndex,
+		LocalAddr: localAddr,
+		PeerAddr:  peerAddr,
+	}
+	reply := &vpp_bfd.BfdUDPDelReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetBfdAuthKey implements BFD handler.
+func (h *BfdVppHandler) SetBfdAuthKey(key *bfd.BfdAuthKey) error {
+	if len(key.Secret) > maxAuthKeyLen {
+		return errors.Errorf("BFD authentication key secret cannot be longer than %d bytes", maxAuthKeyLen)
+	}
+	req := &vpp_bfd.BfdAuthSetKey{
+		ConfKeyID: key.Id,
+		KeyLen:    uint8(len(key.Secret)),
+		AuthType:  toAuthType(key.AuthType),
+		Key:       []byte(key.Secret),
+	}
+	reply := &vpp_bfd.BfdAuthSetKeyReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteBfdAuthKey implements BFD handler.
+func (h *BfdVppHandler) DeleteBfdAuthKey(id uint32) error {
+	req := &vpp_bfd.BfdAuthDelKey{
+		ConfKeyID: id,
+	}
+	reply := &vpp_bfd.BfdAuthDelKeyReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// sessionID returns fields identifying the BFD session in VPP.
+// Multi-hop sessions are not bound to any interface.
+func (h *BfdVppHandler) sessionID(session *bfd.BfdSession) (
+	swIfIndex interface_types.InterfaceIndex, localAddr, peerAddr ip_types.Address, err error,
+) {
+	swIfIndex = ^interface_types.InterfaceIndex(0)
+	if !session.Multihop {
+		meta, found := h.ifIndexes.LookupByName(session.Interface)
+		if !found {
+			return 0, localAddr, peerAddr, errors.Errorf("failed to get index of interface %s", session.Interface)
+		}
+		swIfIndex = interface_types.InterfaceIndex(meta.SwIfIndex)
+	}
+	if localAddr, err = ip_types.ParseAddress(session.LocalIp); err != nil {
+		return 0, localAddr, peerAddr, errors.Errorf("invalid local IP %q: %v", session.LocalIp, err)
+	}
+	if peerAddr, err = ip_types.ParseAddress(session.PeerIp); err != nil {
+		return 0, localAddr, peerAddr, errors.Errorf("invalid peer IP %q: %v", session.PeerIp, err)
+	}
+	return swIfIndex, localAddr, peerAddr, nil
+}
+
+// VPP values of the BFD authentication types (bfd_auth_type_e)
+const (
+	authTypeKeyedSHA1           uint8 = 4
+	authTypeMeticulousKeyedSHA1 uint8 = 5
+)
+
+func toAuthType(authType bfd.BfdAuthKey_AuthType) uint8 {
+	if authType == bfd.BfdAuthKey_METICULOUS_KEYED_SHA1 {
+		return authTypeMeticulousKeyedSHA1
+	}
+	return authTypeKeyedSHA1
+}
+
+func fromAuthType(authType uint8) bfd.BfdAuthKey_AuthType {
+	if authType == authTypeMeticulousKeyedSHA1 {
+		return bfd.BfdAuthKey_METICULOUS_KEYED_SHA1
+	}
+	return bfd.BfdAuthKey_KEYED_SHA1
+}
+
+func fromBfdState(state vpp_bfd.BfdState) bfd.BfdSessionNotification_State {
+	switch state {
+	case vpp_bfd.BFD_STATE_API_ADMIN_DOWN:
+		return bfd.BfdSessionNotification_ADMIN_DOWN
+	case vpp_bfd.BFD_STATE_API_DOWN:
+		return bfd.BfdSessionNotification_DOWN
+	case vpp_bfd.BFD_STATE_API_INIT:
+		return bfd.BfdSessionNotification_INIT
+	case vpp_bfd.BFD_STATE_API_UP:
+		return bfd.BfdSessionNotification_UP
+	}
+	return bfd.BfdSessionNotification_UNKNOWN
+}
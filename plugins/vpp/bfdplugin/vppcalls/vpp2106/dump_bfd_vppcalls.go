@@ -0,0 +1,93 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2106
+
+import (
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/bfd"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip_types"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+// DumpBfdSessions implements BFD handler.
+func (h *BfdVppHandler) DumpBfdSessions() (sessions []*vppcalls.BfdSessionDetails, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_bfd.BfdUDPSessionDump{})
+	for {
+		details := &vpp_bfd.BfdUDPSessionDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		session, found := h.toBfdSession(details.SwIfIndex, details.LocalAddr, details.PeerAddr)
+		if !found {
+			h.log.Warnf("BFD session dump: interface name for index %d not found", details.SwIfIndex)
+			continue
+		}
+		session.DesiredMinTxInterval = details.DesiredMinTx
+		session.RequiredMinRxInterval = details.RequiredMinRx
+		session.DetectMultiplier = uint32(details.DetectMult)
+		if details.IsAuthenticated {
+			session.Authentication = &bfd.BfdSession_Authentication{
+				KeyId:    details.ConfKeyID,
+				BfdKeyId: uint32(details.BfdKeyID),
+			}
+		}
+		sessions = append(sessions, &vppcalls.BfdSessionDetails{
+			Session: session,
+			State:   fromBfdState(details.State),
+		})
+	}
+	return sessions, nil
+}
+
+// DumpBfdAuthKeys implements BFD handler.
+func (h *BfdVppHandler) DumpBfdAuthKeys() (keys []*bfd.BfdAuthKey, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_bfd.BfdAuthKeysDump{})
+	for {
+		details := &vpp_bfd.BfdAuthKeysDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, &bfd.BfdAuthKey{
+			Id:       details.ConfKeyID,
+			AuthType: fromAuthType(details.AuthType),
+		})
+	}
+	return keys, nil
+}
+
+// toBfdSession builds BFD session identified by the given VPP fields.
+func (h *BfdVppHandler) toBfdSession(swIfIndex interface_types.InterfaceIndex,
+	localAddr, peerAddr ip_types.Address) (session *bfd.BfdSession, found bool) {
+
+	session = &bfd.BfdSession{
+		LocalIp: localAddr.String(),
+		PeerIp:  peerAddr.String(),
+	}
+	if swIfIndex == ^interface_types.InterfaceIndex(0) {
+		session.Multihop = true
+		return session, true
+	}
+	session.Interface, _, found = h.ifIndexes.LookupBySwIfIndex(uint32(swIfIndex))
+	return session, found
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	govppapi "go.fd.io/govpp/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/bfd"
+)
+
+var (
+	// EventDeliverTimeout defines maximum time to deliver event upstream.
+	EventDeliverTimeout = time.Second
+	// NotifChanBufferSize defines size of notification channel buffer.
+	NotifChanBufferSize = 10
+)
+
+// WatchBfdEvents implements BFD handler.
+func (h *BfdVppHandler) WatchBfdEvents(ctx context.Context, eventsCh chan<- *vppcalls.BfdSessionDetails) error {
+	notifChan := make(chan govppapi.Message, NotifChanBufferSize)
+
+	// subscribe to BfdUDPSessionEvent notifications
+	sub, err := h.callsChannel.SubscribeNotification(notifChan, &vpp_bfd.BfdUDPSessionEvent{})
+	if err != nil {
+		return errors.Errorf("subscribing to VPP notification (bfd_udp_session_event) failed: %v", err)
+	}
+	unsub := func() {
+		if err := sub.Unsubscribe(); err != nil {
+			h.log.Warnf("unsubscribing VPP notification (bfd_udp_session_event) failed: %v", err)
+		}
+	}
+
+	go func() {
+		h.log.Debugf("start watching BFD events")
+		defer h.log.Debugf("done watching BFD events (%v)", ctx.Err())
+
+		for {
+			select {
+			case e, open := <-notifChan:
+				if !open {
+					h.log.Debugf("BFD events channel was closed")
+					unsub()
+					return
+				}
+
+				bfdEvent, ok := e.(*vpp_bfd.BfdUDPSessionEvent)
+				if !ok {
+					h.log.Debugf("unexpected notification type: %#v", bfdEvent)
+					continue
+				}
+				session, found := h.toBfdSession(bfdEvent.SwIfIndex, bfdEvent.LocalAddr, bfdEvent.PeerAddr)
+				if !found {
+					h.log.Debugf("BFD event for unknown interface with index %d", bfdEvent.SwIfIndex)
+					continue
+				}
+				event := &vppcalls.BfdSessionDetails{
+					Session: session,
+					State:   fromBfdState(bfdEvent.State),
+				}
+
+				// try to send event
+				select {
+				case eventsCh <- event:
+					// sent ok
+				case <-ctx.Done():
+					unsub()
+					return
+				default:
+					// channel full send event in goroutine for later processing
+					go func() {
+						select {
+						case eventsCh <- event:
+							// sent ok
+						case <-time.After(EventDeliverTimeout):
+							h.log.Warnf("unable to deliver BFD event, dropping it: %+v", bfdEvent)
+						}
+					}()
+				}
+			case <-ctx.Done():
+				unsub()
+				return
+			}
+		}
+	}()
+
+	// enable BFD events from VPP
+	if err := h.callsChannel.SendRequest(&vpp_bfd.WantBfdEvents{
+		PID:           uint32(os.Getpid()),
+		EnableDisable: true,
+	}).ReceiveReply(&vpp_bfd.WantBfdEventsReply{}); err != nil {
+		if errors.Is(err, govppapi.VPPApiError(govppapi.INVALID_REGISTRATION)) {
+			h.log.Warnf("already subscribed to BFD events: %v", err)
+			return nil
+		}
+		return errors.Errorf("failed to watch BFD events: %v", err)
+	}
+
+	return nil
+}
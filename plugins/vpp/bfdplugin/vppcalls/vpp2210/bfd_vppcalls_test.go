@@ -0,0 +1,245 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls"
+	vpp2210 "go.ligato.io/vpp-agent/v3/plugins/vpp/bfdplugin/vppcalls/vpp2210"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/bfd"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+)
+
+func TestAddBfdSession(t *testing.T) {
+	ctx, bfdHandler, ifIndexes := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("memif1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdUDPAddReply{})
+	err := bfdHandler.AddBfdSession(&bfd.BfdSession{
+		Interface:             "memif1",
+		LocalIp:               "10.0.0.1",
+		PeerIp:                "10.0.0.2",
+		DesiredMinTxInterval:  100000,
+		RequiredMinRxInterval: 200000,
+		DetectMultiplier:      3,
+		Authentication: &bfd.BfdSession_Authentication{
+			KeyId:    5,
+			BfdKeyId: 1,
+		},
+	})
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_bfd.BfdUDPAdd)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.LocalAddr.String()).To(Equal("10.0.0.1"))
+	Expect(vppMsg.PeerAddr.String()).To(Equal("10.0.0.2"))
+	Expect(vppMsg.DesiredMinTx).To(BeEquivalentTo(100000))
+	Expect(vppMsg.RequiredMinRx).To(BeEquivalentTo(200000))
+	Expect(vppMsg.DetectMult).To(BeEquivalentTo(3))
+	Expect(vppMsg.IsAuthenticated).To(BeTrue())
+	Expect(vppMsg.ConfKeyID).To(BeEquivalentTo(5))
+	Expect(vppMsg.BfdKeyID).To(BeEquivalentTo(1))
+}
+
+func TestAddMultihopBfdSession(t *testing.T) {
+	ctx, bfdHandler, _ := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdUDPAddReply{})
+	err := bfdHandler.AddBfdSession(&bfd.BfdSession{
+		LocalIp:  "fd00::1",
+		PeerIp:   "fd01::2",
+		Multihop: true,
+	})
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_bfd.BfdUDPAdd)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(vppMsg.LocalAddr.Af).To(Equal(ip_types.ADDRESS_IP6))
+	Expect(vppMsg.IsAuthenticated).To(BeFalse())
+}
+
+func TestAddBfdSessionError(t *testing.T) {
+	ctx, bfdHandler, _ := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// unknown interface
+	err := bfdHandler.AddBfdSession(&bfd.BfdSession{
+		Interface: "memif1",
+		LocalIp:   "10.0.0.1",
+		PeerIp:    "10.0.0.2",
+	})
+	Expect(err).ToNot(BeNil())
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdUDPAddReply{Retval: -1})
+	err = bfdHandler.AddBfdSession(&bfd.BfdSession{
+		LocalIp:  "10.0.0.1",
+		PeerIp:   "10.1.0.2",
+		Multihop: true,
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestModifyAndDeleteBfdSession(t *testing.T) {
+	ctx, bfdHandler, ifIndexes := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("memif1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	session := &bfd.BfdSession{
+		Interface:            "memif1",
+		LocalIp:              "10.0.0.1",
+		PeerIp:               "10.0.0.2",
+		DesiredMinTxInterval: 300000,
+		DetectMultiplier:     5,
+	}
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdUDPModReply{})
+	err := bfdHandler.ModifyBfdSession(session)
+	Expect(err).To(BeNil())
+	modMsg, ok := ctx.MockChannel.Msg.(*vpp_bfd.BfdUDPMod)
+	Expect(ok).To(BeTrue())
+	Expect(modMsg.DesiredMinTx).To(BeEquivalentTo(300000))
+	Expect(modMsg.DetectMult).To(BeEquivalentTo(5))
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdUDPDelReply{})
+	err = bfdHandler.DeleteBfdSession(session)
+	Expect(err).To(BeNil())
+	delMsg, ok := ctx.MockChannel.Msg.(*vpp_bfd.BfdUDPDel)
+	Expect(ok).To(BeTrue())
+	Expect(delMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(delMsg.PeerAddr.String()).To(Equal("10.0.0.2"))
+}
+
+func TestBfdAuthKey(t *testing.T) {
+	ctx, bfdHandler, _ := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdAuthSetKeyReply{})
+	err := bfdHandler.SetBfdAuthKey(&bfd.BfdAuthKey{
+		Id:       5,
+		AuthType: bfd.BfdAuthKey_METICULOUS_KEYED_SHA1,
+		Secret:   "secret",
+	})
+	Expect(err).To(BeNil())
+	setMsg, ok := ctx.MockChannel.Msg.(*vpp_bfd.BfdAuthSetKey)
+	Expect(ok).To(BeTrue())
+	Expect(setMsg.ConfKeyID).To(BeEquivalentTo(5))
+	Expect(setMsg.AuthType).To(BeEquivalentTo(5))
+	Expect(setMsg.KeyLen).To(BeEquivalentTo(6))
+	Expect(setMsg.Key).To(BeEquivalentTo("secret"))
+
+	err = bfdHandler.SetBfdAuthKey(&bfd.BfdAuthKey{
+		Id:     6,
+		Secret: "secret-longer-than-20-bytes",
+	})
+	Expect(err).ToNot(BeNil())
+
+	ctx.MockVpp.MockReply(&vpp_bfd.BfdAuthDelKeyReply{})
+	err = bfdHandler.DeleteBfdAuthKey(5)
+	Expect(err).To(BeNil())
+	delMsg, ok := ctx.MockChannel.Msg.(*vpp_bfd.BfdAuthDelKey)
+	Expect(ok).To(BeTrue())
+	Expect(delMsg.ConfKeyID).To(BeEquivalentTo(5))
+}
+
+func TestDumpBfdSessions(t *testing.T) {
+	ctx, bfdHandler, ifIndexes := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("memif1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	localAddr, _ := ip_types.ParseAddress("10.0.0.1")
+	peerAddr, _ := ip_types.ParseAddress("10.0.0.2")
+	remoteAddr, _ := ip_types.ParseAddress("10.1.0.2")
+
+	ctx.MockVpp.MockReply(
+		&vpp_bfd.BfdUDPSessionDetails{
+			SwIfIndex:       1,
+			LocalAddr:       localAddr,
+			PeerAddr:        peerAddr,
+			State:           vpp_bfd.BFD_STATE_API_UP,
+			IsAuthenticated: true,
+			BfdKeyID:        1,
+			ConfKeyID:       5,
+			DesiredMinTx:    100000,
+			RequiredMinRx:   100000,
+			DetectMult:      3,
+		},
+		&vpp_bfd.BfdUDPSessionDetails{
+			SwIfIndex: ^interface_types.InterfaceIndex(0),
+			LocalAddr: localAddr,
+			PeerAddr:  remoteAddr,
+			State:     vpp_bfd.BFD_STATE_API_DOWN,
+		},
+		&vpp_bfd.BfdUDPSessionDetails{
+			SwIfIndex: 2, // unknown interface
+			LocalAddr: localAddr,
+			PeerAddr:  peerAddr,
+		},
+	)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	sessions, err := bfdHandler.DumpBfdSessions()
+	Expect(err).To(BeNil())
+	Expect(sessions).To(HaveLen(2))
+	Expect(sessions[0].Session.Interface).To(Equal("memif1"))
+	Expect(sessions[0].Session.LocalIp).To(Equal("10.0.0.1"))
+	Expect(sessions[0].Session.PeerIp).To(Equal("10.0.0.2"))
+	Expect(sessions[0].Session.DetectMultiplier).To(BeEquivalentTo(3))
+	Expect(sessions[0].Session.Authentication.KeyId).To(BeEquivalentTo(5))
+	Expect(sessions[0].State).To(Equal(bfd.BfdSessionNotification_UP))
+	Expect(sessions[1].Session.Multihop).To(BeTrue())
+	Expect(sessions[1].Session.Interface).To(BeEmpty())
+	Expect(sessions[1].Session.PeerIp).To(Equal("10.1.0.2"))
+	Expect(sessions[1].Session.Authentication).To(BeNil())
+	Expect(sessions[1].State).To(Equal(bfd.BfdSessionNotification_DOWN))
+}
+
+func TestDumpBfdAuthKeys(t *testing.T) {
+	ctx, bfdHandler, _ := bfdTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_bfd.BfdAuthKeysDetails{ConfKeyID: 5, AuthType: 4, UseCount: 1},
+		&vpp_bfd.BfdAuthKeysDetails{ConfKeyID: 6, AuthType: 5},
+	)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	keys, err := bfdHandler.DumpBfdAuthKeys()
+	Expect(err).To(BeNil())
+	Expect(keys).To(HaveLen(2))
+	Expect(keys[0].Id).To(BeEquivalentTo(5))
+	Expect(keys[0].AuthType).To(Equal(bfd.BfdAuthKey_KEYED_SHA1))
+	Expect(keys[1].Id).To(BeEquivalentTo(6))
+	Expect(keys[1].AuthType).To(Equal(bfd.BfdAuthKey_METICULOUS_KEYED_SHA1))
+}
+
+func bfdTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.BfdVppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	logger := logrus.NewLogger("test-log")
+	ifIndexes := ifaceidx.NewIfaceIndex(logger, "bfd-if-idx")
+	bfdHandler := vpp2210.NewBfdVppHandler(ctx.MockChannel, ifIndexes, logrus.DefaultLogger())
+	return ctx, bfdHandler, ifIndexes
+}
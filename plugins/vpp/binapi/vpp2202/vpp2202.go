@@ -21,10 +21,14 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/acl"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/af_packet"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/arp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/bfd"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/bond"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/det44"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/dhcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/dns"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/flowprobe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/geneve"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/gre"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/gtpu"
 	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface"
@@ -36,11 +40,16 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ipsec"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/l2"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/l3xc"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/lcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memclnt"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memif"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/mpls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat44_ed"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat44_ei"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/policer"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/punt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/qos"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/rd_cp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/rdma"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/span"
@@ -48,6 +57,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/stn"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/tapv2"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/teib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/vhost_user"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/vlib"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/vmxnet3"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/vpe"
@@ -65,7 +75,9 @@ func init() {
 		Core: vpp.Messages(
 			af_packet.AllMessages,
 			arp.AllMessages,
+			bfd.AllMessages,
 			bond.AllMessages,
+			classify.AllMessages,
 			gre.AllMessages,
 			interfaces.AllMessages,
 			ip.AllMessages,
@@ -76,12 +88,16 @@ func init() {
 			ipsec.AllMessages,
 			l2.AllMessages,
 			memclnt.AllMessages,
+			mpls.AllMessages,
+			policer.AllMessages,
 			punt.AllMessages,
+			qos.AllMessages,
 			rd_cp.AllMessages,
 			span.AllMessages,
 			sr.AllMessages,
 			tapv2.AllMessages,
 			teib.AllMessages,
+			vhost_user.AllMessages,
 			vlib.AllMessages,
 			vpe.AllMessages,
 			vxlan.AllMessages,
@@ -90,14 +106,18 @@ func init() {
 		Plugins: vpp.Messages(
 			abf.AllMessages,
 			acl.AllMessages,
+			det44.AllMessages,
 			dhcp.AllMessages,
 			dns.AllMessages,
 			flowprobe.AllMessages,
+			geneve.AllMessages,
 			gtpu.AllMessages,
 			l3xc.AllMessages,
+			lcp.AllMessages,
 			memif.AllMessages,
 			nat44_ed.AllMessages,
 			nat44_ei.AllMessages,
+			nat64.AllMessages,
 			rdma.AllMessages,
 			stn.AllMessages,
 			vmxnet3.AllMessages,
@@ -110,7 +130,9 @@ func init() {
 //go:generate -command binapigen binapi-generator --no-version-info --output-dir=.
 //go:generate binapigen --input-file=$VPP_API_DIR/core/af_packet.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/arp.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/bfd.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/bond.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/classify.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/gre.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/interface.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/ip.api.json
@@ -121,26 +143,34 @@ func init() {
 //go:generate binapigen --input-file=$VPP_API_DIR/core/ipsec.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/l2.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/memclnt.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/mpls.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/policer.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/punt.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/qos.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/rd_cp.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/span.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/sr.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/tapv2.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/teib.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/vhost_user.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/vlib.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/vpe.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/vxlan.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/vxlan_gpe.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/abf.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/acl.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/det44.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/dhcp.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/dns.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/flowprobe.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/geneve.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/gtpu.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/l3xc.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/lcp.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/memif.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/nat44_ed.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/nat44_ei.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/nat64.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/rdma.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/stn.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/vmxnet3.api.json
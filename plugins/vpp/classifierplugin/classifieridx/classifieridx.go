@@ -0,0 +1,101 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package classifieridx
+
+import (
+	"go.ligato.io/cn-infra/v2/idxmap"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+)
+
+// ClassifyTableMetadataIndex provides read-only access to mapping between classify table indexes
+// (used internally in VPP) and table names.
+type ClassifyTableMetadataIndex interface {
+	// LookupByName looks up previously stored item identified by name in the mapping.
+	LookupByName(name string) (metadata *ClassifyTableMetadata, exists bool)
+
+	// LookupByIndex looks up previously stored item identified by index in the mapping.
+	LookupByIndex(idx uint32) (name string, metadata *ClassifyTableMetadata, exists bool)
+
+	// ListAllTables returns slice of names of all classify tables in the mapping.
+	ListAllTables() (names []string)
+}
+
+// ClassifyTableMetadataIndexRW is mapping between classify table indexes (used internally in VPP)
+// and table names.
+type ClassifyTableMetadataIndexRW interface {
+	ClassifyTableMetadataIndex
+	idxmap.NamedMappingRW
+}
+
+// ClassifyTableMetadata represents metadata for classify table.
+type ClassifyTableMetadata struct {
+	Index         uint32
+	SkipNVectors  uint32
+	MatchNVectors uint32
+}
+
+// GetIndex returns index of the classify table.
+func (m *ClassifyTableMetadata) GetIndex() uint32 {
+	return m.Index
+}
+
+type classifyTableMetadataIndex struct {
+	idxmap.NamedMappingRW
+
+	log         logging.Logger
+	nameToIndex idxvpp.NameToIndex
+}
+
+// NewClassifyTableIndex creates new instance of classifyTableMetadataIndex.
+func NewClassifyTableIndex(logger logging.Logger, title string) ClassifyTableMetadataIndexRW {
+	mapping := idxvpp.NewNameToIndex(logger, title, nil)
+	return &classifyTableMetadataIndex{
+		NamedMappingRW: mapping,
+		log:            logger,
+		nameToIndex:    mapping,
+	}
+}
+
+// LookupByName looks up previously stored item identified by name in mapping.
+func (tableIdx *classifyTableMetadataIndex) LookupByName(name string) (metadata *ClassifyTableMetadata, exists bool) {
+	meta, found := tableIdx.GetValue(name)
+	if found {
+		if typedMeta, ok := meta.(*ClassifyTableMetadata); ok {
+			return typedMeta, found
+		}
+	}
+	return nil, false
+}
+
+// LookupByIndex looks up previously stored item identified by index in mapping.
+func (tableIdx *classifyTableMetadataIndex) LookupByIndex(idx uint32) (name string, metadata *ClassifyTableMetadata, exists bool) {
+	var item idxvpp.WithIndex
+	name, item, exists = tableIdx.nameToIndex.LookupByIndex(idx)
+	if exists {
+		var isTableMeta bool
+		metadata, isTableMeta = item.(*ClassifyTableMetadata)
+		if !isTableMeta {
+			exists = false
+		}
+	}
+	return
+}
+
+// ListAllTables returns slice of names of all classify tables in the mapping.
+func (tableIdx *classifyTableMetadataIndex) ListAllTables() (names []string) {
+	return tableIdx.ListAllNames()
+}
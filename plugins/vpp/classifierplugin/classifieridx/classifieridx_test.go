@@ -0,0 +1,75 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package classifieridx_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/classifieridx"
+)
+
+func TestClassifyTableIndexLookupByName(t *testing.T) {
+	RegisterTestingT(t)
+	tableIndex := classifieridx.NewClassifyTableIndex(logging.DefaultLogger, "classify-table-index")
+
+	tableIndex.Put("tbl1", &classifieridx.ClassifyTableMetadata{Index: 1})
+	tableIndex.Put("tbl2", 2)
+
+	metadata, exists := tableIndex.LookupByName("tbl1")
+	Expect(exists).To(BeTrue())
+	Expect(metadata).ToNot(BeNil())
+	Expect(metadata.Index).To(Equal(uint32(1)))
+
+	metadata, exists = tableIndex.LookupByName("tbl2")
+	Expect(exists).To(BeFalse())
+	Expect(metadata).To(BeNil())
+
+	metadata, exists = tableIndex.LookupByName("tbl3")
+	Expect(exists).To(BeFalse())
+	Expect(metadata).To(BeNil())
+}
+
+func TestClassifyTableIndexLookupByIndex(t *testing.T) {
+	RegisterTestingT(t)
+	tableIndex := classifieridx.NewClassifyTableIndex(logging.DefaultLogger, "classify-table-index")
+
+	tableIndex.Put("tbl1", &classifieridx.ClassifyTableMetadata{Index: 1})
+	tableIndex.Put("tbl2", &classifieridx.ClassifyTableMetadata{Index: 2})
+
+	name, metadata, exists := tableIndex.LookupByIndex(2)
+	Expect(exists).To(BeTrue())
+	Expect(name).To(Equal("tbl2"))
+	Expect(metadata).ToNot(BeNil())
+	Expect(metadata.Index).To(Equal(uint32(2)))
+
+	name, metadata, exists = tableIndex.LookupByIndex(3)
+	Expect(exists).To(BeFalse())
+	Expect(name).To(Equal(""))
+	Expect(metadata).To(BeNil())
+}
+
+func TestClassifyTableIndexListAllTables(t *testing.T) {
+	RegisterTestingT(t)
+	tableIndex := classifieridx.NewClassifyTableIndex(logging.DefaultLogger, "classify-table-index")
+
+	tableIndex.Put("tbl1", &classifieridx.ClassifyTableMetadata{Index: 1})
+	tableIndex.Put("tbl2", &classifieridx.ClassifyTableMetadata{Index: 2})
+
+	names := tableIndex.ListAllTables()
+	Expect(names).To(ConsistOf("tbl1", "tbl2"))
+}
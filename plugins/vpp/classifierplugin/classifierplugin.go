@@ -0,0 +1,108 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name ClassifyTable --value-type *vpp_classifier.ClassifyTable --meta-type *classifieridx.ClassifyTableMetadata --import "go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/classifieridx" --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name ClassifySession --value-type *vpp_classifier.ClassifySession --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name ClassifyInterface --value-type *vpp_classifier.ClassifyInterface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier" --output-dir "descriptor"
+
+package classifierplugin
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/classifieridx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls/vpp2101"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls/vpp2106"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls/vpp2210"
+)
+
+// ClassifierPlugin configures VPP classify tables, their sessions and attachment
+// of the tables to interfaces using GoVPP.
+type ClassifierPlugin struct {
+	Deps
+
+	// handlers
+	classifierHandler vppcalls.ClassifierVppAPI
+
+	// index maps
+	tableIndex classifieridx.ClassifyTableMetadataIndex
+}
+
+// Deps lists dependencies of the classifier plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+// Init registers classifier-related descriptors.
+func (p *ClassifierPlugin) Init() (err error) {
+	// init handlers
+	p.classifierHandler = vppcalls.CompatibleClassifierVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.classifierHandler == nil {
+		return errors.New("classifier VPP handler is not available")
+	}
+
+	// init and register descriptors
+	tableDescriptor, tableDescriptorCtx := descriptor.NewClassifyTableDescriptor(p.classifierHandler, p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(tableDescriptor); err != nil {
+		return err
+	}
+
+	// obtain read-only reference to index map
+	var withIndex bool
+	metadataMap := p.KVScheduler.GetMetadataMap(tableDescriptor.Name)
+	p.tableIndex, withIndex = metadataMap.(classifieridx.ClassifyTableMetadataIndex)
+	if !withIndex {
+		return errors.New("missing index with classify table metadata")
+	}
+	tableDescriptorCtx.SetTableIndex(p.tableIndex)
+
+	sessionDescriptor := descriptor.NewClassifySessionDescriptor(p.classifierHandler, p.tableIndex, p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(sessionDescriptor); err != nil {
+		return err
+	}
+	ifDescriptor := descriptor.NewClassifyInterfaceDescriptor(p.classifierHandler, p.tableIndex,
+		p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(ifDescriptor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *ClassifierPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
+
+// GetClassifyTableIndex gives read-only access to map with metadata of all configured
+// VPP classify tables.
+func (p *ClassifierPlugin) GetClassifyTableIndex() classifieridx.ClassifyTableMetadataIndex {
+	return p.tableIndex
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package classifierplugin
+
+import (
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/classifieridx"
+)
+
+// API defines methods exposed by VPP-ClassifierPlugin.
+type API interface {
+	// GetClassifyTableIndex gives read-only access to map with metadata of all configured
+	// VPP classify tables.
+	GetClassifyTableIndex() classifieridx.ClassifyTableMetadataIndex
+}
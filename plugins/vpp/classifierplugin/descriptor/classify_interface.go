@@ -0,0 +1,234 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/classifieridx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// ClassifyInterfaceDescriptorName is the name of the descriptor for classify
+	// tables attached to VPP interfaces.
+	ClassifyInterfaceDescriptorName = "vpp-classify-interface"
+
+	// dependency labels
+	interfaceDep = "interface-exists"
+	ip4TableDep  = "ip4-classify-table-exists"
+	ip6TableDep  = "ip6-classify-table-exists"
+	l2TableDep   = "l2-classify-table-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrClassifyInterfaceWithoutInterface is returned when interface is undefined.
+	ErrClassifyInterfaceWithoutInterface = errors.New("no interface defined for classify tables")
+
+	// ErrClassifyInterfaceWithoutTable is returned when no table is attached to the interface.
+	ErrClassifyInterfaceWithoutTable = errors.New("at least one classify table must be attached to the interface")
+)
+
+// ClassifyInterfaceDescriptor teaches KVScheduler how to attach classify tables
+// to VPP interfaces.
+type ClassifyInterfaceDescriptor struct {
+	log               logging.Logger
+	classifierHandler vppcalls.ClassifierVppAPI
+	tableIndex        classifieridx.ClassifyTableMetadataIndex
+	ifIndexes         ifaceidx.IfaceMetadataIndex
+}
+
+// NewClassifyInterfaceDescriptor creates a new instance of the ClassifyInterface descriptor.
+func NewClassifyInterfaceDescriptor(classifierHandler vppcalls.ClassifierVppAPI,
+	tableIndex classifieridx.ClassifyTableMetadataIndex, ifIndexes ifaceidx.IfaceMetadataIndex,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &ClassifyInterfaceDescriptor{
+		classifierHandler: classifierHandler,
+		tableIndex:        tableIndex,
+		ifIndexes:         ifIndexes,
+		log:               log.NewLogger("classify-interface-descriptor"),
+	}
+	typedDescr := &adapter.ClassifyInterfaceDescriptor{
+		Name:          ClassifyInterfaceDescriptorName,
+		NBKeyPrefix:   classifier.ModelClassifyInterface.KeyPrefix(),
+		ValueTypeName: classifier.ModelClassifyInterface.ProtoName(),
+		KeySelector:   classifier.ModelClassifyInterface.IsKeyValid,
+		KeyLabel:      classifier.ModelClassifyInterface.StripKeyPrefix,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+		Dependencies:  ctx.Dependencies,
+		RetrieveDependencies: []string{
+			ifdescriptor.InterfaceDescriptorName,
+			ClassifyTableDescriptorName,
+		},
+	}
+	return adapter.NewClassifyInterfaceDescriptor(typedDescr)
+}
+
+// Validate validates configuration of classify tables attached to interface.
+func (d *ClassifyInterfaceDescriptor) Validate(key string, ifTables *classifier.ClassifyInterface) error {
+	if ifTables.Interface == "" {
+		return kvs.NewInvalidValueError(ErrClassifyInterfaceWithoutInterface, "interface")
+	}
+	if ifTables.Ip4Table == "" && ifTables.Ip6Table == "" && ifTables.L2Table == "" {
+		return kvs.NewInvalidValueError(ErrClassifyInterfaceWithoutTable, "ip4_table", "ip6_table", "l2_table")
+	}
+	return nil
+}
+
+// Create attaches classify tables to the interface.
+func (d *ClassifyInterfaceDescriptor) Create(key string, ifTables *classifier.ClassifyInterface) (interface{}, error) {
+	ifIdx, ip4TableIdx, ip6TableIdx, l2TableIdx, err := d.resolveIndexes(ifTables)
+	if err != nil {
+		return nil, err
+	}
+	err = d.classifierHandler.AttachClassifyTables(ifIdx, ifTables.Direction == classifier.ClassifyInterface_OUTPUT,
+		ip4TableIdx, ip6TableIdx, l2TableIdx)
+	if err != nil {
+		return nil, errors.Errorf("failed to attach classify tables to interface %s (%s): %v",
+			ifTables.Interface, ifTables.Direction, err)
+	}
+	return nil, nil
+}
+
+// Delete detaches classify tables from the interface.
+func (d *ClassifyInterfaceDescriptor) Delete(key string, ifTables *classifier.ClassifyInterface, metadata interface{}) error {
+	ifIdx, ip4TableIdx, ip6TableIdx, l2TableIdx, err := d.resolveIndexes(ifTables)
+	if err != nil {
+		return err
+	}
+	err = d.classifierHandler.DetachClassifyTables(ifIdx, ifTables.Direction == classifier.ClassifyInterface_OUTPUT,
+		ip4TableIdx, ip6TableIdx, l2TableIdx)
+	if err != nil {
+		return errors.Errorf("failed to detach classify tables from interface %s (%s): %v",
+			ifTables.Interface, ifTables.Direction, err)
+	}
+	return nil
+}
+
+// Retrieve returns classify tables attached to VPP interfaces.
+func (d *ClassifyInterfaceDescriptor) Retrieve(correlate []adapter.ClassifyInterfaceKVWithMetadata) (
+	retrieved []adapter.ClassifyInterfaceKVWithMetadata, err error,
+) {
+	// VPP does not provide a dump of tables attached for transmitted packets,
+	// these are therefore taken over from the expected configuration.
+	for _, kv := range correlate {
+		if kv.Value.Direction == classifier.ClassifyInterface_OUTPUT {
+			retrieved = append(retrieved, adapter.ClassifyInterfaceKVWithMetadata{
+				Key:    kv.Key,
+				Value:  kv.Value,
+				Origin: kvs.FromNB,
+			})
+		}
+	}
+
+	ifaces, err := d.classifierHandler.DumpClassifyInputInterfaces()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump classify tables attached to interfaces: %v", err)
+	}
+	for _, iface := range ifaces {
+		ifTables := &classifier.ClassifyInterface{
+			Interface: iface.Interface,
+			Direction: classifier.ClassifyInterface_INPUT,
+			Ip4Table:  d.tableName(iface.IP4TableIndex),
+			Ip6Table:  d.tableName(iface.IP6TableIndex),
+			L2Table:   d.tableName(iface.L2TableIndex),
+		}
+		retrieved = append(retrieved, adapter.ClassifyInterfaceKVWithMetadata{
+			Key:    classifier.ClassifyInterfaceKey(ifTables.Interface, ifTables.Direction),
+			Value:  ifTables,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface and all the attached tables as dependencies.
+func (d *ClassifyInterfaceDescriptor) Dependencies(key string, ifTables *classifier.ClassifyInterface) []kvs.Dependency {
+	dependencies := []kvs.Dependency{
+		{
+			Label: interfaceDep,
+			Key:   interfaces.InterfaceKey(ifTables.Interface),
+		},
+	}
+	tables := []struct {
+		label, name string
+	}{
+		{ip4TableDep, ifTables.Ip4Table},
+		{ip6TableDep, ifTables.Ip6Table},
+		{l2TableDep, ifTables.L2Table},
+	}
+	for _, table := range tables {
+		if table.name != "" {
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: table.label,
+				Key:   classifier.ClassifyTableKey(table.name),
+			})
+		}
+	}
+	return dependencies
+}
+
+// resolveIndexes translates interface and table names into VPP indexes.
+func (d *ClassifyInterfaceDescriptor) resolveIndexes(ifTables *classifier.ClassifyInterface) (
+	ifIdx, ip4TableIdx, ip6TableIdx, l2TableIdx uint32, err error,
+) {
+	ifMeta, found := d.ifIndexes.LookupByName(ifTables.Interface)
+	if !found {
+		err = errors.Errorf("failed to find interface %s", ifTables.Interface)
+		return
+	}
+	ifIdx = ifMeta.SwIfIndex
+	if ip4TableIdx, err = d.tableIdx(ifTables.Ip4Table); err != nil {
+		return
+	}
+	if ip6TableIdx, err = d.tableIdx(ifTables.Ip6Table); err != nil {
+		return
+	}
+	l2TableIdx, err = d.tableIdx(ifTables.L2Table)
+	return
+}
+
+// tableIdx returns VPP index of the table or ^uint32(0) if the table is not defined.
+func (d *ClassifyInterfaceDescriptor) tableIdx(tableName string) (uint32, error) {
+	if tableName == "" {
+		return ^uint32(0), nil
+	}
+	table, found := d.tableIndex.LookupByName(tableName)
+	if !found {
+		return 0, errors.Errorf("failed to find classify table %s", tableName)
+	}
+	return table.Index, nil
+}
+
+// tableName returns name of the table with the given VPP index or empty string if unknown.
+func (d *ClassifyInterfaceDescriptor) tableName(tableIdx uint32) string {
+	if tableIdx == ^uint32(0) {
+		return ""
+	}
+	name, _, _ := d.tableIndex.LookupByIndex(tableIdx)
+	return name
+}
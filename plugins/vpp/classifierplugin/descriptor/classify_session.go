@@ -0,0 +1,208 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/classifieridx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+const (
+	// ClassifySessionDescriptorName is the name of the descriptor for sessions
+	// of VPP classify tables.
+	ClassifySessionDescriptorName = "vpp-classify-session"
+
+	// dependency labels
+	tableDep    = "classify-table-exists"
+	vrfTableDep = "vrf-table-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrClassifySessionWithoutTable is returned when VPP classify session has undefined table.
+	ErrClassifySessionWithoutTable = errors.New("VPP classify session defined without table")
+
+	// ErrClassifySessionInvalidMatch is returned when the session match is not a non-empty hex string.
+	ErrClassifySessionInvalidMatch = errors.New("VPP classify session match must be a non-empty hex string")
+)
+
+// ClassifySessionDescriptor teaches KVScheduler how to configure sessions of VPP classify tables.
+type ClassifySessionDescriptor struct {
+	log               logging.Logger
+	classifierHandler vppcalls.ClassifierVppAPI
+	tableIndex        classifieridx.ClassifyTableMetadataIndex
+}
+
+// NewClassifySessionDescriptor creates a new instance of the ClassifySession descriptor.
+func NewClassifySessionDescriptor(classifierHandler vppcalls.ClassifierVppAPI,
+	tableIndex classifieridx.ClassifyTableMetadataIndex, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &ClassifySessionDescriptor{
+		classifierHandler: classifierHandler,
+		tableIndex:        tableIndex,
+		log:               log.NewLogger("classify-session-descriptor"),
+	}
+	typedDescr := &adapter.ClassifySessionDescriptor{
+		Name:                 ClassifySessionDescriptorName,
+		NBKeyPrefix:          classifier.ModelClassifySession.KeyPrefix(),
+		ValueTypeName:        classifier.ModelClassifySession.ProtoName(),
+		KeySelector:          classifier.ModelClassifySession.IsKeyValid,
+		KeyLabel:             classifier.ModelClassifySession.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentClassifySessions,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{ClassifyTableDescriptorName},
+	}
+	return adapter.NewClassifySessionDescriptor(typedDescr)
+}
+
+// EquivalentClassifySessions is a comparison function for classifier.ClassifySession.
+// Table and match are part of the key and need not be compared.
+func (d *ClassifySessionDescriptor) EquivalentClassifySessions(key string, oldSession, newSession *classifier.ClassifySession) bool {
+	return oldSession.HitNext == newSession.HitNext &&
+		oldSession.OpaqueIndex == newSession.OpaqueIndex &&
+		oldSession.Advance == newSession.Advance &&
+		oldSession.Action == newSession.Action &&
+		oldSession.Metadata == newSession.Metadata
+}
+
+// Validate validates VPP classify session configuration.
+func (d *ClassifySessionDescriptor) Validate(key string, session *classifier.ClassifySession) error {
+	if session.Table == "" {
+		return kvs.NewInvalidValueError(ErrClassifySessionWithoutTable, "table")
+	}
+	match, err := hex.DecodeString(session.Match)
+	if err != nil || len(match) == 0 {
+		return kvs.NewInvalidValueError(ErrClassifySessionInvalidMatch, "match")
+	}
+	return nil
+}
+
+// Create adds new session into VPP classify table.
+func (d *ClassifySessionDescriptor) Create(key string, session *classifier.ClassifySession) (interface{}, error) {
+	table, found := d.tableIndex.LookupByName(session.Table)
+	if !found {
+		return nil, errors.Errorf("failed to find classify table %s", session.Table)
+	}
+	if err := d.classifierHandler.AddClassifySession(session, table); err != nil {
+		return nil, errors.Errorf("failed to add session %s into classify table %s: %v",
+			session.Match, session.Table, err)
+	}
+	return nil, nil
+}
+
+// Delete removes session from VPP classify table.
+func (d *ClassifySessionDescriptor) Delete(key string, session *classifier.ClassifySession, metadata interface{}) error {
+	table, found := d.tableIndex.LookupByName(session.Table)
+	if !found {
+		return errors.Errorf("failed to find classify table %s", session.Table)
+	}
+	if err := d.classifierHandler.DeleteClassifySession(session, table); err != nil {
+		return errors.Errorf("failed to delete session %s from classify table %s: %v",
+			session.Match, session.Table, err)
+	}
+	return nil
+}
+
+// Retrieve returns sessions of all classify tables configured in VPP.
+func (d *ClassifySessionDescriptor) Retrieve(correlate []adapter.ClassifySessionKVWithMetadata) (
+	retrieved []adapter.ClassifySessionKVWithMetadata, err error,
+) {
+	// VPP dumps the match padded with zeros to the full length of the table
+	// mask and does not dump the session action with metadata - these are
+	// therefore taken over from the expected configuration.
+	nbSessions := make(map[string][]*classifier.ClassifySession)
+	for _, kv := range correlate {
+		nbSessions[kv.Value.Table] = append(nbSessions[kv.Value.Table], kv.Value)
+	}
+
+	for _, tableName := range d.tableIndex.ListAllTables() {
+		table, found := d.tableIndex.LookupByName(tableName)
+		if !found {
+			continue
+		}
+		sessions, err := d.classifierHandler.DumpClassifySessions(table.Index)
+		if err != nil {
+			return nil, errors.Errorf("failed to dump sessions of classify table %s: %v", tableName, err)
+		}
+		for _, session := range sessions {
+			session.Table = tableName
+			match, _ := hex.DecodeString(session.Match)
+			for _, nbSession := range nbSessions[tableName] {
+				if equivalentMatch(nbSession.Match, match) {
+					session.Match = nbSession.Match
+					session.Action = nbSession.Action
+					session.Metadata = nbSession.Metadata
+					break
+				}
+			}
+			retrieved = append(retrieved, adapter.ClassifySessionKVWithMetadata{
+				Key:    classifier.ClassifySessionKey(session.Table, session.Match),
+				Value:  session,
+				Origin: kvs.FromNB,
+			})
+		}
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the table and, for sessions setting FIB index, the VRF table as dependencies.
+func (d *ClassifySessionDescriptor) Dependencies(key string, session *classifier.ClassifySession) []kvs.Dependency {
+	dependencies := []kvs.Dependency{
+		{
+			Label: tableDep,
+			Key:   classifier.ClassifyTableKey(session.Table),
+		},
+	}
+	if session.Metadata != 0 {
+		switch session.Action {
+		case classifier.ClassifySession_SET_IP4_FIB_INDEX:
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: vrfTableDep,
+				Key:   l3.VrfTableKey(session.Metadata, l3.VrfTable_IPV4),
+			})
+		case classifier.ClassifySession_SET_IP6_FIB_INDEX:
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: vrfTableDep,
+				Key:   l3.VrfTableKey(session.Metadata, l3.VrfTable_IPV6),
+			})
+		}
+	}
+	return dependencies
+}
+
+// equivalentMatch returns true if the (possibly shorter) hex-encoded match
+// is equal to the dumped match padded with zeros.
+func equivalentMatch(matchHex string, dumpedMatch []byte) bool {
+	match, err := hex.DecodeString(matchHex)
+	if err != nil || len(match) > len(dumpedMatch) {
+		return false
+	}
+	return bytes.Equal(match, dumpedMatch[:len(match)]) &&
+		bytes.Count(dumpedMatch[len(match):], []byte{0}) == len(dumpedMatch)-len(match)
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/idxmap"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/classifieridx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+)
+
+const (
+	// ClassifyTableDescriptorName is the name of the descriptor for VPP classify tables.
+	ClassifyTableDescriptorName = "vpp-classify-table"
+
+	// maximal number of 16-byte vectors matched by a classify table
+	maxMatchNVectors = 5
+
+	// prefix of names given to retrieved tables not found in the expected configuration
+	retrievedTableNamePrefix = "table-"
+
+	// dependency labels
+	nextTableDep = "next-classify-table-exists"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrClassifyTableWithoutName is returned when VPP classify table has undefined name.
+	ErrClassifyTableWithoutName = errors.New("VPP classify table defined without name")
+
+	// ErrClassifyTableNameWithSlash is returned when VPP classify table name contains slash
+	// (not allowed since the name is part of keys of classify sessions).
+	ErrClassifyTableNameWithSlash = errors.New("VPP classify table name must not contain '/'")
+
+	// ErrClassifyTableInvalidMask is returned when the mask is not a hex string
+	// of 1 to 5 16-byte vectors.
+	ErrClassifyTableInvalidMask = errors.New("VPP classify table mask must be a hex string of 1 to 5 16-byte vectors")
+
+	// ErrClassifyTableChainedToItself is returned when the table refers to itself as the next table.
+	ErrClassifyTableChainedToItself = errors.New("VPP classify table cannot be chained to itself")
+)
+
+// ClassifyTableDescriptor teaches KVScheduler how to configure VPP classify tables.
+type ClassifyTableDescriptor struct {
+	log               logging.Logger
+	classifierHandler vppcalls.ClassifierVppAPI
+	tableIndex        classifieridx.ClassifyTableMetadataIndex
+}
+
+// NewClassifyTableDescriptor creates a new instance of the ClassifyTable descriptor.
+func NewClassifyTableDescriptor(
+	classifierHandler vppcalls.ClassifierVppAPI, log logging.PluginLogger) (*kvs.KVDescriptor, *ClassifyTableDescriptor) {
+
+	ctx := &ClassifyTableDescriptor{
+		classifierHandler: classifierHandler,
+		log:               log.NewLogger("classify-table-descriptor"),
+	}
+	typedDescr := &adapter.ClassifyTableDescriptor{
+		Name:            ClassifyTableDescriptorName,
+		NBKeyPrefix:     classifier.ModelClassifyTable.KeyPrefix(),
+		ValueTypeName:   classifier.ModelClassifyTable.ProtoName(),
+		KeySelector:     classifier.ModelClassifyTable.IsKeyValid,
+		KeyLabel:        classifier.ModelClassifyTable.StripKeyPrefix,
+		ValueComparator: ctx.EquivalentClassifyTables,
+		WithMetadata:    true,
+		MetadataMapFactory: func() idxmap.NamedMappingRW {
+			return classifieridx.NewClassifyTableIndex(ctx.log, "vpp-classify-table-index")
+		},
+		Validate:     ctx.Validate,
+		Create:       ctx.Create,
+		Delete:       ctx.Delete,
+		Retrieve:     ctx.Retrieve,
+		Dependencies: ctx.Dependencies,
+	}
+	return adapter.NewClassifyTableDescriptor(typedDescr), ctx
+}
+
+// SetTableIndex should be used to provide the index of classify tables,
+// which is needed to resolve the next table.
+func (d *ClassifyTableDescriptor) SetTableIndex(tableIndex classifieridx.ClassifyTableMetadataIndex) {
+	d.tableIndex = tableIndex
+}
+
+// EquivalentClassifyTables is a comparison function for classifier.ClassifyTable.
+func (d *ClassifyTableDescriptor) EquivalentClassifyTables(key string, oldTable, newTable *classifier.ClassifyTable) bool {
+	return equivalentTableAttrs(oldTable, newTable) &&
+		oldTable.NextTable == newTable.NextTable &&
+		getMemorySize(oldTable) == getMemorySize(newTable)
+}
+
+// Validate validates VPP classify table configuration.
+func (d *ClassifyTableDescriptor) Validate(key string, table *classifier.ClassifyTable) error {
+	if table.Name == "" {
+		return kvs.NewInvalidValueError(ErrClassifyTableWithoutName, "name")
+	}
+	if strings.Contains(table.Name, "/") {
+		return kvs.NewInvalidValueError(ErrClassifyTableNameWithSlash, "name")
+	}
+	mask, err := hex.DecodeString(table.Mask)
+	if err != nil || len(mask) == 0 || len(mask)%vppcalls.VectorSize != 0 ||
+		len(mask)/vppcalls.VectorSize > maxMatchNVectors {
+		return kvs.NewInvalidValueError(ErrClassifyTableInvalidMask, "mask")
+	}
+	if table.NextTable == table.Name {
+		return kvs.NewInvalidValueError(ErrClassifyTableChainedToItself, "next_table")
+	}
+	return nil
+}
+
+// Create adds new VPP classify table.
+func (d *ClassifyTableDescriptor) Create(key string, table *classifier.ClassifyTable) (*classifieridx.ClassifyTableMetadata, error) {
+	nextTableIdx := ^uint32(0)
+	if table.NextTable != "" {
+		nextTable, found := d.tableIndex.LookupByName(table.NextTable)
+		if !found {
+			return nil, errors.Errorf("failed to find next classify table %s", table.NextTable)
+		}
+		nextTableIdx = nextTable.Index
+	}
+	tableIdx, err := d.classifierHandler.AddClassifyTable(table, nextTableIdx)
+	if err != nil {
+		return nil, errors.Errorf("failed to add classify table %s: %v", table.Name, err)
+	}
+	mask, _ := hex.DecodeString(table.Mask)
+	return &classifieridx.ClassifyTableMetadata{
+		Index:         tableIdx,
+		SkipNVectors:  table.SkipNVectors,
+		MatchNVectors: uint32(len(mask) / vppcalls.VectorSize),
+	}, nil
+}
+
+// Delete removes VPP classify table.
+func (d *ClassifyTableDescriptor) Delete(key string, table *classifier.ClassifyTable, metadata *classifieridx.ClassifyTableMetadata) error {
+	if err := d.classifierHandler.DeleteClassifyTable(metadata.Index); err != nil {
+		return errors.Errorf("failed to delete classify table %s: %v", table.Name, err)
+	}
+	return nil
+}
+
+// Retrieve returns all classify tables configured in VPP.
+func (d *ClassifyTableDescriptor) Retrieve(correlate []adapter.ClassifyTableKVWithMetadata) (
+	retrieved []adapter.ClassifyTableKVWithMetadata, err error,
+) {
+	tables, err := d.classifierHandler.DumpClassifyTables()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump classify tables: %v", err)
+	}
+
+	// VPP does not know table names - tables are matched against the expected
+	// configuration by the index (known from metadata after the first resync)
+	// and then by the attributes.
+	dumped := make(map[uint32]*vppcalls.ClassifyTableDetails)
+	for _, table := range tables {
+		dumped[table.Meta.Index] = table
+	}
+	matched := make(map[uint32]*classifier.ClassifyTable)
+	var unmatched []*classifier.ClassifyTable
+	for _, kv := range correlate {
+		if kv.Metadata != nil {
+			if table, ok := dumped[kv.Metadata.Index]; ok && matched[kv.Metadata.Index] == nil &&
+				equivalentTableAttrs(kv.Value, table.Table) {
+				matched[kv.Metadata.Index] = kv.Value
+				continue
+			}
+		}
+		unmatched = append(unmatched, kv.Value)
+	}
+	for _, nbTable := range unmatched {
+		for _, table := range tables {
+			if matched[table.Meta.Index] == nil && equivalentTableAttrs(nbTable, table.Table) {
+				matched[table.Meta.Index] = nbTable
+				break
+			}
+		}
+	}
+
+	tableName := func(tableIdx uint32) string {
+		if nbTable, ok := matched[tableIdx]; ok {
+			return nbTable.Name
+		}
+		return fmt.Sprintf("%s%d", retrievedTableNamePrefix, tableIdx)
+	}
+	for _, table := range tables {
+		table.Table.Name = tableName(table.Meta.Index)
+		if _, ok := dumped[table.Meta.NextTableIndex]; ok {
+			table.Table.NextTable = tableName(table.Meta.NextTableIndex)
+		}
+		if nbTable, ok := matched[table.Meta.Index]; ok {
+			// memory size cannot be dumped
+			table.Table.MemorySize = nbTable.MemorySize
+		}
+		retrieved = append(retrieved, adapter.ClassifyTableKVWithMetadata{
+			Key:   classifier.ClassifyTableKey(table.Table.Name),
+			Value: table.Table,
+			Metadata: &classifieridx.ClassifyTableMetadata{
+				Index:         table.Meta.Index,
+				SkipNVectors:  table.Meta.SkipNVectors,
+				MatchNVectors: table.Meta.MatchNVectors,
+			},
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the next table (if any) as the only dependency.
+func (d *ClassifyTableDescriptor) Dependencies(key string, table *classifier.ClassifyTable) []kvs.Dependency {
+	if table.NextTable == "" {
+		return nil
+	}
+	return []kvs.Dependency{
+		{
+			Label: nextTableDep,
+			Key:   classifier.ClassifyTableKey(table.NextTable),
+		},
+	}
+}
+
+// equivalentTableAttrs compares table attributes which can be retrieved from VPP
+// and are known before the table references are resolved.
+func equivalentTableAttrs(table1, table2 *classifier.ClassifyTable) bool {
+	mask1, _ := hex.DecodeString(table1.Mask)
+	mask2, _ := hex.DecodeString(table2.Mask)
+	return bytes.Equal(mask1, mask2) &&
+		table1.SkipNVectors == table2.SkipNVectors &&
+		getNbuckets(table1) == getNbuckets(table2) &&
+		table1.MissNext == table2.MissNext
+}
+
+func getNbuckets(table *classifier.ClassifyTable) uint32 {
+	if table.Nbuckets == 0 {
+		return vppcalls.DefaultNbuckets
+	}
+	return table.Nbuckets
+}
+
+func getMemorySize(table *classifier.ClassifyTable) uint32 {
+	if table.MemorySize == 0 {
+		return vppcalls.DefaultMemorySize
+	}
+	return table.MemorySize
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/classifieridx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+)
+
+const (
+	// DefaultNbuckets is the number of hash buckets used for classify table
+	// with undefined nbuckets.
+	DefaultNbuckets = 32
+	// DefaultMemorySize is the size of memory (in bytes) allocated for sessions
+	// of classify table with undefined memory size.
+	DefaultMemorySize = 2 << 20
+	// VectorSize is the size of one classifier vector in bytes.
+	VectorSize = 16
+)
+
+// ClassifyTableDetails contains classify table configuration together with VPP-internal data.
+// Table name is not known to VPP and is therefore left empty, as well as the name of the next table.
+type ClassifyTableDetails struct {
+	Table *classifier.ClassifyTable
+	Meta  *ClassifyTableMeta
+}
+
+// ClassifyTableMeta contains VPP-internal classify table data.
+type ClassifyTableMeta struct {
+	Index          uint32
+	NextTableIndex uint32
+	SkipNVectors   uint32
+	MatchNVectors  uint32
+}
+
+// ClassifyInterfaceDetails contains indexes of classify tables attached to the interface.
+type ClassifyInterfaceDetails struct {
+	Interface     string
+	IP4TableIndex uint32
+	IP6TableIndex uint32
+	L2TableIndex  uint32
+}
+
+// ClassifierVppAPI provides methods for managing VPP classify tables and sessions.
+type ClassifierVppAPI interface {
+	ClassifierVppRead
+
+	// AddClassifyTable creates new classify table chained with the table
+	// of the given index (^uint32(0) if not chained) and returns its VPP index.
+	AddClassifyTable(table *classifier.ClassifyTable, nextTableIdx uint32) (tableIdx uint32, err error)
+	// DeleteClassifyTable removes classify table with the given index.
+	DeleteClassifyTable(tableIdx uint32) error
+	// AddClassifySession adds session into the classify table.
+	AddClassifySession(session *classifier.ClassifySession, table *classifieridx.ClassifyTableMetadata) error
+	// DeleteClassifySession removes session from the classify table.
+	DeleteClassifySession(session *classifier.ClassifySession, table *classifieridx.ClassifyTableMetadata) error
+	// AttachClassifyTables attaches classify tables (^uint32(0) for unused) to the interface
+	// to classify received (or transmitted if <output> is true) packets.
+	AttachClassifyTables(ifIdx uint32, output bool, ip4TableIdx, ip6TableIdx, l2TableIdx uint32) error
+	// DetachClassifyTables detaches classify tables from the interface.
+	DetachClassifyTables(ifIdx uint32, output bool, ip4TableIdx, ip6TableIdx, l2TableIdx uint32) error
+}
+
+// ClassifierVppRead provides read methods for VPP classify tables and sessions.
+type ClassifierVppRead interface {
+	// DumpClassifyTables dumps all classify tables configured in VPP.
+	DumpClassifyTables() ([]*ClassifyTableDetails, error)
+	// DumpClassifySessions dumps all sessions of the classify table with the given index.
+	// Table name is left empty in the returned sessions.
+	DumpClassifySessions(tableIdx uint32) ([]*classifier.ClassifySession, error)
+	// DumpClassifyInputInterfaces dumps classify tables attached to interfaces
+	// for received packets. VPP does not provide a dump of tables attached
+	// for transmitted packets.
+	DumpClassifyInputInterfaces() ([]*ClassifyInterfaceDetails, error)
+}
+
+var handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "classifier",
+	HandlerAPI: (*ClassifierVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) ClassifierVppAPI
+
+func AddClassifierHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleClassifierVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) ClassifierVppAPI {
+	if v := handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(ClassifierVppAPI)
+	}
+	return nil
+}
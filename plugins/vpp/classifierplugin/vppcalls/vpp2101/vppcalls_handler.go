@@ -0,0 +1,50 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2101
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	vpp2101 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101"
+	vpp_classify "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+)
+
+func init() {
+	var msgs []govppapi.Message
+	msgs = append(msgs, vpp_classify.AllMessages()...)
+
+	vppcalls.AddClassifierHandlerVersion(vpp2101.Version, msgs, NewClassifierVppHandler)
+}
+
+// ClassifierVppHandler is accessor for classifier-related vppcalls methods
+type ClassifierVppHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+// NewClassifierVppHandler creates new instance of classifier vppcalls handler
+func NewClassifierVppHandler(
+	callsChan govppapi.Channel, ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger,
+) vppcalls.ClassifierVppAPI {
+	return &ClassifierVppHandler{
+		callsChannel: callsChan,
+		ifIndexes:    ifIndexes,
+		log:          log,
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2106
+
+import (
+	"encoding/hex"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+)
+
+// DumpClassifyTables implements classifier handler.
+func (h *ClassifierVppHandler) DumpClassifyTables() (tables []*vppcalls.ClassifyTableDetails, err error) {
+	idsReply := &classify.ClassifyTableIdsReply{}
+	if err := h.callsChannel.SendRequest(&classify.ClassifyTableIds{}).ReceiveReply(idsReply); err != nil {
+		return nil, err
+	}
+	for _, tableID := range idsReply.Ids {
+		req := &classify.ClassifyTableInfo{
+			TableID: tableID,
+		}
+		reply := &classify.ClassifyTableInfoReply{}
+		if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+			return nil, err
+		}
+		tables = append(tables, &vppcalls.ClassifyTableDetails{
+			Table: &classifier.ClassifyTable{
+				Mask:         hex.EncodeToString(reply.Mask),
+				SkipNVectors: reply.SkipNVectors,
+				Nbuckets:     reply.Nbuckets,
+				MissNext:     nextIndexToNB(reply.MissNextIndex),
+				// MemorySize: not available in the binary API
+			},
+			Meta: &vppcalls.ClassifyTableMeta{
+				Index:          reply.TableID,
+				NextTableIndex: reply.NextTableIndex,
+				SkipNVectors:   reply.SkipNVectors,
+				MatchNVectors:  reply.MatchNVectors,
+			},
+		})
+	}
+	return tables, nil
+}
+
+// DumpClassifySessions implements classifier handler.
+func (h *ClassifierVppHandler) DumpClassifySessions(tableIdx uint32) (sessions []*classifier.ClassifySession, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&classify.ClassifySessionDump{
+		TableID: tableIdx,
+	})
+	for {
+		details := &classify.ClassifySessionDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, &classifier.ClassifySession{
+			Match:       hex.EncodeToString(details.Match),
+			HitNext:     nextIndexToNB(details.HitNextIndex),
+			OpaqueIndex: details.OpaqueIndex,
+			Advance:     details.Advance,
+			// Action, Metadata: not available in the binary API
+		})
+	}
+	return sessions, nil
+}
+
+// DumpClassifyInputInterfaces implements classifier handler.
+func (h *ClassifierVppHandler) DumpClassifyInputInterfaces() (ifaces []*vppcalls.ClassifyInterfaceDetails, err error) {
+	for _, ifName := range h.ifIndexes.ListAllInterfaces() {
+		ifMeta, exists := h.ifIndexes.LookupByName(ifName)
+		if !exists {
+			continue
+		}
+		req := &classify.ClassifyTableByInterface{
+			SwIfIndex: interface_types.InterfaceIndex(ifMeta.SwIfIndex),
+		}
+		reply := &classify.ClassifyTableByInterfaceReply{}
+		if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+			return nil, err
+		}
+		if reply.IP4TableID == ^uint32(0) && reply.IP6TableID == ^uint32(0) && reply.L2TableID == ^uint32(0) {
+			continue
+		}
+		ifaces = append(ifaces, &vppcalls.ClassifyInterfaceDetails{
+			Interface:     ifName,
+			IP4TableIndex: reply.IP4TableID,
+			IP6TableIndex: reply.IP6TableID,
+			L2TableIndex:  reply.L2TableID,
+		})
+	}
+	return ifaces, nil
+}
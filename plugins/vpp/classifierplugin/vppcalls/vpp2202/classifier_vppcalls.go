@@ -0,0 +1,175 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/classifieridx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+)
+
+// next index used by VPP for packets dropped by the input/output ACL
+const aclNextIndexDeny = 0
+
+// AddClassifyTable implements classifier handler.
+func (h *ClassifierVppHandler) AddClassifyTable(table *classifier.ClassifyTable, nextTableIdx uint32) (uint32, error) {
+	mask, err := hex.DecodeString(table.Mask)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid classify table mask")
+	}
+	if len(mask) == 0 || len(mask)%vppcalls.VectorSize != 0 {
+		return 0, errors.Errorf("classify table mask length %d is not a multiple of %d",
+			len(mask), vppcalls.VectorSize)
+	}
+	nbuckets := table.Nbuckets
+	if nbuckets == 0 {
+		nbuckets = vppcalls.DefaultNbuckets
+	}
+	memorySize := table.MemorySize
+	if memorySize == 0 {
+		memorySize = vppcalls.DefaultMemorySize
+	}
+
+	req := &classify.ClassifyAddDelTable{
+		IsAdd:          true,
+		TableIndex:     ^uint32(0),
+		Nbuckets:       nbuckets,
+		MemorySize:     memorySize,
+		SkipNVectors:   table.SkipNVectors,
+		MatchNVectors:  uint32(len(mask) / vppcalls.VectorSize),
+		NextTableIndex: nextTableIdx,
+		MissNextIndex:  nextIndexToVpp(table.MissNext),
+		MaskLen:        uint32(len(mask)),
+		Mask:           mask,
+	}
+	reply := &classify.ClassifyAddDelTableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	return reply.NewTableIndex, nil
+}
+
+// DeleteClassifyTable implements classifier handler.
+func (h *ClassifierVppHandler) DeleteClassifyTable(tableIdx uint32) error {
+	req := &classify.ClassifyAddDelTable{
+		IsAdd:      false,
+		TableIndex: tableIdx,
+	}
+	reply := &classify.ClassifyAddDelTableReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// AddClassifySession implements classifier handler.
+func (h *ClassifierVppHandler) AddClassifySession(session *classifier.ClassifySession, table *classifieridx.ClassifyTableMetadata) error {
+	return h.addDelClassifySession(true, session, table)
+}
+
+// DeleteClassifySession implements classifier handler.
+func (h *ClassifierVppHandler) DeleteClassifySession(session *classifier.ClassifySession, table *classifieridx.ClassifyTableMetadata) error {
+	return h.addDelClassifySession(false, session, table)
+}
+
+func (h *ClassifierVppHandler) addDelClassifySession(isAdd bool, session *classifier.ClassifySession, table *classifieridx.ClassifyTableMetadata) error {
+	match, err := sessionMatchToVpp(session.Match, table)
+	if err != nil {
+		return err
+	}
+
+	req := &classify.ClassifyAddDelSession{
+		IsAdd:        isAdd,
+		TableIndex:   table.Index,
+		HitNextIndex: nextIndexToVpp(session.HitNext),
+		OpaqueIndex:  session.OpaqueIndex,
+		Advance:      session.Advance,
+		Action:       classify.ClassifyAction(session.Action),
+		Metadata:     session.Metadata,
+		MatchLen:     uint32(len(match)),
+		Match:        match,
+	}
+	reply := &classify.ClassifyAddDelSessionReply{}
+
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// AttachClassifyTables implements classifier handler.
+func (h *ClassifierVppHandler) AttachClassifyTables(ifIdx uint32, output bool, ip4TableIdx, ip6TableIdx, l2TableIdx uint32) error {
+	return h.setInterfaceClassifyTables(true, ifIdx, output, ip4TableIdx, ip6TableIdx, l2TableIdx)
+}
+
+// DetachClassifyTables implements classifier handler.
+func (h *ClassifierVppHandler) DetachClassifyTables(ifIdx uint32, output bool, ip4TableIdx, ip6TableIdx, l2TableIdx uint32) error {
+	return h.setInterfaceClassifyTables(false, ifIdx, output, ip4TableIdx, ip6TableIdx, l2TableIdx)
+}
+
+func (h *ClassifierVppHandler) setInterfaceClassifyTables(isAdd bool, ifIdx uint32, output bool, ip4TableIdx, ip6TableIdx, l2TableIdx uint32) error {
+	if output {
+		req := &classify.OutputACLSetInterface{
+			SwIfIndex:     interface_types.InterfaceIndex(ifIdx),
+			IP4TableIndex: ip4TableIdx,
+			IP6TableIndex: ip6TableIdx,
+			L2TableIndex:  l2TableIdx,
+			IsAdd:         isAdd,
+		}
+		reply := &classify.OutputACLSetInterfaceReply{}
+		return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+	}
+	req := &classify.InputACLSetInterface{
+		SwIfIndex:     interface_types.InterfaceIndex(ifIdx),
+		IP4TableIndex: ip4TableIdx,
+		IP6TableIndex: ip6TableIdx,
+		L2TableIndex:  l2TableIdx,
+		IsAdd:         isAdd,
+	}
+	reply := &classify.InputACLSetInterfaceReply{}
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// sessionMatchToVpp converts hex-encoded session match into the form expected by VPP,
+// i.e. prepended with the skipped vectors and padded with zeros to the length of the mask.
+func sessionMatchToVpp(matchHex string, table *classifieridx.ClassifyTableMetadata) ([]byte, error) {
+	match, err := hex.DecodeString(matchHex)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid classify session match")
+	}
+	if len(match) > int(table.MatchNVectors)*vppcalls.VectorSize {
+		return nil, errors.Errorf("classify session match is longer than the table mask (%d bytes)",
+			table.MatchNVectors*vppcalls.VectorSize)
+	}
+	vppMatch := make([]byte, (table.SkipNVectors+table.MatchNVectors)*vppcalls.VectorSize)
+	copy(vppMatch[table.SkipNVectors*vppcalls.VectorSize:], match)
+	return vppMatch, nil
+}
+
+func nextIndexToVpp(action classifier.NextAction) uint32 {
+	if action == classifier.NextAction_DENY {
+		return aclNextIndexDeny
+	}
+	return ^uint32(0)
+}
+
+func nextIndexToNB(nextIdx uint32) classifier.NextAction {
+	if nextIdx == aclNextIndexDeny {
+		return classifier.NextAction_DENY
+	}
+	return classifier.NextAction_PERMIT
+}
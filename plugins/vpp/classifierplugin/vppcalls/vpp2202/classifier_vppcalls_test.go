@@ -0,0 +1,214 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	vpp_classify "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/classify"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/classifieridx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls"
+	vpp2202 "go.ligato.io/vpp-agent/v3/plugins/vpp/classifierplugin/vppcalls/vpp2202"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
+)
+
+func TestAddClassifyTable(t *testing.T) {
+	ctx, classifierHandler, _ := classifierTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelTableReply{NewTableIndex: 2})
+	tableIdx, err := classifierHandler.AddClassifyTable(&classifier.ClassifyTable{
+		Name:         "tbl1",
+		Mask:         "000000000000ffffffffffff00000000",
+		SkipNVectors: 1,
+		MissNext:     classifier.NextAction_DENY,
+	}, 5)
+	Expect(err).To(BeNil())
+	Expect(tableIdx).To(BeEquivalentTo(2))
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelTable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.Nbuckets).To(BeEquivalentTo(vppcalls.DefaultNbuckets))
+	Expect(vppMsg.MemorySize).To(BeEquivalentTo(vppcalls.DefaultMemorySize))
+	Expect(vppMsg.SkipNVectors).To(BeEquivalentTo(1))
+	Expect(vppMsg.MatchNVectors).To(BeEquivalentTo(1))
+	Expect(vppMsg.NextTableIndex).To(BeEquivalentTo(5))
+	Expect(vppMsg.MissNextIndex).To(BeEquivalentTo(0))
+	Expect(vppMsg.MaskLen).To(BeEquivalentTo(16))
+	Expect(vppMsg.Mask[6]).To(BeEquivalentTo(0xff))
+}
+
+func TestAddClassifyTableInvalidMask(t *testing.T) {
+	ctx, classifierHandler, _ := classifierTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	_, err := classifierHandler.AddClassifyTable(&classifier.ClassifyTable{
+		Name: "tbl1",
+		Mask: "ffff",
+	}, ^uint32(0))
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDeleteClassifyTable(t *testing.T) {
+	ctx, classifierHandler, _ := classifierTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelTableReply{})
+	err := classifierHandler.DeleteClassifyTable(2)
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelTable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeFalse())
+	Expect(vppMsg.TableIndex).To(BeEquivalentTo(2))
+}
+
+func TestAddClassifySession(t *testing.T) {
+	ctx, classifierHandler, _ := classifierTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyAddDelSessionReply{})
+	err := classifierHandler.AddClassifySession(&classifier.ClassifySession{
+		Table:    "tbl1",
+		Match:    "000000000000aabbccddeeff",
+		HitNext:  classifier.NextAction_PERMIT,
+		Action:   classifier.ClassifySession_SET_IP4_FIB_INDEX,
+		Metadata: 10,
+	}, &classifieridx.ClassifyTableMetadata{Index: 2, SkipNVectors: 1, MatchNVectors: 1})
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.ClassifyAddDelSession)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.TableIndex).To(BeEquivalentTo(2))
+	Expect(vppMsg.HitNextIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(vppMsg.Action).To(Equal(vpp_classify.CLASSIFY_API_ACTION_SET_IP4_FIB_INDEX))
+	Expect(vppMsg.Metadata).To(BeEquivalentTo(10))
+	Expect(vppMsg.MatchLen).To(BeEquivalentTo(32))
+	Expect(vppMsg.Match[:16]).To(Equal(make([]byte, 16)))
+	Expect(vppMsg.Match[22]).To(BeEquivalentTo(0xaa))
+}
+
+func TestAddClassifySessionMatchTooLong(t *testing.T) {
+	ctx, classifierHandler, _ := classifierTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := classifierHandler.AddClassifySession(&classifier.ClassifySession{
+		Table: "tbl1",
+		Match: "000000000000000000000000000000000000",
+	}, &classifieridx.ClassifyTableMetadata{Index: 2, MatchNVectors: 1})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestAttachClassifyTables(t *testing.T) {
+	ctx, classifierHandler, _ := classifierTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.InputACLSetInterfaceReply{})
+	err := classifierHandler.AttachClassifyTables(1, false, 2, ^uint32(0), ^uint32(0))
+	Expect(err).To(BeNil())
+	inMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.InputACLSetInterface)
+	Expect(ok).To(BeTrue())
+	Expect(inMsg.IsAdd).To(BeTrue())
+	Expect(inMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(inMsg.IP4TableIndex).To(BeEquivalentTo(2))
+
+	ctx.MockVpp.MockReply(&vpp_classify.OutputACLSetInterfaceReply{})
+	err = classifierHandler.DetachClassifyTables(1, true, ^uint32(0), 3, ^uint32(0))
+	Expect(err).To(BeNil())
+	outMsg, ok := ctx.MockChannel.Msg.(*vpp_classify.OutputACLSetInterface)
+	Expect(ok).To(BeTrue())
+	Expect(outMsg.IsAdd).To(BeFalse())
+	Expect(outMsg.IP6TableIndex).To(BeEquivalentTo(3))
+}
+
+func TestDumpClassifyTables(t *testing.T) {
+	ctx, classifierHandler, _ := classifierTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableIdsReply{Count: 1, Ids: []uint32{2}})
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableInfoReply{
+		TableID:        2,
+		Nbuckets:       32,
+		MatchNVectors:  1,
+		SkipNVectors:   1,
+		NextTableIndex: ^uint32(0),
+		MissNextIndex:  0,
+		MaskLength:     16,
+		Mask:           []byte{0, 0, 0, 0, 0, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0},
+	})
+
+	tables, err := classifierHandler.DumpClassifyTables()
+	Expect(err).To(BeNil())
+	Expect(tables).To(HaveLen(1))
+	Expect(tables[0].Table.Mask).To(Equal("000000000000ffffffffffff00000000"))
+	Expect(tables[0].Table.SkipNVectors).To(BeEquivalentTo(1))
+	Expect(tables[0].Table.MissNext).To(Equal(classifier.NextAction_DENY))
+	Expect(tables[0].Meta.Index).To(BeEquivalentTo(2))
+	Expect(tables[0].Meta.MatchNVectors).To(BeEquivalentTo(1))
+}
+
+func TestDumpClassifySessions(t *testing.T) {
+	ctx, classifierHandler, _ := classifierTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifySessionDetails{
+		TableID:      2,
+		HitNextIndex: ^uint32(0),
+		OpaqueIndex:  7,
+		MatchLength:  16,
+		Match:        []byte{0, 0, 0, 0, 0, 0, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff, 0, 0, 0, 0},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	sessions, err := classifierHandler.DumpClassifySessions(2)
+	Expect(err).To(BeNil())
+	Expect(sessions).To(HaveLen(1))
+	Expect(sessions[0].Match).To(Equal("000000000000aabbccddeeff00000000"))
+	Expect(sessions[0].HitNext).To(Equal(classifier.NextAction_PERMIT))
+	Expect(sessions[0].OpaqueIndex).To(BeEquivalentTo(7))
+}
+
+func TestDumpClassifyInputInterfaces(t *testing.T) {
+	ctx, classifierHandler, ifIndexes := classifierTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ctx.MockVpp.MockReply(&vpp_classify.ClassifyTableByInterfaceReply{
+		SwIfIndex:  1,
+		L2TableID:  ^uint32(0),
+		IP4TableID: 2,
+		IP6TableID: ^uint32(0),
+	})
+
+	ifaces, err := classifierHandler.DumpClassifyInputInterfaces()
+	Expect(err).To(BeNil())
+	Expect(ifaces).To(HaveLen(1))
+	Expect(ifaces[0].Interface).To(Equal("if1"))
+	Expect(ifaces[0].IP4TableIndex).To(BeEquivalentTo(2))
+	Expect(ifaces[0].L2TableIndex).To(BeEquivalentTo(^uint32(0)))
+}
+
+func classifierTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.ClassifierVppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	logger := logrus.NewLogger("test-log")
+	ifIndexes := ifaceidx.NewIfaceIndex(logger, "classifier-if-idx")
+	classifierHandler := vpp2202.NewClassifierVppHandler(ctx.MockChannel, ifIndexes, logrus.DefaultLogger())
+	return ctx, classifierHandler, ifIndexes
+}
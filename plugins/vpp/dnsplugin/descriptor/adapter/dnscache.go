@@ -17,6 +17,17 @@ type DNSCacheKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type DNSCacheBatchOp struct {
+	Key         string
+	Value       *vpp_dns.DNSCache
+	PrevValue   *vpp_dns.DNSCache
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type DNSCacheDescriptor struct {
@@ -33,11 +44,15 @@ type DNSCacheDescriptor struct {
 	Delete               func(key string, value *vpp_dns.DNSCache, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_dns.DNSCache, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_dns.DNSCache, metadata interface{}) bool
+	CreateBatch          func(ops []*DNSCacheBatchOp) error
+	UpdateBatch          func(ops []*DNSCacheBatchOp) error
+	DeleteBatch          func(ops []*DNSCacheBatchOp) error
 	Retrieve             func(correlate []DNSCacheKVWithMetadata) ([]DNSCacheKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_dns.DNSCache) []KeyValuePair
 	Dependencies         func(key string, value *vpp_dns.DNSCache) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewDNSCacheDescriptor(typedDescriptor *DNSCacheDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewDNSCacheDescriptor(typedDescriptor *DNSCacheDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *DNSCacheDescriptorAdapter) UpdateWithRecreate(key string, oldValue, ne
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *DNSCacheDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castDNSCacheBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyDNSCacheBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *DNSCacheDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castDNSCacheBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyDNSCacheBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *DNSCacheDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castDNSCacheBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyDNSCacheBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *DNSCacheDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []DNSCacheKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castDNSCacheValue(key string, value proto.Message) (*vpp_dns.DNSCache, erro
 	return typedValue, nil
 }
 
+func castDNSCacheBatchOps(ops []*BatchOp, withPrevValue bool) []*DNSCacheBatchOp {
+	var typedOps []*DNSCacheBatchOp
+	for _, op := range ops {
+		typedValue, err := castDNSCacheValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_dns.DNSCache
+		if withPrevValue {
+			typedPrevValue, err = castDNSCacheValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castDNSCacheMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &DNSCacheBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyDNSCacheBatchResults(ops []*BatchOp, typedOps []*DNSCacheBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castDNSCacheMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type BondedInterfaceKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type BondedInterfaceBatchOp struct {
+	Key         string
+	Value       *vpp_interfaces.BondLink_BondedInterface
+	PrevValue   *vpp_interfaces.BondLink_BondedInterface
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type BondedInterfaceDescriptor struct {
@@ -33,11 +44,15 @@ type BondedInterfaceDescriptor struct {
 	Delete               func(key string, value *vpp_interfaces.BondLink_BondedInterface, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_interfaces.BondLink_BondedInterface, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_interfaces.BondLink_BondedInterface, metadata interface{}) bool
+	CreateBatch          func(ops []*BondedInterfaceBatchOp) error
+	UpdateBatch          func(ops []*BondedInterfaceBatchOp) error
+	DeleteBatch          func(ops []*BondedInterfaceBatchOp) error
 	Retrieve             func(correlate []BondedInterfaceKVWithMetadata) ([]BondedInterfaceKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_interfaces.BondLink_BondedInterface) []KeyValuePair
 	Dependencies         func(key string, value *vpp_interfaces.BondLink_BondedInterface) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewBondedInterfaceDescriptor(typedDescriptor *BondedInterfaceDescriptor) *K
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewBondedInterfaceDescriptor(typedDescriptor *BondedInterfaceDescriptor) *K
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *BondedInterfaceDescriptorAdapter) UpdateWithRecreate(key string, oldVa
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *BondedInterfaceDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castBondedInterfaceBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyBondedInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *BondedInterfaceDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castBondedInterfaceBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyBondedInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *BondedInterfaceDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castBondedInterfaceBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyBondedInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *BondedInterfaceDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []BondedInterfaceKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castBondedInterfaceValue(key string, value proto.Message) (*vpp_interfaces.
 	return typedValue, nil
 }
 
+func castBondedInterfaceBatchOps(ops []*BatchOp, withPrevValue bool) []*BondedInterfaceBatchOp {
+	var typedOps []*BondedInterfaceBatchOp
+	for _, op := range ops {
+		typedValue, err := castBondedInterfaceValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_interfaces.BondLink_BondedInterface
+		if withPrevValue {
+			typedPrevValue, err = castBondedInterfaceValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castBondedInterfaceMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &BondedInterfaceBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyBondedInterfaceBatchResults(ops []*BatchOp, typedOps []*BondedInterfaceBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castBondedInterfaceMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
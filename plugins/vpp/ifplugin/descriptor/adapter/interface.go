@@ -18,6 +18,17 @@ type InterfaceKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type InterfaceBatchOp struct {
+	Key         string
+	Value       *vpp_interfaces.Interface
+	PrevValue   *vpp_interfaces.Interface
+	Metadata    *ifaceidx.IfaceMetadata
+	NewMetadata *ifaceidx.IfaceMetadata
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type InterfaceDescriptor struct {
@@ -34,11 +45,15 @@ type InterfaceDescriptor struct {
 	Delete               func(key string, value *vpp_interfaces.Interface, metadata *ifaceidx.IfaceMetadata) error
 	Update               func(key string, oldValue, newValue *vpp_interfaces.Interface, oldMetadata *ifaceidx.IfaceMetadata) (newMetadata *ifaceidx.IfaceMetadata, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_interfaces.Interface, metadata *ifaceidx.IfaceMetadata) bool
+	CreateBatch          func(ops []*InterfaceBatchOp) error
+	UpdateBatch          func(ops []*InterfaceBatchOp) error
+	DeleteBatch          func(ops []*InterfaceBatchOp) error
 	Retrieve             func(correlate []InterfaceKVWithMetadata) ([]InterfaceKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_interfaces.Interface) []KeyValuePair
 	Dependencies         func(key string, value *vpp_interfaces.Interface) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -59,6 +74,7 @@ func NewInterfaceDescriptor(typedDescriptor *InterfaceDescriptor) *KVDescriptor
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -78,6 +94,15 @@ func NewInterfaceDescriptor(typedDescriptor *InterfaceDescriptor) *KVDescriptor
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -159,6 +184,27 @@ func (da *InterfaceDescriptorAdapter) UpdateWithRecreate(key string, oldValue, n
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *InterfaceDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castInterfaceBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *InterfaceDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castInterfaceBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *InterfaceDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castInterfaceBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *InterfaceDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []InterfaceKVWithMetadata
 	for _, kvpair := range correlate {
@@ -222,6 +268,50 @@ func castInterfaceValue(key string, value proto.Message) (*vpp_interfaces.Interf
 	return typedValue, nil
 }
 
+func castInterfaceBatchOps(ops []*BatchOp, withPrevValue bool) []*InterfaceBatchOp {
+	var typedOps []*InterfaceBatchOp
+	for _, op := range ops {
+		typedValue, err := castInterfaceValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_interfaces.Interface
+		if withPrevValue {
+			typedPrevValue, err = castInterfaceValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castInterfaceMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &InterfaceBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyInterfaceBatchResults(ops []*BatchOp, typedOps []*InterfaceBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castInterfaceMetadata(key string, metadata Metadata) (*ifaceidx.IfaceMetadata, error) {
 	if metadata == nil {
 		return nil, nil
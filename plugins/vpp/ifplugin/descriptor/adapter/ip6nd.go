@@ -17,6 +17,17 @@ type IP6NDKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type IP6NDBatchOp struct {
+	Key         string
+	Value       *vpp_interfaces.Interface_IP6ND
+	PrevValue   *vpp_interfaces.Interface_IP6ND
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type IP6NDDescriptor struct {
@@ -33,11 +44,15 @@ type IP6NDDescriptor struct {
 	Delete               func(key string, value *vpp_interfaces.Interface_IP6ND, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_interfaces.Interface_IP6ND, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_interfaces.Interface_IP6ND, metadata interface{}) bool
+	CreateBatch          func(ops []*IP6NDBatchOp) error
+	UpdateBatch          func(ops []*IP6NDBatchOp) error
+	DeleteBatch          func(ops []*IP6NDBatchOp) error
 	Retrieve             func(correlate []IP6NDKVWithMetadata) ([]IP6NDKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_interfaces.Interface_IP6ND) []KeyValuePair
 	Dependencies         func(key string, value *vpp_interfaces.Interface_IP6ND) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewIP6NDDescriptor(typedDescriptor *IP6NDDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewIP6NDDescriptor(typedDescriptor *IP6NDDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *IP6NDDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newVa
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *IP6NDDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castIP6NDBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyIP6NDBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *IP6NDDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castIP6NDBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyIP6NDBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *IP6NDDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castIP6NDBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyIP6NDBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *IP6NDDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []IP6NDKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castIP6NDValue(key string, value proto.Message) (*vpp_interfaces.Interface_
 	return typedValue, nil
 }
 
+func castIP6NDBatchOps(ops []*BatchOp, withPrevValue bool) []*IP6NDBatchOp {
+	var typedOps []*IP6NDBatchOp
+	for _, op := range ops {
+		typedValue, err := castIP6NDValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_interfaces.Interface_IP6ND
+		if withPrevValue {
+			typedPrevValue, err = castIP6NDValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castIP6NDMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &IP6NDBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyIP6NDBatchResults(ops []*BatchOp, typedOps []*IP6NDBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castIP6NDMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type RxModeKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type RxModeBatchOp struct {
+	Key         string
+	Value       *vpp_interfaces.Interface
+	PrevValue   *vpp_interfaces.Interface
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type RxModeDescriptor struct {
@@ -33,11 +44,15 @@ type RxModeDescriptor struct {
 	Delete               func(key string, value *vpp_interfaces.Interface, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_interfaces.Interface, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_interfaces.Interface, metadata interface{}) bool
+	CreateBatch          func(ops []*RxModeBatchOp) error
+	UpdateBatch          func(ops []*RxModeBatchOp) error
+	DeleteBatch          func(ops []*RxModeBatchOp) error
 	Retrieve             func(correlate []RxModeKVWithMetadata) ([]RxModeKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_interfaces.Interface) []KeyValuePair
 	Dependencies         func(key string, value *vpp_interfaces.Interface) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewRxModeDescriptor(typedDescriptor *RxModeDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewRxModeDescriptor(typedDescriptor *RxModeDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *RxModeDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newV
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *RxModeDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castRxModeBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyRxModeBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *RxModeDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castRxModeBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyRxModeBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *RxModeDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castRxModeBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyRxModeBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *RxModeDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []RxModeKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castRxModeValue(key string, value proto.Message) (*vpp_interfaces.Interface
 	return typedValue, nil
 }
 
+func castRxModeBatchOps(ops []*BatchOp, withPrevValue bool) []*RxModeBatchOp {
+	var typedOps []*RxModeBatchOp
+	for _, op := range ops {
+		typedValue, err := castRxModeValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_interfaces.Interface
+		if withPrevValue {
+			typedPrevValue, err = castRxModeValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castRxModeMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &RxModeBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyRxModeBatchResults(ops []*BatchOp, typedOps []*RxModeBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castRxModeMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
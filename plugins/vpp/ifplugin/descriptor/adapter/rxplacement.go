@@ -17,6 +17,17 @@ type RxPlacementKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type RxPlacementBatchOp struct {
+	Key         string
+	Value       *vpp_interfaces.Interface_RxPlacement
+	PrevValue   *vpp_interfaces.Interface_RxPlacement
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type RxPlacementDescriptor struct {
@@ -33,11 +44,15 @@ type RxPlacementDescriptor struct {
 	Delete               func(key string, value *vpp_interfaces.Interface_RxPlacement, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_interfaces.Interface_RxPlacement, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_interfaces.Interface_RxPlacement, metadata interface{}) bool
+	CreateBatch          func(ops []*RxPlacementBatchOp) error
+	UpdateBatch          func(ops []*RxPlacementBatchOp) error
+	DeleteBatch          func(ops []*RxPlacementBatchOp) error
 	Retrieve             func(correlate []RxPlacementKVWithMetadata) ([]RxPlacementKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_interfaces.Interface_RxPlacement) []KeyValuePair
 	Dependencies         func(key string, value *vpp_interfaces.Interface_RxPlacement) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewRxPlacementDescriptor(typedDescriptor *RxPlacementDescriptor) *KVDescrip
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewRxPlacementDescriptor(typedDescriptor *RxPlacementDescriptor) *KVDescrip
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *RxPlacementDescriptorAdapter) UpdateWithRecreate(key string, oldValue,
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *RxPlacementDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castRxPlacementBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyRxPlacementBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *RxPlacementDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castRxPlacementBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyRxPlacementBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *RxPlacementDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castRxPlacementBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyRxPlacementBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *RxPlacementDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []RxPlacementKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castRxPlacementValue(key string, value proto.Message) (*vpp_interfaces.Inte
 	return typedValue, nil
 }
 
+func castRxPlacementBatchOps(ops []*BatchOp, withPrevValue bool) []*RxPlacementBatchOp {
+	var typedOps []*RxPlacementBatchOp
+	for _, op := range ops {
+		typedValue, err := castRxPlacementValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_interfaces.Interface_RxPlacement
+		if withPrevValue {
+			typedPrevValue, err = castRxPlacementValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castRxPlacementMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &RxPlacementBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyRxPlacementBatchResults(ops []*BatchOp, typedOps []*RxPlacementBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castRxPlacementMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type SpanKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type SpanBatchOp struct {
+	Key         string
+	Value       *vpp_interfaces.Span
+	PrevValue   *vpp_interfaces.Span
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type SpanDescriptor struct {
@@ -33,11 +44,15 @@ type SpanDescriptor struct {
 	Delete               func(key string, value *vpp_interfaces.Span, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_interfaces.Span, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_interfaces.Span, metadata interface{}) bool
+	CreateBatch          func(ops []*SpanBatchOp) error
+	UpdateBatch          func(ops []*SpanBatchOp) error
+	DeleteBatch          func(ops []*SpanBatchOp) error
 	Retrieve             func(correlate []SpanKVWithMetadata) ([]SpanKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_interfaces.Span) []KeyValuePair
 	Dependencies         func(key string, value *vpp_interfaces.Span) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewSpanDescriptor(typedDescriptor *SpanDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewSpanDescriptor(typedDescriptor *SpanDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *SpanDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newVal
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *SpanDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castSpanBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copySpanBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SpanDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castSpanBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copySpanBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SpanDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castSpanBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copySpanBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *SpanDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []SpanKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castSpanValue(key string, value proto.Message) (*vpp_interfaces.Span, error
 	return typedValue, nil
 }
 
+func castSpanBatchOps(ops []*BatchOp, withPrevValue bool) []*SpanBatchOp {
+	var typedOps []*SpanBatchOp
+	for _, op := range ops {
+		typedValue, err := castSpanValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_interfaces.Span
+		if withPrevValue {
+			typedPrevValue, err = castSpanValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castSpanMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &SpanBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copySpanBatchResults(ops []*BatchOp, typedOps []*SpanBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castSpanMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
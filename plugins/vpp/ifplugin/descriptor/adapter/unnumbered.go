@@ -17,6 +17,17 @@ type UnnumberedKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type UnnumberedBatchOp struct {
+	Key         string
+	Value       *vpp_interfaces.Interface_Unnumbered
+	PrevValue   *vpp_interfaces.Interface_Unnumbered
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type UnnumberedDescriptor struct {
@@ -33,11 +44,15 @@ type UnnumberedDescriptor struct {
 	Delete               func(key string, value *vpp_interfaces.Interface_Unnumbered, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_interfaces.Interface_Unnumbered, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_interfaces.Interface_Unnumbered, metadata interface{}) bool
+	CreateBatch          func(ops []*UnnumberedBatchOp) error
+	UpdateBatch          func(ops []*UnnumberedBatchOp) error
+	DeleteBatch          func(ops []*UnnumberedBatchOp) error
 	Retrieve             func(correlate []UnnumberedKVWithMetadata) ([]UnnumberedKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_interfaces.Interface_Unnumbered) []KeyValuePair
 	Dependencies         func(key string, value *vpp_interfaces.Interface_Unnumbered) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewUnnumberedDescriptor(typedDescriptor *UnnumberedDescriptor) *KVDescripto
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewUnnumberedDescriptor(typedDescriptor *UnnumberedDescriptor) *KVDescripto
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *UnnumberedDescriptorAdapter) UpdateWithRecreate(key string, oldValue,
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *UnnumberedDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castUnnumberedBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyUnnumberedBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *UnnumberedDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castUnnumberedBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyUnnumberedBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *UnnumberedDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castUnnumberedBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyUnnumberedBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *UnnumberedDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []UnnumberedKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castUnnumberedValue(key string, value proto.Message) (*vpp_interfaces.Inter
 	return typedValue, nil
 }
 
+func castUnnumberedBatchOps(ops []*BatchOp, withPrevValue bool) []*UnnumberedBatchOp {
+	var typedOps []*UnnumberedBatchOp
+	for _, op := range ops {
+		typedValue, err := castUnnumberedValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_interfaces.Interface_Unnumbered
+		if withPrevValue {
+			typedPrevValue, err = castUnnumberedValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castUnnumberedMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &UnnumberedBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyUnnumberedBatchResults(ops []*BatchOp, typedOps []*UnnumberedBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castUnnumberedMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -54,10 +54,13 @@ const (
 	vxlanGpeVrfTableDep      = "vrf-table-for-vxlan-gpe-exists"
 	gtpuMulticastDep         = "gtpu-multicast-interface-exists"
 	gtpuVrfTableDep          = "vrf-table-for-gtpu-exists"
+	geneveMulticastDep       = "geneve-multicast-interface-exists"
+	geneveVrfTableDep        = "vrf-table-for-geneve-exists"
 	ipipVrfTableDep          = "vrf-table-for-ipip-exists"
 	microserviceDep          = "microservice-available"
 	parentInterfaceDep       = "parent-interface-exists"
 	rdmaHostInterfaceDep     = "rdma-host-interface-exists"
+	mplsTunnelOutIfDep       = "mpls-tunnel-outgoing-interface-exists"
 
 	// how many characters a logical interface name is allowed to have
 	//  - determined by much fits into the VPP interface tag (64 null-terminated character string)
@@ -82,6 +85,10 @@ const (
 	// default RDMA attributes
 	defaultRdmaQueueNum  = 1
 	defaultRdmaQueueSize = 1024
+
+	// limits of MPLS tunnel label stack (20-bit labels, at most 16 labels per path)
+	maxMplsLabel           = 0xfffff
+	maxMplsLabelStackDepth = 16
 )
 
 // A list of non-retriable errors:
@@ -162,6 +169,18 @@ var (
 	// ErrGtpuDstAddrBad is returned when destination address was not set to valid IP address.
 	ErrGtpuDstAddrBad = errors.Errorf("bad destination address for GTPU tunnel")
 
+	// ErrGeneveSrcAddrBad is returned when source address was not set to valid IP address.
+	ErrGeneveSrcAddrBad = errors.Errorf("bad source address for GENEVE tunnel")
+
+	// ErrGeneveDstAddrBad is returned when destination address was not set to valid IP address.
+	ErrGeneveDstAddrBad = errors.Errorf("bad destination address for GENEVE tunnel")
+
+	// ErrGeneveSrcAndDstAddrIPVersionMismatch is returned when src and dst addresses have different IP versions.
+	ErrGeneveSrcAndDstAddrIPVersionMismatch = errors.Errorf("GENEVE tunnel src and dst addresses must have the same IP version")
+
+	// ErrGeneveVniTooLarge is returned when VNI of GENEVE tunnel exceeds 24 bits.
+	ErrGeneveVniTooLarge = errors.Errorf("VNI of GENEVE tunnel is too large (more than 24bits)")
+
 	// ErrIpipSrcAddrMissing is returned when source address was not set or set to an empty string.
 	ErrIpipSrcAddrMissing = errors.Errorf("missing source address for IPIP tunnel")
 
@@ -186,6 +205,18 @@ var (
 	// ErrWgPort is returned when udp-port exceeds max value.
 	ErrWgPort = errors.New("invalid wireguard port")
 
+	// ErrMplsTunnelWithoutPath is returned when MPLS tunnel has no path defined.
+	ErrMplsTunnelWithoutPath = errors.New("missing path for MPLS tunnel")
+
+	// ErrMplsTunnelNextHopBad is returned when next hop of MPLS tunnel path is not valid IP address.
+	ErrMplsTunnelNextHopBad = errors.New("bad next hop address for MPLS tunnel path")
+
+	// ErrMplsTunnelLabelStack is returned when label stack of MPLS tunnel path is too deep or contains invalid label.
+	ErrMplsTunnelLabelStack = errors.New("invalid label stack for MPLS tunnel path")
+
+	// ErrVhostUserSocketMissing is returned when socket filename is not configured for vhost-user link.
+	ErrVhostUserSocketMissing = errors.New("missing socket filename for vhost-user interface")
+
 	// ErrRdmaHostInterfaceMissing is returned when host_if_name is not configured for RDMA link.
 	ErrRdmaHostInterfaceMissing = errors.Errorf("missing the host interface name for RDMA")
 
@@ -385,6 +416,10 @@ func (d *InterfaceDescriptor) equivalentTypeSpecificConfig(oldIntf, newIntf *int
 		if !proto.Equal(oldIntf.GetGtpu(), newIntf.GetGtpu()) {
 			return false
 		}
+	case interfaces.Interface_GENEVE_TUNNEL:
+		if !proto.Equal(oldIntf.GetGeneve(), newIntf.GetGeneve()) {
+			return false
+		}
 	case interfaces.Interface_IPIP_TUNNEL:
 		if !proto.Equal(oldIntf.GetIpip(), newIntf.GetIpip()) {
 			return false
@@ -393,6 +428,14 @@ func (d *InterfaceDescriptor) equivalentTypeSpecificConfig(oldIntf, newIntf *int
 		if !proto.Equal(oldIntf.GetWireguard(), newIntf.GetWireguard()) {
 			return false
 		}
+	case interfaces.Interface_MPLS_TUNNEL:
+		if !d.equivalentMplsTunnels(oldIntf.GetMplsTunnel(), newIntf.GetMplsTunnel()) {
+			return false
+		}
+	case interfaces.Interface_VHOST_USER:
+		if !proto.Equal(oldIntf.GetVhostUser(), newIntf.GetVhostUser()) {
+			return false
+		}
 	case interfaces.Interface_RDMA:
 		if !d.equivalentRdma(oldIntf.GetRdma(), newIntf.GetRdma()) {
 			return false
@@ -474,6 +517,29 @@ func (d *InterfaceDescriptor) equivalentRdma(oldRdma, newRdma *interfaces.RDMALi
 		d.getRdmaTxQueueSize(oldRdma) == d.getRdmaTxQueueSize(newRdma)
 }
 
+// equivalentMplsTunnels compares two MPLS tunnels for equivalence.
+func (d *InterfaceDescriptor) equivalentMplsTunnels(oldTun, newTun *interfaces.MplsTunnelLink) bool {
+	if oldTun.GetL2Only() != newTun.GetL2Only() ||
+		oldTun.GetMulticast() != newTun.GetMulticast() ||
+		len(oldTun.GetPaths()) != len(newTun.GetPaths()) {
+		return false
+	}
+	for i, oldPath := range oldTun.GetPaths() {
+		newPath := newTun.GetPaths()[i]
+		// weight 0 is the same as the default weight 1
+		if oldPath.Weight != newPath.Weight && oldPath.Weight+newPath.Weight != 1 {
+			return false
+		}
+		oldPath = proto.Clone(oldPath).(*l3.Route_Path)
+		newPath = proto.Clone(newPath).(*l3.Route_Path)
+		oldPath.Weight, newPath.Weight = 0, 0
+		if !proto.Equal(oldPath, newPath) {
+			return false
+		}
+	}
+	return true
+}
+
 // MetadataFactory is a factory for index-map customized for VPP interfaces.
 func (d *InterfaceDescriptor) MetadataFactory() idxmap.NamedMappingRW {
 	return ifaceidx.NewIfaceIndex(d.log, "vpp-interface-index")
@@ -536,6 +602,10 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 		if intf.Type != interfaces.Interface_GTPU_TUNNEL {
 			return linkMismatchErr
 		}
+	case *interfaces.Interface_Geneve:
+		if intf.Type != interfaces.Interface_GENEVE_TUNNEL {
+			return linkMismatchErr
+		}
 	case *interfaces.Interface_Ipip:
 		if intf.Type != interfaces.Interface_IPIP_TUNNEL {
 			return linkMismatchErr
@@ -548,6 +618,14 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 		if intf.Type != interfaces.Interface_RDMA {
 			return linkMismatchErr
 		}
+	case *interfaces.Interface_MplsTunnel:
+		if intf.Type != interfaces.Interface_MPLS_TUNNEL {
+			return linkMismatchErr
+		}
+	case *interfaces.Interface_VhostUser:
+		if intf.Type != interfaces.Interface_VHOST_USER {
+			return linkMismatchErr
+		}
 	case nil:
 		if intf.Type != interfaces.Interface_SOFTWARE_LOOPBACK &&
 			intf.Type != interfaces.Interface_DPDK {
@@ -629,6 +707,22 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 		if net.ParseIP(intf.GetGtpu().DstAddr) == nil {
 			return kvs.NewInvalidValueError(ErrGtpuDstAddrBad, "link.gtpu.dst_addr")
 		}
+	case interfaces.Interface_GENEVE_TUNNEL:
+		srcAddr := net.ParseIP(intf.GetGeneve().GetSrcAddress())
+		if srcAddr == nil {
+			return kvs.NewInvalidValueError(ErrGeneveSrcAddrBad, "link.geneve.src_address")
+		}
+		dstAddr := net.ParseIP(intf.GetGeneve().GetDstAddress())
+		if dstAddr == nil {
+			return kvs.NewInvalidValueError(ErrGeneveDstAddrBad, "link.geneve.dst_address")
+		}
+		if (srcAddr.To4() == nil) != (dstAddr.To4() == nil) {
+			return kvs.NewInvalidValueError(ErrGeneveSrcAndDstAddrIPVersionMismatch,
+				"link.geneve.src_address", "link.geneve.dst_address")
+		}
+		if intf.GetGeneve().GetVni()>>24 != 0 {
+			return kvs.NewInvalidValueError(ErrGeneveVniTooLarge, "link.geneve.vni")
+		}
 	case interfaces.Interface_IPIP_TUNNEL:
 		if intf.GetIpip().SrcAddr == "" {
 			return kvs.NewInvalidValueError(ErrIpipSrcAddrMissing, "link.ipip.src_addr")
@@ -660,6 +754,27 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 		if intf.GetWireguard().Port > 0xFFFF {
 			return kvs.NewInvalidValueError(ErrWgPort, "link.wireguard.port")
 		}
+	case interfaces.Interface_MPLS_TUNNEL:
+		if len(intf.GetMplsTunnel().GetPaths()) == 0 {
+			return kvs.NewInvalidValueError(ErrMplsTunnelWithoutPath, "link.mpls_tunnel.paths")
+		}
+		for _, path := range intf.GetMplsTunnel().GetPaths() {
+			if path.NextHopAddr != "" && net.ParseIP(path.NextHopAddr) == nil {
+				return kvs.NewInvalidValueError(ErrMplsTunnelNextHopBad, "link.mpls_tunnel.paths.next_hop_addr")
+			}
+			if len(path.LabelStack) > maxMplsLabelStackDepth {
+				return kvs.NewInvalidValueError(ErrMplsTunnelLabelStack, "link.mpls_tunnel.paths.label_stack")
+			}
+			for _, label := range path.LabelStack {
+				if label > maxMplsLabel {
+					return kvs.NewInvalidValueError(ErrMplsTunnelLabelStack, "link.mpls_tunnel.paths.label_stack")
+				}
+			}
+		}
+	case interfaces.Interface_VHOST_USER:
+		if intf.GetVhostUser().GetSocketFilename() == "" {
+			return kvs.NewInvalidValueError(ErrVhostUserSocketMissing, "link.vhost_user.socket_filename")
+		}
 	case interfaces.Interface_RDMA:
 		if intf.GetRdma().GetHostIfName() == "" {
 			return kvs.NewInvalidValueError(ErrRdmaHostInterfaceMissing, "link.rdma.host_if_name")
@@ -840,6 +955,37 @@ func (d *InterfaceDescriptor) Dependencies(key string, intf *interfaces.Interfac
 			})
 		}
 
+	case interfaces.Interface_GENEVE_TUNNEL:
+		// GENEVE referencing an interface with Multicast IP address
+		if geneveMulticast := intf.GetGeneve().GetMulticast(); geneveMulticast != "" {
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: geneveMulticastDep,
+				AnyOf: kvs.AnyOfDependency{
+					KeyPrefixes: []string{interfaces.InterfaceAddressPrefix(geneveMulticast)},
+					KeySelector: func(key string) bool {
+						_, ifaceAddr, source, _, _ := interfaces.ParseInterfaceAddressKey(key)
+						if source != netalloc_api.IPAddressSource_ALLOC_REF {
+							ip, _, err := net.ParseCIDR(ifaceAddr)
+							return err == nil && ip.IsMulticast()
+						}
+						return false
+					},
+				},
+			})
+		}
+		if intf.GetGeneve().GetEncapVrfId() != 0 {
+			// binary API for creating GENEVE tunnel requires the VRF table
+			// to be already created
+			var protocol l3.VrfTable_Protocol
+			if net.ParseIP(intf.GetGeneve().GetSrcAddress()).To4() == nil {
+				protocol = l3.VrfTable_IPV6
+			}
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: geneveVrfTableDep,
+				Key:   l3.VrfTableKey(intf.GetGeneve().GetEncapVrfId(), protocol),
+			})
+		}
+
 	case interfaces.Interface_IPIP_TUNNEL:
 		if intf.GetVrf() != 0 {
 			// binary API for creating IPIP tunnel requires the VRF table to be already created
@@ -864,6 +1010,17 @@ func (d *InterfaceDescriptor) Dependencies(key string, intf *interfaces.Interfac
 			})
 		}
 
+	case interfaces.Interface_MPLS_TUNNEL:
+		// outgoing interfaces of MPLS tunnel paths must exist
+		for _, path := range intf.GetMplsTunnel().GetPaths() {
+			if path.OutgoingInterface != "" {
+				dependencies = append(dependencies, kvs.Dependency{
+					Label: mplsTunnelOutIfDep + "-" + path.OutgoingInterface,
+					Key:   interfaces.InterfaceKey(path.OutgoingInterface),
+				})
+			}
+		}
+
 	case interfaces.Interface_RDMA:
 		// RDMA depends on a referenced Linux interface in the default namespace
 		dependencies = append(dependencies, kvs.Dependency{
@@ -955,6 +1112,14 @@ func (d *InterfaceDescriptor) DerivedValues(key string, intf *interfaces.Interfa
 			} else {
 				hasIPv4 = true
 			}
+		case interfaces.Interface_GENEVE_TUNNEL:
+			srcAddr := net.ParseIP(intf.GetGeneve().GetSrcAddress()).To4()
+			dstAddr := net.ParseIP(intf.GetGeneve().GetDstAddress()).To4()
+			if srcAddr == nil && dstAddr == nil {
+				hasIPv6 = true
+			} else {
+				hasIPv4 = true
+			}
 		case interfaces.Interface_IPIP_TUNNEL:
 			srcAddr := net.ParseIP(intf.GetIpip().GetSrcAddr()).To4()
 			dstAddr := net.ParseIP(intf.GetIpip().GetDstAddr()).To4()
@@ -1033,6 +1198,24 @@ func (d *InterfaceDescriptor) getAfPacketTargetHostIfName(afpacket *interfaces.A
 	return linuxIfMeta.HostIfName, nil
 }
 
+// getMplsTunnelOutIfIdxs returns indexes of outgoing interfaces for all paths of the given MPLS tunnel
+// (^uint32(0) is used for paths without outgoing interface).
+func (d *InterfaceDescriptor) getMplsTunnelOutIfIdxs(mplsTunnel *interfaces.MplsTunnelLink) ([]uint32, error) {
+	outIfIdxs := make([]uint32, len(mplsTunnel.GetPaths()))
+	for i, path := range mplsTunnel.GetPaths() {
+		outIfIdxs[i] = ^uint32(0)
+		if path.OutgoingInterface == "" {
+			continue
+		}
+		ifMeta, exists := d.intfIndex.LookupByName(path.OutgoingInterface)
+		if !exists {
+			return nil, errors.Errorf("failed to find outgoing interface %s of MPLS tunnel path", path.OutgoingInterface)
+		}
+		outIfIdxs[i] = ifMeta.SwIfIndex
+	}
+	return outIfIdxs, nil
+}
+
 // resolveMemifSocketFilename returns memif socket filename ID.
 // Registers it if does not exists yet.
 func (d *InterfaceDescriptor) resolveMemifSocketFilename(memifIf *interfaces.MemifLink) (uint32, error) {
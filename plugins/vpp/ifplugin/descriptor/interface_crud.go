@@ -14,6 +14,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
 	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	vpp_linuxcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/linuxcp"
 )
 
 // Create creates a VPP interface.
@@ -135,6 +136,17 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 			d.log.Error(err)
 			return nil, err
 		}
+	case interfaces.Interface_MPLS_TUNNEL:
+		outIfIdxs, err := d.getMplsTunnelOutIfIdxs(intf.GetMplsTunnel())
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+		ifIdx, err = d.ifHandler.AddMplsTunnel(intf.Name, intf.GetMplsTunnel(), outIfIdxs)
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
 	case interfaces.Interface_SUB_INTERFACE:
 		sub := intf.GetSub()
 		parentMeta, found := d.intfIndex.LookupByName(sub.GetParentName())
@@ -198,6 +210,29 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 			return nil, err
 		}
 
+	case interfaces.Interface_GENEVE_TUNNEL:
+		var multicastIfIdx uint32
+		multicastIf := intf.GetGeneve().GetMulticast()
+		if multicastIf != "" {
+			multicastMeta, found := d.intfIndex.LookupByName(multicastIf)
+			if !found {
+				err = errors.Errorf("failed to find multicast interface %s referenced by GENEVE %s",
+					multicastIf, intf.Name)
+				d.log.Error(err)
+				return nil, err
+			}
+			multicastIfIdx = multicastMeta.SwIfIndex
+		} else {
+			// not a multicast tunnel
+			multicastIfIdx = 0xFFFFFFFF
+		}
+
+		ifIdx, err = d.ifHandler.AddGeneveTunnel(intf.Name, multicastIfIdx, intf.GetGeneve())
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+
 	case interfaces.Interface_IPIP_TUNNEL:
 		ifIdx, err = d.ifHandler.AddIpipTunnel(intf.Name, intf.GetVrf(), intf.GetIpip())
 		if err != nil {
@@ -211,6 +246,13 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 			d.log.Error(err)
 			return nil, err
 		}
+
+	case interfaces.Interface_VHOST_USER:
+		ifIdx, err = d.ifHandler.AddVhostUserInterface(ctx, intf.Name, intf.GetVhostUser())
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
 	}
 
 	// MAC address. Note: physical interfaces cannot have the MAC address changed. The bond interface uses its own
@@ -313,6 +355,12 @@ func (d *InterfaceDescriptor) Delete(key string, intf *interfaces.Interface, met
 		err = d.ifHandler.DeleteIPSecTunnelInterface(ctx, intf.Name, ifIdx, intf.GetIpsec())
 	case interfaces.Interface_WIREGUARD_TUNNEL:
 		err = d.ifHandler.DeleteWireguardTunnel(intf.Name, ifIdx)
+	case interfaces.Interface_MPLS_TUNNEL:
+		var outIfIdxs []uint32
+		outIfIdxs, err = d.getMplsTunnelOutIfIdxs(intf.GetMplsTunnel())
+		if err == nil {
+			err = d.ifHandler.DelMplsTunnel(intf.Name, ifIdx, intf.GetMplsTunnel(), outIfIdxs)
+		}
 	case interfaces.Interface_SUB_INTERFACE:
 		err = d.ifHandler.DeleteSubif(ifIdx)
 	case interfaces.Interface_VMXNET3_INTERFACE:
@@ -324,10 +372,14 @@ func (d *InterfaceDescriptor) Delete(key string, intf *interfaces.Interface, met
 		_, err = d.ifHandler.DelGreTunnel(intf.Name, intf.GetGre())
 	case interfaces.Interface_GTPU_TUNNEL:
 		err = d.ifHandler.DelGtpuTunnel(intf.Name, intf.GetGtpu())
+	case interfaces.Interface_GENEVE_TUNNEL:
+		err = d.ifHandler.DelGeneveTunnel(intf.Name, intf.GetGeneve())
 	case interfaces.Interface_IPIP_TUNNEL:
 		err = d.ifHandler.DelIpipTunnel(intf.Name, ifIdx)
 	case interfaces.Interface_RDMA:
 		err = d.ifHandler.DeleteRdmaInterface(ctx, intf.Name, ifIdx)
+	case interfaces.Interface_VHOST_USER:
+		err = d.ifHandler.DeleteVhostUserInterface(ctx, intf.Name, ifIdx)
 	}
 	if err != nil {
 		err = errors.Errorf("failed to remove interface %s, index %d: %v", intf.Name, ifIdx, err)
@@ -466,6 +518,11 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 			// local0 is created automatically
 			origin = kvs.FromSB
 		}
+		if _, isLinuxCpHost := vpp_linuxcp.ParseHostInterfaceTag(intf.Interface.Name); isLinuxCpHost {
+			// host interface of linux-cp pair is created (and removed) by VPP
+			// together with the pair
+			origin = kvs.FromSB
+		}
 		if intf.Interface.Type == interfaces.Interface_DPDK {
 			d.ethernetIfs[intf.Interface.Name] = ifIdx
 			if !intf.Interface.Enabled && len(intf.Interface.IpAddresses) == 0 {
@@ -517,6 +574,19 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 					intf.Interface.GetMemif().BufferSize = expCfg.GetMemif().GetBufferSize()
 				}
 			}
+			if expCfg.Type == interfaces.Interface_GENEVE_TUNNEL && intf.Interface.GetGeneve() != nil {
+				intf.Interface.GetGeneve().L3Mode = expCfg.GetGeneve().GetL3Mode()
+			}
+			if expCfg.Type == interfaces.Interface_VHOST_USER && intf.Interface.GetVhostUser() != nil {
+				// dumped features are the result of negotiation with the guest
+				// (and are not available at all until the guest connects)
+				vhostUser := intf.Interface.GetVhostUser()
+				vhostUser.EnableGso = expCfg.GetVhostUser().GetEnableGso()
+				vhostUser.EnablePacked = expCfg.GetVhostUser().GetEnablePacked()
+				vhostUser.EnableEventIdx = expCfg.GetVhostUser().GetEnableEventIdx()
+				vhostUser.DisableMrgRxbuf = expCfg.GetVhostUser().GetDisableMrgRxbuf()
+				vhostUser.DisableIndirectDesc = expCfg.GetVhostUser().GetDisableIndirectDesc()
+			}
 			//nolint:staticcheck
 			if expCfg.Type == interfaces.Interface_AF_PACKET && intf.Interface.GetAfpacket() != nil {
 				hostIfName, err := d.getAfPacketTargetHostIfName(expCfg.GetAfpacket())
@@ -571,7 +641,7 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 					intf.Interface.Name += afPacketMissingAttachedIfSuffix
 				}
 			}
-			if intf.Interface.Type == interfaces.Interface_TAP {
+			if intf.Interface.Type == interfaces.Interface_TAP && origin == kvs.FromNB {
 				exists, _ := d.linuxIfHandler.InterfaceExists(tapHostIfName)
 				if !exists {
 					// check if it was "stolen" by the Linux plugin
@@ -0,0 +1,337 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+	"net"
+	"sort"
+
+	"github.com/go-errors/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	netalloc_api "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// IP6RaDescriptorName is the name of the descriptor.
+	IP6RaDescriptorName = "vpp-ip6-ra"
+
+	// dependency labels
+	interfaceHasIPv6Dep = "interface-has-IPv6-address"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrIP6RaWithoutInterface is returned when router advertisement configuration
+	// is not associated with any interface.
+	ErrIP6RaWithoutInterface = errors.New("IPv6 router advertisement defined without interface")
+
+	// ErrIP6RaInvalidInterval is returned when RA intervals are out of range.
+	ErrIP6RaInvalidInterval = errors.New("invalid IPv6 router advertisement interval")
+
+	// ErrIP6RaInvalidLifetime is returned when router lifetime is shorter
+	// than maximum RA interval.
+	ErrIP6RaInvalidLifetime = errors.New("IPv6 router lifetime must be zero or not less than max interval")
+
+	// ErrIP6RaInvalidPrefix is returned when advertised prefix is not a valid IPv6 network.
+	ErrIP6RaInvalidPrefix = errors.New("invalid IPv6 router advertisement prefix")
+
+	// ErrIP6RaDuplicatePrefix is returned when the same prefix is advertised twice.
+	ErrIP6RaDuplicatePrefix = errors.New("duplicate IPv6 router advertisement prefix")
+
+	// ErrIP6RaPrefixLifetime is returned when preferred lifetime exceeds valid lifetime.
+	ErrIP6RaPrefixLifetime = errors.New("preferred lifetime of advertised prefix exceeds valid lifetime")
+)
+
+// IP6RaDescriptor teaches KVScheduler how to configure IPv6 router
+// advertisements sent by VPP interfaces.
+type IP6RaDescriptor struct {
+	log     logging.Logger
+	handler vppcalls.IP6ndVppAPI
+	ifIndex ifaceidx.IfaceMetadataIndex
+}
+
+// NewIP6RaDescriptor creates a new instance of the IP6RaDescriptor.
+func NewIP6RaDescriptor(handler vppcalls.IP6ndVppAPI, ifIndex ifaceidx.IfaceMetadataIndex,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &IP6RaDescriptor{
+		handler: handler,
+		ifIndex: ifIndex,
+		log:     log.NewLogger("ip6-ra-descriptor"),
+	}
+
+	typedDescr := &adapter.IP6RADescriptor{
+		Name:                 IP6RaDescriptorName,
+		NBKeyPrefix:          interfaces.ModelIP6RouterAdvertisement.KeyPrefix(),
+		ValueTypeName:        interfaces.ModelIP6RouterAdvertisement.ProtoName(),
+		KeySelector:          interfaces.ModelIP6RouterAdvertisement.IsKeyValid,
+		KeyLabel:             interfaces.ModelIP6RouterAdvertisement.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentIP6Ra,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Update:               ctx.Update,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{InterfaceDescriptorName},
+	}
+	return adapter.NewIP6RADescriptor(typedDescr)
+}
+
+// EquivalentIP6Ra compares router advertisement configurations, ignoring
+// the order and formatting of advertised prefixes.
+func (d *IP6RaDescriptor) EquivalentIP6Ra(key string, oldRa, newRa *interfaces.IP6RouterAdvertisement) bool {
+	return proto.Equal(normalizeIP6Ra(oldRa), normalizeIP6Ra(newRa))
+}
+
+// Validate validates router advertisement configuration.
+func (d *IP6RaDescriptor) Validate(key string, ra *interfaces.IP6RouterAdvertisement) error {
+	if ra.GetInterface() == "" {
+		return kvs.NewInvalidValueError(ErrIP6RaWithoutInterface, "interface")
+	}
+	if max := ra.GetMaxInterval(); max != 0 && (max < 4 || max > 1800) {
+		return kvs.NewInvalidValueError(ErrIP6RaInvalidInterval, "max_interval")
+	}
+	if min := ra.GetMinInterval(); min != 0 {
+		max := ra.GetMaxInterval()
+		if min < 3 || (max != 0 && 4*min > 3*max) {
+			return kvs.NewInvalidValueError(ErrIP6RaInvalidInterval, "min_interval")
+		}
+	}
+	if lifetime := ra.GetLifetime(); lifetime != 0 && lifetime < ra.GetMaxInterval() {
+		return kvs.NewInvalidValueError(ErrIP6RaInvalidLifetime, "lifetime")
+	}
+	prefixes := make(map[string]struct{})
+	for _, prefix := range ra.GetPrefixes() {
+		network := ip6RaPrefixNetwork(prefix)
+		if network == "" {
+			return kvs.NewInvalidValueError(ErrIP6RaInvalidPrefix, "prefixes.address")
+		}
+		if _, duplicate := prefixes[network]; duplicate {
+			return kvs.NewInvalidValueError(ErrIP6RaDuplicatePrefix, "prefixes.address")
+		}
+		prefixes[network] = struct{}{}
+		if prefix.GetPreferredLifetime() > prefix.GetValidLifetime() {
+			return kvs.NewInvalidValueError(ErrIP6RaPrefixLifetime,
+				"prefixes.preferred_lifetime", "prefixes.valid_lifetime")
+		}
+	}
+	return nil
+}
+
+// Create configures router advertisements on the interface.
+func (d *IP6RaDescriptor) Create(key string, ra *interfaces.IP6RouterAdvertisement) (metadata interface{}, err error) {
+	ifMeta, found := d.ifIndex.LookupByName(ra.Interface)
+	if !found {
+		err = errors.Errorf("failed to find interface %s", ra.Interface)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	ctx := context.Background()
+	if err = d.handler.SetIP6RaConfig(ctx, ifMeta.SwIfIndex, ra); err != nil {
+		err = errors.Errorf("failed to configure router advertisements for interface %s: %v", ra.Interface, err)
+		d.log.Error(err)
+		return nil, err
+	}
+	for _, prefix := range ra.Prefixes {
+		if err = d.handler.AddIP6RaPrefix(ctx, ifMeta.SwIfIndex, prefix); err != nil {
+			err = errors.Errorf("failed to add RA prefix %s for interface %s: %v",
+				prefix.Address, ra.Interface, err)
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// Update reconfigures router advertisements and advertised prefixes
+// of the interface.
+func (d *IP6RaDescriptor) Update(key string, oldRa, newRa *interfaces.IP6RouterAdvertisement,
+	oldMetadata interface{}) (newMetadata interface{}, err error) {
+
+	ifMeta, found := d.ifIndex.LookupByName(newRa.Interface)
+	if !found {
+		err = errors.Errorf("failed to find interface %s", newRa.Interface)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	ctx := context.Background()
+	// VPP keeps the current value for every parameter given as zero,
+	// therefore parameters reverted to defaults have to be reset explicitly
+	if ip6RaParamsReverted(oldRa, newRa) {
+		if err = d.handler.ResetIP6RaConfig(ctx, ifMeta.SwIfIndex); err != nil {
+			err = errors.Errorf("failed to reset router advertisements for interface %s: %v", newRa.Interface, err)
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+	if err = d.handler.SetIP6RaConfig(ctx, ifMeta.SwIfIndex, newRa); err != nil {
+		err = errors.Errorf("failed to configure router advertisements for interface %s: %v", newRa.Interface, err)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	newPrefixes := make(map[string]*interfaces.IP6RouterAdvertisement_Prefix)
+	for _, prefix := range newRa.Prefixes {
+		newPrefixes[ip6RaPrefixNetwork(prefix)] = prefix
+	}
+	for _, prefix := range oldRa.Prefixes {
+		if _, keep := newPrefixes[ip6RaPrefixNetwork(prefix)]; keep {
+			continue
+		}
+		if err = d.handler.DelIP6RaPrefix(ctx, ifMeta.SwIfIndex, prefix); err != nil {
+			err = errors.Errorf("failed to remove RA prefix %s from interface %s: %v",
+				prefix.Address, newRa.Interface, err)
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+	// adding an already advertised prefix updates its parameters
+	for _, prefix := range newRa.Prefixes {
+		if err = d.handler.AddIP6RaPrefix(ctx, ifMeta.SwIfIndex, prefix); err != nil {
+			err = errors.Errorf("failed to add RA prefix %s for interface %s: %v",
+				prefix.Address, newRa.Interface, err)
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// Delete removes advertised prefixes and restores default router
+// advertisement parameters of the interface.
+func (d *IP6RaDescriptor) Delete(key string, ra *interfaces.IP6RouterAdvertisement, metadata interface{}) (err error) {
+	ifMeta, found := d.ifIndex.LookupByName(ra.Interface)
+	if !found {
+		err = errors.Errorf("failed to find interface %s", ra.Interface)
+		d.log.Error(err)
+		return err
+	}
+
+	ctx := context.Background()
+	for _, prefix := range ra.Prefixes {
+		if err = d.handler.DelIP6RaPrefix(ctx, ifMeta.SwIfIndex, prefix); err != nil {
+			err = errors.Errorf("failed to remove RA prefix %s from interface %s: %v",
+				prefix.Address, ra.Interface, err)
+			d.log.Error(err)
+			return err
+		}
+	}
+	if err = d.handler.ResetIP6RaConfig(ctx, ifMeta.SwIfIndex); err != nil {
+		err = errors.Errorf("failed to reset router advertisements for interface %s: %v", ra.Interface, err)
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns router advertisement configurations of existing interfaces.
+// VPP does not provide binary API to dump RA parameters, therefore
+// the expected configuration is assumed to be applied for every interface
+// which still exists in VPP.
+func (d *IP6RaDescriptor) Retrieve(correlate []adapter.IP6RAKVWithMetadata) (
+	retrieved []adapter.IP6RAKVWithMetadata, err error,
+) {
+	for _, kv := range correlate {
+		if _, found := d.ifIndex.LookupByName(kv.Value.Interface); !found {
+			continue
+		}
+		retrieved = append(retrieved, adapter.IP6RAKVWithMetadata{
+			Key:    kv.Key,
+			Value:  kv.Value,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface and its IPv6 address as dependencies.
+func (d *IP6RaDescriptor) Dependencies(key string, ra *interfaces.IP6RouterAdvertisement) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: interfaceDep,
+			Key:   interfaces.InterfaceKey(ra.Interface),
+		},
+		interfaceHasIPv6Dependency(ra.Interface),
+	}
+}
+
+// interfaceHasIPv6Dependency returns dependency satisfied once at least one
+// IPv6 address is assigned to the interface (i.e. IPv6 is enabled on it).
+func interfaceHasIPv6Dependency(ifaceName string) kvs.Dependency {
+	return kvs.Dependency{
+		Label: interfaceHasIPv6Dep,
+		AnyOf: kvs.AnyOfDependency{
+			KeyPrefixes: []string{interfaces.InterfaceAddressPrefix(ifaceName)},
+			KeySelector: func(key string) bool {
+				_, ifaceAddr, source, _, _ := interfaces.ParseInterfaceAddressKey(key)
+				if source != netalloc_api.IPAddressSource_ALLOC_REF {
+					ip, _, err := net.ParseCIDR(ifaceAddr)
+					return err == nil && ip.To4() == nil
+				}
+				// address family of an allocated address is not known from the key
+				return false
+			},
+		},
+	}
+}
+
+// ip6RaPrefixNetwork returns advertised prefix in the canonical form,
+// or empty string if the prefix is not a valid IPv6 network.
+func ip6RaPrefixNetwork(prefix *interfaces.IP6RouterAdvertisement_Prefix) string {
+	ip, network, err := net.ParseCIDR(prefix.GetAddress())
+	if err != nil || ip.To4() != nil {
+		return ""
+	}
+	return network.String()
+}
+
+// ip6RaParamsReverted returns true if any of the RA parameters was set
+// in the old configuration but is left to default in the new one.
+func ip6RaParamsReverted(oldRa, newRa *interfaces.IP6RouterAdvertisement) bool {
+	reverted := func(oldVal, newVal uint32) bool {
+		return oldVal != 0 && newVal == 0
+	}
+	return reverted(oldRa.GetMaxInterval(), newRa.GetMaxInterval()) ||
+		reverted(oldRa.GetMinInterval(), newRa.GetMinInterval()) ||
+		reverted(oldRa.GetLifetime(), newRa.GetLifetime()) ||
+		reverted(oldRa.GetInitialCount(), newRa.GetInitialCount()) ||
+		reverted(oldRa.GetInitialInterval(), newRa.GetInitialInterval())
+}
+
+// normalizeIP6Ra returns a copy of the RA configuration with prefixes
+// in the canonical form and order.
+func normalizeIP6Ra(ra *interfaces.IP6RouterAdvertisement) *interfaces.IP6RouterAdvertisement {
+	normalized := proto.Clone(ra).(*interfaces.IP6RouterAdvertisement)
+	for _, prefix := range normalized.Prefixes {
+		if network := ip6RaPrefixNetwork(prefix); network != "" {
+			prefix.Address = network
+		}
+	}
+	sort.Slice(normalized.Prefixes, func(i, j int) bool {
+		return normalized.Prefixes[i].Address < normalized.Prefixes[j].Address
+	})
+	return normalized
+}
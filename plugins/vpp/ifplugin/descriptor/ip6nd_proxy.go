@@ -0,0 +1,173 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+	"net"
+
+	"github.com/go-errors/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+const (
+	// IP6ndProxyDescriptorName is the name of the descriptor.
+	IP6ndProxyDescriptorName = "vpp-ip6nd-proxy"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrIP6ndProxyWithoutInterface is returned when ND proxy entry is not
+	// associated with any interface.
+	ErrIP6ndProxyWithoutInterface = errors.New("IPv6 ND proxy defined without interface")
+
+	// ErrIP6ndProxyInvalidAddress is returned when proxied address is not a valid IPv6 address.
+	ErrIP6ndProxyInvalidAddress = errors.New("invalid IPv6 ND proxy address")
+)
+
+// IP6ndProxyDescriptor teaches KVScheduler how to configure IPv6 ND proxy
+// entries in VPP.
+type IP6ndProxyDescriptor struct {
+	log     logging.Logger
+	handler vppcalls.InterfaceVppAPI
+	ifIndex ifaceidx.IfaceMetadataIndex
+}
+
+// NewIP6ndProxyDescriptor creates a new instance of the IP6ndProxyDescriptor.
+func NewIP6ndProxyDescriptor(handler vppcalls.InterfaceVppAPI, ifIndex ifaceidx.IfaceMetadataIndex,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &IP6ndProxyDescriptor{
+		handler: handler,
+		ifIndex: ifIndex,
+		log:     log.NewLogger("ip6nd-proxy-descriptor"),
+	}
+
+	typedDescr := &adapter.IP6NDProxyDescriptor{
+		Name:                 IP6ndProxyDescriptorName,
+		NBKeyPrefix:          interfaces.ModelIP6NDProxy.KeyPrefix(),
+		ValueTypeName:        interfaces.ModelIP6NDProxy.ProtoName(),
+		KeySelector:          interfaces.ModelIP6NDProxy.IsKeyValid,
+		KeyLabel:             interfaces.ModelIP6NDProxy.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentIP6ndProxy,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{InterfaceDescriptorName},
+	}
+	return adapter.NewIP6NDProxyDescriptor(typedDescr)
+}
+
+// EquivalentIP6ndProxy compares ND proxy entries, ignoring the formatting
+// of the proxied address.
+func (d *IP6ndProxyDescriptor) EquivalentIP6ndProxy(key string, oldProxy, newProxy *interfaces.IP6NDProxy) bool {
+	return oldProxy.Interface == newProxy.Interface &&
+		net.ParseIP(oldProxy.Address).Equal(net.ParseIP(newProxy.Address))
+}
+
+// Validate validates ND proxy entry.
+func (d *IP6ndProxyDescriptor) Validate(key string, proxy *interfaces.IP6NDProxy) error {
+	if proxy.GetInterface() == "" {
+		return kvs.NewInvalidValueError(ErrIP6ndProxyWithoutInterface, "interface")
+	}
+	if ip := net.ParseIP(proxy.GetAddress()); ip == nil || ip.To4() != nil {
+		return kvs.NewInvalidValueError(ErrIP6ndProxyInvalidAddress, "address")
+	}
+	return nil
+}
+
+// Create adds ND proxy entry.
+func (d *IP6ndProxyDescriptor) Create(key string, proxy *interfaces.IP6NDProxy) (metadata interface{}, err error) {
+	ifMeta, found := d.ifIndex.LookupByName(proxy.Interface)
+	if !found {
+		err = errors.Errorf("failed to find interface %s", proxy.Interface)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	if err = d.handler.AddIP6ndProxy(context.Background(), ifMeta.SwIfIndex, proxy.Address); err != nil {
+		err = errors.Errorf("failed to add IPv6 ND proxy for %s on interface %s: %v",
+			proxy.Address, proxy.Interface, err)
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes ND proxy entry.
+func (d *IP6ndProxyDescriptor) Delete(key string, proxy *interfaces.IP6NDProxy, metadata interface{}) (err error) {
+	ifMeta, found := d.ifIndex.LookupByName(proxy.Interface)
+	if !found {
+		err = errors.Errorf("failed to find interface %s", proxy.Interface)
+		d.log.Error(err)
+		return err
+	}
+
+	if err = d.handler.DelIP6ndProxy(context.Background(), ifMeta.SwIfIndex, proxy.Address); err != nil {
+		err = errors.Errorf("failed to delete IPv6 ND proxy for %s on interface %s: %v",
+			proxy.Address, proxy.Interface, err)
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Retrieve returns all ND proxy entries configured in VPP.
+func (d *IP6ndProxyDescriptor) Retrieve(correlate []adapter.IP6NDProxyKVWithMetadata) (
+	retrieved []adapter.IP6NDProxyKVWithMetadata, err error,
+) {
+	proxies, err := d.handler.DumpIP6ndProxies(context.Background())
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+
+	for _, p := range proxies {
+		ifName, _, exists := d.ifIndex.LookupBySwIfIndex(p.SwIfIndex)
+		if !exists {
+			d.log.Debugf("failed to find interface with index %d", p.SwIfIndex)
+			continue
+		}
+		proxy := &interfaces.IP6NDProxy{
+			Interface: ifName,
+			Address:   p.Address,
+		}
+		retrieved = append(retrieved, adapter.IP6NDProxyKVWithMetadata{
+			Key:    interfaces.IP6NDProxyKey(proxy.Interface, proxy.Address),
+			Value:  proxy,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface and its IPv6 address as dependencies.
+func (d *IP6ndProxyDescriptor) Dependencies(key string, proxy *interfaces.IP6NDProxy) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: interfaceDep,
+			Key:   interfaces.InterfaceKey(proxy.Interface),
+		},
+		interfaceHasIPv6Dependency(proxy.Interface),
+	}
+}
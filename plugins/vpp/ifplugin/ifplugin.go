@@ -19,6 +19,8 @@
 //go:generate descriptor-adapter --descriptor-name BondedInterface  --value-type *vpp_interfaces.BondLink_BondedInterface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name Span  --value-type *vpp_interfaces.Span --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name IP6ND --value-type *vpp_interfaces.Interface_IP6ND --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name IP6RA --value-type *vpp_interfaces.IP6RouterAdvertisement --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name IP6NDProxy --value-type *vpp_interfaces.IP6NDProxy --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces" --output-dir "descriptor"
 
 package ifplugin
 
@@ -183,6 +185,8 @@ func (p *IfPlugin) Init() (err error) {
 	spanDescriptor, spanDescriptorCtx := descriptor.NewSpanDescriptor(p.ifHandler, p.Log)
 	spanDescriptorCtx.SetInterfaceIndex(p.intfIndex)
 	ip6ndDescriptor := descriptor.NewIP6ndDescriptor(p.KVScheduler, p.ifHandler, p.intfIndex, p.Log)
+	ip6RaDescriptor := descriptor.NewIP6RaDescriptor(p.ifHandler, p.intfIndex, p.Log)
+	ip6ndProxyDescriptor := descriptor.NewIP6ndProxyDescriptor(p.ifHandler, p.intfIndex, p.Log)
 
 	err = p.KVScheduler.RegisterKVDescriptor(
 		dhcpDescriptor,
@@ -196,6 +200,8 @@ func (p *IfPlugin) Init() (err error) {
 		withAddrDescriptor,
 		spanDescriptor,
 		ip6ndDescriptor,
+		ip6RaDescriptor,
+		ip6ndProxyDescriptor,
 	)
 	if err != nil {
 		return err
@@ -118,6 +118,12 @@ type InterfaceState struct {
 	LinkMTU    uint16
 }
 
+// IP6ndProxyDetails is a helper struct grouping IPv6 ND proxy entry data.
+type IP6ndProxyDetails struct {
+	SwIfIndex uint32
+	Address   string
+}
+
 // InterfaceSpanDetails is a helper struct grouping SPAN data.
 type InterfaceSpanDetails struct {
 	SwIfIndexFrom uint32
@@ -160,6 +166,11 @@ type InterfaceVppAPI interface {
 	// DeleteVxLanGpeTunnel removes VxLAN-GPE tunnel.
 	DeleteVxLanGpeTunnel(ifName string, vxLan *interfaces.VxlanLink) error
 
+	// AddGeneveTunnel creates GENEVE tunnel.
+	AddGeneveTunnel(ifName string, multicastIf uint32, geneve *interfaces.GeneveLink) (uint32, error)
+	// DelGeneveTunnel removes GENEVE tunnel.
+	DelGeneveTunnel(ifName string, geneve *interfaces.GeneveLink) error
+
 	// AddIPSecTunnelInterface adds a new IPSec tunnel interface
 	AddIPSecTunnelInterface(ctx context.Context, ifName string, ipSecLink *interfaces.IPSecLink) (uint32, error)
 	// DeleteIPSecTunnelInterface removes existing IPSec tunnel interface
@@ -195,6 +206,17 @@ type InterfaceVppAPI interface {
 	// DeleteWireguardTunnel removes wireguard tunnel interface.
 	DeleteWireguardTunnel(ifName string, ifIdx uint32) error
 
+	// AddMplsTunnel adds new MPLS tunnel interface. Indexes of outgoing interfaces
+	// are given for every tunnel path (^uint32(0) for path without outgoing interface).
+	AddMplsTunnel(ifName string, mplsLink *interfaces.MplsTunnelLink, outIfIdxs []uint32) (uint32, error)
+	// DelMplsTunnel removes MPLS tunnel interface (together with all its paths).
+	DelMplsTunnel(ifName string, ifIdx uint32, mplsLink *interfaces.MplsTunnelLink, outIfIdxs []uint32) error
+
+	// AddVhostUserInterface adds new vhost-user interface.
+	AddVhostUserInterface(ctx context.Context, ifName string, vhostUserLink *interfaces.VhostUserLink) (swIdx uint32, err error)
+	// DeleteVhostUserInterface removes vhost-user interface.
+	DeleteVhostUserInterface(ctx context.Context, ifName string, ifIdx uint32) error
+
 	// CreateSubif creates sub interface.
 	CreateSubif(ifIdx, vlanID uint32) (swIfIdx uint32, err error)
 	// DeleteSubif deletes sub interface.
@@ -286,6 +308,8 @@ type InterfaceVppRead interface {
 	DumpInterfaceStates(ifIdxs ...uint32) (map[uint32]*InterfaceState, error)
 	// DumpSpan returns all records from span table.
 	DumpSpan() ([]*InterfaceSpanDetails, error)
+	// DumpIP6ndProxies returns all IPv6 ND proxy entries configured in VPP.
+	DumpIP6ndProxies(ctx context.Context) ([]*IP6ndProxyDetails, error)
 	// GetInterfaceVrf reads VRF table to interface
 	GetInterfaceVrf(ifIdx uint32) (vrfID uint32, err error)
 	// GetInterfaceVrfIPv6 reads IPv6 VRF table to interface
@@ -301,6 +325,18 @@ type InterfaceVppRead interface {
 // IP6ndVppAPI provides methods for managing IPv6 ND configuration.
 type IP6ndVppAPI interface {
 	SetIP6ndAutoconfig(ctx context.Context, ifIdx uint32, enable, installDefaultRoutes bool) error
+	// SetIP6RaConfig configures router advertisement parameters of the interface.
+	SetIP6RaConfig(ctx context.Context, ifIdx uint32, ra *interfaces.IP6RouterAdvertisement) error
+	// ResetIP6RaConfig restores router advertisement parameters of the interface to defaults.
+	ResetIP6RaConfig(ctx context.Context, ifIdx uint32) error
+	// AddIP6RaPrefix adds (or updates) prefix advertised by the interface.
+	AddIP6RaPrefix(ctx context.Context, ifIdx uint32, prefix *interfaces.IP6RouterAdvertisement_Prefix) error
+	// DelIP6RaPrefix removes prefix advertised by the interface.
+	DelIP6RaPrefix(ctx context.Context, ifIdx uint32, prefix *interfaces.IP6RouterAdvertisement_Prefix) error
+	// AddIP6ndProxy adds IPv6 ND proxy entry for the address on the interface.
+	AddIP6ndProxy(ctx context.Context, ifIdx uint32, address string) error
+	// DelIP6ndProxy removes IPv6 ND proxy entry for the address on the interface.
+	DelIP6ndProxy(ctx context.Context, ifIdx uint32, address string) error
 }
 
 var Handler = vpp.RegisterHandler(vpp.HandlerDesc{
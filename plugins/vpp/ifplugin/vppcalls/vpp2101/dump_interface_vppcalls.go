@@ -293,6 +293,11 @@ func (h *InterfaceVppHandler) DumpInterfaces(ctx context.Context) (map[uint32]*v
 		return nil, err
 	}
 
+	err = h.dumpGeneveDetails(interfaces)
+	if err != nil {
+		return nil, err
+	}
+
 	err = h.dumpIpipDetails(interfaces)
 	if err != nil {
 		return nil, err
@@ -303,6 +308,16 @@ func (h *InterfaceVppHandler) DumpInterfaces(ctx context.Context) (map[uint32]*v
 		return nil, err
 	}
 
+	err = h.dumpMplsTunnelDetails(interfaces)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.dumpVhostUserDetails(interfaces)
+	if err != nil {
+		return nil, err
+	}
+
 	// Rx-placement dump is last since it uses interface type-specific data
 	err = h.dumpRxPlacement(interfaces)
 	if err != nil {
@@ -969,12 +984,21 @@ func guessInterfaceType(ifDevType, ifName string) ifs.Interface_Type {
 	case strings.HasPrefix(ifName, "gtpu"):
 		return ifs.Interface_GTPU_TUNNEL
 
+	case strings.HasPrefix(ifName, "geneve"):
+		return ifs.Interface_GENEVE_TUNNEL
+
 	case strings.HasPrefix(ifName, "ipip"):
 		return ifs.Interface_IPIP_TUNNEL
 
 	case strings.HasPrefix(ifName, "wireguard"):
 		return ifs.Interface_WIREGUARD_TUNNEL
 
+	case strings.HasPrefix(ifName, "mpls-tunnel"):
+		return ifs.Interface_MPLS_TUNNEL
+
+	case strings.HasPrefix(ifName, "VirtualEthernet"):
+		return ifs.Interface_VHOST_USER
+
 	default:
 		return ifs.Interface_DPDK
 	}
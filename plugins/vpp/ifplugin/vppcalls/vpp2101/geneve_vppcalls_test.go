@@ -0,0 +1,142 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2101_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_geneve "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/geneve"
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip_types"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestAddGeneveTunnel(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_geneve.GeneveAddDelTunnel2Reply{
+		SwIfIndex: 1,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	swIfIdx, err := ifHandler.AddGeneveTunnel("ifName", 2, &ifs.GeneveLink{
+		SrcAddress: "10.0.0.1",
+		DstAddress: "20.0.0.1",
+		Vni:        100,
+		EncapVrfId: 10,
+		L3Mode:     true,
+	})
+	Expect(err).To(BeNil())
+	Expect(swIfIdx).To(BeEquivalentTo(1))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_geneve.GeneveAddDelTunnel2)
+		if ok {
+			Expect(vppMsg.LocalAddress).To(Equal(ip_types.Address{
+				Af: ip_types.ADDRESS_IP4,
+				Un: ip_types.AddressUnionIP4(ip_types.IP4Address{10, 0, 0, 1}),
+			}))
+			Expect(vppMsg.RemoteAddress).To(Equal(ip_types.Address{
+				Af: ip_types.ADDRESS_IP4,
+				Un: ip_types.AddressUnionIP4(ip_types.IP4Address{20, 0, 0, 1}),
+			}))
+			Expect(vppMsg.IsAdd).To(BeTrue())
+			Expect(vppMsg.Vni).To(BeEquivalentTo(100))
+			Expect(vppMsg.EncapVrfID).To(BeEquivalentTo(10))
+			Expect(vppMsg.McastSwIfIndex).To(BeEquivalentTo(2))
+			Expect(vppMsg.DecapNextIndex).To(BeEquivalentTo(^uint32(0)))
+			Expect(vppMsg.L3Mode).To(BeTrue())
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddGeneveTunnelIPv6(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_geneve.GeneveAddDelTunnel2Reply{
+		SwIfIndex: 1,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	swIfIdx, err := ifHandler.AddGeneveTunnel("ifName", ^uint32(0), &ifs.GeneveLink{
+		SrcAddress: "2001:db8::1",
+		DstAddress: "2001:db8::2",
+		Vni:        100,
+	})
+	Expect(err).To(BeNil())
+	Expect(swIfIdx).To(BeEquivalentTo(1))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_geneve.GeneveAddDelTunnel2)
+		if ok {
+			Expect(vppMsg.LocalAddress.Af).To(Equal(ip_types.ADDRESS_IP6))
+			Expect(vppMsg.RemoteAddress.Af).To(Equal(ip_types.ADDRESS_IP6))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddGeneveTunnelError(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// mixed IPv4 and IPv6 addresses
+	_, err := ifHandler.AddGeneveTunnel("ifName", ^uint32(0), &ifs.GeneveLink{
+		SrcAddress: "10.0.0.1",
+		DstAddress: "2001:db8::2",
+	})
+	Expect(err).ToNot(BeNil())
+
+	// invalid source address
+	_, err = ifHandler.AddGeneveTunnel("ifName", ^uint32(0), &ifs.GeneveLink{
+		SrcAddress: "10.0.0",
+		DstAddress: "20.0.0.1",
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDelGeneveTunnel(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_geneve.GeneveAddDelTunnel2Reply{
+		SwIfIndex: 1,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	err := ifHandler.DelGeneveTunnel("ifName", &ifs.GeneveLink{
+		SrcAddress: "10.0.0.1",
+		DstAddress: "20.0.0.1",
+		Vni:        100,
+	})
+	Expect(err).To(BeNil())
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_geneve.GeneveAddDelTunnel2)
+		if ok {
+			Expect(vppMsg.IsAdd).To(BeFalse())
+			Expect(vppMsg.Vni).To(BeEquivalentTo(100))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
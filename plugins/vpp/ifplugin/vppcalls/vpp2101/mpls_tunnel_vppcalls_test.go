@@ -0,0 +1,111 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2101_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/fib_types"
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip_types"
+	vpp_mpls "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/mpls"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+func TestAddMplsTunnel(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsTunnelAddDelReply{
+		SwIfIndex: 2,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	swIfIdx, err := ifHandler.AddMplsTunnel("mplstun1", &ifs.MplsTunnelLink{
+		L2Only: true,
+		Paths: []*l3.Route_Path{
+			{
+				NextHopAddr:       "10.0.0.1",
+				OutgoingInterface: "if1",
+				LabelStack:        []uint32{100, 200},
+			},
+		},
+	}, []uint32{1})
+	Expect(err).To(BeNil())
+	Expect(swIfIdx).To(BeEquivalentTo(2))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_mpls.MplsTunnelAddDel)
+		if ok {
+			Expect(vppMsg.MtIsAdd).To(BeTrue())
+			Expect(vppMsg.MtTunnel.MtSwIfIndex).To(BeEquivalentTo(^uint32(0)))
+			Expect(vppMsg.MtTunnel.MtL2Only).To(BeTrue())
+			Expect(vppMsg.MtTunnel.MtNPaths).To(BeEquivalentTo(1))
+			path := vppMsg.MtTunnel.MtPaths[0]
+			Expect(path.SwIfIndex).To(BeEquivalentTo(1))
+			Expect(path.Proto).To(Equal(fib_types.FIB_API_PATH_NH_PROTO_IP4))
+			Expect(path.Nh.Address.GetIP4()).To(Equal(ip_types.IP4Address{10, 0, 0, 1}))
+			Expect(path.NLabels).To(BeEquivalentTo(2))
+			Expect(path.LabelStack[0].Label).To(BeEquivalentTo(100))
+			Expect(path.LabelStack[1].Label).To(BeEquivalentTo(200))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddMplsTunnelError(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// missing outgoing interface index for path
+	_, err := ifHandler.AddMplsTunnel("mplstun1", &ifs.MplsTunnelLink{
+		Paths: []*l3.Route_Path{{NextHopAddr: "10.0.0.1"}},
+	}, nil)
+	Expect(err).ToNot(BeNil())
+
+	// invalid next hop
+	_, err = ifHandler.AddMplsTunnel("mplstun1", &ifs.MplsTunnelLink{
+		Paths: []*l3.Route_Path{{NextHopAddr: "not-an-ip"}},
+	}, []uint32{^uint32(0)})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDelMplsTunnel(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsTunnelAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	err := ifHandler.DelMplsTunnel("mplstun1", 2, &ifs.MplsTunnelLink{
+		Paths: []*l3.Route_Path{{OutgoingInterface: "if1", LabelStack: []uint32{100}}},
+	}, []uint32{1})
+	Expect(err).To(BeNil())
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_mpls.MplsTunnelAddDel)
+		if ok {
+			Expect(vppMsg.MtIsAdd).To(BeFalse())
+			Expect(vppMsg.MtTunnel.MtSwIfIndex).To(BeEquivalentTo(2))
+			Expect(vppMsg.MtTunnel.MtPaths).To(HaveLen(1))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
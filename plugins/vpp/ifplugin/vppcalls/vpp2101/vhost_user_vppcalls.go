@@ -0,0 +1,106 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2101
+
+import (
+	"context"
+	"fmt"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface_types"
+	vpp_vhost "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vhost_user"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/virtio_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// virtio features which are not defined in the binary API
+const (
+	virtioRingFeatureEventIdx   virtio_types.VirtioNetFeaturesFirst32 = 1 << 29
+	virtioFeatureRingPackedLast virtio_types.VirtioNetFeaturesLast32  = 1 << (34 - 32)
+)
+
+// AddVhostUserInterface adds new vhost-user interface.
+func (h *InterfaceVppHandler) AddVhostUserInterface(ctx context.Context, ifName string, vhostUserLink *ifs.VhostUserLink) (swIdx uint32, err error) {
+	req := &vpp_vhost.CreateVhostUserIfV2{
+		IsServer:            vhostUserLink.GetIsServer(),
+		SockFilename:        vhostUserLink.GetSocketFilename(),
+		DisableMrgRxbuf:     vhostUserLink.GetDisableMrgRxbuf(),
+		DisableIndirectDesc: vhostUserLink.GetDisableIndirectDesc(),
+		EnableGso:           vhostUserLink.GetEnableGso(),
+		EnablePacked:        vhostUserLink.GetEnablePacked(),
+		EnableEventIdx:      vhostUserLink.GetEnableEventIdx(),
+	}
+	reply := &vpp_vhost.CreateVhostUserIfV2Reply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	swIdx = uint32(reply.SwIfIndex)
+
+	return swIdx, h.SetInterfaceTag(ifName, swIdx)
+}
+
+// DeleteVhostUserInterface removes vhost-user interface.
+func (h *InterfaceVppHandler) DeleteVhostUserInterface(ctx context.Context, ifName string, ifIdx uint32) error {
+	req := &vpp_vhost.DeleteVhostUserIf{
+		SwIfIndex: interface_types.InterfaceIndex(ifIdx),
+	}
+	reply := &vpp_vhost.DeleteVhostUserIfReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return h.RemoveInterfaceTag(ifName, ifIdx)
+}
+
+// dumpVhostUserDetails dumps vhost-user interface details from VPP and fills them into the provided interface map.
+func (h *InterfaceVppHandler) dumpVhostUserDetails(interfaces map[uint32]*vppcalls.InterfaceDetails) error {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_vhost.SwInterfaceVhostUserDump{
+		SwIfIndex: ^interface_types.InterfaceIndex(0),
+	})
+	for {
+		vhostDetails := &vpp_vhost.SwInterfaceVhostUserDetails{}
+		stop, err := reqCtx.ReceiveReply(vhostDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to dump vhost-user interface details: %v", err)
+		}
+		_, ifIdxExists := interfaces[uint32(vhostDetails.SwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+
+		vhostUserLink := &ifs.VhostUserLink{
+			SocketFilename: cleanString(vhostDetails.SockFilename),
+			IsServer:       vhostDetails.IsServer,
+		}
+		// features are known only after they were negotiated with the guest
+		first32, last32 := vhostDetails.FeaturesFirst32, vhostDetails.FeaturesLast32
+		if first32 != 0 || last32 != 0 {
+			vhostUserLink.EnableGso = first32&virtio_types.VIRTIO_NET_F_API_GUEST_TSO4 != 0
+			vhostUserLink.EnablePacked = last32&virtioFeatureRingPackedLast != 0
+			vhostUserLink.EnableEventIdx = first32&virtioRingFeatureEventIdx != 0
+			vhostUserLink.DisableMrgRxbuf = first32&virtio_types.VIRTIO_NET_F_API_MRG_RXBUF == 0
+			vhostUserLink.DisableIndirectDesc = first32&virtio_types.VIRTIO_F_API_INDIRECT_DESC == 0
+		}
+
+		interfaces[uint32(vhostDetails.SwIfIndex)].Interface.Link = &ifs.Interface_VhostUser{VhostUser: vhostUserLink}
+		interfaces[uint32(vhostDetails.SwIfIndex)].Interface.Type = ifs.Interface_VHOST_USER
+	}
+	return nil
+}
@@ -16,9 +16,25 @@ package vpp2106
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net"
 
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip6_nd"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip_types"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/rd_cp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// Default router advertisement parameters used by VPP.
+const (
+	defaultRaMaxInterval     = 200
+	defaultRaMinInterval     = 150
+	defaultRaLifetime        = 600
+	defaultRaInitialCount    = 3
+	defaultRaInitialInterval = 16
 )
 
 func (h *InterfaceVppHandler) SetIP6ndAutoconfig(ctx context.Context, ifIdx uint32, enable, installDefaultRoutes bool) error {
@@ -32,3 +48,135 @@ func (h *InterfaceVppHandler) SetIP6ndAutoconfig(ctx context.Context, ifIdx uint
 	}
 	return nil
 }
+
+// SetIP6RaConfig configures router advertisement parameters of the interface.
+// Flags are only ever set or cleared by VPP when marked in the request,
+// therefore flags disabled in the configuration are cleared explicitly first.
+func (h *InterfaceVppHandler) SetIP6RaConfig(ctx context.Context, ifIdx uint32, ra *ifs.IP6RouterAdvertisement) error {
+	clear := &ip6_nd.SwInterfaceIP6ndRaConfig{
+		SwIfIndex:   interface_types.InterfaceIndex(ifIdx),
+		Suppress:    boolToUint(!ra.GetSuppress()),
+		Managed:     boolToUint(!ra.GetManaged()),
+		Other:       boolToUint(!ra.GetOther()),
+		LlOption:    boolToUint(!ra.GetLlOption()),
+		SendUnicast: boolToUint(!ra.GetSendUnicast()),
+		Cease:       boolToUint(!ra.GetCease()),
+		IsNo:        true,
+	}
+	if _, err := h.rpcIP6nd.SwInterfaceIP6ndRaConfig(ctx, clear); err != nil {
+		return err
+	}
+	_, err := h.rpcIP6nd.SwInterfaceIP6ndRaConfig(ctx, &ip6_nd.SwInterfaceIP6ndRaConfig{
+		SwIfIndex:       interface_types.InterfaceIndex(ifIdx),
+		Suppress:        boolToUint(ra.GetSuppress()),
+		Managed:         boolToUint(ra.GetManaged()),
+		Other:           boolToUint(ra.GetOther()),
+		LlOption:        boolToUint(ra.GetLlOption()),
+		SendUnicast:     boolToUint(ra.GetSendUnicast()),
+		Cease:           boolToUint(ra.GetCease()),
+		MaxInterval:     ra.GetMaxInterval(),
+		MinInterval:     ra.GetMinInterval(),
+		Lifetime:        ra.GetLifetime(),
+		InitialCount:    ra.GetInitialCount(),
+		InitialInterval: ra.GetInitialInterval(),
+	})
+	return err
+}
+
+// ResetIP6RaConfig restores router advertisement parameters of the interface to defaults.
+func (h *InterfaceVppHandler) ResetIP6RaConfig(ctx context.Context, ifIdx uint32) error {
+	_, err := h.rpcIP6nd.SwInterfaceIP6ndRaConfig(ctx, &ip6_nd.SwInterfaceIP6ndRaConfig{
+		SwIfIndex:       interface_types.InterfaceIndex(ifIdx),
+		Suppress:        1,
+		Managed:         1,
+		Other:           1,
+		LlOption:        1,
+		SendUnicast:     1,
+		Cease:           1,
+		IsNo:            true,
+		MaxInterval:     defaultRaMaxInterval,
+		MinInterval:     defaultRaMinInterval,
+		Lifetime:        defaultRaLifetime,
+		InitialCount:    defaultRaInitialCount,
+		InitialInterval: defaultRaInitialInterval,
+	})
+	return err
+}
+
+// AddIP6RaPrefix adds (or updates) prefix advertised by the interface.
+func (h *InterfaceVppHandler) AddIP6RaPrefix(ctx context.Context, ifIdx uint32, prefix *ifs.IP6RouterAdvertisement_Prefix) error {
+	return h.handleIP6RaPrefix(ctx, ifIdx, prefix, false)
+}
+
+// DelIP6RaPrefix removes prefix advertised by the interface.
+func (h *InterfaceVppHandler) DelIP6RaPrefix(ctx context.Context, ifIdx uint32, prefix *ifs.IP6RouterAdvertisement_Prefix) error {
+	return h.handleIP6RaPrefix(ctx, ifIdx, prefix, true)
+}
+
+func (h *InterfaceVppHandler) handleIP6RaPrefix(ctx context.Context, ifIdx uint32, prefix *ifs.IP6RouterAdvertisement_Prefix, isNo bool) error {
+	p, err := ip_types.ParsePrefix(prefix.GetAddress())
+	if err != nil {
+		return err
+	}
+	_, err = h.rpcIP6nd.SwInterfaceIP6ndRaPrefix(ctx, &ip6_nd.SwInterfaceIP6ndRaPrefix{
+		SwIfIndex:    interface_types.InterfaceIndex(ifIdx),
+		Prefix:       p,
+		UseDefault:   prefix.GetValidLifetime() == 0 && prefix.GetPreferredLifetime() == 0,
+		NoAdvertise:  prefix.GetNoAdvertise(),
+		OffLink:      prefix.GetOffLink(),
+		NoAutoconfig: prefix.GetNoAutoconfig(),
+		IsNo:         isNo,
+		ValLifetime:  prefix.GetValidLifetime(),
+		PrefLifetime: prefix.GetPreferredLifetime(),
+	})
+	return err
+}
+
+// AddIP6ndProxy adds IPv6 ND proxy entry for the address on the interface.
+func (h *InterfaceVppHandler) AddIP6ndProxy(ctx context.Context, ifIdx uint32, address string) error {
+	return h.handleIP6ndProxy(ctx, ifIdx, address, true)
+}
+
+// DelIP6ndProxy removes IPv6 ND proxy entry for the address on the interface.
+func (h *InterfaceVppHandler) DelIP6ndProxy(ctx context.Context, ifIdx uint32, address string) error {
+	return h.handleIP6ndProxy(ctx, ifIdx, address, false)
+}
+
+func (h *InterfaceVppHandler) handleIP6ndProxy(ctx context.Context, ifIdx uint32, address string, isAdd bool) error {
+	if netIP := net.ParseIP(address); netIP == nil || netIP.To4() != nil {
+		return fmt.Errorf("invalid IPv6 address: %q", address)
+	}
+	ip, err := ip_types.ParseIP6Address(address)
+	if err != nil {
+		return err
+	}
+	_, err = h.rpcIP6nd.IP6ndProxyAddDel(ctx, &ip6_nd.IP6ndProxyAddDel{
+		SwIfIndex: interface_types.InterfaceIndex(ifIdx),
+		IsAdd:     isAdd,
+		IP:        ip,
+	})
+	return err
+}
+
+// DumpIP6ndProxies returns all IPv6 ND proxy entries configured in VPP.
+func (h *InterfaceVppHandler) DumpIP6ndProxies(ctx context.Context) ([]*vppcalls.IP6ndProxyDetails, error) {
+	dump, err := h.rpcIP6nd.IP6ndProxyDump(ctx, &ip6_nd.IP6ndProxyDump{})
+	if err != nil {
+		return nil, err
+	}
+	var proxies []*vppcalls.IP6ndProxyDetails
+	for {
+		details, err := dump.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		proxies = append(proxies, &vppcalls.IP6ndProxyDetails{
+			SwIfIndex: uint32(details.SwIfIndex),
+			Address:   details.IP.String(),
+		})
+	}
+	return proxies, nil
+}
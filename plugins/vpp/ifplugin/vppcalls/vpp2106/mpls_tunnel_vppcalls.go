@@ -0,0 +1,182 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2106
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/fib_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/mpls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+// mplsLabelUnset equals to MPLS_LABEL_INVALID defined in VPP.
+const mplsLabelUnset uint32 = 0xfffff + 1
+
+// AddMplsTunnel adds new MPLS tunnel interface.
+func (h *InterfaceVppHandler) AddMplsTunnel(ifName string, mplsLink *interfaces.MplsTunnelLink, outIfIdxs []uint32) (uint32, error) {
+	tunnel, err := mplsTunnelToVpp(mplsLink, outIfIdxs)
+	if err != nil {
+		return 0, err
+	}
+	tunnel.MtSwIfIndex = ^interface_types.InterfaceIndex(0)
+
+	req := &mpls.MplsTunnelAddDel{
+		MtIsAdd:  true,
+		MtTunnel: tunnel,
+	}
+	reply := &mpls.MplsTunnelAddDelReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	swIfIndex := uint32(reply.SwIfIndex)
+	return swIfIndex, h.SetInterfaceTag(ifName, swIfIndex)
+}
+
+// DelMplsTunnel removes MPLS tunnel interface. VPP removes the tunnel
+// once the last of its paths is removed.
+func (h *InterfaceVppHandler) DelMplsTunnel(ifName string, ifIdx uint32, mplsLink *interfaces.MplsTunnelLink, outIfIdxs []uint32) error {
+	tunnel, err := mplsTunnelToVpp(mplsLink, outIfIdxs)
+	if err != nil {
+		return err
+	}
+	tunnel.MtSwIfIndex = interface_types.InterfaceIndex(ifIdx)
+
+	req := &mpls.MplsTunnelAddDel{
+		MtIsAdd:  false,
+		MtTunnel: tunnel,
+	}
+	reply := &mpls.MplsTunnelAddDelReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return h.RemoveInterfaceTag(ifName, ifIdx)
+}
+
+// dumpMplsTunnelDetails dumps MPLS tunnel interface details from VPP and fills them into the provided interface map.
+func (h *InterfaceVppHandler) dumpMplsTunnelDetails(ifc map[uint32]*vppcalls.InterfaceDetails) error {
+	reqCtx := h.callsChannel.SendMultiRequest(&mpls.MplsTunnelDump{
+		SwIfIndex: ^interface_types.InterfaceIndex(0),
+	})
+	for {
+		mplsDetails := &mpls.MplsTunnelDetails{}
+		stop, err := reqCtx.ReceiveReply(mplsDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to dump MPLS tunnel interface details: %v", err)
+		}
+		tunnel := mplsDetails.MtTunnel
+		_, ifIdxExists := ifc[uint32(tunnel.MtSwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+
+		mplsLink := &interfaces.MplsTunnelLink{
+			L2Only:    tunnel.MtL2Only,
+			Multicast: tunnel.MtIsMulticast,
+			Tag:       cleanString(tunnel.MtTag),
+		}
+		for _, fibPath := range tunnel.MtPaths {
+			path := &l3.Route_Path{
+				Weight:     uint32(fibPath.Weight),
+				Preference: uint32(fibPath.Preference),
+				ViaVrfId:   fibPath.TableID,
+			}
+			if outIf, exists := ifc[fibPath.SwIfIndex]; exists {
+				path.OutgoingInterface = outIf.Interface.Name
+			}
+			switch fibPath.Proto {
+			case fib_types.FIB_API_PATH_NH_PROTO_IP4:
+				ip4Addr := fibPath.Nh.Address.GetIP4()
+				path.NextHopAddr = net.IP(ip4Addr[:]).String()
+			case fib_types.FIB_API_PATH_NH_PROTO_IP6:
+				ip6Addr := fibPath.Nh.Address.GetIP6()
+				path.NextHopAddr = net.IP(ip6Addr[:]).String()
+			}
+			if net.ParseIP(path.NextHopAddr).IsUnspecified() {
+				path.NextHopAddr = ""
+			}
+			for _, label := range fibPath.LabelStack[:fibPath.NLabels] {
+				path.LabelStack = append(path.LabelStack, label.Label)
+			}
+			mplsLink.Paths = append(mplsLink.Paths, path)
+		}
+
+		ifc[uint32(tunnel.MtSwIfIndex)].Interface.Link = &interfaces.Interface_MplsTunnel{MplsTunnel: mplsLink}
+		ifc[uint32(tunnel.MtSwIfIndex)].Interface.Type = interfaces.Interface_MPLS_TUNNEL
+	}
+	return nil
+}
+
+// mplsTunnelToVpp converts MPLS tunnel link configuration into binary API type.
+func mplsTunnelToVpp(mplsLink *interfaces.MplsTunnelLink, outIfIdxs []uint32) (mpls.MplsTunnel, error) {
+	tunnel := mpls.MplsTunnel{
+		MtL2Only:      mplsLink.GetL2Only(),
+		MtIsMulticast: mplsLink.GetMulticast(),
+		MtTag:         mplsLink.GetTag(),
+	}
+	if len(outIfIdxs) != len(mplsLink.GetPaths()) {
+		return tunnel, errors.New("outgoing interface indexes do not match MPLS tunnel paths")
+	}
+	for i, path := range mplsLink.GetPaths() {
+		fibPath := fib_types.FibPath{
+			SwIfIndex:  outIfIdxs[i],
+			TableID:    path.ViaVrfId,
+			Weight:     uint8(path.Weight),
+			Preference: uint8(path.Preference),
+			Nh: fib_types.FibPathNh{
+				ViaLabel:           mplsLabelUnset,
+				ClassifyTableIndex: ^uint32(0),
+			},
+		}
+		if path.NextHopAddr != "" {
+			nextHop := net.ParseIP(path.NextHopAddr)
+			if nextHop == nil {
+				return tunnel, errors.Errorf("bad next hop address %q for MPLS tunnel path", path.NextHopAddr)
+			}
+			if nextHop.To4() == nil {
+				var ip6addr ip_types.IP6Address
+				copy(ip6addr[:], nextHop.To16())
+				fibPath.Nh.Address.SetIP6(ip6addr)
+				fibPath.Proto = fib_types.FIB_API_PATH_NH_PROTO_IP6
+			} else {
+				var ip4addr ip_types.IP4Address
+				copy(ip4addr[:], nextHop.To4())
+				fibPath.Nh.Address.SetIP4(ip4addr)
+				fibPath.Proto = fib_types.FIB_API_PATH_NH_PROTO_IP4
+			}
+		}
+		fibPath.NLabels = uint8(len(path.LabelStack))
+		for j, label := range path.LabelStack {
+			fibPath.LabelStack[j] = fib_types.FibMplsLabel{
+				Label: label,
+			}
+		}
+		tunnel.MtPaths = append(tunnel.MtPaths, fibPath)
+	}
+	tunnel.MtNPaths = uint8(len(tunnel.MtPaths))
+	return tunnel, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2106_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface"
+	vpp_vhost "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/vhost_user"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestAddVhostUserInterface(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_vhost.CreateVhostUserIfV2Reply{
+		SwIfIndex: 2,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	swIfIdx, err := ifHandler.AddVhostUserInterface(ctx.Context, "vhost1", &ifs.VhostUserLink{
+		SocketFilename:  "/run/vpp/vhost1.sock",
+		IsServer:        true,
+		EnableGso:       true,
+		EnablePacked:    true,
+		DisableMrgRxbuf: true,
+	})
+	Expect(err).To(BeNil())
+	Expect(swIfIdx).To(BeEquivalentTo(2))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_vhost.CreateVhostUserIfV2)
+		if ok {
+			Expect(vppMsg.SockFilename).To(Equal("/run/vpp/vhost1.sock"))
+			Expect(vppMsg.IsServer).To(BeTrue())
+			Expect(vppMsg.EnableGso).To(BeTrue())
+			Expect(vppMsg.EnablePacked).To(BeTrue())
+			Expect(vppMsg.EnableEventIdx).To(BeFalse())
+			Expect(vppMsg.DisableMrgRxbuf).To(BeTrue())
+			Expect(vppMsg.DisableIndirectDesc).To(BeFalse())
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddVhostUserInterfaceRetval(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_vhost.CreateVhostUserIfV2Reply{
+		Retval: 1,
+	})
+
+	_, err := ifHandler.AddVhostUserInterface(ctx.Context, "vhost1", &ifs.VhostUserLink{
+		SocketFilename: "/run/vpp/vhost1.sock",
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDeleteVhostUserInterface(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_vhost.DeleteVhostUserIfReply{})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	err := ifHandler.DeleteVhostUserInterface(ctx.Context, "vhost1", 2)
+	Expect(err).To(BeNil())
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_vhost.DeleteVhostUserIf)
+		if ok {
+			Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(2))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/geneve"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func (h *InterfaceVppHandler) geneveAddDelTunnel(isAdd bool, geneveLink *interfaces.GeneveLink, multicastIf uint32) (uint32, error) {
+	srcAddr, err := IPToAddress(geneveLink.GetSrcAddress())
+	if err != nil {
+		return 0, errors.Wrap(err, "bad source address for GENEVE tunnel")
+	}
+	dstAddr, err := IPToAddress(geneveLink.GetDstAddress())
+	if err != nil {
+		return 0, errors.Wrap(err, "bad destination address for GENEVE tunnel")
+	}
+	if srcAddr.Af != dstAddr.Af {
+		return 0, errors.New("source and destination addresses must be both either IPv4 or IPv6")
+	}
+
+	req := &geneve.GeneveAddDelTunnel2{
+		IsAdd:          isAdd,
+		LocalAddress:   srcAddr,
+		RemoteAddress:  dstAddr,
+		McastSwIfIndex: interface_types.InterfaceIndex(multicastIf),
+		EncapVrfID:     geneveLink.GetEncapVrfId(),
+		DecapNextIndex: defaultDecapNextIndex,
+		Vni:            geneveLink.GetVni(),
+		L3Mode:         geneveLink.GetL3Mode(),
+	}
+	reply := &geneve.GeneveAddDelTunnel2Reply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	return uint32(reply.SwIfIndex), nil
+}
+
+// AddGeneveTunnel creates GENEVE tunnel.
+func (h *InterfaceVppHandler) AddGeneveTunnel(ifName string, multicastIf uint32, geneveLink *interfaces.GeneveLink) (uint32, error) {
+	if h.geneve == nil {
+		return 0, errors.WithMessage(vpp.ErrPluginDisabled, "geneve")
+	}
+	if geneveLink == nil {
+		return 0, errors.New("missing GENEVE tunnel information")
+	}
+
+	swIfIndex, err := h.geneveAddDelTunnel(true, geneveLink, multicastIf)
+	if err != nil {
+		return 0, err
+	}
+	return swIfIndex, h.SetInterfaceTag(ifName, swIfIndex)
+}
+
+// DelGeneveTunnel removes GENEVE tunnel.
+func (h *InterfaceVppHandler) DelGeneveTunnel(ifName string, geneveLink *interfaces.GeneveLink) error {
+	if h.geneve == nil {
+		return errors.WithMessage(vpp.ErrPluginDisabled, "geneve")
+	}
+	if geneveLink == nil {
+		return errors.New("missing GENEVE tunnel information")
+	}
+
+	swIfIndex, err := h.geneveAddDelTunnel(false, geneveLink, ^uint32(0))
+	if err != nil {
+		return err
+	}
+	return h.RemoveInterfaceTag(ifName, swIfIndex)
+}
+
+// dumpGeneveDetails dumps GENEVE interface details from VPP and fills them into the provided interface map.
+func (h *InterfaceVppHandler) dumpGeneveDetails(ifc map[uint32]*vppcalls.InterfaceDetails) error {
+	if h.geneve == nil {
+		// no-op when disabled
+		return nil
+	}
+
+	reqCtx := h.callsChannel.SendMultiRequest(&geneve.GeneveTunnelDump{
+		SwIfIndex: ^interface_types.InterfaceIndex(0),
+	})
+	for {
+		geneveDetails := &geneve.GeneveTunnelDetails{}
+		stop, err := reqCtx.ReceiveReply(geneveDetails)
+		if stop {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to dump GENEVE tunnel interface details: %v", err)
+		}
+		_, ifIdxExists := ifc[uint32(geneveDetails.SwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+		// Multicast interface
+		var multicastIfName string
+		if multicastIf, exists := ifc[uint32(geneveDetails.McastSwIfIndex)]; exists {
+			multicastIfName = multicastIf.Interface.Name
+		}
+
+		geneveLink := &interfaces.GeneveLink{
+			Multicast:  multicastIfName,
+			Vni:        geneveDetails.Vni,
+			EncapVrfId: geneveDetails.EncapVrfID,
+			// L3Mode: // not available in the binary API
+		}
+
+		if geneveDetails.SrcAddress.Af == ip_types.ADDRESS_IP6 {
+			srcAddrArr := geneveDetails.SrcAddress.Un.GetIP6()
+			geneveLink.SrcAddress = net.IP(srcAddrArr[:]).To16().String()
+			dstAddrArr := geneveDetails.DstAddress.Un.GetIP6()
+			geneveLink.DstAddress = net.IP(dstAddrArr[:]).To16().String()
+		} else {
+			srcAddrArr := geneveDetails.SrcAddress.Un.GetIP4()
+			geneveLink.SrcAddress = net.IP(srcAddrArr[:4]).To4().String()
+			dstAddrArr := geneveDetails.DstAddress.Un.GetIP4()
+			geneveLink.DstAddress = net.IP(dstAddrArr[:4]).To4().String()
+		}
+
+		ifc[uint32(geneveDetails.SwIfIndex)].Interface.Link = &interfaces.Interface_Geneve{Geneve: geneveLink}
+		ifc[uint32(geneveDetails.SwIfIndex)].Interface.Type = interfaces.Interface_GENEVE_TUNNEL
+	}
+	return nil
+}
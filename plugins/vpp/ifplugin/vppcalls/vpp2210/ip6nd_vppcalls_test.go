@@ -0,0 +1,198 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2210_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip6_nd"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/memclnt"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestSetIP6RaConfig(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaConfigReply{})
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaConfigReply{})
+
+	err := ifHandler.SetIP6RaConfig(ctx.Context, 1, &ifs.IP6RouterAdvertisement{
+		Managed:     true,
+		Other:       true,
+		MaxInterval: 30,
+		MinInterval: 10,
+		Lifetime:    90,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	var msgs []*ip6_nd.SwInterfaceIP6ndRaConfig
+	for _, msg := range ctx.MockChannel.Msgs {
+		if vppMsg, ok := msg.(*ip6_nd.SwInterfaceIP6ndRaConfig); ok {
+			msgs = append(msgs, vppMsg)
+		}
+	}
+	Expect(msgs).To(HaveLen(2))
+	// flags disabled in the configuration are cleared first
+	Expect(msgs[0].IsNo).To(BeTrue())
+	Expect(msgs[0].Suppress).To(BeEquivalentTo(1))
+	Expect(msgs[0].Managed).To(BeEquivalentTo(0))
+	Expect(msgs[0].Other).To(BeEquivalentTo(0))
+	Expect(msgs[1].IsNo).To(BeFalse())
+	Expect(msgs[1].SwIfIndex).To(BeEquivalentTo(1))
+	Expect(msgs[1].Suppress).To(BeEquivalentTo(0))
+	Expect(msgs[1].Managed).To(BeEquivalentTo(1))
+	Expect(msgs[1].Other).To(BeEquivalentTo(1))
+	Expect(msgs[1].MaxInterval).To(BeEquivalentTo(30))
+	Expect(msgs[1].MinInterval).To(BeEquivalentTo(10))
+	Expect(msgs[1].Lifetime).To(BeEquivalentTo(90))
+}
+
+func TestSetIP6RaConfigError(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaConfigReply{
+		Retval: 1,
+	})
+
+	err := ifHandler.SetIP6RaConfig(ctx.Context, 1, &ifs.IP6RouterAdvertisement{})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestResetIP6RaConfig(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaConfigReply{})
+
+	err := ifHandler.ResetIP6RaConfig(ctx.Context, 2)
+	Expect(err).ShouldNot(HaveOccurred())
+	vppMsg, ok := ctx.MockChannel.Msg.(*ip6_nd.SwInterfaceIP6ndRaConfig)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(vppMsg.IsNo).To(BeTrue())
+	Expect(vppMsg.Suppress).To(BeEquivalentTo(1))
+	Expect(vppMsg.Managed).To(BeEquivalentTo(1))
+	Expect(vppMsg.Other).To(BeEquivalentTo(1))
+}
+
+func TestAddIP6RaPrefix(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaPrefixReply{})
+
+	err := ifHandler.AddIP6RaPrefix(ctx.Context, 1, &ifs.IP6RouterAdvertisement_Prefix{
+		Address:           "2001:db8::/64",
+		ValidLifetime:     3600,
+		PreferredLifetime: 1800,
+		NoAutoconfig:      true,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	vppMsg, ok := ctx.MockChannel.Msg.(*ip6_nd.SwInterfaceIP6ndRaPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.Prefix.String()).To(Equal("2001:db8::/64"))
+	Expect(vppMsg.IsNo).To(BeFalse())
+	Expect(vppMsg.UseDefault).To(BeFalse())
+	Expect(vppMsg.NoAutoconfig).To(BeTrue())
+	Expect(vppMsg.ValLifetime).To(BeEquivalentTo(3600))
+	Expect(vppMsg.PrefLifetime).To(BeEquivalentTo(1800))
+}
+
+func TestDelIP6RaPrefix(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.SwInterfaceIP6ndRaPrefixReply{})
+
+	err := ifHandler.DelIP6RaPrefix(ctx.Context, 1, &ifs.IP6RouterAdvertisement_Prefix{
+		Address: "2001:db8::/64",
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	vppMsg, ok := ctx.MockChannel.Msg.(*ip6_nd.SwInterfaceIP6ndRaPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsNo).To(BeTrue())
+	Expect(vppMsg.UseDefault).To(BeTrue())
+}
+
+func TestAddIP6RaPrefixInvalid(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := ifHandler.AddIP6RaPrefix(ctx.Context, 1, &ifs.IP6RouterAdvertisement_Prefix{
+		Address: "invalid-prefix",
+	})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestAddIP6ndProxy(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.IP6ndProxyAddDelReply{})
+
+	err := ifHandler.AddIP6ndProxy(ctx.Context, 3, "2001:db8::1")
+	Expect(err).ShouldNot(HaveOccurred())
+	vppMsg, ok := ctx.MockChannel.Msg.(*ip6_nd.IP6ndProxyAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(3))
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.IP.String()).To(Equal("2001:db8::1"))
+}
+
+func TestDelIP6ndProxy(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&ip6_nd.IP6ndProxyAddDelReply{})
+
+	err := ifHandler.DelIP6ndProxy(ctx.Context, 3, "2001:db8::1")
+	Expect(err).ShouldNot(HaveOccurred())
+	vppMsg, ok := ctx.MockChannel.Msg.(*ip6_nd.IP6ndProxyAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeFalse())
+}
+
+func TestAddIP6ndProxyInvalidAddress(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := ifHandler.AddIP6ndProxy(ctx.Context, 3, "10.0.0.1")
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDumpIP6ndProxies(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ip, err := ip_types.ParseIP6Address("2001:db8::1")
+	Expect(err).ShouldNot(HaveOccurred())
+	ctx.MockVpp.MockReply(&ip6_nd.IP6ndProxyDetails{
+		SwIfIndex: 3,
+		IP:        ip,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	proxies, err := ifHandler.DumpIP6ndProxies(ctx.Context)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(proxies).To(HaveLen(1))
+	Expect(proxies[0].SwIfIndex).To(BeEquivalentTo(3))
+	Expect(proxies[0].Address).To(Equal("2001:db8::1"))
+}
@@ -23,6 +23,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/af_packet"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/bond"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/dhcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/geneve"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/gre"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/gtpu"
 	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface"
@@ -35,6 +36,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/rdma"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/span"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/tapv2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/vhost_user"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/vmxnet3"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/vxlan"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/wireguard"
@@ -55,11 +57,15 @@ var HandlerVersion = vpp.HandlerVersion{
 			l2.AllMessages,
 			span.AllMessages,
 			tapv2.AllMessages,
+			vhost_user.AllMessages,
 			vxlan.AllMessages,
 		)
 		if c.IsPluginLoaded(gtpu.APIFile) {
 			msgs.Add(gtpu.AllMessages)
 		}
+		if c.IsPluginLoaded(geneve.APIFile) {
+			msgs.Add(geneve.AllMessages)
+		}
 		if c.IsPluginLoaded(memif.APIFile) {
 			msgs.Add(memif.AllMessages)
 		}
@@ -89,6 +95,7 @@ type InterfaceVppHandler struct {
 	interfaces   interfaces.RPCService
 	ipsec        ipsec.RPCService
 	gtpu         gtpu.RPCService
+	geneve       geneve.RPCService
 	memif        memif.RPCService
 	vmxnet3      vmxnet3.RPCService
 	rpcIP6nd     ip6_nd.RPCService
@@ -115,6 +122,9 @@ func NewInterfaceVppHandler(c vpp.Client, log logging.Logger) vppcalls.Interface
 	if c.IsPluginLoaded(gtpu.APIFile) {
 		h.gtpu = gtpu.NewServiceClient(c)
 	}
+	if c.IsPluginLoaded(geneve.APIFile) {
+		h.geneve = geneve.NewServiceClient(c)
+	}
 	if c.IsPluginLoaded(memif.APIFile) {
 		h.memif = memif.NewServiceClient(c)
 	}
@@ -17,6 +17,17 @@ type FlowProbeFeatureKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type FlowProbeFeatureBatchOp struct {
+	Key         string
+	Value       *vpp_ipfix.FlowProbeFeature
+	PrevValue   *vpp_ipfix.FlowProbeFeature
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type FlowProbeFeatureDescriptor struct {
@@ -33,11 +44,15 @@ type FlowProbeFeatureDescriptor struct {
 	Delete               func(key string, value *vpp_ipfix.FlowProbeFeature, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_ipfix.FlowProbeFeature, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_ipfix.FlowProbeFeature, metadata interface{}) bool
+	CreateBatch          func(ops []*FlowProbeFeatureBatchOp) error
+	UpdateBatch          func(ops []*FlowProbeFeatureBatchOp) error
+	DeleteBatch          func(ops []*FlowProbeFeatureBatchOp) error
 	Retrieve             func(correlate []FlowProbeFeatureKVWithMetadata) ([]FlowProbeFeatureKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_ipfix.FlowProbeFeature) []KeyValuePair
 	Dependencies         func(key string, value *vpp_ipfix.FlowProbeFeature) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewFlowProbeFeatureDescriptor(typedDescriptor *FlowProbeFeatureDescriptor)
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewFlowProbeFeatureDescriptor(typedDescriptor *FlowProbeFeatureDescriptor)
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *FlowProbeFeatureDescriptorAdapter) UpdateWithRecreate(key string, oldV
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *FlowProbeFeatureDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castFlowProbeFeatureBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyFlowProbeFeatureBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *FlowProbeFeatureDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castFlowProbeFeatureBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyFlowProbeFeatureBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *FlowProbeFeatureDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castFlowProbeFeatureBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyFlowProbeFeatureBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *FlowProbeFeatureDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []FlowProbeFeatureKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castFlowProbeFeatureValue(key string, value proto.Message) (*vpp_ipfix.Flow
 	return typedValue, nil
 }
 
+func castFlowProbeFeatureBatchOps(ops []*BatchOp, withPrevValue bool) []*FlowProbeFeatureBatchOp {
+	var typedOps []*FlowProbeFeatureBatchOp
+	for _, op := range ops {
+		typedValue, err := castFlowProbeFeatureValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_ipfix.FlowProbeFeature
+		if withPrevValue {
+			typedPrevValue, err = castFlowProbeFeatureValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castFlowProbeFeatureMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &FlowProbeFeatureBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyFlowProbeFeatureBatchResults(ops []*BatchOp, typedOps []*FlowProbeFeatureBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castFlowProbeFeatureMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type FlowProbeParamsKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type FlowProbeParamsBatchOp struct {
+	Key         string
+	Value       *vpp_ipfix.FlowProbeParams
+	PrevValue   *vpp_ipfix.FlowProbeParams
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type FlowProbeParamsDescriptor struct {
@@ -33,11 +44,15 @@ type FlowProbeParamsDescriptor struct {
 	Delete               func(key string, value *vpp_ipfix.FlowProbeParams, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_ipfix.FlowProbeParams, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_ipfix.FlowProbeParams, metadata interface{}) bool
+	CreateBatch          func(ops []*FlowProbeParamsBatchOp) error
+	UpdateBatch          func(ops []*FlowProbeParamsBatchOp) error
+	DeleteBatch          func(ops []*FlowProbeParamsBatchOp) error
 	Retrieve             func(correlate []FlowProbeParamsKVWithMetadata) ([]FlowProbeParamsKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_ipfix.FlowProbeParams) []KeyValuePair
 	Dependencies         func(key string, value *vpp_ipfix.FlowProbeParams) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewFlowProbeParamsDescriptor(typedDescriptor *FlowProbeParamsDescriptor) *K
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewFlowProbeParamsDescriptor(typedDescriptor *FlowProbeParamsDescriptor) *K
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *FlowProbeParamsDescriptorAdapter) UpdateWithRecreate(key string, oldVa
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *FlowProbeParamsDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castFlowProbeParamsBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyFlowProbeParamsBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *FlowProbeParamsDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castFlowProbeParamsBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyFlowProbeParamsBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *FlowProbeParamsDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castFlowProbeParamsBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyFlowProbeParamsBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *FlowProbeParamsDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []FlowProbeParamsKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castFlowProbeParamsValue(key string, value proto.Message) (*vpp_ipfix.FlowP
 	return typedValue, nil
 }
 
+func castFlowProbeParamsBatchOps(ops []*BatchOp, withPrevValue bool) []*FlowProbeParamsBatchOp {
+	var typedOps []*FlowProbeParamsBatchOp
+	for _, op := range ops {
+		typedValue, err := castFlowProbeParamsValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_ipfix.FlowProbeParams
+		if withPrevValue {
+			typedPrevValue, err = castFlowProbeParamsValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castFlowProbeParamsMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &FlowProbeParamsBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyFlowProbeParamsBatchResults(ops []*BatchOp, typedOps []*FlowProbeParamsBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castFlowProbeParamsMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
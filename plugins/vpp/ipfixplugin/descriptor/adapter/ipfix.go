@@ -17,6 +17,17 @@ type IPFIXKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type IPFIXBatchOp struct {
+	Key         string
+	Value       *vpp_ipfix.IPFIX
+	PrevValue   *vpp_ipfix.IPFIX
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type IPFIXDescriptor struct {
@@ -33,11 +44,15 @@ type IPFIXDescriptor struct {
 	Delete               func(key string, value *vpp_ipfix.IPFIX, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_ipfix.IPFIX, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_ipfix.IPFIX, metadata interface{}) bool
+	CreateBatch          func(ops []*IPFIXBatchOp) error
+	UpdateBatch          func(ops []*IPFIXBatchOp) error
+	DeleteBatch          func(ops []*IPFIXBatchOp) error
 	Retrieve             func(correlate []IPFIXKVWithMetadata) ([]IPFIXKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_ipfix.IPFIX) []KeyValuePair
 	Dependencies         func(key string, value *vpp_ipfix.IPFIX) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewIPFIXDescriptor(typedDescriptor *IPFIXDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewIPFIXDescriptor(typedDescriptor *IPFIXDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *IPFIXDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newVa
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *IPFIXDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castIPFIXBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyIPFIXBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *IPFIXDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castIPFIXBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyIPFIXBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *IPFIXDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castIPFIXBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyIPFIXBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *IPFIXDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []IPFIXKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castIPFIXValue(key string, value proto.Message) (*vpp_ipfix.IPFIX, error) {
 	return typedValue, nil
 }
 
+func castIPFIXBatchOps(ops []*BatchOp, withPrevValue bool) []*IPFIXBatchOp {
+	var typedOps []*IPFIXBatchOp
+	for _, op := range ops {
+		typedValue, err := castIPFIXValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_ipfix.IPFIX
+		if withPrevValue {
+			typedPrevValue, err = castIPFIXValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castIPFIXMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &IPFIXBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyIPFIXBatchResults(ops []*BatchOp, typedOps []*IPFIXBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castIPFIXMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
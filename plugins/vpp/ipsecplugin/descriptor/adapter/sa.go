@@ -17,6 +17,17 @@ type SAKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type SABatchOp struct {
+	Key         string
+	Value       *vpp_ipsec.SecurityAssociation
+	PrevValue   *vpp_ipsec.SecurityAssociation
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type SADescriptor struct {
@@ -33,11 +44,15 @@ type SADescriptor struct {
 	Delete               func(key string, value *vpp_ipsec.SecurityAssociation, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_ipsec.SecurityAssociation, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_ipsec.SecurityAssociation, metadata interface{}) bool
+	CreateBatch          func(ops []*SABatchOp) error
+	UpdateBatch          func(ops []*SABatchOp) error
+	DeleteBatch          func(ops []*SABatchOp) error
 	Retrieve             func(correlate []SAKVWithMetadata) ([]SAKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_ipsec.SecurityAssociation) []KeyValuePair
 	Dependencies         func(key string, value *vpp_ipsec.SecurityAssociation) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewSADescriptor(typedDescriptor *SADescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewSADescriptor(typedDescriptor *SADescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *SADescriptorAdapter) UpdateWithRecreate(key string, oldValue, newValue
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *SADescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castSABatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copySABatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SADescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castSABatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copySABatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SADescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castSABatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copySABatchResults(ops, typedOps)
+	return err
+}
+
 func (da *SADescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []SAKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castSAValue(key string, value proto.Message) (*vpp_ipsec.SecurityAssociatio
 	return typedValue, nil
 }
 
+func castSABatchOps(ops []*BatchOp, withPrevValue bool) []*SABatchOp {
+	var typedOps []*SABatchOp
+	for _, op := range ops {
+		typedValue, err := castSAValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_ipsec.SecurityAssociation
+		if withPrevValue {
+			typedPrevValue, err = castSAValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castSAMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &SABatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copySABatchResults(ops []*BatchOp, typedOps []*SABatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castSAMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type SPKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type SPBatchOp struct {
+	Key         string
+	Value       *vpp_ipsec.SecurityPolicy
+	PrevValue   *vpp_ipsec.SecurityPolicy
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type SPDescriptor struct {
@@ -33,11 +44,15 @@ type SPDescriptor struct {
 	Delete               func(key string, value *vpp_ipsec.SecurityPolicy, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_ipsec.SecurityPolicy, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_ipsec.SecurityPolicy, metadata interface{}) bool
+	CreateBatch          func(ops []*SPBatchOp) error
+	UpdateBatch          func(ops []*SPBatchOp) error
+	DeleteBatch          func(ops []*SPBatchOp) error
 	Retrieve             func(correlate []SPKVWithMetadata) ([]SPKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_ipsec.SecurityPolicy) []KeyValuePair
 	Dependencies         func(key string, value *vpp_ipsec.SecurityPolicy) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewSPDescriptor(typedDescriptor *SPDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewSPDescriptor(typedDescriptor *SPDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *SPDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newValue
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *SPDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castSPBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copySPBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SPDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castSPBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copySPBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SPDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castSPBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copySPBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *SPDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []SPKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castSPValue(key string, value proto.Message) (*vpp_ipsec.SecurityPolicy, er
 	return typedValue, nil
 }
 
+func castSPBatchOps(ops []*BatchOp, withPrevValue bool) []*SPBatchOp {
+	var typedOps []*SPBatchOp
+	for _, op := range ops {
+		typedValue, err := castSPValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_ipsec.SecurityPolicy
+		if withPrevValue {
+			typedPrevValue, err = castSPValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castSPMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &SPBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copySPBatchResults(ops []*BatchOp, typedOps []*SPBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castSPMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type SPDKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type SPDBatchOp struct {
+	Key         string
+	Value       *vpp_ipsec.SecurityPolicyDatabase
+	PrevValue   *vpp_ipsec.SecurityPolicyDatabase
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type SPDDescriptor struct {
@@ -33,11 +44,15 @@ type SPDDescriptor struct {
 	Delete               func(key string, value *vpp_ipsec.SecurityPolicyDatabase, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_ipsec.SecurityPolicyDatabase, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_ipsec.SecurityPolicyDatabase, metadata interface{}) bool
+	CreateBatch          func(ops []*SPDBatchOp) error
+	UpdateBatch          func(ops []*SPDBatchOp) error
+	DeleteBatch          func(ops []*SPDBatchOp) error
 	Retrieve             func(correlate []SPDKVWithMetadata) ([]SPDKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_ipsec.SecurityPolicyDatabase) []KeyValuePair
 	Dependencies         func(key string, value *vpp_ipsec.SecurityPolicyDatabase) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewSPDDescriptor(typedDescriptor *SPDDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewSPDDescriptor(typedDescriptor *SPDDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *SPDDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newValu
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *SPDDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castSPDBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copySPDBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SPDDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castSPDBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copySPDBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SPDDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castSPDBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copySPDBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *SPDDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []SPDKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castSPDValue(key string, value proto.Message) (*vpp_ipsec.SecurityPolicyDat
 	return typedValue, nil
 }
 
+func castSPDBatchOps(ops []*BatchOp, withPrevValue bool) []*SPDBatchOp {
+	var typedOps []*SPDBatchOp
+	for _, op := range ops {
+		typedValue, err := castSPDValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_ipsec.SecurityPolicyDatabase
+		if withPrevValue {
+			typedPrevValue, err = castSPDValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castSPDMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &SPDBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copySPDBatchResults(ops []*BatchOp, typedOps []*SPDBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castSPDMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type SPDInterfaceKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type SPDInterfaceBatchOp struct {
+	Key         string
+	Value       *vpp_ipsec.SecurityPolicyDatabase_Interface
+	PrevValue   *vpp_ipsec.SecurityPolicyDatabase_Interface
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type SPDInterfaceDescriptor struct {
@@ -33,11 +44,15 @@ type SPDInterfaceDescriptor struct {
 	Delete               func(key string, value *vpp_ipsec.SecurityPolicyDatabase_Interface, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_ipsec.SecurityPolicyDatabase_Interface, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_ipsec.SecurityPolicyDatabase_Interface, metadata interface{}) bool
+	CreateBatch          func(ops []*SPDInterfaceBatchOp) error
+	UpdateBatch          func(ops []*SPDInterfaceBatchOp) error
+	DeleteBatch          func(ops []*SPDInterfaceBatchOp) error
 	Retrieve             func(correlate []SPDInterfaceKVWithMetadata) ([]SPDInterfaceKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_ipsec.SecurityPolicyDatabase_Interface) []KeyValuePair
 	Dependencies         func(key string, value *vpp_ipsec.SecurityPolicyDatabase_Interface) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewSPDInterfaceDescriptor(typedDescriptor *SPDInterfaceDescriptor) *KVDescr
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewSPDInterfaceDescriptor(typedDescriptor *SPDInterfaceDescriptor) *KVDescr
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *SPDInterfaceDescriptorAdapter) UpdateWithRecreate(key string, oldValue
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *SPDInterfaceDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castSPDInterfaceBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copySPDInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SPDInterfaceDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castSPDInterfaceBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copySPDInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SPDInterfaceDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castSPDInterfaceBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copySPDInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *SPDInterfaceDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []SPDInterfaceKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castSPDInterfaceValue(key string, value proto.Message) (*vpp_ipsec.Security
 	return typedValue, nil
 }
 
+func castSPDInterfaceBatchOps(ops []*BatchOp, withPrevValue bool) []*SPDInterfaceBatchOp {
+	var typedOps []*SPDInterfaceBatchOp
+	for _, op := range ops {
+		typedValue, err := castSPDInterfaceValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_ipsec.SecurityPolicyDatabase_Interface
+		if withPrevValue {
+			typedPrevValue, err = castSPDInterfaceValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castSPDInterfaceMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &SPDInterfaceBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copySPDInterfaceBatchResults(ops []*BatchOp, typedOps []*SPDInterfaceBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castSPDInterfaceMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
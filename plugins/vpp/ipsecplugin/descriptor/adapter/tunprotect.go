@@ -17,6 +17,17 @@ type TunProtectKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type TunProtectBatchOp struct {
+	Key         string
+	Value       *vpp_ipsec.TunnelProtection
+	PrevValue   *vpp_ipsec.TunnelProtection
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type TunProtectDescriptor struct {
@@ -33,11 +44,15 @@ type TunProtectDescriptor struct {
 	Delete               func(key string, value *vpp_ipsec.TunnelProtection, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_ipsec.TunnelProtection, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_ipsec.TunnelProtection, metadata interface{}) bool
+	CreateBatch          func(ops []*TunProtectBatchOp) error
+	UpdateBatch          func(ops []*TunProtectBatchOp) error
+	DeleteBatch          func(ops []*TunProtectBatchOp) error
 	Retrieve             func(correlate []TunProtectKVWithMetadata) ([]TunProtectKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_ipsec.TunnelProtection) []KeyValuePair
 	Dependencies         func(key string, value *vpp_ipsec.TunnelProtection) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewTunProtectDescriptor(typedDescriptor *TunProtectDescriptor) *KVDescripto
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewTunProtectDescriptor(typedDescriptor *TunProtectDescriptor) *KVDescripto
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *TunProtectDescriptorAdapter) UpdateWithRecreate(key string, oldValue,
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *TunProtectDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castTunProtectBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyTunProtectBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *TunProtectDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castTunProtectBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyTunProtectBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *TunProtectDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castTunProtectBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyTunProtectBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *TunProtectDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []TunProtectKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castTunProtectValue(key string, value proto.Message) (*vpp_ipsec.TunnelProt
 	return typedValue, nil
 }
 
+func castTunProtectBatchOps(ops []*BatchOp, withPrevValue bool) []*TunProtectBatchOp {
+	var typedOps []*TunProtectBatchOp
+	for _, op := range ops {
+		typedValue, err := castTunProtectValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_ipsec.TunnelProtection
+		if withPrevValue {
+			typedPrevValue, err = castTunProtectValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castTunProtectMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &TunProtectBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyTunProtectBatchResults(ops []*BatchOp, typedOps []*TunProtectBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castTunProtectMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type XConnectKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type XConnectBatchOp struct {
+	Key         string
+	Value       *vpp_l2.XConnectPair
+	PrevValue   *vpp_l2.XConnectPair
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type XConnectDescriptor struct {
@@ -33,11 +44,15 @@ type XConnectDescriptor struct {
 	Delete               func(key string, value *vpp_l2.XConnectPair, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_l2.XConnectPair, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_l2.XConnectPair, metadata interface{}) bool
+	CreateBatch          func(ops []*XConnectBatchOp) error
+	UpdateBatch          func(ops []*XConnectBatchOp) error
+	DeleteBatch          func(ops []*XConnectBatchOp) error
 	Retrieve             func(correlate []XConnectKVWithMetadata) ([]XConnectKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_l2.XConnectPair) []KeyValuePair
 	Dependencies         func(key string, value *vpp_l2.XConnectPair) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewXConnectDescriptor(typedDescriptor *XConnectDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewXConnectDescriptor(typedDescriptor *XConnectDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *XConnectDescriptorAdapter) UpdateWithRecreate(key string, oldValue, ne
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *XConnectDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castXConnectBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyXConnectBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *XConnectDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castXConnectBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyXConnectBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *XConnectDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castXConnectBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyXConnectBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *XConnectDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []XConnectKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castXConnectValue(key string, value proto.Message) (*vpp_l2.XConnectPair, e
 	return typedValue, nil
 }
 
+func castXConnectBatchOps(ops []*BatchOp, withPrevValue bool) []*XConnectBatchOp {
+	var typedOps []*XConnectBatchOp
+	for _, op := range ops {
+		typedValue, err := castXConnectValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_l2.XConnectPair
+		if withPrevValue {
+			typedPrevValue, err = castXConnectValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castXConnectMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &XConnectBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyXConnectBatchResults(ops []*BatchOp, typedOps []*XConnectBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castXConnectMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -78,6 +78,8 @@ func (d *FIBDescriptor) GetDescriptor() *adapter.FIBDescriptor {
 		Validate:             d.Validate,
 		Create:               d.Create,
 		Delete:               d.Delete,
+		CreateBatch:          d.CreateBatch,
+		DeleteBatch:          d.DeleteBatch,
 		Retrieve:             d.Retrieve,
 		Dependencies:         d.Dependencies,
 		RetrieveDependencies: []string{vpp_ifdescriptor.InterfaceDescriptorName, BridgeDomainDescriptorName},
@@ -137,6 +139,36 @@ func (d *FIBDescriptor) Delete(key string, fib *l2.FIBEntry, metadata interface{
 	return err
 }
 
+// CreateBatch adds multiple L2 FIBs at once.
+func (d *FIBDescriptor) CreateBatch(ops []*adapter.FIBBatchOp) error {
+	fibs := make([]*l2.FIBEntry, len(ops))
+	for i, op := range ops {
+		fibs[i] = op.Value
+	}
+	for i, err := range d.fibHandler.AddL2FIBs(fibs) {
+		if err != nil {
+			d.log.Error(err)
+		}
+		ops[i].Err = err
+	}
+	return nil
+}
+
+// DeleteBatch removes multiple VPP L2 FIBs at once.
+func (d *FIBDescriptor) DeleteBatch(ops []*adapter.FIBBatchOp) error {
+	fibs := make([]*l2.FIBEntry, len(ops))
+	for i, op := range ops {
+		fibs[i] = op.Value
+	}
+	for i, err := range d.fibHandler.DeleteL2FIBs(fibs) {
+		if err != nil {
+			d.log.Error(err)
+		}
+		ops[i].Err = err
+	}
+	return nil
+}
+
 // Retrieve returns all configured VPP L2 FIBs.
 func (d *FIBDescriptor) Retrieve(correlate []adapter.FIBKVWithMetadata) (retrieved []adapter.FIBKVWithMetadata, err error) {
 	fibs, err := d.fibHandler.DumpL2FIBs()
@@ -88,6 +88,14 @@ type FIBVppAPI interface {
 	AddL2FIB(fib *l2.FIBEntry) error
 	// DeleteL2FIB removes existing L2 FIB table entry.
 	DeleteL2FIB(fib *l2.FIBEntry) error
+	// AddL2FIBs creates multiple L2 FIB table entries at once, without waiting
+	// for the reply to one request before sending the next one.
+	// Returned is an error for each of the entries (nil if the entry was created).
+	AddL2FIBs(fibs []*l2.FIBEntry) []error
+	// DeleteL2FIBs removes multiple L2 FIB table entries at once, without waiting
+	// for the reply to one request before sending the next one.
+	// Returned is an error for each of the entries (nil if the entry was removed).
+	DeleteL2FIBs(fibs []*l2.FIBEntry) []error
 }
 
 // FIBVppRead provides read methods for FIBs.
@@ -18,12 +18,18 @@ import (
 	"errors"
 	"net"
 
+	govppapi "go.fd.io/govpp/api"
+
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ethernet_types"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface_types"
 	vpp_l2 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/l2"
 	l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 )
 
+// maxInFlightRequests is the maximum number of pipelined requests waiting
+// for reply (must not exceed the size of the reply buffer of the GoVPP channel).
+const maxInFlightRequests = 64
+
 // AddL2FIB creates L2 FIB table entry.
 func (h *FIBVppHandler) AddL2FIB(fib *l2.FIBEntry) error {
 	return h.l2fibAddDel(fib, true)
@@ -34,11 +40,70 @@ func (h *FIBVppHandler) DeleteL2FIB(fib *l2.FIBEntry) error {
 	return h.l2fibAddDel(fib, false)
 }
 
+// AddL2FIBs creates multiple L2 FIB table entries.
+func (h *FIBVppHandler) AddL2FIBs(fibs []*l2.FIBEntry) []error {
+	return h.l2fibAddDelMulti(fibs, true)
+}
+
+// DeleteL2FIBs removes multiple existing L2 FIB table entries.
+func (h *FIBVppHandler) DeleteL2FIBs(fibs []*l2.FIBEntry) []error {
+	return h.l2fibAddDelMulti(fibs, false)
+}
+
 func (h *FIBVppHandler) l2fibAddDel(fib *l2.FIBEntry, isAdd bool) (err error) {
+	req, err := h.l2fibAddDelRequest(fib, isAdd)
+	if err != nil {
+		return err
+	}
+	reply := &vpp_l2.L2fibAddDelReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// l2fibAddDelMulti adds or removes multiple L2 FIB entries. Requests are pipelined
+// - sent without waiting for the replies to the preceding requests, with at most
+// maxInFlightRequests requests waiting for reply at any time.
+// Returned is an error for each entry (nil if the entry was added/removed).
+func (h *FIBVppHandler) l2fibAddDelMulti(fibs []*l2.FIBEntry, isAdd bool) []error {
+	errs := make([]error, len(fibs))
+	type sentRequest struct {
+		idx    int
+		reqCtx govppapi.RequestCtx
+	}
+	var inFlight []sentRequest
+	receiveReplies := func() {
+		for _, sent := range inFlight {
+			reply := &vpp_l2.L2fibAddDelReply{}
+			errs[sent.idx] = sent.reqCtx.ReceiveReply(reply)
+		}
+		inFlight = inFlight[:0]
+	}
+
+	for i, fib := range fibs {
+		req, err := h.l2fibAddDelRequest(fib, isAdd)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		inFlight = append(inFlight, sentRequest{idx: i, reqCtx: h.callsChannel.SendRequest(req)})
+		if len(inFlight) == maxInFlightRequests {
+			receiveReplies()
+		}
+	}
+	receiveReplies()
+	return errs
+}
+
+// l2fibAddDelRequest builds request to add or remove the L2 FIB entry.
+func (h *FIBVppHandler) l2fibAddDelRequest(fib *l2.FIBEntry, isAdd bool) (req *vpp_l2.L2fibAddDel, err error) {
 	// get bridge domain metadata
 	bdMeta, found := h.bdIndexes.LookupByName(fib.BridgeDomain)
 	if !found {
-		return errors.New("failed to get bridge domain metadata")
+		return nil, errors.New("failed to get bridge domain metadata")
 	}
 
 	// get outgoing interface index
@@ -46,7 +111,7 @@ func (h *FIBVppHandler) l2fibAddDel(fib *l2.FIBEntry, isAdd bool) (err error) {
 	if fib.Action == l2.FIBEntry_FORWARD {
 		ifaceMeta, found := h.ifIndexes.LookupByName(fib.OutgoingInterface)
 		if !found {
-			return errors.New("failed to get interface metadata")
+			return nil, errors.New("failed to get interface metadata")
 		}
 		swIfIndex = ifaceMeta.GetIndex()
 	}
@@ -56,7 +121,7 @@ func (h *FIBVppHandler) l2fibAddDel(fib *l2.FIBEntry, isAdd bool) (err error) {
 	if fib.PhysAddress != "" {
 		mac, err = net.ParseMAC(fib.PhysAddress)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -64,7 +129,7 @@ func (h *FIBVppHandler) l2fibAddDel(fib *l2.FIBEntry, isAdd bool) (err error) {
 	copy(macAddr[:], mac)
 
 	// add L2 FIB
-	req := &vpp_l2.L2fibAddDel{
+	req = &vpp_l2.L2fibAddDel{
 		IsAdd:     isAdd,
 		Mac:       macAddr,
 		BdID:      bdMeta.GetIndex(),
@@ -73,11 +138,6 @@ func (h *FIBVppHandler) l2fibAddDel(fib *l2.FIBEntry, isAdd bool) (err error) {
 		StaticMac: fib.StaticConfig,
 		FilterMac: fib.Action == l2.FIBEntry_DROP,
 	}
-	reply := &vpp_l2.L2fibAddDelReply{}
-
-	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
-		return err
-	}
 
-	return nil
+	return req, nil
 }
@@ -103,6 +103,53 @@ func TestL2FibDelete(t *testing.T) {
 	}
 }
 
+func TestL2FibAddMulti(t *testing.T) {
+	ctx, fibHandler, ifaceIdx, bdIndexes := fibTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifaceIdx.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 55})
+	bdIndexes.Put("bd1", &idxvpp.OnlyIndex{Index: 5})
+
+	ctx.MockVpp.MockReply(&vpp_l2.L2fibAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_l2.L2fibAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_l2.L2fibAddDelReply{Retval: 1})
+	ctx.MockVpp.MockReply(&vpp_l2.L2fibAddDelReply{})
+	fibs := append(testDataInFib, &l2.FIBEntry{PhysAddress: "not:mac:addr", BridgeDomain: "bd1", OutgoingInterface: "if1"})
+	errs := fibHandler.AddL2FIBs(fibs)
+	Expect(errs).To(HaveLen(5))
+	Expect(errs[0]).ShouldNot(HaveOccurred())
+	Expect(errs[1]).ShouldNot(HaveOccurred())
+	Expect(errs[2]).Should(HaveOccurred())
+	Expect(errs[3]).ShouldNot(HaveOccurred())
+	Expect(errs[4]).Should(HaveOccurred())
+
+	// invalid entry is not sent
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(4))
+	for i := 0; i < len(testDataInFib); i++ {
+		testDatasOutFib[i].IsAdd = true
+		Expect(ctx.MockChannel.Msgs[i]).To(Equal(testDatasOutFib[i]))
+	}
+}
+
+func TestL2FibDeleteMulti(t *testing.T) {
+	ctx, fibHandler, ifaceIdx, bdIndexes := fibTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifaceIdx.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 55})
+	bdIndexes.Put("bd1", &idxvpp.OnlyIndex{Index: 5})
+
+	for range testDataInFib {
+		ctx.MockVpp.MockReply(&vpp_l2.L2fibAddDelReply{})
+	}
+	errs := fibHandler.DeleteL2FIBs(testDataInFib)
+	Expect(errs).To(HaveLen(len(testDataInFib)))
+	for i := 0; i < len(testDataInFib); i++ {
+		Expect(errs[i]).ShouldNot(HaveOccurred())
+		testDatasOutFib[i].IsAdd = false
+		Expect(ctx.MockChannel.Msgs[i]).To(Equal(testDatasOutFib[i]))
+	}
+}
+
 func fibTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.FIBVppAPI, ifaceidx.IfaceMetadataIndexRW, idxvpp.NameToIndexRW) {
 	ctx := vppmock.SetupTestCtx(t)
 	logger := logrus.NewLogger("test-log")
@@ -17,6 +17,17 @@ type ARPEntryKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type ARPEntryBatchOp struct {
+	Key         string
+	Value       *vpp_l3.ARPEntry
+	PrevValue   *vpp_l3.ARPEntry
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type ARPEntryDescriptor struct {
@@ -33,11 +44,15 @@ type ARPEntryDescriptor struct {
 	Delete               func(key string, value *vpp_l3.ARPEntry, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_l3.ARPEntry, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_l3.ARPEntry, metadata interface{}) bool
+	CreateBatch          func(ops []*ARPEntryBatchOp) error
+	UpdateBatch          func(ops []*ARPEntryBatchOp) error
+	DeleteBatch          func(ops []*ARPEntryBatchOp) error
 	Retrieve             func(correlate []ARPEntryKVWithMetadata) ([]ARPEntryKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_l3.ARPEntry) []KeyValuePair
 	Dependencies         func(key string, value *vpp_l3.ARPEntry) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewARPEntryDescriptor(typedDescriptor *ARPEntryDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewARPEntryDescriptor(typedDescriptor *ARPEntryDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *ARPEntryDescriptorAdapter) UpdateWithRecreate(key string, oldValue, ne
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *ARPEntryDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castARPEntryBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyARPEntryBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *ARPEntryDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castARPEntryBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyARPEntryBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *ARPEntryDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castARPEntryBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyARPEntryBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *ARPEntryDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []ARPEntryKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castARPEntryValue(key string, value proto.Message) (*vpp_l3.ARPEntry, error
 	return typedValue, nil
 }
 
+func castARPEntryBatchOps(ops []*BatchOp, withPrevValue bool) []*ARPEntryBatchOp {
+	var typedOps []*ARPEntryBatchOp
+	for _, op := range ops {
+		typedValue, err := castARPEntryValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_l3.ARPEntry
+		if withPrevValue {
+			typedPrevValue, err = castARPEntryValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castARPEntryMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &ARPEntryBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyARPEntryBatchResults(ops []*BatchOp, typedOps []*ARPEntryBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castARPEntryMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
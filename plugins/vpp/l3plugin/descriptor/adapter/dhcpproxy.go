@@ -17,6 +17,17 @@ type DHCPProxyKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type DHCPProxyBatchOp struct {
+	Key         string
+	Value       *vpp_l3.DHCPProxy
+	PrevValue   *vpp_l3.DHCPProxy
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type DHCPProxyDescriptor struct {
@@ -33,11 +44,15 @@ type DHCPProxyDescriptor struct {
 	Delete               func(key string, value *vpp_l3.DHCPProxy, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_l3.DHCPProxy, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_l3.DHCPProxy, metadata interface{}) bool
+	CreateBatch          func(ops []*DHCPProxyBatchOp) error
+	UpdateBatch          func(ops []*DHCPProxyBatchOp) error
+	DeleteBatch          func(ops []*DHCPProxyBatchOp) error
 	Retrieve             func(correlate []DHCPProxyKVWithMetadata) ([]DHCPProxyKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_l3.DHCPProxy) []KeyValuePair
 	Dependencies         func(key string, value *vpp_l3.DHCPProxy) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewDHCPProxyDescriptor(typedDescriptor *DHCPProxyDescriptor) *KVDescriptor
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewDHCPProxyDescriptor(typedDescriptor *DHCPProxyDescriptor) *KVDescriptor
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *DHCPProxyDescriptorAdapter) UpdateWithRecreate(key string, oldValue, n
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *DHCPProxyDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castDHCPProxyBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyDHCPProxyBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *DHCPProxyDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castDHCPProxyBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyDHCPProxyBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *DHCPProxyDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castDHCPProxyBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyDHCPProxyBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *DHCPProxyDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []DHCPProxyKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castDHCPProxyValue(key string, value proto.Message) (*vpp_l3.DHCPProxy, err
 	return typedValue, nil
 }
 
+func castDHCPProxyBatchOps(ops []*BatchOp, withPrevValue bool) []*DHCPProxyBatchOp {
+	var typedOps []*DHCPProxyBatchOp
+	for _, op := range ops {
+		typedValue, err := castDHCPProxyValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_l3.DHCPProxy
+		if withPrevValue {
+			typedPrevValue, err = castDHCPProxyValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castDHCPProxyMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &DHCPProxyBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyDHCPProxyBatchResults(ops []*BatchOp, typedOps []*DHCPProxyBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castDHCPProxyMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
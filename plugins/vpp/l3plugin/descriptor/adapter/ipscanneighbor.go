@@ -17,6 +17,17 @@ type IPScanNeighborKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type IPScanNeighborBatchOp struct {
+	Key         string
+	Value       *vpp_l3.IPScanNeighbor
+	PrevValue   *vpp_l3.IPScanNeighbor
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type IPScanNeighborDescriptor struct {
@@ -33,11 +44,15 @@ type IPScanNeighborDescriptor struct {
 	Delete               func(key string, value *vpp_l3.IPScanNeighbor, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_l3.IPScanNeighbor, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_l3.IPScanNeighbor, metadata interface{}) bool
+	CreateBatch          func(ops []*IPScanNeighborBatchOp) error
+	UpdateBatch          func(ops []*IPScanNeighborBatchOp) error
+	DeleteBatch          func(ops []*IPScanNeighborBatchOp) error
 	Retrieve             func(correlate []IPScanNeighborKVWithMetadata) ([]IPScanNeighborKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_l3.IPScanNeighbor) []KeyValuePair
 	Dependencies         func(key string, value *vpp_l3.IPScanNeighbor) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewIPScanNeighborDescriptor(typedDescriptor *IPScanNeighborDescriptor) *KVD
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewIPScanNeighborDescriptor(typedDescriptor *IPScanNeighborDescriptor) *KVD
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *IPScanNeighborDescriptorAdapter) UpdateWithRecreate(key string, oldVal
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *IPScanNeighborDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castIPScanNeighborBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyIPScanNeighborBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *IPScanNeighborDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castIPScanNeighborBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyIPScanNeighborBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *IPScanNeighborDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castIPScanNeighborBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyIPScanNeighborBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *IPScanNeighborDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []IPScanNeighborKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castIPScanNeighborValue(key string, value proto.Message) (*vpp_l3.IPScanNei
 	return typedValue, nil
 }
 
+func castIPScanNeighborBatchOps(ops []*BatchOp, withPrevValue bool) []*IPScanNeighborBatchOp {
+	var typedOps []*IPScanNeighborBatchOp
+	for _, op := range ops {
+		typedValue, err := castIPScanNeighborValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_l3.IPScanNeighbor
+		if withPrevValue {
+			typedPrevValue, err = castIPScanNeighborValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castIPScanNeighborMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &IPScanNeighborBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyIPScanNeighborBatchResults(ops []*BatchOp, typedOps []*IPScanNeighborBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castIPScanNeighborMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type L3XCKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type L3XCBatchOp struct {
+	Key         string
+	Value       *vpp_l3.L3XConnect
+	PrevValue   *vpp_l3.L3XConnect
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type L3XCDescriptor struct {
@@ -33,11 +44,15 @@ type L3XCDescriptor struct {
 	Delete               func(key string, value *vpp_l3.L3XConnect, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_l3.L3XConnect, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_l3.L3XConnect, metadata interface{}) bool
+	CreateBatch          func(ops []*L3XCBatchOp) error
+	UpdateBatch          func(ops []*L3XCBatchOp) error
+	DeleteBatch          func(ops []*L3XCBatchOp) error
 	Retrieve             func(correlate []L3XCKVWithMetadata) ([]L3XCKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_l3.L3XConnect) []KeyValuePair
 	Dependencies         func(key string, value *vpp_l3.L3XConnect) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewL3XCDescriptor(typedDescriptor *L3XCDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewL3XCDescriptor(typedDescriptor *L3XCDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *L3XCDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newVal
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *L3XCDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castL3XCBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyL3XCBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *L3XCDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castL3XCBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyL3XCBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *L3XCDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castL3XCBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyL3XCBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *L3XCDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []L3XCKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castL3XCValue(key string, value proto.Message) (*vpp_l3.L3XConnect, error)
 	return typedValue, nil
 }
 
+func castL3XCBatchOps(ops []*BatchOp, withPrevValue bool) []*L3XCBatchOp {
+	var typedOps []*L3XCBatchOp
+	for _, op := range ops {
+		typedValue, err := castL3XCValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_l3.L3XConnect
+		if withPrevValue {
+			typedPrevValue, err = castL3XCValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castL3XCMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &L3XCBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyL3XCBatchResults(ops []*BatchOp, typedOps []*L3XCBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castL3XCMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
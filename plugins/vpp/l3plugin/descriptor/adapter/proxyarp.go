@@ -17,6 +17,17 @@ type ProxyARPKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type ProxyARPBatchOp struct {
+	Key         string
+	Value       *vpp_l3.ProxyARP
+	PrevValue   *vpp_l3.ProxyARP
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type ProxyARPDescriptor struct {
@@ -33,11 +44,15 @@ type ProxyARPDescriptor struct {
 	Delete               func(key string, value *vpp_l3.ProxyARP, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_l3.ProxyARP, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_l3.ProxyARP, metadata interface{}) bool
+	CreateBatch          func(ops []*ProxyARPBatchOp) error
+	UpdateBatch          func(ops []*ProxyARPBatchOp) error
+	DeleteBatch          func(ops []*ProxyARPBatchOp) error
 	Retrieve             func(correlate []ProxyARPKVWithMetadata) ([]ProxyARPKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_l3.ProxyARP) []KeyValuePair
 	Dependencies         func(key string, value *vpp_l3.ProxyARP) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewProxyARPDescriptor(typedDescriptor *ProxyARPDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewProxyARPDescriptor(typedDescriptor *ProxyARPDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *ProxyARPDescriptorAdapter) UpdateWithRecreate(key string, oldValue, ne
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *ProxyARPDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castProxyARPBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyProxyARPBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *ProxyARPDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castProxyARPBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyProxyARPBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *ProxyARPDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castProxyARPBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyProxyARPBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *ProxyARPDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []ProxyARPKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castProxyARPValue(key string, value proto.Message) (*vpp_l3.ProxyARP, error
 	return typedValue, nil
 }
 
+func castProxyARPBatchOps(ops []*BatchOp, withPrevValue bool) []*ProxyARPBatchOp {
+	var typedOps []*ProxyARPBatchOp
+	for _, op := range ops {
+		typedValue, err := castProxyARPValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_l3.ProxyARP
+		if withPrevValue {
+			typedPrevValue, err = castProxyARPValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castProxyARPMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &ProxyARPBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyProxyARPBatchResults(ops []*BatchOp, typedOps []*ProxyARPBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castProxyARPMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type ProxyARPInterfaceKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type ProxyARPInterfaceBatchOp struct {
+	Key         string
+	Value       *vpp_l3.ProxyARP_Interface
+	PrevValue   *vpp_l3.ProxyARP_Interface
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type ProxyARPInterfaceDescriptor struct {
@@ -33,11 +44,15 @@ type ProxyARPInterfaceDescriptor struct {
 	Delete               func(key string, value *vpp_l3.ProxyARP_Interface, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_l3.ProxyARP_Interface, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_l3.ProxyARP_Interface, metadata interface{}) bool
+	CreateBatch          func(ops []*ProxyARPInterfaceBatchOp) error
+	UpdateBatch          func(ops []*ProxyARPInterfaceBatchOp) error
+	DeleteBatch          func(ops []*ProxyARPInterfaceBatchOp) error
 	Retrieve             func(correlate []ProxyARPInterfaceKVWithMetadata) ([]ProxyARPInterfaceKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_l3.ProxyARP_Interface) []KeyValuePair
 	Dependencies         func(key string, value *vpp_l3.ProxyARP_Interface) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewProxyARPInterfaceDescriptor(typedDescriptor *ProxyARPInterfaceDescriptor
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewProxyARPInterfaceDescriptor(typedDescriptor *ProxyARPInterfaceDescriptor
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *ProxyARPInterfaceDescriptorAdapter) UpdateWithRecreate(key string, old
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *ProxyARPInterfaceDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castProxyARPInterfaceBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyProxyARPInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *ProxyARPInterfaceDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castProxyARPInterfaceBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyProxyARPInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *ProxyARPInterfaceDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castProxyARPInterfaceBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyProxyARPInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *ProxyARPInterfaceDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []ProxyARPInterfaceKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castProxyARPInterfaceValue(key string, value proto.Message) (*vpp_l3.ProxyA
 	return typedValue, nil
 }
 
+func castProxyARPInterfaceBatchOps(ops []*BatchOp, withPrevValue bool) []*ProxyARPInterfaceBatchOp {
+	var typedOps []*ProxyARPInterfaceBatchOp
+	for _, op := range ops {
+		typedValue, err := castProxyARPInterfaceValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_l3.ProxyARP_Interface
+		if withPrevValue {
+			typedPrevValue, err = castProxyARPInterfaceValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castProxyARPInterfaceMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &ProxyARPInterfaceBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyProxyARPInterfaceBatchResults(ops []*BatchOp, typedOps []*ProxyARPInterfaceBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castProxyARPInterfaceMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type TeibEntryKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type TeibEntryBatchOp struct {
+	Key         string
+	Value       *vpp_l3.TeibEntry
+	PrevValue   *vpp_l3.TeibEntry
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type TeibEntryDescriptor struct {
@@ -33,11 +44,15 @@ type TeibEntryDescriptor struct {
 	Delete               func(key string, value *vpp_l3.TeibEntry, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_l3.TeibEntry, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_l3.TeibEntry, metadata interface{}) bool
+	CreateBatch          func(ops []*TeibEntryBatchOp) error
+	UpdateBatch          func(ops []*TeibEntryBatchOp) error
+	DeleteBatch          func(ops []*TeibEntryBatchOp) error
 	Retrieve             func(correlate []TeibEntryKVWithMetadata) ([]TeibEntryKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_l3.TeibEntry) []KeyValuePair
 	Dependencies         func(key string, value *vpp_l3.TeibEntry) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewTeibEntryDescriptor(typedDescriptor *TeibEntryDescriptor) *KVDescriptor
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewTeibEntryDescriptor(typedDescriptor *TeibEntryDescriptor) *KVDescriptor
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *TeibEntryDescriptorAdapter) UpdateWithRecreate(key string, oldValue, n
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *TeibEntryDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castTeibEntryBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyTeibEntryBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *TeibEntryDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castTeibEntryBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyTeibEntryBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *TeibEntryDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castTeibEntryBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyTeibEntryBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *TeibEntryDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []TeibEntryKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castTeibEntryValue(key string, value proto.Message) (*vpp_l3.TeibEntry, err
 	return typedValue, nil
 }
 
+func castTeibEntryBatchOps(ops []*BatchOp, withPrevValue bool) []*TeibEntryBatchOp {
+	var typedOps []*TeibEntryBatchOp
+	for _, op := range ops {
+		typedValue, err := castTeibEntryValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_l3.TeibEntry
+		if withPrevValue {
+			typedPrevValue, err = castTeibEntryValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castTeibEntryMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &TeibEntryBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyTeibEntryBatchResults(ops []*BatchOp, typedOps []*TeibEntryBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castTeibEntryMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -18,6 +18,17 @@ type VrfTableKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type VrfTableBatchOp struct {
+	Key         string
+	Value       *vpp_l3.VrfTable
+	PrevValue   *vpp_l3.VrfTable
+	Metadata    *vrfidx.VRFMetadata
+	NewMetadata *vrfidx.VRFMetadata
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type VrfTableDescriptor struct {
@@ -34,11 +45,15 @@ type VrfTableDescriptor struct {
 	Delete               func(key string, value *vpp_l3.VrfTable, metadata *vrfidx.VRFMetadata) error
 	Update               func(key string, oldValue, newValue *vpp_l3.VrfTable, oldMetadata *vrfidx.VRFMetadata) (newMetadata *vrfidx.VRFMetadata, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_l3.VrfTable, metadata *vrfidx.VRFMetadata) bool
+	CreateBatch          func(ops []*VrfTableBatchOp) error
+	UpdateBatch          func(ops []*VrfTableBatchOp) error
+	DeleteBatch          func(ops []*VrfTableBatchOp) error
 	Retrieve             func(correlate []VrfTableKVWithMetadata) ([]VrfTableKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_l3.VrfTable) []KeyValuePair
 	Dependencies         func(key string, value *vpp_l3.VrfTable) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -59,6 +74,7 @@ func NewVrfTableDescriptor(typedDescriptor *VrfTableDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -78,6 +94,15 @@ func NewVrfTableDescriptor(typedDescriptor *VrfTableDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -159,6 +184,27 @@ func (da *VrfTableDescriptorAdapter) UpdateWithRecreate(key string, oldValue, ne
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *VrfTableDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castVrfTableBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyVrfTableBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *VrfTableDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castVrfTableBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyVrfTableBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *VrfTableDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castVrfTableBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyVrfTableBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *VrfTableDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []VrfTableKVWithMetadata
 	for _, kvpair := range correlate {
@@ -222,6 +268,50 @@ func castVrfTableValue(key string, value proto.Message) (*vpp_l3.VrfTable, error
 	return typedValue, nil
 }
 
+func castVrfTableBatchOps(ops []*BatchOp, withPrevValue bool) []*VrfTableBatchOp {
+	var typedOps []*VrfTableBatchOp
+	for _, op := range ops {
+		typedValue, err := castVrfTableValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_l3.VrfTable
+		if withPrevValue {
+			typedPrevValue, err = castVrfTableValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castVrfTableMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &VrfTableBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyVrfTableBatchResults(ops []*BatchOp, typedOps []*VrfTableBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castVrfTableMetadata(key string, metadata Metadata) (*vrfidx.VRFMetadata, error) {
 	if metadata == nil {
 		return nil, nil
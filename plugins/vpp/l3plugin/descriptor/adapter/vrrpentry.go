@@ -17,6 +17,17 @@ type VRRPEntryKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type VRRPEntryBatchOp struct {
+	Key         string
+	Value       *vpp_l3.VRRPEntry
+	PrevValue   *vpp_l3.VRRPEntry
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type VRRPEntryDescriptor struct {
@@ -33,11 +44,15 @@ type VRRPEntryDescriptor struct {
 	Delete               func(key string, value *vpp_l3.VRRPEntry, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_l3.VRRPEntry, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_l3.VRRPEntry, metadata interface{}) bool
+	CreateBatch          func(ops []*VRRPEntryBatchOp) error
+	UpdateBatch          func(ops []*VRRPEntryBatchOp) error
+	DeleteBatch          func(ops []*VRRPEntryBatchOp) error
 	Retrieve             func(correlate []VRRPEntryKVWithMetadata) ([]VRRPEntryKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_l3.VRRPEntry) []KeyValuePair
 	Dependencies         func(key string, value *vpp_l3.VRRPEntry) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewVRRPEntryDescriptor(typedDescriptor *VRRPEntryDescriptor) *KVDescriptor
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewVRRPEntryDescriptor(typedDescriptor *VRRPEntryDescriptor) *KVDescriptor
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *VRRPEntryDescriptorAdapter) UpdateWithRecreate(key string, oldValue, n
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *VRRPEntryDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castVRRPEntryBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyVRRPEntryBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *VRRPEntryDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castVRRPEntryBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyVRRPEntryBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *VRRPEntryDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castVRRPEntryBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyVRRPEntryBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *VRRPEntryDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []VRRPEntryKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castVRRPEntryValue(key string, value proto.Message) (*vpp_l3.VRRPEntry, err
 	return typedValue, nil
 }
 
+func castVRRPEntryBatchOps(ops []*BatchOp, withPrevValue bool) []*VRRPEntryBatchOp {
+	var typedOps []*VRRPEntryBatchOp
+	for _, op := range ops {
+		typedValue, err := castVRRPEntryValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_l3.VRRPEntry
+		if withPrevValue {
+			typedPrevValue, err = castVRRPEntryValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castVRRPEntryMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &VRRPEntryBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyVRRPEntryBatchResults(ops []*BatchOp, typedOps []*VRRPEntryBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castVRRPEntryMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -34,6 +34,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor/adapter"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
 	netalloc_api "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
 	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
 )
@@ -46,9 +47,31 @@ const (
 	routeOutInterfaceDep = "interface-exists"
 	vrfTableDep          = "vrf-table-exists"
 	viaVrfTableDep       = "via-vrf-table-exists"
+	bfdSessionUpDep      = "bfd-session-is-up"
 
 	// static route weight by default
 	defaultWeight = 1
+
+	// maximum number of MPLS labels imposed by one path
+	maxLabelStackDepth = 16
+
+	// maximum value of MPLS label (20 bits)
+	maxMplsLabel = 1<<20 - 1
+)
+
+// Validation errors:
+var (
+	// ErrMultipathRouteWithNextHop is returned when multipath route defines also next hop
+	// attributes outside of the paths.
+	ErrMultipathRouteWithNextHop = errors.New("next hop of multipath route must be defined only inside paths")
+	// ErrMultipathDropRoute is returned when DROP route is defined with paths.
+	ErrMultipathDropRoute = errors.New("DROP route cannot be defined with paths")
+	// ErrDuplicateRoutePath is returned when the same path is defined more than once.
+	ErrDuplicateRoutePath = errors.New("duplicate route path")
+	// ErrLabelStackTooDeep is returned when path imposes too many MPLS labels.
+	ErrLabelStackTooDeep = errors.Errorf("label stack cannot have more than %d labels", maxLabelStackDepth)
+	// ErrInvalidMplsLabel is returned when MPLS label does not fit into 20 bits.
+	ErrInvalidMplsLabel = errors.New("MPLS label must be in the range 0-1048575")
 )
 
 // RouteDescriptor teaches KVScheduler how to configure VPP routes.
@@ -70,16 +93,20 @@ func NewRouteDescriptor(
 	}
 
 	typedDescr := &adapter.RouteDescriptor{
-		Name:            RouteDescriptorName,
-		NBKeyPrefix:     l3.ModelRoute.KeyPrefix(),
-		ValueTypeName:   l3.ModelRoute.ProtoName(),
-		KeySelector:     l3.ModelRoute.IsKeyValid,
-		ValueComparator: ctx.EquivalentRoutes,
-		Validate:        ctx.Validate,
-		Create:          ctx.Create,
-		Delete:          ctx.Delete,
-		Retrieve:        ctx.Retrieve,
-		Dependencies:    ctx.Dependencies,
+		Name:               RouteDescriptorName,
+		NBKeyPrefix:        l3.ModelRoute.KeyPrefix(),
+		ValueTypeName:      l3.ModelRoute.ProtoName(),
+		KeySelector:        l3.ModelRoute.IsKeyValid,
+		ValueComparator:    ctx.EquivalentRoutes,
+		Validate:           ctx.Validate,
+		Create:             ctx.Create,
+		Delete:             ctx.Delete,
+		Update:             ctx.Update,
+		UpdateWithRecreate: ctx.UpdateWithRecreate,
+		CreateBatch:        ctx.CreateBatch,
+		DeleteBatch:        ctx.DeleteBatch,
+		Retrieve:           ctx.Retrieve,
+		Dependencies:       ctx.Dependencies,
 		RetrieveDependencies: []string{
 			netalloc_descr.IPAllocDescriptorName,
 			ifdescriptor.InterfaceDescriptorName,
@@ -89,13 +116,16 @@ func NewRouteDescriptor(
 }
 
 // EquivalentRoutes is case-insensitive comparison function for l3.Route.
+// Paths of multipath routes are compared regardless of their order.
 func (d *RouteDescriptor) EquivalentRoutes(key string, oldRoute, newRoute *l3.Route) bool {
 	if oldRoute.GetType() != newRoute.GetType() ||
-		oldRoute.GetVrfId() != newRoute.GetVrfId() ||
-		oldRoute.GetViaVrfId() != newRoute.GetViaVrfId() ||
-		oldRoute.GetOutgoingInterface() != newRoute.GetOutgoingInterface() ||
-		getWeight(oldRoute) != getWeight(newRoute) ||
-		oldRoute.GetPreference() != newRoute.GetPreference() {
+		oldRoute.GetVrfId() != newRoute.GetVrfId() {
+		return false
+	}
+
+	// compare referenced BFD sessions
+	if vpp_bfd.SessionStateKey(oldRoute.GetBfdSession(), true) !=
+		vpp_bfd.SessionStateKey(newRoute.GetBfdSession(), true) {
 		return false
 	}
 
@@ -104,12 +134,14 @@ func (d *RouteDescriptor) EquivalentRoutes(key string, oldRoute, newRoute *l3.Ro
 		return false
 	}
 
-	// compare gw addresses (next hop)
-	if !equalAddrs(getGwAddr(oldRoute), getGwAddr(newRoute)) {
+	// compare paths (next hops)
+	oldPaths := vppcalls.RoutePaths(oldRoute)
+	newPaths := vppcalls.RoutePaths(newRoute)
+	if len(oldPaths) != len(newPaths) {
 		return false
 	}
-
-	return true
+	added, removed := diffRoutePaths(oldRoute, newRoute)
+	return len(added) == 0 && len(removed) == 0
 }
 
 // Validate validates VPP static route configuration.
@@ -122,9 +154,13 @@ func (d *RouteDescriptor) Validate(key string, route *l3.Route) (err error) {
 	}
 
 	// validate next hop address (GW)
-	err = d.addrAlloc.ValidateIPAddress(getGwAddr(route), route.OutgoingInterface,
-		"gw_addr", netalloc.GWRefRequired)
-	if err != nil {
+	if len(route.Paths) == 0 {
+		err = d.addrAlloc.ValidateIPAddress(getGwAddr(route), route.OutgoingInterface,
+			"gw_addr", netalloc.GWRefRequired)
+		if err != nil {
+			return err
+		}
+	} else if err = d.validatePaths(route); err != nil {
 		return err
 	}
 
@@ -143,6 +179,39 @@ func (d *RouteDescriptor) Validate(key string, route *l3.Route) (err error) {
 	return nil
 }
 
+// validatePaths validates paths of a multipath route.
+func (d *RouteDescriptor) validatePaths(route *l3.Route) error {
+	if route.NextHopAddr != "" || route.OutgoingInterface != "" || route.Weight != 0 ||
+		route.Preference != 0 || route.ViaVrfId != 0 {
+		return kvs.NewInvalidValueError(ErrMultipathRouteWithNextHop,
+			"next_hop_addr", "outgoing_interface", "weight", "preference", "via_vrf_id")
+	}
+	if route.Type == l3.Route_DROP {
+		return kvs.NewInvalidValueError(ErrMultipathDropRoute, "type", "paths")
+	}
+	for i, path := range route.Paths {
+		err := d.addrAlloc.ValidateIPAddress(getPathGwAddr(route, path), path.OutgoingInterface,
+			fmt.Sprintf("paths[%d].next_hop_addr", i), netalloc.GWRefRequired)
+		if err != nil {
+			return err
+		}
+		if len(path.LabelStack) > maxLabelStackDepth {
+			return kvs.NewInvalidValueError(ErrLabelStackTooDeep, fmt.Sprintf("paths[%d].label_stack", i))
+		}
+		for _, label := range path.LabelStack {
+			if label > maxMplsLabel {
+				return kvs.NewInvalidValueError(ErrInvalidMplsLabel, fmt.Sprintf("paths[%d].label_stack", i))
+			}
+		}
+		for j := 0; j < i; j++ {
+			if equivalentPaths(route, route.Paths[j], path) {
+				return kvs.NewInvalidValueError(ErrDuplicateRoutePath, fmt.Sprintf("paths[%d]", i))
+			}
+		}
+	}
+	return nil
+}
+
 // Create adds VPP static route.
 func (d *RouteDescriptor) Create(key string, route *l3.Route) (metadata interface{}, err error) {
 	err = d.routeHandler.VppAddRoute(context.TODO(), route)
@@ -163,6 +232,49 @@ func (d *RouteDescriptor) Delete(key string, route *l3.Route, metadata interface
 	return nil
 }
 
+// CreateBatch adds multiple VPP static routes at once.
+func (d *RouteDescriptor) CreateBatch(ops []*adapter.RouteBatchOp) error {
+	routes := make([]*l3.Route, len(ops))
+	for i, op := range ops {
+		routes[i] = op.Value
+	}
+	for i, err := range d.routeHandler.VppAddRoutes(context.TODO(), routes) {
+		ops[i].Err = err
+	}
+	return nil
+}
+
+// DeleteBatch removes multiple VPP static routes at once.
+func (d *RouteDescriptor) DeleteBatch(ops []*adapter.RouteBatchOp) error {
+	routes := make([]*l3.Route, len(ops))
+	for i, op := range ops {
+		routes[i] = op.Value
+	}
+	for i, err := range d.routeHandler.VppDelRoutes(context.TODO(), routes) {
+		ops[i].Err = err
+	}
+	return nil
+}
+
+// Update replaces paths of a multipath route with the new set of paths using
+// a single request, so that the route is never left without paths. Paths are
+// not updated incrementally, VPP receives the full set of paths every time.
+func (d *RouteDescriptor) Update(key string, oldRoute, newRoute *l3.Route, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	err = d.routeHandler.VppReplaceRoutePaths(context.TODO(), newRoute)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// UpdateWithRecreate returns true unless both routes are multipath routes of the same
+// type, in which case the paths are replaced by Update.
+func (d *RouteDescriptor) UpdateWithRecreate(key string, oldRoute, newRoute *l3.Route, metadata interface{}) bool {
+	return len(oldRoute.Paths) == 0 || len(newRoute.Paths) == 0 ||
+		oldRoute.Type != newRoute.Type
+}
+
 // Retrieve returns all routes associated with interfaces managed by this agent.
 func (d *RouteDescriptor) Retrieve(correlate []adapter.RouteKVWithMetadata) (
 	retrieved []adapter.RouteKVWithMetadata, err error,
@@ -186,6 +298,13 @@ func (d *RouteDescriptor) Retrieve(correlate []adapter.RouteKVWithMetadata) (
 		route := proto.Clone(kv.Value).(*l3.Route)
 		route.DstNetwork = dstNetwork
 		route.NextHopAddr = nextHop
+		for _, path := range route.Paths {
+			parsed, err = d.addrAlloc.GetOrParseIPAddress(getPathGwAddr(kv.Value, path),
+				path.OutgoingInterface, netalloc_api.IPAddressForm_ADDR_ONLY)
+			if err == nil {
+				path.NextHopAddr = parsed.IP.String()
+			}
+		}
 		key := models.Key(route)
 		expCfg[key] = route
 		nbCfg[key] = kv.Value
@@ -197,13 +316,53 @@ func (d *RouteDescriptor) Retrieve(correlate []adapter.RouteKVWithMetadata) (
 		return nil, errors.Errorf("failed to dump VPP routes: %v", err)
 	}
 
+	// paths of multipath routes are dumped as separate routes
+	multipath := make(map[string]*l3.Route)
+	var multipathKeys []string
+	for _, route := range routes {
+		key := l3.MultipathRouteKey(route.Route.VrfId, route.Route.DstNetwork)
+		if expCfg, hasExpCfg := expCfg[key]; !hasExpCfg || len(expCfg.Paths) == 0 {
+			continue
+		}
+		if _, grouped := multipath[key]; !grouped {
+			multipath[key] = &l3.Route{
+				Type:       route.Route.Type,
+				VrfId:      route.Route.VrfId,
+				DstNetwork: route.Route.DstNetwork,
+			}
+			multipathKeys = append(multipathKeys, key)
+		}
+		path := &l3.Route_Path{
+			NextHopAddr:       route.Route.NextHopAddr,
+			OutgoingInterface: route.Route.OutgoingInterface,
+			Weight:            route.Route.Weight,
+			Preference:        route.Route.Preference,
+			ViaVrfId:          route.Route.ViaVrfId,
+		}
+		if route.Meta != nil {
+			for _, label := range route.Meta.LabelStack {
+				path.LabelStack = append(path.LabelStack, label.Label)
+			}
+		}
+		multipath[key].Paths = append(multipath[key].Paths, path)
+		route.Route = nil // grouped
+	}
+	for _, key := range multipathKeys {
+		routes = append(routes, &vppcalls.RouteDetails{Route: multipath[key]})
+	}
+
 	for _, route := range routes {
+		if route.Route == nil {
+			continue
+		}
 		key := models.Key(route.Route)
 		value := route.Route
 		origin := kvs.UnknownOrigin
 
 		// correlate with the expected configuration
 		if expCfg, hasExpCfg := expCfg[key]; hasExpCfg {
+			// BFD session reference is not known to VPP
+			value.BfdSession = expCfg.BfdSession
 			if d.EquivalentRoutes(key, value, expCfg) {
 				value = nbCfg[key]
 				// recreate the key in case the dest. IP or GW IP were replaced with netalloc link
@@ -225,11 +384,21 @@ func (d *RouteDescriptor) Retrieve(correlate []adapter.RouteKVWithMetadata) (
 // Dependencies lists dependencies for a VPP route.
 func (d *RouteDescriptor) Dependencies(key string, route *l3.Route) []kvs.Dependency {
 	var dependencies []kvs.Dependency
-	// the outgoing interface must exist and be UP
-	if route.OutgoingInterface != "" {
+	// the outgoing interface(s) must exist and be UP
+	for _, path := range vppcalls.RoutePaths(route) {
+		if path.OutgoingInterface != "" {
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: routeOutInterfaceDep + pathDepSuffix(route, path),
+				Key:   interfaces.InterfaceKey(path.OutgoingInterface),
+			})
+		}
+	}
+
+	// referenced BFD session must be up
+	if route.BfdSession != nil {
 		dependencies = append(dependencies, kvs.Dependency{
-			Label: routeOutInterfaceDep,
-			Key:   interfaces.InterfaceKey(route.OutgoingInterface),
+			Label: bfdSessionUpDep,
+			Key:   vpp_bfd.SessionStateKey(route.BfdSession, true),
 		})
 	}
 
@@ -245,11 +414,18 @@ func (d *RouteDescriptor) Dependencies(key string, route *l3.Route) []kvs.Depend
 			Key:   l3.VrfTableKey(route.VrfId, protocol),
 		})
 	}
-	if route.Type == l3.Route_INTER_VRF && route.ViaVrfId != 0 {
-		dependencies = append(dependencies, kvs.Dependency{
-			Label: viaVrfTableDep,
-			Key:   l3.VrfTableKey(route.ViaVrfId, protocol),
-		})
+	if route.Type == l3.Route_INTER_VRF {
+		viaVrfs := make(map[uint32]struct{})
+		for _, path := range vppcalls.RoutePaths(route) {
+			if _, listed := viaVrfs[path.ViaVrfId]; listed || path.ViaVrfId == 0 {
+				continue
+			}
+			viaVrfs[path.ViaVrfId] = struct{}{}
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: viaVrfTableDep + pathDepSuffix(route, path),
+				Key:   l3.VrfTableKey(path.ViaVrfId, protocol),
+			})
+		}
 	}
 
 	// if destination network is netalloc reference, then the address must be allocated first
@@ -259,10 +435,12 @@ func (d *RouteDescriptor) Dependencies(key string, route *l3.Route) []kvs.Depend
 		dependencies = append(dependencies, allocDep)
 	}
 	// if GW is netalloc reference, then the address must be allocated first
-	allocDep, hasAllocDep = d.addrAlloc.GetAddressAllocDep(route.NextHopAddr,
-		route.OutgoingInterface, "gw_addr-")
-	if hasAllocDep {
-		dependencies = append(dependencies, allocDep)
+	for _, path := range vppcalls.RoutePaths(route) {
+		allocDep, hasAllocDep = d.addrAlloc.GetAddressAllocDep(path.NextHopAddr,
+			path.OutgoingInterface, "gw_addr"+pathDepSuffix(route, path)+"-")
+		if hasAllocDep {
+			dependencies = append(dependencies, allocDep)
+		}
 	}
 
 	// TODO: perhaps check GW routability
@@ -304,12 +482,74 @@ func getGwAddr(route *l3.Route) string {
 	return net.IPv4zero.String()
 }
 
-// getWeight returns static route weight, handling the cases when it is left undefined.
-func getWeight(route *l3.Route) uint32 {
-	if route.Weight == 0 {
+// getPathGwAddr returns the GW address chosen in the given route path, handling
+// the cases when it is left undefined.
+func getPathGwAddr(route *l3.Route, path *l3.Route_Path) string {
+	return getGwAddr(&l3.Route{
+		DstNetwork:  route.GetDstNetwork(),
+		NextHopAddr: path.GetNextHopAddr(),
+	})
+}
+
+// getPathWeight returns route path weight, handling the cases when it is left undefined.
+func getPathWeight(path *l3.Route_Path) uint32 {
+	if path.Weight == 0 {
 		return defaultWeight
 	}
-	return route.Weight
+	return path.Weight
+}
+
+// equivalentPaths compares two paths of the given route.
+func equivalentPaths(route *l3.Route, path1, path2 *l3.Route_Path) bool {
+	if path1.GetOutgoingInterface() != path2.GetOutgoingInterface() ||
+		path1.GetViaVrfId() != path2.GetViaVrfId() ||
+		getPathWeight(path1) != getPathWeight(path2) ||
+		path1.GetPreference() != path2.GetPreference() ||
+		len(path1.GetLabelStack()) != len(path2.GetLabelStack()) {
+		return false
+	}
+	for i := range path1.GetLabelStack() {
+		if path1.LabelStack[i] != path2.LabelStack[i] {
+			return false
+		}
+	}
+	return equalAddrs(getPathGwAddr(route, path1), getPathGwAddr(route, path2))
+}
+
+// diffRoutePaths returns paths of the new route missing in the old route (added)
+// and paths of the old route missing in the new route (removed).
+func diffRoutePaths(oldRoute, newRoute *l3.Route) (added, removed []*l3.Route_Path) {
+	oldPaths := vppcalls.RoutePaths(oldRoute)
+	newPaths := vppcalls.RoutePaths(newRoute)
+	for _, newPath := range newPaths {
+		if !containsPath(newRoute, oldPaths, newPath) {
+			added = append(added, newPath)
+		}
+	}
+	for _, oldPath := range oldPaths {
+		if !containsPath(oldRoute, newPaths, oldPath) {
+			removed = append(removed, oldPath)
+		}
+	}
+	return added, removed
+}
+
+// containsPath returns true if the list contains path equivalent to the given one.
+func containsPath(route *l3.Route, paths []*l3.Route_Path, path *l3.Route_Path) bool {
+	for _, p := range paths {
+		if equivalentPaths(route, p, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathDepSuffix returns suffix making dependency labels of multipath route paths unique.
+func pathDepSuffix(route *l3.Route, path *l3.Route_Path) string {
+	if len(route.Paths) == 0 {
+		return ""
+	}
+	return "-" + path.GetOutgoingInterface() + "/" + path.GetNextHopAddr()
 }
 
 // equalNetworks compares two IP networks for equality.
@@ -188,10 +188,41 @@ type RouteVppAPI interface {
 
 	// VppAddRoute adds new route, according to provided input.
 	// Every route has to contain VRF ID (default is 0).
+	// All paths of a multipath route are added at once.
 	VppAddRoute(ctx context.Context, route *l3.Route) error
 	// VppDelRoute removes old route, according to provided input.
 	// Every route has to contain VRF ID (default is 0).
+	// Only the listed paths of a multipath route are removed, the route itself
+	// is removed from VPP together with the last path.
 	VppDelRoute(ctx context.Context, route *l3.Route) error
+	// VppReplaceRoutePaths replaces all paths of an existing route with the paths
+	// of the given route at once.
+	VppReplaceRoutePaths(ctx context.Context, route *l3.Route) error
+	// VppAddRoutes adds multiple routes at once, without waiting for the reply
+	// to one request before sending the next one.
+	// Returned is an error for each of the routes (nil if the route was added).
+	VppAddRoutes(ctx context.Context, routes []*l3.Route) []error
+	// VppDelRoutes removes multiple routes at once, without waiting for the reply
+	// to one request before sending the next one.
+	// Returned is an error for each of the routes (nil if the route was removed).
+	VppDelRoutes(ctx context.Context, routes []*l3.Route) []error
+}
+
+// RoutePaths returns paths of the route. For single-path route the path is built
+// from the next hop attributes of the route itself.
+func RoutePaths(route *l3.Route) []*l3.Route_Path {
+	if len(route.GetPaths()) > 0 {
+		return route.GetPaths()
+	}
+	return []*l3.Route_Path{
+		{
+			NextHopAddr:       route.GetNextHopAddr(),
+			OutgoingInterface: route.GetOutgoingInterface(),
+			Weight:            route.GetWeight(),
+			Preference:        route.GetPreference(),
+			ViaVrfId:          route.GetViaVrfId(),
+		},
+	}
 }
 
 // RouteVppRead provides read methods for routes
@@ -67,6 +67,52 @@ func TestAddRoute(t *testing.T) {
 	Expect(err).To(Not(BeNil())) // unknown interface
 }
 
+// Test adding multipath route
+func TestAddMultipathRoute(t *testing.T) {
+	ctx, _, rtHandler := routeTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPRouteAddDelReply{})
+	err := rtHandler.VppAddRoute(ctx.Context, &l3.Route{
+		VrfId:      1,
+		DstNetwork: "10.1.0.0/16",
+		Paths: []*l3.Route_Path{
+			{
+				NextHopAddr:       "192.168.30.1",
+				OutgoingInterface: "iface1",
+				Weight:            2,
+			},
+			{
+				NextHopAddr: "192.168.40.1",
+				LabelStack:  []uint32{100, 200},
+			},
+		},
+	})
+	Expect(err).To(Succeed())
+	req, ok := ctx.MockChannel.Msg.(*vpp_ip.IPRouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(req.IsAdd).To(BeTrue())
+	Expect(req.IsMultipath).To(BeTrue())
+	Expect(req.Route.NPaths).To(BeEquivalentTo(2))
+	Expect(req.Route.Paths).To(HaveLen(2))
+	Expect(req.Route.Paths[0].SwIfIndex).To(BeEquivalentTo(1))
+	Expect(req.Route.Paths[0].Weight).To(BeEquivalentTo(2))
+	Expect(req.Route.Paths[1].SwIfIndex).To(BeEquivalentTo(vpp2106.NextHopOutgoingIfUnset))
+	Expect(req.Route.Paths[1].NLabels).To(BeEquivalentTo(2))
+	Expect(req.Route.Paths[1].LabelStack[0].Label).To(BeEquivalentTo(100))
+	Expect(req.Route.Paths[1].LabelStack[1].Label).To(BeEquivalentTo(200))
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPRouteAddDelReply{})
+	err = rtHandler.VppAddRoute(ctx.Context, &l3.Route{
+		DstNetwork: "10.1.0.0/16",
+		Paths: []*l3.Route_Path{
+			{NextHopAddr: "192.168.30.1", OutgoingInterface: "iface1"},
+			{NextHopAddr: "192.168.40.1", OutgoingInterface: "iface3"},
+		},
+	})
+	Expect(err).To(Not(BeNil())) // unknown interface
+}
+
 // Test deleting routes
 func TestDeleteRoute(t *testing.T) {
 	ctx, _, rtHandler := routeTestSetup(t)
@@ -85,6 +131,76 @@ func TestDeleteRoute(t *testing.T) {
 	Expect(err).To(Not(BeNil()))
 }
 
+// Test replacing paths of multipath route
+func TestReplaceRoutePaths(t *testing.T) {
+	ctx, _, rtHandler := routeTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPRouteAddDelReply{})
+	err := rtHandler.VppReplaceRoutePaths(ctx.Context, &l3.Route{
+		VrfId:      1,
+		DstNetwork: "10.1.0.0/16",
+		Paths: []*l3.Route_Path{
+			{NextHopAddr: "192.168.30.1", OutgoingInterface: "iface1"},
+			{NextHopAddr: "192.168.40.1", Weight: 3},
+		},
+	})
+	Expect(err).To(Succeed())
+	req, ok := ctx.MockChannel.Msg.(*vpp_ip.IPRouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(req.IsAdd).To(BeTrue())
+	Expect(req.IsMultipath).To(BeFalse())
+	Expect(req.Route.NPaths).To(BeEquivalentTo(2))
+	Expect(req.Route.Paths[1].Weight).To(BeEquivalentTo(3))
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPRouteAddDelReply{Retval: 1})
+	err = rtHandler.VppReplaceRoutePaths(ctx.Context, routes[0])
+	Expect(err).To(Not(BeNil()))
+}
+
+// Test adding multiple routes at once
+func TestAddRoutes(t *testing.T) {
+	ctx, _, rtHandler := routeTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPRouteAddDelReply{})
+	ctx.MockVpp.MockReply(&vpp_ip.IPRouteAddDelReply{Retval: 1})
+	errs := rtHandler.VppAddRoutes(ctx.Context, routes)
+	Expect(errs).To(HaveLen(3))
+	Expect(errs[0]).To(Succeed())
+	Expect(errs[1]).To(Not(BeNil()))
+	Expect(errs[2]).To(Not(BeNil())) // unknown interface
+
+	// route with unknown interface is not sent
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(2))
+	for _, msg := range ctx.MockChannel.Msgs {
+		req, ok := msg.(*vpp_ip.IPRouteAddDel)
+		Expect(ok).To(BeTrue())
+		Expect(req.IsAdd).To(BeTrue())
+	}
+}
+
+// Test deleting more routes than can be pipelined at once
+func TestDeleteRoutes(t *testing.T) {
+	ctx, _, rtHandler := routeTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	var toDelete []*l3.Route
+	for i := 0; i < 100; i++ {
+		ctx.MockVpp.MockReply(&vpp_ip.IPRouteAddDelReply{})
+		toDelete = append(toDelete, routes[i%2])
+	}
+	errs := rtHandler.VppDelRoutes(ctx.Context, toDelete)
+	Expect(errs).To(HaveLen(100))
+	for _, err := range errs {
+		Expect(err).To(Succeed())
+	}
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(100))
+	req, ok := ctx.MockChannel.Msg.(*vpp_ip.IPRouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(req.IsAdd).To(BeFalse())
+}
+
 func routeTestSetup(t *testing.T) (*vppmock.TestCtx, ifvppcalls.InterfaceVppAPI, vppcalls.RouteVppAPI) {
 	ctx := vppmock.SetupTestCtx(t)
 	log := logrus.NewLogger("test-log")
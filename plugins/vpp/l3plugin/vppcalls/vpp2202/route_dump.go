@@ -131,8 +131,8 @@ func (h *RouteHandler) dumpRouteIPDetails(ipRoute vpp_ip.IPRoute) ([]*vppcalls.R
 				ViaVrfId:          viaVrfID,
 			}
 
-			labelStack := make([]vppcalls.FibMplsLabel, len(path.LabelStack))
-			for i, l := range path.LabelStack {
+			labelStack := make([]vppcalls.FibMplsLabel, path.NLabels)
+			for i, l := range path.LabelStack[:path.NLabels] {
 				labelStack[i] = vppcalls.FibMplsLabel{
 					IsUniform: uintToBool(l.IsUniform),
 					Label:     l.Label,
@@ -19,10 +19,12 @@ import (
 	"net"
 
 	"github.com/pkg/errors"
+	govppapi "go.fd.io/govpp/api"
 
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/fib_types"
 	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
 	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
 )
@@ -39,81 +41,175 @@ const (
 	// NextHopOutgoingIfUnset constant has to be assigned into the field next_hop_outgoing_interface
 	// in ip_add_del_route binary message if outgoing interface for next hop is not defined.
 	NextHopOutgoingIfUnset = ^uint32(0)
+
+	// maxInFlightRequests is the maximum number of pipelined requests waiting
+	// for reply (must not exceed the size of the reply buffer of the GoVPP channel).
+	maxInFlightRequests = 64
 )
 
 // vppAddDelRoute adds or removes route, according to provided input. Every route has to contain VRF ID (default is 0).
-func (h *RouteHandler) vppAddDelRoute(route *l3.Route, rtIfIdx uint32, delete bool) error {
+// All paths of a multipath route are added or removed at once.
+func (h *RouteHandler) vppAddDelRoute(route *l3.Route, delete bool) error {
+	req, err := h.routeAddDelRequest(route, delete)
+	if err != nil {
+		return err
+	}
+
+	reply := &vpp_ip.IPRouteAddDelReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// vppAddDelRoutes adds or removes multiple routes. Requests are pipelined - sent
+// without waiting for the replies to the preceding requests, with at most
+// maxInFlightRequests requests waiting for reply at any time.
+// Returned is an error for each route (nil if the route was added/removed).
+func (h *RouteHandler) vppAddDelRoutes(routes []*l3.Route, delete bool) []error {
+	errs := make([]error, len(routes))
+	type sentRequest struct {
+		idx    int
+		reqCtx govppapi.RequestCtx
+	}
+	var inFlight []sentRequest
+	receiveReplies := func() {
+		for _, sent := range inFlight {
+			reply := &vpp_ip.IPRouteAddDelReply{}
+			errs[sent.idx] = sent.reqCtx.ReceiveReply(reply)
+		}
+		inFlight = inFlight[:0]
+	}
+
+	for i, route := range routes {
+		req, err := h.routeAddDelRequest(route, delete)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		inFlight = append(inFlight, sentRequest{idx: i, reqCtx: h.callsChannel.SendRequest(req)})
+		if len(inFlight) == maxInFlightRequests {
+			receiveReplies()
+		}
+	}
+	receiveReplies()
+	return errs
+}
+
+// routeAddDelRequest builds request to add or remove the route.
+func (h *RouteHandler) routeAddDelRequest(route *l3.Route, delete bool) (*vpp_ip.IPRouteAddDel, error) {
 	req := &vpp_ip.IPRouteAddDel{
-		// Multi path is always true
+		// only the listed paths are added or removed
 		IsMultipath: true,
 		IsAdd:       !delete,
 	}
 
-	// Common route parameters
-	fibPath := fib_types.FibPath{
-		Weight:     uint8(route.Weight),
-		Preference: uint8(route.Preference),
-	}
-	if route.NextHopAddr != "" {
-		nextHop, err := h.addrAlloc.GetOrParseIPAddress(route.NextHopAddr,
-			route.OutgoingInterface, netalloc.IPAddressForm_ADDR_ONLY)
+	// Paths
+	var fibPaths []fib_types.FibPath
+	for _, path := range vppcalls.RoutePaths(route) {
+		fibPath, err := h.routeFibPath(route, path)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		fibPath.Nh, fibPath.Proto = setFibPathNhAndProto(nextHop.IP)
+		fibPaths = append(fibPaths, fibPath)
 	}
 
-	// VRF/Other route parameters based on type
-	if route.Type == l3.Route_INTER_VRF {
-		fibPath.SwIfIndex = rtIfIdx
-		fibPath.TableID = route.ViaVrfId
-	} else if route.Type == l3.Route_DROP {
-		fibPath.Type = fib_types.FIB_API_PATH_TYPE_DROP
-	} else {
-		fibPath.SwIfIndex = rtIfIdx
-		fibPath.TableID = route.VrfId
-	}
 	// Destination address
 	dstNet, err := h.addrAlloc.GetOrParseIPAddress(route.DstNetwork,
 		"", netalloc.IPAddressForm_ADDR_NET)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	prefix := networkToPrefix(dstNet)
 
 	req.Route = vpp_ip.IPRoute{
 		TableID: route.VrfId,
 		Prefix:  prefix,
-		NPaths:  1,
-		Paths:   []fib_types.FibPath{fibPath},
+		NPaths:  uint8(len(fibPaths)),
+		Paths:   fibPaths,
 	}
 
-	reply := &vpp_ip.IPRouteAddDelReply{}
-	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
-		return err
+	return req, nil
+}
+
+// routeFibPath builds FIB path for one path of the route.
+func (h *RouteHandler) routeFibPath(route *l3.Route, path *l3.Route_Path) (fibPath fib_types.FibPath, err error) {
+	rtIfIdx, err := h.getRouteSwIfIndex(path.OutgoingInterface)
+	if err != nil {
+		return fibPath, err
 	}
 
-	return nil
+	// Common path parameters
+	fibPath = fib_types.FibPath{
+		Weight:     uint8(path.Weight),
+		Preference: uint8(path.Preference),
+	}
+	if path.NextHopAddr != "" {
+		nextHop, err := h.addrAlloc.GetOrParseIPAddress(path.NextHopAddr,
+			path.OutgoingInterface, netalloc.IPAddressForm_ADDR_ONLY)
+		if err != nil {
+			return fibPath, err
+		}
+		fibPath.Nh, fibPath.Proto = setFibPathNhAndProto(nextHop.IP)
+	}
+
+	// VRF/Other path parameters based on route type
+	if route.Type == l3.Route_INTER_VRF {
+		fibPath.SwIfIndex = rtIfIdx
+		fibPath.TableID = path.ViaVrfId
+	} else if route.Type == l3.Route_DROP {
+		fibPath.Type = fib_types.FIB_API_PATH_TYPE_DROP
+	} else {
+		fibPath.SwIfIndex = rtIfIdx
+		fibPath.TableID = route.VrfId
+	}
+
+	// MPLS label stack
+	fibPath.NLabels = uint8(len(path.LabelStack))
+	for i, label := range path.LabelStack {
+		fibPath.LabelStack[i] = fib_types.FibMplsLabel{
+			Label: label,
+		}
+	}
+	return fibPath, nil
 }
 
 // VppAddRoute implements route handler.
 func (h *RouteHandler) VppAddRoute(ctx context.Context, route *l3.Route) error {
-	swIfIdx, err := h.getRouteSwIfIndex(route.OutgoingInterface)
-	if err != nil {
-		return err
-	}
-
-	return h.vppAddDelRoute(route, swIfIdx, false)
+	return h.vppAddDelRoute(route, false)
 }
 
 // VppDelRoute implements route handler.
 func (h *RouteHandler) VppDelRoute(ctx context.Context, route *l3.Route) error {
-	swIfIdx, err := h.getRouteSwIfIndex(route.OutgoingInterface)
+	return h.vppAddDelRoute(route, true)
+}
+
+// VppReplaceRoutePaths implements route handler.
+func (h *RouteHandler) VppReplaceRoutePaths(ctx context.Context, route *l3.Route) error {
+	req, err := h.routeAddDelRequest(route, false)
 	if err != nil {
 		return err
 	}
+	// without the multipath flag VPP replaces the paths of the route
+	req.IsMultipath = false
+
+	reply := &vpp_ip.IPRouteAddDelReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// VppAddRoutes implements route handler.
+func (h *RouteHandler) VppAddRoutes(ctx context.Context, routes []*l3.Route) []error {
+	return h.vppAddDelRoutes(routes, false)
+}
 
-	return h.vppAddDelRoute(route, swIfIdx, true)
+// VppDelRoutes implements route handler.
+func (h *RouteHandler) VppDelRoutes(ctx context.Context, routes []*l3.Route) []error {
+	return h.vppAddDelRoutes(routes, true)
 }
 
 func setFibPathNhAndProto(netIP net.IP) (nh fib_types.FibPathNh, proto fib_types.FibPathNhProto) {
@@ -0,0 +1,108 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls"
+	linuxcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/linuxcp"
+)
+
+const (
+	// LinuxCpGlobalDescriptorName is the name of the descriptor for global
+	// linux-cp settings.
+	LinuxCpGlobalDescriptorName = "vpp-linuxcp-global"
+)
+
+// LinuxCpGlobalDescriptor teaches KVScheduler how to configure global
+// settings of the VPP linux-cp plugin.
+type LinuxCpGlobalDescriptor struct {
+	log        logging.Logger
+	lcpHandler vppcalls.LinuxCpVppAPI
+}
+
+// NewLinuxCpGlobalDescriptor creates a new instance of the LinuxCpGlobal descriptor.
+func NewLinuxCpGlobalDescriptor(lcpHandler vppcalls.LinuxCpVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &LinuxCpGlobalDescriptor{
+		lcpHandler: lcpHandler,
+		log:        log.NewLogger("linuxcp-global-descriptor"),
+	}
+	typedDescr := &adapter.LinuxCpGlobalDescriptor{
+		Name:          LinuxCpGlobalDescriptorName,
+		NBKeyPrefix:   linuxcp.ModelLinuxCpGlobal.KeyPrefix(),
+		ValueTypeName: linuxcp.ModelLinuxCpGlobal.ProtoName(),
+		KeySelector:   linuxcp.ModelLinuxCpGlobal.IsKeyValid,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Update:        ctx.Update,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+	}
+	return adapter.NewLinuxCpGlobalDescriptor(typedDescr)
+}
+
+// Validate validates global linux-cp settings.
+func (d *LinuxCpGlobalDescriptor) Validate(key string, global *linuxcp.LinuxCpGlobal) error {
+	if len(global.DefaultNetns) > maxNetnsLen {
+		return kvs.NewInvalidValueError(ErrLinuxCpNetnsTooLong, "default_netns")
+	}
+	return nil
+}
+
+// Create applies global linux-cp settings.
+func (d *LinuxCpGlobalDescriptor) Create(key string, global *linuxcp.LinuxCpGlobal) (interface{}, error) {
+	_, err := d.Update(key, nil, global, nil)
+	return nil, err
+}
+
+// Update changes global linux-cp settings. Already created pairs are not
+// affected by the change of the default namespace.
+func (d *LinuxCpGlobalDescriptor) Update(key string, oldGlobal, newGlobal *linuxcp.LinuxCpGlobal, oldMetadata interface{}) (interface{}, error) {
+	if err := d.lcpHandler.SetDefaultNetns(newGlobal.DefaultNetns); err != nil {
+		return nil, errors.Errorf("failed to set linux-cp default namespace: %v", err)
+	}
+	return nil, nil
+}
+
+// Delete reverts the default namespace back to the namespace of VPP.
+func (d *LinuxCpGlobalDescriptor) Delete(key string, global *linuxcp.LinuxCpGlobal, metadata interface{}) error {
+	if err := d.lcpHandler.SetDefaultNetns(""); err != nil {
+		return errors.Errorf("failed to reset linux-cp default namespace: %v", err)
+	}
+	return nil
+}
+
+// Retrieve returns global linux-cp settings if they differ from defaults.
+func (d *LinuxCpGlobalDescriptor) Retrieve(correlate []adapter.LinuxCpGlobalKVWithMetadata) (
+	retrieved []adapter.LinuxCpGlobalKVWithMetadata, err error,
+) {
+	netns, err := d.lcpHandler.GetDefaultNetns()
+	if err != nil {
+		return nil, errors.Errorf("failed to get linux-cp default namespace: %v", err)
+	}
+	if netns == "" {
+		return nil, nil
+	}
+	retrieved = append(retrieved, adapter.LinuxCpGlobalKVWithMetadata{
+		Key:    linuxcp.GlobalKey(),
+		Value:  &linuxcp.LinuxCpGlobal{DefaultNetns: netns},
+		Origin: kvs.FromNB,
+	})
+	return retrieved, nil
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	linux_ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls"
+	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	linuxcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/linuxcp"
+)
+
+const (
+	// LinuxCpPairDescriptorName is the name of the descriptor for linux-cp interface pairs.
+	LinuxCpPairDescriptorName = "vpp-linuxcp-pair"
+
+	// dependency labels
+	interfaceDep = "interface-exists"
+
+	// maximum length of the host interface name and of the namespace name
+	maxHostIfNameLen = 15
+	maxNetnsLen      = 31
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrLinuxCpPairWithoutInterface is returned when linux-cp pair has no VPP interface defined.
+	ErrLinuxCpPairWithoutInterface = errors.New("linux-cp pair defined without VPP interface")
+
+	// ErrLinuxCpPairWithoutHostIfName is returned when linux-cp pair has no host interface name defined.
+	ErrLinuxCpPairWithoutHostIfName = errors.New("linux-cp pair defined without host interface name")
+
+	// ErrLinuxCpHostIfNameTooLong is returned when the host interface name exceeds 15 characters.
+	ErrLinuxCpHostIfNameTooLong = errors.Errorf("host interface name cannot be longer than %d characters", maxHostIfNameLen)
+
+	// ErrLinuxCpNetnsTooLong is returned when the namespace name exceeds 31 characters.
+	ErrLinuxCpNetnsTooLong = errors.Errorf("namespace name cannot be longer than %d characters", maxNetnsLen)
+)
+
+// LinuxCpPairDescriptor teaches KVScheduler how to configure VPP linux-cp interface pairs.
+type LinuxCpPairDescriptor struct {
+	log        logging.Logger
+	lcpHandler vppcalls.LinuxCpVppAPI
+}
+
+// NewLinuxCpPairDescriptor creates a new instance of the LinuxCpPair descriptor.
+func NewLinuxCpPairDescriptor(lcpHandler vppcalls.LinuxCpVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &LinuxCpPairDescriptor{
+		lcpHandler: lcpHandler,
+		log:        log.NewLogger("linuxcp-pair-descriptor"),
+	}
+	typedDescr := &adapter.LinuxCpPairDescriptor{
+		Name:               LinuxCpPairDescriptorName,
+		NBKeyPrefix:        linuxcp.ModelLinuxCpPair.KeyPrefix(),
+		ValueTypeName:      linuxcp.ModelLinuxCpPair.ProtoName(),
+		KeySelector:        linuxcp.ModelLinuxCpPair.IsKeyValid,
+		KeyLabel:           linuxcp.ModelLinuxCpPair.StripKeyPrefix,
+		Validate:           ctx.Validate,
+		Create:             ctx.Create,
+		Delete:             ctx.Delete,
+		UpdateWithRecreate: ctx.UpdateWithRecreate,
+		Retrieve:           ctx.Retrieve,
+		Dependencies:       ctx.Dependencies,
+		DerivedValues:      ctx.DerivedValues,
+		RetrieveDependencies: []string{
+			ifdescriptor.InterfaceDescriptorName,
+			LinuxCpGlobalDescriptorName,
+			// the host side is retrieved (with metadata) by Linux ifplugin first
+			linux_ifdescriptor.InterfaceDescriptorName,
+		},
+	}
+	return adapter.NewLinuxCpPairDescriptor(typedDescr)
+}
+
+// Validate validates linux-cp interface pair configuration.
+func (d *LinuxCpPairDescriptor) Validate(key string, pair *linuxcp.LinuxCpPair) error {
+	if pair.Interface == "" {
+		return kvs.NewInvalidValueError(ErrLinuxCpPairWithoutInterface, "interface")
+	}
+	if pair.HostIfName == "" {
+		return kvs.NewInvalidValueError(ErrLinuxCpPairWithoutHostIfName, "host_if_name")
+	}
+	if len(pair.HostIfName) > maxHostIfNameLen {
+		return kvs.NewInvalidValueError(ErrLinuxCpHostIfNameTooLong, "host_if_name")
+	}
+	if len(pair.Netns) > maxNetnsLen {
+		return kvs.NewInvalidValueError(ErrLinuxCpNetnsTooLong, "netns")
+	}
+	return nil
+}
+
+// Create creates linux-cp interface pair.
+func (d *LinuxCpPairDescriptor) Create(key string, pair *linuxcp.LinuxCpPair) (interface{}, error) {
+	if _, err := d.lcpHandler.AddLinuxCpPair(pair); err != nil {
+		return nil, errors.Errorf("failed to add linux-cp pair for interface %s: %v", pair.Interface, err)
+	}
+	return nil, nil
+}
+
+// Delete removes linux-cp interface pair together with the host interface.
+func (d *LinuxCpPairDescriptor) Delete(key string, pair *linuxcp.LinuxCpPair, metadata interface{}) error {
+	if err := d.lcpHandler.DeleteLinuxCpPair(pair); err != nil {
+		return errors.Errorf("failed to delete linux-cp pair for interface %s: %v", pair.Interface, err)
+	}
+	return nil
+}
+
+// UpdateWithRecreate returns true if the pair itself has changed - linux-cp
+// pair cannot be modified. Configuration of the host side is updated through
+// the derived Linux interface.
+func (d *LinuxCpPairDescriptor) UpdateWithRecreate(key string, oldPair, newPair *linuxcp.LinuxCpPair, metadata interface{}) bool {
+	return oldPair.Interface != newPair.Interface ||
+		oldPair.HostIfName != newPair.HostIfName ||
+		oldPair.HostIfType != newPair.HostIfType ||
+		oldPair.Netns != newPair.Netns
+}
+
+// Dependencies lists the paired VPP interface as the only dependency.
+func (d *LinuxCpPairDescriptor) Dependencies(key string, pair *linuxcp.LinuxCpPair) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: interfaceDep,
+			Key:   interfaces.InterfaceKey(pair.Interface),
+		},
+	}
+}
+
+// DerivedValues derives Linux interface of type LINUX_CP representing
+// the host side of the pair.
+func (d *LinuxCpPairDescriptor) DerivedValues(key string, pair *linuxcp.LinuxCpPair) []kvs.KeyValuePair {
+	linuxIf := hostInterface(pair)
+	return []kvs.KeyValuePair{
+		{
+			Key:   linux_interfaces.InterfaceKey(linuxIf.Name),
+			Value: linuxIf,
+		},
+	}
+}
+
+// Retrieve returns all linux-cp interface pairs configured in VPP.
+func (d *LinuxCpPairDescriptor) Retrieve(correlate []adapter.LinuxCpPairKVWithMetadata) (
+	retrieved []adapter.LinuxCpPairKVWithMetadata, err error,
+) {
+	nbPairs := make(map[string]*linuxcp.LinuxCpPair)
+	for _, kv := range correlate {
+		nbPairs[kv.Value.Interface] = kv.Value
+	}
+
+	pairs, err := d.lcpHandler.DumpLinuxCpPairs()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump linux-cp pairs: %v", err)
+	}
+	defaultNetns, err := d.lcpHandler.GetDefaultNetns()
+	if err != nil {
+		return nil, errors.Errorf("failed to get linux-cp default namespace: %v", err)
+	}
+
+	for _, pairDetails := range pairs {
+		pair := pairDetails.Pair
+		// VPP returns the namespace resolved when the pair was created
+		if nbPair, hasNbPair := nbPairs[pair.Interface]; hasNbPair {
+			if nbPair.Netns == "" && pair.Netns == defaultNetns {
+				pair.Netns = ""
+			}
+			// configuration of the host side is retrieved with the derived Linux interface
+			pair.LinuxIfName = nbPair.LinuxIfName
+			pair.HostIpAddresses = nbPair.HostIpAddresses
+			pair.HostMtu = nbPair.HostMtu
+		}
+		retrieved = append(retrieved, adapter.LinuxCpPairKVWithMetadata{
+			Key:    linuxcp.PairKey(pair.Interface),
+			Value:  pair,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// hostInterface returns Linux interface representing the host side of the pair.
+func hostInterface(pair *linuxcp.LinuxCpPair) *linux_interfaces.Interface {
+	linuxIf := &linux_interfaces.Interface{
+		Name:        pair.LinuxIfName,
+		Type:        linux_interfaces.Interface_LINUX_CP,
+		HostIfName:  pair.HostIfName,
+		Enabled:     true,
+		IpAddresses: pair.HostIpAddresses,
+		Mtu:         pair.HostMtu,
+		Link: &linux_interfaces.Interface_LinuxCp{
+			LinuxCp: &linux_interfaces.LinuxCpLink{
+				VppIfName: pair.Interface,
+			},
+		},
+	}
+	if linuxIf.Name == "" {
+		linuxIf.Name = pair.HostIfName
+	}
+	if pair.Netns != "" {
+		linuxIf.Namespace = &linux_namespace.NetNamespace{
+			Type:      linux_namespace.NetNamespace_NSID,
+			Reference: pair.Netns,
+		}
+	}
+	return linuxIf
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name LinuxCpPair --value-type *vpp_linuxcp.LinuxCpPair --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/linuxcp" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name LinuxCpGlobal --value-type *vpp_linuxcp.LinuxCpGlobal --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/linuxcp" --output-dir "descriptor"
+
+package linuxcpplugin
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls/vpp2101"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls/vpp2106"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls/vpp2210"
+)
+
+// LinuxCpPlugin configures VPP linux-cp interface pairs using GoVPP.
+type LinuxCpPlugin struct {
+	Deps
+
+	// handlers
+	lcpHandler vppcalls.LinuxCpVppAPI
+}
+
+// Deps lists dependencies of the linux-cp plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+// Init registers linux-cp related descriptors.
+func (p *LinuxCpPlugin) Init() (err error) {
+	if !p.VPP.IsPluginLoaded("linux_cp") {
+		p.Log.Warnf("VPP plugin linux-cp was disabled by VPP")
+		return nil
+	}
+
+	// init handlers
+	p.lcpHandler = vppcalls.CompatibleLinuxCpVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.lcpHandler == nil {
+		return errors.New("linux-cp VPP handler is not available")
+	}
+
+	// init and register descriptors
+	globalDescriptor := descriptor.NewLinuxCpGlobalDescriptor(p.lcpHandler, p.Log)
+	pairDescriptor := descriptor.NewLinuxCpPairDescriptor(p.lcpHandler, p.Log)
+
+	err = p.KVScheduler.RegisterKVDescriptor(
+		globalDescriptor,
+		pairDescriptor,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *LinuxCpPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
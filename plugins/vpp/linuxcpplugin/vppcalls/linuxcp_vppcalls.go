@@ -0,0 +1,89 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	linuxcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/linuxcp"
+)
+
+// LinuxCpPairDetails contains proto-modelled linux-cp interface pair
+// together with VPP specific metadata.
+type LinuxCpPairDetails struct {
+	Pair *linuxcp.LinuxCpPair
+	Meta *LinuxCpPairMeta
+}
+
+// LinuxCpPairMeta contains VPP indexes of both sides of the pair.
+type LinuxCpPairMeta struct {
+	PhySwIfIndex  uint32
+	HostSwIfIndex uint32
+	VifIndex      uint32
+}
+
+// LinuxCpVppAPI provides methods for managing VPP linux-cp interface pairs.
+type LinuxCpVppAPI interface {
+	LinuxCpVppRead
+
+	// AddLinuxCpPair creates linux-cp interface pair for the given VPP interface
+	// and returns the index of the VPP side of the created host interface.
+	AddLinuxCpPair(pair *linuxcp.LinuxCpPair) (hostSwIfIndex uint32, err error)
+	// DeleteLinuxCpPair removes linux-cp interface pair of the given VPP interface.
+	DeleteLinuxCpPair(pair *linuxcp.LinuxCpPair) error
+	// SetDefaultNetns sets the default namespace for host interfaces.
+	SetDefaultNetns(netns string) error
+}
+
+// LinuxCpVppRead provides read methods for VPP linux-cp interface pairs.
+type LinuxCpVppRead interface {
+	// DumpLinuxCpPairs dumps all linux-cp interface pairs configured in VPP.
+	DumpLinuxCpPairs() ([]*LinuxCpPairDetails, error)
+	// GetDefaultNetns returns the default namespace for host interfaces.
+	GetDefaultNetns() (string, error)
+}
+
+var handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "linuxcp",
+	HandlerAPI: (*LinuxCpVppAPI)(nil),
+})
+
+type NewHandlerFunc func(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) LinuxCpVppAPI
+
+func AddLinuxCpHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			return h(c, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleLinuxCpVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) LinuxCpVppAPI {
+	if v := handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(LinuxCpVppAPI)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2101
+
+import (
+	"github.com/pkg/errors"
+
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface_types"
+	vpp_lcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/lcp"
+	linuxcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/linuxcp"
+)
+
+// AddLinuxCpPair creates linux-cp interface pair for the given VPP interface.
+// The VPP side of the created host interface is tagged so that it can be
+// recognized as owned by the pair.
+func (h *LinuxCpVppHandler) AddLinuxCpPair(pair *linuxcp.LinuxCpPair) (hostSwIfIndex uint32, err error) {
+	meta, found := h.ifIndexes.LookupByName(pair.Interface)
+	if !found {
+		return 0, errors.Errorf("failed to get index for interface: %s", pair.Interface)
+	}
+
+	req := &vpp_lcp.LcpItfPairAddDelV2{
+		IsAdd:      true,
+		SwIfIndex:  interface_types.InterfaceIndex(meta.SwIfIndex),
+		HostIfName: pair.HostIfName,
+		HostIfType: hostIfTypeToVpp(pair.HostIfType),
+		Netns:      pair.Netns,
+	}
+	reply := &vpp_lcp.LcpItfPairAddDelV2Reply{}
+	if err = h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	hostSwIfIndex = uint32(reply.HostSwIfIndex)
+
+	tagReq := &vpp_ifs.SwInterfaceTagAddDel{
+		IsAdd:     true,
+		SwIfIndex: reply.HostSwIfIndex,
+		Tag:       linuxcp.HostInterfaceTag(pair.Interface),
+	}
+	tagReply := &vpp_ifs.SwInterfaceTagAddDelReply{}
+	if err = h.callsChannel.SendRequest(tagReq).ReceiveReply(tagReply); err != nil {
+		return hostSwIfIndex, errors.Errorf("failed to tag host interface of linux-cp pair %s: %v",
+			pair.Interface, err)
+	}
+	return hostSwIfIndex, nil
+}
+
+// DeleteLinuxCpPair removes linux-cp interface pair of the given VPP interface.
+func (h *LinuxCpVppHandler) DeleteLinuxCpPair(pair *linuxcp.LinuxCpPair) error {
+	meta, found := h.ifIndexes.LookupByName(pair.Interface)
+	if !found {
+		return errors.Errorf("failed to get index for interface: %s", pair.Interface)
+	}
+
+	req := &vpp_lcp.LcpItfPairAddDelV2{
+		IsAdd:     false,
+		SwIfIndex: interface_types.InterfaceIndex(meta.SwIfIndex),
+	}
+	reply := &vpp_lcp.LcpItfPairAddDelV2Reply{}
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+// SetDefaultNetns sets the default namespace for host interfaces.
+func (h *LinuxCpVppHandler) SetDefaultNetns(netns string) error {
+	req := &vpp_lcp.LcpDefaultNsSet{
+		Netns: netns,
+	}
+	reply := &vpp_lcp.LcpDefaultNsSetReply{}
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func hostIfTypeToVpp(hostIfType linuxcp.LinuxCpPair_HostIfType) vpp_lcp.LcpItfHostType {
+	if hostIfType == linuxcp.LinuxCpPair_TUN {
+		return vpp_lcp.LCP_API_ITF_HOST_TUN
+	}
+	return vpp_lcp.LCP_API_ITF_HOST_TAP
+}
+
+func hostIfTypeFromVpp(hostIfType vpp_lcp.LcpItfHostType) linuxcp.LinuxCpPair_HostIfType {
+	if hostIfType == vpp_lcp.LCP_API_ITF_HOST_TUN {
+		return linuxcp.LinuxCpPair_TUN
+	}
+	return linuxcp.LinuxCpPair_TAP
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2101
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101"
+	vpp_lcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/lcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls"
+)
+
+func init() {
+	var msgs []govppapi.Message
+	msgs = append(msgs, vpp_lcp.AllMessages()...)
+
+	vppcalls.AddLinuxCpHandlerVersion(vpp2101.Version, msgs, NewLinuxCpVppHandler)
+}
+
+// LinuxCpVppHandler is accessor for linux-cp related vppcalls methods.
+type LinuxCpVppHandler struct {
+	callsChannel govppapi.Channel
+	lcp          vpp_lcp.RPCService
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+// NewLinuxCpVppHandler creates new instance of linux-cp vppcalls handler.
+func NewLinuxCpVppHandler(c vpp.Client, ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger,
+) vppcalls.LinuxCpVppAPI {
+	callsChan, _ := c.NewAPIChannel()
+	return &LinuxCpVppHandler{
+		callsChannel: callsChan,
+		lcp:          vpp_lcp.NewServiceClient(c),
+		ifIndexes:    ifIndexes,
+		log:          log,
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	vpp_lcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/lcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls"
+	linuxcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/linuxcp"
+)
+
+// DumpLinuxCpPairs dumps all linux-cp interface pairs configured in VPP.
+func (h *LinuxCpVppHandler) DumpLinuxCpPairs() (pairs []*vppcalls.LinuxCpPairDetails, err error) {
+	var cursor uint32
+	for {
+		rpcServ, err := h.lcp.LcpItfPairGet(context.Background(), &vpp_lcp.LcpItfPairGet{
+			Cursor: cursor,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump linux-cp interface pairs: %v", err)
+		}
+	RecvLoop:
+		for {
+			details, reply, err := rpcServ.Recv()
+			if err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to dump linux-cp interface pairs: %v", err)
+			}
+			if reply != nil {
+				if reply.Cursor == cursor || reply.Cursor == ^uint32(0) {
+					return pairs, nil
+				}
+				cursor = reply.Cursor
+				break RecvLoop
+			}
+			if details != nil {
+				ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(details.PhySwIfIndex))
+				if !found {
+					h.log.Warnf("Interface with index %d not found in the mapping", details.PhySwIfIndex)
+					continue
+				}
+				pairs = append(pairs, &vppcalls.LinuxCpPairDetails{
+					Pair: &linuxcp.LinuxCpPair{
+						Interface:  ifName,
+						HostIfName: strings.TrimRight(details.HostIfName, "\x00"),
+						HostIfType: hostIfTypeFromVpp(details.HostIfType),
+						Netns:      strings.TrimRight(details.Netns, "\x00"),
+					},
+					Meta: &vppcalls.LinuxCpPairMeta{
+						PhySwIfIndex:  uint32(details.PhySwIfIndex),
+						HostSwIfIndex: uint32(details.HostSwIfIndex),
+						VifIndex:      details.VifIndex,
+					},
+				})
+			}
+		}
+	}
+}
+
+// GetDefaultNetns returns the default namespace for host interfaces.
+func (h *LinuxCpVppHandler) GetDefaultNetns() (string, error) {
+	req := &vpp_lcp.LcpDefaultNsGet{}
+	reply := &vpp_lcp.LcpDefaultNsGetReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return "", err
+	}
+	return strings.TrimRight(reply.Netns, "\x00"), nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface"
+	vpp_lcp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/lcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/linuxcpplugin/vppcalls/vpp2210"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	linuxcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/linuxcp"
+)
+
+func TestAddLinuxCpPair(t *testing.T) {
+	ctx, lcpHandler, ifIndexes := lcpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("memif1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_lcp.LcpItfPairAddDelV2Reply{HostSwIfIndex: 5})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+	hostSwIfIndex, err := lcpHandler.AddLinuxCpPair(&linuxcp.LinuxCpPair{
+		Interface:  "memif1",
+		HostIfName: "host-memif1",
+		HostIfType: linuxcp.LinuxCpPair_TUN,
+		Netns:      "dataplane",
+	})
+	Expect(err).To(BeNil())
+	Expect(hostSwIfIndex).To(BeEquivalentTo(5))
+	Expect(ctx.MockChannel.Msgs).To(HaveLen(2))
+
+	vppMsg, ok := ctx.MockChannel.Msgs[0].(*vpp_lcp.LcpItfPairAddDelV2)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.HostIfName).To(Equal("host-memif1"))
+	Expect(vppMsg.HostIfType).To(Equal(vpp_lcp.LCP_API_ITF_HOST_TUN))
+	Expect(vppMsg.Netns).To(Equal("dataplane"))
+
+	tagMsg, ok := ctx.MockChannel.Msgs[1].(*vpp_ifs.SwInterfaceTagAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(tagMsg.IsAdd).To(BeTrue())
+	Expect(tagMsg.SwIfIndex).To(BeEquivalentTo(5))
+	Expect(tagMsg.Tag).To(Equal(linuxcp.HostInterfaceTag("memif1")))
+}
+
+func TestAddLinuxCpPairError(t *testing.T) {
+	ctx, lcpHandler, ifIndexes := lcpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// unknown interface
+	_, err := lcpHandler.AddLinuxCpPair(&linuxcp.LinuxCpPair{
+		Interface:  "memif1",
+		HostIfName: "host-memif1",
+	})
+	Expect(err).ToNot(BeNil())
+
+	ifIndexes.Put("memif1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ctx.MockVpp.MockReply(&vpp_lcp.LcpItfPairAddDelV2Reply{Retval: -1})
+	_, err = lcpHandler.AddLinuxCpPair(&linuxcp.LinuxCpPair{
+		Interface:  "memif1",
+		HostIfName: "host-memif1",
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDeleteLinuxCpPair(t *testing.T) {
+	ctx, lcpHandler, ifIndexes := lcpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("memif1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_lcp.LcpItfPairAddDelV2Reply{})
+	err := lcpHandler.DeleteLinuxCpPair(&linuxcp.LinuxCpPair{
+		Interface:  "memif1",
+		HostIfName: "host-memif1",
+	})
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_lcp.LcpItfPairAddDelV2)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeFalse())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(1))
+}
+
+func TestDefaultNetns(t *testing.T) {
+	ctx, lcpHandler, _ := lcpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_lcp.LcpDefaultNsSetReply{})
+	err := lcpHandler.SetDefaultNetns("dataplane")
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_lcp.LcpDefaultNsSet)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Netns).To(Equal("dataplane"))
+
+	ctx.MockVpp.MockReply(&vpp_lcp.LcpDefaultNsGetReply{Netns: "dataplane"})
+	netns, err := lcpHandler.GetDefaultNetns()
+	Expect(err).To(BeNil())
+	Expect(netns).To(Equal("dataplane"))
+}
+
+func TestDumpLinuxCpPairs(t *testing.T) {
+	ctx, lcpHandler, ifIndexes := lcpTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("memif1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndexes.Put("memif2", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(
+		&vpp_lcp.LcpItfPairDetails{
+			PhySwIfIndex:  1,
+			HostSwIfIndex: 5,
+			VifIndex:      10,
+			HostIfName:    "host-memif1",
+			HostIfType:    vpp_lcp.LCP_API_ITF_HOST_TAP,
+		},
+		&vpp_lcp.LcpItfPairDetails{
+			PhySwIfIndex:  2,
+			HostSwIfIndex: 6,
+			VifIndex:      11,
+			HostIfName:    "host-memif2",
+			HostIfType:    vpp_lcp.LCP_API_ITF_HOST_TUN,
+			Netns:         "dataplane",
+		},
+		&vpp_lcp.LcpItfPairGetReply{
+			Retval: 0,
+			Cursor: ^uint32(0),
+		})
+
+	pairs, err := lcpHandler.DumpLinuxCpPairs()
+	Expect(err).To(BeNil())
+	Expect(pairs).To(HaveLen(2))
+
+	Expect(pairs[0].Pair.Interface).To(Equal("memif1"))
+	Expect(pairs[0].Pair.HostIfName).To(Equal("host-memif1"))
+	Expect(pairs[0].Pair.HostIfType).To(Equal(linuxcp.LinuxCpPair_TAP))
+	Expect(pairs[0].Pair.Netns).To(BeEmpty())
+	Expect(pairs[0].Meta.HostSwIfIndex).To(BeEquivalentTo(5))
+	Expect(pairs[0].Meta.VifIndex).To(BeEquivalentTo(10))
+
+	Expect(pairs[1].Pair.Interface).To(Equal("memif2"))
+	Expect(pairs[1].Pair.HostIfType).To(Equal(linuxcp.LinuxCpPair_TUN))
+	Expect(pairs[1].Pair.Netns).To(Equal("dataplane"))
+	Expect(pairs[1].Meta.PhySwIfIndex).To(BeEquivalentTo(2))
+}
+
+func lcpTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.LinuxCpVppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	logger := logrus.NewLogger("test-log")
+	ifIndexes := ifaceidx.NewIfaceIndex(logger, "lcp-if-idx")
+	lcpHandler := vpp2210.NewLinuxCpVppHandler(ctx.MockVPPClient, ifIndexes, logrus.DefaultLogger())
+	return ctx, lcpHandler, ifIndexes
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	mpls "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/mpls"
+)
+
+const (
+	// LocalLabelDescriptorName is the name of the descriptor for MPLS local labels.
+	LocalLabelDescriptorName = "vpp-mpls-local-label"
+
+	// dependency labels
+	localLabelTableDep  = "mpls-table-exists"
+	localLabelOutIfDep  = "outgoing-interface-exists"
+	localLabelViaVrfDep = "via-vrf-table-exists"
+
+	// labels 0-15 are reserved, label is a 20-bit value
+	minLabelValue = 16
+	maxLabelValue = 0xfffff
+
+	// maximum number of labels VPP is able to impose on a path
+	maxLabelStackDepth = 16
+
+	// weight used by VPP for paths without weight defined
+	defaultPathWeight = 1
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrLocalLabelReserved is returned when local label is from the reserved range.
+	ErrLocalLabelReserved = errors.New("MPLS labels 0-15 are reserved")
+	// ErrInvalidMplsLabel is returned when MPLS label exceeds 20 bits.
+	ErrInvalidMplsLabel = errors.New("MPLS label exceeds the maximum value (1048575)")
+	// ErrLocalLabelWithoutPath is returned when local label entry has no path defined.
+	ErrLocalLabelWithoutPath = errors.New("MPLS local label entry has no path defined")
+	// ErrLabelStackTooDeep is returned when path imposes too many labels.
+	ErrLabelStackTooDeep = errors.New("MPLS label stack exceeds the maximum depth (16)")
+	// ErrInvalidNextHop is returned when next hop of a path is not a valid IP address.
+	ErrInvalidNextHop = errors.New("next hop is not a valid IP address")
+)
+
+// LocalLabelDescriptor teaches KVScheduler how to configure VPP MPLS local label entries.
+type LocalLabelDescriptor struct {
+	log         logging.Logger
+	mplsHandler vppcalls.MplsVppAPI
+}
+
+// NewLocalLabelDescriptor creates a new instance of the LocalLabel descriptor.
+func NewLocalLabelDescriptor(
+	mplsHandler vppcalls.MplsVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &LocalLabelDescriptor{
+		mplsHandler: mplsHandler,
+		log:         log.NewLogger("mpls-local-label-descriptor"),
+	}
+	typedDescr := &adapter.LocalLabelDescriptor{
+		Name:                 LocalLabelDescriptorName,
+		NBKeyPrefix:          mpls.ModelLocalLabel.KeyPrefix(),
+		ValueTypeName:        mpls.ModelLocalLabel.ProtoName(),
+		KeySelector:          mpls.ModelLocalLabel.IsKeyValid,
+		KeyLabel:             mpls.ModelLocalLabel.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentLocalLabels,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName, MplsTableDescriptorName},
+	}
+	return adapter.NewLocalLabelDescriptor(typedDescr)
+}
+
+// EquivalentLocalLabels is a comparison function for mpls.LocalLabel.
+// Paths are compared regardless of their order.
+func (d *LocalLabelDescriptor) EquivalentLocalLabels(key string, oldLabel, newLabel *mpls.LocalLabel) bool {
+	if len(oldLabel.Paths) != len(newLabel.Paths) {
+		return false
+	}
+	for _, oldPath := range oldLabel.Paths {
+		var found bool
+		for _, newPath := range newLabel.Paths {
+			if equivalentPaths(oldPath, newPath) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate validates configuration of MPLS local label entry.
+func (d *LocalLabelDescriptor) Validate(key string, localLabel *mpls.LocalLabel) error {
+	if localLabel.Label < minLabelValue {
+		return kvs.NewInvalidValueError(ErrLocalLabelReserved, "label")
+	}
+	if localLabel.Label > maxLabelValue {
+		return kvs.NewInvalidValueError(ErrInvalidMplsLabel, "label")
+	}
+	if len(localLabel.Paths) == 0 {
+		return kvs.NewInvalidValueError(ErrLocalLabelWithoutPath, "paths")
+	}
+	for i, path := range localLabel.Paths {
+		field := fmt.Sprintf("paths[%d]", i)
+		if path.NextHopAddr != "" && net.ParseIP(path.NextHopAddr) == nil {
+			return kvs.NewInvalidValueError(ErrInvalidNextHop, field+".next_hop_addr")
+		}
+		if len(path.LabelStack) > maxLabelStackDepth {
+			return kvs.NewInvalidValueError(ErrLabelStackTooDeep, field+".label_stack")
+		}
+		for _, label := range path.LabelStack {
+			if label > maxLabelValue {
+				return kvs.NewInvalidValueError(ErrInvalidMplsLabel, field+".label_stack")
+			}
+		}
+	}
+	return nil
+}
+
+// Create adds VPP MPLS local label entry.
+func (d *LocalLabelDescriptor) Create(key string, localLabel *mpls.LocalLabel) (interface{}, error) {
+	return nil, d.mplsHandler.AddLocalLabel(localLabel)
+}
+
+// Delete removes VPP MPLS local label entry.
+func (d *LocalLabelDescriptor) Delete(key string, localLabel *mpls.LocalLabel, metadata interface{}) error {
+	return d.mplsHandler.DelLocalLabel(localLabel)
+}
+
+// Retrieve returns all MPLS local label entries configured in VPP.
+func (d *LocalLabelDescriptor) Retrieve(correlate []adapter.LocalLabelKVWithMetadata) (
+	retrieved []adapter.LocalLabelKVWithMetadata, err error,
+) {
+	localLabels, err := d.mplsHandler.DumpLocalLabels()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump VPP MPLS local labels: %v", err)
+	}
+	for _, localLabel := range localLabels {
+		retrieved = append(retrieved, adapter.LocalLabelKVWithMetadata{
+			Key:    mpls.LocalLabelKey(localLabel.TableId, localLabel.Label, localLabel.Eos, localLabel.EosProtocol),
+			Value:  localLabel,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the MPLS table, outgoing interfaces and via VRF tables
+// of the entry paths as dependencies.
+func (d *LocalLabelDescriptor) Dependencies(key string, localLabel *mpls.LocalLabel) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: localLabelTableDep,
+		Key:   mpls.MplsTableKey(localLabel.TableId),
+	})
+	for i, path := range localLabel.Paths {
+		if path.OutgoingInterface != "" {
+			deps = append(deps, kvs.Dependency{
+				Label: fmt.Sprintf("%s-%d", localLabelOutIfDep, i),
+				Key:   interfaces.InterfaceKey(path.OutgoingInterface),
+			})
+		}
+		if path.ViaVrfId != 0 {
+			deps = append(deps, kvs.Dependency{
+				Label: fmt.Sprintf("%s-%d", localLabelViaVrfDep, i),
+				Key:   l3.VrfTableKey(path.ViaVrfId, getPathVrfProtocol(localLabel, path)),
+			})
+		}
+	}
+	return deps
+}
+
+// getPathVrfProtocol returns protocol of the VRF table used for the path lookup.
+func getPathVrfProtocol(localLabel *mpls.LocalLabel, path *l3.Route_Path) l3.VrfTable_Protocol {
+	if ip := net.ParseIP(path.NextHopAddr); ip != nil {
+		if ip.To4() == nil {
+			return l3.VrfTable_IPV6
+		}
+		return l3.VrfTable_IPV4
+	}
+	if localLabel.Eos && localLabel.EosProtocol == mpls.LocalLabel_IPV6 {
+		return l3.VrfTable_IPV6
+	}
+	return l3.VrfTable_IPV4
+}
+
+// equivalentPaths compares two paths with default weight and next hop address normalized.
+func equivalentPaths(path1, path2 *l3.Route_Path) bool {
+	if getPathWeight(path1) != getPathWeight(path2) ||
+		!equalAddrs(path1.NextHopAddr, path2.NextHopAddr) {
+		return false
+	}
+	p1 := proto.Clone(path1).(*l3.Route_Path)
+	p2 := proto.Clone(path2).(*l3.Route_Path)
+	p1.Weight, p2.Weight = 0, 0
+	p1.NextHopAddr, p2.NextHopAddr = "", ""
+	return proto.Equal(p1, p2)
+}
+
+func getPathWeight(path *l3.Route_Path) uint32 {
+	if path.Weight == 0 {
+		return defaultPathWeight
+	}
+	return path.Weight
+}
+
+// equalAddrs compares two IP addresses for equality.
+func equalAddrs(addr1, addr2 string) bool {
+	a1 := net.ParseIP(addr1)
+	a2 := net.ParseIP(addr2)
+	if a1 == nil || a2 == nil {
+		// if parsing fails, compare as strings
+		return addr1 == addr2
+	}
+	return a1.Equal(a2)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	mpls "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/mpls"
+)
+
+const (
+	// MplsInterfaceDescriptorName is the name of the descriptor for interfaces with enabled MPLS.
+	MplsInterfaceDescriptorName = "vpp-mpls-interface"
+
+	// ID of the default MPLS table
+	defaultMplsTableID = 0
+
+	// dependency labels
+	mplsInterfaceDep    = "interface-exists"
+	mplsDefaultTableDep = "default-mpls-table-exists"
+)
+
+// MplsInterfaceDescriptor teaches KVScheduler how to enable MPLS forwarding on VPP interfaces.
+type MplsInterfaceDescriptor struct {
+	log         logging.Logger
+	mplsHandler vppcalls.MplsVppAPI
+	ifIndexes   ifaceidx.IfaceMetadataIndex
+}
+
+// NewMplsInterfaceDescriptor creates a new instance of the MplsInterface descriptor.
+func NewMplsInterfaceDescriptor(mplsHandler vppcalls.MplsVppAPI,
+	ifIndexes ifaceidx.IfaceMetadataIndex, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &MplsInterfaceDescriptor{
+		mplsHandler: mplsHandler,
+		ifIndexes:   ifIndexes,
+		log:         log.NewLogger("mpls-interface-descriptor"),
+	}
+	// Note: VPP does not provide a dump of interfaces with enabled MPLS,
+	// therefore the descriptor does not implement Retrieve.
+	typedDescr := &adapter.MplsInterfaceDescriptor{
+		Name:          MplsInterfaceDescriptorName,
+		NBKeyPrefix:   mpls.ModelMplsInterface.KeyPrefix(),
+		ValueTypeName: mpls.ModelMplsInterface.ProtoName(),
+		KeySelector:   mpls.ModelMplsInterface.IsKeyValid,
+		KeyLabel:      mpls.ModelMplsInterface.StripKeyPrefix,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Dependencies:  ctx.Dependencies,
+	}
+	return adapter.NewMplsInterfaceDescriptor(typedDescr)
+}
+
+// Validate validates configuration of interface with enabled MPLS.
+func (d *MplsInterfaceDescriptor) Validate(key string, mplsIf *mpls.MplsInterface) error {
+	if mplsIf.Name == "" {
+		return kvs.NewInvalidValueError(errors.New("no interface defined"), "name")
+	}
+	return nil
+}
+
+// Create enables MPLS forwarding on the interface.
+func (d *MplsInterfaceDescriptor) Create(key string, mplsIf *mpls.MplsInterface) (interface{}, error) {
+	ifMeta, found := d.ifIndexes.LookupByName(mplsIf.Name)
+	if !found {
+		return nil, errors.Errorf("failed to find interface %s", mplsIf.Name)
+	}
+	return nil, d.mplsHandler.EnableInterfaceMpls(ifMeta.SwIfIndex)
+}
+
+// Delete disables MPLS forwarding on the interface.
+func (d *MplsInterfaceDescriptor) Delete(key string, mplsIf *mpls.MplsInterface, metadata interface{}) error {
+	ifMeta, found := d.ifIndexes.LookupByName(mplsIf.Name)
+	if !found {
+		return errors.Errorf("failed to find interface %s", mplsIf.Name)
+	}
+	return d.mplsHandler.DisableInterfaceMpls(ifMeta.SwIfIndex)
+}
+
+// Dependencies lists the interface and the default MPLS table as dependencies.
+func (d *MplsInterfaceDescriptor) Dependencies(key string, mplsIf *mpls.MplsInterface) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: mplsInterfaceDep,
+			Key:   interfaces.InterfaceKey(mplsIf.Name),
+		},
+		{
+			// VPP refuses to enable MPLS on interface until the default table exists
+			Label: mplsDefaultTableDep,
+			Key:   mpls.MplsTableKey(defaultMplsTableID),
+		},
+	}
+}
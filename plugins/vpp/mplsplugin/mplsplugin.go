@@ -0,0 +1,86 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name MplsTable --value-type *vpp_mpls.MplsTable --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/mpls" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name MplsInterface --value-type *vpp_mpls.MplsInterface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/mpls" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name LocalLabel --value-type *vpp_mpls.LocalLabel --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/mpls" --output-dir "descriptor"
+
+package mplsplugin
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/vppcalls"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/vppcalls/vpp2101"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/vppcalls/vpp2106"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/vppcalls/vpp2210"
+)
+
+// MplsPlugin configures VPP MPLS tables, local labels and interfaces with enabled MPLS using GoVPP.
+type MplsPlugin struct {
+	Deps
+
+	// handlers
+	mplsHandler vppcalls.MplsVppAPI
+}
+
+// Deps lists dependencies of the MPLS plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+// Init registers MPLS-related descriptors.
+func (p *MplsPlugin) Init() (err error) {
+	// init handlers
+	p.mplsHandler = vppcalls.CompatibleMplsVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.mplsHandler == nil {
+		return errors.New("MPLS VPP handler is not available")
+	}
+
+	// init and register descriptors
+	tableDescriptor := descriptor.NewMplsTableDescriptor(p.mplsHandler, p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(tableDescriptor); err != nil {
+		return err
+	}
+	ifDescriptor := descriptor.NewMplsInterfaceDescriptor(p.mplsHandler, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(ifDescriptor); err != nil {
+		return err
+	}
+	localLabelDescriptor := descriptor.NewLocalLabelDescriptor(p.mplsHandler, p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(localLabelDescriptor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *MplsPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
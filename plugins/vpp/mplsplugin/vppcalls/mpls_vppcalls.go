@@ -0,0 +1,85 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	mpls "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/mpls"
+)
+
+// MplsVppAPI provides methods for managing VPP MPLS configuration.
+type MplsVppAPI interface {
+	MplsVppRead
+
+	// AddMplsTable creates new MPLS table.
+	AddMplsTable(table *mpls.MplsTable) error
+	// DelMplsTable removes existing MPLS table.
+	DelMplsTable(table *mpls.MplsTable) error
+	// EnableInterfaceMpls enables MPLS forwarding on the given interface.
+	EnableInterfaceMpls(ifIdx uint32) error
+	// DisableInterfaceMpls disables MPLS forwarding on the given interface.
+	DisableInterfaceMpls(ifIdx uint32) error
+	// AddLocalLabel adds MPLS local label entry with all its paths.
+	AddLocalLabel(localLabel *mpls.LocalLabel) error
+	// DelLocalLabel removes MPLS local label entry.
+	DelLocalLabel(localLabel *mpls.LocalLabel) error
+}
+
+// MplsVppRead provides read methods for VPP MPLS configuration.
+type MplsVppRead interface {
+	// DumpMplsTables dumps all MPLS tables configured in VPP.
+	DumpMplsTables() ([]*mpls.MplsTable, error)
+	// DumpLocalLabels dumps MPLS local label entries from all MPLS tables.
+	// Entries with reserved labels (0-15) added by VPP itself are skipped.
+	DumpLocalLabels() ([]*mpls.LocalLabel, error)
+}
+
+var handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "mpls",
+	HandlerAPI: (*MplsVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) MplsVppAPI
+
+func AddMplsHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleMplsVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) MplsVppAPI {
+	if v := handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(MplsVppAPI)
+	}
+	return nil
+}
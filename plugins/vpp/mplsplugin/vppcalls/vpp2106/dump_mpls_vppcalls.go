@@ -0,0 +1,127 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2106
+
+import (
+	"net"
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/fib_types"
+	vpp_mpls "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/mpls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	mpls "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/mpls"
+)
+
+// reservedLabels is the number of MPLS labels (0-15) reserved for special use.
+const reservedLabels = 16
+
+// DumpMplsTables implements MPLS handler.
+func (h *MplsVppHandler) DumpMplsTables() (tables []*mpls.MplsTable, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_mpls.MplsTableDump{})
+	for {
+		details := &vpp_mpls.MplsTableDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, &mpls.MplsTable{
+			Id:    details.MtTable.MtTableID,
+			Label: strings.Trim(details.MtTable.MtName, "\x00"),
+		})
+	}
+	return tables, nil
+}
+
+// DumpLocalLabels implements MPLS handler.
+func (h *MplsVppHandler) DumpLocalLabels() (localLabels []*mpls.LocalLabel, err error) {
+	tables, err := h.DumpMplsTables()
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range tables {
+		reqCtx := h.callsChannel.SendMultiRequest(&vpp_mpls.MplsRouteDump{
+			Table: vpp_mpls.MplsTable{
+				MtTableID: table.Id,
+			},
+		})
+		for {
+			details := &vpp_mpls.MplsRouteDetails{}
+			stop, err := reqCtx.ReceiveReply(details)
+			if stop {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if details.MrRoute.MrLabel < reservedLabels {
+				continue
+			}
+			localLabels = append(localLabels, h.fromMplsRoute(details.MrRoute))
+		}
+	}
+	return localLabels, nil
+}
+
+func (h *MplsVppHandler) fromMplsRoute(route vpp_mpls.MplsRoute) *mpls.LocalLabel {
+	localLabel := &mpls.LocalLabel{
+		Label:   route.MrLabel,
+		TableId: route.MrTableID,
+		Eos:     route.MrEos != 0,
+	}
+	if localLabel.Eos && fib_types.FibPathNhProto(route.MrEosProto) == fib_types.FIB_API_PATH_NH_PROTO_IP6 {
+		localLabel.EosProtocol = mpls.LocalLabel_IPV6
+	}
+	for _, fibPath := range route.MrPaths {
+		localLabel.Paths = append(localLabel.Paths, h.fromFibPath(fibPath))
+	}
+	return localLabel
+}
+
+// fromFibPath converts FIB path of MPLS route into the local label path.
+func (h *MplsVppHandler) fromFibPath(fibPath fib_types.FibPath) *l3.Route_Path {
+	path := &l3.Route_Path{
+		Weight:     uint32(fibPath.Weight),
+		Preference: uint32(fibPath.Preference),
+	}
+	if fibPath.SwIfIndex != swIfIndexUnset {
+		ifName, _, exists := h.ifIndexes.LookupBySwIfIndex(fibPath.SwIfIndex)
+		if !exists {
+			h.log.Warnf("MPLS route dump: interface name for index %d not found", fibPath.SwIfIndex)
+		}
+		path.OutgoingInterface = ifName
+	}
+	var nextHop net.IP
+	switch fibPath.Proto {
+	case fib_types.FIB_API_PATH_NH_PROTO_IP4:
+		ip4Addr := fibPath.Nh.Address.GetIP4()
+		nextHop = net.IP(ip4Addr[:])
+	case fib_types.FIB_API_PATH_NH_PROTO_IP6:
+		ip6Addr := fibPath.Nh.Address.GetIP6()
+		nextHop = net.IP(ip6Addr[:])
+	}
+	if nextHop != nil && !nextHop.IsUnspecified() {
+		path.NextHopAddr = nextHop.String()
+	} else if fibPath.SwIfIndex == swIfIndexUnset {
+		// lookup in the IP table
+		path.ViaVrfId = fibPath.TableID
+	}
+	for _, label := range fibPath.LabelStack[:fibPath.NLabels] {
+		path.LabelStack = append(path.LabelStack, label.Label)
+	}
+	return path
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2106_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/fib_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip_types"
+	vpp_mpls "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/mpls"
+	vpp_vpe "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/vpe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/vppcalls"
+	vpp2106 "go.ligato.io/vpp-agent/v3/plugins/vpp/mplsplugin/vppcalls/vpp2106"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	mpls "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/mpls"
+)
+
+func TestAddMplsTable(t *testing.T) {
+	ctx, mplsHandler, _ := mplsTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsTableAddDelReply{})
+	err := mplsHandler.AddMplsTable(&mpls.MplsTable{Id: 10, Label: "table10"})
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_mpls.MplsTableAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.MtIsAdd).To(BeTrue())
+	Expect(vppMsg.MtTable.MtTableID).To(BeEquivalentTo(10))
+	Expect(vppMsg.MtTable.MtName).To(Equal("table10"))
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsTableAddDelReply{Retval: 1})
+	err = mplsHandler.DelMplsTable(&mpls.MplsTable{Id: 10})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestEnableInterfaceMpls(t *testing.T) {
+	ctx, mplsHandler, _ := mplsTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_mpls.SwInterfaceSetMplsEnableReply{})
+	err := mplsHandler.EnableInterfaceMpls(2)
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_mpls.SwInterfaceSetMplsEnable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(vppMsg.Enable).To(BeTrue())
+
+	ctx.MockVpp.MockReply(&vpp_mpls.SwInterfaceSetMplsEnableReply{})
+	err = mplsHandler.DisableInterfaceMpls(2)
+	Expect(err).To(BeNil())
+	vppMsg, ok = ctx.MockChannel.Msg.(*vpp_mpls.SwInterfaceSetMplsEnable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Enable).To(BeFalse())
+}
+
+func TestAddLocalLabel(t *testing.T) {
+	ctx, mplsHandler, ifIndexes := mplsTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsRouteAddDelReply{})
+	err := mplsHandler.AddLocalLabel(&mpls.LocalLabel{
+		Label: 100,
+		Paths: []*l3.Route_Path{
+			{
+				NextHopAddr:       "10.0.0.1",
+				OutgoingInterface: "if1",
+				LabelStack:        []uint32{200, 300},
+			},
+		},
+	})
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_mpls.MplsRouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.MrIsAdd).To(BeTrue())
+	Expect(vppMsg.MrRoute.MrLabel).To(BeEquivalentTo(100))
+	Expect(vppMsg.MrRoute.MrEos).To(BeEquivalentTo(0))
+	Expect(vppMsg.MrRoute.MrNPaths).To(BeEquivalentTo(1))
+	path := vppMsg.MrRoute.MrPaths[0]
+	Expect(path.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(path.Proto).To(Equal(fib_types.FIB_API_PATH_NH_PROTO_IP4))
+	Expect(path.Nh.Address.GetIP4()).To(Equal(ip_types.IP4Address{10, 0, 0, 1}))
+	Expect(path.NLabels).To(BeEquivalentTo(2))
+	Expect(path.LabelStack[0].Label).To(BeEquivalentTo(200))
+	Expect(path.LabelStack[1].Label).To(BeEquivalentTo(300))
+}
+
+func TestAddLocalLabelDeag(t *testing.T) {
+	ctx, mplsHandler, _ := mplsTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsRouteAddDelReply{})
+	err := mplsHandler.AddLocalLabel(&mpls.LocalLabel{
+		Label:       100,
+		Eos:         true,
+		EosProtocol: mpls.LocalLabel_IPV6,
+		Paths:       []*l3.Route_Path{{ViaVrfId: 2}},
+	})
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_mpls.MplsRouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.MrRoute.MrEos).To(BeEquivalentTo(1))
+	Expect(vppMsg.MrRoute.MrEosProto).To(BeEquivalentTo(fib_types.FIB_API_PATH_NH_PROTO_IP6))
+	path := vppMsg.MrRoute.MrPaths[0]
+	Expect(path.SwIfIndex).To(BeEquivalentTo(^uint32(0)))
+	Expect(path.TableID).To(BeEquivalentTo(2))
+	Expect(path.Proto).To(Equal(fib_types.FIB_API_PATH_NH_PROTO_IP6))
+}
+
+func TestAddLocalLabelError(t *testing.T) {
+	ctx, mplsHandler, _ := mplsTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// missing interface
+	err := mplsHandler.AddLocalLabel(&mpls.LocalLabel{
+		Label: 100,
+		Paths: []*l3.Route_Path{{OutgoingInterface: "if1"}},
+	})
+	Expect(err).ToNot(BeNil())
+
+	// invalid next hop
+	err = mplsHandler.AddLocalLabel(&mpls.LocalLabel{
+		Label: 100,
+		Paths: []*l3.Route_Path{{NextHopAddr: "not-an-ip"}},
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDumpLocalLabels(t *testing.T) {
+	ctx, mplsHandler, ifIndexes := mplsTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	var nh ip_types.AddressUnion
+	nh.SetIP4(ip_types.IP4Address{10, 0, 0, 1})
+
+	ctx.MockVpp.MockReply(&vpp_mpls.MplsTableDetails{
+		MtTable: vpp_mpls.MplsTable{MtTableID: 0, MtName: "MPLS-VRF:0"},
+	})
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+	ctx.MockVpp.MockReply(
+		// reserved label
+		&vpp_mpls.MplsRouteDetails{
+			MrRoute: vpp_mpls.MplsRoute{MrLabel: 0, MrEos: 1},
+		},
+		&vpp_mpls.MplsRouteDetails{
+			MrRoute: vpp_mpls.MplsRoute{
+				MrLabel:  100,
+				MrNPaths: 1,
+				MrPaths: []fib_types.FibPath{
+					{
+						SwIfIndex: 1,
+						Weight:    1,
+						Proto:     fib_types.FIB_API_PATH_NH_PROTO_IP4,
+						Nh:        fib_types.FibPathNh{Address: nh},
+						NLabels:   1,
+						LabelStack: [16]fib_types.FibMplsLabel{
+							{Label: 200},
+						},
+					},
+				},
+			},
+		},
+		&vpp_mpls.MplsRouteDetails{
+			MrRoute: vpp_mpls.MplsRoute{
+				MrLabel:    101,
+				MrEos:      1,
+				MrEosProto: uint8(fib_types.FIB_API_PATH_NH_PROTO_IP6),
+				MrNPaths:   1,
+				MrPaths: []fib_types.FibPath{
+					{
+						SwIfIndex: ^uint32(0),
+						TableID:   2,
+						Weight:    1,
+						Proto:     fib_types.FIB_API_PATH_NH_PROTO_IP6,
+					},
+				},
+			},
+		},
+	)
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+
+	localLabels, err := mplsHandler.DumpLocalLabels()
+	Expect(err).To(BeNil())
+	Expect(localLabels).To(HaveLen(2))
+	Expect(localLabels[0].Label).To(BeEquivalentTo(100))
+	Expect(localLabels[0].Eos).To(BeFalse())
+	Expect(localLabels[0].Paths).To(HaveLen(1))
+	Expect(localLabels[0].Paths[0].OutgoingInterface).To(Equal("if1"))
+	Expect(localLabels[0].Paths[0].NextHopAddr).To(Equal("10.0.0.1"))
+	Expect(localLabels[0].Paths[0].LabelStack).To(Equal([]uint32{200}))
+	Expect(localLabels[1].Label).To(BeEquivalentTo(101))
+	Expect(localLabels[1].Eos).To(BeTrue())
+	Expect(localLabels[1].EosProtocol).To(Equal(mpls.LocalLabel_IPV6))
+	Expect(localLabels[1].Paths[0].ViaVrfId).To(BeEquivalentTo(2))
+	Expect(localLabels[1].Paths[0].NextHopAddr).To(BeEmpty())
+}
+
+func mplsTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.MplsVppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	logger := logrus.NewLogger("test-log")
+	ifIndexes := ifaceidx.NewIfaceIndex(logger, "mpls-if-idx")
+	mplsHandler := vpp2106.NewMplsVppHandler(ctx.MockChannel, ifIndexes, logrus.DefaultLogger())
+	return ctx, mplsHandler, ifIndexes
+}
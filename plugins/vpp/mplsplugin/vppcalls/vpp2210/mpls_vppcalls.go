@@ -0,0 +1,197 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/fib_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/ip_types"
+	vpp_mpls "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/mpls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	mpls "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/mpls"
+)
+
+const (
+	// labelUnset equals to MPLS_LABEL_INVALID defined in VPP.
+	labelUnset uint32 = 0xfffff + 1
+
+	// classifyTableIndexUnset is a default value for classify table index of FIB path.
+	classifyTableIndexUnset = ^uint32(0)
+
+	// swIfIndexUnset is used in FIB path when outgoing interface is not defined.
+	swIfIndexUnset = ^uint32(0)
+)
+
+// AddMplsTable implements MPLS handler.
+func (h *MplsVppHandler) AddMplsTable(table *mpls.MplsTable) error {
+	return h.addDelMplsTable(table, true)
+}
+
+// DelMplsTable implements MPLS handler.
+func (h *MplsVppHandler) DelMplsTable(table *mpls.MplsTable) error {
+	return h.addDelMplsTable(table, false)
+}
+
+func (h *MplsVppHandler) addDelMplsTable(table *mpls.MplsTable, isAdd bool) error {
+	req := &vpp_mpls.MplsTableAddDel{
+		MtIsAdd: isAdd,
+		MtTable: vpp_mpls.MplsTable{
+			MtTableID: table.Id,
+			MtName:    table.Label,
+		},
+	}
+	reply := &vpp_mpls.MplsTableAddDelReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// EnableInterfaceMpls implements MPLS handler.
+func (h *MplsVppHandler) EnableInterfaceMpls(ifIdx uint32) error {
+	return h.setInterfaceMpls(ifIdx, true)
+}
+
+// DisableInterfaceMpls implements MPLS handler.
+func (h *MplsVppHandler) DisableInterfaceMpls(ifIdx uint32) error {
+	return h.setInterfaceMpls(ifIdx, false)
+}
+
+func (h *MplsVppHandler) setInterfaceMpls(ifIdx uint32, enable bool) error {
+	req := &vpp_mpls.SwInterfaceSetMplsEnable{
+		SwIfIndex: interface_types.InterfaceIndex(ifIdx),
+		Enable:    enable,
+	}
+	reply := &vpp_mpls.SwInterfaceSetMplsEnableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddLocalLabel implements MPLS handler.
+func (h *MplsVppHandler) AddLocalLabel(localLabel *mpls.LocalLabel) error {
+	return h.addDelLocalLabel(localLabel, true)
+}
+
+// DelLocalLabel implements MPLS handler.
+func (h *MplsVppHandler) DelLocalLabel(localLabel *mpls.LocalLabel) error {
+	return h.addDelLocalLabel(localLabel, false)
+}
+
+func (h *MplsVppHandler) addDelLocalLabel(localLabel *mpls.LocalLabel, isAdd bool) error {
+	// payload protocol is relevant only for end-of-stack entries
+	payloadProto := fib_types.FIB_API_PATH_NH_PROTO_MPLS
+	var eos uint8
+	if localLabel.Eos {
+		eos = 1
+		payloadProto = eosProtoToFibProto(localLabel.EosProtocol)
+	}
+
+	var fibPaths []fib_types.FibPath
+	for _, path := range localLabel.Paths {
+		fibPath, err := h.toFibPath(path, payloadProto)
+		if err != nil {
+			return err
+		}
+		fibPaths = append(fibPaths, fibPath)
+	}
+
+	req := &vpp_mpls.MplsRouteAddDel{
+		MrIsAdd:       isAdd,
+		MrIsMultipath: true,
+		MrRoute: vpp_mpls.MplsRoute{
+			MrTableID: localLabel.TableId,
+			MrLabel:   localLabel.Label,
+			MrEos:     eos,
+			MrNPaths:  uint8(len(fibPaths)),
+			MrPaths:   fibPaths,
+		},
+	}
+	if localLabel.Eos {
+		req.MrRoute.MrEosProto = uint8(payloadProto)
+	}
+	reply := &vpp_mpls.MplsRouteAddDelReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// toFibPath converts path of MPLS local label entry into the FIB path.
+// Path without next hop and outgoing interface is a lookup in the via VRF table.
+func (h *MplsVppHandler) toFibPath(path *l3.Route_Path, payloadProto fib_types.FibPathNhProto) (fib_types.FibPath, error) {
+	fibPath := fib_types.FibPath{
+		SwIfIndex:  swIfIndexUnset,
+		TableID:    path.ViaVrfId,
+		Weight:     uint8(path.Weight),
+		Preference: uint8(path.Preference),
+		Proto:      payloadProto,
+		Nh: fib_types.FibPathNh{
+			ViaLabel:           labelUnset,
+			ClassifyTableIndex: classifyTableIndexUnset,
+		},
+	}
+	if path.OutgoingInterface != "" {
+		meta, found := h.ifIndexes.LookupByName(path.OutgoingInterface)
+		if !found {
+			return fibPath, errors.Errorf("interface %s not found", path.OutgoingInterface)
+		}
+		fibPath.SwIfIndex = meta.SwIfIndex
+	}
+	if path.NextHopAddr != "" {
+		nextHop := net.ParseIP(path.NextHopAddr)
+		if nextHop == nil {
+			return fibPath, errors.Errorf("invalid next hop address %q", path.NextHopAddr)
+		}
+		fibPath.Nh.Address, fibPath.Proto = ipToAddressUnion(nextHop)
+	}
+
+	// MPLS label stack
+	fibPath.NLabels = uint8(len(path.LabelStack))
+	for i, label := range path.LabelStack {
+		fibPath.LabelStack[i] = fib_types.FibMplsLabel{
+			Label: label,
+		}
+	}
+	return fibPath, nil
+}
+
+func ipToAddressUnion(netIP net.IP) (addrUnion ip_types.AddressUnion, proto fib_types.FibPathNhProto) {
+	if netIP.To4() == nil {
+		var ip6addr ip_types.IP6Address
+		copy(ip6addr[:], netIP.To16())
+		addrUnion.SetIP6(ip6addr)
+		return addrUnion, fib_types.FIB_API_PATH_NH_PROTO_IP6
+	}
+	var ip4addr ip_types.IP4Address
+	copy(ip4addr[:], netIP.To4())
+	addrUnion.SetIP4(ip4addr)
+	return addrUnion, fib_types.FIB_API_PATH_NH_PROTO_IP4
+}
+
+func eosProtoToFibProto(proto mpls.LocalLabel_EosProtocol) fib_types.FibPathNhProto {
+	if proto == mpls.LocalLabel_IPV6 {
+		return fib_types.FIB_API_PATH_NH_PROTO_IP6
+	}
+	return fib_types.FIB_API_PATH_NH_PROTO_IP4
+}
@@ -17,6 +17,17 @@ type DNAT44KVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type DNAT44BatchOp struct {
+	Key         string
+	Value       *vpp_nat.DNat44
+	PrevValue   *vpp_nat.DNat44
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type DNAT44Descriptor struct {
@@ -33,11 +44,15 @@ type DNAT44Descriptor struct {
 	Delete               func(key string, value *vpp_nat.DNat44, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_nat.DNat44, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_nat.DNat44, metadata interface{}) bool
+	CreateBatch          func(ops []*DNAT44BatchOp) error
+	UpdateBatch          func(ops []*DNAT44BatchOp) error
+	DeleteBatch          func(ops []*DNAT44BatchOp) error
 	Retrieve             func(correlate []DNAT44KVWithMetadata) ([]DNAT44KVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_nat.DNat44) []KeyValuePair
 	Dependencies         func(key string, value *vpp_nat.DNat44) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewDNAT44Descriptor(typedDescriptor *DNAT44Descriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewDNAT44Descriptor(typedDescriptor *DNAT44Descriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *DNAT44DescriptorAdapter) UpdateWithRecreate(key string, oldValue, newV
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *DNAT44DescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castDNAT44BatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyDNAT44BatchResults(ops, typedOps)
+	return err
+}
+
+func (da *DNAT44DescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castDNAT44BatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyDNAT44BatchResults(ops, typedOps)
+	return err
+}
+
+func (da *DNAT44DescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castDNAT44BatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyDNAT44BatchResults(ops, typedOps)
+	return err
+}
+
 func (da *DNAT44DescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []DNAT44KVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castDNAT44Value(key string, value proto.Message) (*vpp_nat.DNat44, error) {
 	return typedValue, nil
 }
 
+func castDNAT44BatchOps(ops []*BatchOp, withPrevValue bool) []*DNAT44BatchOp {
+	var typedOps []*DNAT44BatchOp
+	for _, op := range ops {
+		typedValue, err := castDNAT44Value(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_nat.DNat44
+		if withPrevValue {
+			typedPrevValue, err = castDNAT44Value(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castDNAT44Metadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &DNAT44BatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyDNAT44BatchResults(ops []*BatchOp, typedOps []*DNAT44BatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castDNAT44Metadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
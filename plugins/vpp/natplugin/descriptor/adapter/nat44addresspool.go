@@ -17,6 +17,17 @@ type NAT44AddressPoolKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type NAT44AddressPoolBatchOp struct {
+	Key         string
+	Value       *vpp_nat.Nat44AddressPool
+	PrevValue   *vpp_nat.Nat44AddressPool
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type NAT44AddressPoolDescriptor struct {
@@ -33,11 +44,15 @@ type NAT44AddressPoolDescriptor struct {
 	Delete               func(key string, value *vpp_nat.Nat44AddressPool, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_nat.Nat44AddressPool, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_nat.Nat44AddressPool, metadata interface{}) bool
+	CreateBatch          func(ops []*NAT44AddressPoolBatchOp) error
+	UpdateBatch          func(ops []*NAT44AddressPoolBatchOp) error
+	DeleteBatch          func(ops []*NAT44AddressPoolBatchOp) error
 	Retrieve             func(correlate []NAT44AddressPoolKVWithMetadata) ([]NAT44AddressPoolKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_nat.Nat44AddressPool) []KeyValuePair
 	Dependencies         func(key string, value *vpp_nat.Nat44AddressPool) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewNAT44AddressPoolDescriptor(typedDescriptor *NAT44AddressPoolDescriptor)
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewNAT44AddressPoolDescriptor(typedDescriptor *NAT44AddressPoolDescriptor)
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *NAT44AddressPoolDescriptorAdapter) UpdateWithRecreate(key string, oldV
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *NAT44AddressPoolDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castNAT44AddressPoolBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyNAT44AddressPoolBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *NAT44AddressPoolDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castNAT44AddressPoolBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyNAT44AddressPoolBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *NAT44AddressPoolDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castNAT44AddressPoolBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyNAT44AddressPoolBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *NAT44AddressPoolDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []NAT44AddressPoolKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castNAT44AddressPoolValue(key string, value proto.Message) (*vpp_nat.Nat44A
 	return typedValue, nil
 }
 
+func castNAT44AddressPoolBatchOps(ops []*BatchOp, withPrevValue bool) []*NAT44AddressPoolBatchOp {
+	var typedOps []*NAT44AddressPoolBatchOp
+	for _, op := range ops {
+		typedValue, err := castNAT44AddressPoolValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_nat.Nat44AddressPool
+		if withPrevValue {
+			typedPrevValue, err = castNAT44AddressPoolValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castNAT44AddressPoolMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &NAT44AddressPoolBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyNAT44AddressPoolBatchResults(ops []*BatchOp, typedOps []*NAT44AddressPoolBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castNAT44AddressPoolMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type NAT44GlobalKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type NAT44GlobalBatchOp struct {
+	Key         string
+	Value       *vpp_nat.Nat44Global
+	PrevValue   *vpp_nat.Nat44Global
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type NAT44GlobalDescriptor struct {
@@ -33,11 +44,15 @@ type NAT44GlobalDescriptor struct {
 	Delete               func(key string, value *vpp_nat.Nat44Global, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_nat.Nat44Global, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_nat.Nat44Global, metadata interface{}) bool
+	CreateBatch          func(ops []*NAT44GlobalBatchOp) error
+	UpdateBatch          func(ops []*NAT44GlobalBatchOp) error
+	DeleteBatch          func(ops []*NAT44GlobalBatchOp) error
 	Retrieve             func(correlate []NAT44GlobalKVWithMetadata) ([]NAT44GlobalKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_nat.Nat44Global) []KeyValuePair
 	Dependencies         func(key string, value *vpp_nat.Nat44Global) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewNAT44GlobalDescriptor(typedDescriptor *NAT44GlobalDescriptor) *KVDescrip
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewNAT44GlobalDescriptor(typedDescriptor *NAT44GlobalDescriptor) *KVDescrip
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *NAT44GlobalDescriptorAdapter) UpdateWithRecreate(key string, oldValue,
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *NAT44GlobalDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castNAT44GlobalBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyNAT44GlobalBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *NAT44GlobalDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castNAT44GlobalBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyNAT44GlobalBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *NAT44GlobalDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castNAT44GlobalBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyNAT44GlobalBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *NAT44GlobalDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []NAT44GlobalKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castNAT44GlobalValue(key string, value proto.Message) (*vpp_nat.Nat44Global
 	return typedValue, nil
 }
 
+func castNAT44GlobalBatchOps(ops []*BatchOp, withPrevValue bool) []*NAT44GlobalBatchOp {
+	var typedOps []*NAT44GlobalBatchOp
+	for _, op := range ops {
+		typedValue, err := castNAT44GlobalValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_nat.Nat44Global
+		if withPrevValue {
+			typedPrevValue, err = castNAT44GlobalValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castNAT44GlobalMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &NAT44GlobalBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyNAT44GlobalBatchResults(ops []*BatchOp, typedOps []*NAT44GlobalBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castNAT44GlobalMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
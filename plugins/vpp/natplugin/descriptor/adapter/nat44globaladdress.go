@@ -17,6 +17,17 @@ type NAT44GlobalAddressKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type NAT44GlobalAddressBatchOp struct {
+	Key         string
+	Value       *vpp_nat.Nat44Global_Address
+	PrevValue   *vpp_nat.Nat44Global_Address
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type NAT44GlobalAddressDescriptor struct {
@@ -33,11 +44,15 @@ type NAT44GlobalAddressDescriptor struct {
 	Delete               func(key string, value *vpp_nat.Nat44Global_Address, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_nat.Nat44Global_Address, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_nat.Nat44Global_Address, metadata interface{}) bool
+	CreateBatch          func(ops []*NAT44GlobalAddressBatchOp) error
+	UpdateBatch          func(ops []*NAT44GlobalAddressBatchOp) error
+	DeleteBatch          func(ops []*NAT44GlobalAddressBatchOp) error
 	Retrieve             func(correlate []NAT44GlobalAddressKVWithMetadata) ([]NAT44GlobalAddressKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_nat.Nat44Global_Address) []KeyValuePair
 	Dependencies         func(key string, value *vpp_nat.Nat44Global_Address) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewNAT44GlobalAddressDescriptor(typedDescriptor *NAT44GlobalAddressDescript
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewNAT44GlobalAddressDescriptor(typedDescriptor *NAT44GlobalAddressDescript
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *NAT44GlobalAddressDescriptorAdapter) UpdateWithRecreate(key string, ol
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *NAT44GlobalAddressDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castNAT44GlobalAddressBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyNAT44GlobalAddressBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *NAT44GlobalAddressDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castNAT44GlobalAddressBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyNAT44GlobalAddressBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *NAT44GlobalAddressDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castNAT44GlobalAddressBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyNAT44GlobalAddressBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *NAT44GlobalAddressDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []NAT44GlobalAddressKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castNAT44GlobalAddressValue(key string, value proto.Message) (*vpp_nat.Nat4
 	return typedValue, nil
 }
 
+func castNAT44GlobalAddressBatchOps(ops []*BatchOp, withPrevValue bool) []*NAT44GlobalAddressBatchOp {
+	var typedOps []*NAT44GlobalAddressBatchOp
+	for _, op := range ops {
+		typedValue, err := castNAT44GlobalAddressValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_nat.Nat44Global_Address
+		if withPrevValue {
+			typedPrevValue, err = castNAT44GlobalAddressValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castNAT44GlobalAddressMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &NAT44GlobalAddressBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyNAT44GlobalAddressBatchResults(ops []*BatchOp, typedOps []*NAT44GlobalAddressBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castNAT44GlobalAddressMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type NAT44GlobalInterfaceKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type NAT44GlobalInterfaceBatchOp struct {
+	Key         string
+	Value       *vpp_nat.Nat44Global_Interface
+	PrevValue   *vpp_nat.Nat44Global_Interface
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type NAT44GlobalInterfaceDescriptor struct {
@@ -33,11 +44,15 @@ type NAT44GlobalInterfaceDescriptor struct {
 	Delete               func(key string, value *vpp_nat.Nat44Global_Interface, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_nat.Nat44Global_Interface, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_nat.Nat44Global_Interface, metadata interface{}) bool
+	CreateBatch          func(ops []*NAT44GlobalInterfaceBatchOp) error
+	UpdateBatch          func(ops []*NAT44GlobalInterfaceBatchOp) error
+	DeleteBatch          func(ops []*NAT44GlobalInterfaceBatchOp) error
 	Retrieve             func(correlate []NAT44GlobalInterfaceKVWithMetadata) ([]NAT44GlobalInterfaceKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_nat.Nat44Global_Interface) []KeyValuePair
 	Dependencies         func(key string, value *vpp_nat.Nat44Global_Interface) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewNAT44GlobalInterfaceDescriptor(typedDescriptor *NAT44GlobalInterfaceDesc
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewNAT44GlobalInterfaceDescriptor(typedDescriptor *NAT44GlobalInterfaceDesc
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *NAT44GlobalInterfaceDescriptorAdapter) UpdateWithRecreate(key string,
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *NAT44GlobalInterfaceDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castNAT44GlobalInterfaceBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyNAT44GlobalInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *NAT44GlobalInterfaceDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castNAT44GlobalInterfaceBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyNAT44GlobalInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *NAT44GlobalInterfaceDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castNAT44GlobalInterfaceBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyNAT44GlobalInterfaceBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *NAT44GlobalInterfaceDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []NAT44GlobalInterfaceKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castNAT44GlobalInterfaceValue(key string, value proto.Message) (*vpp_nat.Na
 	return typedValue, nil
 }
 
+func castNAT44GlobalInterfaceBatchOps(ops []*BatchOp, withPrevValue bool) []*NAT44GlobalInterfaceBatchOp {
+	var typedOps []*NAT44GlobalInterfaceBatchOp
+	for _, op := range ops {
+		typedValue, err := castNAT44GlobalInterfaceValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_nat.Nat44Global_Interface
+		if withPrevValue {
+			typedPrevValue, err = castNAT44GlobalInterfaceValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castNAT44GlobalInterfaceMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &NAT44GlobalInterfaceBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyNAT44GlobalInterfaceBatchResults(ops []*BatchOp, typedOps []*NAT44GlobalInterfaceBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castNAT44GlobalInterfaceMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
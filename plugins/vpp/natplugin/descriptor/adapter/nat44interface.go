@@ -17,6 +17,17 @@ type NAT44InterfaceKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type NAT44InterfaceBatchOp struct {
+	Key         string
+	Value       *vpp_nat.Nat44Interface
+	PrevValue   *vpp_nat.Nat44Interface
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type NAT44InterfaceDescriptor struct {
@@ -33,11 +44,15 @@ type NAT44InterfaceDescriptor struct {
 	Delete               func(key string, value *vpp_nat.Nat44Interface, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_nat.Nat44Interface, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_nat.Nat44Interface, metadata interface{}) bool
+	CreateBatch          func(ops []*NAT44InterfaceBatchOp) error
+	UpdateBatch          func(ops []*NAT44InterfaceBatchOp) error
+	DeleteBatch          func(ops []*NAT44InterfaceBatchOp) error
 	Retrieve             func(correlate []NAT44InterfaceKVWithMetadata) ([]NAT44InterfaceKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_nat.Nat44Interface) []KeyValuePair
 	Dependencies         func(key string, value *vpp_nat.Nat44Interface) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewNAT44InterfaceDescriptor(typedDescriptor *NAT44InterfaceDescriptor) *KVD
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewNAT44InterfaceDescriptor(typedDescriptor *NAT44InterfaceDescriptor) *KVD
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *NAT44InterfaceDescriptorAdapter) UpdateWithRecreate(key string, oldVal
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *NAT44InterfaceDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castNAT44InterfaceBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyNAT44InterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *NAT44InterfaceDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castNAT44InterfaceBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyNAT44InterfaceBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *NAT44InterfaceDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castNAT44InterfaceBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyNAT44InterfaceBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *NAT44InterfaceDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []NAT44InterfaceKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castNAT44InterfaceValue(key string, value proto.Message) (*vpp_nat.Nat44Int
 	return typedValue, nil
 }
 
+func castNAT44InterfaceBatchOps(ops []*BatchOp, withPrevValue bool) []*NAT44InterfaceBatchOp {
+	var typedOps []*NAT44InterfaceBatchOp
+	for _, op := range ops {
+		typedValue, err := castNAT44InterfaceValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_nat.Nat44Interface
+		if withPrevValue {
+			typedPrevValue, err = castNAT44InterfaceValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castNAT44InterfaceMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &NAT44InterfaceBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyNAT44InterfaceBatchResults(ops []*BatchOp, typedOps []*NAT44InterfaceBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castNAT44InterfaceMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
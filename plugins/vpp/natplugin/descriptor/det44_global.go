@@ -0,0 +1,155 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// DET44GlobalDescriptorName is the name of the descriptor for VPP DET44 global
+	// configuration.
+	DET44GlobalDescriptorName = "vpp-det44-global"
+
+	// dependency labels
+	det44GlobalCfgDep  = "det44-global-config-exists"
+	det44InsideVrfDep  = "inside-vrf-table-exists"
+	det44OutsideVrfDep = "outside-vrf-table-exists"
+)
+
+// DET44GlobalDescriptor teaches KVScheduler how to enable VPP DET44 plugin
+// and configure its global options.
+type DET44GlobalDescriptor struct {
+	log          logging.Logger
+	det44Handler vppcalls.Det44VppAPI
+}
+
+// NewDET44GlobalDescriptor creates a new instance of the DET44Global descriptor.
+func NewDET44GlobalDescriptor(det44Handler vppcalls.Det44VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &DET44GlobalDescriptor{
+		det44Handler: det44Handler,
+		log:          log.NewLogger("det44-global-descriptor"),
+	}
+
+	typedDescr := &adapter.DET44GlobalDescriptor{
+		Name:               DET44GlobalDescriptorName,
+		NBKeyPrefix:        nat.ModelDet44Global.KeyPrefix(),
+		ValueTypeName:      nat.ModelDet44Global.ProtoName(),
+		KeySelector:        nat.ModelDet44Global.IsKeyValid,
+		ValueComparator:    ctx.EquivalentDET44Global,
+		Create:             ctx.Create,
+		Delete:             ctx.Delete,
+		Update:             ctx.Update,
+		UpdateWithRecreate: ctx.UpdateWithRecreate,
+		Retrieve:           ctx.Retrieve,
+		Dependencies:       ctx.Dependencies,
+	}
+	return adapter.NewDET44GlobalDescriptor(typedDescr)
+}
+
+// EquivalentDET44Global compares two DET44 global configs for equality.
+func (d *DET44GlobalDescriptor) EquivalentDET44Global(key string, oldGlobalCfg, newGlobalCfg *nat.Det44Global) bool {
+	return oldGlobalCfg.InsideVrf == newGlobalCfg.InsideVrf &&
+		oldGlobalCfg.OutsideVrf == newGlobalCfg.OutsideVrf &&
+		equivalentNatTimeouts(oldGlobalCfg.Timeouts, newGlobalCfg.Timeouts)
+}
+
+// Create enables DET44 plugin and applies DET44 global options.
+func (d *DET44GlobalDescriptor) Create(key string, globalCfg *nat.Det44Global) (metadata interface{}, err error) {
+	if err = d.det44Handler.EnableDET44Plugin(globalCfg); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	if err = d.det44Handler.SetDet44Timeouts(globalCfg.Timeouts); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete disables DET44 plugin.
+func (d *DET44GlobalDescriptor) Delete(key string, globalCfg *nat.Det44Global, metadata interface{}) error {
+	return d.det44Handler.DisableDET44Plugin()
+}
+
+// UpdateWithRecreate returns true if inside or outside VRF has changed, which
+// requires DET44 plugin to be disabled and re-enabled.
+func (d *DET44GlobalDescriptor) UpdateWithRecreate(key string, oldGlobalCfg, newGlobalCfg *nat.Det44Global, metadata interface{}) bool {
+	return oldGlobalCfg.InsideVrf != newGlobalCfg.InsideVrf ||
+		oldGlobalCfg.OutsideVrf != newGlobalCfg.OutsideVrf
+}
+
+// Update updates DET44 session timeouts.
+func (d *DET44GlobalDescriptor) Update(key string, oldGlobalCfg, newGlobalCfg *nat.Det44Global, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	if err = d.det44Handler.SetDet44Timeouts(newGlobalCfg.Timeouts); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Retrieve returns the current DET44 global configuration.
+func (d *DET44GlobalDescriptor) Retrieve(correlate []adapter.DET44GlobalKVWithMetadata) ([]adapter.DET44GlobalKVWithMetadata, error) {
+	if len(correlate) == 0 {
+		// It is not possible to find out if the DET44 plugin is enabled or disabled.
+		// If it is not expected to be enabled then we will assume that to be the case.
+		return nil, nil
+	}
+
+	timeouts, err := d.det44Handler.Det44TimeoutsDump()
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+
+	// inside/outside VRFs cannot be dumped, take them from NB
+	nbCfg := correlate[0].Value
+	globalCfg := &nat.Det44Global{
+		InsideVrf:  nbCfg.InsideVrf,
+		OutsideVrf: nbCfg.OutsideVrf,
+		Timeouts:   timeouts,
+	}
+
+	retrieved := []adapter.DET44GlobalKVWithMetadata{{
+		Key:    models.Key(globalCfg),
+		Value:  globalCfg,
+		Origin: kvs.FromNB,
+	}}
+	return retrieved, nil
+}
+
+// Dependencies lists non-zero inside and outside VRFs as dependencies.
+func (d *DET44GlobalDescriptor) Dependencies(key string, globalCfg *nat.Det44Global) (deps []kvs.Dependency) {
+	if globalCfg.InsideVrf != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: det44InsideVrfDep,
+			Key:   l3.VrfTableKey(globalCfg.InsideVrf, l3.VrfTable_IPV4),
+		})
+	}
+	if globalCfg.OutsideVrf != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: det44OutsideVrfDep,
+			Key:   l3.VrfTableKey(globalCfg.OutsideVrf, l3.VrfTable_IPV4),
+		})
+	}
+	return deps
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// DET44InterfaceDescriptorName is the name of the descriptor for VPP DET44 features applied to interfaces.
+	DET44InterfaceDescriptorName = "vpp-det44-interface"
+)
+
+// DET44InterfaceDescriptor teaches KVScheduler how to configure VPP DET44 interface features.
+type DET44InterfaceDescriptor struct {
+	log          logging.Logger
+	det44Handler vppcalls.Det44VppAPI
+}
+
+// NewDET44InterfaceDescriptor creates a new instance of the DET44Interface descriptor.
+func NewDET44InterfaceDescriptor(det44Handler vppcalls.Det44VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &DET44InterfaceDescriptor{
+		det44Handler: det44Handler,
+		log:          log.NewLogger("det44-iface-descriptor"),
+	}
+
+	typedDescr := &adapter.DET44InterfaceDescriptor{
+		Name:                 DET44InterfaceDescriptorName,
+		NBKeyPrefix:          nat.ModelDet44Interface.KeyPrefix(),
+		ValueTypeName:        nat.ModelDet44Interface.ProtoName(),
+		KeySelector:          nat.ModelDet44Interface.IsKeyValid,
+		KeyLabel:             nat.ModelDet44Interface.StripKeyPrefix,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{DET44GlobalDescriptorName},
+	}
+	return adapter.NewDET44InterfaceDescriptor(typedDescr)
+}
+
+// Create enables DET44 on an interface.
+func (d *DET44InterfaceDescriptor) Create(key string, natIface *nat.Det44Interface) (metadata interface{}, err error) {
+	if natIface.NatInside {
+		if err = d.det44Handler.EnableDet44Interface(natIface.Name, true); err != nil {
+			return
+		}
+	}
+	if natIface.NatOutside {
+		if err = d.det44Handler.EnableDet44Interface(natIface.Name, false); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Delete disables DET44 on an interface.
+func (d *DET44InterfaceDescriptor) Delete(key string, natIface *nat.Det44Interface, metadata interface{}) (err error) {
+	if natIface.NatInside {
+		if err = d.det44Handler.DisableDet44Interface(natIface.Name, true); err != nil {
+			return
+		}
+	}
+	if natIface.NatOutside {
+		if err = d.det44Handler.DisableDet44Interface(natIface.Name, false); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Retrieve returns the current DET44 interface configuration.
+func (d *DET44InterfaceDescriptor) Retrieve(correlate []adapter.DET44InterfaceKVWithMetadata) (
+	retrieved []adapter.DET44InterfaceKVWithMetadata, err error) {
+	natIfs, err := d.det44Handler.Det44InterfacesDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, natIf := range natIfs {
+		retrieved = append(retrieved, adapter.DET44InterfaceKVWithMetadata{
+			Key:    nat.Det44InterfaceKey(natIf.Name),
+			Value:  natIf,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists the interface and the DET44 global configuration as dependencies.
+func (d *DET44InterfaceDescriptor) Dependencies(key string, natIface *nat.Det44Interface) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: det44GlobalCfgDep,
+			Key:   nat.GlobalDET44Key(),
+		},
+		{
+			Label: natInterfaceDep,
+			Key:   interfaces.InterfaceKey(natIface.Name),
+		},
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// DET44MappingDescriptorName is the name of the descriptor for VPP DET44 mappings.
+	DET44MappingDescriptorName = "vpp-det44-mapping"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrInvalidDet44Prefix is returned when DET44 mapping prefix is not valid IPv4 network.
+	ErrInvalidDet44Prefix = errors.New("DET44 mapping prefix must be IPv4 network")
+	// ErrDet44OutsideTooLarge is returned when outside network of the DET44 mapping
+	// is larger than the inside network.
+	ErrDet44OutsideTooLarge = errors.New("DET44 outside network cannot be larger than inside network")
+	// ErrDet44MappingOverlap is returned when DET44 mapping overlaps with another
+	// configured DET44 mapping.
+	ErrDet44MappingOverlap = errors.New("DET44 mapping overlaps with another mapping")
+)
+
+// DET44MappingDescriptor teaches KVScheduler how to configure VPP DET44 mappings.
+type DET44MappingDescriptor struct {
+	log          logging.Logger
+	det44Handler vppcalls.Det44VppAPI
+
+	// created mappings (key -> mapping), used to detect overlaps
+	mappings map[string]*nat.Det44Mapping
+}
+
+// NewDET44MappingDescriptor creates a new instance of the DET44Mapping descriptor.
+func NewDET44MappingDescriptor(det44Handler vppcalls.Det44VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &DET44MappingDescriptor{
+		det44Handler: det44Handler,
+		log:          log.NewLogger("det44-mapping-descriptor"),
+		mappings:     make(map[string]*nat.Det44Mapping),
+	}
+
+	typedDescr := &adapter.DET44MappingDescriptor{
+		Name:                 DET44MappingDescriptorName,
+		NBKeyPrefix:          nat.ModelDet44Mapping.KeyPrefix(),
+		ValueTypeName:        nat.ModelDet44Mapping.ProtoName(),
+		KeySelector:          nat.ModelDet44Mapping.IsKeyValid,
+		KeyLabel:             nat.ModelDet44Mapping.StripKeyPrefix,
+		Validate:             ctx.Validate,
+		IsRetriableFailure:   ctx.IsRetriableFailure,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{DET44GlobalDescriptorName},
+	}
+	return adapter.NewDET44MappingDescriptor(typedDescr)
+}
+
+// Validate validates DET44 mapping.
+func (d *DET44MappingDescriptor) Validate(key string, mapping *nat.Det44Mapping) error {
+	inNet, outNet, err := det44MappingNetworks(mapping)
+	if err != nil {
+		return err
+	}
+	inLen, _ := inNet.Mask.Size()
+	outLen, _ := outNet.Mask.Size()
+	if outLen < inLen {
+		return kvs.NewInvalidValueError(ErrDet44OutsideTooLarge, "outside_prefix")
+	}
+	return nil
+}
+
+// IsRetriableFailure returns false if error is one of errors
+// defined at the top of this file as non-retriable.
+func (d *DET44MappingDescriptor) IsRetriableFailure(err error) bool {
+	return !errors.Is(err, ErrDet44MappingOverlap)
+}
+
+// Create adds DET44 mapping.
+// Neither inside nor outside network may overlap with networks of other already
+// created mappings.
+func (d *DET44MappingDescriptor) Create(key string, mapping *nat.Det44Mapping) (metadata interface{}, err error) {
+	if otherKey, field := d.findOverlappingMapping(key, mapping); otherKey != "" {
+		return nil, errors.Wrapf(ErrDet44MappingOverlap, "%s (overlapping mapping: %s)", field, otherKey)
+	}
+	if err = d.det44Handler.AddDet44Mapping(mapping); err != nil {
+		return nil, err
+	}
+	d.mappings[key] = mapping
+	return nil, nil
+}
+
+// Delete removes DET44 mapping.
+func (d *DET44MappingDescriptor) Delete(key string, mapping *nat.Det44Mapping, metadata interface{}) error {
+	if err := d.det44Handler.DelDet44Mapping(mapping); err != nil {
+		return err
+	}
+	delete(d.mappings, key)
+	return nil
+}
+
+// Retrieve returns DET44 mappings configured on VPP.
+func (d *DET44MappingDescriptor) Retrieve(correlate []adapter.DET44MappingKVWithMetadata) (
+	retrieved []adapter.DET44MappingKVWithMetadata, err error) {
+	mappings, err := d.det44Handler.Det44MappingsDump()
+	if err != nil {
+		return nil, err
+	}
+
+	d.mappings = make(map[string]*nat.Det44Mapping)
+	for _, mapping := range mappings {
+		key := models.Key(mapping)
+		d.mappings[key] = mapping
+		retrieved = append(retrieved, adapter.DET44MappingKVWithMetadata{
+			Key:    key,
+			Value:  mapping,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists DET44 global configuration as dependency.
+func (d *DET44MappingDescriptor) Dependencies(key string, mapping *nat.Det44Mapping) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: det44GlobalCfgDep,
+			Key:   nat.GlobalDET44Key(),
+		},
+	}
+}
+
+// findOverlappingMapping returns key of a created mapping with inside or outside
+// network overlapping with the given mapping, and the name of the overlapping field.
+func (d *DET44MappingDescriptor) findOverlappingMapping(key string, mapping *nat.Det44Mapping) (otherKey, field string) {
+	inNet, outNet, err := det44MappingNetworks(mapping)
+	if err != nil {
+		return "", ""
+	}
+	for otherKey, other := range d.mappings {
+		if otherKey == key {
+			continue
+		}
+		otherIn, otherOut, err := det44MappingNetworks(other)
+		if err != nil {
+			continue
+		}
+		if networksOverlap(inNet, otherIn) {
+			return otherKey, "inside_prefix"
+		}
+		if networksOverlap(outNet, otherOut) {
+			return otherKey, "outside_prefix"
+		}
+	}
+	return "", ""
+}
+
+// det44MappingNetworks parses inside and outside network of the DET44 mapping.
+func det44MappingNetworks(mapping *nat.Det44Mapping) (inNet, outNet *net.IPNet, err error) {
+	ip, inNet, err := net.ParseCIDR(mapping.InsidePrefix)
+	if err != nil || ip.To4() == nil {
+		return nil, nil, kvs.NewInvalidValueError(ErrInvalidDet44Prefix, "inside_prefix")
+	}
+	ip, outNet, err = net.ParseCIDR(mapping.OutsidePrefix)
+	if err != nil || ip.To4() == nil {
+		return nil, nil, kvs.NewInvalidValueError(ErrInvalidDet44Prefix, "outside_prefix")
+	}
+	return inNet, outNet, nil
+}
+
+// networksOverlap returns true if the given networks share at least one address.
+func networksOverlap(net1, net2 *net.IPNet) bool {
+	return net1.Contains(net2.IP) || net2.Contains(net1.IP)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// mockDet44Handler records DET44 mappings added to VPP.
+type mockDet44Handler struct {
+	vppcalls.Det44VppAPI
+	mappings map[string]string // inside prefix -> outside prefix
+}
+
+func (h *mockDet44Handler) AddDet44Mapping(mapping *nat.Det44Mapping) error {
+	h.mappings[mapping.InsidePrefix] = mapping.OutsidePrefix
+	return nil
+}
+
+func (h *mockDet44Handler) DelDet44Mapping(mapping *nat.Det44Mapping) error {
+	delete(h.mappings, mapping.InsidePrefix)
+	return nil
+}
+
+func TestDET44MappingOverlap(t *testing.T) {
+	RegisterTestingT(t)
+
+	handler := &mockDet44Handler{mappings: make(map[string]string)}
+	d := &DET44MappingDescriptor{
+		det44Handler: handler,
+		log:          logrus.NewLogger("test"),
+		mappings:     make(map[string]*nat.Det44Mapping),
+	}
+
+	mapping1 := &nat.Det44Mapping{InsidePrefix: "10.0.0.0/24", OutsidePrefix: "1.1.1.0/28"}
+	tests := []struct {
+		name    string
+		mapping *nat.Det44Mapping
+		expErr  error
+	}{
+		{name: "first mapping", mapping: mapping1},
+		{name: "same mapping again", mapping: mapping1},
+		{name: "overlapping inside network",
+			mapping: &nat.Det44Mapping{InsidePrefix: "10.0.0.128/25", OutsidePrefix: "2.2.2.0/28"},
+			expErr:  ErrDet44MappingOverlap},
+		{name: "inside network containing other",
+			mapping: &nat.Det44Mapping{InsidePrefix: "10.0.0.0/16", OutsidePrefix: "2.2.0.0/24"},
+			expErr:  ErrDet44MappingOverlap},
+		{name: "overlapping outside network",
+			mapping: &nat.Det44Mapping{InsidePrefix: "10.0.1.0/24", OutsidePrefix: "1.1.1.8/29"},
+			expErr:  ErrDet44MappingOverlap},
+		{name: "disjoint mapping",
+			mapping: &nat.Det44Mapping{InsidePrefix: "10.0.1.0/24", OutsidePrefix: "1.1.1.16/28"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := models.Key(test.mapping)
+			// validation does not depend on the created mappings
+			Expect(d.Validate(key, test.mapping)).To(Succeed())
+
+			_, err := d.Create(key, test.mapping)
+			if test.expErr == nil {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(handler.mappings).To(HaveKeyWithValue(test.mapping.InsidePrefix, test.mapping.OutsidePrefix))
+				return
+			}
+			Expect(errors.Is(err, test.expErr)).To(BeTrue())
+			Expect(d.IsRetriableFailure(err)).To(BeFalse())
+			Expect(handler.mappings).ToNot(HaveKey(test.mapping.InsidePrefix))
+		})
+	}
+
+	// mapping can be created once the overlapping mapping is removed
+	Expect(d.Delete(models.Key(mapping1), mapping1, nil)).To(Succeed())
+	mapping2 := &nat.Det44Mapping{InsidePrefix: "10.0.0.128/25", OutsidePrefix: "1.1.1.0/29"}
+	_, err := d.Create(models.Key(mapping2), mapping2)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(handler.mappings).To(HaveLen(2))
+}
+
+func TestDET44MappingValidate(t *testing.T) {
+	RegisterTestingT(t)
+
+	d := &DET44MappingDescriptor{mappings: make(map[string]*nat.Det44Mapping)}
+	tests := []struct {
+		name    string
+		mapping *nat.Det44Mapping
+		expErr  error
+	}{
+		{name: "valid", mapping: &nat.Det44Mapping{InsidePrefix: "10.0.0.0/24", OutsidePrefix: "1.1.1.0/28"}},
+		{name: "same size", mapping: &nat.Det44Mapping{InsidePrefix: "10.0.0.0/24", OutsidePrefix: "1.1.1.0/24"}},
+		{name: "invalid inside prefix", mapping: &nat.Det44Mapping{InsidePrefix: "10.0.0.0", OutsidePrefix: "1.1.1.0/28"},
+			expErr: ErrInvalidDet44Prefix},
+		{name: "IPv6 outside prefix", mapping: &nat.Det44Mapping{InsidePrefix: "10.0.0.0/24", OutsidePrefix: "2001::/64"},
+			expErr: ErrInvalidDet44Prefix},
+		{name: "outside larger than inside", mapping: &nat.Det44Mapping{InsidePrefix: "10.0.0.0/24", OutsidePrefix: "1.1.0.0/16"},
+			expErr: ErrDet44OutsideTooLarge},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := d.Validate("", test.mapping)
+			if test.expErr == nil {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(&kvs.InvalidValueError{}))
+				Expect(err.(*kvs.InvalidValueError).GetValidationError()).To(Equal(test.expErr))
+			}
+		})
+	}
+}
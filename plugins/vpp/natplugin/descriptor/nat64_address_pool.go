@@ -0,0 +1,201 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"bytes"
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64AddressPoolDescriptorName is the name of the descriptor for NAT64 IP address pools.
+	NAT64AddressPoolDescriptorName = "vpp-nat64-address-pool"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrNat64PoolOverlap is returned when NAT64 address pool overlaps with another
+	// configured NAT64 address pool.
+	ErrNat64PoolOverlap = errors.New("NAT64 address pool overlaps with another pool")
+)
+
+// NAT64AddressPoolDescriptor teaches KVScheduler how to add/remove VPP NAT64 IP addresses pools.
+type NAT64AddressPoolDescriptor struct {
+	log          logging.Logger
+	nat64Handler vppcalls.Nat64VppAPI
+
+	// created pools (key -> pool), used to detect overlaps
+	pools map[string]*nat.Nat64AddressPool
+}
+
+// NewNAT64AddressPoolDescriptor creates a new instance of the NAT64AddressPoolDescriptor.
+func NewNAT64AddressPoolDescriptor(nat64Handler vppcalls.Nat64VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64AddressPoolDescriptor{
+		nat64Handler: nat64Handler,
+		log:          log.NewLogger("nat64-address-pool-descriptor"),
+		pools:        make(map[string]*nat.Nat64AddressPool),
+	}
+	typedDescr := &adapter.NAT64AddressPoolDescriptor{
+		Name:                 NAT64AddressPoolDescriptorName,
+		NBKeyPrefix:          nat.ModelNat64AddressPool.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat64AddressPool.ProtoName(),
+		KeySelector:          nat.ModelNat64AddressPool.IsKeyValid,
+		KeyLabel:             nat.ModelNat64AddressPool.StripKeyPrefix,
+		Validate:             ctx.Validate,
+		IsRetriableFailure:   ctx.IsRetriableFailure,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{NAT64GlobalDescriptorName},
+	}
+	return adapter.NewNAT64AddressPoolDescriptor(typedDescr)
+}
+
+// Validate validates configuration for NAT64 IP addresses pool.
+func (d *NAT64AddressPoolDescriptor) Validate(key string, pool *nat.Nat64AddressPool) error {
+	_, _, err := nat64PoolRange(pool)
+	return err
+}
+
+// IsRetriableFailure returns false if error is one of errors
+// defined at the top of this file as non-retriable.
+func (d *NAT64AddressPoolDescriptor) IsRetriableFailure(err error) bool {
+	return !errors.Is(err, ErrNat64PoolOverlap)
+}
+
+// Create adds IP address pool into VPP NAT64 address pools.
+// Pool overlapping with another already created pool is refused.
+func (d *NAT64AddressPoolDescriptor) Create(key string, pool *nat.Nat64AddressPool) (metadata interface{}, err error) {
+	if otherKey := d.findOverlappingPool(key, pool); otherKey != "" {
+		return nil, errors.Wrapf(ErrNat64PoolOverlap, "overlapping pool: %s", otherKey)
+	}
+	if err = d.nat64Handler.AddNat64AddressPool(pool.VrfId, pool.FirstIp, pool.LastIp); err != nil {
+		return nil, err
+	}
+	d.pools[key] = pool
+	return nil, nil
+}
+
+// Delete removes IP address pool from VPP NAT64 address pools.
+func (d *NAT64AddressPoolDescriptor) Delete(key string, pool *nat.Nat64AddressPool, metadata interface{}) error {
+	if err := d.nat64Handler.DelNat64AddressPool(pool.VrfId, pool.FirstIp, pool.LastIp); err != nil {
+		return err
+	}
+	delete(d.pools, key)
+	return nil
+}
+
+// Retrieve returns NAT64 IP address pools configured on VPP.
+func (d *NAT64AddressPoolDescriptor) Retrieve(correlate []adapter.NAT64AddressPoolKVWithMetadata) (
+	retrieved []adapter.NAT64AddressPoolKVWithMetadata, err error) {
+	natPools, err := d.nat64Handler.Nat64AddressPoolsDump()
+	if err != nil {
+		return nil, err
+	}
+
+	d.pools = make(map[string]*nat.Nat64AddressPool)
+	for _, sbPool := range natPools {
+		// try to find NB Pool corresponding to SB Pool (for named pools we link name to SB pools)
+		pool := sbPool
+		for _, nbPool := range correlate {
+			if equalNamelessNat64Pool(nbPool.Value, sbPool) {
+				pool = nbPool.Value // NB pool found
+				break
+			}
+		}
+		key := models.Key(pool)
+		d.pools[key] = pool
+		retrieved = append(retrieved, adapter.NAT64AddressPoolKVWithMetadata{
+			Key:    key,
+			Value:  pool,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists NAT64 global configuration and non-zero/non-all-ones VRF as dependencies.
+func (d *NAT64AddressPoolDescriptor) Dependencies(key string, pool *nat.Nat64AddressPool) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: nat64GlobalCfgDep,
+		Key:   nat.GlobalNAT64Key(),
+	})
+	if pool.VrfId != 0 && pool.VrfId != ^uint32(0) {
+		deps = append(deps, kvs.Dependency{
+			Label: addressVrfDep,
+			Key:   l3.VrfTableKey(pool.VrfId, l3.VrfTable_IPV4),
+		})
+	}
+	return deps
+}
+
+// findOverlappingPool returns key of a created pool overlapping with the given pool.
+func (d *NAT64AddressPoolDescriptor) findOverlappingPool(key string, pool *nat.Nat64AddressPool) (otherKey string) {
+	firstIP, lastIP, err := nat64PoolRange(pool)
+	if err != nil {
+		return ""
+	}
+	for otherKey, other := range d.pools {
+		if otherKey == key {
+			continue
+		}
+		otherFirst, otherLast, err := nat64PoolRange(other)
+		if err != nil {
+			continue
+		}
+		if bytes.Compare(firstIP, otherLast) <= 0 && bytes.Compare(otherFirst, lastIP) <= 0 {
+			return otherKey
+		}
+	}
+	return ""
+}
+
+// nat64PoolRange returns the first and the last IPv4 address of the pool.
+func nat64PoolRange(pool *nat.Nat64AddressPool) (firstIP, lastIP net.IP, err error) {
+	firstIP, err = ParseIPv4(pool.FirstIp)
+	if err != nil {
+		return nil, nil, kvs.NewInvalidValueError(errInvalidIPAddress, "first_ip")
+	}
+	lastIP = firstIP
+	if pool.LastIp != "" {
+		lastIP, err = ParseIPv4(pool.LastIp)
+		if err != nil {
+			return nil, nil, kvs.NewInvalidValueError(errInvalidIPAddress, "last_ip")
+		}
+		if bytes.Compare(firstIP, lastIP) > 0 {
+			// last IP should be empty or higher than first IP
+			return nil, nil, kvs.NewInvalidValueError(errInvalidLastPoolAddress, "last_ip")
+		}
+	}
+	return firstIP, lastIP, nil
+}
+
+// equalNamelessNat64Pool determine equality between 2 Nat64AddressPools ignoring Name field
+func equalNamelessNat64Pool(pool1, pool2 *nat.Nat64AddressPool) bool {
+	return pool1.VrfId == pool2.VrfId &&
+		equivalentIPv4(pool1.FirstIp, pool2.FirstIp) &&
+		equivalentIPv4(pool1.LastIp, pool2.LastIp)
+}
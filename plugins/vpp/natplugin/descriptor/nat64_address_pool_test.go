@@ -0,0 +1,129 @@
+// Copyright (c) 2022 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// mockNat64Handler records NAT64 address pools added to VPP.
+type mockNat64Handler struct {
+	vppcalls.Nat64VppAPI
+	pools map[string]string // first IP -> last IP
+}
+
+func (h *mockNat64Handler) AddNat64AddressPool(vrf uint32, firstIP, lastIP string) error {
+	h.pools[firstIP] = lastIP
+	return nil
+}
+
+func (h *mockNat64Handler) DelNat64AddressPool(vrf uint32, firstIP, lastIP string) error {
+	delete(h.pools, firstIP)
+	return nil
+}
+
+func TestNAT64AddressPoolOverlap(t *testing.T) {
+	RegisterTestingT(t)
+
+	handler := &mockNat64Handler{pools: make(map[string]string)}
+	d := &NAT64AddressPoolDescriptor{
+		nat64Handler: handler,
+		log:          logrus.NewLogger("test"),
+		pools:        make(map[string]*nat.Nat64AddressPool),
+	}
+
+	pool1 := &nat.Nat64AddressPool{Name: "pool1", FirstIp: "10.0.0.1", LastIp: "10.0.0.10"}
+	pool2 := &nat.Nat64AddressPool{Name: "pool2", FirstIp: "10.0.0.10", LastIp: "10.0.0.20"}
+	pool3 := &nat.Nat64AddressPool{Name: "pool3", FirstIp: "10.0.0.11"}
+
+	tests := []struct {
+		name      string
+		pool      *nat.Nat64AddressPool
+		expErr    error
+		retriable bool
+	}{
+		{name: "first pool", pool: pool1},
+		{name: "same pool again", pool: pool1},
+		{name: "overlapping last IP", pool: pool2, expErr: ErrNat64PoolOverlap},
+		{name: "single address after the pool", pool: pool3},
+		{name: "overlapping single address", pool: &nat.Nat64AddressPool{Name: "pool4", FirstIp: "10.0.0.5"},
+			expErr: ErrNat64PoolOverlap},
+		{name: "range covering other pools", pool: &nat.Nat64AddressPool{Name: "pool5", FirstIp: "10.0.0.0", LastIp: "10.0.1.0"},
+			expErr: ErrNat64PoolOverlap},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := models.Key(test.pool)
+			// validation does not depend on the created pools
+			Expect(d.Validate(key, test.pool)).To(Succeed())
+
+			_, err := d.Create(key, test.pool)
+			if test.expErr == nil {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(handler.pools).To(HaveKeyWithValue(test.pool.FirstIp, test.pool.LastIp))
+				return
+			}
+			Expect(errors.Is(err, test.expErr)).To(BeTrue())
+			Expect(d.IsRetriableFailure(err)).To(BeFalse())
+			Expect(handler.pools).ToNot(HaveKey(test.pool.FirstIp))
+		})
+	}
+
+	// pool can be created once the overlapping pool is removed
+	Expect(d.Delete(models.Key(pool1), pool1, nil)).To(Succeed())
+	pool4 := &nat.Nat64AddressPool{Name: "pool4", FirstIp: "10.0.0.5"}
+	_, err := d.Create(models.Key(pool4), pool4)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(handler.pools).To(HaveLen(2))
+}
+
+func TestNAT64AddressPoolValidate(t *testing.T) {
+	RegisterTestingT(t)
+
+	d := &NAT64AddressPoolDescriptor{pools: make(map[string]*nat.Nat64AddressPool)}
+	tests := []struct {
+		name   string
+		pool   *nat.Nat64AddressPool
+		expErr error
+	}{
+		{name: "single address", pool: &nat.Nat64AddressPool{FirstIp: "10.0.0.1"}},
+		{name: "range", pool: &nat.Nat64AddressPool{FirstIp: "10.0.0.1", LastIp: "10.0.0.2"}},
+		{name: "invalid first IP", pool: &nat.Nat64AddressPool{FirstIp: "10.0.0"}, expErr: errInvalidIPAddress},
+		{name: "IPv6 first IP", pool: &nat.Nat64AddressPool{FirstIp: "::1"}, expErr: errInvalidIPAddress},
+		{name: "invalid last IP", pool: &nat.Nat64AddressPool{FirstIp: "10.0.0.1", LastIp: "x"}, expErr: errInvalidIPAddress},
+		{name: "last IP lower than first IP", pool: &nat.Nat64AddressPool{FirstIp: "10.0.0.2", LastIp: "10.0.0.1"},
+			expErr: errInvalidLastPoolAddress},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := d.Validate("", test.pool)
+			if test.expErr == nil {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(BeAssignableToTypeOf(&kvs.InvalidValueError{}))
+				Expect(err.(*kvs.InvalidValueError).GetValidationError()).To(Equal(test.expErr))
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64GlobalDescriptorName is the name of the descriptor for VPP NAT64 global
+	// configuration.
+	NAT64GlobalDescriptorName = "vpp-nat64-global"
+
+	// dependency labels
+	nat64GlobalCfgDep = "nat64-global-config-exists"
+)
+
+// NAT64GlobalDescriptor teaches KVScheduler how to enable VPP NAT64 plugin
+// and configure its global options.
+type NAT64GlobalDescriptor struct {
+	log          logging.Logger
+	nat64Handler vppcalls.Nat64VppAPI
+}
+
+// NewNAT64GlobalDescriptor creates a new instance of the NAT64Global descriptor.
+func NewNAT64GlobalDescriptor(nat64Handler vppcalls.Nat64VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64GlobalDescriptor{
+		nat64Handler: nat64Handler,
+		log:          log.NewLogger("nat64-global-descriptor"),
+	}
+
+	typedDescr := &adapter.NAT64GlobalDescriptor{
+		Name:               NAT64GlobalDescriptorName,
+		NBKeyPrefix:        nat.ModelNat64Global.KeyPrefix(),
+		ValueTypeName:      nat.ModelNat64Global.ProtoName(),
+		KeySelector:        nat.ModelNat64Global.IsKeyValid,
+		ValueComparator:    ctx.EquivalentNAT64Global,
+		Create:             ctx.Create,
+		Delete:             ctx.Delete,
+		Update:             ctx.Update,
+		UpdateWithRecreate: ctx.UpdateWithRecreate,
+		Retrieve:           ctx.Retrieve,
+	}
+	return adapter.NewNAT64GlobalDescriptor(typedDescr)
+}
+
+// EquivalentNAT64Global compares two NAT64 global configs for equality.
+func (d *NAT64GlobalDescriptor) EquivalentNAT64Global(key string, oldGlobalCfg, newGlobalCfg *nat.Nat64Global) bool {
+	return equivalentNat64Sizing(oldGlobalCfg, newGlobalCfg) &&
+		equivalentNatTimeouts(oldGlobalCfg.Timeouts, newGlobalCfg.Timeouts)
+}
+
+// Create enables NAT64 plugin and applies NAT64 global options.
+func (d *NAT64GlobalDescriptor) Create(key string, globalCfg *nat.Nat64Global) (metadata interface{}, err error) {
+	if err = d.nat64Handler.EnableNAT64Plugin(globalCfg); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	if err = d.nat64Handler.SetNat64Timeouts(globalCfg.Timeouts); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete disables NAT64 plugin.
+func (d *NAT64GlobalDescriptor) Delete(key string, globalCfg *nat.Nat64Global, metadata interface{}) error {
+	return d.nat64Handler.DisableNAT64Plugin()
+}
+
+// UpdateWithRecreate returns true if the sizing of BIB or session tables has changed,
+// which requires NAT64 plugin to be disabled and re-enabled.
+func (d *NAT64GlobalDescriptor) UpdateWithRecreate(key string, oldGlobalCfg, newGlobalCfg *nat.Nat64Global, metadata interface{}) bool {
+	return !equivalentNat64Sizing(oldGlobalCfg, newGlobalCfg)
+}
+
+// Update updates NAT64 session timeouts.
+func (d *NAT64GlobalDescriptor) Update(key string, oldGlobalCfg, newGlobalCfg *nat.Nat64Global, oldMetadata interface{}) (newMetadata interface{}, err error) {
+	if err = d.nat64Handler.SetNat64Timeouts(newGlobalCfg.Timeouts); err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Retrieve returns the current NAT64 global configuration.
+func (d *NAT64GlobalDescriptor) Retrieve(correlate []adapter.NAT64GlobalKVWithMetadata) ([]adapter.NAT64GlobalKVWithMetadata, error) {
+	if len(correlate) == 0 {
+		// It is not possible to find out if the NAT64 plugin is enabled or disabled.
+		// If it is not expected to be enabled then we will assume that to be the case.
+		return nil, nil
+	}
+
+	timeouts, err := d.nat64Handler.Nat64TimeoutsDump()
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+
+	// table sizing cannot be dumped, take it from NB
+	nbCfg := correlate[0].Value
+	globalCfg := &nat.Nat64Global{
+		BibBuckets:    nbCfg.BibBuckets,
+		BibMemorySize: nbCfg.BibMemorySize,
+		StBuckets:     nbCfg.StBuckets,
+		StMemorySize:  nbCfg.StMemorySize,
+		Timeouts:      timeouts,
+	}
+
+	retrieved := []adapter.NAT64GlobalKVWithMetadata{{
+		Key:    models.Key(globalCfg),
+		Value:  globalCfg,
+		Origin: kvs.FromNB,
+	}}
+	return retrieved, nil
+}
+
+// equivalentNat64Sizing compares sizing of the NAT64 BIB and session tables.
+func equivalentNat64Sizing(cfg1, cfg2 *nat.Nat64Global) bool {
+	return cfg1.BibBuckets == cfg2.BibBuckets &&
+		cfg1.BibMemorySize == cfg2.BibMemorySize &&
+		cfg1.StBuckets == cfg2.StBuckets &&
+		cfg1.StMemorySize == cfg2.StMemorySize
+}
+
+// equivalentNatTimeouts compares NAT session timeouts, zero values are treated
+// as VPP defaults.
+func equivalentNatTimeouts(t1, t2 *nat.NatTimeouts) bool {
+	withDefault := func(val, def uint32) uint32 {
+		if val == 0 {
+			return def
+		}
+		return val
+	}
+	return withDefault(t1.GetUdp(), vppcalls.DefaultUDPTimeout) ==
+		withDefault(t2.GetUdp(), vppcalls.DefaultUDPTimeout) &&
+		withDefault(t1.GetTcpEstablished(), vppcalls.DefaultTCPEstablishedTimeout) ==
+			withDefault(t2.GetTcpEstablished(), vppcalls.DefaultTCPEstablishedTimeout) &&
+		withDefault(t1.GetTcpTransitory(), vppcalls.DefaultTCPTransitoryTimeout) ==
+			withDefault(t2.GetTcpTransitory(), vppcalls.DefaultTCPTransitoryTimeout) &&
+		withDefault(t1.GetIcmp(), vppcalls.DefaultICMPTimeout) ==
+			withDefault(t2.GetIcmp(), vppcalls.DefaultICMPTimeout)
+}
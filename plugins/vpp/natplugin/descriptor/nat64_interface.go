@@ -0,0 +1,119 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64InterfaceDescriptorName is the name of the descriptor for VPP NAT64 features applied to interfaces.
+	NAT64InterfaceDescriptorName = "vpp-nat64-interface"
+)
+
+// NAT64InterfaceDescriptor teaches KVScheduler how to configure VPP NAT64 interface features.
+type NAT64InterfaceDescriptor struct {
+	log          logging.Logger
+	nat64Handler vppcalls.Nat64VppAPI
+}
+
+// NewNAT64InterfaceDescriptor creates a new instance of the NAT64Interface descriptor.
+func NewNAT64InterfaceDescriptor(nat64Handler vppcalls.Nat64VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64InterfaceDescriptor{
+		nat64Handler: nat64Handler,
+		log:          log.NewLogger("nat64-iface-descriptor"),
+	}
+
+	typedDescr := &adapter.NAT64InterfaceDescriptor{
+		Name:                 NAT64InterfaceDescriptorName,
+		NBKeyPrefix:          nat.ModelNat64Interface.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat64Interface.ProtoName(),
+		KeySelector:          nat.ModelNat64Interface.IsKeyValid,
+		KeyLabel:             nat.ModelNat64Interface.StripKeyPrefix,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{NAT64GlobalDescriptorName},
+	}
+	return adapter.NewNAT64InterfaceDescriptor(typedDescr)
+}
+
+// Create enables NAT64 on an interface.
+func (d *NAT64InterfaceDescriptor) Create(key string, natIface *nat.Nat64Interface) (metadata interface{}, err error) {
+	if natIface.NatInside {
+		if err = d.nat64Handler.EnableNat64Interface(natIface.Name, true); err != nil {
+			return
+		}
+	}
+	if natIface.NatOutside {
+		if err = d.nat64Handler.EnableNat64Interface(natIface.Name, false); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Delete disables NAT64 on an interface.
+func (d *NAT64InterfaceDescriptor) Delete(key string, natIface *nat.Nat64Interface, metadata interface{}) (err error) {
+	if natIface.NatInside {
+		if err = d.nat64Handler.DisableNat64Interface(natIface.Name, true); err != nil {
+			return
+		}
+	}
+	if natIface.NatOutside {
+		if err = d.nat64Handler.DisableNat64Interface(natIface.Name, false); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Retrieve returns the current NAT64 interface configuration.
+func (d *NAT64InterfaceDescriptor) Retrieve(correlate []adapter.NAT64InterfaceKVWithMetadata) (
+	retrieved []adapter.NAT64InterfaceKVWithMetadata, err error) {
+	natIfs, err := d.nat64Handler.Nat64InterfacesDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, natIf := range natIfs {
+		retrieved = append(retrieved, adapter.NAT64InterfaceKVWithMetadata{
+			Key:    nat.Nat64InterfaceKey(natIf.Name),
+			Value:  natIf,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists the interface and the NAT64 global configuration as dependencies.
+func (d *NAT64InterfaceDescriptor) Dependencies(key string, natIface *nat.Nat64Interface) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: nat64GlobalCfgDep,
+			Key:   nat.GlobalNAT64Key(),
+		},
+		{
+			Label: natInterfaceDep,
+			Key:   interfaces.InterfaceKey(natIface.Name),
+		},
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64PrefixDescriptorName is the name of the descriptor for VPP NAT64 prefixes.
+	NAT64PrefixDescriptorName = "vpp-nat64-prefix"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrInvalidNat64Prefix is returned when NAT64 prefix is not valid IPv6 network
+	// with one of the lengths allowed by RFC 6052.
+	ErrInvalidNat64Prefix = errors.New("NAT64 prefix must be IPv6 network with length 32, 40, 48, 56, 64 or 96")
+)
+
+// NAT64PrefixDescriptor teaches KVScheduler how to configure VPP NAT64 prefixes.
+type NAT64PrefixDescriptor struct {
+	log          logging.Logger
+	nat64Handler vppcalls.Nat64VppAPI
+}
+
+// NewNAT64PrefixDescriptor creates a new instance of the NAT64Prefix descriptor.
+func NewNAT64PrefixDescriptor(nat64Handler vppcalls.Nat64VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64PrefixDescriptor{
+		nat64Handler: nat64Handler,
+		log:          log.NewLogger("nat64-prefix-descriptor"),
+	}
+
+	typedDescr := &adapter.NAT64PrefixDescriptor{
+		Name:                 NAT64PrefixDescriptorName,
+		NBKeyPrefix:          nat.ModelNat64Prefix.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat64Prefix.ProtoName(),
+		KeySelector:          nat.ModelNat64Prefix.IsKeyValid,
+		KeyLabel:             nat.ModelNat64Prefix.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentNAT64Prefix,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{NAT64GlobalDescriptorName},
+	}
+	return adapter.NewNAT64PrefixDescriptor(typedDescr)
+}
+
+// EquivalentNAT64Prefix compares two NAT64 prefixes for equality.
+func (d *NAT64PrefixDescriptor) EquivalentNAT64Prefix(key string, oldPrefix, newPrefix *nat.Nat64Prefix) bool {
+	_, oldNet, err1 := net.ParseCIDR(oldPrefix.Prefix)
+	_, newNet, err2 := net.ParseCIDR(newPrefix.Prefix)
+	if err1 != nil || err2 != nil {
+		return equivalentTrimmedLowered(oldPrefix.Prefix, newPrefix.Prefix)
+	}
+	return oldNet.String() == newNet.String()
+}
+
+// Validate validates NAT64 prefix.
+func (d *NAT64PrefixDescriptor) Validate(key string, prefix *nat.Nat64Prefix) error {
+	ip, ipNet, err := net.ParseCIDR(prefix.Prefix)
+	if err != nil || ip.To4() != nil {
+		return kvs.NewInvalidValueError(ErrInvalidNat64Prefix, "prefix")
+	}
+	switch ones, _ := ipNet.Mask.Size(); ones {
+	case 32, 40, 48, 56, 64, 96:
+		return nil
+	default:
+		return kvs.NewInvalidValueError(ErrInvalidNat64Prefix, "prefix")
+	}
+}
+
+// Create configures NAT64 prefix.
+func (d *NAT64PrefixDescriptor) Create(key string, prefix *nat.Nat64Prefix) (metadata interface{}, err error) {
+	return nil, d.nat64Handler.AddNat64Prefix(prefix.VrfId, prefix.Prefix)
+}
+
+// Delete removes NAT64 prefix.
+func (d *NAT64PrefixDescriptor) Delete(key string, prefix *nat.Nat64Prefix, metadata interface{}) error {
+	return d.nat64Handler.DelNat64Prefix(prefix.VrfId, prefix.Prefix)
+}
+
+// Retrieve returns NAT64 prefixes configured on VPP.
+func (d *NAT64PrefixDescriptor) Retrieve(correlate []adapter.NAT64PrefixKVWithMetadata) (
+	retrieved []adapter.NAT64PrefixKVWithMetadata, err error) {
+	prefixes, err := d.nat64Handler.Nat64PrefixesDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, prefix := range prefixes {
+		retrieved = append(retrieved, adapter.NAT64PrefixKVWithMetadata{
+			Key:    models.Key(prefix),
+			Value:  prefix,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists NAT64 global configuration and non-zero VRF as dependencies.
+func (d *NAT64PrefixDescriptor) Dependencies(key string, prefix *nat.Nat64Prefix) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: nat64GlobalCfgDep,
+		Key:   nat.GlobalNAT64Key(),
+	})
+	if prefix.VrfId != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: addressVrfDep,
+			Key:   l3.VrfTableKey(prefix.VrfId, l3.VrfTable_IPV6),
+		})
+	}
+	return deps
+}
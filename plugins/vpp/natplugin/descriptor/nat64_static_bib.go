@@ -0,0 +1,124 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64StaticBibDescriptorName is the name of the descriptor for static NAT64 BIB entries.
+	NAT64StaticBibDescriptorName = "vpp-nat64-static-bib"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrInvalidNat64BibAddress is returned when inside address of the static BIB entry
+	// is not IPv6 or outside address is not IPv4.
+	ErrInvalidNat64BibAddress = errors.New("NAT64 static BIB requires IPv6 inside and IPv4 outside address")
+)
+
+// NAT64StaticBibDescriptor teaches KVScheduler how to configure static NAT64 BIB entries.
+type NAT64StaticBibDescriptor struct {
+	log          logging.Logger
+	nat64Handler vppcalls.Nat64VppAPI
+}
+
+// NewNAT64StaticBibDescriptor creates a new instance of the NAT64StaticBib descriptor.
+func NewNAT64StaticBibDescriptor(nat64Handler vppcalls.Nat64VppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64StaticBibDescriptor{
+		nat64Handler: nat64Handler,
+		log:          log.NewLogger("nat64-static-bib-descriptor"),
+	}
+
+	typedDescr := &adapter.NAT64StaticBibDescriptor{
+		Name:                 NAT64StaticBibDescriptorName,
+		NBKeyPrefix:          nat.ModelNat64StaticBib.KeyPrefix(),
+		ValueTypeName:        nat.ModelNat64StaticBib.ProtoName(),
+		KeySelector:          nat.ModelNat64StaticBib.IsKeyValid,
+		KeyLabel:             nat.ModelNat64StaticBib.StripKeyPrefix,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{NAT64GlobalDescriptorName, NAT64AddressPoolDescriptorName},
+	}
+	return adapter.NewNAT64StaticBibDescriptor(typedDescr)
+}
+
+// Validate validates static NAT64 BIB entry.
+func (d *NAT64StaticBibDescriptor) Validate(key string, bib *nat.Nat64StaticBib) error {
+	insideIP := net.ParseIP(bib.InsideIp)
+	if insideIP == nil || insideIP.To4() != nil {
+		return kvs.NewInvalidValueError(ErrInvalidNat64BibAddress, "inside_ip")
+	}
+	if _, err := ParseIPv4(bib.OutsideIp); err != nil {
+		return kvs.NewInvalidValueError(ErrInvalidNat64BibAddress, "outside_ip")
+	}
+	return nil
+}
+
+// Create adds static NAT64 BIB entry.
+func (d *NAT64StaticBibDescriptor) Create(key string, bib *nat.Nat64StaticBib) (metadata interface{}, err error) {
+	return nil, d.nat64Handler.AddNat64StaticBib(bib)
+}
+
+// Delete removes static NAT64 BIB entry.
+func (d *NAT64StaticBibDescriptor) Delete(key string, bib *nat.Nat64StaticBib, metadata interface{}) error {
+	return d.nat64Handler.DelNat64StaticBib(bib)
+}
+
+// Retrieve returns static NAT64 BIB entries configured on VPP.
+func (d *NAT64StaticBibDescriptor) Retrieve(correlate []adapter.NAT64StaticBibKVWithMetadata) (
+	retrieved []adapter.NAT64StaticBibKVWithMetadata, err error) {
+	bibs, err := d.nat64Handler.Nat64StaticBibsDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, bib := range bibs {
+		retrieved = append(retrieved, adapter.NAT64StaticBibKVWithMetadata{
+			Key:    models.Key(bib),
+			Value:  bib,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists NAT64 global configuration and non-zero VRF as dependencies.
+func (d *NAT64StaticBibDescriptor) Dependencies(key string, bib *nat.Nat64StaticBib) (deps []kvs.Dependency) {
+	deps = append(deps, kvs.Dependency{
+		Label: nat64GlobalCfgDep,
+		Key:   nat.GlobalNAT64Key(),
+	})
+	if bib.VrfId != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: addressVrfDep,
+			Key:   l3.VrfTableKey(bib.VrfId, l3.VrfTable_IPV6),
+		})
+	}
+	return deps
+}
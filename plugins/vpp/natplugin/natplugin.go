@@ -18,6 +18,14 @@
 //go:generate descriptor-adapter --descriptor-name DNAT44 --value-type *vpp_nat.DNat44 --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name NAT44Interface --value-type *vpp_nat.Nat44Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name NAT44AddressPool --value-type *vpp_nat.Nat44AddressPool --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64Global --value-type *vpp_nat.Nat64Global --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64Prefix --value-type *vpp_nat.Nat64Prefix --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64AddressPool --value-type *vpp_nat.Nat64AddressPool --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64Interface --value-type *vpp_nat.Nat64Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64StaticBib --value-type *vpp_nat.Nat64StaticBib --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name DET44Global --value-type *vpp_nat.Det44Global --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name DET44Mapping --value-type *vpp_nat.Det44Mapping --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name DET44Interface --value-type *vpp_nat.Det44Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 
 package natplugin
 
@@ -44,7 +52,9 @@ type NATPlugin struct {
 	Deps
 
 	// handlers
-	natHandler vppcalls.NatVppAPI
+	natHandler   vppcalls.NatVppAPI
+	nat64Handler vppcalls.Nat64VppAPI
+	det44Handler vppcalls.Det44VppAPI
 }
 
 // Deps lists dependencies of the NAT plugin.
@@ -58,11 +68,32 @@ type Deps struct {
 
 // Init registers NAT-related descriptors.
 func (p *NATPlugin) Init() (err error) {
-	if !p.VPP.IsPluginLoaded("nat") {
+	if p.VPP.IsPluginLoaded("nat") {
+		if err = p.initNAT44(); err != nil {
+			return err
+		}
+	} else {
 		p.Log.Warnf("VPP plugin NAT was disabled by VPP")
-		return nil
 	}
+	if p.VPP.IsPluginLoaded("nat64") {
+		if err = p.initNAT64(); err != nil {
+			return err
+		}
+	} else {
+		p.Log.Warnf("VPP plugin NAT64 was disabled by VPP")
+	}
+	if p.VPP.IsPluginLoaded("det44") {
+		if err = p.initDET44(); err != nil {
+			return err
+		}
+	} else {
+		p.Log.Warnf("VPP plugin DET44 was disabled by VPP")
+	}
+	return nil
+}
 
+// initNAT44 initializes NAT44 handler and registers NAT44 descriptors.
+func (p *NATPlugin) initNAT44() (err error) {
 	// init handlers
 	p.natHandler = vppcalls.CompatibleNatVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.IfPlugin.GetDHCPIndex(), p.Log)
 	if p.natHandler == nil {
@@ -92,6 +123,46 @@ func (p *NATPlugin) Init() (err error) {
 	return nil
 }
 
+// initNAT64 initializes NAT64 handler and registers NAT64 descriptors.
+func (p *NATPlugin) initNAT64() (err error) {
+	p.nat64Handler = vppcalls.CompatibleNat64VppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.nat64Handler == nil {
+		return errors.New("nat64Handler is not available")
+	}
+
+	err = p.KVScheduler.RegisterKVDescriptor(
+		descriptor.NewNAT64GlobalDescriptor(p.nat64Handler, p.Log),
+		descriptor.NewNAT64PrefixDescriptor(p.nat64Handler, p.Log),
+		descriptor.NewNAT64AddressPoolDescriptor(p.nat64Handler, p.Log),
+		descriptor.NewNAT64InterfaceDescriptor(p.nat64Handler, p.Log),
+		descriptor.NewNAT64StaticBibDescriptor(p.nat64Handler, p.Log),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// initDET44 initializes DET44 handler and registers DET44 descriptors.
+func (p *NATPlugin) initDET44() (err error) {
+	p.det44Handler = vppcalls.CompatibleDet44VppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.det44Handler == nil {
+		return errors.New("det44Handler is not available")
+	}
+
+	err = p.KVScheduler.RegisterKVDescriptor(
+		descriptor.NewDET44GlobalDescriptor(p.det44Handler, p.Log),
+		descriptor.NewDET44MappingDescriptor(p.det44Handler, p.Log),
+		descriptor.NewDET44InterfaceDescriptor(p.det44Handler, p.Log),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AfterInit registers plugin with StatusCheck.
 func (p *NATPlugin) AfterInit() error {
 	if p.StatusCheck != nil {
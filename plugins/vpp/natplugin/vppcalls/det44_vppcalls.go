@@ -0,0 +1,89 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// Det44VppAPI provides methods for managing VPP deterministic NAT44 (DET44) configuration.
+type Det44VppAPI interface {
+	Det44VppRead
+
+	// EnableDET44Plugin enables DET44 plugin with the inside/outside VRFs
+	// given by the global configuration.
+	EnableDET44Plugin(globalCfg *nat.Det44Global) error
+	// DisableDET44Plugin disables DET44 plugin.
+	DisableDET44Plugin() error
+	// SetDet44Timeouts configures DET44 session timeouts (zero values are replaced with defaults).
+	SetDet44Timeouts(timeouts *nat.NatTimeouts) error
+	// AddDet44Mapping adds deterministic mapping of inside network onto outside network.
+	AddDet44Mapping(mapping *nat.Det44Mapping) error
+	// DelDet44Mapping removes deterministic mapping.
+	DelDet44Mapping(mapping *nat.Det44Mapping) error
+	// EnableDet44Interface enables DET44 feature for provided interface.
+	EnableDet44Interface(iface string, isInside bool) error
+	// DisableDet44Interface disables DET44 feature for provided interface.
+	DisableDet44Interface(iface string, isInside bool) error
+}
+
+// Det44VppRead provides read methods for VPP DET44 configuration.
+type Det44VppRead interface {
+	// Det44TimeoutsDump dumps DET44 session timeouts.
+	Det44TimeoutsDump() (*nat.NatTimeouts, error)
+	// Det44MappingsDump dumps all configured DET44 mappings.
+	Det44MappingsDump() ([]*nat.Det44Mapping, error)
+	// Det44InterfacesDump dumps DET44 config of all DET44-enabled interfaces.
+	Det44InterfacesDump() ([]*nat.Det44Interface, error)
+}
+
+var det44Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "det44",
+	HandlerAPI: (*Det44VppAPI)(nil),
+})
+
+type NewDet44HandlerFunc func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) Det44VppAPI
+
+func AddDet44HandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewDet44HandlerFunc) {
+	det44Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleDet44VppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) Det44VppAPI {
+	if v := det44Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(Det44VppAPI)
+	}
+	return nil
+}
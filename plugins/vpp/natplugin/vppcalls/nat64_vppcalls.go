@@ -0,0 +1,111 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// Default NAT session timeouts (in seconds) used by VPP NAT64 and DET44 plugins.
+const (
+	DefaultUDPTimeout            = 300
+	DefaultTCPEstablishedTimeout = 7440
+	DefaultTCPTransitoryTimeout  = 240
+	DefaultICMPTimeout           = 60
+)
+
+// Nat64VppAPI provides methods for managing VPP NAT64 configuration.
+type Nat64VppAPI interface {
+	Nat64VppRead
+
+	// EnableNAT64Plugin enables NAT64 plugin with the BIB and session table
+	// sizing given by the global configuration.
+	EnableNAT64Plugin(globalCfg *nat.Nat64Global) error
+	// DisableNAT64Plugin disables NAT64 plugin.
+	DisableNAT64Plugin() error
+	// SetNat64Timeouts configures NAT64 session timeouts (zero values are replaced with defaults).
+	SetNat64Timeouts(timeouts *nat.NatTimeouts) error
+	// AddNat64Prefix configures NAT64 prefix for the given VRF.
+	AddNat64Prefix(vrf uint32, prefix string) error
+	// DelNat64Prefix removes NAT64 prefix configured for the given VRF.
+	DelNat64Prefix(vrf uint32, prefix string) error
+	// AddNat64AddressPool adds new IPv4 address range into the NAT64 pools.
+	AddNat64AddressPool(vrf uint32, firstIP, lastIP string) error
+	// DelNat64AddressPool removes existing IPv4 address range from the NAT64 pools.
+	DelNat64AddressPool(vrf uint32, firstIP, lastIP string) error
+	// EnableNat64Interface enables NAT64 feature for provided interface.
+	EnableNat64Interface(iface string, isInside bool) error
+	// DisableNat64Interface disables NAT64 feature for provided interface.
+	DisableNat64Interface(iface string, isInside bool) error
+	// AddNat64StaticBib adds static entry into NAT64 BIB.
+	AddNat64StaticBib(bib *nat.Nat64StaticBib) error
+	// DelNat64StaticBib removes static entry from NAT64 BIB.
+	DelNat64StaticBib(bib *nat.Nat64StaticBib) error
+}
+
+// Nat64VppRead provides read methods for VPP NAT64 configuration.
+type Nat64VppRead interface {
+	// Nat64TimeoutsDump dumps NAT64 session timeouts.
+	Nat64TimeoutsDump() (*nat.NatTimeouts, error)
+	// Nat64PrefixesDump dumps all configured NAT64 prefixes.
+	Nat64PrefixesDump() ([]*nat.Nat64Prefix, error)
+	// Nat64AddressPoolsDump dumps all configured NAT64 address pools.
+	// VPP dumps individual addresses, consecutive addresses from the same VRF
+	// are therefore merged into a single (unnamed) pool.
+	Nat64AddressPoolsDump() ([]*nat.Nat64AddressPool, error)
+	// Nat64InterfacesDump dumps NAT64 config of all NAT64-enabled interfaces.
+	Nat64InterfacesDump() ([]*nat.Nat64Interface, error)
+	// Nat64StaticBibsDump dumps all static NAT64 BIB entries.
+	Nat64StaticBibsDump() ([]*nat.Nat64StaticBib, error)
+}
+
+var nat64Handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "nat64",
+	HandlerAPI: (*Nat64VppAPI)(nil),
+})
+
+type NewNat64HandlerFunc func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) Nat64VppAPI
+
+func AddNat64HandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewNat64HandlerFunc) {
+	nat64Handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatibleNat64VppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) Nat64VppAPI {
+	if v := nat64Handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(Nat64VppAPI)
+	}
+	return nil
+}
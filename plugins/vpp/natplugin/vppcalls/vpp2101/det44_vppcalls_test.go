@@ -0,0 +1,161 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	vpp_det44 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/det44"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls/vpp2101"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+func TestEnableDet44Plugin(t *testing.T) {
+	ctx, det44Handler, _ := det44TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_det44.Det44PluginEnableDisableReply{})
+	err := det44Handler.EnableDET44Plugin(&nat.Det44Global{InsideVrf: 1, OutsideVrf: 2})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_det44.Det44PluginEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Enable).To(BeTrue())
+	Expect(msg.InsideVrf).To(BeEquivalentTo(1))
+	Expect(msg.OutsideVrf).To(BeEquivalentTo(2))
+}
+
+func TestAddDet44Mapping(t *testing.T) {
+	ctx, det44Handler, _ := det44TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_det44.Det44AddDelMapReply{})
+	err := det44Handler.AddDet44Mapping(&nat.Det44Mapping{
+		InsidePrefix:  "10.0.0.0/24",
+		OutsidePrefix: "1.1.1.0/28",
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_det44.Det44AddDelMap)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.InAddr.String()).To(Equal("10.0.0.0"))
+	Expect(msg.InPlen).To(BeEquivalentTo(24))
+	Expect(msg.OutAddr.String()).To(Equal("1.1.1.0"))
+	Expect(msg.OutPlen).To(BeEquivalentTo(28))
+}
+
+func TestAddDet44MappingInvalid(t *testing.T) {
+	ctx, det44Handler, _ := det44TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_det44.Det44AddDelMapReply{})
+	err := det44Handler.AddDet44Mapping(&nat.Det44Mapping{
+		InsidePrefix:  "2001:db8::/64",
+		OutsidePrefix: "1.1.1.0/28",
+	})
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDisableDet44Interface(t *testing.T) {
+	ctx, det44Handler, swIfIndexes := det44TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 3})
+
+	ctx.MockVpp.MockReply(&vpp_det44.Det44InterfaceAddDelFeatureReply{})
+	err := det44Handler.DisableDet44Interface("if0", true)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_det44.Det44InterfaceAddDelFeature)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeFalse())
+	Expect(msg.IsInside).To(BeTrue())
+	Expect(msg.SwIfIndex).To(BeEquivalentTo(3))
+}
+
+func TestDet44TimeoutsDump(t *testing.T) {
+	ctx, det44Handler, _ := det44TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_det44.Det44GetTimeoutsReply{
+		UDP:            vppcalls.DefaultUDPTimeout,
+		TCPEstablished: 1000,
+		TCPTransitory:  vppcalls.DefaultTCPTransitoryTimeout,
+		ICMP:           vppcalls.DefaultICMPTimeout,
+	})
+
+	timeouts, err := det44Handler.Det44TimeoutsDump()
+	Expect(err).To(Succeed())
+	Expect(timeouts.Udp).To(BeEquivalentTo(vppcalls.DefaultUDPTimeout))
+	Expect(timeouts.TcpEstablished).To(BeEquivalentTo(1000))
+}
+
+func TestDet44MappingsDump(t *testing.T) {
+	ctx, det44Handler, _ := det44TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_det44.Det44MapDetails{
+		InAddr:  ipTo4Address("10.0.0.0"),
+		InPlen:  24,
+		OutAddr: ipTo4Address("1.1.1.0"),
+		OutPlen: 28,
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	mappings, err := det44Handler.Det44MappingsDump()
+	Expect(err).To(Succeed())
+	Expect(mappings).To(HaveLen(1))
+	Expect(mappings[0].InsidePrefix).To(Equal("10.0.0.0/24"))
+	Expect(mappings[0].OutsidePrefix).To(Equal("1.1.1.0/28"))
+}
+
+func TestDet44InterfacesDump(t *testing.T) {
+	ctx, det44Handler, swIfIndexes := det44TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_det44.Det44InterfaceDetails{SwIfIndex: 1, IsInside: true},
+		&vpp_det44.Det44InterfaceDetails{SwIfIndex: 2, IsOutside: true},
+	)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	swIfIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	interfaces, err := det44Handler.Det44InterfacesDump()
+	Expect(err).To(Succeed())
+	Expect(interfaces).To(HaveLen(2))
+	Expect(interfaces[0].Name).To(Equal("if0"))
+	Expect(interfaces[0].NatInside).To(BeTrue())
+	Expect(interfaces[1].Name).To(Equal("if1"))
+	Expect(interfaces[1].NatOutside).To(BeTrue())
+}
+
+func det44TestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.Det44VppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	swIfIndexes := ifaceidx.NewIfaceIndex(logrus.DefaultLogger(), "test-sw_if_indexes")
+	det44Handler := vpp2101.NewDet44VppHandler(ctx.MockChannel, swIfIndexes, log)
+	return ctx, det44Handler, swIfIndexes
+}
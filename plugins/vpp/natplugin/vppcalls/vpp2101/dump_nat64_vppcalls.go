@@ -0,0 +1,161 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101
+
+import (
+	"fmt"
+	"net"
+
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/nat_types"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// allProtocols is used in BIB dump request to dump entries of all protocols.
+const allProtocols uint8 = 255
+
+// Nat64TimeoutsDump dumps NAT64 session timeouts.
+func (h *Nat64VppHandler) Nat64TimeoutsDump() (*nat.NatTimeouts, error) {
+	req := &vpp_nat64.Nat64GetTimeouts{}
+	reply := &vpp_nat64.Nat64GetTimeoutsReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return nil, fmt.Errorf("failed to dump NAT64 timeouts: %v", err)
+	}
+	return &nat.NatTimeouts{
+		Udp:            reply.UDP,
+		TcpEstablished: reply.TCPEstablished,
+		TcpTransitory:  reply.TCPTransitory,
+		Icmp:           reply.ICMP,
+	}, nil
+}
+
+// Nat64PrefixesDump dumps all configured NAT64 prefixes.
+func (h *Nat64VppHandler) Nat64PrefixesDump() (prefixes []*nat.Nat64Prefix, err error) {
+	req := &vpp_nat64.Nat64PrefixDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat64.Nat64PrefixDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 prefixes: %v", err)
+		}
+		if stop {
+			break
+		}
+		prefixes = append(prefixes, &nat.Nat64Prefix{
+			VrfId:  msg.VrfID,
+			Prefix: msg.Prefix.String(),
+		})
+	}
+	return prefixes, nil
+}
+
+// Nat64AddressPoolsDump dumps all configured NAT64 address pools.
+func (h *Nat64VppHandler) Nat64AddressPoolsDump() (natPools []*nat.Nat64AddressPool, err error) {
+	var curPool *nat.Nat64AddressPool
+	var lastIP net.IP
+
+	req := &vpp_nat64.Nat64PoolAddrDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat64.Nat64PoolAddrDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 address pool: %v", err)
+		}
+		if stop {
+			break
+		}
+		ip := net.IP(msg.Address[:])
+		// merge subsequent IPs into a single pool
+		if curPool != nil && curPool.VrfId == msg.VrfID && ip.Equal(incIP(lastIP)) {
+			// update current pool
+			curPool.LastIp = ip.String()
+		} else {
+			// start a new pool
+			pool := &nat.Nat64AddressPool{
+				FirstIp: ip.String(),
+				VrfId:   msg.VrfID,
+			}
+			curPool = pool
+			natPools = append(natPools, pool)
+		}
+		lastIP = ip
+	}
+	return natPools, nil
+}
+
+// Nat64InterfacesDump dumps NAT64 config of all NAT64-enabled interfaces.
+func (h *Nat64VppHandler) Nat64InterfacesDump() (natIfs []*nat.Nat64Interface, err error) {
+	req := &vpp_nat64.Nat64InterfaceDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat64.Nat64InterfaceDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 interface: %v", err)
+		}
+		if stop {
+			break
+		}
+		ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(msg.SwIfIndex))
+		if !found {
+			h.log.Warnf("Interface with index %d not found in the mapping", msg.SwIfIndex)
+			continue
+		}
+		natIfs = append(natIfs, &nat.Nat64Interface{
+			Name:       ifName,
+			NatInside:  msg.Flags&nat_types.NAT_IS_INSIDE != 0,
+			NatOutside: msg.Flags&nat_types.NAT_IS_OUTSIDE != 0,
+		})
+	}
+	return natIfs, nil
+}
+
+// Nat64StaticBibsDump dumps all static NAT64 BIB entries.
+func (h *Nat64VppHandler) Nat64StaticBibsDump() (bibs []*nat.Nat64StaticBib, err error) {
+	req := &vpp_nat64.Nat64BibDump{
+		Proto: allProtocols,
+	}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat64.Nat64BibDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 BIB: %v", err)
+		}
+		if stop {
+			break
+		}
+		// skip dynamic entries created for sessions
+		if msg.Flags&nat_types.NAT_IS_STATIC == 0 {
+			continue
+		}
+		bibs = append(bibs, &nat.Nat64StaticBib{
+			VrfId:       msg.VrfID,
+			Protocol:    h.protocolNumberToNBValue(msg.Proto),
+			InsideIp:    msg.IAddr.String(),
+			InsidePort:  uint32(msg.IPort),
+			OutsideIp:   msg.OAddr.String(),
+			OutsidePort: uint32(msg.OPort),
+		})
+	}
+	return bibs, nil
+}
@@ -0,0 +1,136 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2106
+
+import (
+	"github.com/pkg/errors"
+
+	vpp_det44 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/det44"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip_types"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// EnableDET44Plugin enables DET44 plugin.
+func (h *Det44VppHandler) EnableDET44Plugin(globalCfg *nat.Det44Global) error {
+	req := &vpp_det44.Det44PluginEnableDisable{
+		InsideVrf:  globalCfg.GetInsideVrf(),
+		OutsideVrf: globalCfg.GetOutsideVrf(),
+		Enable:     true,
+	}
+	reply := &vpp_det44.Det44PluginEnableDisableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DisableDET44Plugin disables DET44 plugin.
+func (h *Det44VppHandler) DisableDET44Plugin() error {
+	req := &vpp_det44.Det44PluginEnableDisable{
+		Enable: false,
+	}
+	reply := &vpp_det44.Det44PluginEnableDisableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetDet44Timeouts configures DET44 session timeouts.
+func (h *Det44VppHandler) SetDet44Timeouts(timeouts *nat.NatTimeouts) error {
+	udp, tcpEst, tcpTrans, icmp := timeoutsWithDefaults(timeouts)
+	req := &vpp_det44.Det44SetTimeouts{
+		UDP:            udp,
+		TCPEstablished: tcpEst,
+		TCPTransitory:  tcpTrans,
+		ICMP:           icmp,
+	}
+	reply := &vpp_det44.Det44SetTimeoutsReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddDet44Mapping adds deterministic mapping of inside network onto outside network.
+func (h *Det44VppHandler) AddDet44Mapping(mapping *nat.Det44Mapping) error {
+	return h.handleDet44Mapping(mapping, true)
+}
+
+// DelDet44Mapping removes deterministic mapping.
+func (h *Det44VppHandler) DelDet44Mapping(mapping *nat.Det44Mapping) error {
+	return h.handleDet44Mapping(mapping, false)
+}
+
+// EnableDet44Interface enables DET44 feature for provided interface.
+func (h *Det44VppHandler) EnableDet44Interface(iface string, isInside bool) error {
+	return h.handleDet44Interface(iface, isInside, true)
+}
+
+// DisableDet44Interface disables DET44 feature for provided interface.
+func (h *Det44VppHandler) DisableDet44Interface(iface string, isInside bool) error {
+	return h.handleDet44Interface(iface, isInside, false)
+}
+
+// Calls VPP binary API to add/remove DET44 mapping.
+func (h *Det44VppHandler) handleDet44Mapping(mapping *nat.Det44Mapping, isAdd bool) error {
+	inPrefix, err := ip_types.ParseIP4Prefix(mapping.InsidePrefix)
+	if err != nil {
+		return errors.Errorf("unable to parse inside prefix %s: %v", mapping.InsidePrefix, err)
+	}
+	outPrefix, err := ip_types.ParseIP4Prefix(mapping.OutsidePrefix)
+	if err != nil {
+		return errors.Errorf("unable to parse outside prefix %s: %v", mapping.OutsidePrefix, err)
+	}
+
+	req := &vpp_det44.Det44AddDelMap{
+		IsAdd:   isAdd,
+		InAddr:  inPrefix.Address,
+		InPlen:  inPrefix.Len,
+		OutAddr: outPrefix.Address,
+		OutPlen: outPrefix.Len,
+	}
+	reply := &vpp_det44.Det44AddDelMapReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Calls VPP binary API to set/unset interface DET44 feature.
+func (h *Det44VppHandler) handleDet44Interface(iface string, isInside, isAdd bool) error {
+	// get interface metadata
+	ifaceMeta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return errors.New("failed to get interface metadata")
+	}
+
+	req := &vpp_det44.Det44InterfaceAddDelFeature{
+		IsAdd:     isAdd,
+		IsInside:  isInside,
+		SwIfIndex: interface_types.InterfaceIndex(ifaceMeta.SwIfIndex),
+	}
+	reply := &vpp_det44.Det44InterfaceAddDelFeatureReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2106
+
+import (
+	"fmt"
+
+	vpp_det44 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/det44"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip_types"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// Det44TimeoutsDump dumps DET44 session timeouts.
+func (h *Det44VppHandler) Det44TimeoutsDump() (*nat.NatTimeouts, error) {
+	req := &vpp_det44.Det44GetTimeouts{}
+	reply := &vpp_det44.Det44GetTimeoutsReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return nil, fmt.Errorf("failed to dump DET44 timeouts: %v", err)
+	}
+	return &nat.NatTimeouts{
+		Udp:            reply.UDP,
+		TcpEstablished: reply.TCPEstablished,
+		TcpTransitory:  reply.TCPTransitory,
+		Icmp:           reply.ICMP,
+	}, nil
+}
+
+// Det44MappingsDump dumps all configured DET44 mappings.
+func (h *Det44VppHandler) Det44MappingsDump() (mappings []*nat.Det44Mapping, err error) {
+	req := &vpp_det44.Det44MapDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_det44.Det44MapDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump DET44 mappings: %v", err)
+		}
+		if stop {
+			break
+		}
+		inPrefix := ip_types.IP4Prefix{Address: msg.InAddr, Len: msg.InPlen}
+		outPrefix := ip_types.IP4Prefix{Address: msg.OutAddr, Len: msg.OutPlen}
+		mappings = append(mappings, &nat.Det44Mapping{
+			InsidePrefix:  inPrefix.String(),
+			OutsidePrefix: outPrefix.String(),
+		})
+	}
+	return mappings, nil
+}
+
+// Det44InterfacesDump dumps DET44 config of all DET44-enabled interfaces.
+func (h *Det44VppHandler) Det44InterfacesDump() (natIfs []*nat.Det44Interface, err error) {
+	req := &vpp_det44.Det44InterfaceDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_det44.Det44InterfaceDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump DET44 interface: %v", err)
+		}
+		if stop {
+			break
+		}
+		ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(msg.SwIfIndex))
+		if !found {
+			h.log.Warnf("Interface with index %d not found in the mapping", msg.SwIfIndex)
+			continue
+		}
+		natIfs = append(natIfs, &nat.Det44Interface{
+			Name:       ifName,
+			NatInside:  msg.IsInside,
+			NatOutside: msg.IsOutside,
+		})
+	}
+	return natIfs, nil
+}
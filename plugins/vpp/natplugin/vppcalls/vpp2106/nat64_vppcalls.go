@@ -0,0 +1,269 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2106
+
+import (
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/ip_types"
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/nat_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// EnableNAT64Plugin enables NAT64 plugin.
+func (h *Nat64VppHandler) EnableNAT64Plugin(globalCfg *nat.Nat64Global) error {
+	req := &vpp_nat64.Nat64PluginEnableDisable{
+		BibBuckets:    globalCfg.GetBibBuckets(),
+		BibMemorySize: globalCfg.GetBibMemorySize(),
+		StBuckets:     globalCfg.GetStBuckets(),
+		StMemorySize:  globalCfg.GetStMemorySize(),
+		Enable:        true,
+	}
+	reply := &vpp_nat64.Nat64PluginEnableDisableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DisableNAT64Plugin disables NAT64 plugin.
+func (h *Nat64VppHandler) DisableNAT64Plugin() error {
+	req := &vpp_nat64.Nat64PluginEnableDisable{
+		Enable: false,
+	}
+	reply := &vpp_nat64.Nat64PluginEnableDisableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetNat64Timeouts configures NAT64 session timeouts.
+func (h *Nat64VppHandler) SetNat64Timeouts(timeouts *nat.NatTimeouts) error {
+	udp, tcpEst, tcpTrans, icmp := timeoutsWithDefaults(timeouts)
+	req := &vpp_nat64.Nat64SetTimeouts{
+		UDP:            udp,
+		TCPEstablished: tcpEst,
+		TCPTransitory:  tcpTrans,
+		ICMP:           icmp,
+	}
+	reply := &vpp_nat64.Nat64SetTimeoutsReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddNat64Prefix configures NAT64 prefix for the given VRF.
+func (h *Nat64VppHandler) AddNat64Prefix(vrf uint32, prefix string) error {
+	return h.handleNat64Prefix(vrf, prefix, true)
+}
+
+// DelNat64Prefix removes NAT64 prefix configured for the given VRF.
+func (h *Nat64VppHandler) DelNat64Prefix(vrf uint32, prefix string) error {
+	return h.handleNat64Prefix(vrf, prefix, false)
+}
+
+// AddNat64AddressPool adds new IPv4 address range into the NAT64 pools.
+func (h *Nat64VppHandler) AddNat64AddressPool(vrf uint32, firstIP, lastIP string) error {
+	return h.handleNat64AddressPool(vrf, firstIP, lastIP, true)
+}
+
+// DelNat64AddressPool removes existing IPv4 address range from the NAT64 pools.
+func (h *Nat64VppHandler) DelNat64AddressPool(vrf uint32, firstIP, lastIP string) error {
+	return h.handleNat64AddressPool(vrf, firstIP, lastIP, false)
+}
+
+// EnableNat64Interface enables NAT64 feature for provided interface.
+func (h *Nat64VppHandler) EnableNat64Interface(iface string, isInside bool) error {
+	return h.handleNat64Interface(iface, isInside, true)
+}
+
+// DisableNat64Interface disables NAT64 feature for provided interface.
+func (h *Nat64VppHandler) DisableNat64Interface(iface string, isInside bool) error {
+	return h.handleNat64Interface(iface, isInside, false)
+}
+
+// AddNat64StaticBib adds static entry into NAT64 BIB.
+func (h *Nat64VppHandler) AddNat64StaticBib(bib *nat.Nat64StaticBib) error {
+	return h.handleNat64StaticBib(bib, true)
+}
+
+// DelNat64StaticBib removes static entry from NAT64 BIB.
+func (h *Nat64VppHandler) DelNat64StaticBib(bib *nat.Nat64StaticBib) error {
+	return h.handleNat64StaticBib(bib, false)
+}
+
+// Calls VPP binary API to add/remove NAT64 prefix.
+func (h *Nat64VppHandler) handleNat64Prefix(vrf uint32, prefix string, isAdd bool) error {
+	ip6Prefix, err := ip_types.ParseIP6Prefix(prefix)
+	if err != nil {
+		return errors.Errorf("unable to parse NAT64 prefix %s: %v", prefix, err)
+	}
+
+	req := &vpp_nat64.Nat64AddDelPrefix{
+		Prefix: ip6Prefix,
+		VrfID:  vrf,
+		IsAdd:  isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelPrefixReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Calls VPP binary API to add/remove addresses to/from the NAT64 pool.
+func (h *Nat64VppHandler) handleNat64AddressPool(vrf uint32, firstIP, lastIP string, isAdd bool) error {
+	firstAddr, err := ipTo4Address(firstIP)
+	if err != nil {
+		return errors.Errorf("unable to parse address %s from the NAT64 pool: %v", firstIP, err)
+	}
+	lastAddr := firstAddr
+	if lastIP != "" {
+		lastAddr, err = ipTo4Address(lastIP)
+		if err != nil {
+			return errors.Errorf("unable to parse address %s from the NAT64 pool: %v", lastIP, err)
+		}
+	}
+
+	req := &vpp_nat64.Nat64AddDelPoolAddrRange{
+		StartAddr: firstAddr,
+		EndAddr:   lastAddr,
+		VrfID:     vrf,
+		IsAdd:     isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelPoolAddrRangeReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Calls VPP binary API to set/unset interface NAT64 feature.
+func (h *Nat64VppHandler) handleNat64Interface(iface string, isInside, isAdd bool) error {
+	// get interface metadata
+	ifaceMeta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return errors.New("failed to get interface metadata")
+	}
+
+	var flags nat_types.NatConfigFlags
+	if isInside {
+		flags = nat_types.NAT_IS_INSIDE
+	} else {
+		flags = nat_types.NAT_IS_OUTSIDE
+	}
+
+	req := &vpp_nat64.Nat64AddDelInterface{
+		SwIfIndex: interface_types.InterfaceIndex(ifaceMeta.SwIfIndex),
+		Flags:     flags,
+		IsAdd:     isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelInterfaceReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Calls VPP binary API to add/remove static NAT64 BIB entry.
+func (h *Nat64VppHandler) handleNat64StaticBib(bib *nat.Nat64StaticBib, isAdd bool) error {
+	insideAddr, err := ip_types.ParseIP6Address(bib.InsideIp)
+	if err != nil {
+		return errors.Errorf("unable to parse inside IPv6 address %s: %v", bib.InsideIp, err)
+	}
+	outsideAddr, err := ipTo4Address(bib.OutsideIp)
+	if err != nil {
+		return errors.Errorf("unable to parse outside IPv4 address %s: %v", bib.OutsideIp, err)
+	}
+
+	req := &vpp_nat64.Nat64AddDelStaticBib{
+		IAddr: insideAddr,
+		OAddr: outsideAddr,
+		IPort: uint16(bib.InsidePort),
+		OPort: uint16(bib.OutsidePort),
+		VrfID: bib.VrfId,
+		Proto: h.protocolNBValueToNumber(bib.Protocol),
+		IsAdd: isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelStaticBibReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// protocolNBValueToNumber converts protocol enum value from the NB model into the
+// corresponding numeric representation.
+func (h *Nat64VppHandler) protocolNBValueToNumber(protocol nat.DNat44_Protocol) uint8 {
+	switch protocol {
+	case nat.DNat44_TCP:
+		return TCP
+	case nat.DNat44_UDP:
+		return UDP
+	case nat.DNat44_ICMP:
+		return ICMP
+	default:
+		h.log.Warnf("Unknown protocol %v, defaulting to TCP", protocol)
+		return TCP
+	}
+}
+
+// protocolNumberToNBValue converts protocol numeric representation into the corresponding
+// enum value from the NB model.
+func (h *Nat64VppHandler) protocolNumberToNBValue(protocol uint8) nat.DNat44_Protocol {
+	switch protocol {
+	case TCP:
+		return nat.DNat44_TCP
+	case UDP:
+		return nat.DNat44_UDP
+	case ICMP:
+		return nat.DNat44_ICMP
+	default:
+		h.log.Warnf("Unknown protocol %v", protocol)
+		return 0
+	}
+}
+
+// timeoutsWithDefaults returns NAT session timeouts with zero values replaced
+// by VPP defaults.
+func timeoutsWithDefaults(timeouts *nat.NatTimeouts) (udp, tcpEst, tcpTrans, icmp uint32) {
+	udp, tcpEst, tcpTrans, icmp = timeouts.GetUdp(), timeouts.GetTcpEstablished(),
+		timeouts.GetTcpTransitory(), timeouts.GetIcmp()
+	if udp == 0 {
+		udp = vppcalls.DefaultUDPTimeout
+	}
+	if tcpEst == 0 {
+		tcpEst = vppcalls.DefaultTCPEstablishedTimeout
+	}
+	if tcpTrans == 0 {
+		tcpTrans = vppcalls.DefaultTCPTransitoryTimeout
+	}
+	if icmp == 0 {
+		icmp = vppcalls.DefaultICMPTimeout
+	}
+	return
+}
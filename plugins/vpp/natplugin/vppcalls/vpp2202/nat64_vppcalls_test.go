@@ -0,0 +1,247 @@
+//  Copyright (c) 2022 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2202_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memclnt"
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/nat_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls/vpp2202"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+func TestEnableNat64Plugin(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64PluginEnableDisableReply{})
+	err := nat64Handler.EnableNAT64Plugin(&nat.Nat64Global{
+		BibBuckets: 2048,
+		StBuckets:  4096,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64PluginEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(msg.Enable).To(BeTrue())
+	Expect(msg.BibBuckets).To(BeEquivalentTo(2048))
+	Expect(msg.StBuckets).To(BeEquivalentTo(4096))
+}
+
+func TestSetNat64Timeouts(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64SetTimeoutsReply{})
+	err := nat64Handler.SetNat64Timeouts(&nat.NatTimeouts{Udp: 100})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64SetTimeouts)
+	Expect(ok).To(BeTrue())
+	Expect(msg.UDP).To(BeEquivalentTo(100))
+	Expect(msg.TCPEstablished).To(BeEquivalentTo(vppcalls.DefaultTCPEstablishedTimeout))
+	Expect(msg.TCPTransitory).To(BeEquivalentTo(vppcalls.DefaultTCPTransitoryTimeout))
+	Expect(msg.ICMP).To(BeEquivalentTo(vppcalls.DefaultICMPTimeout))
+}
+
+func TestAddNat64Prefix(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPrefixReply{})
+	err := nat64Handler.AddNat64Prefix(1, "64:ff9b::/96")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.VrfID).To(BeEquivalentTo(1))
+	Expect(msg.Prefix.String()).To(Equal("64:ff9b::/96"))
+}
+
+func TestAddNat64PrefixInvalid(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPrefixReply{})
+	err := nat64Handler.AddNat64Prefix(0, "10.0.0.0/24")
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestDelNat64AddressPool(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPoolAddrRangeReply{})
+	err := nat64Handler.DelNat64AddressPool(0, "10.1.1.1", "10.1.1.5")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelPoolAddrRange)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeFalse())
+	Expect(msg.StartAddr.String()).To(Equal("10.1.1.1"))
+	Expect(msg.EndAddr.String()).To(Equal("10.1.1.5"))
+}
+
+func TestEnableNat64Interface(t *testing.T) {
+	ctx, nat64Handler, swIfIndexes := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelInterfaceReply{})
+	err := nat64Handler.EnableNat64Interface("if0", false)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelInterface)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(msg.Flags).To(Equal(nat_types.NAT_IS_OUTSIDE))
+}
+
+func TestEnableNat64InterfaceMissing(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelInterfaceReply{})
+	err := nat64Handler.EnableNat64Interface("if0", true)
+	Expect(err).Should(HaveOccurred())
+}
+
+func TestAddNat64StaticBib(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelStaticBibReply{})
+	err := nat64Handler.AddNat64StaticBib(&nat.Nat64StaticBib{
+		VrfId:       0,
+		Protocol:    nat.DNat44_UDP,
+		InsideIp:    "2001:db8::1",
+		InsidePort:  53,
+		OutsideIp:   "10.1.1.1",
+		OutsidePort: 5353,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelStaticBib)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.Proto).To(Equal(vpp2202.UDP))
+	Expect(msg.IAddr.String()).To(Equal("2001:db8::1"))
+	Expect(msg.IPort).To(BeEquivalentTo(53))
+	Expect(msg.OAddr.String()).To(Equal("10.1.1.1"))
+	Expect(msg.OPort).To(BeEquivalentTo(5353))
+}
+
+func TestNat64AddressPoolsDump(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_nat64.Nat64PoolAddrDetails{Address: ipTo4Address("10.1.1.1")},
+		&vpp_nat64.Nat64PoolAddrDetails{Address: ipTo4Address("10.1.1.2")},
+		&vpp_nat64.Nat64PoolAddrDetails{Address: ipTo4Address("10.1.1.3")},
+		&vpp_nat64.Nat64PoolAddrDetails{Address: ipTo4Address("10.1.1.10"), VrfID: 1},
+	)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	pools, err := nat64Handler.Nat64AddressPoolsDump()
+	Expect(err).To(Succeed())
+	Expect(pools).To(HaveLen(2))
+	Expect(pools[0].FirstIp).To(Equal("10.1.1.1"))
+	Expect(pools[0].LastIp).To(Equal("10.1.1.3"))
+	Expect(pools[0].VrfId).To(BeEquivalentTo(0))
+	Expect(pools[1].FirstIp).To(Equal("10.1.1.10"))
+	Expect(pools[1].LastIp).To(BeEmpty())
+	Expect(pools[1].VrfId).To(BeEquivalentTo(1))
+}
+
+func TestNat64InterfacesDump(t *testing.T) {
+	ctx, nat64Handler, swIfIndexes := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_nat64.Nat64InterfaceDetails{SwIfIndex: 1, Flags: nat_types.NAT_IS_INSIDE},
+		&vpp_nat64.Nat64InterfaceDetails{SwIfIndex: 2, Flags: nat_types.NAT_IS_OUTSIDE},
+		&vpp_nat64.Nat64InterfaceDetails{SwIfIndex: 3, Flags: nat_types.NAT_IS_OUTSIDE},
+	)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	swIfIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+
+	interfaces, err := nat64Handler.Nat64InterfacesDump()
+	Expect(err).To(Succeed())
+	Expect(interfaces).To(HaveLen(2))
+	Expect(interfaces[0].Name).To(Equal("if0"))
+	Expect(interfaces[0].NatInside).To(BeTrue())
+	Expect(interfaces[0].NatOutside).To(BeFalse())
+	Expect(interfaces[1].Name).To(Equal("if1"))
+	Expect(interfaces[1].NatInside).To(BeFalse())
+	Expect(interfaces[1].NatOutside).To(BeTrue())
+}
+
+func TestNat64StaticBibsDump(t *testing.T) {
+	ctx, nat64Handler, _ := nat64TestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	inside, _ := ip_types.ParseIP6Address("2001:db8::1")
+	ctx.MockVpp.MockReply(
+		&vpp_nat64.Nat64BibDetails{
+			IAddr: inside,
+			OAddr: ipTo4Address("10.1.1.1"),
+			IPort: 53,
+			OPort: 5353,
+			Proto: vpp2202.UDP,
+			Flags: nat_types.NAT_IS_STATIC,
+		},
+		&vpp_nat64.Nat64BibDetails{
+			IAddr: inside,
+			OAddr: ipTo4Address("10.1.1.1"),
+			IPort: 1024,
+			OPort: 1025,
+			Proto: vpp2202.TCP,
+		},
+	)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+
+	bibs, err := nat64Handler.Nat64StaticBibsDump()
+	Expect(err).To(Succeed())
+	Expect(bibs).To(HaveLen(1))
+	Expect(bibs[0].Protocol).To(Equal(nat.DNat44_UDP))
+	Expect(bibs[0].InsideIp).To(Equal("2001:db8::1"))
+	Expect(bibs[0].InsidePort).To(BeEquivalentTo(53))
+	Expect(bibs[0].OutsideIp).To(Equal("10.1.1.1"))
+	Expect(bibs[0].OutsidePort).To(BeEquivalentTo(5353))
+}
+
+func nat64TestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.Nat64VppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	swIfIndexes := ifaceidx.NewIfaceIndex(logrus.DefaultLogger(), "test-sw_if_indexes")
+	nat64Handler := vpp2202.NewNat64VppHandler(ctx.MockChannel, swIfIndexes, log)
+	return ctx, nat64Handler, swIfIndexes
+}
@@ -21,8 +21,10 @@ import (
 
 	"go.ligato.io/vpp-agent/v3/plugins/vpp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210"
+	vpp_det44 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/det44"
 	vpp_nat_ed "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat44_ed"
 	vpp_nat_ei "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat44_ei"
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/nat64"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
 )
@@ -33,6 +35,8 @@ func init() {
 	msgs = append(msgs, vpp_nat_ei.AllMessages()...)
 
 	vppcalls.AddNatHandlerVersion(vpp2210.Version, msgs, NewNatVppHandler)
+	vppcalls.AddNat64HandlerVersion(vpp2210.Version, vpp_nat64.AllMessages(), NewNat64VppHandler)
+	vppcalls.AddDet44HandlerVersion(vpp2210.Version, vpp_det44.AllMessages(), NewDet44VppHandler)
 }
 
 // NatVppHandler is accessor for NAT-related vppcalls methods.
@@ -61,3 +65,39 @@ func NewNatVppHandler(c vpp.Client,
 		ed:           true,
 	}
 }
+
+// Nat64VppHandler is accessor for NAT64-related vppcalls methods.
+type Nat64VppHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+// NewNat64VppHandler creates new instance of NAT64 vppcalls handler.
+func NewNat64VppHandler(callsChan govppapi.Channel,
+	ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger,
+) vppcalls.Nat64VppAPI {
+	return &Nat64VppHandler{
+		callsChannel: callsChan,
+		ifIndexes:    ifIndexes,
+		log:          log,
+	}
+}
+
+// Det44VppHandler is accessor for DET44-related vppcalls methods.
+type Det44VppHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+// NewDet44VppHandler creates new instance of DET44 vppcalls handler.
+func NewDet44VppHandler(callsChan govppapi.Channel,
+	ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger,
+) vppcalls.Det44VppAPI {
+	return &Det44VppHandler{
+		callsChannel: callsChan,
+		ifIndexes:    ifIndexes,
+		log:          log,
+	}
+}
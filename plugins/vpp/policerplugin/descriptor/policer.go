@@ -0,0 +1,212 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/idxmap"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/policeridx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+const (
+	// PolicerDescriptorName is the name of the descriptor for VPP policers.
+	PolicerDescriptorName = "vpp-policer"
+
+	// how many characters a policer name is allowed to have
+	//  - determined by much fits into the binary API (64 null-terminated character string)
+	policerNameLengthLimit = 63
+
+	// maximal value of DSCP
+	maxDscp = 63
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrPolicerWithoutName is returned when VPP policer has undefined name.
+	ErrPolicerWithoutName = errors.New("VPP policer defined without name")
+
+	// ErrPolicerNameTooLong is returned when VPP policer name exceeds the length limit.
+	ErrPolicerNameTooLong = errors.New("VPP policer name exceeds the length limit (63 characters)")
+
+	// ErrPolicerNameWithSlash is returned when VPP policer name contains slash
+	// (not allowed since the name is part of keys of policer-interface bindings).
+	ErrPolicerNameWithSlash = errors.New("VPP policer name must not contain '/'")
+
+	// ErrPolicerDscpTooHigh is returned when policer action marks packets with invalid DSCP.
+	ErrPolicerDscpTooHigh = errors.New("DSCP value exceeds the limit (63)")
+)
+
+// PolicerDescriptor teaches KVScheduler how to configure VPP policers.
+type PolicerDescriptor struct {
+	log            logging.Logger
+	policerHandler vppcalls.PolicerVppAPI
+}
+
+// NewPolicerDescriptor creates a new instance of the Policer descriptor.
+func NewPolicerDescriptor(
+	policerHandler vppcalls.PolicerVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &PolicerDescriptor{
+		policerHandler: policerHandler,
+		log:            log.NewLogger("policer-descriptor"),
+	}
+	typedDescr := &adapter.PolicerDescriptor{
+		Name:            PolicerDescriptorName,
+		NBKeyPrefix:     policer.ModelPolicer.KeyPrefix(),
+		ValueTypeName:   policer.ModelPolicer.ProtoName(),
+		KeySelector:     policer.ModelPolicer.IsKeyValid,
+		KeyLabel:        policer.ModelPolicer.StripKeyPrefix,
+		ValueComparator: ctx.EquivalentPolicers,
+		WithMetadata:    true,
+		MetadataMapFactory: func() idxmap.NamedMappingRW {
+			return policeridx.NewPolicerIndex(ctx.log, "vpp-policer-index")
+		},
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+		DerivedValues: ctx.DerivedValues,
+	}
+	return adapter.NewPolicerDescriptor(typedDescr)
+}
+
+// EquivalentPolicers is a comparison function for policer.Policer.
+// Interfaces are not compared - they are represented by derived values.
+func (d *PolicerDescriptor) EquivalentPolicers(key string, oldPolicer, newPolicer *policer.Policer) bool {
+	if oldPolicer.Cir != newPolicer.Cir ||
+		oldPolicer.Eir != newPolicer.Eir ||
+		oldPolicer.Cb != newPolicer.Cb ||
+		oldPolicer.Eb != newPolicer.Eb ||
+		oldPolicer.RateType != newPolicer.RateType ||
+		oldPolicer.RoundType != newPolicer.RoundType ||
+		oldPolicer.Type != newPolicer.Type ||
+		oldPolicer.ColorAware != newPolicer.ColorAware {
+		return false
+	}
+	return equivalentActions(oldPolicer.ConformAction, newPolicer.ConformAction) &&
+		equivalentActions(oldPolicer.ExceedAction, newPolicer.ExceedAction) &&
+		equivalentActions(oldPolicer.ViolateAction, newPolicer.ViolateAction)
+}
+
+// Validate validates VPP policer configuration.
+func (d *PolicerDescriptor) Validate(key string, p *policer.Policer) error {
+	if p.Name == "" {
+		return kvs.NewInvalidValueError(ErrPolicerWithoutName, "name")
+	}
+	if len(p.Name) > policerNameLengthLimit {
+		return kvs.NewInvalidValueError(ErrPolicerNameTooLong, "name")
+	}
+	if strings.Contains(p.Name, "/") {
+		return kvs.NewInvalidValueError(ErrPolicerNameWithSlash, "name")
+	}
+	actions := map[string]*policer.Policer_Action{
+		"conform_action": p.ConformAction,
+		"exceed_action":  p.ExceedAction,
+		"violate_action": p.ViolateAction,
+	}
+	for field, action := range actions {
+		if action.GetDscp() > maxDscp {
+			return kvs.NewInvalidValueError(ErrPolicerDscpTooHigh, field+".dscp")
+		}
+	}
+	return nil
+}
+
+// Create adds new VPP policer.
+func (d *PolicerDescriptor) Create(key string, p *policer.Policer) (*policeridx.PolicerMetadata, error) {
+	policerIdx, err := d.policerHandler.AddPolicer(p)
+	if err != nil {
+		return nil, errors.Errorf("failed to add policer %s: %v", p.Name, err)
+	}
+	return &policeridx.PolicerMetadata{
+		Index: policerIdx,
+	}, nil
+}
+
+// Delete removes VPP policer.
+func (d *PolicerDescriptor) Delete(key string, p *policer.Policer, metadata *policeridx.PolicerMetadata) error {
+	if err := d.policerHandler.DelPolicer(p.Name); err != nil {
+		return errors.Errorf("failed to delete policer %s: %v", p.Name, err)
+	}
+	return nil
+}
+
+// DerivedValues returns derived values representing policer applied to interfaces.
+func (d *PolicerDescriptor) DerivedValues(key string, p *policer.Policer) (derived []kvs.KeyValuePair) {
+	for _, ifName := range p.GetInterfaces().GetInput() {
+		derived = append(derived, kvs.KeyValuePair{
+			Key:   policer.ToInterfaceKey(p.Name, ifName, policer.InputDirection),
+			Value: &emptypb.Empty{},
+		})
+	}
+	for _, ifName := range p.GetInterfaces().GetOutput() {
+		derived = append(derived, kvs.KeyValuePair{
+			Key:   policer.ToInterfaceKey(p.Name, ifName, policer.OutputDirection),
+			Value: &emptypb.Empty{},
+		})
+	}
+	return derived
+}
+
+// Retrieve returns all policers configured in VPP.
+func (d *PolicerDescriptor) Retrieve(correlate []adapter.PolicerKVWithMetadata) (
+	retrieved []adapter.PolicerKVWithMetadata, err error,
+) {
+	// VPP does not provide a dump of interfaces with applied policers,
+	// interfaces are therefore taken over from the expected configuration.
+	nbPolicers := make(map[string]*policer.Policer)
+	for _, kv := range correlate {
+		nbPolicers[kv.Value.Name] = kv.Value
+	}
+
+	policers, err := d.policerHandler.DumpPolicers()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump policers: %v", err)
+	}
+	for _, p := range policers {
+		if nbPolicer, ok := nbPolicers[p.Policer.Name]; ok {
+			p.Policer.Interfaces = nbPolicer.Interfaces
+		}
+		retrieved = append(retrieved, adapter.PolicerKVWithMetadata{
+			Key:   policer.PolicerKey(p.Policer.Name),
+			Value: p.Policer,
+			Metadata: &policeridx.PolicerMetadata{
+				Index: p.Meta.Index,
+			},
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// equivalentActions compares policer actions, DSCP is relevant only for marking.
+func equivalentActions(action1, action2 *policer.Policer_Action) bool {
+	if action1.GetType() != action2.GetType() {
+		return false
+	}
+	if action1.GetType() == policer.Policer_Action_MARK_AND_TRANSMIT {
+		return action1.GetDscp() == action2.GetDscp()
+	}
+	return true
+}
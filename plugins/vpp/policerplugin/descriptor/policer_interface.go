@@ -0,0 +1,113 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+	"google.golang.org/protobuf/proto"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+const (
+	// PolicerInterfaceDescriptorName is the name of the descriptor for policers
+	// applied to interfaces.
+	PolicerInterfaceDescriptorName = "vpp-policer-interface"
+
+	// dependency labels
+	interfaceDep = "interface-exists"
+)
+
+// PolicerInterfaceDescriptor teaches KVScheduler how to apply policer to interface
+// (derived value of the policer).
+type PolicerInterfaceDescriptor struct {
+	log            logging.Logger
+	policerHandler vppcalls.PolicerVppAPI
+	ifIndexes      ifaceidx.IfaceMetadataIndex
+}
+
+// NewPolicerInterfaceDescriptor creates a new instance of the PolicerInterface descriptor.
+func NewPolicerInterfaceDescriptor(policerHandler vppcalls.PolicerVppAPI,
+	ifIndexes ifaceidx.IfaceMetadataIndex, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &PolicerInterfaceDescriptor{
+		policerHandler: policerHandler,
+		ifIndexes:      ifIndexes,
+		log:            log.NewLogger("policer-interface-descriptor"),
+	}
+	// Note: VPP does not provide a dump of interfaces with applied policers,
+	// therefore the descriptor does not implement Retrieve.
+	return &kvs.KVDescriptor{
+		Name:         PolicerInterfaceDescriptorName,
+		KeySelector:  ctx.IsPolicerInterfaceKey,
+		Create:       ctx.Create,
+		Delete:       ctx.Delete,
+		Dependencies: ctx.Dependencies,
+	}
+}
+
+// IsPolicerInterfaceKey returns true if the key identifies policer applied to interface.
+func (d *PolicerInterfaceDescriptor) IsPolicerInterfaceKey(key string) bool {
+	_, _, _, isPolicerInterfaceKey := policer.ParsePolicerToInterfaceKey(key)
+	return isPolicerInterfaceKey
+}
+
+// Create applies policer to the interface.
+func (d *PolicerInterfaceDescriptor) Create(key string, emptyVal proto.Message) (metadata kvs.Metadata, err error) {
+	policerName, ifName, direction, _ := policer.ParsePolicerToInterfaceKey(key)
+
+	ifMeta, found := d.ifIndexes.LookupByName(ifName)
+	if !found {
+		return nil, errors.Errorf("failed to find interface %s", ifName)
+	}
+	err = d.policerHandler.AddPolicerToInterface(policerName, ifMeta.SwIfIndex, direction == policer.OutputDirection)
+	if err != nil {
+		return nil, errors.Errorf("failed to apply policer %s to interface %s (%s): %v",
+			policerName, ifName, direction, err)
+	}
+	return nil, nil
+}
+
+// Delete removes policer from the interface.
+func (d *PolicerInterfaceDescriptor) Delete(key string, emptyVal proto.Message, metadata kvs.Metadata) error {
+	policerName, ifName, direction, _ := policer.ParsePolicerToInterfaceKey(key)
+
+	ifMeta, found := d.ifIndexes.LookupByName(ifName)
+	if !found {
+		return errors.Errorf("failed to find interface %s", ifName)
+	}
+	err := d.policerHandler.DelPolicerFromInterface(policerName, ifMeta.SwIfIndex, direction == policer.OutputDirection)
+	if err != nil {
+		return errors.Errorf("failed to remove policer %s from interface %s (%s): %v",
+			policerName, ifName, direction, err)
+	}
+	return nil
+}
+
+// Dependencies lists the interface as the only dependency for the binding.
+func (d *PolicerInterfaceDescriptor) Dependencies(key string, emptyVal proto.Message) []kvs.Dependency {
+	_, ifName, _, _ := policer.ParsePolicerToInterfaceKey(key)
+	return []kvs.Dependency{
+		{
+			Label: interfaceDep,
+			Key:   interfaces.InterfaceKey(ifName),
+		},
+	}
+}
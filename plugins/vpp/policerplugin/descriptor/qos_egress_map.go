@@ -0,0 +1,163 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+const (
+	// QosEgressMapDescriptorName is the name of the descriptor for QoS egress maps.
+	QosEgressMapDescriptorName = "vpp-qos-egress-map"
+
+	// maximal QoS value (QoS bits are carried in a single byte)
+	maxQosValue = 255
+
+	// number of QoS sources (rows of egress map)
+	qosSources = 4
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrQosEgressMapDuplicateRow is returned when egress map contains multiple
+	// rows for the same QoS source.
+	ErrQosEgressMapDuplicateRow = errors.New("QoS egress map contains duplicate row for the same source")
+
+	// ErrQosEgressMapInvalidSource is returned when egress map row refers to undefined QoS source.
+	ErrQosEgressMapInvalidSource = errors.New("QoS egress map row with undefined source")
+
+	// ErrQosEgressMapInvalidValue is returned when egress map row contains value above 255.
+	ErrQosEgressMapInvalidValue = errors.New("QoS egress map row contains value exceeding the limit (255)")
+)
+
+// QosEgressMapDescriptor teaches KVScheduler how to configure VPP QoS egress maps.
+type QosEgressMapDescriptor struct {
+	log            logging.Logger
+	policerHandler vppcalls.PolicerVppAPI
+}
+
+// NewQosEgressMapDescriptor creates a new instance of the QosEgressMap descriptor.
+func NewQosEgressMapDescriptor(
+	policerHandler vppcalls.PolicerVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &QosEgressMapDescriptor{
+		policerHandler: policerHandler,
+		log:            log.NewLogger("qos-egress-map-descriptor"),
+	}
+	typedDescr := &adapter.QosEgressMapDescriptor{
+		Name:            QosEgressMapDescriptorName,
+		NBKeyPrefix:     policer.ModelQosEgressMap.KeyPrefix(),
+		ValueTypeName:   policer.ModelQosEgressMap.ProtoName(),
+		KeySelector:     policer.ModelQosEgressMap.IsKeyValid,
+		KeyLabel:        policer.ModelQosEgressMap.StripKeyPrefix,
+		ValueComparator: ctx.EquivalentQosEgressMaps,
+		Validate:        ctx.Validate,
+		Create:          ctx.Create,
+		Update:          ctx.Update,
+		Delete:          ctx.Delete,
+		Retrieve:        ctx.Retrieve,
+	}
+	return adapter.NewQosEgressMapDescriptor(typedDescr)
+}
+
+// EquivalentQosEgressMaps compares egress maps by their effective translation
+// tables (missing and zero output values are equivalent).
+func (d *QosEgressMapDescriptor) EquivalentQosEgressMaps(key string, oldMap, newMap *policer.QosEgressMap) bool {
+	return egressMapTable(oldMap) == egressMapTable(newMap)
+}
+
+// Validate validates QoS egress map configuration.
+func (d *QosEgressMapDescriptor) Validate(key string, egressMap *policer.QosEgressMap) error {
+	sources := make(map[policer.QosSource]struct{})
+	for _, row := range egressMap.Rows {
+		if _, defined := policer.QosSource_name[int32(row.Source)]; !defined {
+			return kvs.NewInvalidValueError(ErrQosEgressMapInvalidSource, "rows.source")
+		}
+		if _, duplicate := sources[row.Source]; duplicate {
+			return kvs.NewInvalidValueError(ErrQosEgressMapDuplicateRow, "rows.source")
+		}
+		sources[row.Source] = struct{}{}
+		for input, output := range row.Outputs {
+			if input > maxQosValue || output > maxQosValue {
+				return kvs.NewInvalidValueError(ErrQosEgressMapInvalidValue, "rows.outputs")
+			}
+		}
+	}
+	return nil
+}
+
+// Create adds new QoS egress map.
+func (d *QosEgressMapDescriptor) Create(key string, egressMap *policer.QosEgressMap) (interface{}, error) {
+	if err := d.policerHandler.UpdateQosEgressMap(egressMap); err != nil {
+		return nil, errors.Errorf("failed to add QoS egress map %d: %v", egressMap.Id, err)
+	}
+	return nil, nil
+}
+
+// Update overwrites rows of the QoS egress map.
+func (d *QosEgressMapDescriptor) Update(key string, oldMap, newMap *policer.QosEgressMap, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	if err := d.policerHandler.UpdateQosEgressMap(newMap); err != nil {
+		return nil, errors.Errorf("failed to update QoS egress map %d: %v", newMap.Id, err)
+	}
+	return nil, nil
+}
+
+// Delete removes QoS egress map.
+func (d *QosEgressMapDescriptor) Delete(key string, egressMap *policer.QosEgressMap, metadata interface{}) error {
+	if err := d.policerHandler.DeleteQosEgressMap(egressMap.Id); err != nil {
+		return errors.Errorf("failed to delete QoS egress map %d: %v", egressMap.Id, err)
+	}
+	return nil
+}
+
+// Retrieve returns all QoS egress maps configured in VPP.
+func (d *QosEgressMapDescriptor) Retrieve(correlate []adapter.QosEgressMapKVWithMetadata) (
+	retrieved []adapter.QosEgressMapKVWithMetadata, err error,
+) {
+	egressMaps, err := d.policerHandler.DumpQosEgressMaps()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump QoS egress maps: %v", err)
+	}
+	for _, egressMap := range egressMaps {
+		retrieved = append(retrieved, adapter.QosEgressMapKVWithMetadata{
+			Key:    policer.QosEgressMapKey(egressMap.Id),
+			Value:  egressMap,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// egressMapTable returns the translation table as programmed into VPP.
+func egressMapTable(egressMap *policer.QosEgressMap) (table [qosSources][maxQosValue + 1]uint32) {
+	for _, row := range egressMap.Rows {
+		if int(row.Source) >= len(table) {
+			continue
+		}
+		for input, output := range row.Outputs {
+			if input <= maxQosValue {
+				table[row.Source][input] = output
+			}
+		}
+	}
+	return table
+}
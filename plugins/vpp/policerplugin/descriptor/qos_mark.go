@@ -0,0 +1,136 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+const (
+	// QosMarkDescriptorName is the name of the descriptor for QoS marking.
+	QosMarkDescriptorName = "vpp-qos-mark"
+
+	// dependency labels
+	egressMapDep = "qos-egress-map-exists"
+)
+
+// QosMarkDescriptor teaches KVScheduler how to enable QoS marking on VPP interfaces.
+type QosMarkDescriptor struct {
+	log            logging.Logger
+	policerHandler vppcalls.PolicerVppAPI
+	ifIndexes      ifaceidx.IfaceMetadataIndex
+}
+
+// NewQosMarkDescriptor creates a new instance of the QosMark descriptor.
+func NewQosMarkDescriptor(policerHandler vppcalls.PolicerVppAPI,
+	ifIndexes ifaceidx.IfaceMetadataIndex, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &QosMarkDescriptor{
+		policerHandler: policerHandler,
+		ifIndexes:      ifIndexes,
+		log:            log.NewLogger("qos-mark-descriptor"),
+	}
+	typedDescr := &adapter.QosMarkDescriptor{
+		Name:          QosMarkDescriptorName,
+		NBKeyPrefix:   policer.ModelQosMark.KeyPrefix(),
+		ValueTypeName: policer.ModelQosMark.ProtoName(),
+		KeySelector:   policer.ModelQosMark.IsKeyValid,
+		KeyLabel:      policer.ModelQosMark.StripKeyPrefix,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Update:        ctx.Update,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+		Dependencies:  ctx.Dependencies,
+		RetrieveDependencies: []string{
+			ifdescriptor.InterfaceDescriptorName,
+			QosEgressMapDescriptorName,
+		},
+	}
+	return adapter.NewQosMarkDescriptor(typedDescr)
+}
+
+// Validate validates QoS marking configuration.
+func (d *QosMarkDescriptor) Validate(key string, mark *policer.QosMark) error {
+	if mark.Interface == "" {
+		return kvs.NewInvalidValueError(errors.New("no interface defined"), "interface")
+	}
+	return nil
+}
+
+// Create enables QoS marking on the interface.
+func (d *QosMarkDescriptor) Create(key string, mark *policer.QosMark) (interface{}, error) {
+	ifMeta, found := d.ifIndexes.LookupByName(mark.Interface)
+	if !found {
+		return nil, errors.Errorf("failed to find interface %s", mark.Interface)
+	}
+	return nil, d.policerHandler.EnableQosMark(ifMeta.SwIfIndex, mark.Source, mark.EgressMapId)
+}
+
+// Update switches the egress map used for QoS marking on the interface.
+func (d *QosMarkDescriptor) Update(key string, oldMark, newMark *policer.QosMark, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	return d.Create(key, newMark)
+}
+
+// Delete disables QoS marking on the interface.
+func (d *QosMarkDescriptor) Delete(key string, mark *policer.QosMark, metadata interface{}) error {
+	ifMeta, found := d.ifIndexes.LookupByName(mark.Interface)
+	if !found {
+		return errors.Errorf("failed to find interface %s", mark.Interface)
+	}
+	return d.policerHandler.DisableQosMark(ifMeta.SwIfIndex, mark.Source)
+}
+
+// Retrieve returns all interfaces with enabled QoS marking.
+func (d *QosMarkDescriptor) Retrieve(correlate []adapter.QosMarkKVWithMetadata) (
+	retrieved []adapter.QosMarkKVWithMetadata, err error,
+) {
+	marks, err := d.policerHandler.DumpQosMarks()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump QoS marks: %v", err)
+	}
+	for _, mark := range marks {
+		retrieved = append(retrieved, adapter.QosMarkKVWithMetadata{
+			Key:    policer.QosMarkKey(mark.Interface, mark.Source),
+			Value:  mark,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface and the egress map as dependencies.
+func (d *QosMarkDescriptor) Dependencies(key string, mark *policer.QosMark) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: interfaceDep,
+			Key:   interfaces.InterfaceKey(mark.Interface),
+		},
+		{
+			Label: egressMapDep,
+			Key:   policer.QosEgressMapKey(mark.EgressMapId),
+		},
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+const (
+	// QosRecordDescriptorName is the name of the descriptor for QoS recording.
+	QosRecordDescriptorName = "vpp-qos-record"
+)
+
+// QosRecordDescriptor teaches KVScheduler how to enable QoS recording on VPP interfaces.
+type QosRecordDescriptor struct {
+	log            logging.Logger
+	policerHandler vppcalls.PolicerVppAPI
+	ifIndexes      ifaceidx.IfaceMetadataIndex
+}
+
+// NewQosRecordDescriptor creates a new instance of the QosRecord descriptor.
+func NewQosRecordDescriptor(policerHandler vppcalls.PolicerVppAPI,
+	ifIndexes ifaceidx.IfaceMetadataIndex, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &QosRecordDescriptor{
+		policerHandler: policerHandler,
+		ifIndexes:      ifIndexes,
+		log:            log.NewLogger("qos-record-descriptor"),
+	}
+	typedDescr := &adapter.QosRecordDescriptor{
+		Name:                 QosRecordDescriptorName,
+		NBKeyPrefix:          policer.ModelQosRecord.KeyPrefix(),
+		ValueTypeName:        policer.ModelQosRecord.ProtoName(),
+		KeySelector:          policer.ModelQosRecord.IsKeyValid,
+		KeyLabel:             policer.ModelQosRecord.StripKeyPrefix,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewQosRecordDescriptor(typedDescr)
+}
+
+// Validate validates QoS recording configuration.
+func (d *QosRecordDescriptor) Validate(key string, record *policer.QosRecord) error {
+	if record.Interface == "" {
+		return kvs.NewInvalidValueError(errors.New("no interface defined"), "interface")
+	}
+	return nil
+}
+
+// Create enables QoS recording on the interface.
+func (d *QosRecordDescriptor) Create(key string, record *policer.QosRecord) (interface{}, error) {
+	ifMeta, found := d.ifIndexes.LookupByName(record.Interface)
+	if !found {
+		return nil, errors.Errorf("failed to find interface %s", record.Interface)
+	}
+	return nil, d.policerHandler.EnableQosRecord(ifMeta.SwIfIndex, record.Source)
+}
+
+// Delete disables QoS recording on the interface.
+func (d *QosRecordDescriptor) Delete(key string, record *policer.QosRecord, metadata interface{}) error {
+	ifMeta, found := d.ifIndexes.LookupByName(record.Interface)
+	if !found {
+		return errors.Errorf("failed to find interface %s", record.Interface)
+	}
+	return d.policerHandler.DisableQosRecord(ifMeta.SwIfIndex, record.Source)
+}
+
+// Retrieve returns all interfaces with enabled QoS recording.
+func (d *QosRecordDescriptor) Retrieve(correlate []adapter.QosRecordKVWithMetadata) (
+	retrieved []adapter.QosRecordKVWithMetadata, err error,
+) {
+	records, err := d.policerHandler.DumpQosRecords()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump QoS records: %v", err)
+	}
+	for _, record := range records {
+		retrieved = append(retrieved, adapter.QosRecordKVWithMetadata{
+			Key:    policer.QosRecordKey(record.Interface, record.Source),
+			Value:  record,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface as the only dependency.
+func (d *QosRecordDescriptor) Dependencies(key string, record *policer.QosRecord) []kvs.Dependency {
+	return []kvs.Dependency{
+		{
+			Label: interfaceDep,
+			Key:   interfaces.InterfaceKey(record.Interface),
+		},
+	}
+}
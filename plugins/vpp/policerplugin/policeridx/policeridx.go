@@ -0,0 +1,91 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policeridx
+
+import (
+	"go.ligato.io/cn-infra/v2/idxmap"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/idxvpp"
+)
+
+// PolicerMetadataIndex provides read-only access to mapping between policer indexes
+// (used internally in VPP) and policer names.
+type PolicerMetadataIndex interface {
+	// LookupByName looks up previously stored item identified by name in the mapping.
+	LookupByName(name string) (metadata *PolicerMetadata, exists bool)
+
+	// LookupByIndex looks up previously stored item identified by index in the mapping.
+	LookupByIndex(idx uint32) (name string, metadata *PolicerMetadata, exists bool)
+}
+
+// PolicerMetadataIndexRW is mapping between policer indexes (used internally in VPP)
+// and policer names.
+type PolicerMetadataIndexRW interface {
+	PolicerMetadataIndex
+	idxmap.NamedMappingRW
+}
+
+// PolicerMetadata represents metadata for policer.
+type PolicerMetadata struct {
+	Index uint32
+}
+
+// GetIndex returns index of the policer.
+func (m *PolicerMetadata) GetIndex() uint32 {
+	return m.Index
+}
+
+type policerMetadataIndex struct {
+	idxmap.NamedMappingRW
+
+	log         logging.Logger
+	nameToIndex idxvpp.NameToIndex
+}
+
+// NewPolicerIndex creates new instance of policerMetadataIndex.
+func NewPolicerIndex(logger logging.Logger, title string) PolicerMetadataIndexRW {
+	mapping := idxvpp.NewNameToIndex(logger, title, nil)
+	return &policerMetadataIndex{
+		NamedMappingRW: mapping,
+		log:            logger,
+		nameToIndex:    mapping,
+	}
+}
+
+// LookupByName looks up previously stored item identified by name in mapping.
+func (policerIdx *policerMetadataIndex) LookupByName(name string) (metadata *PolicerMetadata, exists bool) {
+	meta, found := policerIdx.GetValue(name)
+	if found {
+		if typedMeta, ok := meta.(*PolicerMetadata); ok {
+			return typedMeta, found
+		}
+	}
+	return nil, false
+}
+
+// LookupByIndex looks up previously stored item identified by index in mapping.
+func (policerIdx *policerMetadataIndex) LookupByIndex(idx uint32) (name string, metadata *PolicerMetadata, exists bool) {
+	var item idxvpp.WithIndex
+	name, item, exists = policerIdx.nameToIndex.LookupByIndex(idx)
+	if exists {
+		var isPolicerMeta bool
+		metadata, isPolicerMeta = item.(*PolicerMetadata)
+		if !isPolicerMeta {
+			exists = false
+		}
+	}
+	return
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policeridx_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/policeridx"
+)
+
+func TestPolicerIndexLookupByName(t *testing.T) {
+	RegisterTestingT(t)
+	policerIndex := policeridx.NewPolicerIndex(logging.DefaultLogger, "policer-index")
+
+	policerIndex.Put("pol1", &policeridx.PolicerMetadata{Index: 1})
+	policerIndex.Put("pol2", 2)
+
+	metadata, exists := policerIndex.LookupByName("pol1")
+	Expect(exists).To(BeTrue())
+	Expect(metadata).ToNot(BeNil())
+	Expect(metadata.Index).To(Equal(uint32(1)))
+
+	metadata, exists = policerIndex.LookupByName("pol2")
+	Expect(exists).To(BeFalse())
+	Expect(metadata).To(BeNil())
+
+	metadata, exists = policerIndex.LookupByName("pol3")
+	Expect(exists).To(BeFalse())
+	Expect(metadata).To(BeNil())
+}
+
+func TestPolicerIndexLookupByIndex(t *testing.T) {
+	RegisterTestingT(t)
+	policerIndex := policeridx.NewPolicerIndex(logging.DefaultLogger, "policer-index")
+
+	policerIndex.Put("pol1", &policeridx.PolicerMetadata{Index: 1})
+	policerIndex.Put("pol2", &policeridx.PolicerMetadata{Index: 2})
+
+	name, metadata, exists := policerIndex.LookupByIndex(2)
+	Expect(exists).To(BeTrue())
+	Expect(name).To(Equal("pol2"))
+	Expect(metadata).ToNot(BeNil())
+	Expect(metadata.Index).To(Equal(uint32(2)))
+
+	name, metadata, exists = policerIndex.LookupByIndex(3)
+	Expect(exists).To(BeFalse())
+	Expect(name).To(Equal(""))
+	Expect(metadata).To(BeNil())
+}
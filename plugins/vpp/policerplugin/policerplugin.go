@@ -0,0 +1,114 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name Policer --value-type *vpp_policer.Policer --meta-type *policeridx.PolicerMetadata --import "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/policeridx" --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name QosRecord --value-type *vpp_policer.QosRecord --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name QosEgressMap --value-type *vpp_policer.QosEgressMap --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name QosMark --value-type *vpp_policer.QosMark --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer" --output-dir "descriptor"
+
+package policerplugin
+
+import (
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/policeridx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls/vpp2101"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls/vpp2106"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls/vpp2202"
+	_ "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls/vpp2210"
+)
+
+// PolicerPlugin configures VPP policers and QoS marking using GoVPP.
+type PolicerPlugin struct {
+	Deps
+
+	// handlers
+	policerHandler vppcalls.PolicerVppAPI
+
+	// index maps
+	policerIndex policeridx.PolicerMetadataIndex
+}
+
+// Deps lists dependencies of the policer plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	VPP         govppmux.API
+	IfPlugin    ifplugin.API
+	StatusCheck statuscheck.PluginStatusWriter // optional
+}
+
+// Init registers policer and QoS related descriptors.
+func (p *PolicerPlugin) Init() (err error) {
+	// init handlers
+	p.policerHandler = vppcalls.CompatiblePolicerVppHandler(p.VPP, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if p.policerHandler == nil {
+		return errors.New("policer VPP handler is not available")
+	}
+
+	// init and register descriptors
+	policerDescriptor := descriptor.NewPolicerDescriptor(p.policerHandler, p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(policerDescriptor); err != nil {
+		return err
+	}
+
+	// obtain read-only reference to index map
+	var withIndex bool
+	metadataMap := p.KVScheduler.GetMetadataMap(policerDescriptor.Name)
+	p.policerIndex, withIndex = metadataMap.(policeridx.PolicerMetadataIndex)
+	if !withIndex {
+		return errors.New("missing index with policer metadata")
+	}
+
+	policerIfDescriptor := descriptor.NewPolicerInterfaceDescriptor(p.policerHandler, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(policerIfDescriptor); err != nil {
+		return err
+	}
+	qosRecordDescriptor := descriptor.NewQosRecordDescriptor(p.policerHandler, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(qosRecordDescriptor); err != nil {
+		return err
+	}
+	qosEgressMapDescriptor := descriptor.NewQosEgressMapDescriptor(p.policerHandler, p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(qosEgressMapDescriptor); err != nil {
+		return err
+	}
+	qosMarkDescriptor := descriptor.NewQosMarkDescriptor(p.policerHandler, p.IfPlugin.GetInterfaceIndex(), p.Log)
+	if err = p.KVScheduler.RegisterKVDescriptor(qosMarkDescriptor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AfterInit registers plugin with StatusCheck.
+func (p *PolicerPlugin) AfterInit() error {
+	if p.StatusCheck != nil {
+		p.StatusCheck.Register(p.PluginName, nil)
+	}
+	return nil
+}
+
+// GetPolicerIndex gives read-only access to map with metadata of all configured
+// VPP policers.
+func (p *PolicerPlugin) GetPolicerIndex() policeridx.PolicerMetadataIndex {
+	return p.policerIndex
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vppcalls
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+// PolicerDetails contains policer configuration together with VPP-internal data.
+type PolicerDetails struct {
+	Policer *policer.Policer
+	Meta    *PolicerMeta
+}
+
+// PolicerMeta contains VPP-internal policer data.
+type PolicerMeta struct {
+	Index uint32
+}
+
+// PolicerVppAPI provides methods for managing VPP policers and QoS marking.
+type PolicerVppAPI interface {
+	PolicerVppRead
+
+	// AddPolicer creates new policer and returns its VPP index.
+	AddPolicer(p *policer.Policer) (policerIdx uint32, err error)
+	// DelPolicer removes existing policer.
+	DelPolicer(name string) error
+	// AddPolicerToInterface applies policer to packets received (or transmitted
+	// if <output> is true) on the given interface.
+	AddPolicerToInterface(name string, ifIdx uint32, output bool) error
+	// DelPolicerFromInterface removes policer from the given interface.
+	DelPolicerFromInterface(name string, ifIdx uint32, output bool) error
+	// EnableQosRecord enables recording of QoS bits from the given source on the interface.
+	EnableQosRecord(ifIdx uint32, source policer.QosSource) error
+	// DisableQosRecord disables recording of QoS bits from the given source on the interface.
+	DisableQosRecord(ifIdx uint32, source policer.QosSource) error
+	// UpdateQosEgressMap creates or updates QoS egress map.
+	UpdateQosEgressMap(egressMap *policer.QosEgressMap) error
+	// DeleteQosEgressMap removes QoS egress map.
+	DeleteQosEgressMap(id uint32) error
+	// EnableQosMark enables marking of QoS bits of the given source on the interface
+	// using the egress map.
+	EnableQosMark(ifIdx uint32, source policer.QosSource, egressMapID uint32) error
+	// DisableQosMark disables marking of QoS bits of the given source on the interface.
+	DisableQosMark(ifIdx uint32, source policer.QosSource) error
+}
+
+// PolicerVppRead provides read methods for VPP policers and QoS marking.
+type PolicerVppRead interface {
+	// DumpPolicers dumps all policers configured in VPP.
+	// Interfaces the policers are applied to are not included.
+	DumpPolicers() ([]*PolicerDetails, error)
+	// DumpQosRecords dumps all interfaces with enabled QoS recording.
+	DumpQosRecords() ([]*policer.QosRecord, error)
+	// DumpQosEgressMaps dumps all QoS egress maps.
+	DumpQosEgressMaps() ([]*policer.QosEgressMap, error)
+	// DumpQosMarks dumps all interfaces with enabled QoS marking.
+	DumpQosMarks() ([]*policer.QosMark, error)
+}
+
+var handler = vpp.RegisterHandler(vpp.HandlerDesc{
+	Name:       "policer",
+	HandlerAPI: (*PolicerVppAPI)(nil),
+})
+
+type NewHandlerFunc func(ch govppapi.Channel, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) PolicerVppAPI
+
+func AddPolicerHandlerVersion(version vpp.Version, msgs []govppapi.Message, h NewHandlerFunc) {
+	handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(c vpp.Client) error {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return ch.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
+			ch, err := c.NewAPIChannel()
+			if err != nil {
+				return err
+			}
+			return h(ch, a[0].(ifaceidx.IfaceMetadataIndex), a[1].(logging.Logger))
+		},
+	})
+}
+
+func CompatiblePolicerVppHandler(c vpp.Client, ifIdx ifaceidx.IfaceMetadataIndex, log logging.Logger) PolicerVppAPI {
+	if v := handler.FindCompatibleVersion(c); v != nil {
+		return v.NewHandler(c, ifIdx, log).(PolicerVppAPI)
+	}
+	return nil
+}
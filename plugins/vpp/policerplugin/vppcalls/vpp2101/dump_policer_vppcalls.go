@@ -0,0 +1,154 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2101
+
+import (
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface_types"
+	vpp_policer "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/policer"
+	vpp_qos "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/qos"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+// DumpPolicers implements policer handler.
+func (h *PolicerVppHandler) DumpPolicers() (policers []*vppcalls.PolicerDetails, err error) {
+	// Policer details do not carry the policer index. VPP walks the pool
+	// of policers in the order of indexes, therefore the index is derived
+	// from the position of the policer in the dump.
+	var policerIdx uint32
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_policer.PolicerDump{})
+	for {
+		details := &vpp_policer.PolicerDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		policers = append(policers, &vppcalls.PolicerDetails{
+			Policer: &policer.Policer{
+				Name:          strings.Trim(details.Name, "\x00"),
+				Cir:           details.Cir,
+				Eir:           details.Eir,
+				Cb:            details.Cb,
+				Eb:            details.Eb,
+				RateType:      policer.Policer_RateType(details.RateType),
+				RoundType:     policer.Policer_RoundType(details.RoundType),
+				Type:          policer.Policer_Type(details.Type),
+				ColorAware:    details.ColorAware,
+				ConformAction: fromQosAction(details.ConformAction),
+				ExceedAction:  fromQosAction(details.ExceedAction),
+				ViolateAction: fromQosAction(details.ViolateAction),
+			},
+			Meta: &vppcalls.PolicerMeta{
+				Index: policerIdx,
+			},
+		})
+		policerIdx++
+	}
+	return policers, nil
+}
+
+// DumpQosRecords implements policer handler.
+func (h *PolicerVppHandler) DumpQosRecords() (records []*policer.QosRecord, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_qos.QosRecordDump{})
+	for {
+		details := &vpp_qos.QosRecordDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		ifName, _, exists := h.ifIndexes.LookupBySwIfIndex(uint32(details.Record.SwIfIndex))
+		if !exists {
+			h.log.Warnf("QoS record dump: interface name not found for index %d", details.Record.SwIfIndex)
+			continue
+		}
+		records = append(records, &policer.QosRecord{
+			Interface: ifName,
+			Source:    policer.QosSource(details.Record.InputSource),
+		})
+	}
+	return records, nil
+}
+
+// DumpQosEgressMaps implements policer handler.
+// Only rows with at least one non-zero output value are returned.
+func (h *PolicerVppHandler) DumpQosEgressMaps() (egressMaps []*policer.QosEgressMap, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_qos.QosEgressMapDump{})
+	for {
+		details := &vpp_qos.QosEgressMapDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		egressMap := &policer.QosEgressMap{
+			Id: details.Map.ID,
+		}
+		for source, row := range details.Map.Rows {
+			outputs := make(map[uint32]uint32)
+			for input, output := range row.Outputs {
+				if output != 0 {
+					outputs[uint32(input)] = uint32(output)
+				}
+			}
+			if len(outputs) == 0 {
+				continue
+			}
+			egressMap.Rows = append(egressMap.Rows, &policer.QosEgressMap_Row{
+				Source:  policer.QosSource(source),
+				Outputs: outputs,
+			})
+		}
+		egressMaps = append(egressMaps, egressMap)
+	}
+	return egressMaps, nil
+}
+
+// DumpQosMarks implements policer handler.
+func (h *PolicerVppHandler) DumpQosMarks() (marks []*policer.QosMark, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_qos.QosMarkDump{
+		SwIfIndex: interface_types.InterfaceIndex(^uint32(0)),
+	})
+	for {
+		details := &vpp_qos.QosMarkDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		ifName, _, exists := h.ifIndexes.LookupBySwIfIndex(details.Mark.SwIfIndex)
+		if !exists {
+			h.log.Warnf("QoS mark dump: interface name not found for index %d", details.Mark.SwIfIndex)
+			continue
+		}
+		marks = append(marks, &policer.QosMark{
+			Interface:   ifName,
+			Source:      policer.QosSource(details.Mark.OutputSource),
+			EgressMapId: details.Mark.MapID,
+		})
+	}
+	return marks, nil
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2101_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	vpp_policer "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/policer"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/policer_types"
+	vpp_vpe "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vpe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+	vpp2101 "go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls/vpp2101"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+func TestAddPolicer(t *testing.T) {
+	ctx, policerHandler, _ := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_policer.PolicerAddDelReply{PolicerIndex: 3})
+	policerIdx, err := policerHandler.AddPolicer(&policer.Policer{
+		Name:      "tenant1",
+		Cir:       10000,
+		Eir:       20000,
+		Cb:        15000,
+		Eb:        30000,
+		RateType:  policer.Policer_KBPS,
+		RoundType: policer.Policer_UP,
+		Type:      policer.Policer_TYPE_2R3C_RFC_2698,
+		ConformAction: &policer.Policer_Action{
+			Type: policer.Policer_Action_TRANSMIT,
+		},
+		ExceedAction: &policer.Policer_Action{
+			Type: policer.Policer_Action_MARK_AND_TRANSMIT,
+			Dscp: 10,
+		},
+	})
+	Expect(err).To(BeNil())
+	Expect(policerIdx).To(BeEquivalentTo(3))
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_policer.PolicerAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeTrue())
+	Expect(vppMsg.Name).To(Equal("tenant1"))
+	Expect(vppMsg.Cir).To(BeEquivalentTo(10000))
+	Expect(vppMsg.Eir).To(BeEquivalentTo(20000))
+	Expect(vppMsg.Cb).To(BeEquivalentTo(15000))
+	Expect(vppMsg.Eb).To(BeEquivalentTo(30000))
+	Expect(vppMsg.RateType).To(Equal(policer_types.SSE2_QOS_RATE_API_KBPS))
+	Expect(vppMsg.RoundType).To(Equal(policer_types.SSE2_QOS_ROUND_API_TO_UP))
+	Expect(vppMsg.Type).To(Equal(policer_types.SSE2_QOS_POLICER_TYPE_API_2R3C_RFC_2698))
+	Expect(vppMsg.ConformAction.Type).To(Equal(policer_types.SSE2_QOS_ACTION_API_TRANSMIT))
+	Expect(vppMsg.ExceedAction.Type).To(Equal(policer_types.SSE2_QOS_ACTION_API_MARK_AND_TRANSMIT))
+	Expect(vppMsg.ExceedAction.Dscp).To(BeEquivalentTo(10))
+	Expect(vppMsg.ViolateAction.Type).To(Equal(policer_types.SSE2_QOS_ACTION_API_DROP))
+}
+
+func TestAddPolicerError(t *testing.T) {
+	ctx, policerHandler, _ := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_policer.PolicerAddDelReply{Retval: 1})
+	_, err := policerHandler.AddPolicer(&policer.Policer{Name: "tenant1"})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDelPolicer(t *testing.T) {
+	ctx, policerHandler, _ := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_policer.PolicerAddDelReply{})
+	err := policerHandler.DelPolicer("tenant1")
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_policer.PolicerAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.IsAdd).To(BeFalse())
+	Expect(vppMsg.Name).To(Equal("tenant1"))
+}
+
+func TestPolicerToInterface(t *testing.T) {
+	ctx, policerHandler, _ := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_policer.PolicerInputReply{})
+	err := policerHandler.AddPolicerToInterface("tenant1", 2, false)
+	Expect(err).To(BeNil())
+	inMsg, ok := ctx.MockChannel.Msg.(*vpp_policer.PolicerInput)
+	Expect(ok).To(BeTrue())
+	Expect(inMsg.Name).To(Equal("tenant1"))
+	Expect(inMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(inMsg.Apply).To(BeTrue())
+
+	ctx.MockVpp.MockReply(&vpp_policer.PolicerOutputReply{})
+	err = policerHandler.DelPolicerFromInterface("tenant1", 2, true)
+	Expect(err).To(BeNil())
+	outMsg, ok := ctx.MockChannel.Msg.(*vpp_policer.PolicerOutput)
+	Expect(ok).To(BeTrue())
+	Expect(outMsg.Name).To(Equal("tenant1"))
+	Expect(outMsg.SwIfIndex).To(BeEquivalentTo(2))
+	Expect(outMsg.Apply).To(BeFalse())
+}
+
+func TestDumpPolicers(t *testing.T) {
+	ctx, policerHandler, _ := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_policer.PolicerDetails{
+			Name:     "tenant1",
+			Cir:      10000,
+			Cb:       15000,
+			RateType: policer_types.SSE2_QOS_RATE_API_PPS,
+			ConformAction: policer_types.Sse2QosAction{
+				Type: policer_types.SSE2_QOS_ACTION_API_TRANSMIT,
+			},
+		},
+		&vpp_policer.PolicerDetails{
+			Name: "tenant2",
+			Type: policer_types.SSE2_QOS_POLICER_TYPE_API_1R3C_RFC_2697,
+			ViolateAction: policer_types.Sse2QosAction{
+				Type: policer_types.SSE2_QOS_ACTION_API_MARK_AND_TRANSMIT,
+				Dscp: 8,
+			},
+		},
+	)
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+
+	policers, err := policerHandler.DumpPolicers()
+	Expect(err).To(BeNil())
+	Expect(policers).To(HaveLen(2))
+	Expect(policers[0].Policer.Name).To(Equal("tenant1"))
+	Expect(policers[0].Policer.Cir).To(BeEquivalentTo(10000))
+	Expect(policers[0].Policer.Cb).To(BeEquivalentTo(15000))
+	Expect(policers[0].Policer.RateType).To(Equal(policer.Policer_PPS))
+	Expect(policers[0].Policer.ConformAction.Type).To(Equal(policer.Policer_Action_TRANSMIT))
+	Expect(policers[0].Meta.Index).To(BeEquivalentTo(0))
+	Expect(policers[1].Policer.Name).To(Equal("tenant2"))
+	Expect(policers[1].Policer.Type).To(Equal(policer.Policer_TYPE_1R3C_RFC_2697))
+	Expect(policers[1].Policer.ViolateAction.Type).To(Equal(policer.Policer_Action_MARK_AND_TRANSMIT))
+	Expect(policers[1].Policer.ViolateAction.Dscp).To(BeEquivalentTo(8))
+	Expect(policers[1].Meta.Index).To(BeEquivalentTo(1))
+}
+
+func policerTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.PolicerVppAPI, ifaceidx.IfaceMetadataIndexRW) {
+	ctx := vppmock.SetupTestCtx(t)
+	logger := logrus.NewLogger("test-log")
+	ifIndexes := ifaceidx.NewIfaceIndex(logger, "policer-if-idx")
+	policerHandler := vpp2101.NewPolicerVppHandler(ctx.MockChannel, ifIndexes, logrus.DefaultLogger())
+	return ctx, policerHandler, ifIndexes
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2101
+
+import (
+	govppapi "go.fd.io/govpp/api"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	vpp2101 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101"
+	vpp_policer "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/policer"
+	vpp_qos "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/qos"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/policerplugin/vppcalls"
+)
+
+func init() {
+	var msgs []govppapi.Message
+	msgs = append(msgs, vpp_policer.AllMessages()...)
+	msgs = append(msgs, vpp_qos.AllMessages()...)
+
+	vppcalls.AddPolicerHandlerVersion(vpp2101.Version, msgs, NewPolicerVppHandler)
+}
+
+// PolicerVppHandler is accessor for policer-related vppcalls methods
+type PolicerVppHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	log          logging.Logger
+}
+
+// NewPolicerVppHandler creates new instance of policer vppcalls handler
+func NewPolicerVppHandler(
+	callsChan govppapi.Channel, ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger,
+) vppcalls.PolicerVppAPI {
+	return &PolicerVppHandler{
+		callsChannel: callsChan,
+		ifIndexes:    ifIndexes,
+		log:          log,
+	}
+}
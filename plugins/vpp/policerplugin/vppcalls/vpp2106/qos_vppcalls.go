@@ -0,0 +1,122 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2106
+
+import (
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/interface_types"
+	vpp_qos "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2106/qos"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+// qosValues is the number of distinct QoS values (size of egress map row).
+const qosValues = 256
+
+// EnableQosRecord implements policer handler.
+func (h *PolicerVppHandler) EnableQosRecord(ifIdx uint32, source policer.QosSource) error {
+	return h.qosRecordEnableDisable(ifIdx, source, true)
+}
+
+// DisableQosRecord implements policer handler.
+func (h *PolicerVppHandler) DisableQosRecord(ifIdx uint32, source policer.QosSource) error {
+	return h.qosRecordEnableDisable(ifIdx, source, false)
+}
+
+func (h *PolicerVppHandler) qosRecordEnableDisable(ifIdx uint32, source policer.QosSource, enable bool) error {
+	req := &vpp_qos.QosRecordEnableDisable{
+		Enable: enable,
+		Record: vpp_qos.QosRecord{
+			SwIfIndex:   interface_types.InterfaceIndex(ifIdx),
+			InputSource: vpp_qos.QosSource(source),
+		},
+	}
+	reply := &vpp_qos.QosRecordEnableDisableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateQosEgressMap implements policer handler.
+func (h *PolicerVppHandler) UpdateQosEgressMap(egressMap *policer.QosEgressMap) error {
+	req := &vpp_qos.QosEgressMapUpdate{
+		Map: vpp_qos.QosEgressMap{
+			ID: egressMap.Id,
+		},
+	}
+	for i := range req.Map.Rows {
+		req.Map.Rows[i].Outputs = make([]byte, qosValues)
+	}
+	for _, row := range egressMap.Rows {
+		if int(row.Source) >= len(req.Map.Rows) {
+			return errors.Errorf("invalid QoS source %v in egress map %d", row.Source, egressMap.Id)
+		}
+		outputs := req.Map.Rows[row.Source].Outputs
+		for input, output := range row.Outputs {
+			if input >= qosValues || output >= qosValues {
+				return errors.Errorf("invalid QoS value mapping %d->%d in egress map %d", input, output, egressMap.Id)
+			}
+			outputs[input] = byte(output)
+		}
+	}
+	reply := &vpp_qos.QosEgressMapUpdateReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteQosEgressMap implements policer handler.
+func (h *PolicerVppHandler) DeleteQosEgressMap(id uint32) error {
+	req := &vpp_qos.QosEgressMapDelete{
+		ID: id,
+	}
+	reply := &vpp_qos.QosEgressMapDeleteReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// EnableQosMark implements policer handler.
+func (h *PolicerVppHandler) EnableQosMark(ifIdx uint32, source policer.QosSource, egressMapID uint32) error {
+	return h.qosMarkEnableDisable(ifIdx, source, egressMapID, true)
+}
+
+// DisableQosMark implements policer handler.
+func (h *PolicerVppHandler) DisableQosMark(ifIdx uint32, source policer.QosSource) error {
+	return h.qosMarkEnableDisable(ifIdx, source, 0, false)
+}
+
+func (h *PolicerVppHandler) qosMarkEnableDisable(ifIdx uint32, source policer.QosSource, egressMapID uint32, enable bool) error {
+	req := &vpp_qos.QosMarkEnableDisable{
+		Enable: enable,
+		Mark: vpp_qos.QosMark{
+			SwIfIndex:    ifIdx,
+			MapID:        egressMapID,
+			OutputSource: vpp_qos.QosSource(source),
+		},
+	}
+	reply := &vpp_qos.QosMarkEnableDisableReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
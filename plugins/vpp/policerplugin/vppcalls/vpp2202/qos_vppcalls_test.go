@@ -0,0 +1,156 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2202_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/memclnt"
+	vpp_qos "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2202/qos"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+func TestQosRecord(t *testing.T) {
+	ctx, policerHandler, _ := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosRecordEnableDisableReply{})
+	err := policerHandler.EnableQosRecord(1, policer.QosSource_IP)
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_qos.QosRecordEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Enable).To(BeTrue())
+	Expect(vppMsg.Record.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.Record.InputSource).To(Equal(vpp_qos.QOS_API_SOURCE_IP))
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosRecordEnableDisableReply{})
+	err = policerHandler.DisableQosRecord(1, policer.QosSource_IP)
+	Expect(err).To(BeNil())
+	vppMsg, ok = ctx.MockChannel.Msg.(*vpp_qos.QosRecordEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Enable).To(BeFalse())
+}
+
+func TestUpdateQosEgressMap(t *testing.T) {
+	ctx, policerHandler, _ := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosEgressMapUpdateReply{})
+	err := policerHandler.UpdateQosEgressMap(&policer.QosEgressMap{
+		Id: 5,
+		Rows: []*policer.QosEgressMap_Row{
+			{
+				Source:  policer.QosSource_IP,
+				Outputs: map[uint32]uint32{46: 5, 10: 1},
+			},
+		},
+	})
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_qos.QosEgressMapUpdate)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Map.ID).To(BeEquivalentTo(5))
+	Expect(vppMsg.Map.Rows[vpp_qos.QOS_API_SOURCE_IP].Outputs).To(HaveLen(256))
+	Expect(vppMsg.Map.Rows[vpp_qos.QOS_API_SOURCE_IP].Outputs[46]).To(BeEquivalentTo(5))
+	Expect(vppMsg.Map.Rows[vpp_qos.QOS_API_SOURCE_IP].Outputs[10]).To(BeEquivalentTo(1))
+	Expect(vppMsg.Map.Rows[vpp_qos.QOS_API_SOURCE_VLAN].Outputs[46]).To(BeEquivalentTo(0))
+
+	err = policerHandler.UpdateQosEgressMap(&policer.QosEgressMap{
+		Id: 5,
+		Rows: []*policer.QosEgressMap_Row{
+			{
+				Source:  policer.QosSource_IP,
+				Outputs: map[uint32]uint32{256: 1},
+			},
+		},
+	})
+	Expect(err).ToNot(BeNil())
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosEgressMapDeleteReply{})
+	err = policerHandler.DeleteQosEgressMap(5)
+	Expect(err).To(BeNil())
+	delMsg, ok := ctx.MockChannel.Msg.(*vpp_qos.QosEgressMapDelete)
+	Expect(ok).To(BeTrue())
+	Expect(delMsg.ID).To(BeEquivalentTo(5))
+}
+
+func TestQosMark(t *testing.T) {
+	ctx, policerHandler, _ := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosMarkEnableDisableReply{})
+	err := policerHandler.EnableQosMark(1, policer.QosSource_VLAN, 5)
+	Expect(err).To(BeNil())
+	vppMsg, ok := ctx.MockChannel.Msg.(*vpp_qos.QosMarkEnableDisable)
+	Expect(ok).To(BeTrue())
+	Expect(vppMsg.Enable).To(BeTrue())
+	Expect(vppMsg.Mark.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(vppMsg.Mark.MapID).To(BeEquivalentTo(5))
+	Expect(vppMsg.Mark.OutputSource).To(Equal(vpp_qos.QOS_API_SOURCE_VLAN))
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosMarkEnableDisableReply{Retval: 1})
+	err = policerHandler.DisableQosMark(1, policer.QosSource_VLAN)
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDumpQos(t *testing.T) {
+	ctx, policerHandler, ifIndexes := policerTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifIndexes.Put("memif1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(
+		&vpp_qos.QosRecordDetails{
+			Record: vpp_qos.QosRecord{SwIfIndex: 1, InputSource: vpp_qos.QOS_API_SOURCE_IP},
+		},
+		&vpp_qos.QosRecordDetails{
+			Record: vpp_qos.QosRecord{SwIfIndex: 2, InputSource: vpp_qos.QOS_API_SOURCE_IP},
+		},
+	)
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	records, err := policerHandler.DumpQosRecords()
+	Expect(err).To(BeNil())
+	Expect(records).To(HaveLen(1))
+	Expect(records[0].Interface).To(Equal("memif1"))
+	Expect(records[0].Source).To(Equal(policer.QosSource_IP))
+
+	egressMap := vpp_qos.QosEgressMap{ID: 5}
+	for i := range egressMap.Rows {
+		egressMap.Rows[i].Outputs = make([]byte, 256)
+	}
+	egressMap.Rows[vpp_qos.QOS_API_SOURCE_MPLS].Outputs[3] = 7
+	ctx.MockVpp.MockReply(&vpp_qos.QosEgressMapDetails{Map: egressMap})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	egressMaps, err := policerHandler.DumpQosEgressMaps()
+	Expect(err).To(BeNil())
+	Expect(egressMaps).To(HaveLen(1))
+	Expect(egressMaps[0].Id).To(BeEquivalentTo(5))
+	Expect(egressMaps[0].Rows).To(HaveLen(1))
+	Expect(egressMaps[0].Rows[0].Source).To(Equal(policer.QosSource_MPLS))
+	Expect(egressMaps[0].Rows[0].Outputs).To(Equal(map[uint32]uint32{3: 7}))
+
+	ctx.MockVpp.MockReply(&vpp_qos.QosMarkDetails{
+		Mark: vpp_qos.QosMark{SwIfIndex: 1, MapID: 5, OutputSource: vpp_qos.QOS_API_SOURCE_MPLS},
+	})
+	ctx.MockVpp.MockReply(&memclnt.ControlPingReply{})
+	marks, err := policerHandler.DumpQosMarks()
+	Expect(err).To(BeNil())
+	Expect(marks).To(HaveLen(1))
+	Expect(marks[0].Interface).To(Equal("memif1"))
+	Expect(marks[0].Source).To(Equal(policer.QosSource_MPLS))
+	Expect(marks[0].EgressMapId).To(BeEquivalentTo(5))
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp2210
+
+import (
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/interface_types"
+	vpp_policer "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/policer"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2210/policer_types"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
+)
+
+// AddPolicer implements policer handler.
+func (h *PolicerVppHandler) AddPolicer(p *policer.Policer) (policerIdx uint32, err error) {
+	req := &vpp_policer.PolicerAddDel{
+		IsAdd:         true,
+		Name:          p.Name,
+		Cir:           p.Cir,
+		Eir:           p.Eir,
+		Cb:            p.Cb,
+		Eb:            p.Eb,
+		RateType:      policer_types.Sse2QosRateType(p.RateType),
+		RoundType:     policer_types.Sse2QosRoundType(p.RoundType),
+		Type:          policer_types.Sse2QosPolicerType(p.Type),
+		ColorAware:    p.ColorAware,
+		ConformAction: toQosAction(p.ConformAction),
+		ExceedAction:  toQosAction(p.ExceedAction),
+		ViolateAction: toQosAction(p.ViolateAction),
+	}
+	reply := &vpp_policer.PolicerAddDelReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return 0, err
+	}
+	return reply.PolicerIndex, nil
+}
+
+// DelPolicer implements policer handler.
+func (h *PolicerVppHandler) DelPolicer(name string) error {
+	req := &vpp_policer.PolicerAddDel{
+		IsAdd: false,
+		Name:  name,
+	}
+	reply := &vpp_policer.PolicerAddDelReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddPolicerToInterface implements policer handler.
+func (h *PolicerVppHandler) AddPolicerToInterface(name string, ifIdx uint32, output bool) error {
+	return h.applyPolicer(name, ifIdx, output, true)
+}
+
+// DelPolicerFromInterface implements policer handler.
+func (h *PolicerVppHandler) DelPolicerFromInterface(name string, ifIdx uint32, output bool) error {
+	return h.applyPolicer(name, ifIdx, output, false)
+}
+
+func (h *PolicerVppHandler) applyPolicer(name string, ifIdx uint32, output, apply bool) error {
+	if output {
+		req := &vpp_policer.PolicerOutput{
+			Name:      name,
+			SwIfIndex: interface_types.InterfaceIndex(ifIdx),
+			Apply:     apply,
+		}
+		reply := &vpp_policer.PolicerOutputReply{}
+		return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+	}
+	req := &vpp_policer.PolicerInput{
+		Name:      name,
+		SwIfIndex: interface_types.InterfaceIndex(ifIdx),
+		Apply:     apply,
+	}
+	reply := &vpp_policer.PolicerInputReply{}
+	return h.callsChannel.SendRequest(req).ReceiveReply(reply)
+}
+
+func toQosAction(action *policer.Policer_Action) policer_types.Sse2QosAction {
+	return policer_types.Sse2QosAction{
+		Type: policer_types.Sse2QosActionType(action.GetType()),
+		Dscp: uint8(action.GetDscp()),
+	}
+}
+
+func fromQosAction(action policer_types.Sse2QosAction) *policer.Policer_Action {
+	return &policer.Policer_Action{
+		Type: policer.Policer_Action_Type(action.Type),
+		Dscp: uint32(action.Dscp),
+	}
+}
@@ -17,6 +17,17 @@ type IPPuntRedirectKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type IPPuntRedirectBatchOp struct {
+	Key         string
+	Value       *vpp_punt.IPRedirect
+	PrevValue   *vpp_punt.IPRedirect
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type IPPuntRedirectDescriptor struct {
@@ -33,11 +44,15 @@ type IPPuntRedirectDescriptor struct {
 	Delete               func(key string, value *vpp_punt.IPRedirect, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_punt.IPRedirect, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_punt.IPRedirect, metadata interface{}) bool
+	CreateBatch          func(ops []*IPPuntRedirectBatchOp) error
+	UpdateBatch          func(ops []*IPPuntRedirectBatchOp) error
+	DeleteBatch          func(ops []*IPPuntRedirectBatchOp) error
 	Retrieve             func(correlate []IPPuntRedirectKVWithMetadata) ([]IPPuntRedirectKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_punt.IPRedirect) []KeyValuePair
 	Dependencies         func(key string, value *vpp_punt.IPRedirect) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewIPPuntRedirectDescriptor(typedDescriptor *IPPuntRedirectDescriptor) *KVD
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewIPPuntRedirectDescriptor(typedDescriptor *IPPuntRedirectDescriptor) *KVD
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *IPPuntRedirectDescriptorAdapter) UpdateWithRecreate(key string, oldVal
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *IPPuntRedirectDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castIPPuntRedirectBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyIPPuntRedirectBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *IPPuntRedirectDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castIPPuntRedirectBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyIPPuntRedirectBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *IPPuntRedirectDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castIPPuntRedirectBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyIPPuntRedirectBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *IPPuntRedirectDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []IPPuntRedirectKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castIPPuntRedirectValue(key string, value proto.Message) (*vpp_punt.IPRedir
 	return typedValue, nil
 }
 
+func castIPPuntRedirectBatchOps(ops []*BatchOp, withPrevValue bool) []*IPPuntRedirectBatchOp {
+	var typedOps []*IPPuntRedirectBatchOp
+	for _, op := range ops {
+		typedValue, err := castIPPuntRedirectValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_punt.IPRedirect
+		if withPrevValue {
+			typedPrevValue, err = castIPPuntRedirectValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castIPPuntRedirectMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &IPPuntRedirectBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyIPPuntRedirectBatchResults(ops []*BatchOp, typedOps []*IPPuntRedirectBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castIPPuntRedirectMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type PuntExceptionKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type PuntExceptionBatchOp struct {
+	Key         string
+	Value       *vpp_punt.Exception
+	PrevValue   *vpp_punt.Exception
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type PuntExceptionDescriptor struct {
@@ -33,11 +44,15 @@ type PuntExceptionDescriptor struct {
 	Delete               func(key string, value *vpp_punt.Exception, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_punt.Exception, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_punt.Exception, metadata interface{}) bool
+	CreateBatch          func(ops []*PuntExceptionBatchOp) error
+	UpdateBatch          func(ops []*PuntExceptionBatchOp) error
+	DeleteBatch          func(ops []*PuntExceptionBatchOp) error
 	Retrieve             func(correlate []PuntExceptionKVWithMetadata) ([]PuntExceptionKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_punt.Exception) []KeyValuePair
 	Dependencies         func(key string, value *vpp_punt.Exception) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewPuntExceptionDescriptor(typedDescriptor *PuntExceptionDescriptor) *KVDes
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewPuntExceptionDescriptor(typedDescriptor *PuntExceptionDescriptor) *KVDes
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *PuntExceptionDescriptorAdapter) UpdateWithRecreate(key string, oldValu
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *PuntExceptionDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castPuntExceptionBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyPuntExceptionBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *PuntExceptionDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castPuntExceptionBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyPuntExceptionBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *PuntExceptionDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castPuntExceptionBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyPuntExceptionBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *PuntExceptionDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []PuntExceptionKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castPuntExceptionValue(key string, value proto.Message) (*vpp_punt.Exceptio
 	return typedValue, nil
 }
 
+func castPuntExceptionBatchOps(ops []*BatchOp, withPrevValue bool) []*PuntExceptionBatchOp {
+	var typedOps []*PuntExceptionBatchOp
+	for _, op := range ops {
+		typedValue, err := castPuntExceptionValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_punt.Exception
+		if withPrevValue {
+			typedPrevValue, err = castPuntExceptionValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castPuntExceptionMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &PuntExceptionBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyPuntExceptionBatchResults(ops []*BatchOp, typedOps []*PuntExceptionBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castPuntExceptionMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type PuntToHostKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type PuntToHostBatchOp struct {
+	Key         string
+	Value       *vpp_punt.ToHost
+	PrevValue   *vpp_punt.ToHost
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type PuntToHostDescriptor struct {
@@ -33,11 +44,15 @@ type PuntToHostDescriptor struct {
 	Delete               func(key string, value *vpp_punt.ToHost, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_punt.ToHost, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_punt.ToHost, metadata interface{}) bool
+	CreateBatch          func(ops []*PuntToHostBatchOp) error
+	UpdateBatch          func(ops []*PuntToHostBatchOp) error
+	DeleteBatch          func(ops []*PuntToHostBatchOp) error
 	Retrieve             func(correlate []PuntToHostKVWithMetadata) ([]PuntToHostKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_punt.ToHost) []KeyValuePair
 	Dependencies         func(key string, value *vpp_punt.ToHost) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewPuntToHostDescriptor(typedDescriptor *PuntToHostDescriptor) *KVDescripto
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewPuntToHostDescriptor(typedDescriptor *PuntToHostDescriptor) *KVDescripto
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *PuntToHostDescriptorAdapter) UpdateWithRecreate(key string, oldValue,
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *PuntToHostDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castPuntToHostBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyPuntToHostBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *PuntToHostDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castPuntToHostBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyPuntToHostBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *PuntToHostDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castPuntToHostBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyPuntToHostBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *PuntToHostDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []PuntToHostKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castPuntToHostValue(key string, value proto.Message) (*vpp_punt.ToHost, err
 	return typedValue, nil
 }
 
+func castPuntToHostBatchOps(ops []*BatchOp, withPrevValue bool) []*PuntToHostBatchOp {
+	var typedOps []*PuntToHostBatchOp
+	for _, op := range ops {
+		typedValue, err := castPuntToHostValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_punt.ToHost
+		if withPrevValue {
+			typedPrevValue, err = castPuntToHostValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castPuntToHostMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &PuntToHostBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyPuntToHostBatchResults(ops []*BatchOp, typedOps []*PuntToHostBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castPuntToHostMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
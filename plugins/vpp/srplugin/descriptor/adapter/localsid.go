@@ -17,6 +17,17 @@ type LocalSIDKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type LocalSIDBatchOp struct {
+	Key         string
+	Value       *vpp_srv6.LocalSID
+	PrevValue   *vpp_srv6.LocalSID
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type LocalSIDDescriptor struct {
@@ -33,11 +44,15 @@ type LocalSIDDescriptor struct {
 	Delete               func(key string, value *vpp_srv6.LocalSID, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_srv6.LocalSID, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_srv6.LocalSID, metadata interface{}) bool
+	CreateBatch          func(ops []*LocalSIDBatchOp) error
+	UpdateBatch          func(ops []*LocalSIDBatchOp) error
+	DeleteBatch          func(ops []*LocalSIDBatchOp) error
 	Retrieve             func(correlate []LocalSIDKVWithMetadata) ([]LocalSIDKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_srv6.LocalSID) []KeyValuePair
 	Dependencies         func(key string, value *vpp_srv6.LocalSID) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewLocalSIDDescriptor(typedDescriptor *LocalSIDDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewLocalSIDDescriptor(typedDescriptor *LocalSIDDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *LocalSIDDescriptorAdapter) UpdateWithRecreate(key string, oldValue, ne
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *LocalSIDDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castLocalSIDBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyLocalSIDBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *LocalSIDDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castLocalSIDBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyLocalSIDBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *LocalSIDDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castLocalSIDBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyLocalSIDBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *LocalSIDDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []LocalSIDKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castLocalSIDValue(key string, value proto.Message) (*vpp_srv6.LocalSID, err
 	return typedValue, nil
 }
 
+func castLocalSIDBatchOps(ops []*BatchOp, withPrevValue bool) []*LocalSIDBatchOp {
+	var typedOps []*LocalSIDBatchOp
+	for _, op := range ops {
+		typedValue, err := castLocalSIDValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_srv6.LocalSID
+		if withPrevValue {
+			typedPrevValue, err = castLocalSIDValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castLocalSIDMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &LocalSIDBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyLocalSIDBatchResults(ops []*BatchOp, typedOps []*LocalSIDBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castLocalSIDMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type PolicyKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type PolicyBatchOp struct {
+	Key         string
+	Value       *vpp_srv6.Policy
+	PrevValue   *vpp_srv6.Policy
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type PolicyDescriptor struct {
@@ -33,11 +44,15 @@ type PolicyDescriptor struct {
 	Delete               func(key string, value *vpp_srv6.Policy, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_srv6.Policy, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_srv6.Policy, metadata interface{}) bool
+	CreateBatch          func(ops []*PolicyBatchOp) error
+	UpdateBatch          func(ops []*PolicyBatchOp) error
+	DeleteBatch          func(ops []*PolicyBatchOp) error
 	Retrieve             func(correlate []PolicyKVWithMetadata) ([]PolicyKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_srv6.Policy) []KeyValuePair
 	Dependencies         func(key string, value *vpp_srv6.Policy) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewPolicyDescriptor(typedDescriptor *PolicyDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewPolicyDescriptor(typedDescriptor *PolicyDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *PolicyDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newV
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *PolicyDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castPolicyBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyPolicyBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *PolicyDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castPolicyBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyPolicyBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *PolicyDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castPolicyBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyPolicyBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *PolicyDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []PolicyKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castPolicyValue(key string, value proto.Message) (*vpp_srv6.Policy, error)
 	return typedValue, nil
 }
 
+func castPolicyBatchOps(ops []*BatchOp, withPrevValue bool) []*PolicyBatchOp {
+	var typedOps []*PolicyBatchOp
+	for _, op := range ops {
+		typedValue, err := castPolicyValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_srv6.Policy
+		if withPrevValue {
+			typedPrevValue, err = castPolicyValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castPolicyMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &PolicyBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyPolicyBatchResults(ops []*BatchOp, typedOps []*PolicyBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castPolicyMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
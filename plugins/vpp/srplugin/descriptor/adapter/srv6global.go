@@ -17,6 +17,17 @@ type SRv6GlobalKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type SRv6GlobalBatchOp struct {
+	Key         string
+	Value       *vpp_srv6.SRv6Global
+	PrevValue   *vpp_srv6.SRv6Global
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type SRv6GlobalDescriptor struct {
@@ -33,11 +44,15 @@ type SRv6GlobalDescriptor struct {
 	Delete               func(key string, value *vpp_srv6.SRv6Global, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_srv6.SRv6Global, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_srv6.SRv6Global, metadata interface{}) bool
+	CreateBatch          func(ops []*SRv6GlobalBatchOp) error
+	UpdateBatch          func(ops []*SRv6GlobalBatchOp) error
+	DeleteBatch          func(ops []*SRv6GlobalBatchOp) error
 	Retrieve             func(correlate []SRv6GlobalKVWithMetadata) ([]SRv6GlobalKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_srv6.SRv6Global) []KeyValuePair
 	Dependencies         func(key string, value *vpp_srv6.SRv6Global) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewSRv6GlobalDescriptor(typedDescriptor *SRv6GlobalDescriptor) *KVDescripto
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewSRv6GlobalDescriptor(typedDescriptor *SRv6GlobalDescriptor) *KVDescripto
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *SRv6GlobalDescriptorAdapter) UpdateWithRecreate(key string, oldValue,
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *SRv6GlobalDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castSRv6GlobalBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copySRv6GlobalBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SRv6GlobalDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castSRv6GlobalBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copySRv6GlobalBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SRv6GlobalDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castSRv6GlobalBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copySRv6GlobalBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *SRv6GlobalDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []SRv6GlobalKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castSRv6GlobalValue(key string, value proto.Message) (*vpp_srv6.SRv6Global,
 	return typedValue, nil
 }
 
+func castSRv6GlobalBatchOps(ops []*BatchOp, withPrevValue bool) []*SRv6GlobalBatchOp {
+	var typedOps []*SRv6GlobalBatchOp
+	for _, op := range ops {
+		typedValue, err := castSRv6GlobalValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_srv6.SRv6Global
+		if withPrevValue {
+			typedPrevValue, err = castSRv6GlobalValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castSRv6GlobalMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &SRv6GlobalBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copySRv6GlobalBatchResults(ops []*BatchOp, typedOps []*SRv6GlobalBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castSRv6GlobalMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type SteeringKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type SteeringBatchOp struct {
+	Key         string
+	Value       *vpp_srv6.Steering
+	PrevValue   *vpp_srv6.Steering
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type SteeringDescriptor struct {
@@ -33,11 +44,15 @@ type SteeringDescriptor struct {
 	Delete               func(key string, value *vpp_srv6.Steering, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_srv6.Steering, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_srv6.Steering, metadata interface{}) bool
+	CreateBatch          func(ops []*SteeringBatchOp) error
+	UpdateBatch          func(ops []*SteeringBatchOp) error
+	DeleteBatch          func(ops []*SteeringBatchOp) error
 	Retrieve             func(correlate []SteeringKVWithMetadata) ([]SteeringKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_srv6.Steering) []KeyValuePair
 	Dependencies         func(key string, value *vpp_srv6.Steering) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewSteeringDescriptor(typedDescriptor *SteeringDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewSteeringDescriptor(typedDescriptor *SteeringDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *SteeringDescriptorAdapter) UpdateWithRecreate(key string, oldValue, ne
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *SteeringDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castSteeringBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copySteeringBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SteeringDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castSteeringBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copySteeringBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *SteeringDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castSteeringBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copySteeringBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *SteeringDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []SteeringKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castSteeringValue(key string, value proto.Message) (*vpp_srv6.Steering, err
 	return typedValue, nil
 }
 
+func castSteeringBatchOps(ops []*BatchOp, withPrevValue bool) []*SteeringBatchOp {
+	var typedOps []*SteeringBatchOp
+	for _, op := range ops {
+		typedValue, err := castSteeringValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_srv6.Steering
+		if withPrevValue {
+			typedPrevValue, err = castSteeringValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castSteeringMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &SteeringBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copySteeringBatchResults(ops []*BatchOp, typedOps []*SteeringBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castSteeringMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
@@ -17,6 +17,17 @@ type STNKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type STNBatchOp struct {
+	Key         string
+	Value       *vpp_stn.Rule
+	PrevValue   *vpp_stn.Rule
+	Metadata    interface{}
+	NewMetadata interface{}
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type STNDescriptor struct {
@@ -33,11 +44,15 @@ type STNDescriptor struct {
 	Delete               func(key string, value *vpp_stn.Rule, metadata interface{}) error
 	Update               func(key string, oldValue, newValue *vpp_stn.Rule, oldMetadata interface{}) (newMetadata interface{}, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_stn.Rule, metadata interface{}) bool
+	CreateBatch          func(ops []*STNBatchOp) error
+	UpdateBatch          func(ops []*STNBatchOp) error
+	DeleteBatch          func(ops []*STNBatchOp) error
 	Retrieve             func(correlate []STNKVWithMetadata) ([]STNKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_stn.Rule) []KeyValuePair
 	Dependencies         func(key string, value *vpp_stn.Rule) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -58,6 +73,7 @@ func NewSTNDescriptor(typedDescriptor *STNDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -77,6 +93,15 @@ func NewSTNDescriptor(typedDescriptor *STNDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -158,6 +183,27 @@ func (da *STNDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newValu
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *STNDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castSTNBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copySTNBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *STNDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castSTNBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copySTNBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *STNDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castSTNBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copySTNBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *STNDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []STNKVWithMetadata
 	for _, kvpair := range correlate {
@@ -221,6 +267,50 @@ func castSTNValue(key string, value proto.Message) (*vpp_stn.Rule, error) {
 	return typedValue, nil
 }
 
+func castSTNBatchOps(ops []*BatchOp, withPrevValue bool) []*STNBatchOp {
+	var typedOps []*STNBatchOp
+	for _, op := range ops {
+		typedValue, err := castSTNValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_stn.Rule
+		if withPrevValue {
+			typedPrevValue, err = castSTNValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castSTNMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &STNBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copySTNBatchResults(ops []*BatchOp, typedOps []*STNBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castSTNMetadata(key string, metadata Metadata) (interface{}, error) {
 	if metadata == nil {
 		return nil, nil
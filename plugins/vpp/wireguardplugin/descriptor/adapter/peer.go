@@ -18,6 +18,17 @@ type PeerKVWithMetadata struct {
 	Origin   ValueOrigin
 }
 
+////////// type-safe batch operation //////////
+
+type PeerBatchOp struct {
+	Key         string
+	Value       *vpp_wg.Peer
+	PrevValue   *vpp_wg.Peer
+	Metadata    *wgidx.WgMetadata
+	NewMetadata *wgidx.WgMetadata
+	Err         error
+}
+
 ////////// type-safe Descriptor structure //////////
 
 type PeerDescriptor struct {
@@ -34,11 +45,15 @@ type PeerDescriptor struct {
 	Delete               func(key string, value *vpp_wg.Peer, metadata *wgidx.WgMetadata) error
 	Update               func(key string, oldValue, newValue *vpp_wg.Peer, oldMetadata *wgidx.WgMetadata) (newMetadata *wgidx.WgMetadata, err error)
 	UpdateWithRecreate   func(key string, oldValue, newValue *vpp_wg.Peer, metadata *wgidx.WgMetadata) bool
+	CreateBatch          func(ops []*PeerBatchOp) error
+	UpdateBatch          func(ops []*PeerBatchOp) error
+	DeleteBatch          func(ops []*PeerBatchOp) error
 	Retrieve             func(correlate []PeerKVWithMetadata) ([]PeerKVWithMetadata, error)
 	IsRetriableFailure   func(err error) bool
 	DerivedValues        func(key string, value *vpp_wg.Peer) []KeyValuePair
 	Dependencies         func(key string, value *vpp_wg.Peer) []Dependency
 	RetrieveDependencies []string /* descriptor name */
+	ConcurrencySafe      bool
 }
 
 ////////// Descriptor adapter //////////
@@ -59,6 +74,7 @@ func NewPeerDescriptor(typedDescriptor *PeerDescriptor) *KVDescriptor {
 		MetadataMapFactory:   typedDescriptor.MetadataMapFactory,
 		IsRetriableFailure:   typedDescriptor.IsRetriableFailure,
 		RetrieveDependencies: typedDescriptor.RetrieveDependencies,
+		ConcurrencySafe:      typedDescriptor.ConcurrencySafe,
 	}
 	if typedDescriptor.ValueComparator != nil {
 		descriptor.ValueComparator = adapter.ValueComparator
@@ -78,6 +94,15 @@ func NewPeerDescriptor(typedDescriptor *PeerDescriptor) *KVDescriptor {
 	if typedDescriptor.UpdateWithRecreate != nil {
 		descriptor.UpdateWithRecreate = adapter.UpdateWithRecreate
 	}
+	if typedDescriptor.CreateBatch != nil {
+		descriptor.CreateBatch = adapter.CreateBatch
+	}
+	if typedDescriptor.UpdateBatch != nil {
+		descriptor.UpdateBatch = adapter.UpdateBatch
+	}
+	if typedDescriptor.DeleteBatch != nil {
+		descriptor.DeleteBatch = adapter.DeleteBatch
+	}
 	if typedDescriptor.Retrieve != nil {
 		descriptor.Retrieve = adapter.Retrieve
 	}
@@ -159,6 +184,27 @@ func (da *PeerDescriptorAdapter) UpdateWithRecreate(key string, oldValue, newVal
 	return da.descriptor.UpdateWithRecreate(key, oldTypedValue, newTypedValue, typedMetadata)
 }
 
+func (da *PeerDescriptorAdapter) CreateBatch(ops []*BatchOp) error {
+	typedOps := castPeerBatchOps(ops, false)
+	err := da.descriptor.CreateBatch(typedOps)
+	copyPeerBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *PeerDescriptorAdapter) UpdateBatch(ops []*BatchOp) error {
+	typedOps := castPeerBatchOps(ops, true)
+	err := da.descriptor.UpdateBatch(typedOps)
+	copyPeerBatchResults(ops, typedOps)
+	return err
+}
+
+func (da *PeerDescriptorAdapter) DeleteBatch(ops []*BatchOp) error {
+	typedOps := castPeerBatchOps(ops, false)
+	err := da.descriptor.DeleteBatch(typedOps)
+	copyPeerBatchResults(ops, typedOps)
+	return err
+}
+
 func (da *PeerDescriptorAdapter) Retrieve(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
 	var correlateWithType []PeerKVWithMetadata
 	for _, kvpair := range correlate {
@@ -222,6 +268,50 @@ func castPeerValue(key string, value proto.Message) (*vpp_wg.Peer, error) {
 	return typedValue, nil
 }
 
+func castPeerBatchOps(ops []*BatchOp, withPrevValue bool) []*PeerBatchOp {
+	var typedOps []*PeerBatchOp
+	for _, op := range ops {
+		typedValue, err := castPeerValue(op.Key, op.Value)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		var typedPrevValue *vpp_wg.Peer
+		if withPrevValue {
+			typedPrevValue, err = castPeerValue(op.Key, op.PrevValue)
+			if err != nil {
+				op.Err = err
+				continue
+			}
+		}
+		typedMetadata, err := castPeerMetadata(op.Key, op.Metadata)
+		if err != nil {
+			op.Err = err
+			continue
+		}
+		typedOps = append(typedOps, &PeerBatchOp{
+			Key:       op.Key,
+			Value:     typedValue,
+			PrevValue: typedPrevValue,
+			Metadata:  typedMetadata,
+		})
+	}
+	return typedOps
+}
+
+func copyPeerBatchResults(ops []*BatchOp, typedOps []*PeerBatchOp) {
+	i := 0
+	for _, op := range ops {
+		if op.Err != nil {
+			// failed to cast, not passed to the descriptor
+			continue
+		}
+		op.NewMetadata = typedOps[i].NewMetadata
+		op.Err = typedOps[i].Err
+		i++
+	}
+}
+
 func castPeerMetadata(key string, metadata Metadata) (*wgidx.WgMetadata, error) {
 	if metadata == nil {
 		return nil, nil
@@ -31,6 +31,11 @@ type ManagerServiceClient interface {
 	// Subscribe is used for subscribing to events.
 	// Notifications are returned by streaming updates.
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ManagerService_SubscribeClient, error)
+	// ListRevisions is used to list retained revisions of the desired configuration.
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	// Rollback is used to re-apply the desired configuration
+	// of an older revision (as a full resync).
+	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error)
 }
 
 type managerServiceClient struct {
@@ -100,6 +105,24 @@ func (x *managerServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
 	return m, nil
 }
 
+func (c *managerServiceClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/ligato.generic.ManagerService/ListRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error) {
+	out := new(RollbackResponse)
+	err := c.cc.Invoke(ctx, "/ligato.generic.ManagerService/Rollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ManagerServiceServer is the server API for ManagerService service.
 // All implementations must embed UnimplementedManagerServiceServer
 // for forward compatibility
@@ -113,6 +136,11 @@ type ManagerServiceServer interface {
 	// Subscribe is used for subscribing to events.
 	// Notifications are returned by streaming updates.
 	Subscribe(*SubscribeRequest, ManagerService_SubscribeServer) error
+	// ListRevisions is used to list retained revisions of the desired configuration.
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	// Rollback is used to re-apply the desired configuration
+	// of an older revision (as a full resync).
+	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
 	mustEmbedUnimplementedManagerServiceServer()
 }
 
@@ -132,6 +160,12 @@ func (UnimplementedManagerServiceServer) DumpState(context.Context, *DumpStateRe
 func (UnimplementedManagerServiceServer) Subscribe(*SubscribeRequest, ManagerService_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (UnimplementedManagerServiceServer) ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedManagerServiceServer) Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
 func (UnimplementedManagerServiceServer) mustEmbedUnimplementedManagerServiceServer() {}
 
 // UnsafeManagerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -220,6 +254,42 @@ func (x *managerServiceSubscribeServer) Send(m *SubscribeResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ManagerService_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ligato.generic.ManagerService/ListRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ligato.generic.ManagerService/Rollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).Rollback(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ManagerService_ServiceDesc is the grpc.ServiceDesc for ManagerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -239,6 +309,14 @@ var ManagerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DumpState",
 			Handler:    _ManagerService_DumpState_Handler,
 		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _ManagerService_ListRevisions_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _ManagerService_Rollback_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,414 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/kvscheduler/txn_plan.proto
+
+package kvscheduler
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TxnQueuePriority is the priority with which a transaction is queued for processing.
+// Queued transactions of higher priority are processed first, transaction
+// already being processed is never interrupted.
+type TxnQueuePriority int32
+
+const (
+	TxnQueuePriority_NORMAL TxnQueuePriority = 0
+	TxnQueuePriority_LOW    TxnQueuePriority = 1
+	TxnQueuePriority_HIGH   TxnQueuePriority = 2
+)
+
+// Enum value maps for TxnQueuePriority.
+var (
+	TxnQueuePriority_name = map[int32]string{
+		0: "NORMAL",
+		1: "LOW",
+		2: "HIGH",
+	}
+	TxnQueuePriority_value = map[string]int32{
+		"NORMAL": 0,
+		"LOW":    1,
+		"HIGH":   2,
+	}
+)
+
+func (x TxnQueuePriority) Enum() *TxnQueuePriority {
+	p := new(TxnQueuePriority)
+	*p = x
+	return p
+}
+
+func (x TxnQueuePriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TxnQueuePriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_kvscheduler_txn_plan_proto_enumTypes[0].Descriptor()
+}
+
+func (TxnQueuePriority) Type() protoreflect.EnumType {
+	return &file_ligato_kvscheduler_txn_plan_proto_enumTypes[0]
+}
+
+func (x TxnQueuePriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TxnQueuePriority.Descriptor instead.
+func (TxnQueuePriority) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_kvscheduler_txn_plan_proto_rawDescGZIP(), []int{0}
+}
+
+// TxnPlan is an execution plan of a transaction obtained by simulation
+// (dry-run) without actually executing any of the operations.
+type TxnPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sequence number of the simulated transaction.
+	SeqNum uint64 `protobuf:"varint,1,opt,name=seq_num,json=seqNum,proto3" json:"seq_num,omitempty"`
+	// Operations in the order in which they would be executed.
+	Operations []*PlannedOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (x *TxnPlan) Reset() {
+	*x = TxnPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_kvscheduler_txn_plan_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxnPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxnPlan) ProtoMessage() {}
+
+func (x *TxnPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_kvscheduler_txn_plan_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxnPlan.ProtoReflect.Descriptor instead.
+func (*TxnPlan) Descriptor() ([]byte, []int) {
+	return file_ligato_kvscheduler_txn_plan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TxnPlan) GetSeqNum() uint64 {
+	if x != nil {
+		return x.SeqNum
+	}
+	return 0
+}
+
+func (x *TxnPlan) GetOperations() []*PlannedOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// PlannedOperation is a single operation of a transaction plan.
+type PlannedOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation TxnOperation `protobuf:"varint,1,opt,name=operation,proto3,enum=ligato.kvscheduler.TxnOperation" json:"operation,omitempty"`
+	Key       string       `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Value before and after the operation (in the text format).
+	PrevValue string     `protobuf:"bytes,3,opt,name=prev_value,json=prevValue,proto3" json:"prev_value,omitempty"`
+	NewValue  string     `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	PrevState ValueState `protobuf:"varint,5,opt,name=prev_state,json=prevState,proto3,enum=ligato.kvscheduler.ValueState" json:"prev_state,omitempty"`
+	NewState  ValueState `protobuf:"varint,6,opt,name=new_state,json=newState,proto3,enum=ligato.kvscheduler.ValueState" json:"new_state,omitempty"`
+	// Error the operation would end with (e.g. value failed validation).
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// Labels of unmet dependencies for value that would be left pending.
+	UnmetDependencies []string `protobuf:"bytes,8,rep,name=unmet_dependencies,json=unmetDependencies,proto3" json:"unmet_dependencies,omitempty"`
+	IsDerived         bool     `protobuf:"varint,9,opt,name=is_derived,json=isDerived,proto3" json:"is_derived,omitempty"`
+	IsProperty        bool     `protobuf:"varint,10,opt,name=is_property,json=isProperty,proto3" json:"is_property,omitempty"`
+	IsRecreate        bool     `protobuf:"varint,11,opt,name=is_recreate,json=isRecreate,proto3" json:"is_recreate,omitempty"`
+	// NOOP is true if the operation would not actually change anything
+	// in the southbound (e.g. value is left pending).
+	Noop bool `protobuf:"varint,12,opt,name=noop,proto3" json:"noop,omitempty"`
+}
+
+func (x *PlannedOperation) Reset() {
+	*x = PlannedOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_kvscheduler_txn_plan_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlannedOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedOperation) ProtoMessage() {}
+
+func (x *PlannedOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_kvscheduler_txn_plan_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedOperation.ProtoReflect.Descriptor instead.
+func (*PlannedOperation) Descriptor() ([]byte, []int) {
+	return file_ligato_kvscheduler_txn_plan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlannedOperation) GetOperation() TxnOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return TxnOperation_UNDEFINED
+}
+
+func (x *PlannedOperation) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PlannedOperation) GetPrevValue() string {
+	if x != nil {
+		return x.PrevValue
+	}
+	return ""
+}
+
+func (x *PlannedOperation) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *PlannedOperation) GetPrevState() ValueState {
+	if x != nil {
+		return x.PrevState
+	}
+	return ValueState_NONEXISTENT
+}
+
+func (x *PlannedOperation) GetNewState() ValueState {
+	if x != nil {
+		return x.NewState
+	}
+	return ValueState_NONEXISTENT
+}
+
+func (x *PlannedOperation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PlannedOperation) GetUnmetDependencies() []string {
+	if x != nil {
+		return x.UnmetDependencies
+	}
+	return nil
+}
+
+func (x *PlannedOperation) GetIsDerived() bool {
+	if x != nil {
+		return x.IsDerived
+	}
+	return false
+}
+
+func (x *PlannedOperation) GetIsProperty() bool {
+	if x != nil {
+		return x.IsProperty
+	}
+	return false
+}
+
+func (x *PlannedOperation) GetIsRecreate() bool {
+	if x != nil {
+		return x.IsRecreate
+	}
+	return false
+}
+
+func (x *PlannedOperation) GetNoop() bool {
+	if x != nil {
+		return x.Noop
+	}
+	return false
+}
+
+var File_ligato_kvscheduler_txn_plan_proto protoreflect.FileDescriptor
+
+var file_ligato_kvscheduler_txn_plan_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6b, 0x76, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2f, 0x74, 0x78, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x12, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6b, 0x76, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x1a, 0x25, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
+	0x6b, 0x76, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x68,
+	0x0a, 0x07, 0x54, 0x78, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x71,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x71, 0x4e,
+	0x75, 0x6d, 0x12, 0x44, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x6b, 0x76, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd6, 0x03, 0x0a, 0x10, 0x50, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6b, 0x76, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x78, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x65, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x70,
+	0x72, 0x65, 0x76, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6b, 0x76, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x09, 0x70, 0x72, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x6e, 0x65,
+	0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6b, 0x76, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6e,
+	0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2d, 0x0a,
+	0x12, 0x75, 0x6e, 0x6d, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x75, 0x6e, 0x6d, 0x65, 0x74,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x73, 0x5f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6e, 0x6f, 0x6f,
+	0x70, 0x2a, 0x31, 0x0a, 0x10, 0x54, 0x78, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49,
+	0x47, 0x48, 0x10, 0x02, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76,
+	0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6b,
+	0x76, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_ligato_kvscheduler_txn_plan_proto_rawDescOnce sync.Once
+	file_ligato_kvscheduler_txn_plan_proto_rawDescData = file_ligato_kvscheduler_txn_plan_proto_rawDesc
+)
+
+func file_ligato_kvscheduler_txn_plan_proto_rawDescGZIP() []byte {
+	file_ligato_kvscheduler_txn_plan_proto_rawDescOnce.Do(func() {
+		file_ligato_kvscheduler_txn_plan_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_kvscheduler_txn_plan_proto_rawDescData)
+	})
+	return file_ligato_kvscheduler_txn_plan_proto_rawDescData
+}
+
+var file_ligato_kvscheduler_txn_plan_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ligato_kvscheduler_txn_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ligato_kvscheduler_txn_plan_proto_goTypes = []interface{}{
+	(TxnQueuePriority)(0),    // 0: ligato.kvscheduler.TxnQueuePriority
+	(*TxnPlan)(nil),          // 1: ligato.kvscheduler.TxnPlan
+	(*PlannedOperation)(nil), // 2: ligato.kvscheduler.PlannedOperation
+	(TxnOperation)(0),        // 3: ligato.kvscheduler.TxnOperation
+	(ValueState)(0),          // 4: ligato.kvscheduler.ValueState
+}
+var file_ligato_kvscheduler_txn_plan_proto_depIdxs = []int32{
+	2, // 0: ligato.kvscheduler.TxnPlan.operations:type_name -> ligato.kvscheduler.PlannedOperation
+	3, // 1: ligato.kvscheduler.PlannedOperation.operation:type_name -> ligato.kvscheduler.TxnOperation
+	4, // 2: ligato.kvscheduler.PlannedOperation.prev_state:type_name -> ligato.kvscheduler.ValueState
+	4, // 3: ligato.kvscheduler.PlannedOperation.new_state:type_name -> ligato.kvscheduler.ValueState
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ligato_kvscheduler_txn_plan_proto_init() }
+func file_ligato_kvscheduler_txn_plan_proto_init() {
+	if File_ligato_kvscheduler_txn_plan_proto != nil {
+		return
+	}
+	file_ligato_kvscheduler_value_status_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_kvscheduler_txn_plan_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxnPlan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_kvscheduler_txn_plan_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlannedOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_kvscheduler_txn_plan_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_kvscheduler_txn_plan_proto_goTypes,
+		DependencyIndexes: file_ligato_kvscheduler_txn_plan_proto_depIdxs,
+		EnumInfos:         file_ligato_kvscheduler_txn_plan_proto_enumTypes,
+		MessageInfos:      file_ligato_kvscheduler_txn_plan_proto_msgTypes,
+	}.Build()
+	File_ligato_kvscheduler_txn_plan_proto = out.File
+	file_ligato_kvscheduler_txn_plan_proto_rawDesc = nil
+	file_ligato_kvscheduler_txn_plan_proto_goTypes = nil
+	file_ligato_kvscheduler_txn_plan_proto_depIdxs = nil
+}
@@ -58,18 +58,40 @@ const (
 	Interface_VRF_DEVICE Interface_Type = 5
 	// Create a dummy Linux interface which effectively behaves just like the loopback.
 	Interface_DUMMY Interface_Type = 6
+	// Linux bridge with other Linux interfaces enslaved to it as ports.
+	Interface_BRIDGE Interface_Type = 7
+	// VLAN sub-interface on top of a parent Linux interface.
+	Interface_VLAN Interface_Type = 8
+	// MACVLAN interface on top of a parent Linux interface.
+	Interface_MACVLAN Interface_Type = 9
+	// IPVLAN interface on top of a parent Linux interface.
+	Interface_IPVLAN Interface_Type = 10
+	// VXLAN tunnel interface.
+	Interface_VXLAN Interface_Type = 11
+	// Host side of VPP linux-cp interface pair (see ligato.vpp.linuxcp.LinuxCpPair).
+	// The interface is created by VPP in the namespace selected by the pair,
+	// the agent only attaches additional configuration (IP addresses, MTU, ...)
+	// to it. Interfaces of this type are derived from the pair and should not
+	// be configured directly.
+	Interface_LINUX_CP Interface_Type = 12
 )
 
 // Enum value maps for Interface_Type.
 var (
 	Interface_Type_name = map[int32]string{
-		0: "UNDEFINED",
-		1: "VETH",
-		2: "TAP_TO_VPP",
-		3: "LOOPBACK",
-		4: "EXISTING",
-		5: "VRF_DEVICE",
-		6: "DUMMY",
+		0:  "UNDEFINED",
+		1:  "VETH",
+		2:  "TAP_TO_VPP",
+		3:  "LOOPBACK",
+		4:  "EXISTING",
+		5:  "VRF_DEVICE",
+		6:  "DUMMY",
+		7:  "BRIDGE",
+		8:  "VLAN",
+		9:  "MACVLAN",
+		10: "IPVLAN",
+		11: "VXLAN",
+		12: "LINUX_CP",
 	}
 	Interface_Type_value = map[string]int32{
 		"UNDEFINED":  0,
@@ -79,6 +101,12 @@ var (
 		"EXISTING":   4,
 		"VRF_DEVICE": 5,
 		"DUMMY":      6,
+		"BRIDGE":     7,
+		"VLAN":       8,
+		"MACVLAN":    9,
+		"IPVLAN":     10,
+		"VXLAN":      11,
+		"LINUX_CP":   12,
 	}
 )
 
@@ -158,6 +186,202 @@ func (VethLink_ChecksumOffloading) EnumDescriptor() ([]byte, []int) {
 	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{1, 0}
 }
 
+type VlanLink_Protocol int32
+
+const (
+	VlanLink_DOT1Q  VlanLink_Protocol = 0
+	VlanLink_DOT1AD VlanLink_Protocol = 1
+)
+
+// Enum value maps for VlanLink_Protocol.
+var (
+	VlanLink_Protocol_name = map[int32]string{
+		0: "DOT1Q",
+		1: "DOT1AD",
+	}
+	VlanLink_Protocol_value = map[string]int32{
+		"DOT1Q":  0,
+		"DOT1AD": 1,
+	}
+)
+
+func (x VlanLink_Protocol) Enum() *VlanLink_Protocol {
+	p := new(VlanLink_Protocol)
+	*p = x
+	return p
+}
+
+func (x VlanLink_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VlanLink_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_interfaces_interface_proto_enumTypes[2].Descriptor()
+}
+
+func (VlanLink_Protocol) Type() protoreflect.EnumType {
+	return &file_ligato_linux_interfaces_interface_proto_enumTypes[2]
+}
+
+func (x VlanLink_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VlanLink_Protocol.Descriptor instead.
+func (VlanLink_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type MacvlanLink_Mode int32
+
+const (
+	MacvlanLink_BRIDGE   MacvlanLink_Mode = 0
+	MacvlanLink_PRIVATE  MacvlanLink_Mode = 1
+	MacvlanLink_VEPA     MacvlanLink_Mode = 2
+	MacvlanLink_PASSTHRU MacvlanLink_Mode = 3
+)
+
+// Enum value maps for MacvlanLink_Mode.
+var (
+	MacvlanLink_Mode_name = map[int32]string{
+		0: "BRIDGE",
+		1: "PRIVATE",
+		2: "VEPA",
+		3: "PASSTHRU",
+	}
+	MacvlanLink_Mode_value = map[string]int32{
+		"BRIDGE":   0,
+		"PRIVATE":  1,
+		"VEPA":     2,
+		"PASSTHRU": 3,
+	}
+)
+
+func (x MacvlanLink_Mode) Enum() *MacvlanLink_Mode {
+	p := new(MacvlanLink_Mode)
+	*p = x
+	return p
+}
+
+func (x MacvlanLink_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MacvlanLink_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_interfaces_interface_proto_enumTypes[3].Descriptor()
+}
+
+func (MacvlanLink_Mode) Type() protoreflect.EnumType {
+	return &file_ligato_linux_interfaces_interface_proto_enumTypes[3]
+}
+
+func (x MacvlanLink_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MacvlanLink_Mode.Descriptor instead.
+func (MacvlanLink_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type IpvlanLink_Mode int32
+
+const (
+	IpvlanLink_L2  IpvlanLink_Mode = 0
+	IpvlanLink_L3  IpvlanLink_Mode = 1
+	IpvlanLink_L3S IpvlanLink_Mode = 2
+)
+
+// Enum value maps for IpvlanLink_Mode.
+var (
+	IpvlanLink_Mode_name = map[int32]string{
+		0: "L2",
+		1: "L3",
+		2: "L3S",
+	}
+	IpvlanLink_Mode_value = map[string]int32{
+		"L2":  0,
+		"L3":  1,
+		"L3S": 2,
+	}
+)
+
+func (x IpvlanLink_Mode) Enum() *IpvlanLink_Mode {
+	p := new(IpvlanLink_Mode)
+	*p = x
+	return p
+}
+
+func (x IpvlanLink_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IpvlanLink_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_interfaces_interface_proto_enumTypes[4].Descriptor()
+}
+
+func (IpvlanLink_Mode) Type() protoreflect.EnumType {
+	return &file_ligato_linux_interfaces_interface_proto_enumTypes[4]
+}
+
+func (x IpvlanLink_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IpvlanLink_Mode.Descriptor instead.
+func (IpvlanLink_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type IpvlanLink_Flag int32
+
+const (
+	IpvlanLink_BRIDGE  IpvlanLink_Flag = 0
+	IpvlanLink_PRIVATE IpvlanLink_Flag = 1
+	IpvlanLink_VEPA    IpvlanLink_Flag = 2
+)
+
+// Enum value maps for IpvlanLink_Flag.
+var (
+	IpvlanLink_Flag_name = map[int32]string{
+		0: "BRIDGE",
+		1: "PRIVATE",
+		2: "VEPA",
+	}
+	IpvlanLink_Flag_value = map[string]int32{
+		"BRIDGE":  0,
+		"PRIVATE": 1,
+		"VEPA":    2,
+	}
+)
+
+func (x IpvlanLink_Flag) Enum() *IpvlanLink_Flag {
+	p := new(IpvlanLink_Flag)
+	*p = x
+	return p
+}
+
+func (x IpvlanLink_Flag) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IpvlanLink_Flag) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_interfaces_interface_proto_enumTypes[5].Descriptor()
+}
+
+func (IpvlanLink_Flag) Type() protoreflect.EnumType {
+	return &file_ligato_linux_interfaces_interface_proto_enumTypes[5]
+}
+
+func (x IpvlanLink_Flag) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IpvlanLink_Flag.Descriptor instead.
+func (IpvlanLink_Flag) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{8, 1}
+}
+
 type Interface struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -191,6 +415,12 @@ type Interface struct {
 	//	*Interface_Veth
 	//	*Interface_Tap
 	//	*Interface_VrfDev
+	//	*Interface_Bridge
+	//	*Interface_Vlan
+	//	*Interface_Macvlan
+	//	*Interface_Ipvlan
+	//	*Interface_Vxlan
+	//	*Interface_LinuxCp
 	Link isInterface_Link `protobuf_oneof:"link"`
 	// Configure/Resync link only. IP/MAC addresses are expected to be configured
 	// externally - i.e. by a different agent or manually via CLI.
@@ -319,6 +549,48 @@ func (x *Interface) GetVrfDev() *VrfDevLink {
 	return nil
 }
 
+func (x *Interface) GetBridge() *BridgeLink {
+	if x, ok := x.GetLink().(*Interface_Bridge); ok {
+		return x.Bridge
+	}
+	return nil
+}
+
+func (x *Interface) GetVlan() *VlanLink {
+	if x, ok := x.GetLink().(*Interface_Vlan); ok {
+		return x.Vlan
+	}
+	return nil
+}
+
+func (x *Interface) GetMacvlan() *MacvlanLink {
+	if x, ok := x.GetLink().(*Interface_Macvlan); ok {
+		return x.Macvlan
+	}
+	return nil
+}
+
+func (x *Interface) GetIpvlan() *IpvlanLink {
+	if x, ok := x.GetLink().(*Interface_Ipvlan); ok {
+		return x.Ipvlan
+	}
+	return nil
+}
+
+func (x *Interface) GetVxlan() *VxlanLink {
+	if x, ok := x.GetLink().(*Interface_Vxlan); ok {
+		return x.Vxlan
+	}
+	return nil
+}
+
+func (x *Interface) GetLinuxCp() *LinuxCpLink {
+	if x, ok := x.GetLink().(*Interface_LinuxCp); ok {
+		return x.LinuxCp
+	}
+	return nil
+}
+
 func (x *Interface) GetLinkOnly() bool {
 	if x != nil {
 		return x.LinkOnly
@@ -352,12 +624,54 @@ type Interface_VrfDev struct {
 	VrfDev *VrfDevLink `protobuf:"bytes,22,opt,name=vrf_dev,json=vrfDev,proto3,oneof"`
 }
 
+type Interface_Bridge struct {
+	// BRIDGE-specific configuration
+	Bridge *BridgeLink `protobuf:"bytes,23,opt,name=bridge,proto3,oneof"`
+}
+
+type Interface_Vlan struct {
+	// VLAN-specific configuration
+	Vlan *VlanLink `protobuf:"bytes,24,opt,name=vlan,proto3,oneof"`
+}
+
+type Interface_Macvlan struct {
+	// MACVLAN-specific configuration
+	Macvlan *MacvlanLink `protobuf:"bytes,25,opt,name=macvlan,proto3,oneof"`
+}
+
+type Interface_Ipvlan struct {
+	// IPVLAN-specific configuration
+	Ipvlan *IpvlanLink `protobuf:"bytes,26,opt,name=ipvlan,proto3,oneof"`
+}
+
+type Interface_Vxlan struct {
+	// VXLAN-specific configuration
+	Vxlan *VxlanLink `protobuf:"bytes,27,opt,name=vxlan,proto3,oneof"`
+}
+
+type Interface_LinuxCp struct {
+	// LINUX_CP-specific configuration
+	LinuxCp *LinuxCpLink `protobuf:"bytes,28,opt,name=linux_cp,json=linuxCp,proto3,oneof"`
+}
+
 func (*Interface_Veth) isInterface_Link() {}
 
 func (*Interface_Tap) isInterface_Link() {}
 
 func (*Interface_VrfDev) isInterface_Link() {}
 
+func (*Interface_Bridge) isInterface_Link() {}
+
+func (*Interface_Vlan) isInterface_Link() {}
+
+func (*Interface_Macvlan) isInterface_Link() {}
+
+func (*Interface_Ipvlan) isInterface_Link() {}
+
+func (*Interface_Vxlan) isInterface_Link() {}
+
+func (*Interface_LinuxCp) isInterface_Link() {}
+
 type VethLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -472,6 +786,55 @@ func (x *TapLink) GetVppTapIfName() string {
 	return ""
 }
 
+type LinuxCpLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Logical name of the VPP interface paired with this interface
+	// using linux-cp (mandatory for LINUX_CP)
+	VppIfName string `protobuf:"bytes,1,opt,name=vpp_if_name,json=vppIfName,proto3" json:"vpp_if_name,omitempty"`
+}
+
+func (x *LinuxCpLink) Reset() {
+	*x = LinuxCpLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinuxCpLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinuxCpLink) ProtoMessage() {}
+
+func (x *LinuxCpLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinuxCpLink.ProtoReflect.Descriptor instead.
+func (*LinuxCpLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LinuxCpLink) GetVppIfName() string {
+	if x != nil {
+		return x.VppIfName
+	}
+	return ""
+}
+
 type VrfDevLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -488,7 +851,7 @@ type VrfDevLink struct {
 func (x *VrfDevLink) Reset() {
 	*x = VrfDevLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[3]
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -501,7 +864,7 @@ func (x *VrfDevLink) String() string {
 func (*VrfDevLink) ProtoMessage() {}
 
 func (x *VrfDevLink) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[3]
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +877,7 @@ func (x *VrfDevLink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VrfDevLink.ProtoReflect.Descriptor instead.
 func (*VrfDevLink) Descriptor() ([]byte, []int) {
-	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{3}
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *VrfDevLink) GetRoutingTable() uint32 {
@@ -524,6 +887,364 @@ func (x *VrfDevLink) GetRoutingTable() uint32 {
 	return 0
 }
 
+type BridgeLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Logical names of Linux interfaces enslaved to the bridge as ports.
+	// Ports have to be placed into the same network namespace as the bridge.
+	Ports []string `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+	// Enable VLAN filtering on the bridge.
+	VlanFiltering bool `protobuf:"varint,2,opt,name=vlan_filtering,json=vlanFiltering,proto3" json:"vlan_filtering,omitempty"`
+}
+
+func (x *BridgeLink) Reset() {
+	*x = BridgeLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BridgeLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeLink) ProtoMessage() {}
+
+func (x *BridgeLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeLink.ProtoReflect.Descriptor instead.
+func (*BridgeLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BridgeLink) GetPorts() []string {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *BridgeLink) GetVlanFiltering() bool {
+	if x != nil {
+		return x.VlanFiltering
+	}
+	return false
+}
+
+type VlanLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Logical name of the parent Linux interface (mandatory for VLAN).
+	// VLAN sub-interface is created in the namespace of the parent interface
+	// and then moved to the namespace of the sub-interface.
+	ParentIfName string `protobuf:"bytes,1,opt,name=parent_if_name,json=parentIfName,proto3" json:"parent_if_name,omitempty"`
+	// VLAN ID (mandatory for VLAN).
+	VlanId uint32 `protobuf:"varint,2,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	// VLAN protocol (802.1Q by default).
+	Protocol VlanLink_Protocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=ligato.linux.interfaces.VlanLink_Protocol" json:"protocol,omitempty"`
+}
+
+func (x *VlanLink) Reset() {
+	*x = VlanLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VlanLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VlanLink) ProtoMessage() {}
+
+func (x *VlanLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VlanLink.ProtoReflect.Descriptor instead.
+func (*VlanLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VlanLink) GetParentIfName() string {
+	if x != nil {
+		return x.ParentIfName
+	}
+	return ""
+}
+
+func (x *VlanLink) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+func (x *VlanLink) GetProtocol() VlanLink_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return VlanLink_DOT1Q
+}
+
+type MacvlanLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Logical name of the parent Linux interface (mandatory for MACVLAN).
+	// MACVLAN interface is created in the namespace of the parent interface
+	// and then moved to the namespace of the MACVLAN interface.
+	ParentIfName string `protobuf:"bytes,1,opt,name=parent_if_name,json=parentIfName,proto3" json:"parent_if_name,omitempty"`
+	// MACVLAN mode (bridge by default).
+	Mode MacvlanLink_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=ligato.linux.interfaces.MacvlanLink_Mode" json:"mode,omitempty"`
+}
+
+func (x *MacvlanLink) Reset() {
+	*x = MacvlanLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MacvlanLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MacvlanLink) ProtoMessage() {}
+
+func (x *MacvlanLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MacvlanLink.ProtoReflect.Descriptor instead.
+func (*MacvlanLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MacvlanLink) GetParentIfName() string {
+	if x != nil {
+		return x.ParentIfName
+	}
+	return ""
+}
+
+func (x *MacvlanLink) GetMode() MacvlanLink_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return MacvlanLink_BRIDGE
+}
+
+type IpvlanLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Logical name of the parent Linux interface (mandatory for IPVLAN).
+	// IPVLAN interface is created in the namespace of the parent interface
+	// and then moved to the namespace of the IPVLAN interface.
+	ParentIfName string `protobuf:"bytes,1,opt,name=parent_if_name,json=parentIfName,proto3" json:"parent_if_name,omitempty"`
+	// IPVLAN mode (L2 by default).
+	Mode IpvlanLink_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=ligato.linux.interfaces.IpvlanLink_Mode" json:"mode,omitempty"`
+	// IPVLAN flag (bridge by default).
+	Flag IpvlanLink_Flag `protobuf:"varint,3,opt,name=flag,proto3,enum=ligato.linux.interfaces.IpvlanLink_Flag" json:"flag,omitempty"`
+}
+
+func (x *IpvlanLink) Reset() {
+	*x = IpvlanLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IpvlanLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IpvlanLink) ProtoMessage() {}
+
+func (x *IpvlanLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IpvlanLink.ProtoReflect.Descriptor instead.
+func (*IpvlanLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IpvlanLink) GetParentIfName() string {
+	if x != nil {
+		return x.ParentIfName
+	}
+	return ""
+}
+
+func (x *IpvlanLink) GetMode() IpvlanLink_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return IpvlanLink_L2
+}
+
+func (x *IpvlanLink) GetFlag() IpvlanLink_Flag {
+	if x != nil {
+		return x.Flag
+	}
+	return IpvlanLink_BRIDGE
+}
+
+type VxlanLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VXLAN network identifier (mandatory for VXLAN).
+	Vni uint32 `protobuf:"varint,1,opt,name=vni,proto3" json:"vni,omitempty"`
+	// Source IP address of the tunnel (optional).
+	SrcAddress string `protobuf:"bytes,2,opt,name=src_address,json=srcAddress,proto3" json:"src_address,omitempty"`
+	// IP address of the remote VTEP or multicast group address (optional).
+	DstAddress string `protobuf:"bytes,3,opt,name=dst_address,json=dstAddress,proto3" json:"dst_address,omitempty"`
+	// Logical name of the parent (underlay) Linux interface (optional,
+	// mandatory for multicast destination). VXLAN interface is created
+	// in the namespace of the parent interface and then moved
+	// to the namespace of the VXLAN interface.
+	ParentIfName string `protobuf:"bytes,4,opt,name=parent_if_name,json=parentIfName,proto3" json:"parent_if_name,omitempty"`
+	// UDP destination port (4789 by default).
+	DstPort uint32 `protobuf:"varint,5,opt,name=dst_port,json=dstPort,proto3" json:"dst_port,omitempty"`
+	// TTL of the outer IP header (0 inherits TTL from the inner packet).
+	Ttl uint32 `protobuf:"varint,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Enable learning of remote MAC addresses.
+	Learning bool `protobuf:"varint,7,opt,name=learning,proto3" json:"learning,omitempty"`
+}
+
+func (x *VxlanLink) Reset() {
+	*x = VxlanLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VxlanLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VxlanLink) ProtoMessage() {}
+
+func (x *VxlanLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VxlanLink.ProtoReflect.Descriptor instead.
+func (*VxlanLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VxlanLink) GetVni() uint32 {
+	if x != nil {
+		return x.Vni
+	}
+	return 0
+}
+
+func (x *VxlanLink) GetSrcAddress() string {
+	if x != nil {
+		return x.SrcAddress
+	}
+	return ""
+}
+
+func (x *VxlanLink) GetDstAddress() string {
+	if x != nil {
+		return x.DstAddress
+	}
+	return ""
+}
+
+func (x *VxlanLink) GetParentIfName() string {
+	if x != nil {
+		return x.ParentIfName
+	}
+	return ""
+}
+
+func (x *VxlanLink) GetDstPort() uint32 {
+	if x != nil {
+		return x.DstPort
+	}
+	return 0
+}
+
+func (x *VxlanLink) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *VxlanLink) GetLearning() bool {
+	if x != nil {
+		return x.Learning
+	}
+	return false
+}
+
 var File_ligato_linux_interfaces_interface_proto protoreflect.FileDescriptor
 
 var file_ligato_linux_interfaces_interface_proto_rawDesc = []byte{
@@ -535,7 +1256,7 @@ var file_ligato_linux_interfaces_interface_proto_rawDesc = []byte{
 	0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
 	0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61,
 	0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x05, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x08, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
 	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
@@ -566,54 +1287,144 @@ var file_ligato_linux_interfaces_interface_proto_rawDesc = []byte{
 	0x72, 0x66, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c,
 	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65,
 	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x72, 0x66, 0x44, 0x65, 0x76, 0x4c, 0x69, 0x6e,
-	0x6b, 0x48, 0x00, 0x52, 0x06, 0x76, 0x72, 0x66, 0x44, 0x65, 0x76, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
-	0x69, 0x6e, 0x6b, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x6c, 0x69, 0x6e, 0x6b, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x72, 0x66, 0x5f,
-	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x72, 0x66, 0x4d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x66, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x45, 0x54, 0x48, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54,
-	0x41, 0x50, 0x5f, 0x54, 0x4f, 0x5f, 0x56, 0x50, 0x50, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4c,
-	0x4f, 0x4f, 0x50, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x49,
-	0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x52, 0x46, 0x5f, 0x44,
-	0x45, 0x56, 0x49, 0x43, 0x45, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x55, 0x4d, 0x4d, 0x59,
-	0x10, 0x06, 0x42, 0x06, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xec, 0x02, 0x0a, 0x08, 0x56,
-	0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f,
-	0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x65, 0x65, 0x72, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x6a, 0x0a, 0x16, 0x72, 0x78, 0x5f,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x14, 0x72, 0x78, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x6a, 0x0a, 0x16, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c,
+	0x6b, 0x48, 0x00, 0x52, 0x06, 0x76, 0x72, 0x66, 0x44, 0x65, 0x76, 0x12, 0x3d, 0x0a, 0x06, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x76, 0x6c,
+	0x61, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x76,
+	0x6c, 0x61, 0x6e, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x76, 0x6c, 0x61, 0x6e, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d,
+	0x61, 0x63, 0x76, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61,
+	0x63, 0x76, 0x6c, 0x61, 0x6e, 0x12, 0x3d, 0x0a, 0x06, 0x69, 0x70, 0x76, 0x6c, 0x61, 0x6e, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c,
 	0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e,
-	0x56, 0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
-	0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x74, 0x78, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e,
-	0x67, 0x22, 0x66, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66,
-	0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x4b, 0x53, 0x4d,
-	0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
-	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x4b, 0x53, 0x4d, 0x5f, 0x4f, 0x46, 0x46, 0x4c,
-	0x4f, 0x41, 0x44, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a,
-	0x16, 0x43, 0x48, 0x4b, 0x53, 0x4d, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x44,
-	0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x30, 0x0a, 0x07, 0x54, 0x61, 0x70,
-	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x25, 0x0a, 0x0f, 0x76, 0x70, 0x70, 0x5f, 0x74, 0x61, 0x70, 0x5f,
-	0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76,
-	0x70, 0x70, 0x54, 0x61, 0x70, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x0a, 0x56,
+	0x49, 0x70, 0x76, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x69, 0x70,
+	0x76, 0x6c, 0x61, 0x6e, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x78, 0x6c, 0x61, 0x6e, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e,
+	0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78,
+	0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x76, 0x78, 0x6c, 0x61, 0x6e,
+	0x12, 0x41, 0x0a, 0x08, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x63, 0x70, 0x18, 0x1c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x6e,
+	0x75, 0x78, 0x43, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x43, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x30, 0x0a, 0x14, 0x76, 0x72, 0x66, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x76, 0x72, 0x66, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55,
+	0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x45,
+	0x54, 0x48, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x50, 0x5f, 0x54, 0x4f, 0x5f, 0x56,
+	0x50, 0x50, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x41, 0x43, 0x4b,
+	0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04,
+	0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x52, 0x46, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x10, 0x05,
+	0x12, 0x09, 0x0a, 0x05, 0x44, 0x55, 0x4d, 0x4d, 0x59, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x42,
+	0x52, 0x49, 0x44, 0x47, 0x45, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x4c, 0x41, 0x4e, 0x10,
+	0x08, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x10, 0x09, 0x12, 0x0a,
+	0x0a, 0x06, 0x49, 0x50, 0x56, 0x4c, 0x41, 0x4e, 0x10, 0x0a, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x58,
+	0x4c, 0x41, 0x4e, 0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x49, 0x4e, 0x55, 0x58, 0x5f, 0x43,
+	0x50, 0x10, 0x0c, 0x42, 0x06, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xec, 0x02, 0x0a, 0x08,
+	0x56, 0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x65, 0x65, 0x72, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x6a, 0x0a, 0x16, 0x72, 0x78,
+	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x14, 0x72, 0x78, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c,
+	0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x6a, 0x0a, 0x16, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x2e, 0x56, 0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x74, 0x78,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0x66, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66,
+	0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x4b, 0x53,
+	0x4d, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x4b, 0x53, 0x4d, 0x5f, 0x4f, 0x46, 0x46,
+	0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a,
+	0x0a, 0x16, 0x43, 0x48, 0x4b, 0x53, 0x4d, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x4f, 0x41, 0x44, 0x5f,
+	0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x30, 0x0a, 0x07, 0x54, 0x61,
+	0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x25, 0x0a, 0x0f, 0x76, 0x70, 0x70, 0x5f, 0x74, 0x61, 0x70,
+	0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x76, 0x70, 0x70, 0x54, 0x61, 0x70, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x0b,
+	0x4c, 0x69, 0x6e, 0x75, 0x78, 0x43, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x0b, 0x76,
+	0x70, 0x70, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x70, 0x70, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x0a, 0x56,
 	0x72, 0x66, 0x44, 0x65, 0x76, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75,
 	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x4a,
-	0x5a, 0x48, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
-	0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x3b, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x49,
+	0x0a, 0x0a, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x76, 0x6c, 0x61, 0x6e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x22, 0xc0, 0x01, 0x0a, 0x08, 0x56, 0x6c,
+	0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x07,
+	0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a, 0x82,
+	0x7d, 0x07, 0x12, 0x05, 0x08, 0x01, 0x10, 0xfe, 0x1f, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x46, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e,
+	0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x6c,
+	0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x21, 0x0a, 0x08, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x4f, 0x54, 0x31, 0x51, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x44, 0x4f, 0x54, 0x31, 0x41, 0x44, 0x10, 0x01, 0x22, 0xab, 0x01, 0x0a,
+	0x0b, 0x4d, 0x61, 0x63, 0x76, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x24, 0x0a, 0x0e,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x66, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x29, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x76, 0x6c,
+	0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x22, 0x37, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x49,
+	0x44, 0x47, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x45, 0x50, 0x41, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
+	0x50, 0x41, 0x53, 0x53, 0x54, 0x48, 0x52, 0x55, 0x10, 0x03, 0x22, 0xfa, 0x01, 0x0a, 0x0a, 0x49,
+	0x70, 0x76, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x3c, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x70, 0x76, 0x6c, 0x61, 0x6e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a,
+	0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x70, 0x76, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x2e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x22, 0x1f, 0x0a, 0x04, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c,
+	0x33, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x33, 0x53, 0x10, 0x02, 0x22, 0x29, 0x0a, 0x04,
+	0x46, 0x6c, 0x61, 0x67, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x56, 0x45, 0x50, 0x41, 0x10, 0x02, 0x22, 0xff, 0x01, 0x0a, 0x09, 0x56, 0x78, 0x6c, 0x61,
+	0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0c, 0x82, 0x7d, 0x09, 0x12, 0x07, 0x08, 0x01, 0x10, 0xff, 0xff, 0xff, 0x07,
+	0x52, 0x03, 0x76, 0x6e, 0x69, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08,
+	0x01, 0x52, 0x0a, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a,
+	0x0b, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x08, 0x64,
+	0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82,
+	0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x1a, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08,
+	0x82, 0x7d, 0x05, 0x12, 0x03, 0x10, 0xff, 0x01, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x6f, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x3b, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -628,30 +1439,50 @@ func file_ligato_linux_interfaces_interface_proto_rawDescGZIP() []byte {
 	return file_ligato_linux_interfaces_interface_proto_rawDescData
 }
 
-var file_ligato_linux_interfaces_interface_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_ligato_linux_interfaces_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ligato_linux_interfaces_interface_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_ligato_linux_interfaces_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_ligato_linux_interfaces_interface_proto_goTypes = []interface{}{
 	(Interface_Type)(0),              // 0: ligato.linux.interfaces.Interface.Type
 	(VethLink_ChecksumOffloading)(0), // 1: ligato.linux.interfaces.VethLink.ChecksumOffloading
-	(*Interface)(nil),                // 2: ligato.linux.interfaces.Interface
-	(*VethLink)(nil),                 // 3: ligato.linux.interfaces.VethLink
-	(*TapLink)(nil),                  // 4: ligato.linux.interfaces.TapLink
-	(*VrfDevLink)(nil),               // 5: ligato.linux.interfaces.VrfDevLink
-	(*namespace.NetNamespace)(nil),   // 6: ligato.linux.namespace.NetNamespace
+	(VlanLink_Protocol)(0),           // 2: ligato.linux.interfaces.VlanLink.Protocol
+	(MacvlanLink_Mode)(0),            // 3: ligato.linux.interfaces.MacvlanLink.Mode
+	(IpvlanLink_Mode)(0),             // 4: ligato.linux.interfaces.IpvlanLink.Mode
+	(IpvlanLink_Flag)(0),             // 5: ligato.linux.interfaces.IpvlanLink.Flag
+	(*Interface)(nil),                // 6: ligato.linux.interfaces.Interface
+	(*VethLink)(nil),                 // 7: ligato.linux.interfaces.VethLink
+	(*TapLink)(nil),                  // 8: ligato.linux.interfaces.TapLink
+	(*LinuxCpLink)(nil),              // 9: ligato.linux.interfaces.LinuxCpLink
+	(*VrfDevLink)(nil),               // 10: ligato.linux.interfaces.VrfDevLink
+	(*BridgeLink)(nil),               // 11: ligato.linux.interfaces.BridgeLink
+	(*VlanLink)(nil),                 // 12: ligato.linux.interfaces.VlanLink
+	(*MacvlanLink)(nil),              // 13: ligato.linux.interfaces.MacvlanLink
+	(*IpvlanLink)(nil),               // 14: ligato.linux.interfaces.IpvlanLink
+	(*VxlanLink)(nil),                // 15: ligato.linux.interfaces.VxlanLink
+	(*namespace.NetNamespace)(nil),   // 16: ligato.linux.namespace.NetNamespace
 }
 var file_ligato_linux_interfaces_interface_proto_depIdxs = []int32{
-	0, // 0: ligato.linux.interfaces.Interface.type:type_name -> ligato.linux.interfaces.Interface.Type
-	6, // 1: ligato.linux.interfaces.Interface.namespace:type_name -> ligato.linux.namespace.NetNamespace
-	3, // 2: ligato.linux.interfaces.Interface.veth:type_name -> ligato.linux.interfaces.VethLink
-	4, // 3: ligato.linux.interfaces.Interface.tap:type_name -> ligato.linux.interfaces.TapLink
-	5, // 4: ligato.linux.interfaces.Interface.vrf_dev:type_name -> ligato.linux.interfaces.VrfDevLink
-	1, // 5: ligato.linux.interfaces.VethLink.rx_checksum_offloading:type_name -> ligato.linux.interfaces.VethLink.ChecksumOffloading
-	1, // 6: ligato.linux.interfaces.VethLink.tx_checksum_offloading:type_name -> ligato.linux.interfaces.VethLink.ChecksumOffloading
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	0,  // 0: ligato.linux.interfaces.Interface.type:type_name -> ligato.linux.interfaces.Interface.Type
+	16, // 1: ligato.linux.interfaces.Interface.namespace:type_name -> ligato.linux.namespace.NetNamespace
+	7,  // 2: ligato.linux.interfaces.Interface.veth:type_name -> ligato.linux.interfaces.VethLink
+	8,  // 3: ligato.linux.interfaces.Interface.tap:type_name -> ligato.linux.interfaces.TapLink
+	10, // 4: ligato.linux.interfaces.Interface.vrf_dev:type_name -> ligato.linux.interfaces.VrfDevLink
+	11, // 5: ligato.linux.interfaces.Interface.bridge:type_name -> ligato.linux.interfaces.BridgeLink
+	12, // 6: ligato.linux.interfaces.Interface.vlan:type_name -> ligato.linux.interfaces.VlanLink
+	13, // 7: ligato.linux.interfaces.Interface.macvlan:type_name -> ligato.linux.interfaces.MacvlanLink
+	14, // 8: ligato.linux.interfaces.Interface.ipvlan:type_name -> ligato.linux.interfaces.IpvlanLink
+	15, // 9: ligato.linux.interfaces.Interface.vxlan:type_name -> ligato.linux.interfaces.VxlanLink
+	9,  // 10: ligato.linux.interfaces.Interface.linux_cp:type_name -> ligato.linux.interfaces.LinuxCpLink
+	1,  // 11: ligato.linux.interfaces.VethLink.rx_checksum_offloading:type_name -> ligato.linux.interfaces.VethLink.ChecksumOffloading
+	1,  // 12: ligato.linux.interfaces.VethLink.tx_checksum_offloading:type_name -> ligato.linux.interfaces.VethLink.ChecksumOffloading
+	2,  // 13: ligato.linux.interfaces.VlanLink.protocol:type_name -> ligato.linux.interfaces.VlanLink.Protocol
+	3,  // 14: ligato.linux.interfaces.MacvlanLink.mode:type_name -> ligato.linux.interfaces.MacvlanLink.Mode
+	4,  // 15: ligato.linux.interfaces.IpvlanLink.mode:type_name -> ligato.linux.interfaces.IpvlanLink.Mode
+	5,  // 16: ligato.linux.interfaces.IpvlanLink.flag:type_name -> ligato.linux.interfaces.IpvlanLink.Flag
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_ligato_linux_interfaces_interface_proto_init() }
@@ -697,6 +1528,18 @@ func file_ligato_linux_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_linux_interfaces_interface_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinuxCpLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VrfDevLink); i {
 			case 0:
 				return &v.state
@@ -708,19 +1551,85 @@ func file_ligato_linux_interfaces_interface_proto_init() {
 				return nil
 			}
 		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacvlanLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IpvlanLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VxlanLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_ligato_linux_interfaces_interface_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Interface_Veth)(nil),
 		(*Interface_Tap)(nil),
 		(*Interface_VrfDev)(nil),
+		(*Interface_Bridge)(nil),
+		(*Interface_Vlan)(nil),
+		(*Interface_Macvlan)(nil),
+		(*Interface_Ipvlan)(nil),
+		(*Interface_Vxlan)(nil),
+		(*Interface_LinuxCp)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_linux_interfaces_interface_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   4,
+			NumEnums:      6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -78,6 +78,12 @@ const (
 	// interfaceVrfKeyTmpl is a template for (derived) key representing assignment
 	// of a Linux interface into a VRF.
 	interfaceVrfKeyTmpl = "linux/interface/{iface}/vrf/{vrf}"
+
+	/* Interface Bridge Port (derived) */
+
+	// interfaceBridgePortKeyTmpl is a template for (derived) key representing
+	// a Linux interface enslaved to a bridge as a port.
+	interfaceBridgePortKeyTmpl = "linux/interface/{iface}/bridge/{bridge}"
 )
 
 const (
@@ -201,8 +207,8 @@ func ParseInterfaceAddressKey(key string) (iface, address string, source netallo
 	addrIdx := -1
 	for idx, part := range parts {
 		switch part {
-		case "vrf":
-			// avoid collision with InterfaceVrfKey
+		case "vrf", "bridge":
+			// avoid collision with InterfaceVrfKey and InterfaceBridgePortKey
 			return
 		case "address":
 			addrIdx = idx
@@ -270,8 +276,8 @@ func ParseInterfaceVrfKey(key string) (iface string, vrf string, invalidKey, isV
 	vrfIdx := -1
 	for idx, part := range parts {
 		switch part {
-		case "address":
-			// avoid collision with InterfaceAddressKey
+		case "address", "bridge":
+			// avoid collision with InterfaceAddressKey and InterfaceBridgePortKey
 			return
 		case "vrf":
 			vrfIdx = idx
@@ -298,3 +304,61 @@ func ParseInterfaceVrfKey(key string) (iface string, vrf string, invalidKey, isV
 	vrf = parts[vrfIdx+1]
 	return
 }
+
+// InterfaceBridgePortKey returns key representing Linux interface enslaved to a bridge.
+func InterfaceBridgePortKey(iface string, bridge string) string {
+	if iface == "" {
+		iface = InvalidKeyPart
+	}
+	if bridge == "" {
+		bridge = InvalidKeyPart
+	}
+
+	tmpl := interfaceBridgePortKeyTmpl
+	key := strings.Replace(tmpl, "{iface}", iface, 1)
+	key = strings.Replace(key, "{bridge}", bridge, 1)
+	return key
+}
+
+// ParseInterfaceBridgePortKey parses interface and bridge from key derived
+// from bridge by InterfaceBridgePortKey().
+func ParseInterfaceBridgePortKey(key string) (iface string, bridge string, invalidKey, isBridgePortKey bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) < 4 || parts[0] != "linux" || parts[1] != "interface" {
+		return
+	}
+	if parts[2] == "state" || parts[2] == "host-name" {
+		return
+	}
+
+	bridgeIdx := -1
+	for idx, part := range parts {
+		switch part {
+		case "address", "vrf":
+			// avoid collision with InterfaceAddressKey and InterfaceVrfKey
+			return
+		case "bridge":
+			bridgeIdx = idx
+		}
+	}
+	if bridgeIdx == -1 {
+		return
+	}
+	isBridgePortKey = true
+
+	// parse interface name
+	iface = strings.Join(parts[2:bridgeIdx], "/")
+	if iface == "" {
+		iface = InvalidKeyPart
+		invalidKey = true
+	}
+
+	// parse bridge
+	if bridgeIdx == len(parts)-1 {
+		invalidKey = true
+		bridge = InvalidKeyPart
+		return
+	}
+	bridge = parts[bridgeIdx+1]
+	return
+}
@@ -406,3 +406,103 @@ func TestParseInterfaceVrfKey(t *testing.T) {
 		})
 	}
 }
+
+func TestInterfaceBridgePortKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       string
+		bridge      string
+		expectedKey string
+	}{
+		{
+			name:        "valid bridge port",
+			iface:       "veth0",
+			bridge:      "br0",
+			expectedKey: "linux/interface/veth0/bridge/br0",
+		},
+		{
+			name:        "invalid interface",
+			iface:       "",
+			bridge:      "br0",
+			expectedKey: "linux/interface/<invalid>/bridge/br0",
+		},
+		{
+			name:        "invalid bridge",
+			iface:       "veth0",
+			bridge:      "",
+			expectedKey: "linux/interface/veth0/bridge/<invalid>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := InterfaceBridgePortKey(test.iface, test.bridge)
+			if key != test.expectedKey {
+				t.Errorf("failed for: iface=%s bridge=%s\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.iface, test.bridge, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestParseInterfaceBridgePortKey(t *testing.T) {
+	tests := []struct {
+		name                    string
+		key                     string
+		expectedIface           string
+		expectedBridge          string
+		expectedInvalidKey      bool
+		expectedIsBridgePortKey bool
+	}{
+		{
+			name:                    "valid bridge port",
+			key:                     "linux/interface/veth0/bridge/br0",
+			expectedIface:           "veth0",
+			expectedBridge:          "br0",
+			expectedIsBridgePortKey: true,
+		},
+		{
+			name:                    "missing interface",
+			key:                     "linux/interface//bridge/br0",
+			expectedIface:           "<invalid>",
+			expectedBridge:          "br0",
+			expectedInvalidKey:      true,
+			expectedIsBridgePortKey: true,
+		},
+		{
+			name:                    "missing bridge",
+			key:                     "linux/interface/veth0/bridge",
+			expectedIface:           "veth0",
+			expectedBridge:          "<invalid>",
+			expectedInvalidKey:      true,
+			expectedIsBridgePortKey: true,
+		},
+		{
+			name:                    "not bridge port key",
+			key:                     "linux/interface/veth0/vrf/blue",
+			expectedIsBridgePortKey: false,
+		},
+		{
+			name:                    "not bridge port key #2",
+			key:                     "linux/interface/tap1/address/static/192.168.1.1/32",
+			expectedIsBridgePortKey: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			iface, bridge, invalidKey, isBridgePortKey := ParseInterfaceBridgePortKey(test.key)
+			if isBridgePortKey != test.expectedIsBridgePortKey {
+				t.Errorf("expected isBridgePortKey: %v\tgot: %v", test.expectedIsBridgePortKey, isBridgePortKey)
+			}
+			if invalidKey != test.expectedInvalidKey {
+				t.Errorf("expected invalidKey: %v\tgot: %v", test.expectedInvalidKey, invalidKey)
+			}
+			if iface != test.expectedIface {
+				t.Errorf("expected iface: %s\tgot: %s", test.expectedIface, iface)
+			}
+			if bridge != test.expectedBridge {
+				t.Errorf("expected bridge: %s\tgot: %s", test.expectedBridge, bridge)
+			}
+		})
+	}
+}
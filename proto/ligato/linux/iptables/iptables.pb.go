@@ -235,20 +235,204 @@ func (RuleChain_Policy) EnumDescriptor() ([]byte, []int) {
 	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{0, 3}
 }
 
+type Rule_Protocol int32
+
+const (
+	Rule_ANY    Rule_Protocol = 0
+	Rule_TCP    Rule_Protocol = 1
+	Rule_UDP    Rule_Protocol = 2
+	Rule_SCTP   Rule_Protocol = 3
+	Rule_ICMP   Rule_Protocol = 4
+	Rule_ICMPV6 Rule_Protocol = 5
+)
+
+// Enum value maps for Rule_Protocol.
+var (
+	Rule_Protocol_name = map[int32]string{
+		0: "ANY",
+		1: "TCP",
+		2: "UDP",
+		3: "SCTP",
+		4: "ICMP",
+		5: "ICMPV6",
+	}
+	Rule_Protocol_value = map[string]int32{
+		"ANY":    0,
+		"TCP":    1,
+		"UDP":    2,
+		"SCTP":   3,
+		"ICMP":   4,
+		"ICMPV6": 5,
+	}
+)
+
+func (x Rule_Protocol) Enum() *Rule_Protocol {
+	p := new(Rule_Protocol)
+	*p = x
+	return p
+}
+
+func (x Rule_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rule_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_iptables_iptables_proto_enumTypes[4].Descriptor()
+}
+
+func (Rule_Protocol) Type() protoreflect.EnumType {
+	return &file_ligato_linux_iptables_iptables_proto_enumTypes[4]
+}
+
+func (x Rule_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rule_Protocol.Descriptor instead.
+func (Rule_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type Rule_ConnState int32
+
+const (
+	Rule_NEW         Rule_ConnState = 0
+	Rule_ESTABLISHED Rule_ConnState = 1
+	Rule_RELATED     Rule_ConnState = 2
+	Rule_INVALID     Rule_ConnState = 3
+	Rule_UNTRACKED   Rule_ConnState = 4
+)
+
+// Enum value maps for Rule_ConnState.
+var (
+	Rule_ConnState_name = map[int32]string{
+		0: "NEW",
+		1: "ESTABLISHED",
+		2: "RELATED",
+		3: "INVALID",
+		4: "UNTRACKED",
+	}
+	Rule_ConnState_value = map[string]int32{
+		"NEW":         0,
+		"ESTABLISHED": 1,
+		"RELATED":     2,
+		"INVALID":     3,
+		"UNTRACKED":   4,
+	}
+)
+
+func (x Rule_ConnState) Enum() *Rule_ConnState {
+	p := new(Rule_ConnState)
+	*p = x
+	return p
+}
+
+func (x Rule_ConnState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rule_ConnState) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_iptables_iptables_proto_enumTypes[5].Descriptor()
+}
+
+func (Rule_ConnState) Type() protoreflect.EnumType {
+	return &file_ligato_linux_iptables_iptables_proto_enumTypes[5]
+}
+
+func (x Rule_ConnState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rule_ConnState.Descriptor instead.
+func (Rule_ConnState) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{1, 1}
+}
+
+type Rule_Target_Action int32
+
+const (
+	Rule_Target_ACCEPT     Rule_Target_Action = 0
+	Rule_Target_DROP       Rule_Target_Action = 1
+	Rule_Target_RETURN     Rule_Target_Action = 2
+	Rule_Target_REJECT     Rule_Target_Action = 3
+	Rule_Target_LOG        Rule_Target_Action = 4
+	Rule_Target_JUMP       Rule_Target_Action = 5 // jump to the custom chain
+	Rule_Target_MASQUERADE Rule_Target_Action = 6 // NAT table only
+	Rule_Target_SNAT       Rule_Target_Action = 7 // NAT table only
+	Rule_Target_DNAT       Rule_Target_Action = 8 // NAT table only
+	Rule_Target_REDIRECT   Rule_Target_Action = 9 // NAT table only
+)
+
+// Enum value maps for Rule_Target_Action.
+var (
+	Rule_Target_Action_name = map[int32]string{
+		0: "ACCEPT",
+		1: "DROP",
+		2: "RETURN",
+		3: "REJECT",
+		4: "LOG",
+		5: "JUMP",
+		6: "MASQUERADE",
+		7: "SNAT",
+		8: "DNAT",
+		9: "REDIRECT",
+	}
+	Rule_Target_Action_value = map[string]int32{
+		"ACCEPT":     0,
+		"DROP":       1,
+		"RETURN":     2,
+		"REJECT":     3,
+		"LOG":        4,
+		"JUMP":       5,
+		"MASQUERADE": 6,
+		"SNAT":       7,
+		"DNAT":       8,
+		"REDIRECT":   9,
+	}
+)
+
+func (x Rule_Target_Action) Enum() *Rule_Target_Action {
+	p := new(Rule_Target_Action)
+	*p = x
+	return p
+}
+
+func (x Rule_Target_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rule_Target_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_iptables_iptables_proto_enumTypes[6].Descriptor()
+}
+
+func (Rule_Target_Action) Type() protoreflect.EnumType {
+	return &file_ligato_linux_iptables_iptables_proto_enumTypes[6]
+}
+
+func (x Rule_Target_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rule_Target_Action.Descriptor instead.
+func (Rule_Target_Action) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{1, 1, 0}
+}
+
 type RuleChain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace     *namespace.NetNamespace `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                           // network namespace in which this rule chain is applied
-	Interfaces    []string                `protobuf:"bytes,3,rep,name=interfaces,proto3" json:"interfaces,omitempty"`                                                                         // list of interfaces referred by the rules (optional)
-	Protocol      RuleChain_Protocol      `protobuf:"varint,4,opt,name=protocol,proto3,enum=ligato.linux.iptables.RuleChain_Protocol" json:"protocol,omitempty"`                              // protocol (address family) of the rule chain
-	Table         RuleChain_Table         `protobuf:"varint,5,opt,name=table,proto3,enum=ligato.linux.iptables.RuleChain_Table" json:"table,omitempty"`                                       // table the rule chain belongs to
-	ChainType     RuleChain_ChainType     `protobuf:"varint,6,opt,name=chain_type,json=chainType,proto3,enum=ligato.linux.iptables.RuleChain_ChainType" json:"chain_type,omitempty"`          // type of the chain
-	ChainName     string                  `protobuf:"bytes,7,opt,name=chain_name,json=chainName,proto3" json:"chain_name,omitempty"`                                                          // name of the chain, used only for chains with CUSTOM chain_type
-	DefaultPolicy RuleChain_Policy        `protobuf:"varint,8,opt,name=default_policy,json=defaultPolicy,proto3,enum=ligato.linux.iptables.RuleChain_Policy" json:"default_policy,omitempty"` // default policy of the chain. Used for FILTER tables only.
-	Rules         []string                `protobuf:"bytes,10,rep,name=rules,proto3" json:"rules,omitempty"`
+	Name            string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace       *namespace.NetNamespace `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                           // network namespace in which this rule chain is applied
+	Interfaces      []string                `protobuf:"bytes,3,rep,name=interfaces,proto3" json:"interfaces,omitempty"`                                                                         // list of interfaces referred by the rules (optional)
+	Protocol        RuleChain_Protocol      `protobuf:"varint,4,opt,name=protocol,proto3,enum=ligato.linux.iptables.RuleChain_Protocol" json:"protocol,omitempty"`                              // protocol (address family) of the rule chain
+	Table           RuleChain_Table         `protobuf:"varint,5,opt,name=table,proto3,enum=ligato.linux.iptables.RuleChain_Table" json:"table,omitempty"`                                       // table the rule chain belongs to
+	ChainType       RuleChain_ChainType     `protobuf:"varint,6,opt,name=chain_type,json=chainType,proto3,enum=ligato.linux.iptables.RuleChain_ChainType" json:"chain_type,omitempty"`          // type of the chain
+	ChainName       string                  `protobuf:"bytes,7,opt,name=chain_name,json=chainName,proto3" json:"chain_name,omitempty"`                                                          // name of the chain, used only for chains with CUSTOM chain_type
+	DefaultPolicy   RuleChain_Policy        `protobuf:"varint,8,opt,name=default_policy,json=defaultPolicy,proto3,enum=ligato.linux.iptables.RuleChain_Policy" json:"default_policy,omitempty"` // default policy of the chain. Used for FILTER tables only.
+	Rules           []string                `protobuf:"bytes,10,rep,name=rules,proto3" json:"rules,omitempty"`
+	StructuredRules []*Rule                 `protobuf:"bytes,11,rep,name=structured_rules,json=structuredRules,proto3" json:"structured_rules,omitempty"`
 }
 
 func (x *RuleChain) Reset() {
@@ -346,6 +530,274 @@ func (x *RuleChain) GetRules() []string {
 	return nil
 }
 
+func (x *RuleChain) GetStructuredRules() []*Rule {
+	if x != nil {
+		return x.StructuredRules
+	}
+	return nil
+}
+
+type Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InInterface      string           `protobuf:"bytes,1,opt,name=in_interface,json=inInterface,proto3" json:"in_interface,omitempty"`                                                // logical name of the linux interface packets are received on
+	OutInterface     string           `protobuf:"bytes,2,opt,name=out_interface,json=outInterface,proto3" json:"out_interface,omitempty"`                                             // logical name of the linux interface packets are sent out from
+	Source           string           `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                                                                             // source IP address or network (CIDR notation)
+	Destination      string           `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`                                                                   // destination IP address or network (CIDR notation)
+	Protocol         Rule_Protocol    `protobuf:"varint,5,opt,name=protocol,proto3,enum=ligato.linux.iptables.Rule_Protocol" json:"protocol,omitempty"`                               // L4 protocol
+	SourcePorts      *Rule_PortRange  `protobuf:"bytes,6,opt,name=source_ports,json=sourcePorts,proto3" json:"source_ports,omitempty"`                                                // source ports, requires TCP, UDP or SCTP protocol
+	DestinationPorts *Rule_PortRange  `protobuf:"bytes,7,opt,name=destination_ports,json=destinationPorts,proto3" json:"destination_ports,omitempty"`                                 // destination ports, requires TCP, UDP or SCTP protocol
+	ConnStates       []Rule_ConnState `protobuf:"varint,8,rep,packed,name=conn_states,json=connStates,proto3,enum=ligato.linux.iptables.Rule_ConnState" json:"conn_states,omitempty"` // match connections in any of the given conntrack states
+	Comment          string           `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`                                                                           // comment attached to the rule
+	Target           *Rule_Target     `protobuf:"bytes,10,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_iptables_iptables_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_iptables_iptables_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Rule) GetInInterface() string {
+	if x != nil {
+		return x.InInterface
+	}
+	return ""
+}
+
+func (x *Rule) GetOutInterface() string {
+	if x != nil {
+		return x.OutInterface
+	}
+	return ""
+}
+
+func (x *Rule) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Rule) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Rule) GetProtocol() Rule_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Rule_ANY
+}
+
+func (x *Rule) GetSourcePorts() *Rule_PortRange {
+	if x != nil {
+		return x.SourcePorts
+	}
+	return nil
+}
+
+func (x *Rule) GetDestinationPorts() *Rule_PortRange {
+	if x != nil {
+		return x.DestinationPorts
+	}
+	return nil
+}
+
+func (x *Rule) GetConnStates() []Rule_ConnState {
+	if x != nil {
+		return x.ConnStates
+	}
+	return nil
+}
+
+func (x *Rule) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Rule) GetTarget() *Rule_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type Rule_PortRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LowerPort uint32 `protobuf:"varint,1,opt,name=lower_port,json=lowerPort,proto3" json:"lower_port,omitempty"`
+	UpperPort uint32 `protobuf:"varint,2,opt,name=upper_port,json=upperPort,proto3" json:"upper_port,omitempty"` // single port is matched if not set
+}
+
+func (x *Rule_PortRange) Reset() {
+	*x = Rule_PortRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_iptables_iptables_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_PortRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_PortRange) ProtoMessage() {}
+
+func (x *Rule_PortRange) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_iptables_iptables_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_PortRange.ProtoReflect.Descriptor instead.
+func (*Rule_PortRange) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Rule_PortRange) GetLowerPort() uint32 {
+	if x != nil {
+		return x.LowerPort
+	}
+	return 0
+}
+
+func (x *Rule_PortRange) GetUpperPort() uint32 {
+	if x != nil {
+		return x.UpperPort
+	}
+	return 0
+}
+
+type Rule_Target struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action     Rule_Target_Action `protobuf:"varint,1,opt,name=action,proto3,enum=ligato.linux.iptables.Rule_Target_Action" json:"action,omitempty"`
+	Chain      string             `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`                             // name of the custom chain for JUMP action
+	ToAddress  string             `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`    // translated address for SNAT and DNAT actions
+	ToPorts    *Rule_PortRange    `protobuf:"bytes,4,opt,name=to_ports,json=toPorts,proto3" json:"to_ports,omitempty"`          // translated ports for MASQUERADE, SNAT, DNAT and REDIRECT actions
+	RejectWith string             `protobuf:"bytes,5,opt,name=reject_with,json=rejectWith,proto3" json:"reject_with,omitempty"` // ICMP error type for REJECT action, e.g. "icmp-port-unreachable"
+	LogPrefix  string             `protobuf:"bytes,6,opt,name=log_prefix,json=logPrefix,proto3" json:"log_prefix,omitempty"`    // prefix of log messages for LOG action
+}
+
+func (x *Rule_Target) Reset() {
+	*x = Rule_Target{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_iptables_iptables_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_Target) ProtoMessage() {}
+
+func (x *Rule_Target) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_iptables_iptables_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_Target.ProtoReflect.Descriptor instead.
+func (*Rule_Target) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Rule_Target) GetAction() Rule_Target_Action {
+	if x != nil {
+		return x.Action
+	}
+	return Rule_Target_ACCEPT
+}
+
+func (x *Rule_Target) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *Rule_Target) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *Rule_Target) GetToPorts() *Rule_PortRange {
+	if x != nil {
+		return x.ToPorts
+	}
+	return nil
+}
+
+func (x *Rule_Target) GetRejectWith() string {
+	if x != nil {
+		return x.RejectWith
+	}
+	return ""
+}
+
+func (x *Rule_Target) GetLogPrefix() string {
+	if x != nil {
+		return x.LogPrefix
+	}
+	return ""
+}
+
 var File_ligato_linux_iptables_iptables_proto protoreflect.FileDescriptor
 
 var file_ligato_linux_iptables_iptables_proto_rawDesc = []byte{
@@ -355,7 +807,7 @@ var file_ligato_linux_iptables_iptables_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x26, 0x6c,
 	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6e, 0x61, 0x6d, 0x65,
 	0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x05, 0x0a, 0x09, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x06, 0x0a, 0x09, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68,
 	0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
 	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67,
@@ -385,27 +837,103 @@ var file_ligato_linux_iptables_iptables_proto_rawDesc = []byte{
 	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x64, 0x65, 0x66,
 	0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75,
 	0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x22, 0x1e, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x08, 0x0a, 0x04,
-	0x49, 0x50, 0x56, 0x34, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36, 0x10, 0x01,
-	0x22, 0x3f, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x49, 0x4c,
-	0x54, 0x45, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x41, 0x54, 0x10, 0x01, 0x12, 0x0a,
-	0x0a, 0x06, 0x4d, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x41,
-	0x57, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x10,
-	0x04, 0x22, 0x5c, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a,
-	0x0a, 0x06, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e,
-	0x50, 0x55, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10,
-	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x03, 0x12, 0x0e,
-	0x0a, 0x0a, 0x50, 0x52, 0x45, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0f,
-	0x0a, 0x0b, 0x50, 0x4f, 0x53, 0x54, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x22,
-	0x3f, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
-	0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x01, 0x12,
-	0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45,
-	0x55, 0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x04,
-	0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f,
-	0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78,
-	0x2f, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x3b, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f,
-	0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x46, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x1e, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x34, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x49, 0x50, 0x56, 0x36, 0x10, 0x01, 0x22, 0x3f, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x4e, 0x41, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x4e, 0x47, 0x4c, 0x45,
+	0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x41, 0x57, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x53,
+	0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x10, 0x04, 0x22, 0x5c, 0x0a, 0x09, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4f, 0x52,
+	0x57, 0x41, 0x52, 0x44, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x45, 0x52, 0x4f, 0x55,
+	0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4f, 0x53, 0x54, 0x52, 0x4f,
+	0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x22, 0x3f, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41,
+	0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10,
+	0x02, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
+	0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x04, 0x22, 0xea, 0x08, 0x0a, 0x04, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x48, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x52, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x1a, 0x49, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x75, 0x70, 0x70, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x1a, 0xff, 0x02, 0x0a,
+	0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x40, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78,
+	0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x74, 0x6f, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x69,
+	0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x22, 0x7b, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x41,
+	0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x47,
+	0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x55, 0x4d, 0x50, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4d, 0x41, 0x53, 0x51, 0x55, 0x45, 0x52, 0x41, 0x44, 0x45, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04,
+	0x53, 0x4e, 0x41, 0x54, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4e, 0x41, 0x54, 0x10, 0x08,
+	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x09, 0x22, 0x45,
+	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e,
+	0x59, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03,
+	0x55, 0x44, 0x50, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x43, 0x54, 0x50, 0x10, 0x03, 0x12,
+	0x08, 0x0a, 0x04, 0x49, 0x43, 0x4d, 0x50, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x43, 0x4d,
+	0x50, 0x56, 0x36, 0x10, 0x05, 0x22, 0x4e, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x57, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x45,
+	0x53, 0x54, 0x41, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x45, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x54, 0x52, 0x41, 0x43,
+	0x4b, 0x45, 0x44, 0x10, 0x04, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f,
+	0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x3b, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x5f, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -420,27 +948,41 @@ func file_ligato_linux_iptables_iptables_proto_rawDescGZIP() []byte {
 	return file_ligato_linux_iptables_iptables_proto_rawDescData
 }
 
-var file_ligato_linux_iptables_iptables_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_ligato_linux_iptables_iptables_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ligato_linux_iptables_iptables_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_ligato_linux_iptables_iptables_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_ligato_linux_iptables_iptables_proto_goTypes = []interface{}{
 	(RuleChain_Protocol)(0),        // 0: ligato.linux.iptables.RuleChain.Protocol
 	(RuleChain_Table)(0),           // 1: ligato.linux.iptables.RuleChain.Table
 	(RuleChain_ChainType)(0),       // 2: ligato.linux.iptables.RuleChain.ChainType
 	(RuleChain_Policy)(0),          // 3: ligato.linux.iptables.RuleChain.Policy
-	(*RuleChain)(nil),              // 4: ligato.linux.iptables.RuleChain
-	(*namespace.NetNamespace)(nil), // 5: ligato.linux.namespace.NetNamespace
+	(Rule_Protocol)(0),             // 4: ligato.linux.iptables.Rule.Protocol
+	(Rule_ConnState)(0),            // 5: ligato.linux.iptables.Rule.ConnState
+	(Rule_Target_Action)(0),        // 6: ligato.linux.iptables.Rule.Target.Action
+	(*RuleChain)(nil),              // 7: ligato.linux.iptables.RuleChain
+	(*Rule)(nil),                   // 8: ligato.linux.iptables.Rule
+	(*Rule_PortRange)(nil),         // 9: ligato.linux.iptables.Rule.PortRange
+	(*Rule_Target)(nil),            // 10: ligato.linux.iptables.Rule.Target
+	(*namespace.NetNamespace)(nil), // 11: ligato.linux.namespace.NetNamespace
 }
 var file_ligato_linux_iptables_iptables_proto_depIdxs = []int32{
-	5, // 0: ligato.linux.iptables.RuleChain.namespace:type_name -> ligato.linux.namespace.NetNamespace
-	0, // 1: ligato.linux.iptables.RuleChain.protocol:type_name -> ligato.linux.iptables.RuleChain.Protocol
-	1, // 2: ligato.linux.iptables.RuleChain.table:type_name -> ligato.linux.iptables.RuleChain.Table
-	2, // 3: ligato.linux.iptables.RuleChain.chain_type:type_name -> ligato.linux.iptables.RuleChain.ChainType
-	3, // 4: ligato.linux.iptables.RuleChain.default_policy:type_name -> ligato.linux.iptables.RuleChain.Policy
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	11, // 0: ligato.linux.iptables.RuleChain.namespace:type_name -> ligato.linux.namespace.NetNamespace
+	0,  // 1: ligato.linux.iptables.RuleChain.protocol:type_name -> ligato.linux.iptables.RuleChain.Protocol
+	1,  // 2: ligato.linux.iptables.RuleChain.table:type_name -> ligato.linux.iptables.RuleChain.Table
+	2,  // 3: ligato.linux.iptables.RuleChain.chain_type:type_name -> ligato.linux.iptables.RuleChain.ChainType
+	3,  // 4: ligato.linux.iptables.RuleChain.default_policy:type_name -> ligato.linux.iptables.RuleChain.Policy
+	8,  // 5: ligato.linux.iptables.RuleChain.structured_rules:type_name -> ligato.linux.iptables.Rule
+	4,  // 6: ligato.linux.iptables.Rule.protocol:type_name -> ligato.linux.iptables.Rule.Protocol
+	9,  // 7: ligato.linux.iptables.Rule.source_ports:type_name -> ligato.linux.iptables.Rule.PortRange
+	9,  // 8: ligato.linux.iptables.Rule.destination_ports:type_name -> ligato.linux.iptables.Rule.PortRange
+	5,  // 9: ligato.linux.iptables.Rule.conn_states:type_name -> ligato.linux.iptables.Rule.ConnState
+	10, // 10: ligato.linux.iptables.Rule.target:type_name -> ligato.linux.iptables.Rule.Target
+	6,  // 11: ligato.linux.iptables.Rule.Target.action:type_name -> ligato.linux.iptables.Rule.Target.Action
+	9,  // 12: ligato.linux.iptables.Rule.Target.to_ports:type_name -> ligato.linux.iptables.Rule.PortRange
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_ligato_linux_iptables_iptables_proto_init() }
@@ -461,14 +1003,50 @@ func file_ligato_linux_iptables_iptables_proto_init() {
 				return nil
 			}
 		}
+		file_ligato_linux_iptables_iptables_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_iptables_iptables_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule_PortRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_iptables_iptables_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule_Target); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_linux_iptables_iptables_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   1,
+			NumEnums:      7,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -18,6 +18,7 @@ import (
 	"strings"
 
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 )
 
 // ModuleName is the module name used for models.
@@ -36,7 +37,16 @@ var (
 		Type:    "route",
 	}, models.WithNameTemplate(
 		`{{with ipnet .DstNetwork}}{{printf "%s/%d" .IP .MaskSize}}`+
-			`{{else}}{{.DstNetwork}}{{end}}/{{.OutgoingInterface}}`,
+			`{{else}}{{.DstNetwork}}{{end}}/{{.OutgoingInterface}}`+
+			`{{if .Table}}/table/{{.Table}}{{end}}`,
+	))
+
+	ModelRule = models.Register(&Rule{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "rule",
+	}, models.WithNameTemplate(
+		"{{.Family}}/{{.Priority}}{{with .Namespace}}{{if .Type}}/ns/{{.Type}}/{{.Reference}}{{end}}{{end}}",
 	))
 )
 
@@ -56,6 +66,26 @@ func RouteKey(dstNetwork, outgoingInterface string) string {
 	})
 }
 
+// RouteTableKey returns the key used in ETCD to store configuration of a particular
+// Linux route put into the given routing table.
+func RouteTableKey(dstNetwork, outgoingInterface string, table uint32) string {
+	return models.Key(&Route{
+		DstNetwork:        dstNetwork,
+		OutgoingInterface: outgoingInterface,
+		Table:             table,
+	})
+}
+
+// RuleKey returns the key used in ETCD to store configuration of a particular Linux rule.
+// Rules in different network namespaces may have the same family and priority.
+func RuleKey(family Rule_Family, priority uint32, namespace *linux_namespace.NetNamespace) string {
+	return models.Key(&Rule{
+		Family:    family,
+		Priority:  priority,
+		Namespace: namespace,
+	})
+}
+
 const (
 	/* Link-local route (derived) */
 
@@ -16,6 +16,8 @@ package linux_l3
 
 import (
 	"testing"
+
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 )
 
 func TestRouteKey(t *testing.T) {
@@ -50,6 +52,87 @@ func TestRouteKey(t *testing.T) {
 	}
 }
 
+func TestRouteTableKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		outIface    string
+		dstNetwork  string
+		table       uint32
+		expectedKey string
+	}{
+		{
+			name:        "main routing table",
+			outIface:    "memif1",
+			dstNetwork:  "192.168.1.0/24",
+			expectedKey: "config/linux/l3/v2/route/192.168.1.0/24/memif1",
+		},
+		{
+			name:        "non-main routing table",
+			outIface:    "memif1",
+			dstNetwork:  "0.0.0.0/0",
+			table:       100,
+			expectedKey: "config/linux/l3/v2/route/0.0.0.0/0/memif1/table/100",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := RouteTableKey(test.dstNetwork, test.outIface, test.table)
+			if key != test.expectedKey {
+				t.Errorf("failed for: outIface=%s dstNet=%s table=%d\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.outIface, test.dstNetwork, test.table, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestRuleKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		family      Rule_Family
+		priority    uint32
+		namespace   *linux_namespace.NetNamespace
+		expectedKey string
+	}{
+		{
+			name:        "IPv4 rule",
+			family:      Rule_IPV4,
+			priority:    100,
+			expectedKey: "config/linux/l3/v2/rule/IPV4/100",
+		},
+		{
+			name:        "IPv6 rule",
+			family:      Rule_IPV6,
+			priority:    32000,
+			expectedKey: "config/linux/l3/v2/rule/IPV6/32000",
+		},
+		{
+			name:        "rule in namespace",
+			family:      Rule_IPV4,
+			priority:    100,
+			namespace:   &linux_namespace.NetNamespace{Type: linux_namespace.NetNamespace_MICROSERVICE, Reference: "ms1"},
+			expectedKey: "config/linux/l3/v2/rule/IPV4/100/ns/MICROSERVICE/ms1",
+		},
+		{
+			name:        "rule in undefined namespace",
+			family:      Rule_IPV4,
+			priority:    100,
+			namespace:   &linux_namespace.NetNamespace{},
+			expectedKey: "config/linux/l3/v2/rule/IPV4/100",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := RuleKey(test.family, test.priority, test.namespace)
+			if key != test.expectedKey {
+				t.Errorf("failed for: family=%v priority=%d namespace=%v\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.family, test.priority, test.namespace, test.expectedKey, key)
+			}
+		})
+	}
+}
+
 func TestStaticLinkLocalRouteKey(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/linux/l3/rule.proto
+
+package linux_l3
+
+import (
+	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Rule_Family int32
+
+const (
+	Rule_IPV4 Rule_Family = 0
+	Rule_IPV6 Rule_Family = 1
+)
+
+// Enum value maps for Rule_Family.
+var (
+	Rule_Family_name = map[int32]string{
+		0: "IPV4",
+		1: "IPV6",
+	}
+	Rule_Family_value = map[string]int32{
+		"IPV4": 0,
+		"IPV6": 1,
+	}
+)
+
+func (x Rule_Family) Enum() *Rule_Family {
+	p := new(Rule_Family)
+	*p = x
+	return p
+}
+
+func (x Rule_Family) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rule_Family) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_l3_rule_proto_enumTypes[0].Descriptor()
+}
+
+func (Rule_Family) Type() protoreflect.EnumType {
+	return &file_ligato_linux_l3_rule_proto_enumTypes[0]
+}
+
+func (x Rule_Family) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rule_Family.Descriptor instead.
+func (Rule_Family) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_l3_rule_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Rule is a policy routing rule (see `ip rule`) selecting the routing table
+// to be used for packets matching the given selectors.
+type Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address family of the rule.
+	Family Rule_Family `protobuf:"varint,1,opt,name=family,proto3,enum=ligato.linux.l3.Rule_Family" json:"family,omitempty"`
+	// Priority (preference) of the rule, unique within the address family.
+	// Rules are evaluated in the order of increasing priority.
+	Priority uint32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Source network in the format <address>/<prefix> (optional).
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Destination network in the format <address>/<prefix> (optional).
+	To string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Firewall mark to match (optional).
+	Fwmark uint32 `protobuf:"varint,5,opt,name=fwmark,proto3" json:"fwmark,omitempty"`
+	// Mask applied to the firewall mark before the comparison.
+	// If not defined, the whole mark is compared.
+	Fwmask uint32 `protobuf:"varint,6,opt,name=fwmask,proto3" json:"fwmask,omitempty"`
+	// Logical name of the interface the packets are received from (optional).
+	InInterface string `protobuf:"bytes,7,opt,name=in_interface,json=inInterface,proto3" json:"in_interface,omitempty"`
+	// Logical name of the interface the packets are sent through (optional).
+	OutInterface string `protobuf:"bytes,8,opt,name=out_interface,json=outInterface,proto3" json:"out_interface,omitempty"`
+	// ID of the routing table to look up for matching packets.
+	// Either table or vrf must be defined.
+	Table uint32 `protobuf:"varint,9,opt,name=table,proto3" json:"table,omitempty"`
+	// Logical name of the VRF_DEVICE interface, the routing table of which
+	// should be looked up for matching packets.
+	Vrf string `protobuf:"bytes,10,opt,name=vrf,proto3" json:"vrf,omitempty"`
+	// Network namespace in which the rule is configured.
+	Namespace *namespace.NetNamespace `protobuf:"bytes,11,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_l3_rule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_l3_rule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_l3_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Rule) GetFamily() Rule_Family {
+	if x != nil {
+		return x.Family
+	}
+	return Rule_IPV4
+}
+
+func (x *Rule) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Rule) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Rule) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Rule) GetFwmark() uint32 {
+	if x != nil {
+		return x.Fwmark
+	}
+	return 0
+}
+
+func (x *Rule) GetFwmask() uint32 {
+	if x != nil {
+		return x.Fwmask
+	}
+	return 0
+}
+
+func (x *Rule) GetInInterface() string {
+	if x != nil {
+		return x.InInterface
+	}
+	return ""
+}
+
+func (x *Rule) GetOutInterface() string {
+	if x != nil {
+		return x.OutInterface
+	}
+	return ""
+}
+
+func (x *Rule) GetTable() uint32 {
+	if x != nil {
+		return x.Table
+	}
+	return 0
+}
+
+func (x *Rule) GetVrf() string {
+	if x != nil {
+		return x.Vrf
+	}
+	return ""
+}
+
+func (x *Rule) GetNamespace() *namespace.NetNamespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+var File_ligato_linux_l3_rule_proto protoreflect.FileDescriptor
+
+var file_ligato_linux_l3_rule_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6c,
+	0x33, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6c, 0x33, 0x1a, 0x18, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x8c, 0x03, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e,
+	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x15, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x77, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x77,
+	0x6d, 0x61, 0x72, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x72,
+	0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x72, 0x66, 0x12, 0x42, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x22, 0x1c, 0x0a, 0x06, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50,
+	0x56, 0x34, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36, 0x10, 0x01, 0x42, 0x3a,
+	0x5a, 0x38, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6c,
+	0x33, 0x3b, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x6c, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_ligato_linux_l3_rule_proto_rawDescOnce sync.Once
+	file_ligato_linux_l3_rule_proto_rawDescData = file_ligato_linux_l3_rule_proto_rawDesc
+)
+
+func file_ligato_linux_l3_rule_proto_rawDescGZIP() []byte {
+	file_ligato_linux_l3_rule_proto_rawDescOnce.Do(func() {
+		file_ligato_linux_l3_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_linux_l3_rule_proto_rawDescData)
+	})
+	return file_ligato_linux_l3_rule_proto_rawDescData
+}
+
+var file_ligato_linux_l3_rule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ligato_linux_l3_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ligato_linux_l3_rule_proto_goTypes = []interface{}{
+	(Rule_Family)(0),               // 0: ligato.linux.l3.Rule.Family
+	(*Rule)(nil),                   // 1: ligato.linux.l3.Rule
+	(*namespace.NetNamespace)(nil), // 2: ligato.linux.namespace.NetNamespace
+}
+var file_ligato_linux_l3_rule_proto_depIdxs = []int32{
+	0, // 0: ligato.linux.l3.Rule.family:type_name -> ligato.linux.l3.Rule.Family
+	2, // 1: ligato.linux.l3.Rule.namespace:type_name -> ligato.linux.namespace.NetNamespace
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ligato_linux_l3_rule_proto_init() }
+func file_ligato_linux_l3_rule_proto_init() {
+	if File_ligato_linux_l3_rule_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_linux_l3_rule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_linux_l3_rule_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_linux_l3_rule_proto_goTypes,
+		DependencyIndexes: file_ligato_linux_l3_rule_proto_depIdxs,
+		EnumInfos:         file_ligato_linux_l3_rule_proto_enumTypes,
+		MessageInfos:      file_ligato_linux_l3_rule_proto_msgTypes,
+	}.Build()
+	File_ligato_linux_l3_rule_proto = out.File
+	file_ligato_linux_l3_rule_proto_rawDesc = nil
+	file_ligato_linux_l3_rule_proto_goTypes = nil
+	file_ligato_linux_l3_rule_proto_depIdxs = nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linux_nftables
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "linux.nftables"
+
+var (
+	ModelTable = models.Register(&Table{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v1",
+		Type:    "table",
+	}, models.WithNameTemplate(
+		"{{.Family}}/{{.Name}}{{with .Namespace}}{{if .Type}}/ns/{{.Type}}/{{.Reference}}{{end}}{{end}}",
+	))
+)
+
+// TableKey returns the key used in KV database to store configuration of a particular Linux nftables table.
+// Tables of different families or in different namespaces may have the same name.
+func TableKey(name string, family Table_Family, namespace *linux_namespace.NetNamespace) string {
+	return models.Key(&Table{
+		Name:      name,
+		Family:    family,
+		Namespace: namespace,
+	})
+}
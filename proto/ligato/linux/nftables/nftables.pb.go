@@ -0,0 +1,1645 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/linux/nftables/nftables.proto
+
+package linux_nftables
+
+import (
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Verdict decides what happens with packet that matched a rule.
+type Verdict int32
+
+const (
+	// Continue with the next rule.
+	Verdict_CONTINUE Verdict = 0
+	Verdict_ACCEPT   Verdict = 1
+	Verdict_DROP     Verdict = 2
+	Verdict_RETURN   Verdict = 3
+	// Jump to the target chain and return after it is processed.
+	Verdict_JUMP Verdict = 4
+	// Go to the target chain without return.
+	Verdict_GOTO Verdict = 5
+)
+
+// Enum value maps for Verdict.
+var (
+	Verdict_name = map[int32]string{
+		0: "CONTINUE",
+		1: "ACCEPT",
+		2: "DROP",
+		3: "RETURN",
+		4: "JUMP",
+		5: "GOTO",
+	}
+	Verdict_value = map[string]int32{
+		"CONTINUE": 0,
+		"ACCEPT":   1,
+		"DROP":     2,
+		"RETURN":   3,
+		"JUMP":     4,
+		"GOTO":     5,
+	}
+)
+
+func (x Verdict) Enum() *Verdict {
+	p := new(Verdict)
+	*p = x
+	return p
+}
+
+func (x Verdict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Verdict) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_nftables_nftables_proto_enumTypes[0].Descriptor()
+}
+
+func (Verdict) Type() protoreflect.EnumType {
+	return &file_ligato_linux_nftables_nftables_proto_enumTypes[0]
+}
+
+func (x Verdict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Verdict.Descriptor instead.
+func (Verdict) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{0}
+}
+
+// MatchField selects packet field to match against set or map.
+type MatchField int32
+
+const (
+	MatchField_SRC_ADDR MatchField = 0
+	MatchField_DST_ADDR MatchField = 1
+	MatchField_SRC_PORT MatchField = 2
+	MatchField_DST_PORT MatchField = 3
+)
+
+// Enum value maps for MatchField.
+var (
+	MatchField_name = map[int32]string{
+		0: "SRC_ADDR",
+		1: "DST_ADDR",
+		2: "SRC_PORT",
+		3: "DST_PORT",
+	}
+	MatchField_value = map[string]int32{
+		"SRC_ADDR": 0,
+		"DST_ADDR": 1,
+		"SRC_PORT": 2,
+		"DST_PORT": 3,
+	}
+)
+
+func (x MatchField) Enum() *MatchField {
+	p := new(MatchField)
+	*p = x
+	return p
+}
+
+func (x MatchField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchField) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_nftables_nftables_proto_enumTypes[1].Descriptor()
+}
+
+func (MatchField) Type() protoreflect.EnumType {
+	return &file_ligato_linux_nftables_nftables_proto_enumTypes[1]
+}
+
+func (x MatchField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchField.Descriptor instead.
+func (MatchField) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{1}
+}
+
+// ElementType is the type of set elements and map keys.
+type ElementType int32
+
+const (
+	// IPv4 addresses, e.g. "10.0.0.1" or "10.0.0.0/24" (for interval sets).
+	ElementType_IPV4_ADDR ElementType = 0
+	// IPv6 addresses, e.g. "fd00::1" or "fd00::/64" (for interval sets).
+	ElementType_IPV6_ADDR ElementType = 1
+	// MAC addresses, e.g. "aa:bb:cc:dd:ee:ff" (only in bridge tables).
+	ElementType_ETHER_ADDR ElementType = 2
+	// L4 ports, e.g. "80" or "8000-8080" (for interval sets).
+	ElementType_INET_SERVICE ElementType = 3
+)
+
+// Enum value maps for ElementType.
+var (
+	ElementType_name = map[int32]string{
+		0: "IPV4_ADDR",
+		1: "IPV6_ADDR",
+		2: "ETHER_ADDR",
+		3: "INET_SERVICE",
+	}
+	ElementType_value = map[string]int32{
+		"IPV4_ADDR":    0,
+		"IPV6_ADDR":    1,
+		"ETHER_ADDR":   2,
+		"INET_SERVICE": 3,
+	}
+)
+
+func (x ElementType) Enum() *ElementType {
+	p := new(ElementType)
+	*p = x
+	return p
+}
+
+func (x ElementType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ElementType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_nftables_nftables_proto_enumTypes[2].Descriptor()
+}
+
+func (ElementType) Type() protoreflect.EnumType {
+	return &file_ligato_linux_nftables_nftables_proto_enumTypes[2]
+}
+
+func (x ElementType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ElementType.Descriptor instead.
+func (ElementType) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{2}
+}
+
+type Table_Family int32
+
+const (
+	Table_INET   Table_Family = 0
+	Table_IPV4   Table_Family = 1
+	Table_IPV6   Table_Family = 2
+	Table_ARP    Table_Family = 3
+	Table_BRIDGE Table_Family = 4
+)
+
+// Enum value maps for Table_Family.
+var (
+	Table_Family_name = map[int32]string{
+		0: "INET",
+		1: "IPV4",
+		2: "IPV6",
+		3: "ARP",
+		4: "BRIDGE",
+	}
+	Table_Family_value = map[string]int32{
+		"INET":   0,
+		"IPV4":   1,
+		"IPV6":   2,
+		"ARP":    3,
+		"BRIDGE": 4,
+	}
+)
+
+func (x Table_Family) Enum() *Table_Family {
+	p := new(Table_Family)
+	*p = x
+	return p
+}
+
+func (x Table_Family) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Table_Family) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_nftables_nftables_proto_enumTypes[3].Descriptor()
+}
+
+func (Table_Family) Type() protoreflect.EnumType {
+	return &file_ligato_linux_nftables_nftables_proto_enumTypes[3]
+}
+
+func (x Table_Family) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Table_Family.Descriptor instead.
+func (Table_Family) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Chain_Type int32
+
+const (
+	Chain_FILTER Chain_Type = 0
+	Chain_NAT    Chain_Type = 1
+	Chain_ROUTE  Chain_Type = 2
+)
+
+// Enum value maps for Chain_Type.
+var (
+	Chain_Type_name = map[int32]string{
+		0: "FILTER",
+		1: "NAT",
+		2: "ROUTE",
+	}
+	Chain_Type_value = map[string]int32{
+		"FILTER": 0,
+		"NAT":    1,
+		"ROUTE":  2,
+	}
+)
+
+func (x Chain_Type) Enum() *Chain_Type {
+	p := new(Chain_Type)
+	*p = x
+	return p
+}
+
+func (x Chain_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Chain_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_nftables_nftables_proto_enumTypes[4].Descriptor()
+}
+
+func (Chain_Type) Type() protoreflect.EnumType {
+	return &file_ligato_linux_nftables_nftables_proto_enumTypes[4]
+}
+
+func (x Chain_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Chain_Type.Descriptor instead.
+func (Chain_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type Chain_Hook int32
+
+const (
+	// Regular chain, rules are processed only by jump/goto from other chains.
+	Chain_NONE        Chain_Hook = 0
+	Chain_PREROUTING  Chain_Hook = 1
+	Chain_INPUT       Chain_Hook = 2
+	Chain_FORWARD     Chain_Hook = 3
+	Chain_OUTPUT      Chain_Hook = 4
+	Chain_POSTROUTING Chain_Hook = 5
+)
+
+// Enum value maps for Chain_Hook.
+var (
+	Chain_Hook_name = map[int32]string{
+		0: "NONE",
+		1: "PREROUTING",
+		2: "INPUT",
+		3: "FORWARD",
+		4: "OUTPUT",
+		5: "POSTROUTING",
+	}
+	Chain_Hook_value = map[string]int32{
+		"NONE":        0,
+		"PREROUTING":  1,
+		"INPUT":       2,
+		"FORWARD":     3,
+		"OUTPUT":      4,
+		"POSTROUTING": 5,
+	}
+)
+
+func (x Chain_Hook) Enum() *Chain_Hook {
+	p := new(Chain_Hook)
+	*p = x
+	return p
+}
+
+func (x Chain_Hook) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Chain_Hook) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_nftables_nftables_proto_enumTypes[5].Descriptor()
+}
+
+func (Chain_Hook) Type() protoreflect.EnumType {
+	return &file_ligato_linux_nftables_nftables_proto_enumTypes[5]
+}
+
+func (x Chain_Hook) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Chain_Hook.Descriptor instead.
+func (Chain_Hook) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{1, 1}
+}
+
+type Chain_Policy int32
+
+const (
+	Chain_ACCEPT Chain_Policy = 0
+	Chain_DROP   Chain_Policy = 1
+)
+
+// Enum value maps for Chain_Policy.
+var (
+	Chain_Policy_name = map[int32]string{
+		0: "ACCEPT",
+		1: "DROP",
+	}
+	Chain_Policy_value = map[string]int32{
+		"ACCEPT": 0,
+		"DROP":   1,
+	}
+)
+
+func (x Chain_Policy) Enum() *Chain_Policy {
+	p := new(Chain_Policy)
+	*p = x
+	return p
+}
+
+func (x Chain_Policy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Chain_Policy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_nftables_nftables_proto_enumTypes[6].Descriptor()
+}
+
+func (Chain_Policy) Type() protoreflect.EnumType {
+	return &file_ligato_linux_nftables_nftables_proto_enumTypes[6]
+}
+
+func (x Chain_Policy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Chain_Policy.Descriptor instead.
+func (Chain_Policy) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{1, 2}
+}
+
+type Rule_Protocol int32
+
+const (
+	Rule_ANY    Rule_Protocol = 0
+	Rule_TCP    Rule_Protocol = 1
+	Rule_UDP    Rule_Protocol = 2
+	Rule_SCTP   Rule_Protocol = 3
+	Rule_ICMP   Rule_Protocol = 4
+	Rule_ICMPV6 Rule_Protocol = 5
+)
+
+// Enum value maps for Rule_Protocol.
+var (
+	Rule_Protocol_name = map[int32]string{
+		0: "ANY",
+		1: "TCP",
+		2: "UDP",
+		3: "SCTP",
+		4: "ICMP",
+		5: "ICMPV6",
+	}
+	Rule_Protocol_value = map[string]int32{
+		"ANY":    0,
+		"TCP":    1,
+		"UDP":    2,
+		"SCTP":   3,
+		"ICMP":   4,
+		"ICMPV6": 5,
+	}
+)
+
+func (x Rule_Protocol) Enum() *Rule_Protocol {
+	p := new(Rule_Protocol)
+	*p = x
+	return p
+}
+
+func (x Rule_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rule_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_nftables_nftables_proto_enumTypes[7].Descriptor()
+}
+
+func (Rule_Protocol) Type() protoreflect.EnumType {
+	return &file_ligato_linux_nftables_nftables_proto_enumTypes[7]
+}
+
+func (x Rule_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rule_Protocol.Descriptor instead.
+func (Rule_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type Rule_ConnState int32
+
+const (
+	Rule_NEW         Rule_ConnState = 0
+	Rule_ESTABLISHED Rule_ConnState = 1
+	Rule_RELATED     Rule_ConnState = 2
+	Rule_INVALID     Rule_ConnState = 3
+	Rule_UNTRACKED   Rule_ConnState = 4
+)
+
+// Enum value maps for Rule_ConnState.
+var (
+	Rule_ConnState_name = map[int32]string{
+		0: "NEW",
+		1: "ESTABLISHED",
+		2: "RELATED",
+		3: "INVALID",
+		4: "UNTRACKED",
+	}
+	Rule_ConnState_value = map[string]int32{
+		"NEW":         0,
+		"ESTABLISHED": 1,
+		"RELATED":     2,
+		"INVALID":     3,
+		"UNTRACKED":   4,
+	}
+)
+
+func (x Rule_ConnState) Enum() *Rule_ConnState {
+	p := new(Rule_ConnState)
+	*p = x
+	return p
+}
+
+func (x Rule_ConnState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rule_ConnState) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_nftables_nftables_proto_enumTypes[8].Descriptor()
+}
+
+func (Rule_ConnState) Type() protoreflect.EnumType {
+	return &file_ligato_linux_nftables_nftables_proto_enumTypes[8]
+}
+
+func (x Rule_ConnState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rule_ConnState.Descriptor instead.
+func (Rule_ConnState) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{2, 1}
+}
+
+// Table is a nftables table with all its chains, sets and maps.
+// The table is always configured as a whole in a single netlink batch,
+// i.e. the changes are applied atomically.
+type Table struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the table (mandatory).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Network namespace in which the table is configured.
+	Namespace *namespace.NetNamespace `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Address family of the table.
+	Family Table_Family `protobuf:"varint,3,opt,name=family,proto3,enum=ligato.linux.nftables.Table_Family" json:"family,omitempty"`
+	Chains []*Chain     `protobuf:"bytes,4,rep,name=chains,proto3" json:"chains,omitempty"`
+	Sets   []*Set       `protobuf:"bytes,5,rep,name=sets,proto3" json:"sets,omitempty"`
+	Maps   []*Map       `protobuf:"bytes,6,rep,name=maps,proto3" json:"maps,omitempty"`
+}
+
+func (x *Table) Reset() {
+	*x = Table{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Table) ProtoMessage() {}
+
+func (x *Table) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Table.ProtoReflect.Descriptor instead.
+func (*Table) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Table) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Table) GetNamespace() *namespace.NetNamespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *Table) GetFamily() Table_Family {
+	if x != nil {
+		return x.Family
+	}
+	return Table_INET
+}
+
+func (x *Table) GetChains() []*Chain {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+func (x *Table) GetSets() []*Set {
+	if x != nil {
+		return x.Sets
+	}
+	return nil
+}
+
+func (x *Table) GetMaps() []*Map {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+// Chain is a container of rules.
+type Chain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the chain unique within the table (mandatory).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Type of the base chain.
+	Type Chain_Type `protobuf:"varint,2,opt,name=type,proto3,enum=ligato.linux.nftables.Chain_Type" json:"type,omitempty"`
+	// Hook which the base chain is attached to.
+	Hook Chain_Hook `protobuf:"varint,3,opt,name=hook,proto3,enum=ligato.linux.nftables.Chain_Hook" json:"hook,omitempty"`
+	// Priority of the base chain relative to other chains attached to the same hook.
+	Priority int32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Default policy of the base chain.
+	Policy Chain_Policy `protobuf:"varint,5,opt,name=policy,proto3,enum=ligato.linux.nftables.Chain_Policy" json:"policy,omitempty"`
+	// Ordered list of rules of the chain.
+	Rules []*Rule `protobuf:"bytes,6,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *Chain) Reset() {
+	*x = Chain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Chain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chain) ProtoMessage() {}
+
+func (x *Chain) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chain.ProtoReflect.Descriptor instead.
+func (*Chain) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Chain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Chain) GetType() Chain_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Chain_FILTER
+}
+
+func (x *Chain) GetHook() Chain_Hook {
+	if x != nil {
+		return x.Hook
+	}
+	return Chain_NONE
+}
+
+func (x *Chain) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Chain) GetPolicy() Chain_Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return Chain_ACCEPT
+}
+
+func (x *Chain) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Rule matches packets and applies a verdict on them.
+// All the given matches must be satisfied for the rule to apply.
+type Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Logical name of linux interface (from the linux interfaces model)
+	// packets are received on.
+	InInterface string `protobuf:"bytes,1,opt,name=in_interface,json=inInterface,proto3" json:"in_interface,omitempty"`
+	// Logical name of linux interface (from the linux interfaces model)
+	// packets are sent out from.
+	OutInterface string `protobuf:"bytes,2,opt,name=out_interface,json=outInterface,proto3" json:"out_interface,omitempty"`
+	// L4 protocol of packets.
+	Protocol Rule_Protocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=ligato.linux.nftables.Rule_Protocol" json:"protocol,omitempty"`
+	// Source IP address or network (in CIDR notation).
+	SrcNetwork string `protobuf:"bytes,4,opt,name=src_network,json=srcNetwork,proto3" json:"src_network,omitempty"`
+	// Destination IP address or network (in CIDR notation).
+	DstNetwork string `protobuf:"bytes,5,opt,name=dst_network,json=dstNetwork,proto3" json:"dst_network,omitempty"`
+	// Source L4 ports (requires TCP, UDP or SCTP protocol).
+	SrcPorts *Rule_PortRange `protobuf:"bytes,6,opt,name=src_ports,json=srcPorts,proto3" json:"src_ports,omitempty"`
+	// Destination L4 ports (requires TCP, UDP or SCTP protocol).
+	DstPorts   *Rule_PortRange  `protobuf:"bytes,7,opt,name=dst_ports,json=dstPorts,proto3" json:"dst_ports,omitempty"`
+	SetMatches []*Rule_SetMatch `protobuf:"bytes,8,rep,name=set_matches,json=setMatches,proto3" json:"set_matches,omitempty"`
+	// Match packets of connections in any of the given states.
+	ConnStates []Rule_ConnState `protobuf:"varint,9,rep,packed,name=conn_states,json=connStates,proto3,enum=ligato.linux.nftables.Rule_ConnState" json:"conn_states,omitempty"`
+	// Count packets and bytes matched by the rule.
+	Counter bool `protobuf:"varint,10,opt,name=counter,proto3" json:"counter,omitempty"`
+	// Verdict for packets matched by the rule.
+	Verdict Verdict `protobuf:"varint,11,opt,name=verdict,proto3,enum=ligato.linux.nftables.Verdict" json:"verdict,omitempty"`
+	// Target chain for JUMP and GOTO verdicts.
+	TargetChain string `protobuf:"bytes,12,opt,name=target_chain,json=targetChain,proto3" json:"target_chain,omitempty"`
+	// Verdict map to take the verdict from, cannot be combined with verdict.
+	VerdictMap *Rule_VerdictMapLookup `protobuf:"bytes,13,opt,name=verdict_map,json=verdictMap,proto3" json:"verdict_map,omitempty"`
+	// Comment attached to the rule.
+	Comment string `protobuf:"bytes,14,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Rule) GetInInterface() string {
+	if x != nil {
+		return x.InInterface
+	}
+	return ""
+}
+
+func (x *Rule) GetOutInterface() string {
+	if x != nil {
+		return x.OutInterface
+	}
+	return ""
+}
+
+func (x *Rule) GetProtocol() Rule_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Rule_ANY
+}
+
+func (x *Rule) GetSrcNetwork() string {
+	if x != nil {
+		return x.SrcNetwork
+	}
+	return ""
+}
+
+func (x *Rule) GetDstNetwork() string {
+	if x != nil {
+		return x.DstNetwork
+	}
+	return ""
+}
+
+func (x *Rule) GetSrcPorts() *Rule_PortRange {
+	if x != nil {
+		return x.SrcPorts
+	}
+	return nil
+}
+
+func (x *Rule) GetDstPorts() *Rule_PortRange {
+	if x != nil {
+		return x.DstPorts
+	}
+	return nil
+}
+
+func (x *Rule) GetSetMatches() []*Rule_SetMatch {
+	if x != nil {
+		return x.SetMatches
+	}
+	return nil
+}
+
+func (x *Rule) GetConnStates() []Rule_ConnState {
+	if x != nil {
+		return x.ConnStates
+	}
+	return nil
+}
+
+func (x *Rule) GetCounter() bool {
+	if x != nil {
+		return x.Counter
+	}
+	return false
+}
+
+func (x *Rule) GetVerdict() Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return Verdict_CONTINUE
+}
+
+func (x *Rule) GetTargetChain() string {
+	if x != nil {
+		return x.TargetChain
+	}
+	return ""
+}
+
+func (x *Rule) GetVerdictMap() *Rule_VerdictMapLookup {
+	if x != nil {
+		return x.VerdictMap
+	}
+	return nil
+}
+
+func (x *Rule) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// Set is a named set of elements that rules can match against.
+type Set struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the set unique within the table (mandatory).
+	Name string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type ElementType `protobuf:"varint,2,opt,name=type,proto3,enum=ligato.linux.nftables.ElementType" json:"type,omitempty"`
+	// Interval set can contain networks and port ranges.
+	Interval bool     `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	Elements []string `protobuf:"bytes,4,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (x *Set) Reset() {
+	*x = Set{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Set) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Set) ProtoMessage() {}
+
+func (x *Set) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Set.ProtoReflect.Descriptor instead.
+func (*Set) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Set) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Set) GetType() ElementType {
+	if x != nil {
+		return x.Type
+	}
+	return ElementType_IPV4_ADDR
+}
+
+func (x *Set) GetInterval() bool {
+	if x != nil {
+		return x.Interval
+	}
+	return false
+}
+
+func (x *Set) GetElements() []string {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+// Map is a named verdict map.
+type Map struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the map unique within the table (mandatory).
+	Name    string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	KeyType ElementType `protobuf:"varint,2,opt,name=key_type,json=keyType,proto3,enum=ligato.linux.nftables.ElementType" json:"key_type,omitempty"`
+	// Interval map can have networks and port ranges as keys.
+	Interval bool           `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	Elements []*Map_Element `protobuf:"bytes,4,rep,name=elements,proto3" json:"elements,omitempty"`
+}
+
+func (x *Map) Reset() {
+	*x = Map{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Map) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Map) ProtoMessage() {}
+
+func (x *Map) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Map.ProtoReflect.Descriptor instead.
+func (*Map) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Map) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Map) GetKeyType() ElementType {
+	if x != nil {
+		return x.KeyType
+	}
+	return ElementType_IPV4_ADDR
+}
+
+func (x *Map) GetInterval() bool {
+	if x != nil {
+		return x.Interval
+	}
+	return false
+}
+
+func (x *Map) GetElements() []*Map_Element {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+// PortRange is an inclusive range of L4 ports, single port
+// is matched if upper bound is not defined.
+type Rule_PortRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LowerPort uint32 `protobuf:"varint,1,opt,name=lower_port,json=lowerPort,proto3" json:"lower_port,omitempty"`
+	UpperPort uint32 `protobuf:"varint,2,opt,name=upper_port,json=upperPort,proto3" json:"upper_port,omitempty"`
+}
+
+func (x *Rule_PortRange) Reset() {
+	*x = Rule_PortRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_PortRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_PortRange) ProtoMessage() {}
+
+func (x *Rule_PortRange) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_PortRange.ProtoReflect.Descriptor instead.
+func (*Rule_PortRange) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Rule_PortRange) GetLowerPort() uint32 {
+	if x != nil {
+		return x.LowerPort
+	}
+	return 0
+}
+
+func (x *Rule_PortRange) GetUpperPort() uint32 {
+	if x != nil {
+		return x.UpperPort
+	}
+	return 0
+}
+
+// SetMatch matches packet field against elements of a set.
+type Rule_SetMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the set defined in the same table.
+	Set   string     `protobuf:"bytes,1,opt,name=set,proto3" json:"set,omitempty"`
+	Field MatchField `protobuf:"varint,2,opt,name=field,proto3,enum=ligato.linux.nftables.MatchField" json:"field,omitempty"`
+	// Match packets with field NOT present in the set.
+	Invert bool `protobuf:"varint,3,opt,name=invert,proto3" json:"invert,omitempty"`
+}
+
+func (x *Rule_SetMatch) Reset() {
+	*x = Rule_SetMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_SetMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_SetMatch) ProtoMessage() {}
+
+func (x *Rule_SetMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_SetMatch.ProtoReflect.Descriptor instead.
+func (*Rule_SetMatch) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Rule_SetMatch) GetSet() string {
+	if x != nil {
+		return x.Set
+	}
+	return ""
+}
+
+func (x *Rule_SetMatch) GetField() MatchField {
+	if x != nil {
+		return x.Field
+	}
+	return MatchField_SRC_ADDR
+}
+
+func (x *Rule_SetMatch) GetInvert() bool {
+	if x != nil {
+		return x.Invert
+	}
+	return false
+}
+
+// VerdictMapLookup takes verdict from a map, keyed by packet field.
+type Rule_VerdictMapLookup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the map defined in the same table.
+	Map   string     `protobuf:"bytes,1,opt,name=map,proto3" json:"map,omitempty"`
+	Field MatchField `protobuf:"varint,2,opt,name=field,proto3,enum=ligato.linux.nftables.MatchField" json:"field,omitempty"`
+}
+
+func (x *Rule_VerdictMapLookup) Reset() {
+	*x = Rule_VerdictMapLookup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_VerdictMapLookup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_VerdictMapLookup) ProtoMessage() {}
+
+func (x *Rule_VerdictMapLookup) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_VerdictMapLookup.ProtoReflect.Descriptor instead.
+func (*Rule_VerdictMapLookup) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Rule_VerdictMapLookup) GetMap() string {
+	if x != nil {
+		return x.Map
+	}
+	return ""
+}
+
+func (x *Rule_VerdictMapLookup) GetField() MatchField {
+	if x != nil {
+		return x.Field
+	}
+	return MatchField_SRC_ADDR
+}
+
+type Map_Element struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Verdict Verdict `protobuf:"varint,2,opt,name=verdict,proto3,enum=ligato.linux.nftables.Verdict" json:"verdict,omitempty"`
+	// Target chain for JUMP and GOTO verdicts.
+	TargetChain string `protobuf:"bytes,3,opt,name=target_chain,json=targetChain,proto3" json:"target_chain,omitempty"`
+}
+
+func (x *Map_Element) Reset() {
+	*x = Map_Element{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Map_Element) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Map_Element) ProtoMessage() {}
+
+func (x *Map_Element) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_nftables_nftables_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Map_Element.ProtoReflect.Descriptor instead.
+func (*Map_Element) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_nftables_nftables_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Map_Element) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Map_Element) GetVerdict() Verdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return Verdict_CONTINUE
+}
+
+func (x *Map_Element) GetTargetChain() string {
+	if x != nil {
+		return x.TargetChain
+	}
+	return ""
+}
+
+var File_ligato_linux_nftables_nftables_proto protoreflect.FileDescriptor
+
+var file_ligato_linux_nftables_nftables_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6e,
+	0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x26, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xef, 0x02, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x4e, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x2e, 0x53, 0x65, 0x74, 0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x6d, 0x61,
+	0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x2e, 0x4d, 0x61, 0x70, 0x52, 0x04, 0x6d, 0x61, 0x70, 0x73, 0x22, 0x3b, 0x0a, 0x06, 0x46, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x49, 0x50, 0x56, 0x34, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36,
+	0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x52, 0x50, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x42,
+	0x52, 0x49, 0x44, 0x47, 0x45, 0x10, 0x04, 0x22, 0xb4, 0x03, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e,
+	0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x04,
+	0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x04, 0x68,
+	0x6f, 0x6f, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x3b, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e,
+	0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x31, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22,
+	0x26, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x49, 0x4c, 0x54, 0x45,
+	0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x41, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x52, 0x4f, 0x55, 0x54, 0x45, 0x10, 0x02, 0x22, 0x55, 0x0a, 0x04, 0x48, 0x6f, 0x6f, 0x6b, 0x12,
+	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x45,
+	0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x50,
+	0x55, 0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10,
+	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x04, 0x12, 0x0f, 0x0a,
+	0x0b, 0x50, 0x4f, 0x53, 0x54, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x22, 0x1e,
+	0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x43, 0x45,
+	0x50, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x01, 0x22, 0xf9,
+	0x08, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75,
+	0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
+	0x40, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78,
+	0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x72, 0x63, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x72, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x73,
+	0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x64, 0x73, 0x74, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x08, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x73,
+	0x65, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e,
+	0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x53, 0x65,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x56, 0x65,
+	0x72, 0x64, 0x69, 0x63, 0x74, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x12, 0x4d, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x5f, 0x6d, 0x61, 0x70,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x4c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x4d, 0x61, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x49, 0x0a, 0x09, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x75, 0x70, 0x70, 0x65,
+	0x72, 0x50, 0x6f, 0x72, 0x74, 0x1a, 0x6d, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x73, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x1a, 0x5d, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x4d,
+	0x61, 0x70, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x37, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x22, 0x45, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10,
+	0x01, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x43,
+	0x54, 0x50, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x43, 0x4d, 0x50, 0x10, 0x04, 0x12, 0x0a,
+	0x0a, 0x06, 0x49, 0x43, 0x4d, 0x50, 0x56, 0x36, 0x10, 0x05, 0x22, 0x4e, 0x0a, 0x09, 0x43, 0x6f,
+	0x6e, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x57, 0x10, 0x00,
+	0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b,
+	0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x55,
+	0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x04, 0x22, 0x89, 0x01, 0x0a, 0x03, 0x53,
+	0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x03, 0x4d, 0x61, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3e, 0x0a,
+	0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e,
+	0x66, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x2e, 0x45, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x78, 0x0a,
+	0x07, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x66, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x64, 0x69, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2a, 0x4d, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x64, 0x69,
+	0x63, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x45, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x44, 0x52, 0x4f, 0x50, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e,
+	0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x55, 0x4d, 0x50, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04,
+	0x47, 0x4f, 0x54, 0x4f, 0x10, 0x05, 0x2a, 0x44, 0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x52, 0x43, 0x5f, 0x41, 0x44, 0x44, 0x52,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x53, 0x54, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x53, 0x52, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0c,
+	0x0a, 0x08, 0x44, 0x53, 0x54, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x2a, 0x4d, 0x0a, 0x0b,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x49,
+	0x50, 0x56, 0x34, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x50,
+	0x56, 0x36, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x54, 0x48,
+	0x45, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x45,
+	0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x03, 0x42, 0x46, 0x5a, 0x44, 0x67,
+	0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6e, 0x66, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x3b, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x6e, 0x66, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_linux_nftables_nftables_proto_rawDescOnce sync.Once
+	file_ligato_linux_nftables_nftables_proto_rawDescData = file_ligato_linux_nftables_nftables_proto_rawDesc
+)
+
+func file_ligato_linux_nftables_nftables_proto_rawDescGZIP() []byte {
+	file_ligato_linux_nftables_nftables_proto_rawDescOnce.Do(func() {
+		file_ligato_linux_nftables_nftables_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_linux_nftables_nftables_proto_rawDescData)
+	})
+	return file_ligato_linux_nftables_nftables_proto_rawDescData
+}
+
+var file_ligato_linux_nftables_nftables_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_ligato_linux_nftables_nftables_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ligato_linux_nftables_nftables_proto_goTypes = []interface{}{
+	(Verdict)(0),                   // 0: ligato.linux.nftables.Verdict
+	(MatchField)(0),                // 1: ligato.linux.nftables.MatchField
+	(ElementType)(0),               // 2: ligato.linux.nftables.ElementType
+	(Table_Family)(0),              // 3: ligato.linux.nftables.Table.Family
+	(Chain_Type)(0),                // 4: ligato.linux.nftables.Chain.Type
+	(Chain_Hook)(0),                // 5: ligato.linux.nftables.Chain.Hook
+	(Chain_Policy)(0),              // 6: ligato.linux.nftables.Chain.Policy
+	(Rule_Protocol)(0),             // 7: ligato.linux.nftables.Rule.Protocol
+	(Rule_ConnState)(0),            // 8: ligato.linux.nftables.Rule.ConnState
+	(*Table)(nil),                  // 9: ligato.linux.nftables.Table
+	(*Chain)(nil),                  // 10: ligato.linux.nftables.Chain
+	(*Rule)(nil),                   // 11: ligato.linux.nftables.Rule
+	(*Set)(nil),                    // 12: ligato.linux.nftables.Set
+	(*Map)(nil),                    // 13: ligato.linux.nftables.Map
+	(*Rule_PortRange)(nil),         // 14: ligato.linux.nftables.Rule.PortRange
+	(*Rule_SetMatch)(nil),          // 15: ligato.linux.nftables.Rule.SetMatch
+	(*Rule_VerdictMapLookup)(nil),  // 16: ligato.linux.nftables.Rule.VerdictMapLookup
+	(*Map_Element)(nil),            // 17: ligato.linux.nftables.Map.Element
+	(*namespace.NetNamespace)(nil), // 18: ligato.linux.namespace.NetNamespace
+}
+var file_ligato_linux_nftables_nftables_proto_depIdxs = []int32{
+	18, // 0: ligato.linux.nftables.Table.namespace:type_name -> ligato.linux.namespace.NetNamespace
+	3,  // 1: ligato.linux.nftables.Table.family:type_name -> ligato.linux.nftables.Table.Family
+	10, // 2: ligato.linux.nftables.Table.chains:type_name -> ligato.linux.nftables.Chain
+	12, // 3: ligato.linux.nftables.Table.sets:type_name -> ligato.linux.nftables.Set
+	13, // 4: ligato.linux.nftables.Table.maps:type_name -> ligato.linux.nftables.Map
+	4,  // 5: ligato.linux.nftables.Chain.type:type_name -> ligato.linux.nftables.Chain.Type
+	5,  // 6: ligato.linux.nftables.Chain.hook:type_name -> ligato.linux.nftables.Chain.Hook
+	6,  // 7: ligato.linux.nftables.Chain.policy:type_name -> ligato.linux.nftables.Chain.Policy
+	11, // 8: ligato.linux.nftables.Chain.rules:type_name -> ligato.linux.nftables.Rule
+	7,  // 9: ligato.linux.nftables.Rule.protocol:type_name -> ligato.linux.nftables.Rule.Protocol
+	14, // 10: ligato.linux.nftables.Rule.src_ports:type_name -> ligato.linux.nftables.Rule.PortRange
+	14, // 11: ligato.linux.nftables.Rule.dst_ports:type_name -> ligato.linux.nftables.Rule.PortRange
+	15, // 12: ligato.linux.nftables.Rule.set_matches:type_name -> ligato.linux.nftables.Rule.SetMatch
+	8,  // 13: ligato.linux.nftables.Rule.conn_states:type_name -> ligato.linux.nftables.Rule.ConnState
+	0,  // 14: ligato.linux.nftables.Rule.verdict:type_name -> ligato.linux.nftables.Verdict
+	16, // 15: ligato.linux.nftables.Rule.verdict_map:type_name -> ligato.linux.nftables.Rule.VerdictMapLookup
+	2,  // 16: ligato.linux.nftables.Set.type:type_name -> ligato.linux.nftables.ElementType
+	2,  // 17: ligato.linux.nftables.Map.key_type:type_name -> ligato.linux.nftables.ElementType
+	17, // 18: ligato.linux.nftables.Map.elements:type_name -> ligato.linux.nftables.Map.Element
+	1,  // 19: ligato.linux.nftables.Rule.SetMatch.field:type_name -> ligato.linux.nftables.MatchField
+	1,  // 20: ligato.linux.nftables.Rule.VerdictMapLookup.field:type_name -> ligato.linux.nftables.MatchField
+	0,  // 21: ligato.linux.nftables.Map.Element.verdict:type_name -> ligato.linux.nftables.Verdict
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_ligato_linux_nftables_nftables_proto_init() }
+func file_ligato_linux_nftables_nftables_proto_init() {
+	if File_ligato_linux_nftables_nftables_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_linux_nftables_nftables_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Table); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_nftables_nftables_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_nftables_nftables_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_nftables_nftables_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Set); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_nftables_nftables_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Map); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_nftables_nftables_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule_PortRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_nftables_nftables_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule_SetMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_nftables_nftables_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule_VerdictMapLookup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_nftables_nftables_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Map_Element); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_linux_nftables_nftables_proto_rawDesc,
+			NumEnums:      9,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_linux_nftables_nftables_proto_goTypes,
+		DependencyIndexes: file_ligato_linux_nftables_nftables_proto_depIdxs,
+		EnumInfos:         file_ligato_linux_nftables_nftables_proto_enumTypes,
+		MessageInfos:      file_ligato_linux_nftables_nftables_proto_msgTypes,
+	}.Build()
+	File_ligato_linux_nftables_nftables_proto = out.File
+	file_ligato_linux_nftables_nftables_proto_rawDesc = nil
+	file_ligato_linux_nftables_nftables_proto_goTypes = nil
+	file_ligato_linux_nftables_nftables_proto_depIdxs = nil
+}
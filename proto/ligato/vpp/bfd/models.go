@@ -0,0 +1,118 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_bfd
+
+import (
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.bfd"
+
+var (
+	ModelBfdSession = models.Register(&BfdSession{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "session",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"{{if .Multihop}}multihop{{else}}interface/{{.Interface}}{{end}}/local/{{.LocalIp}}/peer/{{.PeerIp}}",
+	))
+
+	ModelBfdAuthKey = models.Register(&BfdAuthKey{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "auth-key",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"id/{{.Id}}",
+	))
+)
+
+// SessionKey returns the key used to represent configuration of BFD session.
+func SessionKey(iface, localIP, peerIP string, multihop bool) string {
+	return models.Key(&BfdSession{
+		Interface: iface,
+		LocalIp:   localIP,
+		PeerIp:    peerIP,
+		Multihop:  multihop,
+	})
+}
+
+// AuthKeyKey returns the key used to represent configuration of BFD
+// authentication key.
+func AuthKeyKey(id uint32) string {
+	return models.Key(&BfdAuthKey{
+		Id: id,
+	})
+}
+
+const (
+	// sessionStateKeyTemplate is a template for keys representing
+	// the state of BFD sessions (up/down).
+	sessionStateKeyTemplate = "vpp/bfd/session/{session}/state/{state}"
+
+	sessionStatePrefix = "vpp/bfd/session/"
+	sessionUpState     = "up"
+	sessionDownState   = "down"
+
+	// InvalidKeyPart is used in key for parts which are invalid
+	InvalidKeyPart = "<invalid>"
+)
+
+// SessionStateKey returns key representing the state of the BFD session
+// (only identifying fields of the session are used).
+func SessionStateKey(session *BfdSession, isUp bool) string {
+	name := InvalidKeyPart
+	if session.GetLocalIp() != "" && session.GetPeerIp() != "" {
+		name = models.Name(&BfdSession{
+			Interface: session.GetInterface(),
+			LocalIp:   session.GetLocalIp(),
+			PeerIp:    session.GetPeerIp(),
+			Multihop:  session.GetMultihop(),
+		})
+	}
+	state := sessionDownState
+	if isUp {
+		state = sessionUpState
+	}
+	key := strings.Replace(sessionStateKeyTemplate, "{session}", name, 1)
+	key = strings.Replace(key, "{state}", state, 1)
+	return key
+}
+
+// ParseSessionStateKey parses key representing the state of the BFD session.
+func ParseSessionStateKey(key string) (session string, isUp bool, isSessionStateKey bool) {
+	suffix := strings.TrimPrefix(key, sessionStatePrefix)
+	if suffix == key {
+		return "", false, false
+	}
+	parts := strings.Split(suffix, "/")
+	if len(parts) < 3 || parts[len(parts)-2] != "state" {
+		return "", false, false
+	}
+	switch parts[len(parts)-1] {
+	case sessionUpState:
+		isUp = true
+	case sessionDownState:
+	default:
+		return "", false, false
+	}
+	session = strings.Join(parts[:len(parts)-2], "/")
+	if session == "" {
+		return "", false, false
+	}
+	return session, isUp, true
+}
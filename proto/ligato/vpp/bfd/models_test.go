@@ -0,0 +1,95 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_bfd
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestBfdKeys(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(SessionKey("memif1", "10.0.0.1", "10.0.0.2", false)).To(
+		Equal("config/vpp/bfd/v2/session/interface/memif1/local/10.0.0.1/peer/10.0.0.2"))
+	Expect(SessionKey("", "10.0.0.1", "10.1.0.2", true)).To(
+		Equal("config/vpp/bfd/v2/session/multihop/local/10.0.0.1/peer/10.1.0.2"))
+	Expect(AuthKeyKey(3)).To(Equal("config/vpp/bfd/v2/auth-key/id/3"))
+}
+
+func TestSessionStateKey(t *testing.T) {
+	tests := []struct {
+		name            string
+		session         *BfdSession
+		isUp            bool
+		expectedKey     string
+		expectedSession string
+	}{
+		{
+			name: "single-hop up",
+			session: &BfdSession{
+				Interface:            "memif1",
+				LocalIp:              "10.0.0.1",
+				PeerIp:               "10.0.0.2",
+				DetectMultiplier:     3,
+				DesiredMinTxInterval: 100000,
+			},
+			isUp:            true,
+			expectedKey:     "vpp/bfd/session/interface/memif1/local/10.0.0.1/peer/10.0.0.2/state/up",
+			expectedSession: "interface/memif1/local/10.0.0.1/peer/10.0.0.2",
+		},
+		{
+			name: "multi-hop down",
+			session: &BfdSession{
+				LocalIp:  "fd00::1",
+				PeerIp:   "fd01::2",
+				Multihop: true,
+			},
+			expectedKey:     "vpp/bfd/session/multihop/local/fd00::1/peer/fd01::2/state/down",
+			expectedSession: "multihop/local/fd00::1/peer/fd01::2",
+		},
+		{
+			name:            "missing addresses",
+			session:         &BfdSession{Interface: "memif1"},
+			isUp:            true,
+			expectedKey:     "vpp/bfd/session/<invalid>/state/up",
+			expectedSession: InvalidKeyPart,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			key := SessionStateKey(test.session, test.isUp)
+			Expect(key).To(Equal(test.expectedKey))
+			session, isUp, isValid := ParseSessionStateKey(key)
+			Expect(isValid).To(BeTrue())
+			Expect(isUp).To(Equal(test.isUp))
+			Expect(session).To(Equal(test.expectedSession))
+		})
+	}
+}
+
+func TestParseInvalidSessionStateKey(t *testing.T) {
+	RegisterTestingT(t)
+	for _, key := range []string{
+		"config/vpp/bfd/v2/session/interface/memif1/local/10.0.0.1/peer/10.0.0.2",
+		"vpp/bfd/session/state/up",
+		"vpp/bfd/session/multihop/local/10.0.0.1/peer/10.0.0.2/state/unknown",
+		"vpp/interface/memif1/link-state/up",
+	} {
+		_, _, isValid := ParseSessionStateKey(key)
+		Expect(isValid).To(BeFalse(), key)
+	}
+}
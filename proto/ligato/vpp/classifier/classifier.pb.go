@@ -0,0 +1,642 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/classifier/classifier.proto
+
+package vpp_classifier
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NextAction defines what happens with a packet classified by a session (hit)
+// or not matched by any session of a table (miss).
+type NextAction int32
+
+const (
+	// PERMIT lets the packet continue through the VPP graph.
+	NextAction_PERMIT NextAction = 0
+	// DENY drops the packet.
+	NextAction_DENY NextAction = 1
+)
+
+// Enum value maps for NextAction.
+var (
+	NextAction_name = map[int32]string{
+		0: "PERMIT",
+		1: "DENY",
+	}
+	NextAction_value = map[string]int32{
+		"PERMIT": 0,
+		"DENY":   1,
+	}
+)
+
+func (x NextAction) Enum() *NextAction {
+	p := new(NextAction)
+	*p = x
+	return p
+}
+
+func (x NextAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NextAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_classifier_classifier_proto_enumTypes[0].Descriptor()
+}
+
+func (NextAction) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_classifier_classifier_proto_enumTypes[0]
+}
+
+func (x NextAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NextAction.Descriptor instead.
+func (NextAction) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_classifier_classifier_proto_rawDescGZIP(), []int{0}
+}
+
+// Action is an optional action performed on hit.
+type ClassifySession_Action int32
+
+const (
+	ClassifySession_NONE ClassifySession_Action = 0
+	// Set IPv4 FIB used for the packet (metadata is the VRF table ID).
+	ClassifySession_SET_IP4_FIB_INDEX ClassifySession_Action = 1
+	// Set IPv6 FIB used for the packet (metadata is the VRF table ID).
+	ClassifySession_SET_IP6_FIB_INDEX ClassifySession_Action = 2
+	// Set metadata of the packet.
+	ClassifySession_SET_METADATA ClassifySession_Action = 3
+)
+
+// Enum value maps for ClassifySession_Action.
+var (
+	ClassifySession_Action_name = map[int32]string{
+		0: "NONE",
+		1: "SET_IP4_FIB_INDEX",
+		2: "SET_IP6_FIB_INDEX",
+		3: "SET_METADATA",
+	}
+	ClassifySession_Action_value = map[string]int32{
+		"NONE":              0,
+		"SET_IP4_FIB_INDEX": 1,
+		"SET_IP6_FIB_INDEX": 2,
+		"SET_METADATA":      3,
+	}
+)
+
+func (x ClassifySession_Action) Enum() *ClassifySession_Action {
+	p := new(ClassifySession_Action)
+	*p = x
+	return p
+}
+
+func (x ClassifySession_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClassifySession_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_classifier_classifier_proto_enumTypes[1].Descriptor()
+}
+
+func (ClassifySession_Action) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_classifier_classifier_proto_enumTypes[1]
+}
+
+func (x ClassifySession_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClassifySession_Action.Descriptor instead.
+func (ClassifySession_Action) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_classifier_classifier_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type ClassifyInterface_Direction int32
+
+const (
+	ClassifyInterface_INPUT  ClassifyInterface_Direction = 0
+	ClassifyInterface_OUTPUT ClassifyInterface_Direction = 1
+)
+
+// Enum value maps for ClassifyInterface_Direction.
+var (
+	ClassifyInterface_Direction_name = map[int32]string{
+		0: "INPUT",
+		1: "OUTPUT",
+	}
+	ClassifyInterface_Direction_value = map[string]int32{
+		"INPUT":  0,
+		"OUTPUT": 1,
+	}
+)
+
+func (x ClassifyInterface_Direction) Enum() *ClassifyInterface_Direction {
+	p := new(ClassifyInterface_Direction)
+	*p = x
+	return p
+}
+
+func (x ClassifyInterface_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClassifyInterface_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_classifier_classifier_proto_enumTypes[2].Descriptor()
+}
+
+func (ClassifyInterface_Direction) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_classifier_classifier_proto_enumTypes[2]
+}
+
+func (x ClassifyInterface_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClassifyInterface_Direction.Descriptor instead.
+func (ClassifyInterface_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_classifier_classifier_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// ClassifyTable defines a VPP classify table. Packet headers are masked and
+// matched against sessions of the table.
+type ClassifyTable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is a logical name of the table used for references from sessions,
+	// chained tables and interfaces (VPP identifies tables only by index).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Mask is a hex-encoded mask applied to the packet data (after the skipped
+	// vectors) before the match. Its length must be a multiple of 16 bytes
+	// (one vector) with at most 5 vectors.
+	Mask string `protobuf:"bytes,2,opt,name=mask,proto3" json:"mask,omitempty"`
+	// SkipNVectors is the number of 16-byte vectors at the beginning of the packet
+	// data that are skipped before the mask is applied.
+	SkipNVectors uint32 `protobuf:"varint,3,opt,name=skip_n_vectors,json=skipNVectors,proto3" json:"skip_n_vectors,omitempty"`
+	// Nbuckets is the number of hash buckets of the table (default 32).
+	Nbuckets uint32 `protobuf:"varint,4,opt,name=nbuckets,proto3" json:"nbuckets,omitempty"`
+	// MemorySize is the size of memory allocated for sessions of the table
+	// in bytes (default 2MB).
+	MemorySize uint32 `protobuf:"varint,5,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
+	// NextTable is the name of the table to continue the classification with
+	// when packet is not matched by any session of this table.
+	NextTable string `protobuf:"bytes,6,opt,name=next_table,json=nextTable,proto3" json:"next_table,omitempty"`
+	// MissNext is applied to packets not matched by this (and any chained) table.
+	MissNext NextAction `protobuf:"varint,7,opt,name=miss_next,json=missNext,proto3,enum=ligato.vpp.classifier.NextAction" json:"miss_next,omitempty"`
+}
+
+func (x *ClassifyTable) Reset() {
+	*x = ClassifyTable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_classifier_classifier_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClassifyTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassifyTable) ProtoMessage() {}
+
+func (x *ClassifyTable) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_classifier_classifier_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassifyTable.ProtoReflect.Descriptor instead.
+func (*ClassifyTable) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_classifier_classifier_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClassifyTable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClassifyTable) GetMask() string {
+	if x != nil {
+		return x.Mask
+	}
+	return ""
+}
+
+func (x *ClassifyTable) GetSkipNVectors() uint32 {
+	if x != nil {
+		return x.SkipNVectors
+	}
+	return 0
+}
+
+func (x *ClassifyTable) GetNbuckets() uint32 {
+	if x != nil {
+		return x.Nbuckets
+	}
+	return 0
+}
+
+func (x *ClassifyTable) GetMemorySize() uint32 {
+	if x != nil {
+		return x.MemorySize
+	}
+	return 0
+}
+
+func (x *ClassifyTable) GetNextTable() string {
+	if x != nil {
+		return x.NextTable
+	}
+	return ""
+}
+
+func (x *ClassifyTable) GetMissNext() NextAction {
+	if x != nil {
+		return x.MissNext
+	}
+	return NextAction_PERMIT
+}
+
+// ClassifySession defines a session of a classify table.
+type ClassifySession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Table is the name of the classify table the session belongs to.
+	Table string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// Match is hex-encoded data to match the masked packet data against
+	// (without the skipped vectors). Shorter match is padded with zeros
+	// to the length of the table mask.
+	Match string `protobuf:"bytes,2,opt,name=match,proto3" json:"match,omitempty"`
+	// HitNext is applied to the packets matched by the session.
+	HitNext NextAction `protobuf:"varint,3,opt,name=hit_next,json=hitNext,proto3,enum=ligato.vpp.classifier.NextAction" json:"hit_next,omitempty"`
+	// OpaqueIndex is stored into the packet metadata on hit.
+	OpaqueIndex uint32 `protobuf:"varint,4,opt,name=opaque_index,json=opaqueIndex,proto3" json:"opaque_index,omitempty"`
+	// Advance moves the current data pointer of matched packets by the given
+	// number of bytes.
+	Advance int32                  `protobuf:"varint,5,opt,name=advance,proto3" json:"advance,omitempty"`
+	Action  ClassifySession_Action `protobuf:"varint,6,opt,name=action,proto3,enum=ligato.vpp.classifier.ClassifySession_Action" json:"action,omitempty"`
+	// Metadata is an argument of the action.
+	Metadata uint32 `protobuf:"varint,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *ClassifySession) Reset() {
+	*x = ClassifySession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_classifier_classifier_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClassifySession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassifySession) ProtoMessage() {}
+
+func (x *ClassifySession) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_classifier_classifier_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassifySession.ProtoReflect.Descriptor instead.
+func (*ClassifySession) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_classifier_classifier_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClassifySession) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *ClassifySession) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *ClassifySession) GetHitNext() NextAction {
+	if x != nil {
+		return x.HitNext
+	}
+	return NextAction_PERMIT
+}
+
+func (x *ClassifySession) GetOpaqueIndex() uint32 {
+	if x != nil {
+		return x.OpaqueIndex
+	}
+	return 0
+}
+
+func (x *ClassifySession) GetAdvance() int32 {
+	if x != nil {
+		return x.Advance
+	}
+	return 0
+}
+
+func (x *ClassifySession) GetAction() ClassifySession_Action {
+	if x != nil {
+		return x.Action
+	}
+	return ClassifySession_NONE
+}
+
+func (x *ClassifySession) GetMetadata() uint32 {
+	if x != nil {
+		return x.Metadata
+	}
+	return 0
+}
+
+// ClassifyInterface attaches classify tables to an interface to filter
+// received or transmitted packets.
+type ClassifyInterface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Interface name.
+	Interface string                      `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Direction ClassifyInterface_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=ligato.vpp.classifier.ClassifyInterface_Direction" json:"direction,omitempty"`
+	// Name of the table used to classify IPv4 packets.
+	Ip4Table string `protobuf:"bytes,3,opt,name=ip4_table,json=ip4Table,proto3" json:"ip4_table,omitempty"`
+	// Name of the table used to classify IPv6 packets.
+	Ip6Table string `protobuf:"bytes,4,opt,name=ip6_table,json=ip6Table,proto3" json:"ip6_table,omitempty"`
+	// Name of the table used to classify L2 (non-IP) packets.
+	L2Table string `protobuf:"bytes,5,opt,name=l2_table,json=l2Table,proto3" json:"l2_table,omitempty"`
+}
+
+func (x *ClassifyInterface) Reset() {
+	*x = ClassifyInterface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_classifier_classifier_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClassifyInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassifyInterface) ProtoMessage() {}
+
+func (x *ClassifyInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_classifier_classifier_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassifyInterface.ProtoReflect.Descriptor instead.
+func (*ClassifyInterface) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_classifier_classifier_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClassifyInterface) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *ClassifyInterface) GetDirection() ClassifyInterface_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return ClassifyInterface_INPUT
+}
+
+func (x *ClassifyInterface) GetIp4Table() string {
+	if x != nil {
+		return x.Ip4Table
+	}
+	return ""
+}
+
+func (x *ClassifyInterface) GetIp6Table() string {
+	if x != nil {
+		return x.Ip6Table
+	}
+	return ""
+}
+
+func (x *ClassifyInterface) GetL2Table() string {
+	if x != nil {
+		return x.L2Table
+	}
+	return ""
+}
+
+var File_ligato_vpp_classifier_classifier_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_classifier_classifier_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22,
+	0xf9, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6b, 0x69,
+	0x70, 0x5f, 0x6e, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x4e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x6e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x6e, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x6d,
+	0x69, 0x73, 0x73, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x6d, 0x69, 0x73, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x22, 0xef, 0x02, 0x0a, 0x0f,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3c, 0x0a, 0x08, 0x68,
+	0x69, 0x74, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x68, 0x69, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x61,
+	0x71, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61,
+	0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x52, 0x0a, 0x06, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x53, 0x45, 0x54, 0x5f, 0x49, 0x50, 0x34, 0x5f, 0x46, 0x49, 0x42, 0x5f, 0x49, 0x4e, 0x44,
+	0x45, 0x58, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x45, 0x54, 0x5f, 0x49, 0x50, 0x36, 0x5f,
+	0x46, 0x49, 0x42, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53,
+	0x45, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x10, 0x03, 0x22, 0xfc, 0x01,
+	0x0a, 0x11, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x50, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x70, 0x34, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x34, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x70, 0x36, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x36, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6c, 0x32, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6c, 0x32, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x22, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x01, 0x2a, 0x22, 0x0a, 0x0a,
+	0x4e, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x45,
+	0x52, 0x4d, 0x49, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x01,
+	0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_classifier_classifier_proto_rawDescOnce sync.Once
+	file_ligato_vpp_classifier_classifier_proto_rawDescData = file_ligato_vpp_classifier_classifier_proto_rawDesc
+)
+
+func file_ligato_vpp_classifier_classifier_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_classifier_classifier_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_classifier_classifier_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_classifier_classifier_proto_rawDescData)
+	})
+	return file_ligato_vpp_classifier_classifier_proto_rawDescData
+}
+
+var file_ligato_vpp_classifier_classifier_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_ligato_vpp_classifier_classifier_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ligato_vpp_classifier_classifier_proto_goTypes = []interface{}{
+	(NextAction)(0),                  // 0: ligato.vpp.classifier.NextAction
+	(ClassifySession_Action)(0),      // 1: ligato.vpp.classifier.ClassifySession.Action
+	(ClassifyInterface_Direction)(0), // 2: ligato.vpp.classifier.ClassifyInterface.Direction
+	(*ClassifyTable)(nil),            // 3: ligato.vpp.classifier.ClassifyTable
+	(*ClassifySession)(nil),          // 4: ligato.vpp.classifier.ClassifySession
+	(*ClassifyInterface)(nil),        // 5: ligato.vpp.classifier.ClassifyInterface
+}
+var file_ligato_vpp_classifier_classifier_proto_depIdxs = []int32{
+	0, // 0: ligato.vpp.classifier.ClassifyTable.miss_next:type_name -> ligato.vpp.classifier.NextAction
+	0, // 1: ligato.vpp.classifier.ClassifySession.hit_next:type_name -> ligato.vpp.classifier.NextAction
+	1, // 2: ligato.vpp.classifier.ClassifySession.action:type_name -> ligato.vpp.classifier.ClassifySession.Action
+	2, // 3: ligato.vpp.classifier.ClassifyInterface.direction:type_name -> ligato.vpp.classifier.ClassifyInterface.Direction
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_classifier_classifier_proto_init() }
+func file_ligato_vpp_classifier_classifier_proto_init() {
+	if File_ligato_vpp_classifier_classifier_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_classifier_classifier_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClassifyTable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_classifier_classifier_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClassifySession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_classifier_classifier_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClassifyInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_classifier_classifier_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_classifier_classifier_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_classifier_classifier_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_classifier_classifier_proto_enumTypes,
+		MessageInfos:      file_ligato_vpp_classifier_classifier_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_classifier_classifier_proto = out.File
+	file_ligato_vpp_classifier_classifier_proto_rawDesc = nil
+	file_ligato_vpp_classifier_classifier_proto_goTypes = nil
+	file_ligato_vpp_classifier_classifier_proto_depIdxs = nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_classifier
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.classifier"
+
+var (
+	ModelClassifyTable = models.Register(&ClassifyTable{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "table",
+		Version: "v2",
+	})
+
+	ModelClassifySession = models.Register(&ClassifySession{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "session",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"{{.Table}}/match/{{.Match}}",
+	))
+
+	ModelClassifyInterface = models.Register(&ClassifyInterface{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "interface",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"{{.Interface}}/{{.Direction}}",
+	))
+)
+
+// ClassifyTableKey returns the key used to represent configuration for VPP classify table.
+func ClassifyTableKey(name string) string {
+	return models.Key(&ClassifyTable{
+		Name: name,
+	})
+}
+
+// ClassifySessionKey returns the key used to represent configuration for session of VPP classify table.
+func ClassifySessionKey(table, match string) string {
+	return models.Key(&ClassifySession{
+		Table: table,
+		Match: match,
+	})
+}
+
+// ClassifyInterfaceKey returns the key used to represent classify tables attached to the interface.
+func ClassifyInterfaceKey(iface string, direction ClassifyInterface_Direction) string {
+	return models.Key(&ClassifyInterface{
+		Interface: iface,
+		Direction: direction,
+	})
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_classifier
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestClassifierKeys(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(ClassifyTableKey("tbl1")).To(Equal("config/vpp/classifier/v2/table/tbl1"))
+	Expect(ClassifySessionKey("tbl1", "000000000000ffff")).To(
+		Equal("config/vpp/classifier/v2/session/tbl1/match/000000000000ffff"))
+	Expect(ClassifyInterfaceKey("memif1", ClassifyInterface_INPUT)).To(
+		Equal("config/vpp/classifier/v2/interface/memif1/INPUT"))
+	Expect(ClassifyInterfaceKey("Gbe0/8/0", ClassifyInterface_OUTPUT)).To(
+		Equal("config/vpp/classifier/v2/interface/Gbe0/8/0/OUTPUT"))
+}
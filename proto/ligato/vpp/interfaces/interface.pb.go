@@ -9,6 +9,7 @@ package vpp_interfaces
 import (
 	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
 	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -43,6 +44,9 @@ const (
 	Interface_IPIP_TUNNEL       Interface_Type = 13
 	Interface_WIREGUARD_TUNNEL  Interface_Type = 14
 	Interface_RDMA              Interface_Type = 15
+	Interface_MPLS_TUNNEL       Interface_Type = 16
+	Interface_VHOST_USER        Interface_Type = 17
+	Interface_GENEVE_TUNNEL     Interface_Type = 18
 )
 
 // Enum value maps for Interface_Type.
@@ -64,6 +68,9 @@ var (
 		13: "IPIP_TUNNEL",
 		14: "WIREGUARD_TUNNEL",
 		15: "RDMA",
+		16: "MPLS_TUNNEL",
+		17: "VHOST_USER",
+		18: "GENEVE_TUNNEL",
 	}
 	Interface_Type_value = map[string]int32{
 		"UNDEFINED_TYPE":    0,
@@ -82,6 +89,9 @@ var (
 		"IPIP_TUNNEL":       13,
 		"WIREGUARD_TUNNEL":  14,
 		"RDMA":              15,
+		"MPLS_TUNNEL":       16,
+		"VHOST_USER":        17,
+		"GENEVE_TUNNEL":     18,
 	}
 )
 
@@ -770,6 +780,9 @@ type Interface struct {
 	//	*Interface_Ipip
 	//	*Interface_Wireguard
 	//	*Interface_Rdma
+	//	*Interface_MplsTunnel
+	//	*Interface_VhostUser
+	//	*Interface_Geneve
 	Link isInterface_Link `protobuf_oneof:"link"`
 }
 
@@ -988,6 +1001,27 @@ func (x *Interface) GetRdma() *RDMALink {
 	return nil
 }
 
+func (x *Interface) GetMplsTunnel() *MplsTunnelLink {
+	if x, ok := x.GetLink().(*Interface_MplsTunnel); ok {
+		return x.MplsTunnel
+	}
+	return nil
+}
+
+func (x *Interface) GetVhostUser() *VhostUserLink {
+	if x, ok := x.GetLink().(*Interface_VhostUser); ok {
+		return x.VhostUser
+	}
+	return nil
+}
+
+func (x *Interface) GetGeneve() *GeneveLink {
+	if x, ok := x.GetLink().(*Interface_Geneve); ok {
+		return x.Geneve
+	}
+	return nil
+}
+
 type isInterface_Link interface {
 	isInterface_Link()
 }
@@ -1045,6 +1079,18 @@ type Interface_Rdma struct {
 	Rdma *RDMALink `protobuf:"bytes,112,opt,name=rdma,proto3,oneof"`
 }
 
+type Interface_MplsTunnel struct {
+	MplsTunnel *MplsTunnelLink `protobuf:"bytes,113,opt,name=mpls_tunnel,json=mplsTunnel,proto3,oneof"`
+}
+
+type Interface_VhostUser struct {
+	VhostUser *VhostUserLink `protobuf:"bytes,114,opt,name=vhost_user,json=vhostUser,proto3,oneof"`
+}
+
+type Interface_Geneve struct {
+	Geneve *GeneveLink `protobuf:"bytes,115,opt,name=geneve,proto3,oneof"`
+}
+
 func (*Interface_Sub) isInterface_Link() {}
 
 func (*Interface_Memif) isInterface_Link() {}
@@ -1071,6 +1117,12 @@ func (*Interface_Wireguard) isInterface_Link() {}
 
 func (*Interface_Rdma) isInterface_Link() {}
 
+func (*Interface_MplsTunnel) isInterface_Link() {}
+
+func (*Interface_VhostUser) isInterface_Link() {}
+
+func (*Interface_Geneve) isInterface_Link() {}
+
 // SubInterface defines configuration for interface type: SUB_INTERFACE
 type SubInterface struct {
 	state         protoimpl.MessageState
@@ -2304,6 +2356,283 @@ func (x *RDMALink) GetTxqSize() uint32 {
 	return 0
 }
 
+// MplsTunnelLink defines configuration for interface type: MPLS_TUNNEL
+type MplsTunnelLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Paths of the tunnel. Each path should define outgoing interface and/or next hop
+	// together with the label stack imposed on packets sent via the tunnel.
+	Paths []*l3.Route_Path `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// L2Only enables the tunnel to carry only L2 (Ethernet) frames (e.g. for VPLS).
+	L2Only bool `protobuf:"varint,2,opt,name=l2_only,json=l2Only,proto3" json:"l2_only,omitempty"`
+	// Multicast enables the tunnel to be used as a point-to-multipoint tunnel.
+	Multicast bool `protobuf:"varint,3,opt,name=multicast,proto3" json:"multicast,omitempty"`
+	// Tag is an optional description of the tunnel.
+	Tag string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *MplsTunnelLink) Reset() {
+	*x = MplsTunnelLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MplsTunnelLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MplsTunnelLink) ProtoMessage() {}
+
+func (x *MplsTunnelLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MplsTunnelLink.ProtoReflect.Descriptor instead.
+func (*MplsTunnelLink) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MplsTunnelLink) GetPaths() []*l3.Route_Path {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *MplsTunnelLink) GetL2Only() bool {
+	if x != nil {
+		return x.L2Only
+	}
+	return false
+}
+
+func (x *MplsTunnelLink) GetMulticast() bool {
+	if x != nil {
+		return x.Multicast
+	}
+	return false
+}
+
+func (x *MplsTunnelLink) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// VhostUserLink defines configuration for interface type: VHOST_USER
+// https://github.com/FDio/vpp/blob/master/docs/usecases/vhost/vhost.rst
+type VhostUserLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SocketFilename is a path to the vhost-user socket (required).
+	SocketFilename string `protobuf:"bytes,1,opt,name=socket_filename,json=socketFilename,proto3" json:"socket_filename,omitempty"`
+	// IsServer selects the server mode, in which VPP creates the socket
+	// and waits for the guest (QEMU) to connect, otherwise VPP connects
+	// as a client to the socket created by the guest.
+	IsServer bool `protobuf:"varint,2,opt,name=is_server,json=isServer,proto3" json:"is_server,omitempty"`
+	// EnableGso enables Generic Segmentation Offload.
+	EnableGso bool `protobuf:"varint,3,opt,name=enable_gso,json=enableGso,proto3" json:"enable_gso,omitempty"`
+	// EnablePacked enables the packed ring (virtio 1.1) layout.
+	EnablePacked bool `protobuf:"varint,4,opt,name=enable_packed,json=enablePacked,proto3" json:"enable_packed,omitempty"`
+	// EnableEventIdx enables the VIRTIO_RING_F_EVENT_IDX feature.
+	EnableEventIdx bool `protobuf:"varint,5,opt,name=enable_event_idx,json=enableEventIdx,proto3" json:"enable_event_idx,omitempty"`
+	// DisableMrgRxbuf disables the VIRTIO_NET_F_MRG_RXBUF feature (mergeable Rx buffers).
+	DisableMrgRxbuf bool `protobuf:"varint,6,opt,name=disable_mrg_rxbuf,json=disableMrgRxbuf,proto3" json:"disable_mrg_rxbuf,omitempty"`
+	// DisableIndirectDesc disables the VIRTIO_F_INDIRECT_DESC feature (indirect descriptors).
+	DisableIndirectDesc bool `protobuf:"varint,7,opt,name=disable_indirect_desc,json=disableIndirectDesc,proto3" json:"disable_indirect_desc,omitempty"`
+}
+
+func (x *VhostUserLink) Reset() {
+	*x = VhostUserLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VhostUserLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VhostUserLink) ProtoMessage() {}
+
+func (x *VhostUserLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VhostUserLink.ProtoReflect.Descriptor instead.
+func (*VhostUserLink) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VhostUserLink) GetSocketFilename() string {
+	if x != nil {
+		return x.SocketFilename
+	}
+	return ""
+}
+
+func (x *VhostUserLink) GetIsServer() bool {
+	if x != nil {
+		return x.IsServer
+	}
+	return false
+}
+
+func (x *VhostUserLink) GetEnableGso() bool {
+	if x != nil {
+		return x.EnableGso
+	}
+	return false
+}
+
+func (x *VhostUserLink) GetEnablePacked() bool {
+	if x != nil {
+		return x.EnablePacked
+	}
+	return false
+}
+
+func (x *VhostUserLink) GetEnableEventIdx() bool {
+	if x != nil {
+		return x.EnableEventIdx
+	}
+	return false
+}
+
+func (x *VhostUserLink) GetDisableMrgRxbuf() bool {
+	if x != nil {
+		return x.DisableMrgRxbuf
+	}
+	return false
+}
+
+func (x *VhostUserLink) GetDisableIndirectDesc() bool {
+	if x != nil {
+		return x.DisableIndirectDesc
+	}
+	return false
+}
+
+// GeneveLink defines configuration for interface type: GENEVE_TUNNEL
+type GeneveLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SrcAddress is source VTEP address
+	SrcAddress string `protobuf:"bytes,1,opt,name=src_address,json=srcAddress,proto3" json:"src_address,omitempty"`
+	// DstAddress is destination VTEP address
+	DstAddress string `protobuf:"bytes,2,opt,name=dst_address,json=dstAddress,proto3" json:"dst_address,omitempty"`
+	// Vni stands for Virtual Network Identifier (24 bits)
+	Vni uint32 `protobuf:"varint,3,opt,name=vni,proto3" json:"vni,omitempty"`
+	// Multicast defines name of multicast interface
+	Multicast string `protobuf:"bytes,4,opt,name=multicast,proto3" json:"multicast,omitempty"`
+	// EncapVrfId is ID of the VRF table used for the encapsulated packets
+	EncapVrfId uint32 `protobuf:"varint,5,opt,name=encap_vrf_id,json=encapVrfId,proto3" json:"encap_vrf_id,omitempty"`
+	// L3Mode enables encapsulation of IP packets instead of Ethernet frames.
+	L3Mode bool `protobuf:"varint,6,opt,name=l3_mode,json=l3Mode,proto3" json:"l3_mode,omitempty"`
+}
+
+func (x *GeneveLink) Reset() {
+	*x = GeneveLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneveLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneveLink) ProtoMessage() {}
+
+func (x *GeneveLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneveLink.ProtoReflect.Descriptor instead.
+func (*GeneveLink) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GeneveLink) GetSrcAddress() string {
+	if x != nil {
+		return x.SrcAddress
+	}
+	return ""
+}
+
+func (x *GeneveLink) GetDstAddress() string {
+	if x != nil {
+		return x.DstAddress
+	}
+	return ""
+}
+
+func (x *GeneveLink) GetVni() uint32 {
+	if x != nil {
+		return x.Vni
+	}
+	return 0
+}
+
+func (x *GeneveLink) GetMulticast() string {
+	if x != nil {
+		return x.Multicast
+	}
+	return ""
+}
+
+func (x *GeneveLink) GetEncapVrfId() uint32 {
+	if x != nil {
+		return x.EncapVrfId
+	}
+	return 0
+}
+
+func (x *GeneveLink) GetL3Mode() bool {
+	if x != nil {
+		return x.L3Mode
+	}
+	return false
+}
+
 // Ip6Nd is used to enable/disable IPv6 ND address autoconfiguration
 // and setting up default routes
 type Interface_IP6ND struct {
@@ -2320,7 +2649,7 @@ type Interface_IP6ND struct {
 func (x *Interface_IP6ND) Reset() {
 	*x = Interface_IP6ND{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[14]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2333,7 +2662,7 @@ func (x *Interface_IP6ND) String() string {
 func (*Interface_IP6ND) ProtoMessage() {}
 
 func (x *Interface_IP6ND) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[14]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2376,7 +2705,7 @@ type Interface_Unnumbered struct {
 func (x *Interface_Unnumbered) Reset() {
 	*x = Interface_Unnumbered{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[15]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2389,7 +2718,7 @@ func (x *Interface_Unnumbered) String() string {
 func (*Interface_Unnumbered) ProtoMessage() {}
 
 func (x *Interface_Unnumbered) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[15]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,7 +2756,7 @@ type Interface_RxMode struct {
 func (x *Interface_RxMode) Reset() {
 	*x = Interface_RxMode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[16]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2440,7 +2769,7 @@ func (x *Interface_RxMode) String() string {
 func (*Interface_RxMode) ProtoMessage() {}
 
 func (x *Interface_RxMode) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[16]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2494,7 +2823,7 @@ type Interface_RxPlacement struct {
 func (x *Interface_RxPlacement) Reset() {
 	*x = Interface_RxPlacement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[17]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2507,7 +2836,7 @@ func (x *Interface_RxPlacement) String() string {
 func (*Interface_RxPlacement) ProtoMessage() {}
 
 func (x *Interface_RxPlacement) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[17]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2558,7 +2887,7 @@ type VxlanLink_Gpe struct {
 func (x *VxlanLink_Gpe) Reset() {
 	*x = VxlanLink_Gpe{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2571,7 +2900,7 @@ func (x *VxlanLink_Gpe) String() string {
 func (*VxlanLink_Gpe) ProtoMessage() {}
 
 func (x *VxlanLink_Gpe) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2614,7 +2943,7 @@ type BondLink_BondedInterface struct {
 func (x *BondLink_BondedInterface) Reset() {
 	*x = BondLink_BondedInterface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2627,7 +2956,7 @@ func (x *BondLink_BondedInterface) String() string {
 func (*BondLink_BondedInterface) ProtoMessage() {}
 
 func (x *BondLink_BondedInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2672,391 +3001,448 @@ var file_ligato_vpp_interfaces_interface_proto_rawDesc = []byte{
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
 	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x1c,
 	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x73, 0x65, 0x63,
-	0x2f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xac, 0x10, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x70, 0x68, 0x79, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x28, 0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52, 0x0b, 0x69,
-	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x72,
-	0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x72, 0x66, 0x12, 0x26, 0x0a, 0x0f,
-	0x73, 0x65, 0x74, 0x5f, 0x64, 0x68, 0x63, 0x70, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x44, 0x68, 0x63, 0x70, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x69, 0x70, 0x36, 0x5f, 0x6e, 0x64, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
-	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x50, 0x36, 0x4e, 0x44, 0x52, 0x05, 0x69, 0x70,
-	0x36, 0x4e, 0x64, 0x12, 0x1a, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
-	0x42, 0x08, 0x82, 0x7d, 0x05, 0x12, 0x03, 0x10, 0x80, 0x48, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12,
-	0x4b, 0x0a, 0x0a, 0x75, 0x6e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x55, 0x6e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x64,
-	0x52, 0x0a, 0x75, 0x6e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x08,
-	0x72, 0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xae, 0x12, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x79, 0x73,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x68, 0x79, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0c, 0x69,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52, 0x0b, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x72, 0x66, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x76, 0x72, 0x66, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x5f, 0x64,
+	0x68, 0x63, 0x70, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x73, 0x65, 0x74, 0x44, 0x68, 0x63, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x3d, 0x0a, 0x06, 0x69, 0x70, 0x36, 0x5f, 0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x2e, 0x49, 0x50, 0x36, 0x4e, 0x44, 0x52, 0x05, 0x69, 0x70, 0x36, 0x4e, 0x64, 0x12, 0x1a,
+	0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0x7d, 0x05,
+	0x12, 0x03, 0x10, 0x80, 0x48, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x4b, 0x0a, 0x0a, 0x75, 0x6e,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
 	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65,
 	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x2e, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x72, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x51, 0x0a, 0x0d, 0x72, 0x78, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x78, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x72, 0x78, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x03, 0x73, 0x75, 0x62, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x48, 0x00, 0x52, 0x03, 0x73, 0x75, 0x62, 0x12, 0x38, 0x0a, 0x05,
-	0x6d, 0x65, 0x6d, 0x69, 0x66, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69,
+	0x2e, 0x55, 0x6e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x64, 0x52, 0x0a, 0x75, 0x6e, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x08, 0x72, 0x78, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x78, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x07, 0x72, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0d, 0x72,
+	0x78, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x78, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0c, 0x72, 0x78, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x37,
+	0x0a, 0x03, 0x73, 0x75, 0x62, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69,
 	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52,
-	0x05, 0x6d, 0x65, 0x6d, 0x69, 0x66, 0x12, 0x41, 0x0a, 0x08, 0x61, 0x66, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x48, 0x00, 0x52, 0x03, 0x73, 0x75, 0x62, 0x12, 0x38, 0x0a, 0x05, 0x6d, 0x65, 0x6d, 0x69, 0x66,
+	0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d,
+	0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x65, 0x6d, 0x69,
+	0x66, 0x12, 0x41, 0x0a, 0x08, 0x61, 0x66, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x66, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x66, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x61, 0x66, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x03, 0x74, 0x61, 0x70, 0x18, 0x67, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x61, 0x70, 0x4c, 0x69, 0x6e,
+	0x6b, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x70, 0x12, 0x38, 0x0a, 0x05, 0x76, 0x78, 0x6c, 0x61,
+	0x6e, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e,
+	0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x76, 0x78, 0x6c,
+	0x61, 0x6e, 0x12, 0x3c, 0x0a, 0x05, 0x69, 0x70, 0x73, 0x65, 0x63, 0x18, 0x69, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x63, 0x4c,
+	0x69, 0x6e, 0x6b, 0x42, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x05, 0x69, 0x70, 0x73, 0x65, 0x63,
+	0x12, 0x3f, 0x0a, 0x08, 0x76, 0x6d, 0x78, 0x5f, 0x6e, 0x65, 0x74, 0x33, 0x18, 0x6a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x6d, 0x78, 0x4e, 0x65,
+	0x74, 0x33, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x07, 0x76, 0x6d, 0x78, 0x4e, 0x65, 0x74,
+	0x33, 0x12, 0x35, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b,
+	0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x03, 0x67, 0x72, 0x65, 0x18,
+	0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x72,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x67, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x04,
+	0x67, 0x74, 0x70, 0x75, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x2e, 0x47, 0x74, 0x70, 0x75, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x67,
+	0x74, 0x70, 0x75, 0x12, 0x35, 0x0a, 0x04, 0x69, 0x70, 0x69, 0x70, 0x18, 0x6e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x50, 0x49, 0x50, 0x4c, 0x69,
+	0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x69, 0x70, 0x69, 0x70, 0x12, 0x44, 0x0a, 0x09, 0x77, 0x69,
+	0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x12, 0x35, 0x0a, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x18, 0x70, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x44, 0x4d, 0x41, 0x4c, 0x69, 0x6e, 0x6b, 0x48,
+	0x00, 0x52, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x12, 0x48, 0x0a, 0x0b, 0x6d, 0x70, 0x6c, 0x73, 0x5f,
+	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x71, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x70, 0x6c, 0x73, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4c,
+	0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x70, 0x6c, 0x73, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x45, 0x0a, 0x0a, 0x76, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x72, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x68,
+	0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x76,
+	0x68, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x65,
+	0x76, 0x65, 0x18, 0x73, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
 	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x2e, 0x41, 0x66, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52,
-	0x08, 0x61, 0x66, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x03, 0x74, 0x61, 0x70,
-	0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x54,
-	0x61, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x70, 0x12, 0x38, 0x0a,
-	0x05, 0x76, 0x78, 0x6c, 0x61, 0x6e, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x67,
+	0x65, 0x6e, 0x65, 0x76, 0x65, 0x1a, 0x6c, 0x0a, 0x05, 0x49, 0x50, 0x36, 0x4e, 0x44, 0x12, 0x2d,
+	0x0a, 0x12, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a,
+	0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x55, 0x6e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65,
+	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x1a, 0xcf, 0x01,
+	0x0a, 0x06, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x40,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c,
 	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00,
-	0x52, 0x05, 0x76, 0x78, 0x6c, 0x61, 0x6e, 0x12, 0x3c, 0x0a, 0x05, 0x69, 0x70, 0x73, 0x65, 0x63,
-	0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x52,
+	0x78, 0x4d, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x4c, 0x4c,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55,
+	0x50, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x44, 0x41, 0x50, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x1a,
+	0x5c, 0x0a, 0x0b, 0x52, 0x78, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0xca, 0x02,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49,
+	0x4e, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x55,
+	0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x53, 0x4f, 0x46, 0x54, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x41,
+	0x43, 0x4b, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x50, 0x44, 0x4b, 0x10, 0x03, 0x12, 0x09,
+	0x0a, 0x05, 0x4d, 0x45, 0x4d, 0x49, 0x46, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x50,
+	0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x46, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10,
+	0x06, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x58, 0x4c, 0x41, 0x4e, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45,
+	0x4c, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x0c, 0x49, 0x50, 0x53, 0x45, 0x43, 0x5f, 0x54, 0x55, 0x4e,
+	0x4e, 0x45, 0x4c, 0x10, 0x08, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x4d, 0x58,
+	0x4e, 0x45, 0x54, 0x33, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x09,
+	0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41,
+	0x43, 0x45, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x52, 0x45, 0x5f, 0x54, 0x55, 0x4e, 0x4e,
+	0x45, 0x4c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x54, 0x50, 0x55, 0x5f, 0x54, 0x55, 0x4e,
+	0x4e, 0x45, 0x4c, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x50, 0x49, 0x50, 0x5f, 0x54, 0x55,
+	0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0d, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x49, 0x52, 0x45, 0x47, 0x55,
+	0x41, 0x52, 0x44, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0e, 0x12, 0x08, 0x0a, 0x04,
+	0x52, 0x44, 0x4d, 0x41, 0x10, 0x0f, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x50, 0x4c, 0x53, 0x5f, 0x54,
+	0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x10, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x48, 0x4f, 0x53, 0x54,
+	0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x11, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x45, 0x4e, 0x45, 0x56,
+	0x45, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x12, 0x42, 0x06, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x6b, 0x22, 0xfa, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x0d, 0x74,
+	0x61, 0x67, 0x5f, 0x72, 0x77, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x74, 0x61, 0x67, 0x52, 0x77,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x64,
+	0x6f, 0x74, 0x31, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75, 0x73, 0x68,
+	0x44, 0x6f, 0x74, 0x31, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x31, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x32, 0x22, 0x8f, 0x01,
+	0x0a, 0x11, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x31, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x50, 0x55, 0x53, 0x48, 0x32, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50, 0x31, 0x10,
+	0x03, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50, 0x32, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x31, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x32, 0x10, 0x06, 0x12, 0x0f, 0x0a,
+	0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x31, 0x10, 0x07, 0x12, 0x0f,
+	0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x32, 0x10, 0x08, 0x22,
+	0xe0, 0x02, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x3e, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x65,
+	0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73, 0x22, 0x32,
+	0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x45,
+	0x54, 0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x50, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x55, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x4a, 0x45, 0x43, 0x54,
+	0x10, 0x02, 0x22, 0xfa, 0x02, 0x0a, 0x09, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x26, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73, 0x72,
+	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82,
+	0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76,
+	0x6e, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74,
+	0x12, 0x36, 0x0a, 0x03, 0x67, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x2e,
+	0x47, 0x70, 0x65, 0x52, 0x03, 0x67, 0x70, 0x65, 0x1a, 0xb4, 0x01, 0x0a, 0x03, 0x47, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66,
+	0x49, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c,
+	0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x47, 0x70, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x40, 0x0a,
+	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x10, 0x01, 0x12,
+	0x07, 0x0a, 0x03, 0x49, 0x50, 0x36, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x54, 0x48, 0x45,
+	0x52, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x53, 0x48, 0x10, 0x04, 0x22,
+	0x59, 0x0a, 0x0c, 0x41, 0x66, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x07, 0x54,
+	0x61, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x6f, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x72,
+	0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x72, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a,
+	0x0c, 0x74, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x73, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x73, 0x6f, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x22, 0xb4, 0x05, 0x0a, 0x09, 0x49, 0x50, 0x53, 0x65, 0x63, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
 	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49,
-	0x50, 0x53, 0x65, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x42, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x05,
-	0x69, 0x70, 0x73, 0x65, 0x63, 0x12, 0x3f, 0x0a, 0x08, 0x76, 0x6d, 0x78, 0x5f, 0x6e, 0x65, 0x74,
-	0x33, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e,
-	0x56, 0x6d, 0x78, 0x4e, 0x65, 0x74, 0x33, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x07, 0x76,
-	0x6d, 0x78, 0x4e, 0x65, 0x74, 0x33, 0x12, 0x35, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x6b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
-	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e,
-	0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x32, 0x0a,
-	0x03, 0x67, 0x72, 0x65, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x2e, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x67, 0x72,
-	0x65, 0x12, 0x35, 0x0a, 0x04, 0x67, 0x74, 0x70, 0x75, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x50, 0x53, 0x65, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x74,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x03, 0x65, 0x73, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x03, 0x65, 0x73, 0x6e, 0x12,
+	0x23, 0x0a, 0x0b, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x61, 0x6e, 0x74, 0x69, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x12, 0x22, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x18, 0x01, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52,
+	0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x24, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x18, 0x01, 0x82,
+	0x7d, 0x02, 0x08, 0x01, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x1f,
+	0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x70, 0x69, 0x12,
+	0x21, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53,
+	0x70, 0x69, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x61, 0x6c, 0x67,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
+	0x41, 0x6c, 0x67, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x41,
+	0x6c, 0x67, 0x12, 0x2c, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4b, 0x65, 0x79,
+	0x12, 0x2e, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4b, 0x65, 0x79,
+	0x12, 0x3b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x12, 0x2a, 0x0a,
+	0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x10, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x64, 0x70,
+	0x45, 0x6e, 0x63, 0x61, 0x70, 0x22, 0x33, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x55,
+	0x4c, 0x54, 0x49, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x22, 0x64, 0x0a, 0x0b, 0x56, 0x6d,
+	0x78, 0x4e, 0x65, 0x74, 0x33, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6c, 0x6f, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78,
+	0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x78,
+	0x71, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0xf9, 0x03, 0x0a, 0x08, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x02, 0x6c, 0x62, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64,
+	0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x02, 0x6c, 0x62, 0x12, 0x5c, 0x0a, 0x11, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b,
+	0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x52, 0x10, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x0f, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x6c,
+	0x6f, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x69, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x22, 0x59, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52,
+	0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x58, 0x4f, 0x52,
+	0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10,
+	0x04, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x43, 0x50, 0x10, 0x05, 0x22, 0x3f, 0x0a, 0x0b, 0x4c,
+	0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x33, 0x34, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c,
+	0x32, 0x33, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x52, 0x52, 0x10, 0x03, 0x12, 0x06, 0x0a, 0x02,
+	0x42, 0x43, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x41, 0x42, 0x10, 0x05, 0x22, 0x86, 0x02, 0x0a,
+	0x07, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x44, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20,
+	0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x46,
+	0x69, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x33, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x54, 0x45, 0x42, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x52, 0x53,
+	0x50, 0x41, 0x4e, 0x10, 0x03, 0x22, 0xeb, 0x02, 0x0a, 0x08, 0x47, 0x74, 0x70, 0x75, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63,
+	0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x65, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x65, 0x6e, 0x63,
+	0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x64,
+	0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
 	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x74, 0x70, 0x75, 0x4c, 0x69, 0x6e, 0x6b,
-	0x48, 0x00, 0x52, 0x04, 0x67, 0x74, 0x70, 0x75, 0x12, 0x35, 0x0a, 0x04, 0x69, 0x70, 0x69, 0x70,
-	0x18, 0x6e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49,
-	0x50, 0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x69, 0x70, 0x69, 0x70, 0x12,
-	0x44, 0x0a, 0x09, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x6f, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x57, 0x69, 0x72, 0x65, 0x67,
-	0x75, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x77, 0x69, 0x72, 0x65,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x35, 0x0a, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x18, 0x70, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x44, 0x4d, 0x41,
-	0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x1a, 0x6c, 0x0a, 0x05,
-	0x49, 0x50, 0x36, 0x4e, 0x44, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x55, 0x6e,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x49, 0x70, 0x1a, 0xcf, 0x01, 0x0a, 0x06, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41,
-	0x44, 0x41, 0x50, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46,
-	0x41, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x1a, 0x5c, 0x0a, 0x0b, 0x52, 0x78, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x54, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x22, 0x96, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x0e, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10,
-	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x55, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41,
-	0x43, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4f, 0x46, 0x54, 0x57, 0x41, 0x52, 0x45,
-	0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44,
-	0x50, 0x44, 0x4b, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x4d, 0x49, 0x46, 0x10, 0x04,
-	0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x50, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x46, 0x5f,
-	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x58, 0x4c, 0x41,
-	0x4e, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x0c, 0x49, 0x50,
-	0x53, 0x45, 0x43, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x08, 0x1a, 0x02, 0x08, 0x01,
-	0x12, 0x15, 0x0a, 0x11, 0x56, 0x4d, 0x58, 0x4e, 0x45, 0x54, 0x33, 0x5f, 0x49, 0x4e, 0x54, 0x45,
-	0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4f, 0x4e, 0x44, 0x5f,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x47,
-	0x52, 0x45, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x47,
-	0x54, 0x50, 0x55, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b,
-	0x49, 0x50, 0x49, 0x50, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0d, 0x12, 0x14, 0x0a,
-	0x10, 0x57, 0x49, 0x52, 0x45, 0x47, 0x55, 0x41, 0x52, 0x44, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45,
-	0x4c, 0x10, 0x0e, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x44, 0x4d, 0x41, 0x10, 0x0f, 0x42, 0x06, 0x0a,
-	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xfa, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x59,
-	0x0a, 0x0d, 0x74, 0x61, 0x67, 0x5f, 0x72, 0x77, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75,
-	0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65,
-	0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x74, 0x61,
-	0x67, 0x52, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x73,
-	0x68, 0x5f, 0x64, 0x6f, 0x74, 0x31, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70,
-	0x75, 0x73, 0x68, 0x44, 0x6f, 0x74, 0x31, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x31,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x31, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x61, 0x67, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x32,
-	0x22, 0x8f, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x31, 0x10, 0x01, 0x12,
-	0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x32, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f,
-	0x50, 0x31, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50, 0x32, 0x10, 0x04, 0x12, 0x0f,
-	0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x31, 0x10, 0x05, 0x12,
-	0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x32, 0x10, 0x06,
-	0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x31, 0x10,
-	0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x32,
-	0x10, 0x08, 0x22, 0xe0, 0x02, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b,
-	0x12, 0x3e, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b,
-	0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x69,
-	0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x66,
-	0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x78, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x73, 0x22, 0x32, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0c,
-	0x0a, 0x08, 0x45, 0x54, 0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02,
-	0x49, 0x50, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x55, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x4a,
-	0x45, 0x43, 0x54, 0x10, 0x02, 0x22, 0xfa, 0x02, 0x0a, 0x09, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52,
-	0x0a, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0b, 0x64,
-	0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x76, 0x6e, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
-	0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63,
-	0x61, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x67, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69,
-	0x6e, 0x6b, 0x2e, 0x47, 0x70, 0x65, 0x52, 0x03, 0x67, 0x70, 0x65, 0x1a, 0xb4, 0x01, 0x0a, 0x03,
-	0x47, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70,
-	0x56, 0x72, 0x66, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e,
-	0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x47, 0x70, 0x65, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x22, 0x40, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34,
-	0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x36, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x45,
-	0x54, 0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x53, 0x48,
-	0x10, 0x04, 0x22, 0x59, 0x0a, 0x0c, 0x41, 0x66, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69,
-	0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c,
-	0x69, 0x6e, 0x75, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0xf6, 0x01,
-	0x0a, 0x07, 0x54, 0x61, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49,
-	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x5f, 0x6d, 0x69, 0x63, 0x72,
-	0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x74, 0x6f, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20,
-	0x0a, 0x0c, 0x72, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x73, 0x6f,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x73,
-	0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0xb4, 0x05, 0x0a, 0x09, 0x49, 0x50, 0x53, 0x65, 0x63,
-	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x73, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
-	0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x03,
-	0x65, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x03, 0x65,
-	0x73, 0x6e, 0x12, 0x23, 0x0a, 0x0b, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x61, 0x6e, 0x74,
-	0x69, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x22, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x18, 0x01, 0x82, 0x7d, 0x02,
-	0x08, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x24, 0x0a, 0x09, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
-	0x18, 0x01, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49,
-	0x70, 0x12, 0x1f, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53,
-	0x70, 0x69, 0x12, 0x21, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x69,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x53, 0x70, 0x69, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f,
-	0x61, 0x6c, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x43, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x41, 0x6c, 0x67, 0x12, 0x2c, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x02, 0x18, 0x01, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
-	0x18, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x4b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x61, 0x6c, 0x67,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x41,
-	0x6c, 0x67, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67,
-	0x12, 0x2a, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x10,
-	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x10, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x55, 0x64, 0x70, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x22, 0x33, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49,
-	0x4e, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f,
-	0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x22, 0x64, 0x0a,
-	0x0b, 0x56, 0x6d, 0x78, 0x4e, 0x65, 0x74, 0x33, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6c, 0x6f, 0x67, 0x12, 0x19, 0x0a,
-	0x08, 0x72, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x72, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0xf9, 0x03, 0x0a, 0x08, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x02, 0x6c, 0x62,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42,
-	0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x02, 0x6c, 0x62, 0x12, 0x5c, 0x0a, 0x11, 0x62, 0x6f, 0x6e, 0x64, 0x65,
-	0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c,
-	0x69, 0x6e, 0x6b, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x52, 0x10, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x0f, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x69, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x69, 0x73, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69,
-	0x73, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x22, 0x59, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x55, 0x4e,
-	0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x43, 0x54,
-	0x49, 0x56, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
-	0x58, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41,
-	0x53, 0x54, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x43, 0x50, 0x10, 0x05, 0x22, 0x3f,
-	0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x06, 0x0a,
-	0x02, 0x4c, 0x32, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x33, 0x34, 0x10, 0x01, 0x12, 0x07,
-	0x0a, 0x03, 0x4c, 0x32, 0x33, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x52, 0x52, 0x10, 0x03, 0x12,
-	0x06, 0x0a, 0x02, 0x42, 0x43, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x41, 0x42, 0x10, 0x05, 0x22,
-	0x86, 0x02, 0x0a, 0x07, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x44, 0x0a, 0x0b, 0x74,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
-	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x66,
-	0x69, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x75, 0x74,
-	0x65, 0x72, 0x46, 0x69, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
-	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c,
-	0x33, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x45, 0x42, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
-	0x45, 0x52, 0x53, 0x50, 0x41, 0x4e, 0x10, 0x03, 0x22, 0xeb, 0x02, 0x0a, 0x08, 0x47, 0x74, 0x70,
-	0x75, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07,
-	0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61,
+	0x2e, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x64,
+	0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x61,
+	0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x22, 0x31, 0x0a, 0x08, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10,
+	0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50,
+	0x36, 0x10, 0x03, 0x22, 0xca, 0x01, 0x0a, 0x08, 0x49, 0x50, 0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x45, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x50,
+	0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61,
 	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01,
-	0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c,
-	0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x65, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c,
-	0x65, 0x6e, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66, 0x49, 0x64, 0x12, 0x4b,
-	0x0a, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x74, 0x70, 0x75, 0x4c,
-	0x69, 0x6e, 0x6b, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x02, 0x18, 0x01,
-	0x52, 0x09, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x64,
-	0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x4e,
-	0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x08, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12,
-	0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02,
-	0x4c, 0x32, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x10, 0x02, 0x12, 0x07, 0x0a,
-	0x03, 0x49, 0x50, 0x36, 0x10, 0x03, 0x22, 0xca, 0x01, 0x0a, 0x08, 0x49, 0x50, 0x49, 0x50, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x2e, 0x49, 0x50, 0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72,
-	0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d,
-	0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08,
-	0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
-	0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x33,
-	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f,
-	0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f,
-	0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4f, 0x49, 0x4e,
-	0x54, 0x10, 0x01, 0x22, 0x71, 0x0a, 0x0d, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
-	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73,
-	0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x08, 0x52, 0x44, 0x4d, 0x41, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49,
-	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x44, 0x4d, 0x41,
-	0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x72, 0x78, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x72, 0x78, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x71, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x78, 0x71, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x21,
-	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00,
-	0x12, 0x07, 0x0a, 0x03, 0x49, 0x42, 0x56, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x44, 0x56, 0x10,
-	0x02, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69,
-	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02,
+	0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x33, 0x0a, 0x04, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f,
+	0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01,
+	0x22, 0x71, 0x0a, 0x0d, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41,
+	0x64, 0x64, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x08, 0x52, 0x44, 0x4d, 0x41, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x44, 0x4d, 0x41, 0x4c, 0x69, 0x6e,
+	0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x78, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72,
+	0x78, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x21, 0x0a, 0x04, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x49, 0x42, 0x56, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x44, 0x56, 0x10, 0x02, 0x22, 0x8a,
+	0x01, 0x0a, 0x0e, 0x4d, 0x70, 0x6c, 0x73, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x2f, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33,
+	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74,
+	0x68, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x32, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x32, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0xa3, 0x02, 0x0a, 0x0d,
+	0x56, 0x68, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x73,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47,
+	0x73, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x78, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x72, 0x67,
+	0x5f, 0x72, 0x78, 0x62, 0x75, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x72, 0x67, 0x52, 0x78, 0x62, 0x75, 0x66, 0x12, 0x32, 0x0a,
+	0x15, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x73,
+	0x63, 0x22, 0xc7, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x6e, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x26, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73, 0x72,
+	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82,
+	0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x76,
+	0x6e, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x33, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x33, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x46, 0x5a, 0x44, 0x67,
+	0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3072,7 +3458,7 @@ func file_ligato_vpp_interfaces_interface_proto_rawDescGZIP() []byte {
 }
 
 var file_ligato_vpp_interfaces_interface_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
-var file_ligato_vpp_interfaces_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_ligato_vpp_interfaces_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_ligato_vpp_interfaces_interface_proto_goTypes = []interface{}{
 	(Interface_Type)(0),                 // 0: ligato.vpp.interfaces.Interface.Type
 	(Interface_RxMode_Type)(0),          // 1: ligato.vpp.interfaces.Interface.RxMode.Type
@@ -3100,21 +3486,25 @@ var file_ligato_vpp_interfaces_interface_proto_goTypes = []interface{}{
 	(*IPIPLink)(nil),                    // 23: ligato.vpp.interfaces.IPIPLink
 	(*WireguardLink)(nil),               // 24: ligato.vpp.interfaces.WireguardLink
 	(*RDMALink)(nil),                    // 25: ligato.vpp.interfaces.RDMALink
-	(*Interface_IP6ND)(nil),             // 26: ligato.vpp.interfaces.Interface.IP6ND
-	(*Interface_Unnumbered)(nil),        // 27: ligato.vpp.interfaces.Interface.Unnumbered
-	(*Interface_RxMode)(nil),            // 28: ligato.vpp.interfaces.Interface.RxMode
-	(*Interface_RxPlacement)(nil),       // 29: ligato.vpp.interfaces.Interface.RxPlacement
-	(*VxlanLink_Gpe)(nil),               // 30: ligato.vpp.interfaces.VxlanLink.Gpe
-	(*BondLink_BondedInterface)(nil),    // 31: ligato.vpp.interfaces.BondLink.BondedInterface
-	(ipsec.CryptoAlg)(0),                // 32: ligato.vpp.ipsec.CryptoAlg
-	(ipsec.IntegAlg)(0),                 // 33: ligato.vpp.ipsec.IntegAlg
+	(*MplsTunnelLink)(nil),              // 26: ligato.vpp.interfaces.MplsTunnelLink
+	(*VhostUserLink)(nil),               // 27: ligato.vpp.interfaces.VhostUserLink
+	(*GeneveLink)(nil),                  // 28: ligato.vpp.interfaces.GeneveLink
+	(*Interface_IP6ND)(nil),             // 29: ligato.vpp.interfaces.Interface.IP6ND
+	(*Interface_Unnumbered)(nil),        // 30: ligato.vpp.interfaces.Interface.Unnumbered
+	(*Interface_RxMode)(nil),            // 31: ligato.vpp.interfaces.Interface.RxMode
+	(*Interface_RxPlacement)(nil),       // 32: ligato.vpp.interfaces.Interface.RxPlacement
+	(*VxlanLink_Gpe)(nil),               // 33: ligato.vpp.interfaces.VxlanLink.Gpe
+	(*BondLink_BondedInterface)(nil),    // 34: ligato.vpp.interfaces.BondLink.BondedInterface
+	(ipsec.CryptoAlg)(0),                // 35: ligato.vpp.ipsec.CryptoAlg
+	(ipsec.IntegAlg)(0),                 // 36: ligato.vpp.ipsec.IntegAlg
+	(*l3.Route_Path)(nil),               // 37: ligato.vpp.l3.Route.Path
 }
 var file_ligato_vpp_interfaces_interface_proto_depIdxs = []int32{
 	0,  // 0: ligato.vpp.interfaces.Interface.type:type_name -> ligato.vpp.interfaces.Interface.Type
-	26, // 1: ligato.vpp.interfaces.Interface.ip6_nd:type_name -> ligato.vpp.interfaces.Interface.IP6ND
-	27, // 2: ligato.vpp.interfaces.Interface.unnumbered:type_name -> ligato.vpp.interfaces.Interface.Unnumbered
-	28, // 3: ligato.vpp.interfaces.Interface.rx_modes:type_name -> ligato.vpp.interfaces.Interface.RxMode
-	29, // 4: ligato.vpp.interfaces.Interface.rx_placements:type_name -> ligato.vpp.interfaces.Interface.RxPlacement
+	29, // 1: ligato.vpp.interfaces.Interface.ip6_nd:type_name -> ligato.vpp.interfaces.Interface.IP6ND
+	30, // 2: ligato.vpp.interfaces.Interface.unnumbered:type_name -> ligato.vpp.interfaces.Interface.Unnumbered
+	31, // 3: ligato.vpp.interfaces.Interface.rx_modes:type_name -> ligato.vpp.interfaces.Interface.RxMode
+	32, // 4: ligato.vpp.interfaces.Interface.rx_placements:type_name -> ligato.vpp.interfaces.Interface.RxPlacement
 	13, // 5: ligato.vpp.interfaces.Interface.sub:type_name -> ligato.vpp.interfaces.SubInterface
 	14, // 6: ligato.vpp.interfaces.Interface.memif:type_name -> ligato.vpp.interfaces.MemifLink
 	16, // 7: ligato.vpp.interfaces.Interface.afpacket:type_name -> ligato.vpp.interfaces.AfpacketLink
@@ -3128,26 +3518,30 @@ var file_ligato_vpp_interfaces_interface_proto_depIdxs = []int32{
 	23, // 15: ligato.vpp.interfaces.Interface.ipip:type_name -> ligato.vpp.interfaces.IPIPLink
 	24, // 16: ligato.vpp.interfaces.Interface.wireguard:type_name -> ligato.vpp.interfaces.WireguardLink
 	25, // 17: ligato.vpp.interfaces.Interface.rdma:type_name -> ligato.vpp.interfaces.RDMALink
-	2,  // 18: ligato.vpp.interfaces.SubInterface.tag_rw_option:type_name -> ligato.vpp.interfaces.SubInterface.TagRewriteOptions
-	3,  // 19: ligato.vpp.interfaces.MemifLink.mode:type_name -> ligato.vpp.interfaces.MemifLink.MemifMode
-	30, // 20: ligato.vpp.interfaces.VxlanLink.gpe:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe
-	5,  // 21: ligato.vpp.interfaces.IPSecLink.tunnel_mode:type_name -> ligato.vpp.interfaces.IPSecLink.Mode
-	32, // 22: ligato.vpp.interfaces.IPSecLink.crypto_alg:type_name -> ligato.vpp.ipsec.CryptoAlg
-	33, // 23: ligato.vpp.interfaces.IPSecLink.integ_alg:type_name -> ligato.vpp.ipsec.IntegAlg
-	6,  // 24: ligato.vpp.interfaces.BondLink.mode:type_name -> ligato.vpp.interfaces.BondLink.Mode
-	7,  // 25: ligato.vpp.interfaces.BondLink.lb:type_name -> ligato.vpp.interfaces.BondLink.LoadBalance
-	31, // 26: ligato.vpp.interfaces.BondLink.bonded_interfaces:type_name -> ligato.vpp.interfaces.BondLink.BondedInterface
-	8,  // 27: ligato.vpp.interfaces.GreLink.tunnel_type:type_name -> ligato.vpp.interfaces.GreLink.Type
-	9,  // 28: ligato.vpp.interfaces.GtpuLink.decap_next:type_name -> ligato.vpp.interfaces.GtpuLink.NextNode
-	10, // 29: ligato.vpp.interfaces.IPIPLink.tunnel_mode:type_name -> ligato.vpp.interfaces.IPIPLink.Mode
-	11, // 30: ligato.vpp.interfaces.RDMALink.mode:type_name -> ligato.vpp.interfaces.RDMALink.Mode
-	1,  // 31: ligato.vpp.interfaces.Interface.RxMode.mode:type_name -> ligato.vpp.interfaces.Interface.RxMode.Type
-	4,  // 32: ligato.vpp.interfaces.VxlanLink.Gpe.protocol:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe.Protocol
-	33, // [33:33] is the sub-list for method output_type
-	33, // [33:33] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	26, // 18: ligato.vpp.interfaces.Interface.mpls_tunnel:type_name -> ligato.vpp.interfaces.MplsTunnelLink
+	27, // 19: ligato.vpp.interfaces.Interface.vhost_user:type_name -> ligato.vpp.interfaces.VhostUserLink
+	28, // 20: ligato.vpp.interfaces.Interface.geneve:type_name -> ligato.vpp.interfaces.GeneveLink
+	2,  // 21: ligato.vpp.interfaces.SubInterface.tag_rw_option:type_name -> ligato.vpp.interfaces.SubInterface.TagRewriteOptions
+	3,  // 22: ligato.vpp.interfaces.MemifLink.mode:type_name -> ligato.vpp.interfaces.MemifLink.MemifMode
+	33, // 23: ligato.vpp.interfaces.VxlanLink.gpe:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe
+	5,  // 24: ligato.vpp.interfaces.IPSecLink.tunnel_mode:type_name -> ligato.vpp.interfaces.IPSecLink.Mode
+	35, // 25: ligato.vpp.interfaces.IPSecLink.crypto_alg:type_name -> ligato.vpp.ipsec.CryptoAlg
+	36, // 26: ligato.vpp.interfaces.IPSecLink.integ_alg:type_name -> ligato.vpp.ipsec.IntegAlg
+	6,  // 27: ligato.vpp.interfaces.BondLink.mode:type_name -> ligato.vpp.interfaces.BondLink.Mode
+	7,  // 28: ligato.vpp.interfaces.BondLink.lb:type_name -> ligato.vpp.interfaces.BondLink.LoadBalance
+	34, // 29: ligato.vpp.interfaces.BondLink.bonded_interfaces:type_name -> ligato.vpp.interfaces.BondLink.BondedInterface
+	8,  // 30: ligato.vpp.interfaces.GreLink.tunnel_type:type_name -> ligato.vpp.interfaces.GreLink.Type
+	9,  // 31: ligato.vpp.interfaces.GtpuLink.decap_next:type_name -> ligato.vpp.interfaces.GtpuLink.NextNode
+	10, // 32: ligato.vpp.interfaces.IPIPLink.tunnel_mode:type_name -> ligato.vpp.interfaces.IPIPLink.Mode
+	11, // 33: ligato.vpp.interfaces.RDMALink.mode:type_name -> ligato.vpp.interfaces.RDMALink.Mode
+	37, // 34: ligato.vpp.interfaces.MplsTunnelLink.paths:type_name -> ligato.vpp.l3.Route.Path
+	1,  // 35: ligato.vpp.interfaces.Interface.RxMode.mode:type_name -> ligato.vpp.interfaces.Interface.RxMode.Type
+	4,  // 36: ligato.vpp.interfaces.VxlanLink.Gpe.protocol:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe.Protocol
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_interfaces_interface_proto_init() }
@@ -3325,7 +3719,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface_IP6ND); i {
+			switch v := v.(*MplsTunnelLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3337,7 +3731,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface_Unnumbered); i {
+			switch v := v.(*VhostUserLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3349,7 +3743,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface_RxMode); i {
+			switch v := v.(*GeneveLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3361,7 +3755,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface_RxPlacement); i {
+			switch v := v.(*Interface_IP6ND); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3373,7 +3767,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VxlanLink_Gpe); i {
+			switch v := v.(*Interface_Unnumbered); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3385,6 +3779,42 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_RxMode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_RxPlacement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VxlanLink_Gpe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BondLink_BondedInterface); i {
 			case 0:
 				return &v.state
@@ -3411,6 +3841,9 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 		(*Interface_Ipip)(nil),
 		(*Interface_Wireguard)(nil),
 		(*Interface_Rdma)(nil),
+		(*Interface_MplsTunnel)(nil),
+		(*Interface_VhostUser)(nil),
+		(*Interface_Geneve)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3418,7 +3851,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_interfaces_interface_proto_rawDesc,
 			NumEnums:      12,
-			NumMessages:   20,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
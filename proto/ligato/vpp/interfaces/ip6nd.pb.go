@@ -0,0 +1,493 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/interfaces/ip6nd.proto
+
+package vpp_interfaces
+
+import (
+	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// IP6RouterAdvertisement configures IPv6 router advertisements (RA)
+// sent by VPP on the given interface. The interface must have IPv6 enabled
+// (i.e. at least one IPv6 address assigned).
+// Zero values of intervals, lifetime and initial count/interval keep VPP defaults.
+type IP6RouterAdvertisement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the interface to configure router advertisements on.
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Suppress disables sending of periodic router advertisements.
+	Suppress bool `protobuf:"varint,2,opt,name=suppress,proto3" json:"suppress,omitempty"`
+	// Managed sets the "managed address configuration" (M) flag.
+	Managed bool `protobuf:"varint,3,opt,name=managed,proto3" json:"managed,omitempty"`
+	// Other sets the "other configuration" (O) flag.
+	Other bool `protobuf:"varint,4,opt,name=other,proto3" json:"other,omitempty"`
+	// LlOption includes the source link-layer address option.
+	LlOption bool `protobuf:"varint,5,opt,name=ll_option,json=llOption,proto3" json:"ll_option,omitempty"`
+	// SendUnicast sends advertisements as unicast to the soliciting node.
+	SendUnicast bool `protobuf:"varint,6,opt,name=send_unicast,json=sendUnicast,proto3" json:"send_unicast,omitempty"`
+	// Cease advertises zero router lifetime (router is not a default router).
+	Cease bool `protobuf:"varint,7,opt,name=cease,proto3" json:"cease,omitempty"`
+	// MaxInterval is maximum time in seconds between unsolicited advertisements.
+	MaxInterval uint32 `protobuf:"varint,8,opt,name=max_interval,json=maxInterval,proto3" json:"max_interval,omitempty"`
+	// MinInterval is minimum time in seconds between unsolicited advertisements.
+	MinInterval uint32 `protobuf:"varint,9,opt,name=min_interval,json=minInterval,proto3" json:"min_interval,omitempty"`
+	// Lifetime is the router lifetime in seconds.
+	Lifetime uint32 `protobuf:"varint,10,opt,name=lifetime,proto3" json:"lifetime,omitempty"`
+	// InitialCount is number of initial advertisements sent after enabling.
+	InitialCount uint32 `protobuf:"varint,11,opt,name=initial_count,json=initialCount,proto3" json:"initial_count,omitempty"`
+	// InitialInterval is interval in seconds between initial advertisements.
+	InitialInterval uint32                           `protobuf:"varint,12,opt,name=initial_interval,json=initialInterval,proto3" json:"initial_interval,omitempty"`
+	Prefixes        []*IP6RouterAdvertisement_Prefix `protobuf:"bytes,13,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (x *IP6RouterAdvertisement) Reset() {
+	*x = IP6RouterAdvertisement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_ip6nd_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IP6RouterAdvertisement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IP6RouterAdvertisement) ProtoMessage() {}
+
+func (x *IP6RouterAdvertisement) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_ip6nd_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IP6RouterAdvertisement.ProtoReflect.Descriptor instead.
+func (*IP6RouterAdvertisement) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_ip6nd_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IP6RouterAdvertisement) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *IP6RouterAdvertisement) GetSuppress() bool {
+	if x != nil {
+		return x.Suppress
+	}
+	return false
+}
+
+func (x *IP6RouterAdvertisement) GetManaged() bool {
+	if x != nil {
+		return x.Managed
+	}
+	return false
+}
+
+func (x *IP6RouterAdvertisement) GetOther() bool {
+	if x != nil {
+		return x.Other
+	}
+	return false
+}
+
+func (x *IP6RouterAdvertisement) GetLlOption() bool {
+	if x != nil {
+		return x.LlOption
+	}
+	return false
+}
+
+func (x *IP6RouterAdvertisement) GetSendUnicast() bool {
+	if x != nil {
+		return x.SendUnicast
+	}
+	return false
+}
+
+func (x *IP6RouterAdvertisement) GetCease() bool {
+	if x != nil {
+		return x.Cease
+	}
+	return false
+}
+
+func (x *IP6RouterAdvertisement) GetMaxInterval() uint32 {
+	if x != nil {
+		return x.MaxInterval
+	}
+	return 0
+}
+
+func (x *IP6RouterAdvertisement) GetMinInterval() uint32 {
+	if x != nil {
+		return x.MinInterval
+	}
+	return 0
+}
+
+func (x *IP6RouterAdvertisement) GetLifetime() uint32 {
+	if x != nil {
+		return x.Lifetime
+	}
+	return 0
+}
+
+func (x *IP6RouterAdvertisement) GetInitialCount() uint32 {
+	if x != nil {
+		return x.InitialCount
+	}
+	return 0
+}
+
+func (x *IP6RouterAdvertisement) GetInitialInterval() uint32 {
+	if x != nil {
+		return x.InitialInterval
+	}
+	return 0
+}
+
+func (x *IP6RouterAdvertisement) GetPrefixes() []*IP6RouterAdvertisement_Prefix {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+// IP6NDProxy configures VPP to answer neighbor solicitations on the given
+// interface on behalf of the given IPv6 address.
+type IP6NDProxy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the interface to proxy neighbor discovery on.
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Address is the proxied IPv6 address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *IP6NDProxy) Reset() {
+	*x = IP6NDProxy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_ip6nd_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IP6NDProxy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IP6NDProxy) ProtoMessage() {}
+
+func (x *IP6NDProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_ip6nd_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IP6NDProxy.ProtoReflect.Descriptor instead.
+func (*IP6NDProxy) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_ip6nd_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IP6NDProxy) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *IP6NDProxy) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// Prefix is a prefix advertised in router advertisements.
+type IP6RouterAdvertisement_Prefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Address is IPv6 prefix in CIDR notation.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// ValidLifetime is valid lifetime of the prefix in seconds.
+	// If both lifetimes are zero, VPP defaults are used.
+	ValidLifetime uint32 `protobuf:"varint,2,opt,name=valid_lifetime,json=validLifetime,proto3" json:"valid_lifetime,omitempty"`
+	// PreferredLifetime is preferred lifetime of the prefix in seconds.
+	// Must not exceed the valid lifetime.
+	PreferredLifetime uint32 `protobuf:"varint,3,opt,name=preferred_lifetime,json=preferredLifetime,proto3" json:"preferred_lifetime,omitempty"`
+	// OffLink clears the on-link (L) flag.
+	OffLink bool `protobuf:"varint,4,opt,name=off_link,json=offLink,proto3" json:"off_link,omitempty"`
+	// NoAutoconfig clears the autonomous address-configuration (A) flag.
+	NoAutoconfig bool `protobuf:"varint,5,opt,name=no_autoconfig,json=noAutoconfig,proto3" json:"no_autoconfig,omitempty"`
+	// NoAdvertise excludes the prefix from advertisements.
+	NoAdvertise bool `protobuf:"varint,6,opt,name=no_advertise,json=noAdvertise,proto3" json:"no_advertise,omitempty"`
+}
+
+func (x *IP6RouterAdvertisement_Prefix) Reset() {
+	*x = IP6RouterAdvertisement_Prefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_ip6nd_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IP6RouterAdvertisement_Prefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IP6RouterAdvertisement_Prefix) ProtoMessage() {}
+
+func (x *IP6RouterAdvertisement_Prefix) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_ip6nd_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IP6RouterAdvertisement_Prefix.ProtoReflect.Descriptor instead.
+func (*IP6RouterAdvertisement_Prefix) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_ip6nd_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *IP6RouterAdvertisement_Prefix) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *IP6RouterAdvertisement_Prefix) GetValidLifetime() uint32 {
+	if x != nil {
+		return x.ValidLifetime
+	}
+	return 0
+}
+
+func (x *IP6RouterAdvertisement_Prefix) GetPreferredLifetime() uint32 {
+	if x != nil {
+		return x.PreferredLifetime
+	}
+	return 0
+}
+
+func (x *IP6RouterAdvertisement_Prefix) GetOffLink() bool {
+	if x != nil {
+		return x.OffLink
+	}
+	return false
+}
+
+func (x *IP6RouterAdvertisement_Prefix) GetNoAutoconfig() bool {
+	if x != nil {
+		return x.NoAutoconfig
+	}
+	return false
+}
+
+func (x *IP6RouterAdvertisement_Prefix) GetNoAdvertise() bool {
+	if x != nil {
+		return x.NoAdvertise
+	}
+	return false
+}
+
+var File_ligato_vpp_interfaces_ip6nd_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_interfaces_ip6nd_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x69, 0x70, 0x36, 0x6e, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x05, 0x0a, 0x16, 0x49, 0x50, 0x36, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6c, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x6c,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x75,
+	0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x65,
+	0x6e, 0x64, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x61,
+	0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0x7d, 0x05, 0x12, 0x03, 0x10, 0x88, 0x0e, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x0c,
+	0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x08, 0x82, 0x7d, 0x05, 0x12, 0x03, 0x10, 0xc6, 0x0a, 0x52, 0x0b, 0x6d, 0x69,
+	0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x08, 0x6c, 0x69, 0x66,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82, 0x7d, 0x05,
+	0x12, 0x03, 0x10, 0xa8, 0x46, 0x52, 0x08, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x50, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x50, 0x36, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x1a, 0xe2, 0x01, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1f, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82,
+	0x7d, 0x02, 0x08, 0x06, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4c, 0x69, 0x66, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x23,
+	0x0a, 0x0d, 0x6e, 0x6f, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x6f, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x5f, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x41, 0x64, 0x76,
+	0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x0a, 0x49, 0x50, 0x36, 0x4e, 0x44, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x12, 0x1f, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x03, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70,
+	0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x3b, 0x76, 0x70, 0x70,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_interfaces_ip6nd_proto_rawDescOnce sync.Once
+	file_ligato_vpp_interfaces_ip6nd_proto_rawDescData = file_ligato_vpp_interfaces_ip6nd_proto_rawDesc
+)
+
+func file_ligato_vpp_interfaces_ip6nd_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_interfaces_ip6nd_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_interfaces_ip6nd_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_interfaces_ip6nd_proto_rawDescData)
+	})
+	return file_ligato_vpp_interfaces_ip6nd_proto_rawDescData
+}
+
+var file_ligato_vpp_interfaces_ip6nd_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ligato_vpp_interfaces_ip6nd_proto_goTypes = []interface{}{
+	(*IP6RouterAdvertisement)(nil),        // 0: ligato.vpp.interfaces.IP6RouterAdvertisement
+	(*IP6NDProxy)(nil),                    // 1: ligato.vpp.interfaces.IP6NDProxy
+	(*IP6RouterAdvertisement_Prefix)(nil), // 2: ligato.vpp.interfaces.IP6RouterAdvertisement.Prefix
+}
+var file_ligato_vpp_interfaces_ip6nd_proto_depIdxs = []int32{
+	2, // 0: ligato.vpp.interfaces.IP6RouterAdvertisement.prefixes:type_name -> ligato.vpp.interfaces.IP6RouterAdvertisement.Prefix
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_interfaces_ip6nd_proto_init() }
+func file_ligato_vpp_interfaces_ip6nd_proto_init() {
+	if File_ligato_vpp_interfaces_ip6nd_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_interfaces_ip6nd_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IP6RouterAdvertisement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_ip6nd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IP6NDProxy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_ip6nd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IP6RouterAdvertisement_Prefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_interfaces_ip6nd_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_interfaces_ip6nd_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_interfaces_ip6nd_proto_depIdxs,
+		MessageInfos:      file_ligato_vpp_interfaces_ip6nd_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_interfaces_ip6nd_proto = out.File
+	file_ligato_vpp_interfaces_ip6nd_proto_rawDesc = nil
+	file_ligato_vpp_interfaces_ip6nd_proto_goTypes = nil
+	file_ligato_vpp_interfaces_ip6nd_proto_depIdxs = nil
+}
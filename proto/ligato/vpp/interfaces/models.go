@@ -37,6 +37,18 @@ var (
 		Version: "v2",
 		Type:    "span",
 	}, models.WithNameTemplate("{{.InterfaceFrom}}/to/{{.InterfaceTo}}"))
+
+	ModelIP6RouterAdvertisement = models.Register(&IP6RouterAdvertisement{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "ip6-ra",
+	}, models.WithNameTemplate("{{.Interface}}"))
+
+	ModelIP6NDProxy = models.Register(&IP6NDProxy{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "ip6nd-proxy",
+	}, models.WithNameTemplate("{{.Interface}}/{{ip .Address}}"))
 )
 
 // InterfaceKey returns the key used in NB DB to store the configuration of the
@@ -56,6 +68,23 @@ func SpanKey(ifaceFrom, ifaceTo string) string {
 	})
 }
 
+// IP6RouterAdvertisementKey returns the key used in NB DB to store
+// the router advertisement configuration of the given vpp interface.
+func IP6RouterAdvertisementKey(iface string) string {
+	return models.Key(&IP6RouterAdvertisement{
+		Interface: iface,
+	})
+}
+
+// IP6NDProxyKey returns the key used in NB DB to store the IPv6 ND proxy
+// entry for the given vpp interface and address.
+func IP6NDProxyKey(iface, address string) string {
+	return models.Key(&IP6NDProxy{
+		Interface: iface,
+		Address:   address,
+	})
+}
+
 /* Interface State */
 const (
 	// StatePrefix is a key prefix used in NB DB to store interface states.
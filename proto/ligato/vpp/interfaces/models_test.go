@@ -1306,3 +1306,70 @@ func TestParseInterfaceWithIPKey(t *testing.T) {
 		})
 	}
 }
+
+func TestIP6RouterAdvertisementKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       string
+		expectedKey string
+	}{
+		{
+			name:        "memif",
+			iface:       "memif0",
+			expectedKey: "config/vpp/v2/ip6-ra/memif0",
+		},
+		{
+			name:        "Gbe interface",
+			iface:       "GigabitEthernet0/8/0",
+			expectedKey: "config/vpp/v2/ip6-ra/GigabitEthernet0/8/0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := IP6RouterAdvertisementKey(test.iface)
+			if key != test.expectedKey {
+				t.Errorf("failed for: iface=%s\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.iface, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestIP6NDProxyKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       string
+		address     string
+		expectedKey string
+	}{
+		{
+			name:        "memif",
+			iface:       "memif0",
+			address:     "2001:db8::1",
+			expectedKey: "config/vpp/v2/ip6nd-proxy/memif0/2001:db8::1",
+		},
+		{
+			name:        "non-canonical address",
+			iface:       "memif0",
+			address:     "2001:0db8:0000::0001",
+			expectedKey: "config/vpp/v2/ip6nd-proxy/memif0/2001:db8::1",
+		},
+		{
+			name:        "invalid address",
+			iface:       "memif0",
+			address:     "invalid-ip",
+			expectedKey: "config/vpp/v2/ip6nd-proxy/memif0/<invalid>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := IP6NDProxyKey(test.iface, test.address)
+			if key != test.expectedKey {
+				t.Errorf("failed for: iface=%s address=%s\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.iface, test.address, test.expectedKey, key)
+			}
+		})
+	}
+}
@@ -42,7 +42,7 @@ var (
 			`vrf/{{.VrfId}}/`+
 			`{{with ipnet .DstNetwork}}{{printf "dst/%s/%d/" .IP .MaskSize}}`+
 			`{{else}}{{printf "dst/%s/" .DstNetwork}}{{end}}`+
-			`{{if .NextHopAddr}}gw/{{.NextHopAddr}}{{end}}`,
+			`{{if .Paths}}multipath{{else if .NextHopAddr}}gw/{{.NextHopAddr}}{{end}}`,
 	))
 
 	ModelProxyARP = models.Register(&ProxyARP{}, models.Spec{
@@ -118,6 +118,16 @@ func RouteKey(iface string, vrf uint32, dstNet string, nextHopAddr string) strin
 	})
 }
 
+// MultipathRouteKey returns the key used in ETCD to store vpp multipath route
+// (route defined with paths) for vpp instance.
+func MultipathRouteKey(vrf uint32, dstNet string) string {
+	return models.Key(&Route{
+		VrfId:      vrf,
+		DstNetwork: dstNet,
+		Paths:      []*Route_Path{{}},
+	})
+}
+
 // ArpEntryKey returns the key to store ARP entry
 func ArpEntryKey(iface, ipAddr string) string {
 	return models.Key(&ARPEntry{
@@ -176,11 +186,18 @@ func RouteVrfPrefix(vrf uint32) string {
 	return ModelRoute.KeyPrefix() + "vrf/" + fmt.Sprint(vrf) + "/"
 }
 
+// multipathKeySuffix is the last item of the key of multipath route.
+const multipathKeySuffix = "multipath"
+
 // ParseRouteKey parses VRF label and route address from a route key.
 func ParseRouteKey(key string) (outIface, vrfIndex, dstNet, nextHopAddr string, isRouteKey bool) {
 	if routeKey := strings.TrimPrefix(key, ModelRoute.KeyPrefix()); routeKey != key {
 		var foundVrf, foundDst bool
 		keyParts := strings.Split(routeKey, "/")
+		if last := len(keyParts) - 1; keyParts[last] == multipathKeySuffix {
+			// multipath route has no next hop in the key
+			keyParts = keyParts[:last]
+		}
 		outIface, _ = getRouteKeyItem(keyParts, "if", "vrf")
 		vrfIndex, foundVrf = getRouteKeyItem(keyParts, "vrf", "dst")
 		dstNet, foundDst = getRouteKeyItem(keyParts, "dst", "gw")
@@ -118,6 +118,18 @@ func TestRouteKey(t *testing.T) {
 			},
 			"config/vpp/v2/route/vrf/3/dst/10.0.0.0/8",
 		},
+		{
+			"route-multipath",
+			Route{
+				VrfId:      0,
+				DstNetwork: "10.0.0.0/8",
+				Paths: []*Route_Path{
+					{NextHopAddr: "192.168.1.1", OutgoingInterface: "iface1"},
+					{NextHopAddr: "192.168.2.1", OutgoingInterface: "iface2"},
+				},
+			},
+			"config/vpp/v2/route/vrf/0/dst/10.0.0.0/8/multipath",
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -174,6 +186,13 @@ func TestParseRouteKey(t *testing.T) {
 			expectedVrfIndex:   "0",
 			expectedDstNet:     "2001:db8::/32",
 		},
+		{
+			name:               "multipath",
+			routeKey:           "config/vpp/v2/route/vrf/1/dst/10.10.0.0/16/multipath",
+			expectedIsRouteKey: true,
+			expectedVrfIndex:   "1",
+			expectedDstNet:     "10.10.0.0/16",
+		},
 		{
 			name:               "invalid-key-missing-dst",
 			routeKey:           "config/vpp/v2/route/vrf/0/10.10.0.0/16/gw/0.0.0.0",
@@ -8,6 +8,7 @@ package vpp_l3
 
 import (
 	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -102,6 +103,17 @@ type Route struct {
 	Preference uint32 `protobuf:"varint,7,opt,name=preference,proto3" json:"preference,omitempty"`
 	// Specifies VRF ID for the next hop lookup / recursive lookup
 	ViaVrfId uint32 `protobuf:"varint,8,opt,name=via_vrf_id,json=viaVrfId,proto3" json:"via_vrf_id,omitempty"`
+	// Paths of a multipath route. If defined, the route is installed with all the paths
+	// at once and next_hop_addr, outgoing_interface, weight, preference and via_vrf_id
+	// must be left undefined. Multipath route is identified only by VRF and destination
+	// network (key ends with "/multipath"). When the paths change, the route is updated
+	// with the full new set of paths in a single request (not path by path).
+	Paths []*Route_Path `protobuf:"bytes,9,rep,name=paths,proto3" json:"paths,omitempty"`
+	// BfdSession optionally references BFD session (only interface, local_ip, peer_ip
+	// and multihop are used to identify it), which must be up for the route to be
+	// installed. The route is removed when the session goes down and re-installed
+	// once it is up again.
+	BfdSession *bfd.BfdSession `protobuf:"bytes,11,opt,name=bfd_session,json=bfdSession,proto3" json:"bfd_session,omitempty"`
 }
 
 func (x *Route) Reset() {
@@ -192,6 +204,114 @@ func (x *Route) GetViaVrfId() uint32 {
 	return 0
 }
 
+func (x *Route) GetPaths() []*Route_Path {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *Route) GetBfdSession() *bfd.BfdSession {
+	if x != nil {
+		return x.BfdSession
+	}
+	return nil
+}
+
+// Path is one of the paths of a multipath (ECMP/UCMP) route.
+type Route_Path struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Next hop address.
+	NextHopAddr string `protobuf:"bytes,1,opt,name=next_hop_addr,json=nextHopAddr,proto3" json:"next_hop_addr,omitempty"`
+	// Interface name of the outgoing interface.
+	OutgoingInterface string `protobuf:"bytes,2,opt,name=outgoing_interface,json=outgoingInterface,proto3" json:"outgoing_interface,omitempty"`
+	// Weight is used for unequal cost load balancing (default is 1).
+	Weight uint32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Preference defines path preference. Lower preference is preferred.
+	Preference uint32 `protobuf:"varint,4,opt,name=preference,proto3" json:"preference,omitempty"`
+	// Specifies VRF ID for the next hop lookup / recursive lookup (INTER_VRF route only).
+	ViaVrfId uint32 `protobuf:"varint,5,opt,name=via_vrf_id,json=viaVrfId,proto3" json:"via_vrf_id,omitempty"`
+	// MPLS labels imposed on packets forwarded via this path (outermost label first).
+	LabelStack []uint32 `protobuf:"varint,6,rep,packed,name=label_stack,json=labelStack,proto3" json:"label_stack,omitempty"`
+}
+
+func (x *Route_Path) Reset() {
+	*x = Route_Path{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_l3_route_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Route_Path) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route_Path) ProtoMessage() {}
+
+func (x *Route_Path) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_l3_route_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route_Path.ProtoReflect.Descriptor instead.
+func (*Route_Path) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_l3_route_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Route_Path) GetNextHopAddr() string {
+	if x != nil {
+		return x.NextHopAddr
+	}
+	return ""
+}
+
+func (x *Route_Path) GetOutgoingInterface() string {
+	if x != nil {
+		return x.OutgoingInterface
+	}
+	return ""
+}
+
+func (x *Route_Path) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Route_Path) GetPreference() uint32 {
+	if x != nil {
+		return x.Preference
+	}
+	return 0
+}
+
+func (x *Route_Path) GetViaVrfId() uint32 {
+	if x != nil {
+		return x.ViaVrfId
+	}
+	return 0
+}
+
+func (x *Route_Path) GetLabelStack() []uint32 {
+	if x != nil {
+		return x.LabelStack
+	}
+	return nil
+}
+
 var File_ligato_vpp_l3_route_proto protoreflect.FileDescriptor
 
 var file_ligato_vpp_l3_route_proto_rawDesc = []byte{
@@ -199,33 +319,55 @@ var file_ligato_vpp_l3_route_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6c, 0x69, 0x67,
 	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61,
 	0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x02, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x32,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x76, 0x72, 0x66, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x73, 0x74,
-	0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
-	0x82, 0x7d, 0x02, 0x08, 0x04, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x12, 0x29, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52,
-	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2d, 0x0a, 0x12,
-	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69,
-	0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x76, 0x69, 0x61, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x76, 0x69, 0x61, 0x56, 0x72, 0x66, 0x49,
-	0x64, 0x22, 0x33, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d,
-	0x0a, 0x09, 0x49, 0x4e, 0x54, 0x52, 0x41, 0x5f, 0x56, 0x52, 0x46, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x56, 0x52, 0x46, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
-	0x44, 0x52, 0x4f, 0x50, 0x10, 0x02, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x33, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
+	0x2f, 0x62, 0x66, 0x64, 0x2f, 0x62, 0x66, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7,
+	0x05, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72,
+	0x66, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52,
+	0x0a, 0x64, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x29, 0x0a, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x48,
+	0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a,
+	0x0a, 0x76, 0x69, 0x61, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x76, 0x69, 0x61, 0x56, 0x72, 0x66, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x3b, 0x0a, 0x0b,
+	0x62, 0x66, 0x64, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x62,
+	0x66, 0x64, 0x2e, 0x42, 0x66, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x62,
+	0x66, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xd7, 0x01, 0x0a, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x29, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01,
+	0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2d, 0x0a,
+	0x12, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x76, 0x69, 0x61, 0x5f, 0x76, 0x72, 0x66, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x76, 0x69, 0x61, 0x56, 0x72, 0x66,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x74,
+	0x61, 0x63, 0x6b, 0x22, 0x33, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x52, 0x41, 0x5f, 0x56, 0x52, 0x46, 0x10, 0x00, 0x12,
+	0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x56, 0x52, 0x46, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x02, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x33,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -241,18 +383,22 @@ func file_ligato_vpp_l3_route_proto_rawDescGZIP() []byte {
 }
 
 var file_ligato_vpp_l3_route_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ligato_vpp_l3_route_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ligato_vpp_l3_route_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_ligato_vpp_l3_route_proto_goTypes = []interface{}{
-	(Route_RouteType)(0), // 0: ligato.vpp.l3.Route.RouteType
-	(*Route)(nil),        // 1: ligato.vpp.l3.Route
+	(Route_RouteType)(0),   // 0: ligato.vpp.l3.Route.RouteType
+	(*Route)(nil),          // 1: ligato.vpp.l3.Route
+	(*Route_Path)(nil),     // 2: ligato.vpp.l3.Route.Path
+	(*bfd.BfdSession)(nil), // 3: ligato.vpp.bfd.BfdSession
 }
 var file_ligato_vpp_l3_route_proto_depIdxs = []int32{
 	0, // 0: ligato.vpp.l3.Route.type:type_name -> ligato.vpp.l3.Route.RouteType
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: ligato.vpp.l3.Route.paths:type_name -> ligato.vpp.l3.Route.Path
+	3, // 2: ligato.vpp.l3.Route.bfd_session:type_name -> ligato.vpp.bfd.BfdSession
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_l3_route_proto_init() }
@@ -273,6 +419,18 @@ func file_ligato_vpp_l3_route_proto_init() {
 				return nil
 			}
 		}
+		file_ligato_vpp_l3_route_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Route_Path); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -280,7 +438,7 @@ func file_ligato_vpp_l3_route_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_l3_route_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,347 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/linuxcp/linuxcp.proto
+
+package vpp_linuxcp
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LinuxCpPair_HostIfType int32
+
+const (
+	LinuxCpPair_TAP LinuxCpPair_HostIfType = 0
+	LinuxCpPair_TUN LinuxCpPair_HostIfType = 1
+)
+
+// Enum value maps for LinuxCpPair_HostIfType.
+var (
+	LinuxCpPair_HostIfType_name = map[int32]string{
+		0: "TAP",
+		1: "TUN",
+	}
+	LinuxCpPair_HostIfType_value = map[string]int32{
+		"TAP": 0,
+		"TUN": 1,
+	}
+)
+
+func (x LinuxCpPair_HostIfType) Enum() *LinuxCpPair_HostIfType {
+	p := new(LinuxCpPair_HostIfType)
+	*p = x
+	return p
+}
+
+func (x LinuxCpPair_HostIfType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinuxCpPair_HostIfType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_linuxcp_linuxcp_proto_enumTypes[0].Descriptor()
+}
+
+func (LinuxCpPair_HostIfType) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_linuxcp_linuxcp_proto_enumTypes[0]
+}
+
+func (x LinuxCpPair_HostIfType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinuxCpPair_HostIfType.Descriptor instead.
+func (LinuxCpPair_HostIfType) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_linuxcp_linuxcp_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// LinuxCpPair mirrors VPP interface into Linux using the VPP linux-cp plugin.
+// VPP creates TAP (or TUN) interface on the host side with the given name
+// and keeps it paired with the VPP interface.
+// The host side is represented by Linux interface of type LINUX_CP derived
+// from the pair, which Linux routes, ARPs, etc. can refer to.
+type LinuxCpPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Logical name of the VPP interface to mirror into Linux.
+	Interface string `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Name of the host interface created by VPP (up to 15 characters).
+	HostIfName string `protobuf:"bytes,2,opt,name=host_if_name,json=hostIfName,proto3" json:"host_if_name,omitempty"`
+	// Type of the host interface.
+	HostIfType LinuxCpPair_HostIfType `protobuf:"varint,3,opt,name=host_if_type,json=hostIfType,proto3,enum=ligato.vpp.linuxcp.LinuxCpPair_HostIfType" json:"host_if_type,omitempty"`
+	// Name of the network namespace (as listed in /var/run/netns)
+	// where the host interface is created. If empty, the default
+	// namespace of the linux-cp plugin is used (see LinuxCpGlobal),
+	// while the derived Linux interface is looked up in the default
+	// namespace of the agent.
+	Netns string `protobuf:"bytes,4,opt,name=netns,proto3" json:"netns,omitempty"`
+	// Logical name of the Linux interface derived from the pair
+	// to represent the host side. If empty, host_if_name is used.
+	LinuxIfName string `protobuf:"bytes,5,opt,name=linux_if_name,json=linuxIfName,proto3" json:"linux_if_name,omitempty"`
+	// IP addresses assigned to the host interface,
+	// in the format <ipAddress>/<ipPrefix>.
+	HostIpAddresses []string `protobuf:"bytes,6,rep,name=host_ip_addresses,json=hostIpAddresses,proto3" json:"host_ip_addresses,omitempty"`
+	// MTU of the host interface.
+	HostMtu uint32 `protobuf:"varint,7,opt,name=host_mtu,json=hostMtu,proto3" json:"host_mtu,omitempty"`
+}
+
+func (x *LinuxCpPair) Reset() {
+	*x = LinuxCpPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_linuxcp_linuxcp_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinuxCpPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinuxCpPair) ProtoMessage() {}
+
+func (x *LinuxCpPair) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_linuxcp_linuxcp_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinuxCpPair.ProtoReflect.Descriptor instead.
+func (*LinuxCpPair) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_linuxcp_linuxcp_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LinuxCpPair) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *LinuxCpPair) GetHostIfName() string {
+	if x != nil {
+		return x.HostIfName
+	}
+	return ""
+}
+
+func (x *LinuxCpPair) GetHostIfType() LinuxCpPair_HostIfType {
+	if x != nil {
+		return x.HostIfType
+	}
+	return LinuxCpPair_TAP
+}
+
+func (x *LinuxCpPair) GetNetns() string {
+	if x != nil {
+		return x.Netns
+	}
+	return ""
+}
+
+func (x *LinuxCpPair) GetLinuxIfName() string {
+	if x != nil {
+		return x.LinuxIfName
+	}
+	return ""
+}
+
+func (x *LinuxCpPair) GetHostIpAddresses() []string {
+	if x != nil {
+		return x.HostIpAddresses
+	}
+	return nil
+}
+
+func (x *LinuxCpPair) GetHostMtu() uint32 {
+	if x != nil {
+		return x.HostMtu
+	}
+	return 0
+}
+
+// LinuxCpGlobal defines global settings of the VPP linux-cp plugin.
+type LinuxCpGlobal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the network namespace used for host interfaces of pairs
+	// without explicit namespace. Empty string selects the namespace of VPP.
+	DefaultNetns string `protobuf:"bytes,1,opt,name=default_netns,json=defaultNetns,proto3" json:"default_netns,omitempty"`
+}
+
+func (x *LinuxCpGlobal) Reset() {
+	*x = LinuxCpGlobal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_linuxcp_linuxcp_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinuxCpGlobal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinuxCpGlobal) ProtoMessage() {}
+
+func (x *LinuxCpGlobal) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_linuxcp_linuxcp_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinuxCpGlobal.ProtoReflect.Descriptor instead.
+func (*LinuxCpGlobal) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_linuxcp_linuxcp_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LinuxCpGlobal) GetDefaultNetns() string {
+	if x != nil {
+		return x.DefaultNetns
+	}
+	return ""
+}
+
+var File_ligato_vpp_linuxcp_linuxcp_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_linuxcp_linuxcp_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x69, 0x6e,
+	0x75, 0x78, 0x63, 0x70, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x12, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x63, 0x70, 0x22, 0xbc, 0x02, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x75, 0x78,
+	0x43, 0x70, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74,
+	0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63,
+	0x70, 0x2e, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x43, 0x70, 0x50, 0x61, 0x69, 0x72, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x49, 0x66, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x65, 0x74, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a,
+	0x0a, 0x11, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x68, 0x6f, 0x73, 0x74, 0x49,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f,
+	0x73, 0x74, 0x5f, 0x6d, 0x74, 0x75, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x68, 0x6f,
+	0x73, 0x74, 0x4d, 0x74, 0x75, 0x22, 0x1e, 0x0a, 0x0a, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x54, 0x55, 0x4e, 0x10, 0x01, 0x22, 0x34, 0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x43, 0x70,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x6e, 0x65, 0x74, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4e, 0x65, 0x74, 0x6e, 0x73, 0x42, 0x40, 0x5a, 0x3e, 0x67,
+	0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63,
+	0x70, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63, 0x70, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_linuxcp_linuxcp_proto_rawDescOnce sync.Once
+	file_ligato_vpp_linuxcp_linuxcp_proto_rawDescData = file_ligato_vpp_linuxcp_linuxcp_proto_rawDesc
+)
+
+func file_ligato_vpp_linuxcp_linuxcp_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_linuxcp_linuxcp_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_linuxcp_linuxcp_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_linuxcp_linuxcp_proto_rawDescData)
+	})
+	return file_ligato_vpp_linuxcp_linuxcp_proto_rawDescData
+}
+
+var file_ligato_vpp_linuxcp_linuxcp_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ligato_vpp_linuxcp_linuxcp_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ligato_vpp_linuxcp_linuxcp_proto_goTypes = []interface{}{
+	(LinuxCpPair_HostIfType)(0), // 0: ligato.vpp.linuxcp.LinuxCpPair.HostIfType
+	(*LinuxCpPair)(nil),         // 1: ligato.vpp.linuxcp.LinuxCpPair
+	(*LinuxCpGlobal)(nil),       // 2: ligato.vpp.linuxcp.LinuxCpGlobal
+}
+var file_ligato_vpp_linuxcp_linuxcp_proto_depIdxs = []int32{
+	0, // 0: ligato.vpp.linuxcp.LinuxCpPair.host_if_type:type_name -> ligato.vpp.linuxcp.LinuxCpPair.HostIfType
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_linuxcp_linuxcp_proto_init() }
+func file_ligato_vpp_linuxcp_linuxcp_proto_init() {
+	if File_ligato_vpp_linuxcp_linuxcp_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_linuxcp_linuxcp_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinuxCpPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_linuxcp_linuxcp_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinuxCpGlobal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_linuxcp_linuxcp_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_linuxcp_linuxcp_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_linuxcp_linuxcp_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_linuxcp_linuxcp_proto_enumTypes,
+		MessageInfos:      file_ligato_vpp_linuxcp_linuxcp_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_linuxcp_linuxcp_proto = out.File
+	file_ligato_vpp_linuxcp_linuxcp_proto_rawDesc = nil
+	file_ligato_vpp_linuxcp_linuxcp_proto_goTypes = nil
+	file_ligato_vpp_linuxcp_linuxcp_proto_depIdxs = nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_linuxcp
+
+import (
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.linuxcp"
+
+var (
+	ModelLinuxCpPair = models.Register(&LinuxCpPair{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "pair",
+		Version: "v2",
+	}, models.WithNameTemplate("{{.Interface}}"))
+
+	ModelLinuxCpGlobal = models.Register(&LinuxCpGlobal{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "global",
+		Version: "v2",
+	})
+)
+
+// PairKey returns the key used to represent configuration of linux-cp
+// interface pair for the given VPP interface.
+func PairKey(iface string) string {
+	return models.Key(&LinuxCpPair{
+		Interface: iface,
+	})
+}
+
+// GlobalKey returns the key used to represent global linux-cp settings.
+func GlobalKey() string {
+	return models.Key(&LinuxCpGlobal{})
+}
+
+// hostInterfaceTagPrefix is a prefix of the tag assigned to the VPP side
+// of the host TAP/TUN interface created by linux-cp plugin.
+const hostInterfaceTagPrefix = "linuxcp-host/"
+
+// HostInterfaceTag returns tag assigned to the VPP side of the host
+// interface created for the pair with the given VPP interface.
+func HostInterfaceTag(iface string) string {
+	return hostInterfaceTagPrefix + iface
+}
+
+// ParseHostInterfaceTag parses out the name of the paired VPP interface
+// from the tag assigned to the VPP side of the host interface.
+func ParseHostInterfaceTag(tag string) (iface string, isHostInterfaceTag bool) {
+	if !strings.HasPrefix(tag, hostInterfaceTagPrefix) {
+		return "", false
+	}
+	iface = strings.TrimPrefix(tag, hostInterfaceTagPrefix)
+	return iface, iface != ""
+}
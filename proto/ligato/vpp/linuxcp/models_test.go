@@ -0,0 +1,66 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_linuxcp
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestLinuxCpKeys(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(PairKey("GigabitEthernet0/8/0")).To(
+		Equal("config/vpp/linuxcp/v2/pair/GigabitEthernet0/8/0"))
+	Expect(GlobalKey()).To(Equal("config/vpp/linuxcp/v2/global"))
+}
+
+func TestHostInterfaceTag(t *testing.T) {
+	tests := []struct {
+		name        string
+		tag         string
+		expectedIf  string
+		expectedTag bool
+	}{
+		{
+			name:        "valid tag",
+			tag:         HostInterfaceTag("memif1"),
+			expectedIf:  "memif1",
+			expectedTag: true,
+		},
+		{
+			name:        "interface with slash",
+			tag:         HostInterfaceTag("GigabitEthernet0/8/0"),
+			expectedIf:  "GigabitEthernet0/8/0",
+			expectedTag: true,
+		},
+		{
+			name: "missing interface",
+			tag:  "linuxcp-host/",
+		},
+		{
+			name: "other tag",
+			tag:  "tap1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			iface, isTag := ParseHostInterfaceTag(test.tag)
+			Expect(isTag).To(Equal(test.expectedTag))
+			Expect(iface).To(Equal(test.expectedIf))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_mpls
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.mpls"
+
+var (
+	ModelMplsTable = models.Register(&MplsTable{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "table",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"id/{{.Id}}",
+	))
+
+	ModelMplsInterface = models.Register(&MplsInterface{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "interface",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"{{.Name}}",
+	))
+
+	ModelLocalLabel = models.Register(&LocalLabel{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "local-label",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		`table/{{.TableId}}/label/{{.Label}}/{{if .Eos}}eos/{{.EosProtocol}}{{else}}non-eos{{end}}`,
+	))
+)
+
+// MplsTableKey returns the key used to represent configuration for VPP MPLS table.
+func MplsTableKey(id uint32) string {
+	return models.Key(&MplsTable{
+		Id: id,
+	})
+}
+
+// MplsInterfaceKey returns the key used to represent interface with enabled MPLS.
+func MplsInterfaceKey(iface string) string {
+	return models.Key(&MplsInterface{
+		Name: iface,
+	})
+}
+
+// LocalLabelKey returns the key used to represent MPLS local label entry.
+func LocalLabelKey(table, label uint32, eos bool, eosProto LocalLabel_EosProtocol) string {
+	return models.Key(&LocalLabel{
+		TableId:     table,
+		Label:       label,
+		Eos:         eos,
+		EosProtocol: eosProto,
+	})
+}
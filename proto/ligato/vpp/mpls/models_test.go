@@ -0,0 +1,59 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_mpls
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestLocalLabelKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		localLabel  *LocalLabel
+		expectedKey string
+	}{
+		{
+			name:        "non-eos",
+			localLabel:  &LocalLabel{Label: 100},
+			expectedKey: "config/vpp/mpls/v2/local-label/table/0/label/100/non-eos",
+		},
+		{
+			name:        "eos-ipv4",
+			localLabel:  &LocalLabel{TableId: 1, Label: 100, Eos: true},
+			expectedKey: "config/vpp/mpls/v2/local-label/table/1/label/100/eos/IPV4",
+		},
+		{
+			name:        "eos-ipv6",
+			localLabel:  &LocalLabel{Label: 200, Eos: true, EosProtocol: LocalLabel_IPV6},
+			expectedKey: "config/vpp/mpls/v2/local-label/table/0/label/200/eos/IPV6",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			key := LocalLabelKey(test.localLabel.TableId, test.localLabel.Label,
+				test.localLabel.Eos, test.localLabel.EosProtocol)
+			Expect(key).To(Equal(test.expectedKey))
+		})
+	}
+}
+
+func TestMplsTableKey(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(MplsTableKey(0)).To(Equal("config/vpp/mpls/v2/table/id/0"))
+	Expect(MplsInterfaceKey("if1")).To(Equal("config/vpp/mpls/v2/interface/if1"))
+}
@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/mpls/mpls.proto
+
+package vpp_mpls
+
+import (
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EosProtocol defines the payload protocol for end-of-stack entries.
+type LocalLabel_EosProtocol int32
+
+const (
+	LocalLabel_IPV4 LocalLabel_EosProtocol = 0
+	LocalLabel_IPV6 LocalLabel_EosProtocol = 1
+)
+
+// Enum value maps for LocalLabel_EosProtocol.
+var (
+	LocalLabel_EosProtocol_name = map[int32]string{
+		0: "IPV4",
+		1: "IPV6",
+	}
+	LocalLabel_EosProtocol_value = map[string]int32{
+		"IPV4": 0,
+		"IPV6": 1,
+	}
+)
+
+func (x LocalLabel_EosProtocol) Enum() *LocalLabel_EosProtocol {
+	p := new(LocalLabel_EosProtocol)
+	*p = x
+	return p
+}
+
+func (x LocalLabel_EosProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LocalLabel_EosProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_mpls_mpls_proto_enumTypes[0].Descriptor()
+}
+
+func (LocalLabel_EosProtocol) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_mpls_mpls_proto_enumTypes[0]
+}
+
+func (x LocalLabel_EosProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LocalLabel_EosProtocol.Descriptor instead.
+func (LocalLabel_EosProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_mpls_mpls_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// MplsTable defines MPLS table (label FIB).
+// Table with ID 0 is the default MPLS table and it has to be created
+// before MPLS can be enabled on any interface.
+type MplsTable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID is mandatory identification for MPLS table.
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Label is an optional description for the table.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *MplsTable) Reset() {
+	*x = MplsTable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_mpls_mpls_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MplsTable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MplsTable) ProtoMessage() {}
+
+func (x *MplsTable) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_mpls_mpls_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MplsTable.ProtoReflect.Descriptor instead.
+func (*MplsTable) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_mpls_mpls_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MplsTable) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MplsTable) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// MplsInterface enables MPLS forwarding on the given interface.
+type MplsInterface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the interface to enable MPLS on.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *MplsInterface) Reset() {
+	*x = MplsInterface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_mpls_mpls_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MplsInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MplsInterface) ProtoMessage() {}
+
+func (x *MplsInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_mpls_mpls_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MplsInterface.ProtoReflect.Descriptor instead.
+func (*MplsInterface) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_mpls_mpls_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MplsInterface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// LocalLabel defines MPLS label FIB entry (i.e. action taken for packets
+// received with the given local label).
+type LocalLabel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Label is the local (incoming) MPLS label.
+	// Values 0-15 are reserved and cannot be used.
+	Label uint32 `protobuf:"varint,1,opt,name=label,proto3" json:"label,omitempty"`
+	// TableId is the ID of MPLS table to put the entry into (default MPLS table is 0).
+	TableId uint32 `protobuf:"varint,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// Eos (end-of-stack) selects entry matching only the bottom-of-stack label.
+	Eos         bool                   `protobuf:"varint,3,opt,name=eos,proto3" json:"eos,omitempty"`
+	EosProtocol LocalLabel_EosProtocol `protobuf:"varint,4,opt,name=eos_protocol,json=eosProtocol,proto3,enum=ligato.vpp.mpls.LocalLabel_EosProtocol" json:"eos_protocol,omitempty"`
+	// Paths to forward the packets via. Label stack of the path defines the labels
+	// swapped for the local label (empty stack means label pop).
+	// Path with only via_vrf_id defined represents label pop followed by
+	// lookup in the given IP VRF table (deaggregation).
+	Paths []*l3.Route_Path `protobuf:"bytes,5,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *LocalLabel) Reset() {
+	*x = LocalLabel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_mpls_mpls_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalLabel) ProtoMessage() {}
+
+func (x *LocalLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_mpls_mpls_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalLabel.ProtoReflect.Descriptor instead.
+func (*LocalLabel) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_mpls_mpls_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LocalLabel) GetLabel() uint32 {
+	if x != nil {
+		return x.Label
+	}
+	return 0
+}
+
+func (x *LocalLabel) GetTableId() uint32 {
+	if x != nil {
+		return x.TableId
+	}
+	return 0
+}
+
+func (x *LocalLabel) GetEos() bool {
+	if x != nil {
+		return x.Eos
+	}
+	return false
+}
+
+func (x *LocalLabel) GetEosProtocol() LocalLabel_EosProtocol {
+	if x != nil {
+		return x.EosProtocol
+	}
+	return LocalLabel_IPV4
+}
+
+func (x *LocalLabel) GetPaths() []*l3.Route_Path {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+var File_ligato_vpp_mpls_mpls_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_mpls_mpls_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6d, 0x70, 0x6c,
+	0x73, 0x2f, 0x6d, 0x70, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6d, 0x70, 0x6c, 0x73, 0x1a, 0x19, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x31, 0x0a, 0x09, 0x4d, 0x70, 0x6c, 0x73,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x23, 0x0a, 0x0d, 0x4d,
+	0x70, 0x6c, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0xef, 0x01, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x65,
+	0x6f, 0x73, 0x12, 0x4a, 0x0a, 0x0c, 0x65, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6d, 0x70, 0x6c, 0x73, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x2e, 0x45, 0x6f, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x52, 0x0b, 0x65, 0x6f, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x2f,
+	0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22,
+	0x21, 0x0a, 0x0b, 0x45, 0x6f, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x08,
+	0x0a, 0x04, 0x49, 0x50, 0x56, 0x34, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36,
+	0x10, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
+	0x2f, 0x6d, 0x70, 0x6c, 0x73, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x6d, 0x70, 0x6c, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_mpls_mpls_proto_rawDescOnce sync.Once
+	file_ligato_vpp_mpls_mpls_proto_rawDescData = file_ligato_vpp_mpls_mpls_proto_rawDesc
+)
+
+func file_ligato_vpp_mpls_mpls_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_mpls_mpls_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_mpls_mpls_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_mpls_mpls_proto_rawDescData)
+	})
+	return file_ligato_vpp_mpls_mpls_proto_rawDescData
+}
+
+var file_ligato_vpp_mpls_mpls_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ligato_vpp_mpls_mpls_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ligato_vpp_mpls_mpls_proto_goTypes = []interface{}{
+	(LocalLabel_EosProtocol)(0), // 0: ligato.vpp.mpls.LocalLabel.EosProtocol
+	(*MplsTable)(nil),           // 1: ligato.vpp.mpls.MplsTable
+	(*MplsInterface)(nil),       // 2: ligato.vpp.mpls.MplsInterface
+	(*LocalLabel)(nil),          // 3: ligato.vpp.mpls.LocalLabel
+	(*l3.Route_Path)(nil),       // 4: ligato.vpp.l3.Route.Path
+}
+var file_ligato_vpp_mpls_mpls_proto_depIdxs = []int32{
+	0, // 0: ligato.vpp.mpls.LocalLabel.eos_protocol:type_name -> ligato.vpp.mpls.LocalLabel.EosProtocol
+	4, // 1: ligato.vpp.mpls.LocalLabel.paths:type_name -> ligato.vpp.l3.Route.Path
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_mpls_mpls_proto_init() }
+func file_ligato_vpp_mpls_mpls_proto_init() {
+	if File_ligato_vpp_mpls_mpls_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_mpls_mpls_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MplsTable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_mpls_mpls_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MplsInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_mpls_mpls_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalLabel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_mpls_mpls_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_mpls_mpls_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_mpls_mpls_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_mpls_mpls_proto_enumTypes,
+		MessageInfos:      file_ligato_vpp_mpls_mpls_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_mpls_mpls_proto = out.File
+	file_ligato_vpp_mpls_mpls_proto_rawDesc = nil
+	file_ligato_vpp_mpls_mpls_proto_goTypes = nil
+	file_ligato_vpp_mpls_mpls_proto_depIdxs = nil
+}
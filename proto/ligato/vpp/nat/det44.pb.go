@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/nat/det44.proto
+
+package vpp_nat
+
+import (
+	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Det44Global defines global configuration of deterministic NAT44 (DET44, also known as CGNAT).
+// The DET44 plugin is enabled by submitting the Det44Global configuration (even default values
+// will make the plugin enabled). Without Det44Global, all other DET44 configuration items
+// (Det44Mapping and Det44Interface) will be in the PENDING state.
+type Det44Global struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VRF (table) ID of inside interfaces.
+	// Non-zero VRF has to be explicitly created (see api/models/vpp/l3/vrf.proto).
+	InsideVrf uint32 `protobuf:"varint,1,opt,name=inside_vrf,json=insideVrf,proto3" json:"inside_vrf,omitempty"`
+	// VRF (table) ID of outside interfaces.
+	// Non-zero VRF has to be explicitly created (see api/models/vpp/l3/vrf.proto).
+	OutsideVrf uint32 `protobuf:"varint,2,opt,name=outside_vrf,json=outsideVrf,proto3" json:"outside_vrf,omitempty"`
+	// Session timeouts.
+	Timeouts *NatTimeouts `protobuf:"bytes,3,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+}
+
+func (x *Det44Global) Reset() {
+	*x = Det44Global{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_det44_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Det44Global) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Det44Global) ProtoMessage() {}
+
+func (x *Det44Global) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_det44_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Det44Global.ProtoReflect.Descriptor instead.
+func (*Det44Global) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_det44_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Det44Global) GetInsideVrf() uint32 {
+	if x != nil {
+		return x.InsideVrf
+	}
+	return 0
+}
+
+func (x *Det44Global) GetOutsideVrf() uint32 {
+	if x != nil {
+		return x.OutsideVrf
+	}
+	return 0
+}
+
+func (x *Det44Global) GetTimeouts() *NatTimeouts {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+// Det44Mapping defines deterministic mapping of an inside IPv4 network onto an outside IPv4 network.
+// Ports of the outside addresses are evenly divided between the inside hosts.
+type Det44Mapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Inside IPv4 network.
+	InsidePrefix string `protobuf:"bytes,1,opt,name=inside_prefix,json=insidePrefix,proto3" json:"inside_prefix,omitempty"`
+	// Outside IPv4 network.
+	OutsidePrefix string `protobuf:"bytes,2,opt,name=outside_prefix,json=outsidePrefix,proto3" json:"outside_prefix,omitempty"`
+}
+
+func (x *Det44Mapping) Reset() {
+	*x = Det44Mapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_det44_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Det44Mapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Det44Mapping) ProtoMessage() {}
+
+func (x *Det44Mapping) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_det44_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Det44Mapping.ProtoReflect.Descriptor instead.
+func (*Det44Mapping) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_det44_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Det44Mapping) GetInsidePrefix() string {
+	if x != nil {
+		return x.InsidePrefix
+	}
+	return ""
+}
+
+func (x *Det44Mapping) GetOutsidePrefix() string {
+	if x != nil {
+		return x.OutsidePrefix
+	}
+	return ""
+}
+
+// Det44Interface defines a local network interface enabled for DET44.
+type Det44Interface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Interface name (logical).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Enable/disable DET44 on inside.
+	NatInside bool `protobuf:"varint,2,opt,name=nat_inside,json=natInside,proto3" json:"nat_inside,omitempty"`
+	// Enable/disable DET44 on outside.
+	NatOutside bool `protobuf:"varint,3,opt,name=nat_outside,json=natOutside,proto3" json:"nat_outside,omitempty"`
+}
+
+func (x *Det44Interface) Reset() {
+	*x = Det44Interface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_det44_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Det44Interface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Det44Interface) ProtoMessage() {}
+
+func (x *Det44Interface) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_det44_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Det44Interface.ProtoReflect.Descriptor instead.
+func (*Det44Interface) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_det44_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Det44Interface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Det44Interface) GetNatInside() bool {
+	if x != nil {
+		return x.NatInside
+	}
+	return false
+}
+
+func (x *Det44Interface) GetNatOutside() bool {
+	if x != nil {
+		return x.NatOutside
+	}
+	return false
+}
+
+var File_ligato_vpp_nat_det44_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_nat_det44_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74,
+	0x2f, 0x64, 0x65, 0x74, 0x34, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x1a, 0x18, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x2f, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x86, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x74, 0x34, 0x34, 0x47, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x76, 0x72, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x56, 0x72,
+	0x66, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x76, 0x72, 0x66,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x56,
+	0x72, 0x66, 0x12, 0x37, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x73, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x22, 0x68, 0x0a, 0x0c, 0x44,
+	0x65, 0x74, 0x34, 0x34, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x0d, 0x69,
+	0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x69, 0x64,
+	0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2c, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x73, 0x69,
+	0x64, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x05, 0x82, 0x7d, 0x02, 0x08, 0x05, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x64, 0x0a, 0x0e, 0x44, 0x65, 0x74, 0x34, 0x34, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x61, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x6e, 0x61, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61,
+	0x74, 0x5f, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x6e, 0x61, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67,
+	0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x3b, 0x76, 0x70,
+	0x70, 0x5f, 0x6e, 0x61, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_nat_det44_proto_rawDescOnce sync.Once
+	file_ligato_vpp_nat_det44_proto_rawDescData = file_ligato_vpp_nat_det44_proto_rawDesc
+)
+
+func file_ligato_vpp_nat_det44_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_nat_det44_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_nat_det44_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_nat_det44_proto_rawDescData)
+	})
+	return file_ligato_vpp_nat_det44_proto_rawDescData
+}
+
+var file_ligato_vpp_nat_det44_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ligato_vpp_nat_det44_proto_goTypes = []interface{}{
+	(*Det44Global)(nil),    // 0: ligato.vpp.nat.Det44Global
+	(*Det44Mapping)(nil),   // 1: ligato.vpp.nat.Det44Mapping
+	(*Det44Interface)(nil), // 2: ligato.vpp.nat.Det44Interface
+	(*NatTimeouts)(nil),    // 3: ligato.vpp.nat.NatTimeouts
+}
+var file_ligato_vpp_nat_det44_proto_depIdxs = []int32{
+	3, // 0: ligato.vpp.nat.Det44Global.timeouts:type_name -> ligato.vpp.nat.NatTimeouts
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_nat_det44_proto_init() }
+func file_ligato_vpp_nat_det44_proto_init() {
+	if File_ligato_vpp_nat_det44_proto != nil {
+		return
+	}
+	file_ligato_vpp_nat_nat64_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_nat_det44_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Det44Global); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_det44_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Det44Mapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_det44_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Det44Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_nat_det44_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_nat_det44_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_nat_det44_proto_depIdxs,
+		MessageInfos:      file_ligato_vpp_nat_det44_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_nat_det44_proto = out.File
+	file_ligato_vpp_nat_det44_proto_rawDesc = nil
+	file_ligato_vpp_nat_det44_proto_goTypes = nil
+	file_ligato_vpp_nat_det44_proto_depIdxs = nil
+}
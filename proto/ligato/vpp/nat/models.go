@@ -57,6 +57,58 @@ var (
 			"{{if and .LastIp (ne .FirstIp .LastIp)}}-{{.LastIp}}{{end}}"+
 			"{{end}}",
 	))
+
+	ModelNat64Global = models.Register(&Nat64Global{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-global",
+		Version: "v2",
+	})
+
+	ModelNat64Prefix = models.Register(&Nat64Prefix{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-prefix",
+		Version: "v2",
+	}, models.WithNameTemplate("vrf/{{.VrfId}}"))
+
+	ModelNat64AddressPool = models.Register(&Nat64AddressPool{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-pool",
+		Version: "v2",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelNat64Interface = models.Register(&Nat64Interface{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-interface",
+		Version: "v2",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelNat64StaticBib = models.Register(&Nat64StaticBib{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-static-bib",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"vrf/{{.VrfId}}/{{.Protocol}}/{{ip .InsideIp}}/{{.InsidePort}}",
+	))
+
+	ModelDet44Global = models.Register(&Det44Global{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "det44-global",
+		Version: "v2",
+	})
+
+	ModelDet44Mapping = models.Register(&Det44Mapping{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "det44-mapping",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"{{with ipnet .InsidePrefix}}{{.IP}}/{{.MaskSize}}{{end}}",
+	))
+
+	ModelDet44Interface = models.Register(&Det44Interface{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "det44-interface",
+		Version: "v2",
+	}, models.WithNameTemplate("{{.Name}}"))
 )
 
 // GlobalNAT44Key returns key for Nat44Global.
@@ -80,6 +132,56 @@ func Nat44InterfaceKey(name string) string {
 	})
 }
 
+// GlobalNAT64Key returns key for Nat64Global.
+func GlobalNAT64Key() string {
+	return models.Key(&Nat64Global{})
+}
+
+// Nat64PrefixKey returns the key used in NB DB to store the NAT64 prefix
+// configured for the given VRF.
+func Nat64PrefixKey(vrfID uint32) string {
+	return models.Key(&Nat64Prefix{
+		VrfId: vrfID,
+	})
+}
+
+// Nat64AddressPoolKey returns the key used in NB DB to store the configuration of the
+// given NAT64 address pool.
+func Nat64AddressPoolKey(name string) string {
+	return models.Key(&Nat64AddressPool{
+		Name: name,
+	})
+}
+
+// Nat64InterfaceKey returns the key used in NB DB to store the configuration of the
+// given NAT64 interface.
+func Nat64InterfaceKey(name string) string {
+	return models.Key(&Nat64Interface{
+		Name: name,
+	})
+}
+
+// GlobalDET44Key returns key for Det44Global.
+func GlobalDET44Key() string {
+	return models.Key(&Det44Global{})
+}
+
+// Det44MappingKey returns the key used in NB DB to store the configuration of the
+// DET44 mapping for the given inside prefix.
+func Det44MappingKey(insidePrefix string) string {
+	return models.Key(&Det44Mapping{
+		InsidePrefix: insidePrefix,
+	})
+}
+
+// Det44InterfaceKey returns the key used in NB DB to store the configuration of the
+// given DET44 interface.
+func Det44InterfaceKey(name string) string {
+	return models.Key(&Det44Interface{
+		Name: name,
+	})
+}
+
 /* NAT44 mode (derived) */
 
 const (
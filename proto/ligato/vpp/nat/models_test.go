@@ -17,6 +17,8 @@ package vpp_nat
 import (
 	"testing"
 
+	"google.golang.org/protobuf/proto"
+
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 )
 
@@ -348,3 +350,87 @@ func TestNat44AddressPoolKey(t *testing.T) {
 		})
 	}
 }
+
+func TestNat64Keys(t *testing.T) {
+	tests := []struct {
+		name        string
+		model       proto.Message
+		expectedKey string
+	}{
+		{
+			"global",
+			&Nat64Global{BibBuckets: 1024},
+			"config/vpp/nat/v2/nat64-global",
+		},
+		{
+			"prefix",
+			&Nat64Prefix{VrfId: 2, Prefix: "64:ff9b::/96"},
+			"config/vpp/nat/v2/nat64-prefix/vrf/2",
+		},
+		{
+			"pool",
+			&Nat64AddressPool{Name: "pool1", FirstIp: "10.0.0.1"},
+			"config/vpp/nat/v2/nat64-pool/pool1",
+		},
+		{
+			"interface",
+			&Nat64Interface{Name: "tap0", NatInside: true},
+			"config/vpp/nat/v2/nat64-interface/tap0",
+		},
+		{
+			"static BIB",
+			&Nat64StaticBib{
+				VrfId:      0,
+				Protocol:   DNat44_UDP,
+				InsideIp:   "2001:DB8::1",
+				InsidePort: 53,
+				OutsideIp:  "10.0.0.1",
+			},
+			"config/vpp/nat/v2/nat64-static-bib/vrf/0/UDP/2001:db8::1/53",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := models.Key(test.model)
+			if key != test.expectedKey {
+				t.Errorf("failed key for NAT64 model: %+v\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.model, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestDet44Keys(t *testing.T) {
+	tests := []struct {
+		name        string
+		model       proto.Message
+		expectedKey string
+	}{
+		{
+			"global",
+			&Det44Global{InsideVrf: 1},
+			"config/vpp/nat/v2/det44-global",
+		},
+		{
+			"mapping",
+			&Det44Mapping{InsidePrefix: "10.0.0.1/24", OutsidePrefix: "1.1.1.0/30"},
+			"config/vpp/nat/v2/det44-mapping/10.0.0.0/24",
+		},
+		{
+			"interface",
+			&Det44Interface{Name: "tap0", NatOutside: true},
+			"config/vpp/nat/v2/det44-interface/tap0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := models.Key(test.model)
+			if key != test.expectedKey {
+				t.Errorf("failed key for DET44 model: %+v\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.model, test.expectedKey, key)
+			}
+		})
+	}
+}
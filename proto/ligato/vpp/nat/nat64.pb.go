@@ -0,0 +1,691 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/nat/nat64.proto
+
+package vpp_nat
+
+import (
+	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Nat64Global defines global NAT64 configuration.
+// The NAT64 plugin is enabled by submitting the Nat64Global configuration (even default values
+// will make the plugin enabled). Without Nat64Global, all other NAT64 configuration items
+// (Nat64Prefix, Nat64AddressPool, Nat64Interface and Nat64StaticBib) will be in the PENDING state.
+type Nat64Global struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of buckets of the BIB (binding information base) hash table (0 means VPP default).
+	BibBuckets uint32 `protobuf:"varint,1,opt,name=bib_buckets,json=bibBuckets,proto3" json:"bib_buckets,omitempty"`
+	// Memory size of the BIB hash table in bytes (0 means VPP default).
+	BibMemorySize uint32 `protobuf:"varint,2,opt,name=bib_memory_size,json=bibMemorySize,proto3" json:"bib_memory_size,omitempty"`
+	// Number of buckets of the session table (0 means VPP default).
+	StBuckets uint32 `protobuf:"varint,3,opt,name=st_buckets,json=stBuckets,proto3" json:"st_buckets,omitempty"`
+	// Memory size of the session table in bytes (0 means VPP default).
+	StMemorySize uint32 `protobuf:"varint,4,opt,name=st_memory_size,json=stMemorySize,proto3" json:"st_memory_size,omitempty"`
+	// Session timeouts.
+	Timeouts *NatTimeouts `protobuf:"bytes,5,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+}
+
+func (x *Nat64Global) Reset() {
+	*x = Nat64Global{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_nat64_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nat64Global) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nat64Global) ProtoMessage() {}
+
+func (x *Nat64Global) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_nat64_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nat64Global.ProtoReflect.Descriptor instead.
+func (*Nat64Global) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_nat64_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Nat64Global) GetBibBuckets() uint32 {
+	if x != nil {
+		return x.BibBuckets
+	}
+	return 0
+}
+
+func (x *Nat64Global) GetBibMemorySize() uint32 {
+	if x != nil {
+		return x.BibMemorySize
+	}
+	return 0
+}
+
+func (x *Nat64Global) GetStBuckets() uint32 {
+	if x != nil {
+		return x.StBuckets
+	}
+	return 0
+}
+
+func (x *Nat64Global) GetStMemorySize() uint32 {
+	if x != nil {
+		return x.StMemorySize
+	}
+	return 0
+}
+
+func (x *Nat64Global) GetTimeouts() *NatTimeouts {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+// Nat64Prefix defines the prefix used to embed IPv4 addresses into IPv6 addresses (RFC 6052).
+// Only one prefix can be configured per VRF. Without any prefix the well-known prefix
+// 64:ff9b::/96 is used.
+type Nat64Prefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VRF (table) ID. Non-zero VRF has to be explicitly created (see api/models/vpp/l3/vrf.proto).
+	VrfId uint32 `protobuf:"varint,1,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`
+	// IPv6 prefix with length 32, 40, 48, 56, 64 or 96.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *Nat64Prefix) Reset() {
+	*x = Nat64Prefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_nat64_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nat64Prefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nat64Prefix) ProtoMessage() {}
+
+func (x *Nat64Prefix) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_nat64_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nat64Prefix.ProtoReflect.Descriptor instead.
+func (*Nat64Prefix) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_nat64_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Nat64Prefix) GetVrfId() uint32 {
+	if x != nil {
+		return x.VrfId
+	}
+	return 0
+}
+
+func (x *Nat64Prefix) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+// Nat64AddressPool defines a pool of IPv4 addresses used for NAT64.
+// Pools must not overlap.
+type Nat64AddressPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique name for address pool.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// VRF id of tenant, 0xFFFFFFFF means independent of VRF.
+	// Non-zero (and not all-ones) VRF has to be explicitly created (see api/models/vpp/l3/vrf.proto).
+	VrfId uint32 `protobuf:"varint,2,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`
+	// First IP address of the pool.
+	FirstIp string `protobuf:"bytes,3,opt,name=first_ip,json=firstIp,proto3" json:"first_ip,omitempty"`
+	// Last IP address of the pool. Should be higher than first_ip or empty.
+	LastIp string `protobuf:"bytes,4,opt,name=last_ip,json=lastIp,proto3" json:"last_ip,omitempty"`
+}
+
+func (x *Nat64AddressPool) Reset() {
+	*x = Nat64AddressPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_nat64_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nat64AddressPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nat64AddressPool) ProtoMessage() {}
+
+func (x *Nat64AddressPool) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_nat64_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nat64AddressPool.ProtoReflect.Descriptor instead.
+func (*Nat64AddressPool) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_nat64_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Nat64AddressPool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Nat64AddressPool) GetVrfId() uint32 {
+	if x != nil {
+		return x.VrfId
+	}
+	return 0
+}
+
+func (x *Nat64AddressPool) GetFirstIp() string {
+	if x != nil {
+		return x.FirstIp
+	}
+	return ""
+}
+
+func (x *Nat64AddressPool) GetLastIp() string {
+	if x != nil {
+		return x.LastIp
+	}
+	return ""
+}
+
+// Nat64Interface defines a local network interface enabled for NAT64.
+type Nat64Interface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Interface name (logical).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Enable/disable NAT64 on inside (IPv6 side).
+	NatInside bool `protobuf:"varint,2,opt,name=nat_inside,json=natInside,proto3" json:"nat_inside,omitempty"`
+	// Enable/disable NAT64 on outside (IPv4 side).
+	NatOutside bool `protobuf:"varint,3,opt,name=nat_outside,json=natOutside,proto3" json:"nat_outside,omitempty"`
+}
+
+func (x *Nat64Interface) Reset() {
+	*x = Nat64Interface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_nat64_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nat64Interface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nat64Interface) ProtoMessage() {}
+
+func (x *Nat64Interface) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_nat64_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nat64Interface.ProtoReflect.Descriptor instead.
+func (*Nat64Interface) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_nat64_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Nat64Interface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Nat64Interface) GetNatInside() bool {
+	if x != nil {
+		return x.NatInside
+	}
+	return false
+}
+
+func (x *Nat64Interface) GetNatOutside() bool {
+	if x != nil {
+		return x.NatOutside
+	}
+	return false
+}
+
+// Nat64StaticBib defines a static entry of the NAT64 BIB (binding information base).
+type Nat64StaticBib struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VRF (table) ID. Non-zero VRF has to be explicitly created (see api/models/vpp/l3/vrf.proto).
+	VrfId uint32 `protobuf:"varint,1,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`
+	// Protocol of the binding.
+	Protocol DNat44_Protocol `protobuf:"varint,2,opt,name=protocol,proto3,enum=ligato.vpp.nat.DNat44_Protocol" json:"protocol,omitempty"`
+	// Inside IPv6 address.
+	InsideIp string `protobuf:"bytes,3,opt,name=inside_ip,json=insideIp,proto3" json:"inside_ip,omitempty"`
+	// Inside port (ICMP identifier for ICMP).
+	InsidePort uint32 `protobuf:"varint,4,opt,name=inside_port,json=insidePort,proto3" json:"inside_port,omitempty"`
+	// Outside IPv4 address (has to be part of some NAT64 address pool).
+	OutsideIp string `protobuf:"bytes,5,opt,name=outside_ip,json=outsideIp,proto3" json:"outside_ip,omitempty"`
+	// Outside port (ICMP identifier for ICMP).
+	OutsidePort uint32 `protobuf:"varint,6,opt,name=outside_port,json=outsidePort,proto3" json:"outside_port,omitempty"`
+}
+
+func (x *Nat64StaticBib) Reset() {
+	*x = Nat64StaticBib{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_nat64_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nat64StaticBib) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nat64StaticBib) ProtoMessage() {}
+
+func (x *Nat64StaticBib) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_nat64_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nat64StaticBib.ProtoReflect.Descriptor instead.
+func (*Nat64StaticBib) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_nat64_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Nat64StaticBib) GetVrfId() uint32 {
+	if x != nil {
+		return x.VrfId
+	}
+	return 0
+}
+
+func (x *Nat64StaticBib) GetProtocol() DNat44_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return DNat44_TCP
+}
+
+func (x *Nat64StaticBib) GetInsideIp() string {
+	if x != nil {
+		return x.InsideIp
+	}
+	return ""
+}
+
+func (x *Nat64StaticBib) GetInsidePort() uint32 {
+	if x != nil {
+		return x.InsidePort
+	}
+	return 0
+}
+
+func (x *Nat64StaticBib) GetOutsideIp() string {
+	if x != nil {
+		return x.OutsideIp
+	}
+	return ""
+}
+
+func (x *Nat64StaticBib) GetOutsidePort() uint32 {
+	if x != nil {
+		return x.OutsidePort
+	}
+	return 0
+}
+
+// NatTimeouts defines timeouts (in seconds) of NAT sessions.
+// Zero value means VPP default.
+type NatTimeouts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UDP session timeout.
+	Udp uint32 `protobuf:"varint,1,opt,name=udp,proto3" json:"udp,omitempty"`
+	// TCP established session timeout.
+	TcpEstablished uint32 `protobuf:"varint,2,opt,name=tcp_established,json=tcpEstablished,proto3" json:"tcp_established,omitempty"`
+	// TCP transitory session timeout.
+	TcpTransitory uint32 `protobuf:"varint,3,opt,name=tcp_transitory,json=tcpTransitory,proto3" json:"tcp_transitory,omitempty"`
+	// ICMP session timeout.
+	Icmp uint32 `protobuf:"varint,4,opt,name=icmp,proto3" json:"icmp,omitempty"`
+}
+
+func (x *NatTimeouts) Reset() {
+	*x = NatTimeouts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_nat64_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NatTimeouts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NatTimeouts) ProtoMessage() {}
+
+func (x *NatTimeouts) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_nat64_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NatTimeouts.ProtoReflect.Descriptor instead.
+func (*NatTimeouts) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_nat64_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NatTimeouts) GetUdp() uint32 {
+	if x != nil {
+		return x.Udp
+	}
+	return 0
+}
+
+func (x *NatTimeouts) GetTcpEstablished() uint32 {
+	if x != nil {
+		return x.TcpEstablished
+	}
+	return 0
+}
+
+func (x *NatTimeouts) GetTcpTransitory() uint32 {
+	if x != nil {
+		return x.TcpTransitory
+	}
+	return 0
+}
+
+func (x *NatTimeouts) GetIcmp() uint32 {
+	if x != nil {
+		return x.Icmp
+	}
+	return 0
+}
+
+var File_ligato_vpp_nat_nat64_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_nat_nat64_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74,
+	0x2f, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x1a, 0x18, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x2f, 0x6e, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xd4, 0x01, 0x0a, 0x0b, 0x4e, 0x61, 0x74, 0x36, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x69, 0x62, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x69, 0x62, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x69, 0x62, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x62, 0x69, 0x62, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x5f,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73,
+	0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x5f, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x37,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61,
+	0x74, 0x2e, 0x4e, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x22, 0x43, 0x0a, 0x0b, 0x4e, 0x61, 0x74, 0x36, 0x34,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72, 0x66, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82,
+	0x7d, 0x02, 0x08, 0x06, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x7f, 0x0a, 0x10,
+	0x4e, 0x61, 0x74, 0x36, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72, 0x66, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x08, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82,
+	0x7d, 0x02, 0x08, 0x02, 0x52, 0x07, 0x66, 0x69, 0x72, 0x73, 0x74, 0x49, 0x70, 0x12, 0x1e, 0x0a,
+	0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
+	0x82, 0x7d, 0x02, 0x08, 0x02, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x70, 0x22, 0x64, 0x0a,
+	0x0e, 0x4e, 0x61, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x61, 0x74, 0x49, 0x6e, 0x73, 0x69,
+	0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x4f, 0x75, 0x74, 0x73,
+	0x69, 0x64, 0x65, 0x22, 0x88, 0x02, 0x0a, 0x0e, 0x4e, 0x61, 0x74, 0x36, 0x34, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x42, 0x69, 0x62, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72, 0x66, 0x49, 0x64, 0x12, 0x3b, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74,
+	0x2e, 0x44, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x09, 0x69, 0x6e,
+	0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82,
+	0x7d, 0x02, 0x08, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x49, 0x70, 0x12, 0x2a,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x6f, 0x75,
+	0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
+	0x82, 0x7d, 0x02, 0x08, 0x02, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x49, 0x70,
+	0x12, 0x2c, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff,
+	0x03, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x83,
+	0x01, 0x0a, 0x0b, 0x4e, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x64, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x64, 0x70,
+	0x12, 0x27, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f, 0x65, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x45, 0x73,
+	0x74, 0x61, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x74, 0x63, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x69, 0x63, 0x6d, 0x70, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76,
+	0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_nat_nat64_proto_rawDescOnce sync.Once
+	file_ligato_vpp_nat_nat64_proto_rawDescData = file_ligato_vpp_nat_nat64_proto_rawDesc
+)
+
+func file_ligato_vpp_nat_nat64_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_nat_nat64_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_nat_nat64_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_nat_nat64_proto_rawDescData)
+	})
+	return file_ligato_vpp_nat_nat64_proto_rawDescData
+}
+
+var file_ligato_vpp_nat_nat64_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_ligato_vpp_nat_nat64_proto_goTypes = []interface{}{
+	(*Nat64Global)(nil),      // 0: ligato.vpp.nat.Nat64Global
+	(*Nat64Prefix)(nil),      // 1: ligato.vpp.nat.Nat64Prefix
+	(*Nat64AddressPool)(nil), // 2: ligato.vpp.nat.Nat64AddressPool
+	(*Nat64Interface)(nil),   // 3: ligato.vpp.nat.Nat64Interface
+	(*Nat64StaticBib)(nil),   // 4: ligato.vpp.nat.Nat64StaticBib
+	(*NatTimeouts)(nil),      // 5: ligato.vpp.nat.NatTimeouts
+	(DNat44_Protocol)(0),     // 6: ligato.vpp.nat.DNat44.Protocol
+}
+var file_ligato_vpp_nat_nat64_proto_depIdxs = []int32{
+	5, // 0: ligato.vpp.nat.Nat64Global.timeouts:type_name -> ligato.vpp.nat.NatTimeouts
+	6, // 1: ligato.vpp.nat.Nat64StaticBib.protocol:type_name -> ligato.vpp.nat.DNat44.Protocol
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_nat_nat64_proto_init() }
+func file_ligato_vpp_nat_nat64_proto_init() {
+	if File_ligato_vpp_nat_nat64_proto != nil {
+		return
+	}
+	file_ligato_vpp_nat_nat_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_nat_nat64_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nat64Global); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_nat64_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nat64Prefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_nat64_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nat64AddressPool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_nat64_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nat64Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_nat64_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nat64StaticBib); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_nat64_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NatTimeouts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_nat_nat64_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_nat_nat64_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_nat_nat64_proto_depIdxs,
+		MessageInfos:      file_ligato_vpp_nat_nat64_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_nat_nat64_proto = out.File
+	file_ligato_vpp_nat_nat64_proto_rawDesc = nil
+	file_ligato_vpp_nat_nat64_proto_goTypes = nil
+	file_ligato_vpp_nat_nat64_proto_depIdxs = nil
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_policer
+
+import (
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "vpp.policer"
+
+var (
+	ModelPolicer = models.Register(&Policer{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "policer",
+		Version: "v2",
+	})
+
+	ModelQosRecord = models.Register(&QosRecord{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "qos-record",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"{{.Interface}}/{{.Source}}",
+	))
+
+	ModelQosEgressMap = models.Register(&QosEgressMap{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "qos-egress-map",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"id/{{.Id}}",
+	))
+
+	ModelQosMark = models.Register(&QosMark{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "qos-mark",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"{{.Interface}}/{{.Source}}",
+	))
+)
+
+// PolicerKey returns the key used to represent configuration for VPP policer.
+func PolicerKey(name string) string {
+	return models.Key(&Policer{
+		Name: name,
+	})
+}
+
+// QosRecordKey returns the key used to represent QoS recording on the interface.
+func QosRecordKey(iface string, source QosSource) string {
+	return models.Key(&QosRecord{
+		Interface: iface,
+		Source:    source,
+	})
+}
+
+// QosEgressMapKey returns the key used to represent QoS egress map.
+func QosEgressMapKey(id uint32) string {
+	return models.Key(&QosEgressMap{
+		Id: id,
+	})
+}
+
+// QosMarkKey returns the key used to represent QoS marking on the interface.
+func QosMarkKey(iface string, source QosSource) string {
+	return models.Key(&QosMark{
+		Interface: iface,
+		Source:    source,
+	})
+}
+
+const (
+	policerToInterfaceTemplate = "vpp/policer/{policer}/interface/{direction}/{iface}"
+
+	// InputDirection represents policer applied to received packets.
+	InputDirection = "input"
+	// OutputDirection represents policer applied to transmitted packets.
+	OutputDirection = "output"
+
+	// InvalidKeyPart is used in key for parts which are invalid
+	InvalidKeyPart = "<invalid>"
+)
+
+// ToInterfaceKey returns key representing binding of policer to interface.
+func ToInterfaceKey(policer, iface, direction string) string {
+	if policer == "" {
+		policer = InvalidKeyPart
+	}
+	if iface == "" {
+		iface = InvalidKeyPart
+	}
+	if direction != InputDirection && direction != OutputDirection {
+		direction = InvalidKeyPart
+	}
+	key := policerToInterfaceTemplate
+	key = strings.Replace(key, "{policer}", policer, 1)
+	key = strings.Replace(key, "{direction}", direction, 1)
+	key = strings.Replace(key, "{iface}", iface, 1)
+	return key
+}
+
+// ParsePolicerToInterfaceKey parses key representing binding of policer to interface.
+func ParsePolicerToInterfaceKey(key string) (policer, iface, direction string, isPolicerToInterface bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) < 6 || parts[0] != "vpp" || parts[1] != "policer" || parts[3] != "interface" {
+		return "", "", "", false
+	}
+	policer = parts[2]
+	direction = parts[4]
+	iface = strings.Join(parts[5:], "/")
+	if policer == "" || iface == "" ||
+		(direction != InputDirection && direction != OutputDirection && direction != InvalidKeyPart) {
+		return "", "", "", false
+	}
+	return policer, iface, direction, true
+}
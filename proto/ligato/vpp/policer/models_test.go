@@ -0,0 +1,93 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vpp_policer
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPolicerKeys(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(PolicerKey("tenant1")).To(Equal("config/vpp/policer/v2/policer/tenant1"))
+	Expect(QosRecordKey("memif1", QosSource_IP)).To(Equal("config/vpp/policer/v2/qos-record/memif1/IP"))
+	Expect(QosEgressMapKey(5)).To(Equal("config/vpp/policer/v2/qos-egress-map/id/5"))
+	Expect(QosMarkKey("memif1", QosSource_VLAN)).To(Equal("config/vpp/policer/v2/qos-mark/memif1/VLAN"))
+}
+
+func TestParsePolicerToInterfaceKey(t *testing.T) {
+	tests := []struct {
+		name              string
+		key               string
+		expectedPolicer   string
+		expectedIface     string
+		expectedDirection string
+		expectedIsValid   bool
+	}{
+		{
+			name:              "input",
+			key:               "vpp/policer/tenant1/interface/input/memif1",
+			expectedPolicer:   "tenant1",
+			expectedIface:     "memif1",
+			expectedDirection: InputDirection,
+			expectedIsValid:   true,
+		},
+		{
+			name:              "output with slash in interface name",
+			key:               "vpp/policer/tenant1/interface/output/Gbe0/8/0",
+			expectedPolicer:   "tenant1",
+			expectedIface:     "Gbe0/8/0",
+			expectedDirection: OutputDirection,
+			expectedIsValid:   true,
+		},
+		{
+			name:              "invalid direction",
+			key:               "vpp/policer/tenant1/interface/<invalid>/memif1",
+			expectedPolicer:   "tenant1",
+			expectedIface:     "memif1",
+			expectedDirection: InvalidKeyPart,
+			expectedIsValid:   true,
+		},
+		{
+			name: "unknown direction",
+			key:  "vpp/policer/tenant1/interface/both/memif1",
+		},
+		{
+			name: "missing interface",
+			key:  "vpp/policer/tenant1/interface/input",
+		},
+		{
+			name: "policer key",
+			key:  "config/vpp/policer/v2/policer/tenant1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			policer, iface, direction, isValid := ParsePolicerToInterfaceKey(test.key)
+			Expect(isValid).To(Equal(test.expectedIsValid))
+			Expect(policer).To(Equal(test.expectedPolicer))
+			Expect(iface).To(Equal(test.expectedIface))
+			Expect(direction).To(Equal(test.expectedDirection))
+		})
+	}
+}
+
+func TestToInterfaceKey(t *testing.T) {
+	RegisterTestingT(t)
+	Expect(ToInterfaceKey("tenant1", "memif1", InputDirection)).To(Equal("vpp/policer/tenant1/interface/input/memif1"))
+	Expect(ToInterfaceKey("", "", "both")).To(Equal("vpp/policer/<invalid>/interface/<invalid>/<invalid>"))
+}
@@ -0,0 +1,672 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/policer/policer.proto
+
+package vpp_policer
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RateType defines units of the rates.
+type Policer_RateType int32
+
+const (
+	Policer_KBPS Policer_RateType = 0
+	Policer_PPS  Policer_RateType = 1
+)
+
+// Enum value maps for Policer_RateType.
+var (
+	Policer_RateType_name = map[int32]string{
+		0: "KBPS",
+		1: "PPS",
+	}
+	Policer_RateType_value = map[string]int32{
+		"KBPS": 0,
+		"PPS":  1,
+	}
+)
+
+func (x Policer_RateType) Enum() *Policer_RateType {
+	p := new(Policer_RateType)
+	*p = x
+	return p
+}
+
+func (x Policer_RateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Policer_RateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_policer_policer_proto_enumTypes[0].Descriptor()
+}
+
+func (Policer_RateType) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_policer_policer_proto_enumTypes[0]
+}
+
+func (x Policer_RateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Policer_RateType.Descriptor instead.
+func (Policer_RateType) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_policer_policer_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// RoundType defines how the configured rates are rounded.
+type Policer_RoundType int32
+
+const (
+	Policer_CLOSEST Policer_RoundType = 0
+	Policer_UP      Policer_RoundType = 1
+	Policer_DOWN    Policer_RoundType = 2
+)
+
+// Enum value maps for Policer_RoundType.
+var (
+	Policer_RoundType_name = map[int32]string{
+		0: "CLOSEST",
+		1: "UP",
+		2: "DOWN",
+	}
+	Policer_RoundType_value = map[string]int32{
+		"CLOSEST": 0,
+		"UP":      1,
+		"DOWN":    2,
+	}
+)
+
+func (x Policer_RoundType) Enum() *Policer_RoundType {
+	p := new(Policer_RoundType)
+	*p = x
+	return p
+}
+
+func (x Policer_RoundType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Policer_RoundType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_policer_policer_proto_enumTypes[1].Descriptor()
+}
+
+func (Policer_RoundType) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_policer_policer_proto_enumTypes[1]
+}
+
+func (x Policer_RoundType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Policer_RoundType.Descriptor instead.
+func (Policer_RoundType) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_policer_policer_proto_rawDescGZIP(), []int{0, 1}
+}
+
+// Type is the policer algorithm.
+type Policer_Type int32
+
+const (
+	Policer_TYPE_1R2C             Policer_Type = 0
+	Policer_TYPE_1R3C_RFC_2697    Policer_Type = 1
+	Policer_TYPE_2R3C_RFC_2698    Policer_Type = 2
+	Policer_TYPE_2R3C_RFC_4115    Policer_Type = 3
+	Policer_TYPE_2R3C_RFC_MEF5CF1 Policer_Type = 4
+)
+
+// Enum value maps for Policer_Type.
+var (
+	Policer_Type_name = map[int32]string{
+		0: "TYPE_1R2C",
+		1: "TYPE_1R3C_RFC_2697",
+		2: "TYPE_2R3C_RFC_2698",
+		3: "TYPE_2R3C_RFC_4115",
+		4: "TYPE_2R3C_RFC_MEF5CF1",
+	}
+	Policer_Type_value = map[string]int32{
+		"TYPE_1R2C":             0,
+		"TYPE_1R3C_RFC_2697":    1,
+		"TYPE_2R3C_RFC_2698":    2,
+		"TYPE_2R3C_RFC_4115":    3,
+		"TYPE_2R3C_RFC_MEF5CF1": 4,
+	}
+)
+
+func (x Policer_Type) Enum() *Policer_Type {
+	p := new(Policer_Type)
+	*p = x
+	return p
+}
+
+func (x Policer_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Policer_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_policer_policer_proto_enumTypes[2].Descriptor()
+}
+
+func (Policer_Type) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_policer_policer_proto_enumTypes[2]
+}
+
+func (x Policer_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Policer_Type.Descriptor instead.
+func (Policer_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_policer_policer_proto_rawDescGZIP(), []int{0, 2}
+}
+
+type Policer_Action_Type int32
+
+const (
+	Policer_Action_DROP              Policer_Action_Type = 0
+	Policer_Action_TRANSMIT          Policer_Action_Type = 1
+	Policer_Action_MARK_AND_TRANSMIT Policer_Action_Type = 2
+)
+
+// Enum value maps for Policer_Action_Type.
+var (
+	Policer_Action_Type_name = map[int32]string{
+		0: "DROP",
+		1: "TRANSMIT",
+		2: "MARK_AND_TRANSMIT",
+	}
+	Policer_Action_Type_value = map[string]int32{
+		"DROP":              0,
+		"TRANSMIT":          1,
+		"MARK_AND_TRANSMIT": 2,
+	}
+)
+
+func (x Policer_Action_Type) Enum() *Policer_Action_Type {
+	p := new(Policer_Action_Type)
+	*p = x
+	return p
+}
+
+func (x Policer_Action_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Policer_Action_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_policer_policer_proto_enumTypes[3].Descriptor()
+}
+
+func (Policer_Action_Type) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_policer_policer_proto_enumTypes[3]
+}
+
+func (x Policer_Action_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Policer_Action_Type.Descriptor instead.
+func (Policer_Action_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_policer_policer_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+// Policer defines named VPP policer (token bucket rate limiter)
+// and interfaces it is applied to.
+type Policer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is mandatory identification of the policer (at most 63 characters).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Cir is the committed information rate.
+	Cir uint32 `protobuf:"varint,2,opt,name=cir,proto3" json:"cir,omitempty"`
+	// Eir is the excess information rate (used only by two-rate policer types).
+	Eir uint32 `protobuf:"varint,3,opt,name=eir,proto3" json:"eir,omitempty"`
+	// Cb is the committed burst size.
+	Cb uint64 `protobuf:"varint,4,opt,name=cb,proto3" json:"cb,omitempty"`
+	// Eb is the excess burst size.
+	Eb        uint64            `protobuf:"varint,5,opt,name=eb,proto3" json:"eb,omitempty"`
+	RateType  Policer_RateType  `protobuf:"varint,6,opt,name=rate_type,json=rateType,proto3,enum=ligato.vpp.policer.Policer_RateType" json:"rate_type,omitempty"`
+	RoundType Policer_RoundType `protobuf:"varint,7,opt,name=round_type,json=roundType,proto3,enum=ligato.vpp.policer.Policer_RoundType" json:"round_type,omitempty"`
+	Type      Policer_Type      `protobuf:"varint,8,opt,name=type,proto3,enum=ligato.vpp.policer.Policer_Type" json:"type,omitempty"`
+	// ColorAware enables color-aware mode.
+	ColorAware bool `protobuf:"varint,9,opt,name=color_aware,json=colorAware,proto3" json:"color_aware,omitempty"`
+	// ConformAction is applied to conforming (green) packets.
+	ConformAction *Policer_Action `protobuf:"bytes,10,opt,name=conform_action,json=conformAction,proto3" json:"conform_action,omitempty"`
+	// ExceedAction is applied to exceeding (yellow) packets.
+	ExceedAction *Policer_Action `protobuf:"bytes,11,opt,name=exceed_action,json=exceedAction,proto3" json:"exceed_action,omitempty"`
+	// ViolateAction is applied to violating (red) packets.
+	ViolateAction *Policer_Action     `protobuf:"bytes,12,opt,name=violate_action,json=violateAction,proto3" json:"violate_action,omitempty"`
+	Interfaces    *Policer_Interfaces `protobuf:"bytes,13,opt,name=interfaces,proto3" json:"interfaces,omitempty"`
+}
+
+func (x *Policer) Reset() {
+	*x = Policer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_policer_policer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Policer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policer) ProtoMessage() {}
+
+func (x *Policer) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_policer_policer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policer.ProtoReflect.Descriptor instead.
+func (*Policer) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_policer_policer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Policer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Policer) GetCir() uint32 {
+	if x != nil {
+		return x.Cir
+	}
+	return 0
+}
+
+func (x *Policer) GetEir() uint32 {
+	if x != nil {
+		return x.Eir
+	}
+	return 0
+}
+
+func (x *Policer) GetCb() uint64 {
+	if x != nil {
+		return x.Cb
+	}
+	return 0
+}
+
+func (x *Policer) GetEb() uint64 {
+	if x != nil {
+		return x.Eb
+	}
+	return 0
+}
+
+func (x *Policer) GetRateType() Policer_RateType {
+	if x != nil {
+		return x.RateType
+	}
+	return Policer_KBPS
+}
+
+func (x *Policer) GetRoundType() Policer_RoundType {
+	if x != nil {
+		return x.RoundType
+	}
+	return Policer_CLOSEST
+}
+
+func (x *Policer) GetType() Policer_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Policer_TYPE_1R2C
+}
+
+func (x *Policer) GetColorAware() bool {
+	if x != nil {
+		return x.ColorAware
+	}
+	return false
+}
+
+func (x *Policer) GetConformAction() *Policer_Action {
+	if x != nil {
+		return x.ConformAction
+	}
+	return nil
+}
+
+func (x *Policer) GetExceedAction() *Policer_Action {
+	if x != nil {
+		return x.ExceedAction
+	}
+	return nil
+}
+
+func (x *Policer) GetViolateAction() *Policer_Action {
+	if x != nil {
+		return x.ViolateAction
+	}
+	return nil
+}
+
+func (x *Policer) GetInterfaces() *Policer_Interfaces {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+// Action defines what is done with packets of a given color.
+type Policer_Action struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type Policer_Action_Type `protobuf:"varint,1,opt,name=type,proto3,enum=ligato.vpp.policer.Policer_Action_Type" json:"type,omitempty"`
+	// Dscp is the DSCP value (0-63) used to mark packets with MARK_AND_TRANSMIT.
+	Dscp uint32 `protobuf:"varint,2,opt,name=dscp,proto3" json:"dscp,omitempty"`
+}
+
+func (x *Policer_Action) Reset() {
+	*x = Policer_Action{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_policer_policer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Policer_Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policer_Action) ProtoMessage() {}
+
+func (x *Policer_Action) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_policer_policer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policer_Action.ProtoReflect.Descriptor instead.
+func (*Policer_Action) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_policer_policer_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Policer_Action) GetType() Policer_Action_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Policer_Action_DROP
+}
+
+func (x *Policer_Action) GetDscp() uint32 {
+	if x != nil {
+		return x.Dscp
+	}
+	return 0
+}
+
+// Interfaces lists interfaces the policer is applied to.
+type Policer_Interfaces struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Input lists interfaces with the policer applied to received packets.
+	Input []string `protobuf:"bytes,1,rep,name=input,proto3" json:"input,omitempty"`
+	// Output lists interfaces with the policer applied to transmitted packets.
+	Output []string `protobuf:"bytes,2,rep,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Policer_Interfaces) Reset() {
+	*x = Policer_Interfaces{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_policer_policer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Policer_Interfaces) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policer_Interfaces) ProtoMessage() {}
+
+func (x *Policer_Interfaces) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_policer_policer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policer_Interfaces.ProtoReflect.Descriptor instead.
+func (*Policer_Interfaces) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_policer_policer_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Policer_Interfaces) GetInput() []string {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *Policer_Interfaces) GetOutput() []string {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+var File_ligato_vpp_policer_policer_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_policer_policer_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x65, 0x72, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x12, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x22, 0xfc, 0x07, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x63, 0x69, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x69, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x69, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x62,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x63, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x62,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x65, 0x62, 0x12, 0x41, 0x0a, 0x09, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a,
+	0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x5f, 0x61, 0x77, 0x61, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x41, 0x77, 0x61, 0x72, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65,
+	0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49,
+	0x0a, 0x0e, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x76, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0a, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x1a, 0x90, 0x01, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x73, 0x63,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x73, 0x63, 0x70, 0x22, 0x35, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x4d, 0x41, 0x52, 0x4b, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4d,
+	0x49, 0x54, 0x10, 0x02, 0x1a, 0x3a, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x22, 0x1d, 0x0a, 0x08, 0x52, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04,
+	0x4b, 0x42, 0x50, 0x53, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x50, 0x53, 0x10, 0x01, 0x22,
+	0x2a, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x22, 0x78, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x31, 0x52, 0x32, 0x43,
+	0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x31, 0x52, 0x33, 0x43, 0x5f,
+	0x52, 0x46, 0x43, 0x5f, 0x32, 0x36, 0x39, 0x37, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x32, 0x52, 0x33, 0x43, 0x5f, 0x52, 0x46, 0x43, 0x5f, 0x32, 0x36, 0x39, 0x38,
+	0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x32, 0x52, 0x33, 0x43, 0x5f,
+	0x52, 0x46, 0x43, 0x5f, 0x34, 0x31, 0x31, 0x35, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x32, 0x52, 0x33, 0x43, 0x5f, 0x52, 0x46, 0x43, 0x5f, 0x4d, 0x45, 0x46, 0x35,
+	0x43, 0x46, 0x31, 0x10, 0x04, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f,
+	0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
+	0x76, 0x70, 0x70, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x3b, 0x76, 0x70, 0x70, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_policer_policer_proto_rawDescOnce sync.Once
+	file_ligato_vpp_policer_policer_proto_rawDescData = file_ligato_vpp_policer_policer_proto_rawDesc
+)
+
+func file_ligato_vpp_policer_policer_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_policer_policer_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_policer_policer_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_policer_policer_proto_rawDescData)
+	})
+	return file_ligato_vpp_policer_policer_proto_rawDescData
+}
+
+var file_ligato_vpp_policer_policer_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_ligato_vpp_policer_policer_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ligato_vpp_policer_policer_proto_goTypes = []interface{}{
+	(Policer_RateType)(0),      // 0: ligato.vpp.policer.Policer.RateType
+	(Policer_RoundType)(0),     // 1: ligato.vpp.policer.Policer.RoundType
+	(Policer_Type)(0),          // 2: ligato.vpp.policer.Policer.Type
+	(Policer_Action_Type)(0),   // 3: ligato.vpp.policer.Policer.Action.Type
+	(*Policer)(nil),            // 4: ligato.vpp.policer.Policer
+	(*Policer_Action)(nil),     // 5: ligato.vpp.policer.Policer.Action
+	(*Policer_Interfaces)(nil), // 6: ligato.vpp.policer.Policer.Interfaces
+}
+var file_ligato_vpp_policer_policer_proto_depIdxs = []int32{
+	0, // 0: ligato.vpp.policer.Policer.rate_type:type_name -> ligato.vpp.policer.Policer.RateType
+	1, // 1: ligato.vpp.policer.Policer.round_type:type_name -> ligato.vpp.policer.Policer.RoundType
+	2, // 2: ligato.vpp.policer.Policer.type:type_name -> ligato.vpp.policer.Policer.Type
+	5, // 3: ligato.vpp.policer.Policer.conform_action:type_name -> ligato.vpp.policer.Policer.Action
+	5, // 4: ligato.vpp.policer.Policer.exceed_action:type_name -> ligato.vpp.policer.Policer.Action
+	5, // 5: ligato.vpp.policer.Policer.violate_action:type_name -> ligato.vpp.policer.Policer.Action
+	6, // 6: ligato.vpp.policer.Policer.interfaces:type_name -> ligato.vpp.policer.Policer.Interfaces
+	3, // 7: ligato.vpp.policer.Policer.Action.type:type_name -> ligato.vpp.policer.Policer.Action.Type
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_policer_policer_proto_init() }
+func file_ligato_vpp_policer_policer_proto_init() {
+	if File_ligato_vpp_policer_policer_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_policer_policer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Policer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_policer_policer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Policer_Action); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_policer_policer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Policer_Interfaces); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_policer_policer_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_policer_policer_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_policer_policer_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_policer_policer_proto_enumTypes,
+		MessageInfos:      file_ligato_vpp_policer_policer_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_policer_policer_proto = out.File
+	file_ligato_vpp_policer_policer_proto_rawDesc = nil
+	file_ligato_vpp_policer_policer_proto_goTypes = nil
+	file_ligato_vpp_policer_policer_proto_depIdxs = nil
+}
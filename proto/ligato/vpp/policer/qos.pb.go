@@ -0,0 +1,471 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: ligato/vpp/policer/qos.proto
+
+package vpp_policer
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QosSource identifies the packet header field carrying the QoS bits.
+type QosSource int32
+
+const (
+	QosSource_EXT  QosSource = 0
+	QosSource_VLAN QosSource = 1
+	QosSource_MPLS QosSource = 2
+	QosSource_IP   QosSource = 3
+)
+
+// Enum value maps for QosSource.
+var (
+	QosSource_name = map[int32]string{
+		0: "EXT",
+		1: "VLAN",
+		2: "MPLS",
+		3: "IP",
+	}
+	QosSource_value = map[string]int32{
+		"EXT":  0,
+		"VLAN": 1,
+		"MPLS": 2,
+		"IP":   3,
+	}
+)
+
+func (x QosSource) Enum() *QosSource {
+	p := new(QosSource)
+	*p = x
+	return p
+}
+
+func (x QosSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QosSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_policer_qos_proto_enumTypes[0].Descriptor()
+}
+
+func (QosSource) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_policer_qos_proto_enumTypes[0]
+}
+
+func (x QosSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QosSource.Descriptor instead.
+func (QosSource) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_policer_qos_proto_rawDescGZIP(), []int{0}
+}
+
+// QosRecord enables recording of QoS bits from the given header
+// of packets received on the interface.
+type QosRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface string    `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Source    QosSource `protobuf:"varint,2,opt,name=source,proto3,enum=ligato.vpp.policer.QosSource" json:"source,omitempty"`
+}
+
+func (x *QosRecord) Reset() {
+	*x = QosRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_policer_qos_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QosRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QosRecord) ProtoMessage() {}
+
+func (x *QosRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_policer_qos_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QosRecord.ProtoReflect.Descriptor instead.
+func (*QosRecord) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_policer_qos_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QosRecord) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *QosRecord) GetSource() QosSource {
+	if x != nil {
+		return x.Source
+	}
+	return QosSource_EXT
+}
+
+// QosEgressMap defines translation of recorded QoS bits into the QoS bits
+// written into the packets on output (see QosMark).
+type QosEgressMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id identifies the egress map.
+	Id   uint32              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rows []*QosEgressMap_Row `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *QosEgressMap) Reset() {
+	*x = QosEgressMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_policer_qos_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QosEgressMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QosEgressMap) ProtoMessage() {}
+
+func (x *QosEgressMap) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_policer_qos_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QosEgressMap.ProtoReflect.Descriptor instead.
+func (*QosEgressMap) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_policer_qos_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QosEgressMap) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QosEgressMap) GetRows() []*QosEgressMap_Row {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// QosMark enables marking of QoS bits in the given header of packets
+// transmitted through the interface using the referenced egress map.
+type QosMark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interface   string    `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Source      QosSource `protobuf:"varint,2,opt,name=source,proto3,enum=ligato.vpp.policer.QosSource" json:"source,omitempty"`
+	EgressMapId uint32    `protobuf:"varint,3,opt,name=egress_map_id,json=egressMapId,proto3" json:"egress_map_id,omitempty"`
+}
+
+func (x *QosMark) Reset() {
+	*x = QosMark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_policer_qos_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QosMark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QosMark) ProtoMessage() {}
+
+func (x *QosMark) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_policer_qos_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QosMark.ProtoReflect.Descriptor instead.
+func (*QosMark) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_policer_qos_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QosMark) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *QosMark) GetSource() QosSource {
+	if x != nil {
+		return x.Source
+	}
+	return QosSource_EXT
+}
+
+func (x *QosMark) GetEgressMapId() uint32 {
+	if x != nil {
+		return x.EgressMapId
+	}
+	return 0
+}
+
+// Row maps recorded QoS values to output values for one recording source.
+// Values not present in the map translate to 0.
+type QosEgressMap_Row struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source  QosSource         `protobuf:"varint,1,opt,name=source,proto3,enum=ligato.vpp.policer.QosSource" json:"source,omitempty"`
+	Outputs map[uint32]uint32 `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *QosEgressMap_Row) Reset() {
+	*x = QosEgressMap_Row{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_policer_qos_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QosEgressMap_Row) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QosEgressMap_Row) ProtoMessage() {}
+
+func (x *QosEgressMap_Row) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_policer_qos_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QosEgressMap_Row.ProtoReflect.Descriptor instead.
+func (*QosEgressMap_Row) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_policer_qos_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *QosEgressMap_Row) GetSource() QosSource {
+	if x != nil {
+		return x.Source
+	}
+	return QosSource_EXT
+}
+
+func (x *QosEgressMap_Row) GetOutputs() map[uint32]uint32 {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+var File_ligato_vpp_policer_qos_proto protoreflect.FileDescriptor
+
+var file_ligato_vpp_policer_qos_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x65, 0x72, 0x2f, 0x71, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x65, 0x72, 0x22, 0x60, 0x0a, 0x09, 0x51, 0x6f, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x35, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x65, 0x72, 0x2e, 0x51, 0x6f, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x0c, 0x51, 0x6f, 0x73, 0x45, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x51, 0x6f, 0x73, 0x45, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x4d, 0x61, 0x70, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x1a,
+	0xc5, 0x01, 0x0a, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x51, 0x6f, 0x73,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4b,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x65, 0x72, 0x2e, 0x51, 0x6f, 0x73, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61,
+	0x70, 0x2e, 0x52, 0x6f, 0x77, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x82, 0x01, 0x0a, 0x07, 0x51, 0x6f, 0x73, 0x4d,
+	0x61, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x51, 0x6f, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x65, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x49, 0x64, 0x2a, 0x30, 0x0a, 0x09,
+	0x51, 0x6f, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x58, 0x54,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x4c, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x4d, 0x50, 0x4c, 0x53, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x50, 0x10, 0x03, 0x42, 0x40,
+	0x5a, 0x3e, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x65, 0x72, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ligato_vpp_policer_qos_proto_rawDescOnce sync.Once
+	file_ligato_vpp_policer_qos_proto_rawDescData = file_ligato_vpp_policer_qos_proto_rawDesc
+)
+
+func file_ligato_vpp_policer_qos_proto_rawDescGZIP() []byte {
+	file_ligato_vpp_policer_qos_proto_rawDescOnce.Do(func() {
+		file_ligato_vpp_policer_qos_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_vpp_policer_qos_proto_rawDescData)
+	})
+	return file_ligato_vpp_policer_qos_proto_rawDescData
+}
+
+var file_ligato_vpp_policer_qos_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ligato_vpp_policer_qos_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ligato_vpp_policer_qos_proto_goTypes = []interface{}{
+	(QosSource)(0),           // 0: ligato.vpp.policer.QosSource
+	(*QosRecord)(nil),        // 1: ligato.vpp.policer.QosRecord
+	(*QosEgressMap)(nil),     // 2: ligato.vpp.policer.QosEgressMap
+	(*QosMark)(nil),          // 3: ligato.vpp.policer.QosMark
+	(*QosEgressMap_Row)(nil), // 4: ligato.vpp.policer.QosEgressMap.Row
+	nil,                      // 5: ligato.vpp.policer.QosEgressMap.Row.OutputsEntry
+}
+var file_ligato_vpp_policer_qos_proto_depIdxs = []int32{
+	0, // 0: ligato.vpp.policer.QosRecord.source:type_name -> ligato.vpp.policer.QosSource
+	4, // 1: ligato.vpp.policer.QosEgressMap.rows:type_name -> ligato.vpp.policer.QosEgressMap.Row
+	0, // 2: ligato.vpp.policer.QosMark.source:type_name -> ligato.vpp.policer.QosSource
+	0, // 3: ligato.vpp.policer.QosEgressMap.Row.source:type_name -> ligato.vpp.policer.QosSource
+	5, // 4: ligato.vpp.policer.QosEgressMap.Row.outputs:type_name -> ligato.vpp.policer.QosEgressMap.Row.OutputsEntry
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_ligato_vpp_policer_qos_proto_init() }
+func file_ligato_vpp_policer_qos_proto_init() {
+	if File_ligato_vpp_policer_qos_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_vpp_policer_qos_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QosRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_policer_qos_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QosEgressMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_policer_qos_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QosMark); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_policer_qos_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QosEgressMap_Row); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ligato_vpp_policer_qos_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ligato_vpp_policer_qos_proto_goTypes,
+		DependencyIndexes: file_ligato_vpp_policer_qos_proto_depIdxs,
+		EnumInfos:         file_ligato_vpp_policer_qos_proto_enumTypes,
+		MessageInfos:      file_ligato_vpp_policer_qos_proto_msgTypes,
+	}.Build()
+	File_ligato_vpp_policer_qos_proto = out.File
+	file_ligato_vpp_policer_qos_proto_rawDesc = nil
+	file_ligato_vpp_policer_qos_proto_goTypes = nil
+	file_ligato_vpp_policer_qos_proto_depIdxs = nil
+}
@@ -9,13 +9,18 @@ package vpp
 import (
 	abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+	bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+	classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
 	dns "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/dns"
 	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 	ipfix "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipfix"
 	ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
 	l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	linuxcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/linuxcp"
+	mpls "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/mpls"
 	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+	policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
 	punt "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/punt"
 	srv6 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/srv6"
 	wireguard "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/wireguard"
@@ -38,41 +43,65 @@ type ConfigData struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Interfaces             []*interfaces.Interface         `protobuf:"bytes,10,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
-	Spans                  []*interfaces.Span              `protobuf:"bytes,11,rep,name=spans,proto3" json:"spans,omitempty"`
-	Acls                   []*acl.ACL                      `protobuf:"bytes,20,rep,name=acls,proto3" json:"acls,omitempty"`
-	Abfs                   []*abf.ABF                      `protobuf:"bytes,21,rep,name=abfs,proto3" json:"abfs,omitempty"`
-	BridgeDomains          []*l2.BridgeDomain              `protobuf:"bytes,30,rep,name=bridge_domains,json=bridgeDomains,proto3" json:"bridge_domains,omitempty"`
-	Fibs                   []*l2.FIBEntry                  `protobuf:"bytes,31,rep,name=fibs,proto3" json:"fibs,omitempty"`
-	XconnectPairs          []*l2.XConnectPair              `protobuf:"bytes,32,rep,name=xconnect_pairs,json=xconnectPairs,proto3" json:"xconnect_pairs,omitempty"`
-	Routes                 []*l3.Route                     `protobuf:"bytes,40,rep,name=routes,proto3" json:"routes,omitempty"`
-	Arps                   []*l3.ARPEntry                  `protobuf:"bytes,41,rep,name=arps,proto3" json:"arps,omitempty"`
-	ProxyArp               *l3.ProxyARP                    `protobuf:"bytes,42,opt,name=proxy_arp,json=proxyArp,proto3" json:"proxy_arp,omitempty"`
-	IpscanNeighbor         *l3.IPScanNeighbor              `protobuf:"bytes,43,opt,name=ipscan_neighbor,json=ipscanNeighbor,proto3" json:"ipscan_neighbor,omitempty"`
-	Vrfs                   []*l3.VrfTable                  `protobuf:"bytes,44,rep,name=vrfs,proto3" json:"vrfs,omitempty"`
-	L3Xconnects            []*l3.L3XConnect                `protobuf:"bytes,45,rep,name=l3xconnects,proto3" json:"l3xconnects,omitempty"`
-	DhcpProxies            []*l3.DHCPProxy                 `protobuf:"bytes,46,rep,name=dhcp_proxies,json=dhcpProxies,proto3" json:"dhcp_proxies,omitempty"`
-	TeibEntries            []*l3.TeibEntry                 `protobuf:"bytes,47,rep,name=teib_entries,json=teibEntries,proto3" json:"teib_entries,omitempty"`
-	Nat44Global            *nat.Nat44Global                `protobuf:"bytes,50,opt,name=nat44_global,json=nat44Global,proto3" json:"nat44_global,omitempty"`
-	Dnat44S                []*nat.DNat44                   `protobuf:"bytes,51,rep,name=dnat44s,proto3" json:"dnat44s,omitempty"`
-	Nat44Interfaces        []*nat.Nat44Interface           `protobuf:"bytes,52,rep,name=nat44_interfaces,json=nat44Interfaces,proto3" json:"nat44_interfaces,omitempty"`
-	Nat44Pools             []*nat.Nat44AddressPool         `protobuf:"bytes,53,rep,name=nat44_pools,json=nat44Pools,proto3" json:"nat44_pools,omitempty"`
-	IpsecSpds              []*ipsec.SecurityPolicyDatabase `protobuf:"bytes,60,rep,name=ipsec_spds,json=ipsecSpds,proto3" json:"ipsec_spds,omitempty"`
-	IpsecSas               []*ipsec.SecurityAssociation    `protobuf:"bytes,61,rep,name=ipsec_sas,json=ipsecSas,proto3" json:"ipsec_sas,omitempty"`
-	IpsecTunnelProtections []*ipsec.TunnelProtection       `protobuf:"bytes,62,rep,name=ipsec_tunnel_protections,json=ipsecTunnelProtections,proto3" json:"ipsec_tunnel_protections,omitempty"`
-	IpsecSps               []*ipsec.SecurityPolicy         `protobuf:"bytes,63,rep,name=ipsec_sps,json=ipsecSps,proto3" json:"ipsec_sps,omitempty"`
-	PuntIpredirects        []*punt.IPRedirect              `protobuf:"bytes,70,rep,name=punt_ipredirects,json=puntIpredirects,proto3" json:"punt_ipredirects,omitempty"`
-	PuntTohosts            []*punt.ToHost                  `protobuf:"bytes,71,rep,name=punt_tohosts,json=puntTohosts,proto3" json:"punt_tohosts,omitempty"`
-	PuntExceptions         []*punt.Exception               `protobuf:"bytes,72,rep,name=punt_exceptions,json=puntExceptions,proto3" json:"punt_exceptions,omitempty"`
-	Srv6Global             *srv6.SRv6Global                `protobuf:"bytes,83,opt,name=srv6_global,json=srv6Global,proto3" json:"srv6_global,omitempty"`
-	Srv6Localsids          []*srv6.LocalSID                `protobuf:"bytes,80,rep,name=srv6_localsids,json=srv6Localsids,proto3" json:"srv6_localsids,omitempty"`
-	Srv6Policies           []*srv6.Policy                  `protobuf:"bytes,81,rep,name=srv6_policies,json=srv6Policies,proto3" json:"srv6_policies,omitempty"`
-	Srv6Steerings          []*srv6.Steering                `protobuf:"bytes,82,rep,name=srv6_steerings,json=srv6Steerings,proto3" json:"srv6_steerings,omitempty"`
-	IpfixGlobal            *ipfix.IPFIX                    `protobuf:"bytes,90,opt,name=ipfix_global,json=ipfixGlobal,proto3" json:"ipfix_global,omitempty"`
-	IpfixFlowprobeParams   *ipfix.FlowProbeParams          `protobuf:"bytes,91,opt,name=ipfix_flowprobe_params,json=ipfixFlowprobeParams,proto3" json:"ipfix_flowprobe_params,omitempty"`
-	IpfixFlowprobes        []*ipfix.FlowProbeFeature       `protobuf:"bytes,92,rep,name=ipfix_flowprobes,json=ipfixFlowprobes,proto3" json:"ipfix_flowprobes,omitempty"`
-	WgPeers                []*wireguard.Peer               `protobuf:"bytes,93,rep,name=wg_peers,json=wgPeers,proto3" json:"wg_peers,omitempty"`
-	DnsCache               *dns.DNSCache                   `protobuf:"bytes,100,opt,name=dns_cache,json=dnsCache,proto3" json:"dns_cache,omitempty"`
+	Interfaces              []*interfaces.Interface              `protobuf:"bytes,10,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	Spans                   []*interfaces.Span                   `protobuf:"bytes,11,rep,name=spans,proto3" json:"spans,omitempty"`
+	Acls                    []*acl.ACL                           `protobuf:"bytes,20,rep,name=acls,proto3" json:"acls,omitempty"`
+	Abfs                    []*abf.ABF                           `protobuf:"bytes,21,rep,name=abfs,proto3" json:"abfs,omitempty"`
+	BridgeDomains           []*l2.BridgeDomain                   `protobuf:"bytes,30,rep,name=bridge_domains,json=bridgeDomains,proto3" json:"bridge_domains,omitempty"`
+	Fibs                    []*l2.FIBEntry                       `protobuf:"bytes,31,rep,name=fibs,proto3" json:"fibs,omitempty"`
+	XconnectPairs           []*l2.XConnectPair                   `protobuf:"bytes,32,rep,name=xconnect_pairs,json=xconnectPairs,proto3" json:"xconnect_pairs,omitempty"`
+	Routes                  []*l3.Route                          `protobuf:"bytes,40,rep,name=routes,proto3" json:"routes,omitempty"`
+	Arps                    []*l3.ARPEntry                       `protobuf:"bytes,41,rep,name=arps,proto3" json:"arps,omitempty"`
+	ProxyArp                *l3.ProxyARP                         `protobuf:"bytes,42,opt,name=proxy_arp,json=proxyArp,proto3" json:"proxy_arp,omitempty"`
+	IpscanNeighbor          *l3.IPScanNeighbor                   `protobuf:"bytes,43,opt,name=ipscan_neighbor,json=ipscanNeighbor,proto3" json:"ipscan_neighbor,omitempty"`
+	Vrfs                    []*l3.VrfTable                       `protobuf:"bytes,44,rep,name=vrfs,proto3" json:"vrfs,omitempty"`
+	L3Xconnects             []*l3.L3XConnect                     `protobuf:"bytes,45,rep,name=l3xconnects,proto3" json:"l3xconnects,omitempty"`
+	DhcpProxies             []*l3.DHCPProxy                      `protobuf:"bytes,46,rep,name=dhcp_proxies,json=dhcpProxies,proto3" json:"dhcp_proxies,omitempty"`
+	TeibEntries             []*l3.TeibEntry                      `protobuf:"bytes,47,rep,name=teib_entries,json=teibEntries,proto3" json:"teib_entries,omitempty"`
+	MplsTables              []*mpls.MplsTable                    `protobuf:"bytes,110,rep,name=mpls_tables,json=mplsTables,proto3" json:"mpls_tables,omitempty"`
+	MplsInterfaces          []*mpls.MplsInterface                `protobuf:"bytes,111,rep,name=mpls_interfaces,json=mplsInterfaces,proto3" json:"mpls_interfaces,omitempty"`
+	MplsLocalLabels         []*mpls.LocalLabel                   `protobuf:"bytes,112,rep,name=mpls_local_labels,json=mplsLocalLabels,proto3" json:"mpls_local_labels,omitempty"`
+	Policers                []*policer.Policer                   `protobuf:"bytes,120,rep,name=policers,proto3" json:"policers,omitempty"`
+	QosRecords              []*policer.QosRecord                 `protobuf:"bytes,121,rep,name=qos_records,json=qosRecords,proto3" json:"qos_records,omitempty"`
+	QosEgressMaps           []*policer.QosEgressMap              `protobuf:"bytes,122,rep,name=qos_egress_maps,json=qosEgressMaps,proto3" json:"qos_egress_maps,omitempty"`
+	QosMarks                []*policer.QosMark                   `protobuf:"bytes,123,rep,name=qos_marks,json=qosMarks,proto3" json:"qos_marks,omitempty"`
+	BfdSessions             []*bfd.BfdSession                    `protobuf:"bytes,130,rep,name=bfd_sessions,json=bfdSessions,proto3" json:"bfd_sessions,omitempty"`
+	BfdAuthKeys             []*bfd.BfdAuthKey                    `protobuf:"bytes,131,rep,name=bfd_auth_keys,json=bfdAuthKeys,proto3" json:"bfd_auth_keys,omitempty"`
+	LinuxcpGlobal           *linuxcp.LinuxCpGlobal               `protobuf:"bytes,140,opt,name=linuxcp_global,json=linuxcpGlobal,proto3" json:"linuxcp_global,omitempty"`
+	LinuxcpPairs            []*linuxcp.LinuxCpPair               `protobuf:"bytes,141,rep,name=linuxcp_pairs,json=linuxcpPairs,proto3" json:"linuxcp_pairs,omitempty"`
+	ClassifyTables          []*classifier.ClassifyTable          `protobuf:"bytes,150,rep,name=classify_tables,json=classifyTables,proto3" json:"classify_tables,omitempty"`
+	ClassifySessions        []*classifier.ClassifySession        `protobuf:"bytes,151,rep,name=classify_sessions,json=classifySessions,proto3" json:"classify_sessions,omitempty"`
+	ClassifyInterfaces      []*classifier.ClassifyInterface      `protobuf:"bytes,152,rep,name=classify_interfaces,json=classifyInterfaces,proto3" json:"classify_interfaces,omitempty"`
+	Nat64Global             *nat.Nat64Global                     `protobuf:"bytes,160,opt,name=nat64_global,json=nat64Global,proto3" json:"nat64_global,omitempty"`
+	Nat64Prefixes           []*nat.Nat64Prefix                   `protobuf:"bytes,161,rep,name=nat64_prefixes,json=nat64Prefixes,proto3" json:"nat64_prefixes,omitempty"`
+	Nat64Pools              []*nat.Nat64AddressPool              `protobuf:"bytes,162,rep,name=nat64_pools,json=nat64Pools,proto3" json:"nat64_pools,omitempty"`
+	Nat64Interfaces         []*nat.Nat64Interface                `protobuf:"bytes,163,rep,name=nat64_interfaces,json=nat64Interfaces,proto3" json:"nat64_interfaces,omitempty"`
+	Nat64StaticBibs         []*nat.Nat64StaticBib                `protobuf:"bytes,164,rep,name=nat64_static_bibs,json=nat64StaticBibs,proto3" json:"nat64_static_bibs,omitempty"`
+	Det44Global             *nat.Det44Global                     `protobuf:"bytes,170,opt,name=det44_global,json=det44Global,proto3" json:"det44_global,omitempty"`
+	Det44Mappings           []*nat.Det44Mapping                  `protobuf:"bytes,171,rep,name=det44_mappings,json=det44Mappings,proto3" json:"det44_mappings,omitempty"`
+	Det44Interfaces         []*nat.Det44Interface                `protobuf:"bytes,172,rep,name=det44_interfaces,json=det44Interfaces,proto3" json:"det44_interfaces,omitempty"`
+	Ip6RouterAdvertisements []*interfaces.IP6RouterAdvertisement `protobuf:"bytes,180,rep,name=ip6_router_advertisements,json=ip6RouterAdvertisements,proto3" json:"ip6_router_advertisements,omitempty"`
+	Ip6NdProxies            []*interfaces.IP6NDProxy             `protobuf:"bytes,181,rep,name=ip6nd_proxies,json=ip6ndProxies,proto3" json:"ip6nd_proxies,omitempty"`
+	Nat44Global             *nat.Nat44Global                     `protobuf:"bytes,50,opt,name=nat44_global,json=nat44Global,proto3" json:"nat44_global,omitempty"`
+	Dnat44S                 []*nat.DNat44                        `protobuf:"bytes,51,rep,name=dnat44s,proto3" json:"dnat44s,omitempty"`
+	Nat44Interfaces         []*nat.Nat44Interface                `protobuf:"bytes,52,rep,name=nat44_interfaces,json=nat44Interfaces,proto3" json:"nat44_interfaces,omitempty"`
+	Nat44Pools              []*nat.Nat44AddressPool              `protobuf:"bytes,53,rep,name=nat44_pools,json=nat44Pools,proto3" json:"nat44_pools,omitempty"`
+	IpsecSpds               []*ipsec.SecurityPolicyDatabase      `protobuf:"bytes,60,rep,name=ipsec_spds,json=ipsecSpds,proto3" json:"ipsec_spds,omitempty"`
+	IpsecSas                []*ipsec.SecurityAssociation         `protobuf:"bytes,61,rep,name=ipsec_sas,json=ipsecSas,proto3" json:"ipsec_sas,omitempty"`
+	IpsecTunnelProtections  []*ipsec.TunnelProtection            `protobuf:"bytes,62,rep,name=ipsec_tunnel_protections,json=ipsecTunnelProtections,proto3" json:"ipsec_tunnel_protections,omitempty"`
+	IpsecSps                []*ipsec.SecurityPolicy              `protobuf:"bytes,63,rep,name=ipsec_sps,json=ipsecSps,proto3" json:"ipsec_sps,omitempty"`
+	PuntIpredirects         []*punt.IPRedirect                   `protobuf:"bytes,70,rep,name=punt_ipredirects,json=puntIpredirects,proto3" json:"punt_ipredirects,omitempty"`
+	PuntTohosts             []*punt.ToHost                       `protobuf:"bytes,71,rep,name=punt_tohosts,json=puntTohosts,proto3" json:"punt_tohosts,omitempty"`
+	PuntExceptions          []*punt.Exception                    `protobuf:"bytes,72,rep,name=punt_exceptions,json=puntExceptions,proto3" json:"punt_exceptions,omitempty"`
+	Srv6Global              *srv6.SRv6Global                     `protobuf:"bytes,83,opt,name=srv6_global,json=srv6Global,proto3" json:"srv6_global,omitempty"`
+	Srv6Localsids           []*srv6.LocalSID                     `protobuf:"bytes,80,rep,name=srv6_localsids,json=srv6Localsids,proto3" json:"srv6_localsids,omitempty"`
+	Srv6Policies            []*srv6.Policy                       `protobuf:"bytes,81,rep,name=srv6_policies,json=srv6Policies,proto3" json:"srv6_policies,omitempty"`
+	Srv6Steerings           []*srv6.Steering                     `protobuf:"bytes,82,rep,name=srv6_steerings,json=srv6Steerings,proto3" json:"srv6_steerings,omitempty"`
+	IpfixGlobal             *ipfix.IPFIX                         `protobuf:"bytes,90,opt,name=ipfix_global,json=ipfixGlobal,proto3" json:"ipfix_global,omitempty"`
+	IpfixFlowprobeParams    *ipfix.FlowProbeParams               `protobuf:"bytes,91,opt,name=ipfix_flowprobe_params,json=ipfixFlowprobeParams,proto3" json:"ipfix_flowprobe_params,omitempty"`
+	IpfixFlowprobes         []*ipfix.FlowProbeFeature            `protobuf:"bytes,92,rep,name=ipfix_flowprobes,json=ipfixFlowprobes,proto3" json:"ipfix_flowprobes,omitempty"`
+	WgPeers                 []*wireguard.Peer                    `protobuf:"bytes,93,rep,name=wg_peers,json=wgPeers,proto3" json:"wg_peers,omitempty"`
+	DnsCache                *dns.DNSCache                        `protobuf:"bytes,100,opt,name=dns_cache,json=dnsCache,proto3" json:"dns_cache,omitempty"`
 }
 
 func (x *ConfigData) Reset() {
@@ -212,6 +241,174 @@ func (x *ConfigData) GetTeibEntries() []*l3.TeibEntry {
 	return nil
 }
 
+func (x *ConfigData) GetMplsTables() []*mpls.MplsTable {
+	if x != nil {
+		return x.MplsTables
+	}
+	return nil
+}
+
+func (x *ConfigData) GetMplsInterfaces() []*mpls.MplsInterface {
+	if x != nil {
+		return x.MplsInterfaces
+	}
+	return nil
+}
+
+func (x *ConfigData) GetMplsLocalLabels() []*mpls.LocalLabel {
+	if x != nil {
+		return x.MplsLocalLabels
+	}
+	return nil
+}
+
+func (x *ConfigData) GetPolicers() []*policer.Policer {
+	if x != nil {
+		return x.Policers
+	}
+	return nil
+}
+
+func (x *ConfigData) GetQosRecords() []*policer.QosRecord {
+	if x != nil {
+		return x.QosRecords
+	}
+	return nil
+}
+
+func (x *ConfigData) GetQosEgressMaps() []*policer.QosEgressMap {
+	if x != nil {
+		return x.QosEgressMaps
+	}
+	return nil
+}
+
+func (x *ConfigData) GetQosMarks() []*policer.QosMark {
+	if x != nil {
+		return x.QosMarks
+	}
+	return nil
+}
+
+func (x *ConfigData) GetBfdSessions() []*bfd.BfdSession {
+	if x != nil {
+		return x.BfdSessions
+	}
+	return nil
+}
+
+func (x *ConfigData) GetBfdAuthKeys() []*bfd.BfdAuthKey {
+	if x != nil {
+		return x.BfdAuthKeys
+	}
+	return nil
+}
+
+func (x *ConfigData) GetLinuxcpGlobal() *linuxcp.LinuxCpGlobal {
+	if x != nil {
+		return x.LinuxcpGlobal
+	}
+	return nil
+}
+
+func (x *ConfigData) GetLinuxcpPairs() []*linuxcp.LinuxCpPair {
+	if x != nil {
+		return x.LinuxcpPairs
+	}
+	return nil
+}
+
+func (x *ConfigData) GetClassifyTables() []*classifier.ClassifyTable {
+	if x != nil {
+		return x.ClassifyTables
+	}
+	return nil
+}
+
+func (x *ConfigData) GetClassifySessions() []*classifier.ClassifySession {
+	if x != nil {
+		return x.ClassifySessions
+	}
+	return nil
+}
+
+func (x *ConfigData) GetClassifyInterfaces() []*classifier.ClassifyInterface {
+	if x != nil {
+		return x.ClassifyInterfaces
+	}
+	return nil
+}
+
+func (x *ConfigData) GetNat64Global() *nat.Nat64Global {
+	if x != nil {
+		return x.Nat64Global
+	}
+	return nil
+}
+
+func (x *ConfigData) GetNat64Prefixes() []*nat.Nat64Prefix {
+	if x != nil {
+		return x.Nat64Prefixes
+	}
+	return nil
+}
+
+func (x *ConfigData) GetNat64Pools() []*nat.Nat64AddressPool {
+	if x != nil {
+		return x.Nat64Pools
+	}
+	return nil
+}
+
+func (x *ConfigData) GetNat64Interfaces() []*nat.Nat64Interface {
+	if x != nil {
+		return x.Nat64Interfaces
+	}
+	return nil
+}
+
+func (x *ConfigData) GetNat64StaticBibs() []*nat.Nat64StaticBib {
+	if x != nil {
+		return x.Nat64StaticBibs
+	}
+	return nil
+}
+
+func (x *ConfigData) GetDet44Global() *nat.Det44Global {
+	if x != nil {
+		return x.Det44Global
+	}
+	return nil
+}
+
+func (x *ConfigData) GetDet44Mappings() []*nat.Det44Mapping {
+	if x != nil {
+		return x.Det44Mappings
+	}
+	return nil
+}
+
+func (x *ConfigData) GetDet44Interfaces() []*nat.Det44Interface {
+	if x != nil {
+		return x.Det44Interfaces
+	}
+	return nil
+}
+
+func (x *ConfigData) GetIp6RouterAdvertisements() []*interfaces.IP6RouterAdvertisement {
+	if x != nil {
+		return x.Ip6RouterAdvertisements
+	}
+	return nil
+}
+
+func (x *ConfigData) GetIp6NdProxies() []*interfaces.IP6NDProxy {
+	if x != nil {
+		return x.Ip6NdProxies
+	}
+	return nil
+}
+
 func (x *ConfigData) GetNat44Global() *nat.Nat44Global {
 	if x != nil {
 		return x.Nat44Global
@@ -357,7 +554,8 @@ type Notification struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Interface *interfaces.InterfaceNotification `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Interface  *interfaces.InterfaceNotification `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	BfdSession *bfd.BfdSessionNotification       `protobuf:"bytes,2,opt,name=bfd_session,json=bfdSession,proto3" json:"bfd_session,omitempty"`
 }
 
 func (x *Notification) Reset() {
@@ -399,6 +597,13 @@ func (x *Notification) GetInterface() *interfaces.InterfaceNotification {
 	return nil
 }
 
+func (x *Notification) GetBfdSession() *bfd.BfdSessionNotification {
+	if x != nil {
+		return x.BfdSession
+	}
+	return nil
+}
+
 type Stats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -455,194 +660,325 @@ var file_ligato_vpp_vpp_proto_rawDesc = []byte{
 	0x62, 0x66, 0x2f, 0x61, 0x62, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69,
 	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x61, 0x63, 0x6c, 0x2f, 0x61, 0x63, 0x6c,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
-	0x70, 0x70, 0x2f, 0x64, 0x6e, 0x73, 0x2f, 0x64, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x66,
-	0x69, 0x78, 0x2f, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20,
-	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x66, 0x69, 0x78,
-	0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x25, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
-	0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x73,
-	0x70, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x70, 0x70, 0x2f, 0x62, 0x66, 0x64, 0x2f, 0x62, 0x66, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x26, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2f, 0x76, 0x70, 0x70, 0x2f, 0x64, 0x6e, 0x73, 0x2f, 0x64, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69,
+	0x70, 0x66, 0x69, 0x78, 0x2f, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x20, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x66,
+	0x69, 0x78, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x25, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6c, 0x69, 0x67, 0x61, 0x74,
 	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2f, 0x69,
-	0x70, 0x73, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x32, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x32, 0x2f, 0x66, 0x69, 0x62,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
-	0x70, 0x70, 0x2f, 0x6c, 0x32, 0x2f, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
-	0x2f, 0x6c, 0x33, 0x2f, 0x61, 0x72, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x6c, 0x33, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70,
-	0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x6c, 0x33, 0x78, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x19, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x74, 0x65, 0x69, 0x62, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
-	0x2f, 0x6c, 0x33, 0x2f, 0x76, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x2f, 0x6e, 0x61,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
-	0x76, 0x70, 0x70, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f,
-	0x73, 0x72, 0x76, 0x36, 0x2f, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x24, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x77, 0x69, 0x72, 0x65,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x11, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x70,
-	0x61, 0x6e, 0x52, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x61, 0x63, 0x6c,
-	0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63, 0x6c, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x61, 0x63,
-	0x6c, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x61, 0x62, 0x66, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x62,
-	0x66, 0x2e, 0x41, 0x42, 0x46, 0x52, 0x04, 0x61, 0x62, 0x66, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x1e, 0x20,
+	0x2f, 0x69, 0x70, 0x36, 0x6e, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x2f, 0x73, 0x70, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70,
+	0x73, 0x65, 0x63, 0x2f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x21, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x32, 0x2f, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c,
+	0x32, 0x2f, 0x66, 0x69, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x32, 0x2f, 0x78, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x61, 0x72, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x16, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c,
+	0x33, 0x2f, 0x6c, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x6c, 0x33, 0x78, 0x63, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
+	0x2f, 0x6c, 0x33, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x74,
+	0x65, 0x69, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x76, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x20, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x63, 0x70, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
+	0x2f, 0x6d, 0x70, 0x6c, 0x73, 0x2f, 0x6d, 0x70, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74,
+	0x2f, 0x64, 0x65, 0x74, 0x34, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x2f, 0x6e, 0x61, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x2f, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x20, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
+	0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2f, 0x71, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x70,
+	0x75, 0x6e, 0x74, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x73, 0x72, 0x76, 0x36, 0x2f,
+	0x73, 0x72, 0x76, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x2f, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xd6, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x40, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x05, 0x73,
+	0x70, 0x61, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x14, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x61, 0x63, 0x6c, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x12, 0x27, 0x0a,
+	0x04, 0x61, 0x62, 0x66, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x62, 0x66, 0x2e, 0x41, 0x42, 0x46,
+	0x52, 0x04, 0x61, 0x62, 0x66, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x42,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x0d, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x69,
+	0x62, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x46, 0x49, 0x42, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x04, 0x66, 0x69, 0x62, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x78, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e,
+	0x58, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0d, 0x78, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x61, 0x72, 0x70,
+	0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x41, 0x52, 0x50, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x61, 0x72, 0x70, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f,
+	0x61, 0x72, 0x70, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x41,
+	0x52, 0x50, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x72, 0x70, 0x12, 0x46, 0x0a, 0x0f,
+	0x69, 0x70, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x18,
+	0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x49, 0x50, 0x53, 0x63, 0x61, 0x6e, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x52, 0x0e, 0x69, 0x70, 0x73, 0x63, 0x61, 0x6e, 0x4e, 0x65, 0x69, 0x67,
+	0x68, 0x62, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x04, 0x76, 0x72, 0x66, 0x73, 0x18, 0x2c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x6c, 0x33, 0x2e, 0x56, 0x72, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x76, 0x72, 0x66,
+	0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x33, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x2d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x4c, 0x33, 0x58, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x52, 0x0b, 0x6c, 0x33, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x3b,
+	0x0a, 0x0c, 0x64, 0x68, 0x63, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x18, 0x2e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x0b,
+	0x64, 0x68, 0x63, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x74,
+	0x65, 0x69, 0x62, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x2f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c,
+	0x33, 0x2e, 0x54, 0x65, 0x69, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x74, 0x65, 0x69,
+	0x62, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6d, 0x70, 0x6c, 0x73,
+	0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x6e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6d, 0x70, 0x6c, 0x73, 0x2e,
+	0x4d, 0x70, 0x6c, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0a, 0x6d, 0x70, 0x6c, 0x73, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0f, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x6f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6d, 0x70, 0x6c, 0x73,
+	0x2e, 0x4d, 0x70, 0x6c, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0e,
+	0x6d, 0x70, 0x6c, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x47,
+	0x0a, 0x11, 0x6d, 0x70, 0x6c, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x70, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6d, 0x70, 0x6c, 0x73, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x0f, 0x6d, 0x70, 0x6c, 0x73, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x65, 0x72, 0x73, 0x18, 0x78, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x73,
+	0x12, 0x3e, 0x0a, 0x0b, 0x71, 0x6f, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x79, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x51, 0x6f, 0x73, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x0a, 0x71, 0x6f, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x48, 0x0a, 0x0f, 0x71, 0x6f, 0x73, 0x5f, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6d,
+	0x61, 0x70, 0x73, 0x18, 0x7a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x65, 0x72, 0x2e, 0x51,
+	0x6f, 0x73, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x0d, 0x71, 0x6f, 0x73,
+	0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x70, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x71, 0x6f,
+	0x73, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x7b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x65, 0x72, 0x2e, 0x51, 0x6f, 0x73, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x08, 0x71, 0x6f, 0x73, 0x4d,
+	0x61, 0x72, 0x6b, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x66, 0x64, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x82, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x62, 0x66, 0x64, 0x2e, 0x42, 0x66, 0x64,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x62, 0x66, 0x64, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x62, 0x66, 0x64, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x83, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x62, 0x66, 0x64, 0x2e, 0x42, 0x66,
+	0x64, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x0b, 0x62, 0x66, 0x64, 0x41, 0x75, 0x74,
+	0x68, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x49, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63, 0x70,
+	0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x63, 0x70, 0x2e, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x43, 0x70, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x52, 0x0d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63, 0x70, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x12, 0x45, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63, 0x70, 0x5f, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x18, 0x8d, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x63, 0x70, 0x2e, 0x4c, 0x69,
+	0x6e, 0x75, 0x78, 0x43, 0x70, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x75, 0x78,
+	0x63, 0x70, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x0f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x69, 0x66, 0x79, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x96, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69,
+	0x66, 0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
+	0x79, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x69, 0x66, 0x79, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5a, 0x0a,
+	0x13, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x98, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x12, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x79, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x6e, 0x61, 0x74,
+	0x36, 0x34, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0xa0, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61,
+	0x74, 0x2e, 0x4e, 0x61, 0x74, 0x36, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x0b, 0x6e,
+	0x61, 0x74, 0x36, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x43, 0x0a, 0x0e, 0x6e, 0x61,
+	0x74, 0x36, 0x34, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0xa1, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x6c, 0x32, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x52, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12,
-	0x2b, 0x0a, 0x04, 0x66, 0x69, 0x62, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x46, 0x49,
-	0x42, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x66, 0x69, 0x62, 0x73, 0x12, 0x42, 0x0a, 0x0e,
-	0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x20,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
-	0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x58, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x61, 0x69,
-	0x72, 0x52, 0x0d, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73,
-	0x12, 0x2c, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33,
-	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2b,
-	0x0a, 0x04, 0x61, 0x72, 0x70, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x41, 0x52, 0x50,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x61, 0x72, 0x70, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x5f, 0x61, 0x72, 0x70, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x50,
-	0x72, 0x6f, 0x78, 0x79, 0x41, 0x52, 0x50, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x72,
-	0x70, 0x12, 0x46, 0x0a, 0x0f, 0x69, 0x70, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x6e, 0x65, 0x69, 0x67,
-	0x68, 0x62, 0x6f, 0x72, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x49, 0x50, 0x53, 0x63, 0x61,
-	0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x0e, 0x69, 0x70, 0x73, 0x63, 0x61,
-	0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x04, 0x76, 0x72, 0x66,
-	0x73, 0x18, 0x2c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x56, 0x72, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x04, 0x76, 0x72, 0x66, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x33, 0x78, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x4c, 0x33, 0x58, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x6c, 0x33, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x64, 0x68, 0x63, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x78,
-	0x69, 0x65, 0x73, 0x18, 0x2e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x52, 0x0b, 0x64, 0x68, 0x63, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73,
-	0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x65, 0x69, 0x62, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x2f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x54, 0x65, 0x69, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0b, 0x74, 0x65, 0x69, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a,
-	0x0c, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x32, 0x20,
+	0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x52, 0x0d, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12,
+	0x42, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0xa2,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x36, 0x34, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x50, 0x6f,
+	0x6f, 0x6c, 0x73, 0x12, 0x4a, 0x0a, 0x10, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0xa3, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e,
+	0x4e, 0x61, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0f,
+	0x6e, 0x61, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12,
+	0x4b, 0x0a, 0x11, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f,
+	0x62, 0x69, 0x62, 0x73, 0x18, 0xa4, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74,
+	0x36, 0x34, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x42, 0x69, 0x62, 0x52, 0x0f, 0x6e, 0x61, 0x74,
+	0x36, 0x34, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x42, 0x69, 0x62, 0x73, 0x12, 0x3f, 0x0a, 0x0c,
+	0x64, 0x65, 0x74, 0x34, 0x34, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0xaa, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x52, 0x0b, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x30, 0x0a,
-	0x07, 0x64, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x73, 0x18, 0x33, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x74, 0x34, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x52, 0x0b, 0x64, 0x65, 0x74, 0x34, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x44, 0x0a,
+	0x0e, 0x64, 0x65, 0x74, 0x34, 0x34, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0xab, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x74, 0x34, 0x34, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x34, 0x34, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x4a, 0x0a, 0x10, 0x64, 0x65, 0x74, 0x34, 0x34, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0xac, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
 	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e,
-	0x44, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x52, 0x07, 0x64, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x73, 0x12,
-	0x49, 0x0a, 0x10, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x73, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0f, 0x6e, 0x61, 0x74, 0x34, 0x34,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x6e, 0x61,
-	0x74, 0x34, 0x34, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x35, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74,
-	0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x47, 0x0a,
-	0x0a, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f, 0x73, 0x70, 0x64, 0x73, 0x18, 0x3c, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
-	0x70, 0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x09, 0x69, 0x70, 0x73,
-	0x65, 0x63, 0x53, 0x70, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f,
-	0x73, 0x61, 0x73, 0x18, 0x3d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x08, 0x69, 0x70, 0x73, 0x65, 0x63, 0x53, 0x61, 0x73, 0x12, 0x5c, 0x0a, 0x18, 0x69, 0x70,
-	0x73, 0x65, 0x63, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x3e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x16, 0x69, 0x70, 0x73, 0x65, 0x63, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f,
-	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x69, 0x70, 0x73, 0x65,
-	0x63, 0x5f, 0x73, 0x70, 0x73, 0x18, 0x3f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x69,
-	0x70, 0x73, 0x65, 0x63, 0x53, 0x70, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x70, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x70, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x18, 0x46, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70,
-	0x75, 0x6e, 0x74, 0x2e, 0x49, 0x50, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x0f,
-	0x70, 0x75, 0x6e, 0x74, 0x49, 0x70, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12,
-	0x3a, 0x0a, 0x0c, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18,
-	0x47, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x0b,
-	0x70, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x70,
-	0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x48,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
-	0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0e, 0x70, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18,
-	0x53, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x47, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x52, 0x0a, 0x73, 0x72, 0x76, 0x36, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x40,
-	0x0a, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x73, 0x69, 0x64, 0x73,
-	0x18, 0x50, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x49,
-	0x44, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x73, 0x69, 0x64, 0x73,
-	0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x18, 0x51, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x52, 0x0c, 0x73, 0x72, 0x76, 0x36, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x40,
-	0x0a, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x73, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x52, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x12, 0x3a, 0x0a, 0x0c, 0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e, 0x49, 0x50, 0x46, 0x49, 0x58, 0x52,
-	0x0b, 0x69, 0x70, 0x66, 0x69, 0x78, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x57, 0x0a, 0x16,
-	0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e,
-	0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x14, 0x69, 0x70, 0x66, 0x69, 0x78, 0x46, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x66,
-	0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0x5c, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66,
-	0x69, 0x78, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x46, 0x65, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x52, 0x0f, 0x69, 0x70, 0x66, 0x69, 0x78, 0x46, 0x6c, 0x6f, 0x77, 0x70, 0x72,
-	0x6f, 0x62, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x77, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73,
-	0x18, 0x5d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x50, 0x65,
-	0x65, 0x72, 0x52, 0x07, 0x77, 0x67, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x64,
-	0x6e, 0x73, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x64, 0x6e, 0x73, 0x2e,
-	0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x08, 0x64, 0x6e, 0x73, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x22, 0x5a, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x4c,
-	0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x42, 0x2c, 0x5a, 0x2a,
-	0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70,
-	0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x44, 0x65, 0x74, 0x34, 0x34, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0f,
+	0x64, 0x65, 0x74, 0x34, 0x34, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12,
+	0x6a, 0x0a, 0x19, 0x69, 0x70, 0x36, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0xb4, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x50, 0x36, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x17, 0x69, 0x70, 0x36, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x41, 0x64, 0x76,
+	0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x69,
+	0x70, 0x36, 0x6e, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x18, 0xb5, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x50, 0x36, 0x4e,
+	0x44, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x0c, 0x69, 0x70, 0x36, 0x6e, 0x64, 0x50, 0x72, 0x6f,
+	0x78, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x5f, 0x67, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34,
+	0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x0b, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x47, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x07, 0x64, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x73, 0x18,
+	0x33, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x44, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x52, 0x07, 0x64,
+	0x6e, 0x61, 0x74, 0x34, 0x34, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61,
+	0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x52, 0x0f, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x12, 0x41, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
+	0x18, 0x35, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x50,
+	0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f, 0x73, 0x70,
+	0x64, 0x73, 0x18, 0x3c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x09, 0x69, 0x70, 0x73, 0x65, 0x63, 0x53, 0x70, 0x64, 0x73, 0x12, 0x42, 0x0a,
+	0x09, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f, 0x73, 0x61, 0x73, 0x18, 0x3d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70,
+	0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x73, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x70, 0x73, 0x65, 0x63, 0x53, 0x61,
+	0x73, 0x12, 0x5c, 0x0a, 0x18, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x3e, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x69, 0x70, 0x73, 0x65, 0x63, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x3d, 0x0a, 0x09, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f, 0x73, 0x70, 0x73, 0x18, 0x3f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x69, 0x70, 0x73, 0x65, 0x63, 0x53, 0x70, 0x73, 0x12, 0x46,
+	0x0a, 0x10, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x46, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x49, 0x50, 0x52, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x0f, 0x70, 0x75, 0x6e, 0x74, 0x49, 0x70, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x74,
+	0x6f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x47, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x54,
+	0x6f, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x0b, 0x70, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x68, 0x6f, 0x73,
+	0x74, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x48, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x45, 0x78,
+	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x70, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x72, 0x76, 0x36, 0x5f,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x53, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x53,
+	0x52, 0x76, 0x36, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x0a, 0x73, 0x72, 0x76, 0x36, 0x47,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x73, 0x69, 0x64, 0x73, 0x18, 0x50, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x49, 0x44, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x73, 0x69, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x51, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36,
+	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0c, 0x73, 0x72, 0x76, 0x36, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x73, 0x74,
+	0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x52, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e,
+	0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x53, 0x74,
+	0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x69, 0x70, 0x66, 0x69, 0x78,
+	0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66, 0x69, 0x78,
+	0x2e, 0x49, 0x50, 0x46, 0x49, 0x58, 0x52, 0x0b, 0x69, 0x70, 0x66, 0x69, 0x78, 0x47, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x12, 0x57, 0x0a, 0x16, 0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x66, 0x6c, 0x6f,
+	0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x5b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x14, 0x69, 0x70, 0x66, 0x69, 0x78, 0x46, 0x6c, 0x6f,
+	0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x10,
+	0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73,
+	0x18, 0x5c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0f, 0x69, 0x70, 0x66, 0x69,
+	0x78, 0x46, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x77,
+	0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x5d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x67,
+	0x75, 0x61, 0x72, 0x64, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x07, 0x77, 0x67, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x6e, 0x73, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x64, 0x6e, 0x73, 0x2e, 0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x08, 0x64, 0x6e, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x0c, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x09, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x62, 0x66, 0x64, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x62, 0x66, 0x64, 0x2e, 0x42, 0x66, 0x64,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x62, 0x66, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x4c, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x42, 0x2c, 0x5a,
+	0x2a, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70,
+	0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -659,46 +995,71 @@ func file_ligato_vpp_vpp_proto_rawDescGZIP() []byte {
 
 var file_ligato_vpp_vpp_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_ligato_vpp_vpp_proto_goTypes = []interface{}{
-	(*ConfigData)(nil),                       // 0: ligato.vpp.ConfigData
-	(*Notification)(nil),                     // 1: ligato.vpp.Notification
-	(*Stats)(nil),                            // 2: ligato.vpp.Stats
-	(*interfaces.Interface)(nil),             // 3: ligato.vpp.interfaces.Interface
-	(*interfaces.Span)(nil),                  // 4: ligato.vpp.interfaces.Span
-	(*acl.ACL)(nil),                          // 5: ligato.vpp.acl.ACL
-	(*abf.ABF)(nil),                          // 6: ligato.vpp.abf.ABF
-	(*l2.BridgeDomain)(nil),                  // 7: ligato.vpp.l2.BridgeDomain
-	(*l2.FIBEntry)(nil),                      // 8: ligato.vpp.l2.FIBEntry
-	(*l2.XConnectPair)(nil),                  // 9: ligato.vpp.l2.XConnectPair
-	(*l3.Route)(nil),                         // 10: ligato.vpp.l3.Route
-	(*l3.ARPEntry)(nil),                      // 11: ligato.vpp.l3.ARPEntry
-	(*l3.ProxyARP)(nil),                      // 12: ligato.vpp.l3.ProxyARP
-	(*l3.IPScanNeighbor)(nil),                // 13: ligato.vpp.l3.IPScanNeighbor
-	(*l3.VrfTable)(nil),                      // 14: ligato.vpp.l3.VrfTable
-	(*l3.L3XConnect)(nil),                    // 15: ligato.vpp.l3.L3XConnect
-	(*l3.DHCPProxy)(nil),                     // 16: ligato.vpp.l3.DHCPProxy
-	(*l3.TeibEntry)(nil),                     // 17: ligato.vpp.l3.TeibEntry
-	(*nat.Nat44Global)(nil),                  // 18: ligato.vpp.nat.Nat44Global
-	(*nat.DNat44)(nil),                       // 19: ligato.vpp.nat.DNat44
-	(*nat.Nat44Interface)(nil),               // 20: ligato.vpp.nat.Nat44Interface
-	(*nat.Nat44AddressPool)(nil),             // 21: ligato.vpp.nat.Nat44AddressPool
-	(*ipsec.SecurityPolicyDatabase)(nil),     // 22: ligato.vpp.ipsec.SecurityPolicyDatabase
-	(*ipsec.SecurityAssociation)(nil),        // 23: ligato.vpp.ipsec.SecurityAssociation
-	(*ipsec.TunnelProtection)(nil),           // 24: ligato.vpp.ipsec.TunnelProtection
-	(*ipsec.SecurityPolicy)(nil),             // 25: ligato.vpp.ipsec.SecurityPolicy
-	(*punt.IPRedirect)(nil),                  // 26: ligato.vpp.punt.IPRedirect
-	(*punt.ToHost)(nil),                      // 27: ligato.vpp.punt.ToHost
-	(*punt.Exception)(nil),                   // 28: ligato.vpp.punt.Exception
-	(*srv6.SRv6Global)(nil),                  // 29: ligato.vpp.srv6.SRv6Global
-	(*srv6.LocalSID)(nil),                    // 30: ligato.vpp.srv6.LocalSID
-	(*srv6.Policy)(nil),                      // 31: ligato.vpp.srv6.Policy
-	(*srv6.Steering)(nil),                    // 32: ligato.vpp.srv6.Steering
-	(*ipfix.IPFIX)(nil),                      // 33: ligato.vpp.ipfix.IPFIX
-	(*ipfix.FlowProbeParams)(nil),            // 34: ligato.vpp.ipfix.FlowProbeParams
-	(*ipfix.FlowProbeFeature)(nil),           // 35: ligato.vpp.ipfix.FlowProbeFeature
-	(*wireguard.Peer)(nil),                   // 36: ligato.vpp.wireguard.Peer
-	(*dns.DNSCache)(nil),                     // 37: ligato.vpp.dns.DNSCache
-	(*interfaces.InterfaceNotification)(nil), // 38: ligato.vpp.interfaces.InterfaceNotification
-	(*interfaces.InterfaceStats)(nil),        // 39: ligato.vpp.interfaces.InterfaceStats
+	(*ConfigData)(nil),                        // 0: ligato.vpp.ConfigData
+	(*Notification)(nil),                      // 1: ligato.vpp.Notification
+	(*Stats)(nil),                             // 2: ligato.vpp.Stats
+	(*interfaces.Interface)(nil),              // 3: ligato.vpp.interfaces.Interface
+	(*interfaces.Span)(nil),                   // 4: ligato.vpp.interfaces.Span
+	(*acl.ACL)(nil),                           // 5: ligato.vpp.acl.ACL
+	(*abf.ABF)(nil),                           // 6: ligato.vpp.abf.ABF
+	(*l2.BridgeDomain)(nil),                   // 7: ligato.vpp.l2.BridgeDomain
+	(*l2.FIBEntry)(nil),                       // 8: ligato.vpp.l2.FIBEntry
+	(*l2.XConnectPair)(nil),                   // 9: ligato.vpp.l2.XConnectPair
+	(*l3.Route)(nil),                          // 10: ligato.vpp.l3.Route
+	(*l3.ARPEntry)(nil),                       // 11: ligato.vpp.l3.ARPEntry
+	(*l3.ProxyARP)(nil),                       // 12: ligato.vpp.l3.ProxyARP
+	(*l3.IPScanNeighbor)(nil),                 // 13: ligato.vpp.l3.IPScanNeighbor
+	(*l3.VrfTable)(nil),                       // 14: ligato.vpp.l3.VrfTable
+	(*l3.L3XConnect)(nil),                     // 15: ligato.vpp.l3.L3XConnect
+	(*l3.DHCPProxy)(nil),                      // 16: ligato.vpp.l3.DHCPProxy
+	(*l3.TeibEntry)(nil),                      // 17: ligato.vpp.l3.TeibEntry
+	(*mpls.MplsTable)(nil),                    // 18: ligato.vpp.mpls.MplsTable
+	(*mpls.MplsInterface)(nil),                // 19: ligato.vpp.mpls.MplsInterface
+	(*mpls.LocalLabel)(nil),                   // 20: ligato.vpp.mpls.LocalLabel
+	(*policer.Policer)(nil),                   // 21: ligato.vpp.policer.Policer
+	(*policer.QosRecord)(nil),                 // 22: ligato.vpp.policer.QosRecord
+	(*policer.QosEgressMap)(nil),              // 23: ligato.vpp.policer.QosEgressMap
+	(*policer.QosMark)(nil),                   // 24: ligato.vpp.policer.QosMark
+	(*bfd.BfdSession)(nil),                    // 25: ligato.vpp.bfd.BfdSession
+	(*bfd.BfdAuthKey)(nil),                    // 26: ligato.vpp.bfd.BfdAuthKey
+	(*linuxcp.LinuxCpGlobal)(nil),             // 27: ligato.vpp.linuxcp.LinuxCpGlobal
+	(*linuxcp.LinuxCpPair)(nil),               // 28: ligato.vpp.linuxcp.LinuxCpPair
+	(*classifier.ClassifyTable)(nil),          // 29: ligato.vpp.classifier.ClassifyTable
+	(*classifier.ClassifySession)(nil),        // 30: ligato.vpp.classifier.ClassifySession
+	(*classifier.ClassifyInterface)(nil),      // 31: ligato.vpp.classifier.ClassifyInterface
+	(*nat.Nat64Global)(nil),                   // 32: ligato.vpp.nat.Nat64Global
+	(*nat.Nat64Prefix)(nil),                   // 33: ligato.vpp.nat.Nat64Prefix
+	(*nat.Nat64AddressPool)(nil),              // 34: ligato.vpp.nat.Nat64AddressPool
+	(*nat.Nat64Interface)(nil),                // 35: ligato.vpp.nat.Nat64Interface
+	(*nat.Nat64StaticBib)(nil),                // 36: ligato.vpp.nat.Nat64StaticBib
+	(*nat.Det44Global)(nil),                   // 37: ligato.vpp.nat.Det44Global
+	(*nat.Det44Mapping)(nil),                  // 38: ligato.vpp.nat.Det44Mapping
+	(*nat.Det44Interface)(nil),                // 39: ligato.vpp.nat.Det44Interface
+	(*interfaces.IP6RouterAdvertisement)(nil), // 40: ligato.vpp.interfaces.IP6RouterAdvertisement
+	(*interfaces.IP6NDProxy)(nil),             // 41: ligato.vpp.interfaces.IP6NDProxy
+	(*nat.Nat44Global)(nil),                   // 42: ligato.vpp.nat.Nat44Global
+	(*nat.DNat44)(nil),                        // 43: ligato.vpp.nat.DNat44
+	(*nat.Nat44Interface)(nil),                // 44: ligato.vpp.nat.Nat44Interface
+	(*nat.Nat44AddressPool)(nil),              // 45: ligato.vpp.nat.Nat44AddressPool
+	(*ipsec.SecurityPolicyDatabase)(nil),      // 46: ligato.vpp.ipsec.SecurityPolicyDatabase
+	(*ipsec.SecurityAssociation)(nil),         // 47: ligato.vpp.ipsec.SecurityAssociation
+	(*ipsec.TunnelProtection)(nil),            // 48: ligato.vpp.ipsec.TunnelProtection
+	(*ipsec.SecurityPolicy)(nil),              // 49: ligato.vpp.ipsec.SecurityPolicy
+	(*punt.IPRedirect)(nil),                   // 50: ligato.vpp.punt.IPRedirect
+	(*punt.ToHost)(nil),                       // 51: ligato.vpp.punt.ToHost
+	(*punt.Exception)(nil),                    // 52: ligato.vpp.punt.Exception
+	(*srv6.SRv6Global)(nil),                   // 53: ligato.vpp.srv6.SRv6Global
+	(*srv6.LocalSID)(nil),                     // 54: ligato.vpp.srv6.LocalSID
+	(*srv6.Policy)(nil),                       // 55: ligato.vpp.srv6.Policy
+	(*srv6.Steering)(nil),                     // 56: ligato.vpp.srv6.Steering
+	(*ipfix.IPFIX)(nil),                       // 57: ligato.vpp.ipfix.IPFIX
+	(*ipfix.FlowProbeParams)(nil),             // 58: ligato.vpp.ipfix.FlowProbeParams
+	(*ipfix.FlowProbeFeature)(nil),            // 59: ligato.vpp.ipfix.FlowProbeFeature
+	(*wireguard.Peer)(nil),                    // 60: ligato.vpp.wireguard.Peer
+	(*dns.DNSCache)(nil),                      // 61: ligato.vpp.dns.DNSCache
+	(*interfaces.InterfaceNotification)(nil),  // 62: ligato.vpp.interfaces.InterfaceNotification
+	(*bfd.BfdSessionNotification)(nil),        // 63: ligato.vpp.bfd.BfdSessionNotification
+	(*interfaces.InterfaceStats)(nil),         // 64: ligato.vpp.interfaces.InterfaceStats
 }
 var file_ligato_vpp_vpp_proto_depIdxs = []int32{
 	3,  // 0: ligato.vpp.ConfigData.interfaces:type_name -> ligato.vpp.interfaces.Interface
@@ -716,33 +1077,58 @@ var file_ligato_vpp_vpp_proto_depIdxs = []int32{
 	15, // 12: ligato.vpp.ConfigData.l3xconnects:type_name -> ligato.vpp.l3.L3XConnect
 	16, // 13: ligato.vpp.ConfigData.dhcp_proxies:type_name -> ligato.vpp.l3.DHCPProxy
 	17, // 14: ligato.vpp.ConfigData.teib_entries:type_name -> ligato.vpp.l3.TeibEntry
-	18, // 15: ligato.vpp.ConfigData.nat44_global:type_name -> ligato.vpp.nat.Nat44Global
-	19, // 16: ligato.vpp.ConfigData.dnat44s:type_name -> ligato.vpp.nat.DNat44
-	20, // 17: ligato.vpp.ConfigData.nat44_interfaces:type_name -> ligato.vpp.nat.Nat44Interface
-	21, // 18: ligato.vpp.ConfigData.nat44_pools:type_name -> ligato.vpp.nat.Nat44AddressPool
-	22, // 19: ligato.vpp.ConfigData.ipsec_spds:type_name -> ligato.vpp.ipsec.SecurityPolicyDatabase
-	23, // 20: ligato.vpp.ConfigData.ipsec_sas:type_name -> ligato.vpp.ipsec.SecurityAssociation
-	24, // 21: ligato.vpp.ConfigData.ipsec_tunnel_protections:type_name -> ligato.vpp.ipsec.TunnelProtection
-	25, // 22: ligato.vpp.ConfigData.ipsec_sps:type_name -> ligato.vpp.ipsec.SecurityPolicy
-	26, // 23: ligato.vpp.ConfigData.punt_ipredirects:type_name -> ligato.vpp.punt.IPRedirect
-	27, // 24: ligato.vpp.ConfigData.punt_tohosts:type_name -> ligato.vpp.punt.ToHost
-	28, // 25: ligato.vpp.ConfigData.punt_exceptions:type_name -> ligato.vpp.punt.Exception
-	29, // 26: ligato.vpp.ConfigData.srv6_global:type_name -> ligato.vpp.srv6.SRv6Global
-	30, // 27: ligato.vpp.ConfigData.srv6_localsids:type_name -> ligato.vpp.srv6.LocalSID
-	31, // 28: ligato.vpp.ConfigData.srv6_policies:type_name -> ligato.vpp.srv6.Policy
-	32, // 29: ligato.vpp.ConfigData.srv6_steerings:type_name -> ligato.vpp.srv6.Steering
-	33, // 30: ligato.vpp.ConfigData.ipfix_global:type_name -> ligato.vpp.ipfix.IPFIX
-	34, // 31: ligato.vpp.ConfigData.ipfix_flowprobe_params:type_name -> ligato.vpp.ipfix.FlowProbeParams
-	35, // 32: ligato.vpp.ConfigData.ipfix_flowprobes:type_name -> ligato.vpp.ipfix.FlowProbeFeature
-	36, // 33: ligato.vpp.ConfigData.wg_peers:type_name -> ligato.vpp.wireguard.Peer
-	37, // 34: ligato.vpp.ConfigData.dns_cache:type_name -> ligato.vpp.dns.DNSCache
-	38, // 35: ligato.vpp.Notification.interface:type_name -> ligato.vpp.interfaces.InterfaceNotification
-	39, // 36: ligato.vpp.Stats.interface:type_name -> ligato.vpp.interfaces.InterfaceStats
-	37, // [37:37] is the sub-list for method output_type
-	37, // [37:37] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	18, // 15: ligato.vpp.ConfigData.mpls_tables:type_name -> ligato.vpp.mpls.MplsTable
+	19, // 16: ligato.vpp.ConfigData.mpls_interfaces:type_name -> ligato.vpp.mpls.MplsInterface
+	20, // 17: ligato.vpp.ConfigData.mpls_local_labels:type_name -> ligato.vpp.mpls.LocalLabel
+	21, // 18: ligato.vpp.ConfigData.policers:type_name -> ligato.vpp.policer.Policer
+	22, // 19: ligato.vpp.ConfigData.qos_records:type_name -> ligato.vpp.policer.QosRecord
+	23, // 20: ligato.vpp.ConfigData.qos_egress_maps:type_name -> ligato.vpp.policer.QosEgressMap
+	24, // 21: ligato.vpp.ConfigData.qos_marks:type_name -> ligato.vpp.policer.QosMark
+	25, // 22: ligato.vpp.ConfigData.bfd_sessions:type_name -> ligato.vpp.bfd.BfdSession
+	26, // 23: ligato.vpp.ConfigData.bfd_auth_keys:type_name -> ligato.vpp.bfd.BfdAuthKey
+	27, // 24: ligato.vpp.ConfigData.linuxcp_global:type_name -> ligato.vpp.linuxcp.LinuxCpGlobal
+	28, // 25: ligato.vpp.ConfigData.linuxcp_pairs:type_name -> ligato.vpp.linuxcp.LinuxCpPair
+	29, // 26: ligato.vpp.ConfigData.classify_tables:type_name -> ligato.vpp.classifier.ClassifyTable
+	30, // 27: ligato.vpp.ConfigData.classify_sessions:type_name -> ligato.vpp.classifier.ClassifySession
+	31, // 28: ligato.vpp.ConfigData.classify_interfaces:type_name -> ligato.vpp.classifier.ClassifyInterface
+	32, // 29: ligato.vpp.ConfigData.nat64_global:type_name -> ligato.vpp.nat.Nat64Global
+	33, // 30: ligato.vpp.ConfigData.nat64_prefixes:type_name -> ligato.vpp.nat.Nat64Prefix
+	34, // 31: ligato.vpp.ConfigData.nat64_pools:type_name -> ligato.vpp.nat.Nat64AddressPool
+	35, // 32: ligato.vpp.ConfigData.nat64_interfaces:type_name -> ligato.vpp.nat.Nat64Interface
+	36, // 33: ligato.vpp.ConfigData.nat64_static_bibs:type_name -> ligato.vpp.nat.Nat64StaticBib
+	37, // 34: ligato.vpp.ConfigData.det44_global:type_name -> ligato.vpp.nat.Det44Global
+	38, // 35: ligato.vpp.ConfigData.det44_mappings:type_name -> ligato.vpp.nat.Det44Mapping
+	39, // 36: ligato.vpp.ConfigData.det44_interfaces:type_name -> ligato.vpp.nat.Det44Interface
+	40, // 37: ligato.vpp.ConfigData.ip6_router_advertisements:type_name -> ligato.vpp.interfaces.IP6RouterAdvertisement
+	41, // 38: ligato.vpp.ConfigData.ip6nd_proxies:type_name -> ligato.vpp.interfaces.IP6NDProxy
+	42, // 39: ligato.vpp.ConfigData.nat44_global:type_name -> ligato.vpp.nat.Nat44Global
+	43, // 40: ligato.vpp.ConfigData.dnat44s:type_name -> ligato.vpp.nat.DNat44
+	44, // 41: ligato.vpp.ConfigData.nat44_interfaces:type_name -> ligato.vpp.nat.Nat44Interface
+	45, // 42: ligato.vpp.ConfigData.nat44_pools:type_name -> ligato.vpp.nat.Nat44AddressPool
+	46, // 43: ligato.vpp.ConfigData.ipsec_spds:type_name -> ligato.vpp.ipsec.SecurityPolicyDatabase
+	47, // 44: ligato.vpp.ConfigData.ipsec_sas:type_name -> ligato.vpp.ipsec.SecurityAssociation
+	48, // 45: ligato.vpp.ConfigData.ipsec_tunnel_protections:type_name -> ligato.vpp.ipsec.TunnelProtection
+	49, // 46: ligato.vpp.ConfigData.ipsec_sps:type_name -> ligato.vpp.ipsec.SecurityPolicy
+	50, // 47: ligato.vpp.ConfigData.punt_ipredirects:type_name -> ligato.vpp.punt.IPRedirect
+	51, // 48: ligato.vpp.ConfigData.punt_tohosts:type_name -> ligato.vpp.punt.ToHost
+	52, // 49: ligato.vpp.ConfigData.punt_exceptions:type_name -> ligato.vpp.punt.Exception
+	53, // 50: ligato.vpp.ConfigData.srv6_global:type_name -> ligato.vpp.srv6.SRv6Global
+	54, // 51: ligato.vpp.ConfigData.srv6_localsids:type_name -> ligato.vpp.srv6.LocalSID
+	55, // 52: ligato.vpp.ConfigData.srv6_policies:type_name -> ligato.vpp.srv6.Policy
+	56, // 53: ligato.vpp.ConfigData.srv6_steerings:type_name -> ligato.vpp.srv6.Steering
+	57, // 54: ligato.vpp.ConfigData.ipfix_global:type_name -> ligato.vpp.ipfix.IPFIX
+	58, // 55: ligato.vpp.ConfigData.ipfix_flowprobe_params:type_name -> ligato.vpp.ipfix.FlowProbeParams
+	59, // 56: ligato.vpp.ConfigData.ipfix_flowprobes:type_name -> ligato.vpp.ipfix.FlowProbeFeature
+	60, // 57: ligato.vpp.ConfigData.wg_peers:type_name -> ligato.vpp.wireguard.Peer
+	61, // 58: ligato.vpp.ConfigData.dns_cache:type_name -> ligato.vpp.dns.DNSCache
+	62, // 59: ligato.vpp.Notification.interface:type_name -> ligato.vpp.interfaces.InterfaceNotification
+	63, // 60: ligato.vpp.Notification.bfd_session:type_name -> ligato.vpp.bfd.BfdSessionNotification
+	64, // 61: ligato.vpp.Stats.interface:type_name -> ligato.vpp.interfaces.InterfaceStats
+	62, // [62:62] is the sub-list for method output_type
+	62, // [62:62] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_vpp_proto_init() }
@@ -17,11 +17,16 @@ package vpp
 import (
 	vpp_abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
+	vpp_bfd "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/bfd"
+	vpp_classifier "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/classifier"
 	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
 	vpp_ipsec "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/ipsec"
 	vpp_l2 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l2"
 	vpp_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	vpp_linuxcp "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/linuxcp"
+	vpp_mpls "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/mpls"
 	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+	vpp_policer "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/policer"
 	vpp_punt "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/punt"
 	vpp_srv6 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/srv6"
 	vpp_stn "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/stn"
@@ -30,8 +35,10 @@ import (
 
 type (
 	// Interface
-	Interface = vpp_interfaces.Interface
-	Span      = vpp_interfaces.Span
+	Interface              = vpp_interfaces.Interface
+	Span                   = vpp_interfaces.Span
+	IP6RouterAdvertisement = vpp_interfaces.IP6RouterAdvertisement
+	IP6NDProxy             = vpp_interfaces.IP6NDProxy
 
 	// ACL & ABF
 	ACL = vpp_acl.ACL
@@ -51,11 +58,43 @@ type (
 	L3XConnect  = vpp_l3.L3XConnect
 	DHCPProxy   = vpp_l3.DHCPProxy
 
+	// MPLS
+	MplsTable      = vpp_mpls.MplsTable
+	MplsInterface  = vpp_mpls.MplsInterface
+	MplsLocalLabel = vpp_mpls.LocalLabel
+
 	// NAT
 	NAT44Global      = vpp_nat.Nat44Global
 	DNAT44           = vpp_nat.DNat44
 	Nat44AddressPool = vpp_nat.Nat44AddressPool
 	Nat44Interface   = vpp_nat.Nat44Interface
+	Nat64Global      = vpp_nat.Nat64Global
+	Nat64Prefix      = vpp_nat.Nat64Prefix
+	Nat64AddressPool = vpp_nat.Nat64AddressPool
+	Nat64Interface   = vpp_nat.Nat64Interface
+	Nat64StaticBib   = vpp_nat.Nat64StaticBib
+	Det44Global      = vpp_nat.Det44Global
+	Det44Mapping     = vpp_nat.Det44Mapping
+	Det44Interface   = vpp_nat.Det44Interface
+
+	// Policer & QoS
+	Policer      = vpp_policer.Policer
+	QosRecord    = vpp_policer.QosRecord
+	QosEgressMap = vpp_policer.QosEgressMap
+	QosMark      = vpp_policer.QosMark
+
+	// BFD
+	BfdSession = vpp_bfd.BfdSession
+	BfdAuthKey = vpp_bfd.BfdAuthKey
+
+	// Linux-CP
+	LinuxCpPair   = vpp_linuxcp.LinuxCpPair
+	LinuxCpGlobal = vpp_linuxcp.LinuxCpGlobal
+
+	// Classifier
+	ClassifyTable     = vpp_classifier.ClassifyTable
+	ClassifySession   = vpp_classifier.ClassifySession
+	ClassifyInterface = vpp_classifier.ClassifyInterface
 
 	// IPSec
 	IPSecSPD = vpp_ipsec.SecurityPolicyDatabase